@@ -20,6 +20,7 @@ import (
 	"github.com/andreypavlenko/jobber/internal/platform/email"
 	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
 	"github.com/andreypavlenko/jobber/internal/platform/logger"
+	"github.com/andreypavlenko/jobber/internal/platform/metrics"
 	"github.com/andreypavlenko/jobber/internal/platform/pdf"
 	"github.com/andreypavlenko/jobber/internal/platform/postgres"
 	"github.com/andreypavlenko/jobber/internal/platform/redis"
@@ -30,7 +31,9 @@ import (
 	authHandler "github.com/andreypavlenko/jobber/modules/auth/handler"
 	authRepo "github.com/andreypavlenko/jobber/modules/auth/repository"
 	authService "github.com/andreypavlenko/jobber/modules/auth/service"
+	userHandler "github.com/andreypavlenko/jobber/modules/users/handler"
 	userRepo "github.com/andreypavlenko/jobber/modules/users/repository"
+	userService "github.com/andreypavlenko/jobber/modules/users/service"
 
 	appHandler "github.com/andreypavlenko/jobber/modules/applications/handler"
 	appRepo "github.com/andreypavlenko/jobber/modules/applications/repository"
@@ -56,6 +59,10 @@ import (
 	analyticsRepo "github.com/andreypavlenko/jobber/modules/analytics/repository"
 	analyticsService "github.com/andreypavlenko/jobber/modules/analytics/service"
 
+	reminderHandler "github.com/andreypavlenko/jobber/modules/reminders/handler"
+	reminderRepo "github.com/andreypavlenko/jobber/modules/reminders/repository"
+	reminderService "github.com/andreypavlenko/jobber/modules/reminders/service"
+
 	calendarHandler "github.com/andreypavlenko/jobber/modules/calendar/handler"
 	calendarRepo "github.com/andreypavlenko/jobber/modules/calendar/repository"
 	calendarService "github.com/andreypavlenko/jobber/modules/calendar/service"
@@ -67,6 +74,14 @@ import (
 	matchScoreRepo "github.com/andreypavlenko/jobber/modules/matchscore/repository"
 	matchScoreService "github.com/andreypavlenko/jobber/modules/matchscore/service"
 
+	goalHandler "github.com/andreypavlenko/jobber/modules/goals/handler"
+	goalRepo "github.com/andreypavlenko/jobber/modules/goals/repository"
+	goalService "github.com/andreypavlenko/jobber/modules/goals/service"
+
+	userWebhookHandler "github.com/andreypavlenko/jobber/modules/webhooks/handler"
+	userWebhookRepo "github.com/andreypavlenko/jobber/modules/webhooks/repository"
+	userWebhookService "github.com/andreypavlenko/jobber/modules/webhooks/service"
+
 	clHandler "github.com/andreypavlenko/jobber/modules/contentlibrary/handler"
 	clRepo "github.com/andreypavlenko/jobber/modules/contentlibrary/repository"
 	clService "github.com/andreypavlenko/jobber/modules/contentlibrary/service"
@@ -79,11 +94,19 @@ import (
 	rbRepo "github.com/andreypavlenko/jobber/modules/resumebuilder/repository"
 	rbService "github.com/andreypavlenko/jobber/modules/resumebuilder/service"
 
+	searchHandler "github.com/andreypavlenko/jobber/modules/search/handler"
+	searchRepo "github.com/andreypavlenko/jobber/modules/search/repository"
+	searchService "github.com/andreypavlenko/jobber/modules/search/service"
+
 	subHandler "github.com/andreypavlenko/jobber/modules/subscriptions/handler"
 	subModel "github.com/andreypavlenko/jobber/modules/subscriptions/model"
 	subRepo "github.com/andreypavlenko/jobber/modules/subscriptions/repository"
 	subService "github.com/andreypavlenko/jobber/modules/subscriptions/service"
 
+	tagHandler "github.com/andreypavlenko/jobber/modules/tags/handler"
+	tagRepo "github.com/andreypavlenko/jobber/modules/tags/repository"
+	tagService "github.com/andreypavlenko/jobber/modules/tags/service"
+
 	supportHandler "github.com/andreypavlenko/jobber/modules/support/handler"
 	supportService "github.com/andreypavlenko/jobber/modules/support/service"
 
@@ -153,6 +176,14 @@ func main() {
 	}
 	defer logger.Sync()
 
+	// "api migrate ..." runs migrations and exits instead of starting the server
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrateCommand(context.Background(), cfg.Database, logger, os.Args[2:]); err != nil {
+			logger.Fatal("Migration command failed", zap.Error(err))
+		}
+		return
+	}
+
 	// Initialize Sentry (respects feature flag)
 	var sentryEnabled bool
 	if cfg.Features.SentryEnabled {
@@ -180,7 +211,6 @@ func main() {
 	logger.Info("Connected to PostgreSQL")
 
 	// Run database migrations (MANDATORY: must run before HTTP server starts)
-	migrationsPath := "./migrations"
 	if err := postgres.RunMigrations(ctx, cfg.Database, logger, migrationsPath); err != nil {
 		logger.Fatal("Failed to run database migrations",
 			zap.Error(err),
@@ -188,21 +218,30 @@ func main() {
 		)
 	}
 
-	// Initialize Redis
+	// Initialize Redis (optional when REDIS_OPTIONAL=true; a nil client disables Redis-backed features)
 	redisClient, err := redis.New(ctx, cfg.Redis)
 	if err != nil {
-		logger.Fatal("Failed to connect to Redis", zap.Error(err))
+		if !cfg.Redis.Optional {
+			logger.Fatal("Failed to connect to Redis", zap.Error(err))
+		}
+		logger.Warn("==================================================================")
+		logger.Warn("REDIS UNAVAILABLE - running in degraded mode", zap.Error(err))
+		logger.Warn("Caching, rate limiting, idempotency and Google Calendar are disabled")
+		logger.Warn("==================================================================")
+	} else {
+		logger.Info("Connected to Redis")
 	}
 	defer redisClient.Close()
-	logger.Info("Connected to Redis")
 
-	// Initialize S3 client (optional - gracefully handle missing config)
-	var s3Client *storage.S3Client
+	// Initialize S3 client (optional - gracefully handle missing config).
+	// The client is wrapped in a circuit breaker so an S3 outage fails fast.
+	var s3Client storage.ObjectStorage
 	if cfg.S3.Endpoint != "" && cfg.S3.Bucket != "" {
-		s3Client, err = storage.NewS3Client(cfg.S3)
-		if err != nil {
-			logger.Warn("Failed to initialize S3 client, file upload will be disabled", zap.Error(err))
+		rawS3Client, s3Err := storage.NewS3Client(cfg.S3)
+		if s3Err != nil {
+			logger.Warn("Failed to initialize S3 client, file upload will be disabled", zap.Error(s3Err))
 		} else {
+			s3Client = storage.NewCircuitBreaker(rawS3Client)
 			logger.Info("S3 client initialized", zap.String("bucket", cfg.S3.Bucket))
 		}
 	} else {
@@ -219,6 +258,16 @@ func main() {
 	router.Use(sentryPlatform.RecoveryMiddleware(sentryEnabled))
 	router.Use(httpPlatform.RequestIDMiddleware())
 	router.Use(httpPlatform.LoggerMiddleware(logger))
+
+	// Prometheus metrics; health checks and scrapes are not counted
+	appMetrics := metrics.New()
+	appMetrics.RegisterPostgresPool(pgClient.Pool)
+	appMetrics.RegisterRedisPool(redisClient.Raw())
+	router.Use(appMetrics.Middleware("/health", "/metrics"))
+	if cfg.Server.Env == "development" {
+		// Log redacted request bodies to ease local debugging
+		router.Use(httpPlatform.BodyLogMiddleware(logger, httpPlatform.DefaultRedactFields))
+	}
 	router.Use(httpPlatform.CORSMiddleware(cfg.Server.AllowedOrigins))
 
 	// Swagger documentation (available in development)
@@ -227,6 +276,20 @@ func main() {
 		logger.Info("Swagger UI available at /swagger/index.html")
 	}
 
+	// Metrics endpoint: on a separate internal port when configured, otherwise
+	// on the API port outside production
+	var metricsSrv *http.Server
+	if cfg.Server.MetricsPort != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", appMetrics.Handler())
+		metricsSrv = &http.Server{
+			Addr:    fmt.Sprintf(":%s", cfg.Server.MetricsPort),
+			Handler: metricsMux,
+		}
+	} else if cfg.Server.Env != "production" {
+		router.GET("/metrics", gin.WrapH(appMetrics.Handler()))
+	}
+
 	// Health check endpoint
 	router.GET("/health", healthCheckHandler(ctx, pgClient, redisClient))
 	
@@ -241,9 +304,6 @@ func main() {
 		cfg.JWT.RefreshExpiry,
 	)
 
-	// Auth middleware
-	authMiddleware := auth.AuthMiddleware(jwtManager)
-
 	// Initialize email sender (respects feature flag)
 	var emailSender email.Sender
 	if !cfg.Features.EmailEnabled {
@@ -270,7 +330,10 @@ func main() {
 	userRepository := userRepo.NewUserRepository(pgClient.Pool)
 	tokenRepository := authRepo.NewRefreshTokenRepository(pgClient.Pool)
 	companyRepository := companyRepo.NewCompanyRepository(pgClient.Pool)
+	companyContactRepository := companyRepo.NewContactRepository(pgClient.Pool)
+	applicationContactRepository := companyRepo.NewApplicationContactRepository(pgClient.Pool)
 	jobRepository := jobRepo.NewJobRepository(pgClient.Pool)
+	jobStatusHistoryRepository := jobRepo.NewJobStatusHistoryRepository(pgClient.Pool)
 	resumeRepository := resumeRepo.NewResumeRepository(pgClient.Pool)
 	applicationRepository := appRepo.NewApplicationRepository(pgClient.Pool)
 	stageTemplateRepository := appRepo.NewStageTemplateRepository(pgClient.Pool)
@@ -278,6 +341,7 @@ func main() {
 	commentRepository := commentRepo.NewCommentRepository(pgClient.Pool)
 	analyticsRepository := analyticsRepo.NewAnalyticsRepository(pgClient.Pool)
 	subscriptionRepository := subRepo.NewSubscriptionRepository(pgClient.Pool)
+	tagRepository := tagRepo.NewTagRepository(pgClient.Pool)
 
 	// Initialize subscription service (used as limit checker by other services)
 	subscriptionSvc := subService.NewSubscriptionService(
@@ -298,7 +362,7 @@ func main() {
 	passwordResetRepository := authRepo.NewPasswordResetRepository(pgClient.Pool)
 
 	// Initialize services
-	authSvc := authService.NewAuthService(authService.AuthServiceConfig{
+	authCfg := authService.AuthServiceConfig{
 		UserRepo:            userRepository,
 		TokenRepo:           tokenRepository,
 		VerificationRepo:    verificationRepository,
@@ -309,39 +373,87 @@ func main() {
 		RefreshExpiry:       cfg.JWT.RefreshExpiry,
 		SubscriptionCreator: subscriptionSvc,
 		Logger:              logger.Logger,
-	})
-	companySvc := companyService.NewCompanyService(companyRepository)
-	jobSvc := jobService.NewJobService(jobRepository, companyRepository, subscriptionSvc, matchScoreCacheRepo)
+	}
+
+	// Sign in with Google (optional — needs the OAuth client and Redis for the sign-in state)
+	googleOAuthConfigured := cfg.GoogleOAuth.ClientID != "" &&
+		cfg.GoogleOAuth.ClientSecret != "" &&
+		cfg.GoogleOAuth.RedirectURL != ""
+	if googleOAuthConfigured && !redisClient.Available() {
+		logger.Warn("Google sign-in configured but Redis is unavailable, sign-in with Google disabled")
+	} else if googleOAuthConfigured {
+		authCfg.GoogleProvider = authService.NewGoogleProvider(cfg.GoogleOAuth.ClientID, cfg.GoogleOAuth.ClientSecret, cfg.GoogleOAuth.RedirectURL)
+		authCfg.OAuthAccountRepo = authRepo.NewOAuthAccountRepository(pgClient.Pool)
+		authCfg.RedisClient = redisClient.Raw()
+		logger.Info("Sign-in with Google enabled")
+	}
+	authSvc := authService.NewAuthService(authCfg)
+	profileSvc := userService.NewProfileService(userRepository, redisClient.Raw())
+	analyticsSvc := analyticsService.NewCachedAnalyticsService(
+		analyticsService.NewAnalyticsService(analyticsRepository), redisClient.Raw(), cfg.Redis.AnalyticsCacheTTL)
+	companySvc := companyService.NewCompanyService(companyRepository, companyContactRepository, profileSvc, analyticsSvc)
+	companyContactSvc := companyService.NewContactService(companyRepository, companyContactRepository)
+	applicationContactSvc := companyService.NewApplicationContactService(companyRepository, companyContactRepository, applicationContactRepository)
+	jobSvc := jobService.NewJobService(jobRepository, companyRepository, subscriptionSvc, matchScoreCacheRepo, jobStatusHistoryRepository, commentRepository, profileSvc, analyticsSvc)
 	resumeSvc := resumeService.NewResumeService(resumeRepository, s3Client, subscriptionSvc, matchScoreCacheRepo)
 
 	// Initialize resume builder repository early — needed by application service
 	resumeBuilderRepository := rbRepo.NewResumeBuilderRepository(pgClient.Pool)
+	reminderRepository := reminderRepo.NewReminderRepository(pgClient.Pool)
+
+	// Initialize user webhooks; status changes are delivered in the background
+	userWebhookRepository := userWebhookRepo.NewWebhookRepository(pgClient.Pool)
+	userWebhookSvc := userWebhookService.NewWebhookService(userWebhookRepository)
+	userWebhookHdl := userWebhookHandler.NewWebhookHandler(userWebhookSvc)
+	webhookDispatcher := userWebhookService.NewDispatcher(userWebhookRepository, logger)
+
+	applicationSvc := appService.NewApplicationService(appService.ApplicationServiceConfig{
+		Pool:              pgClient.Pool,
+		AppRepo:           applicationRepository,
+		StageRepo:         applicationStageRepository,
+		TemplateRepo:      stageTemplateRepository,
+		JobRepo:           jobRepository,
+		CompanyRepo:       companyRepository,
+		ResumeRepo:        resumeRepository,
+		ResumeBuilderRepo: resumeBuilderRepository,
+		CommentRepo:       commentRepository,
+		Logger:            logger,
+		LimitChecker:      subscriptionSvc,
+		FrontendURL:       cfg.Server.FrontendURL,
+		TagRepo:           tagRepository,
+		ReminderRepo:      reminderRepository,
+		Storage:           s3Client,
+		ProfileCache:      profileSvc,
+		AnalyticsCache:    analyticsSvc,
+		StatusNotifier:    webhookDispatcher,
+		RedisClient:       redisClient.Raw(),
+		EventRepo:         appRepo.NewApplicationEventRepository(pgClient.Pool),
+		TxRepos:           appRepo.NewTxRepositories,
+	})
+	appMetrics.RegisterApplicationsByStatus(applicationSvc.StatusCounts)
 
-	applicationSvc := appService.NewApplicationService(
-		pgClient.Pool,
-		applicationRepository,
-		applicationStageRepository,
-		stageTemplateRepository,
-		jobRepository,
-		companyRepository,
-		resumeRepository,
-		resumeBuilderRepository,
-		commentRepository,
-		logger,
-		subscriptionSvc,
-	)
-	commentSvc := commentService.NewCommentService(commentRepository)
-	analyticsSvc := analyticsService.NewAnalyticsService(analyticsRepository)
+	commentSvc := commentService.NewCommentService(commentRepository, applicationSvc)
+	reminderSvc := reminderService.NewReminderService(reminderRepository)
+	tagSvc := tagService.NewTagService(tagRepository)
+	searchSvc := searchService.NewSearchService(searchRepo.NewSearchRepository(pgClient.Pool))
+	weeklyReportSvc := analyticsService.NewWeeklyReportService(analyticsRepository, reminderRepository)
 
 	// Initialize handlers
 	cookieCfg := auth.NewCookieConfig(cfg.Server.Env)
 	authHdl := authHandler.NewAuthHandler(authSvc, cookieCfg, cfg.JWT.AccessExpiry, cfg.JWT.RefreshExpiry)
+	userHdl := userHandler.NewUserHandler(profileSvc)
 	companyHdl := companyHandler.NewCompanyHandler(companySvc)
+	companyContactHdl := companyHandler.NewContactHandler(companyContactSvc)
+	applicationContactHdl := companyHandler.NewApplicationContactHandler(applicationContactSvc)
 	jobHdl := jobHandler.NewJobHandler(jobSvc)
 	resumeHdl := resumeHandler.NewResumeHandler(resumeSvc)
 	applicationHdl := appHandler.NewApplicationHandler(applicationSvc)
 	commentHdl := commentHandler.NewCommentHandler(commentSvc)
-	analyticsHdl := analyticsHandler.NewAnalyticsHandler(analyticsSvc)
+	reminderHdl := reminderHandler.NewReminderHandler(reminderSvc)
+	tagHdl := tagHandler.NewTagHandler(tagSvc)
+	searchHdl := searchHandler.NewSearchHandler(searchSvc)
+	analyticsHdl := analyticsHandler.NewAnalyticsHandler(analyticsSvc, analyticsSvc)
+	weeklyReportHdl := analyticsHandler.NewWeeklyReportHandler(weeklyReportSvc)
 	subscriptionHdl := subHandler.NewSubscriptionHandler(subscriptionSvc, logger.Logger)
 	webhookHdl := subHandler.NewWebhookHandler(subscriptionSvc, logger.Logger)
 
@@ -353,13 +465,17 @@ func main() {
 		logger.Info("Support module enabled")
 	}
 
-	// Initialize calendar module (optional — only if all Google Calendar config is provided)
+	// Initialize calendar module (optional — only if all Google Calendar config is provided).
+	// OAuth state lives in Redis, so the integration also needs Redis.
 	var calendarHdl *calendarHandler.CalendarHandler
-	if cfg.GoogleCalendar.ClientID != "" &&
+	calendarConfigured := cfg.GoogleCalendar.ClientID != "" &&
 		cfg.GoogleCalendar.ClientSecret != "" &&
 		cfg.GoogleCalendar.TokenEncryptionKey != "" &&
 		cfg.GoogleCalendar.RedirectURL != "" &&
-		cfg.GoogleCalendar.FrontendURL != "" {
+		cfg.GoogleCalendar.FrontendURL != ""
+	if calendarConfigured && !redisClient.Available() {
+		logger.Warn("Google Calendar configured but Redis is unavailable, integration disabled")
+	} else if calendarConfigured {
 		oauthConfig := &oauth2.Config{
 			ClientID:     cfg.GoogleCalendar.ClientID,
 			ClientSecret: cfg.GoogleCalendar.ClientSecret,
@@ -382,7 +498,7 @@ func main() {
 			gcalClient,
 			encryptor,
 			oauthConfig,
-			redisClient.Client,
+			redisClient.Raw(),
 			cfg.GoogleCalendar.FrontendURL,
 		)
 		calendarHdl = calendarHandler.NewCalendarHandler(calSvc)
@@ -395,6 +511,11 @@ func main() {
 	resumeBuilderSvc := rbService.NewResumeBuilderService(resumeBuilderRepository, subscriptionSvc)
 	resumeBuilderHdl := rbHandler.NewResumeBuilderHandler(resumeBuilderSvc)
 
+	// Initialize goals module
+	goalRepository := goalRepo.NewGoalRepository(pgClient.Pool)
+	goalSvc := goalService.NewGoalService(goalRepository)
+	goalHdl := goalHandler.NewGoalHandler(goalSvc)
+
 	// Initialize content library module
 	contentLibraryRepository := clRepo.NewContentLibraryRepository(pgClient.Pool)
 	contentLibrarySvc := clService.NewContentLibraryService(contentLibraryRepository)
@@ -461,71 +582,85 @@ func main() {
 		logger.Info("ANTHROPIC_API_KEY not configured, AI features disabled")
 	}
 
-	// Rate limiting for auth endpoints (10 requests per minute per IP)
-	authRateLimiter := httpPlatform.RateLimitMiddleware(redisClient.Client, httpPlatform.RateLimitConfig{
-		MaxRequests: 10,
+	// Rate limiting for auth endpoints (login brute force; 10 requests per minute per IP by default)
+	authRateLimiter := httpPlatform.RateLimitMiddleware(redisClient.Raw(), httpPlatform.RateLimitConfig{
+		MaxRequests: cfg.RateLimit.AuthPerMinute,
 		Window:      1 * time.Minute,
 		KeyPrefix:   "auth",
 	}, logger.Logger)
 
+	// Per-user, per-route limit for authenticated reads (100 per minute by default),
+	// applied right after authentication on every protected route
+	readRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Raw(), httpPlatform.RateLimitConfig{
+		MaxRequests: cfg.RateLimit.ReadPerMinute,
+		Window:      1 * time.Minute,
+		KeyPrefix:   "read",
+		PerRoute:    true,
+		ReadOnly:    true,
+	}, logger.Logger)
+	authMiddleware := httpPlatform.Chain(auth.Authenticate(jwtManager), readRateLimiter)
+
 	// Per-user rate limiting for authenticated AI/export endpoints
-	importRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Client, httpPlatform.RateLimitConfig{
+	importRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Raw(), httpPlatform.RateLimitConfig{
 		MaxRequests: 20,
 		Window:      1 * time.Minute,
 		KeyPrefix:   "ai_import",
 	}, logger.Logger)
 
-	matchScoreRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Client, httpPlatform.RateLimitConfig{
+	matchScoreRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Raw(), httpPlatform.RateLimitConfig{
 		MaxRequests: 10,
 		Window:      1 * time.Minute,
 		KeyPrefix:   "match_score",
 	}, logger.Logger)
 
-	exportRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Client, httpPlatform.RateLimitConfig{
+	exportRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Raw(), httpPlatform.RateLimitConfig{
 		MaxRequests: 5,
 		Window:      1 * time.Minute,
 		KeyPrefix:   "pdf_export",
 	}, logger.Logger)
 
-	resumeAIRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Client, httpPlatform.RateLimitConfig{
+	resumeAIRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Raw(), httpPlatform.RateLimitConfig{
 		MaxRequests: 20,
 		Window:      1 * time.Minute,
 		KeyPrefix:   "resume_ai",
 	}, logger.Logger)
 
-	resumeImportRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Client, httpPlatform.RateLimitConfig{
+	resumeImportRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Raw(), httpPlatform.RateLimitConfig{
 		MaxRequests: 20,
 		Window:      1 * time.Minute,
 		KeyPrefix:   "resume_import",
 	}, logger.Logger)
 
-	coverLetterAIRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Client, httpPlatform.RateLimitConfig{
+	coverLetterAIRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Raw(), httpPlatform.RateLimitConfig{
 		MaxRequests: 20,
 		Window:      1 * time.Minute,
 		KeyPrefix:   "cover_letter_ai",
 	}, logger.Logger)
 
 	// Per-user rate limiting for support endpoint (3 requests per 5 minutes)
-	supportRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Client, httpPlatform.RateLimitConfig{
+	supportRateLimiter := httpPlatform.UserRateLimitMiddleware(redisClient.Raw(), httpPlatform.RateLimitConfig{
 		MaxRequests: 3,
 		Window:      5 * time.Minute,
 		KeyPrefix:   "support",
 	}, logger.Logger)
 
 	// Stricter rate limiting for email-sending endpoints (3 requests per 15 minutes per IP)
-	emailRateLimiter := httpPlatform.RateLimitMiddleware(redisClient.Client, httpPlatform.RateLimitConfig{
+	emailRateLimiter := httpPlatform.RateLimitMiddleware(redisClient.Raw(), httpPlatform.RateLimitConfig{
 		MaxRequests: 3,
 		Window:      15 * time.Minute,
 		KeyPrefix:   "email_send",
 	}, logger.Logger)
 
 	// Stricter rate limiting for code verification endpoints (5 requests per 5 minutes per IP)
-	codeRateLimiter := httpPlatform.RateLimitMiddleware(redisClient.Client, httpPlatform.RateLimitConfig{
+	codeRateLimiter := httpPlatform.RateLimitMiddleware(redisClient.Raw(), httpPlatform.RateLimitConfig{
 		MaxRequests: 5,
 		Window:      5 * time.Minute,
 		KeyPrefix:   "code_verify",
 	}, logger.Logger)
 
+	// Replays cached responses for retried create requests carrying an Idempotency-Key
+	idempotencyMiddleware := httpPlatform.IdempotencyMiddleware(redisClient.Raw(), httpPlatform.DefaultIdempotencyTTL, logger.Logger)
+
 	// API v1 routes
 	v1 := router.Group("/api/v1")
 	{
@@ -536,12 +671,21 @@ func main() {
 			EmailRateLimiter: emailRateLimiter,
 			CodeRateLimiter:  codeRateLimiter,
 		})
+		userHdl.RegisterRoutes(v1, authMiddleware)
 		companyHdl.RegisterRoutes(v1, authMiddleware)
+		companyContactHdl.RegisterRoutes(v1, authMiddleware)
+		applicationContactHdl.RegisterRoutes(v1, authMiddleware)
 		jobHdl.RegisterRoutes(v1, authMiddleware)
 		resumeHdl.RegisterRoutes(v1, authMiddleware)
-		applicationHdl.RegisterRoutes(v1, authMiddleware)
+		applicationHdl.RegisterRoutes(v1, authMiddleware, idempotencyMiddleware)
 		commentHdl.RegisterRoutes(v1, authMiddleware)
+		reminderHdl.RegisterRoutes(v1, authMiddleware)
+		tagHdl.RegisterRoutes(v1, authMiddleware)
+		searchHdl.RegisterRoutes(v1, authMiddleware)
 		analyticsHdl.RegisterRoutes(v1, authMiddleware)
+		weeklyReportHdl.RegisterRoutes(v1, authMiddleware)
+		goalHdl.RegisterRoutes(v1, authMiddleware)
+		userWebhookHdl.RegisterRoutes(v1, authMiddleware)
 		resumeBuilderHdl.RegisterRoutes(v1, authMiddleware)
 		contentLibraryHdl.RegisterRoutes(v1, authMiddleware)
 		coverLetterHdl.RegisterRoutes(v1, authMiddleware)
@@ -613,6 +757,15 @@ func main() {
 		}
 	}()
 
+	if metricsSrv != nil {
+		go func() {
+			logger.Info("Metrics server listening", zap.String("address", metricsSrv.Addr))
+			if err := metricsSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Error("Metrics server failed", zap.Error(err))
+			}
+		}()
+	}
+
 	// Wait for interrupt signal to gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -627,6 +780,14 @@ func main() {
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Fatal("Server forced to shutdown", zap.Error(err))
 	}
+	if metricsSrv != nil {
+		if err := metricsSrv.Shutdown(ctx); err != nil {
+			logger.Error("Metrics server forced to shutdown", zap.Error(err))
+		}
+	}
+
+	// Let queued webhook deliveries finish their retries
+	webhookDispatcher.Wait()
 
 	logger.Info("Server exited")
 }
@@ -649,8 +810,10 @@ func healthCheckHandler(ctx context.Context, pgClient *postgres.Client, redisCli
 			services["postgres"] = "up"
 		}
 
-		// Check Redis
-		if err := redisClient.Health(ctx); err != nil {
+		// Check Redis ("degraded" when the server runs without it)
+		if !redisClient.Available() {
+			services["redis"] = "degraded"
+		} else if err := redisClient.Health(ctx); err != nil {
 			services["redis"] = "down"
 		} else {
 			services["redis"] = "up"
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/andreypavlenko/jobber/internal/config"
+	"github.com/andreypavlenko/jobber/internal/platform/logger"
+	"github.com/andreypavlenko/jobber/internal/platform/postgres"
+)
+
+// migrationsPath is the directory containing the SQL migration files
+const migrationsPath = "./migrations"
+
+const migrateUsage = "usage: api migrate up | api migrate down [--steps N]"
+
+// runMigrateCommand handles the "migrate" subcommand:
+//
+//	api migrate up
+//	api migrate down --steps N
+func runMigrateCommand(ctx context.Context, cfg config.DatabaseConfig, log *logger.Logger, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	switch args[0] {
+	case "up":
+		return postgres.RunMigrations(ctx, cfg, log, migrationsPath)
+	case "down":
+		steps, err := parseDownSteps(args[1:])
+		if err != nil {
+			return err
+		}
+		return postgres.RunMigrationsDown(ctx, cfg, log, migrationsPath, steps)
+	default:
+		return fmt.Errorf("unknown migrate command %q, %s", args[0], migrateUsage)
+	}
+}
+
+// parseDownSteps parses the flags of "migrate down". Steps defaults to 1.
+func parseDownSteps(args []string) (int, error) {
+	fs := flag.NewFlagSet("migrate down", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	steps := fs.Int("steps", 1, "number of migrations to roll back")
+	if err := fs.Parse(args); err != nil {
+		return 0, fmt.Errorf("%w, %s", err, migrateUsage)
+	}
+	if fs.NArg() > 0 {
+		return 0, fmt.Errorf("unexpected arguments %v, %s", fs.Args(), migrateUsage)
+	}
+	if *steps < 1 {
+		return 0, fmt.Errorf("--steps must be at least 1, got %d", *steps)
+	}
+	return *steps, nil
+}
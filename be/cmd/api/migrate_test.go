@@ -0,0 +1,48 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/andreypavlenko/jobber/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDownSteps(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		expect int
+	}{
+		{name: "defaults to one step", args: nil, expect: 1},
+		{name: "double dash flag", args: []string{"--steps", "3"}, expect: 3},
+		{name: "equals syntax", args: []string{"-steps=2"}, expect: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps, err := parseDownSteps(tt.args)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, steps)
+		})
+	}
+
+	for _, args := range [][]string{
+		{"--steps", "0"},
+		{"--steps", "-1"},
+		{"--steps", "abc"},
+		{"--force"},
+		{"--steps", "1", "extra"},
+	} {
+		t.Run("rejects "+args[len(args)-1], func(t *testing.T) {
+			_, err := parseDownSteps(args)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRunMigrateCommand_InvalidArgs(t *testing.T) {
+	assert.Error(t, runMigrateCommand(t.Context(), config.DatabaseConfig{}, nil, nil))
+	assert.Error(t, runMigrateCommand(t.Context(), config.DatabaseConfig{}, nil, []string{"sideways"}))
+	assert.Error(t, runMigrateCommand(t.Context(), config.DatabaseConfig{}, nil, []string{"down", "--steps", "0"}))
+}
@@ -320,22 +320,26 @@ func main() {
 	fmt.Printf("created %d stage templates\n", len(stages))
 
 	// ── 4. companies ─────────────────────────────────────────────────────
-	type company struct{ id, name, location, notes string }
+	type company struct {
+		id, name, location, notes               string
+		industry, size, websiteURL, linkedInURL string
+		foundedYear                             int
+	}
 	companies := []company{
-		{newID(), "TechNova", "San Francisco, CA", "Series B startup, strong engineering culture"},
-		{newID(), "CloudScale Inc.", "Remote", "Cloud infrastructure company, competitive salary"},
-		{newID(), "DataPulse", "New York, NY", "Data analytics platform, fast-growing"},
-		{newID(), "GreenByte Solutions", "Austin, TX", "Sustainability-focused tech, good WLB"},
-		{newID(), "Quantum Labs", "Seattle, WA", "R&D heavy, cutting edge ML work"},
-		{newID(), "FinEdge", "Chicago, IL", "Fintech startup, pre-IPO"},
-		{newID(), "PixelCraft Studios", "Los Angeles, CA", "Creative tools for designers"},
-		{newID(), "InfraCore", "Denver, CO", "DevOps / platform engineering focus"},
+		{newID(), "TechNova", "San Francisco, CA", "Series B startup, strong engineering culture", "Artificial Intelligence", "startup", "https://technova.io", "https://linkedin.com/company/technova", 2019},
+		{newID(), "CloudScale Inc.", "Remote", "Cloud infrastructure company, competitive salary", "Cloud Computing", "large", "https://cloudscale.com", "https://linkedin.com/company/cloudscale", 2011},
+		{newID(), "DataPulse", "New York, NY", "Data analytics platform, fast-growing", "Data Analytics", "medium", "https://datapulse.io", "https://linkedin.com/company/datapulse", 2016},
+		{newID(), "GreenByte Solutions", "Austin, TX", "Sustainability-focused tech, good WLB", "Clean Technology", "small", "https://greenbyte.dev", "https://linkedin.com/company/greenbyte", 2018},
+		{newID(), "Quantum Labs", "Seattle, WA", "R&D heavy, cutting edge ML work", "Artificial Intelligence", "enterprise", "https://quantumlabs.ai", "https://linkedin.com/company/quantumlabs", 2008},
+		{newID(), "FinEdge", "Chicago, IL", "Fintech startup, pre-IPO", "Financial Services", "startup", "https://finedge.com", "https://linkedin.com/company/finedge", 2020},
+		{newID(), "PixelCraft Studios", "Los Angeles, CA", "Creative tools for designers", "Design Software", "small", "https://pixelcraft.studio", "https://linkedin.com/company/pixelcraft", 2015},
+		{newID(), "InfraCore", "Denver, CO", "DevOps / platform engineering focus", "Developer Tools", "medium", "https://infracore.dev", "https://linkedin.com/company/infracore", 2013},
 	}
 	for _, c := range companies {
 		_, err = tx.Exec(ctx,
-			`INSERT INTO companies (id, user_id, name, location, notes, created_at, updated_at)
-			 VALUES ($1, $2, $3, $4, $5, $6, $6)`,
-			c.id, userID, c.name, c.location, c.notes, daysAgo(randBetween(90, 110)),
+			`INSERT INTO companies (id, user_id, name, location, notes, industry, size, website_url, linkedin_url, founded_year, created_at, updated_at)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $11)`,
+			c.id, userID, c.name, c.location, c.notes, c.industry, c.size, c.websiteURL, c.linkedInURL, c.foundedYear, daysAgo(randBetween(90, 110)),
 		)
 		must(err, "create company "+c.name)
 	}
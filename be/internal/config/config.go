@@ -32,10 +32,12 @@ type Config struct {
 	Server         ServerConfig
 	Database       DatabaseConfig
 	Redis          RedisConfig
+	RateLimit      RateLimitConfig
 	JWT            JWTConfig
 	Log            LogConfig
 	S3             S3Config
 	GoogleCalendar GoogleCalendarConfig
+	GoogleOAuth    GoogleOAuthConfig
 	Anthropic      AnthropicConfig
 	Paddle         PaddleConfig
 	Sentry         SentryConfig
@@ -88,12 +90,22 @@ type GoogleCalendarConfig struct {
 	FrontendURL        string
 }
 
+// GoogleOAuthConfig holds the Google client used for "Sign in with Google"
+type GoogleOAuthConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string // must point to /api/v1/auth/google/callback
+}
+
 // ServerConfig holds server configuration
 type ServerConfig struct {
 	Port           string
 	Env            string
 	AllowedOrigins string
 	FrontendURL    string
+	// MetricsPort serves /metrics on a separate internal port; when empty,
+	// /metrics is served on the API port outside production only
+	MetricsPort string
 }
 
 // DatabaseConfig holds database configuration
@@ -115,6 +127,19 @@ type RedisConfig struct {
 	Port     string
 	Password string
 	DB       int
+	// Optional lets the server start without Redis; caching, rate limiting
+	// and idempotency are then skipped
+	Optional bool
+	// AnalyticsCacheTTL is how long analytics results stay cached per user
+	AnalyticsCacheTTL time.Duration
+}
+
+// RateLimitConfig holds per-client request limits, enforced in Redis
+type RateLimitConfig struct {
+	// AuthPerMinute caps login, register and refresh attempts per IP
+	AuthPerMinute int
+	// ReadPerMinute caps authenticated GET requests per user and route
+	ReadPerMinute int
 }
 
 // JWTConfig holds JWT configuration
@@ -148,6 +173,7 @@ func Load() (*Config, error) {
 			Env:            getEnv("SERVER_ENV", "development"),
 			AllowedOrigins: getEnv("ALLOWED_ORIGINS", "*"),
 			FrontendURL:    getEnv("FRONTEND_URL", ""),
+			MetricsPort:    getEnv("METRICS_PORT", ""),
 		},
 		Database: DatabaseConfig{
 			Host:            getEnv("DB_HOST", "localhost"),
@@ -161,10 +187,16 @@ func Load() (*Config, error) {
 			ConnMaxLifetime: getEnvAsDuration("DB_CONN_MAX_LIFETIME", 5*time.Minute),
 		},
 		Redis: RedisConfig{
-			Host:     getEnv("REDIS_HOST", "localhost"),
-			Port:     getEnv("REDIS_PORT", "6379"),
-			Password: getEnv("REDIS_PASSWORD", ""),
-			DB:       getEnvAsInt("REDIS_DB", 0),
+			Host:              getEnv("REDIS_HOST", "localhost"),
+			Port:              getEnv("REDIS_PORT", "6379"),
+			Password:          getEnv("REDIS_PASSWORD", ""),
+			DB:                getEnvAsInt("REDIS_DB", 0),
+			Optional:          getEnvAsBool("REDIS_OPTIONAL", false),
+			AnalyticsCacheTTL: getEnvAsDuration("ANALYTICS_CACHE_TTL", 5*time.Minute),
+		},
+		RateLimit: RateLimitConfig{
+			AuthPerMinute: getEnvAsInt("RATE_LIMIT_AUTH_PER_MINUTE", 10),
+			ReadPerMinute: getEnvAsInt("RATE_LIMIT_READ_PER_MINUTE", 100),
 		},
 		JWT: JWTConfig{
 			AccessSecret:   getEnv("JWT_ACCESS_SECRET", ""),
@@ -190,6 +222,11 @@ func Load() (*Config, error) {
 			TokenEncryptionKey: getEnv("GOOGLE_CALENDAR_TOKEN_ENCRYPTION_KEY", ""),
 			FrontendURL:        getEnv("GOOGLE_CALENDAR_FRONTEND_URL", ""),
 		},
+		GoogleOAuth: GoogleOAuthConfig{
+			ClientID:     getEnv("GOOGLE_OAUTH_CLIENT_ID", ""),
+			ClientSecret: getEnv("GOOGLE_OAUTH_CLIENT_SECRET", ""),
+			RedirectURL:  getEnv("GOOGLE_OAUTH_REDIRECT_URL", ""),
+		},
 		Anthropic: AnthropicConfig{
 			APIKey: getEnv("ANTHROPIC_API_KEY", ""),
 		},
@@ -36,16 +36,59 @@ func TestLoad(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "9090", cfg.Server.Port)
 		assert.Equal(t, "development", cfg.Server.Env)
+		assert.Empty(t, cfg.Server.MetricsPort)
 		assert.Equal(t, "db.example.com", cfg.Database.Host)
 		assert.Equal(t, "5433", cfg.Database.Port)
 		assert.Equal(t, 50, cfg.Database.MaxConns)
 		assert.Equal(t, "redis.example.com", cfg.Redis.Host)
 		assert.Equal(t, "6380", cfg.Redis.Port)
+		assert.False(t, cfg.Redis.Optional)
 		assert.Equal(t, "debug", cfg.Log.Level)
 		assert.Equal(t, 30*time.Minute, cfg.JWT.AccessExpiry)
 		assert.Equal(t, 336*time.Hour, cfg.JWT.RefreshExpiry)
 	})
 
+	t.Run("reads REDIS_OPTIONAL", func(t *testing.T) {
+		setMinimalEnv(t)
+		t.Setenv("REDIS_OPTIONAL", "true")
+
+		cfg, err := Load()
+
+		require.NoError(t, err)
+		assert.True(t, cfg.Redis.Optional)
+	})
+
+	t.Run("reads rate limits", func(t *testing.T) {
+		setMinimalEnv(t)
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		assert.Equal(t, 10, cfg.RateLimit.AuthPerMinute)
+		assert.Equal(t, 100, cfg.RateLimit.ReadPerMinute)
+
+		t.Setenv("RATE_LIMIT_AUTH_PER_MINUTE", "5")
+		t.Setenv("RATE_LIMIT_READ_PER_MINUTE", "250")
+
+		cfg, err = Load()
+		require.NoError(t, err)
+		assert.Equal(t, 5, cfg.RateLimit.AuthPerMinute)
+		assert.Equal(t, 250, cfg.RateLimit.ReadPerMinute)
+	})
+
+	t.Run("reads ANALYTICS_CACHE_TTL", func(t *testing.T) {
+		setMinimalEnv(t)
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Minute, cfg.Redis.AnalyticsCacheTTL)
+
+		t.Setenv("ANALYTICS_CACHE_TTL", "90s")
+
+		cfg, err = Load()
+		require.NoError(t, err)
+		assert.Equal(t, 90*time.Second, cfg.Redis.AnalyticsCacheTTL)
+	})
+
 	t.Run("fails when JWT_ACCESS_SECRET is missing", func(t *testing.T) {
 		t.Setenv("JWT_ACCESS_SECRET", "")
 		t.Setenv("JWT_REFRESH_SECRET", "some-refresh-secret")
@@ -22,6 +22,7 @@ const (
 type Claims struct {
 	UserID string    `json:"user_id"`
 	Type   TokenType `json:"type"`
+	Locale string    `json:"locale,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -44,11 +45,12 @@ func NewJWTManager(accessSecret, refreshSecret string, accessExpiry, refreshExpi
 }
 
 // GenerateAccessToken generates a new access token
-func (m *JWTManager) GenerateAccessToken(userID string) (string, error) {
+func (m *JWTManager) GenerateAccessToken(userID, locale string) (string, error) {
 	now := time.Now()
 	claims := &Claims{
 		UserID: userID,
 		Type:   AccessToken,
+		Locale: locale,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(m.accessExpiry)),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -62,11 +64,12 @@ func (m *JWTManager) GenerateAccessToken(userID string) (string, error) {
 }
 
 // GenerateRefreshToken generates a new refresh token
-func (m *JWTManager) GenerateRefreshToken(userID string) (string, error) {
+func (m *JWTManager) GenerateRefreshToken(userID, locale string) (string, error) {
 	now := time.Now()
 	claims := &Claims{
 		UserID: userID,
 		Type:   RefreshToken,
+		Locale: locale,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(m.refreshExpiry)),
 			IssuedAt:  jwt.NewNumericDate(now),
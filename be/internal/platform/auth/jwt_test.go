@@ -14,7 +14,7 @@ func TestJWTManager_GenerateAccessToken(t *testing.T) {
 	t.Run("generates valid access token", func(t *testing.T) {
 		userID := "user-123"
 
-		token, err := jwtManager.GenerateAccessToken(userID)
+		token, err := jwtManager.GenerateAccessToken(userID, "en")
 
 		require.NoError(t, err)
 		assert.NotEmpty(t, token)
@@ -23,7 +23,7 @@ func TestJWTManager_GenerateAccessToken(t *testing.T) {
 	t.Run("token contains correct user ID", func(t *testing.T) {
 		userID := "user-456"
 
-		token, err := jwtManager.GenerateAccessToken(userID)
+		token, err := jwtManager.GenerateAccessToken(userID, "en")
 		require.NoError(t, err)
 
 		claims, err := jwtManager.ValidateAccessToken(token)
@@ -40,7 +40,7 @@ func TestJWTManager_GenerateRefreshToken(t *testing.T) {
 	t.Run("generates valid refresh token", func(t *testing.T) {
 		userID := "user-123"
 
-		token, err := jwtManager.GenerateRefreshToken(userID)
+		token, err := jwtManager.GenerateRefreshToken(userID, "en")
 
 		require.NoError(t, err)
 		assert.NotEmpty(t, token)
@@ -49,7 +49,7 @@ func TestJWTManager_GenerateRefreshToken(t *testing.T) {
 	t.Run("token contains correct user ID", func(t *testing.T) {
 		userID := "user-789"
 
-		token, err := jwtManager.GenerateRefreshToken(userID)
+		token, err := jwtManager.GenerateRefreshToken(userID, "en")
 		require.NoError(t, err)
 
 		claims, err := jwtManager.ValidateRefreshToken(token)
@@ -65,7 +65,7 @@ func TestJWTManager_ValidateAccessToken(t *testing.T) {
 
 	t.Run("validates valid access token", func(t *testing.T) {
 		userID := "user-123"
-		token, _ := jwtManager.GenerateAccessToken(userID)
+		token, _ := jwtManager.GenerateAccessToken(userID, "en")
 
 		claims, err := jwtManager.ValidateAccessToken(token)
 
@@ -81,7 +81,7 @@ func TestJWTManager_ValidateAccessToken(t *testing.T) {
 
 	t.Run("rejects refresh token as access token", func(t *testing.T) {
 		userID := "user-123"
-		refreshToken, _ := jwtManager.GenerateRefreshToken(userID)
+		refreshToken, _ := jwtManager.GenerateRefreshToken(userID, "en")
 
 		_, err := jwtManager.ValidateAccessToken(refreshToken)
 
@@ -92,7 +92,7 @@ func TestJWTManager_ValidateAccessToken(t *testing.T) {
 	t.Run("rejects expired token", func(t *testing.T) {
 		// Create a JWT manager with very short expiry
 		shortJwt := NewJWTManager("access-secret-32-characters!!", "refresh-secret-32-characters!", -1*time.Second, 7*24*time.Hour)
-		token, _ := shortJwt.GenerateAccessToken("user-123")
+		token, _ := shortJwt.GenerateAccessToken("user-123", "en")
 
 		_, err := jwtManager.ValidateAccessToken(token)
 
@@ -105,7 +105,7 @@ func TestJWTManager_ValidateRefreshToken(t *testing.T) {
 
 	t.Run("validates valid refresh token", func(t *testing.T) {
 		userID := "user-123"
-		token, _ := jwtManager.GenerateRefreshToken(userID)
+		token, _ := jwtManager.GenerateRefreshToken(userID, "en")
 
 		claims, err := jwtManager.ValidateRefreshToken(token)
 
@@ -121,7 +121,7 @@ func TestJWTManager_ValidateRefreshToken(t *testing.T) {
 
 	t.Run("rejects access token as refresh token", func(t *testing.T) {
 		userID := "user-123"
-		accessToken, _ := jwtManager.GenerateAccessToken(userID)
+		accessToken, _ := jwtManager.GenerateAccessToken(userID, "en")
 
 		_, err := jwtManager.ValidateRefreshToken(accessToken)
 
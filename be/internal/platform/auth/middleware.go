@@ -4,12 +4,26 @@ import (
 	"strings"
 
 	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
 	"github.com/gin-gonic/gin"
 )
 
 // AuthMiddleware validates JWT access tokens.
 // It checks the Authorization header first, then falls back to the httpOnly access_token cookie.
 func AuthMiddleware(jwtManager *JWTManager) gin.HandlerFunc {
+	authenticate := Authenticate(jwtManager)
+	return func(c *gin.Context) {
+		authenticate(c)
+		if c.IsAborted() {
+			return
+		}
+		c.Next()
+	}
+}
+
+// Authenticate validates the access token like AuthMiddleware but does not call
+// c.Next, so it can be combined with other middleware in httpPlatform.Chain.
+func Authenticate(jwtManager *JWTManager) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var tokenString string
 
@@ -41,9 +55,11 @@ func AuthMiddleware(jwtManager *JWTManager) gin.HandlerFunc {
 			return
 		}
 
-		// Set user ID in context
+		// Set user ID and locale in context
 		c.Set("user_id", claims.UserID)
-		c.Next()
+		if claims.Locale != "" {
+			c.Set("locale", claims.Locale)
+		}
 	}
 }
 
@@ -56,6 +72,28 @@ func GetUserID(c *gin.Context) (string, bool) {
 	return userID.(string), true
 }
 
+// GetLocale returns the user's locale for translating error messages.
+// It uses the locale from the access token, falling back to the Accept-Language
+// header for unauthenticated requests and to English otherwise.
+func GetLocale(c *gin.Context) string {
+	if locale, exists := c.Get("locale"); exists {
+		if s, ok := locale.(string); ok && s != "" {
+			return s
+		}
+	}
+	if c.Request == nil {
+		return i18n.DefaultLocale
+	}
+	if header := c.GetHeader("Accept-Language"); header != "" {
+		tag, _, _ := strings.Cut(header, ",")
+		tag, _, _ = strings.Cut(tag, ";")
+		if tag = strings.TrimSpace(tag); tag != "" && tag != "*" {
+			return tag
+		}
+	}
+	return i18n.DefaultLocale
+}
+
 // MustGetUserID extracts user ID from context and responds with 401 if not found.
 // Returns the user ID and true if successful, or empty string and false if unauthorized.
 func MustGetUserID(c *gin.Context) (string, bool) {
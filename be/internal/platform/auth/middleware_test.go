@@ -20,7 +20,7 @@ func TestAuthMiddleware(t *testing.T) {
 
 	t.Run("allows request with valid token", func(t *testing.T) {
 		userID := "user-123"
-		token, _ := jwtManager.GenerateAccessToken(userID)
+		token, _ := jwtManager.GenerateAccessToken(userID, "en")
 
 		router := setupTestRouter()
 		router.GET("/protected", AuthMiddleware(jwtManager), func(c *gin.Context) {
@@ -36,6 +36,27 @@ func TestAuthMiddleware(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("returns after the rest of the chain has run", func(t *testing.T) {
+		token, _ := jwtManager.GenerateAccessToken("user-123", "en")
+		middleware := AuthMiddleware(jwtManager)
+		var order []string
+
+		router := setupTestRouter()
+		router.GET("/protected",
+			func(c *gin.Context) {
+				middleware(c)
+				order = append(order, "after auth")
+			},
+			func(c *gin.Context) { order = append(order, "handler") },
+		)
+
+		req, _ := http.NewRequest(http.MethodGet, "/protected", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, []string{"handler", "after auth"}, order)
+	})
+
 	t.Run("rejects request without authorization header", func(t *testing.T) {
 		router := setupTestRouter()
 		router.GET("/protected", AuthMiddleware(jwtManager), func(c *gin.Context) {
@@ -94,7 +115,7 @@ func TestAuthMiddleware(t *testing.T) {
 	t.Run("rejects request with expired token", func(t *testing.T) {
 		// Create a JWT manager with expired tokens
 		expiredJwt := NewJWTManager("access-secret-32-characters!!", "refresh-secret-32-characters!", -1*time.Second, 7*24*time.Hour)
-		token, _ := expiredJwt.GenerateAccessToken("user-123")
+		token, _ := expiredJwt.GenerateAccessToken("user-123", "en")
 
 		router := setupTestRouter()
 		router.GET("/protected", AuthMiddleware(jwtManager), func(c *gin.Context) {
@@ -110,6 +131,45 @@ func TestAuthMiddleware(t *testing.T) {
 	})
 }
 
+func TestAuthenticate(t *testing.T) {
+	jwtManager := NewJWTManager("access-secret-32-characters!!", "refresh-secret-32-characters!", 15*time.Minute, 7*24*time.Hour)
+
+	t.Run("sets the user without running the rest of the chain", func(t *testing.T) {
+		token, _ := jwtManager.GenerateAccessToken("user-123", "en")
+		authenticate := Authenticate(jwtManager)
+		var order []string
+
+		router := setupTestRouter()
+		router.GET("/protected",
+			func(c *gin.Context) {
+				authenticate(c)
+				uid, _ := GetUserID(c)
+				order = append(order, "authenticated "+uid)
+			},
+			func(c *gin.Context) { order = append(order, "handler") },
+		)
+
+		req, _ := http.NewRequest(http.MethodGet, "/protected", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, []string{"authenticated user-123", "handler"}, order)
+	})
+
+	t.Run("aborts without a token", func(t *testing.T) {
+		var called bool
+		router := setupTestRouter()
+		router.GET("/protected", Authenticate(jwtManager), func(c *gin.Context) { called = true })
+
+		req, _ := http.NewRequest(http.MethodGet, "/protected", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.False(t, called)
+	})
+}
+
 func TestGetUserID(t *testing.T) {
 	t.Run("returns user ID when set", func(t *testing.T) {
 		gin.SetMode(gin.TestMode)
@@ -160,3 +220,43 @@ func TestMustGetUserID(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, w.Code)
 	})
 }
+
+func TestGetLocale(t *testing.T) {
+	jwtManager := NewJWTManager("access-secret-32-characters!!", "refresh-secret-32-characters!", 15*time.Minute, 7*24*time.Hour)
+
+	tests := []struct {
+		name           string
+		tokenLocale    string
+		acceptLanguage string
+		want           string
+	}{
+		{"uses locale from token", "es", "fr-FR,fr;q=0.9", "es"},
+		{"falls back to accept-language", "", "es-MX,es;q=0.9", "es-MX"},
+		{"defaults to english", "", "", "en"},
+		{"ignores wildcard accept-language", "", "*", "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, _ := jwtManager.GenerateAccessToken("user-123", tt.tokenLocale)
+
+			var got string
+			router := setupTestRouter()
+			router.GET("/protected", AuthMiddleware(jwtManager), func(c *gin.Context) {
+				got = GetLocale(c)
+				c.Status(http.StatusOK)
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, "/protected", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
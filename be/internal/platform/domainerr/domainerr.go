@@ -0,0 +1,36 @@
+// Package domainerr provides the error type modules use to carry API error
+// codes from the service layer up to their handlers.
+package domainerr
+
+import "errors"
+
+// Error is a domain error that carries its API error code. Each module
+// instantiates it with its own code type, e.g.
+//
+//	type DomainError = domainerr.Error[ErrorCode]
+type Error[C ~string] struct {
+	Code    C
+	Message string
+}
+
+// Error implements the error interface
+func (e *Error[C]) Error() string {
+	return e.Message
+}
+
+// Is reports whether target is an Error with the same code,
+// so errors.Is matches by code rather than by pointer identity
+func (e *Error[C]) Is(target error) bool {
+	t, ok := target.(*Error[C])
+	return ok && t.Code == e.Code
+}
+
+// CodeOf returns the code of the first Error[C] in err's chain,
+// or fallback when there is none
+func CodeOf[C ~string](err error, fallback C) C {
+	var domainErr *Error[C]
+	if errors.As(err, &domainErr) {
+		return domainErr.Code
+	}
+	return fallback
+}
@@ -0,0 +1,40 @@
+package domainerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCode string
+
+type otherCode string
+
+func TestError_Is(t *testing.T) {
+	notFound := &Error[testCode]{Code: "NOT_FOUND", Message: "not found"}
+
+	t.Run("matches by code", func(t *testing.T) {
+		err := &Error[testCode]{Code: "NOT_FOUND", Message: "item 42 not found"}
+		assert.ErrorIs(t, err, notFound)
+		assert.ErrorIs(t, fmt.Errorf("wrapped: %w", err), notFound)
+	})
+
+	t.Run("different code", func(t *testing.T) {
+		err := &Error[testCode]{Code: "CONFLICT", Message: "conflict"}
+		assert.NotErrorIs(t, err, notFound)
+	})
+
+	t.Run("different code type", func(t *testing.T) {
+		err := &Error[otherCode]{Code: "NOT_FOUND", Message: "not found"}
+		assert.NotErrorIs(t, err, notFound)
+	})
+}
+
+func TestCodeOf(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", &Error[testCode]{Code: "NOT_FOUND", Message: "not found"})
+	assert.Equal(t, testCode("NOT_FOUND"), CodeOf(err, testCode("INTERNAL_ERROR")))
+	assert.Equal(t, testCode("INTERNAL_ERROR"), CodeOf(errors.New("boom"), testCode("INTERNAL_ERROR")))
+	assert.Equal(t, testCode("INTERNAL_ERROR"), CodeOf(nil, testCode("INTERNAL_ERROR")))
+}
@@ -0,0 +1,11 @@
+package email
+
+import "regexp"
+
+// addressRegex is compiled once at package level to avoid recompilation on every call.
+var addressRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+// IsValidAddress reports whether address looks like a deliverable email address
+func IsValidAddress(address string) bool {
+	return addressRegex.MatchString(address)
+}
@@ -0,0 +1,29 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		valid   bool
+	}{
+		{"jane.doe+jobs@example.co.uk", true},
+		{"a_b%c@sub.example.com", true},
+		{"", false},
+		{"jane", false},
+		{"jane@example", false},
+		{"@example.com", false},
+		{"jane doe@example.com", false},
+		{"jane@example.c", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			assert.Equal(t, tt.valid, IsValidAddress(tt.address))
+		})
+	}
+}
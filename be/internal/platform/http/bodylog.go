@@ -0,0 +1,93 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/andreypavlenko/jobber/internal/platform/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// maxLoggedBodySize caps how much of a request body is buffered for logging
+const maxLoggedBodySize = 64 << 10
+
+const redactedValue = "[REDACTED]"
+
+// DefaultRedactFields are the JSON keys masked by BodyLogMiddleware unless overridden
+var DefaultRedactFields = []string{"password", "refresh_token", "access_token", "credit_card"}
+
+// BodyLogMiddleware logs JSON request bodies at debug level with the values of
+// redactFields (matched case-insensitively, at any depth) replaced by "[REDACTED]".
+// It is meant for development only. The body is restored for the next handlers;
+// bodies that are not JSON or exceed maxLoggedBodySize are not logged and never
+// fail the request.
+func BodyLogMiddleware(log *logger.Logger, redactFields []string) gin.HandlerFunc {
+	redact := make(map[string]struct{}, len(redactFields))
+	for _, f := range redactFields {
+		redact[strings.ToLower(f)] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || !strings.HasPrefix(c.ContentType(), "application/json") {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxLoggedBodySize+1))
+		// Put back what was read followed by anything left unread
+		c.Request.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), c.Request.Body), Closer: c.Request.Body}
+		if err != nil || len(body) == 0 || len(body) > maxLoggedBodySize {
+			c.Next()
+			return
+		}
+
+		requestID, _ := c.Get("request_id")
+		requestIDStr, _ := requestID.(string)
+		entry := log.WithRequestID(requestIDStr).WithAction(c.Request.Method + " " + c.Request.URL.Path)
+
+		var payload interface{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			entry.Debug("request body is not valid JSON", zap.Int("size", len(body)))
+			c.Next()
+			return
+		}
+
+		redacted, err := json.Marshal(redactJSON(payload, redact))
+		if err == nil {
+			entry.Debug("request body", zap.String("body", string(redacted)))
+		}
+
+		c.Next()
+	}
+}
+
+// readCloser pairs a replacement body reader with the original body's Close
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// redactJSON walks a decoded JSON value and masks the values of redacted keys
+func redactJSON(v interface{}, redact map[string]struct{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, inner := range val {
+			if _, ok := redact[strings.ToLower(k)]; ok {
+				val[k] = redactedValue
+				continue
+			}
+			val[k] = redactJSON(inner, redact)
+		}
+		return val
+	case []interface{}:
+		for i, inner := range val {
+			val[i] = redactJSON(inner, redact)
+		}
+		return val
+	default:
+		return v
+	}
+}
@@ -0,0 +1,108 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/internal/platform/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// setupBodyLogRouter returns a router whose handler echoes the body it received,
+// along with the observed log entries
+func setupBodyLogRouter(redactFields []string) (*gin.Engine, *observer.ObservedLogs) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	router := gin.New()
+	router.Use(BodyLogMiddleware(&logger.Logger{Logger: zap.New(core)}, redactFields))
+	router.POST("/echo", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusOK, string(body))
+	})
+	return router, logs
+}
+
+func postJSON(router *gin.Engine, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestBodyLogMiddleware(t *testing.T) {
+	t.Run("redacts sensitive fields", func(t *testing.T) {
+		router, logs := setupBodyLogRouter(DefaultRedactFields)
+
+		postJSON(router, `{"email":"a@example.com","password":"secret"}`)
+
+		entries := logs.FilterMessage("request body").All()
+		require.Len(t, entries, 1)
+		logged := entries[0].ContextMap()["body"].(string)
+		assert.Contains(t, logged, `"password":"[REDACTED]"`)
+		assert.Contains(t, logged, `"email":"a@example.com"`)
+		assert.NotContains(t, logged, "secret")
+	})
+
+	t.Run("redacts nested fields case-insensitively", func(t *testing.T) {
+		router, logs := setupBodyLogRouter(DefaultRedactFields)
+
+		postJSON(router, `{"tokens":[{"Refresh_Token":"r1","access_token":"a1"}]}`)
+
+		entries := logs.FilterMessage("request body").All()
+		require.Len(t, entries, 1)
+		logged := entries[0].ContextMap()["body"].(string)
+		assert.NotContains(t, logged, "r1")
+		assert.NotContains(t, logged, "a1")
+	})
+
+	t.Run("passes the original body to the handler", func(t *testing.T) {
+		router, _ := setupBodyLogRouter(DefaultRedactFields)
+		body := `{"password":"secret"}`
+
+		w := postJSON(router, body)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, body, w.Body.String())
+	})
+
+	t.Run("does not fail on invalid JSON", func(t *testing.T) {
+		router, logs := setupBodyLogRouter(DefaultRedactFields)
+
+		w := postJSON(router, `{"password":`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"password":`, w.Body.String())
+		assert.Equal(t, 0, logs.FilterMessage("request body").Len())
+		assert.Equal(t, 1, logs.FilterMessage("request body is not valid JSON").Len())
+	})
+
+	t.Run("skips non-JSON content types", func(t *testing.T) {
+		router, logs := setupBodyLogRouter(DefaultRedactFields)
+		req := httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader("password=secret"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, "password=secret", w.Body.String())
+		assert.Equal(t, 0, logs.Len())
+	})
+
+	t.Run("does not log oversized bodies", func(t *testing.T) {
+		router, logs := setupBodyLogRouter(DefaultRedactFields)
+		body := `{"notes":"` + strings.Repeat("a", maxLoggedBodySize) + `"}`
+
+		w := postJSON(router, body)
+
+		assert.Equal(t, body, w.Body.String())
+		assert.Equal(t, 0, logs.Len())
+	})
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ComputeETag derives a strong ETag from the JSON encoding of a response body.
+// Hashing the payload rather than a timestamp means nested data such as counts,
+// contacts or query-dependent ordering also changes the tag.
+// It returns "" when v cannot be encoded.
+func ComputeETag(v any) string {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// SetETag sets the ETag header and asks clients to revalidate on every request.
+// An empty tag is ignored.
+func SetETag(c *gin.Context, tag string) {
+	if tag == "" {
+		return
+	}
+	c.Header("ETag", tag)
+	c.Header("Cache-Control", "private, max-age=0")
+}
+
+// CheckIfNoneMatch reports whether the request's If-None-Match header matches tag.
+// On a match it responds 304 Not Modified with no body; the caller must then return.
+func CheckIfNoneMatch(c *gin.Context, tag string) bool {
+	header := c.GetHeader("If-None-Match")
+	if header == "" || tag == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == tag {
+			c.Status(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeETag(t *testing.T) {
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	body := gin.H{"id": "1", "updated_at": updatedAt, "count": 2}
+
+	tag := ComputeETag(body)
+
+	assert.Equal(t, tag, ComputeETag(gin.H{"id": "1", "updated_at": updatedAt, "count": 2}), "same body yields same tag")
+	assert.NotEqual(t, tag, ComputeETag(gin.H{"id": "1", "updated_at": updatedAt, "count": 3}), "nested data changes the tag")
+	assert.Len(t, tag, 66, "quoted hex sha256")
+	assert.Equal(t, byte('"'), tag[0])
+	assert.Equal(t, byte('"'), tag[len(tag)-1])
+
+	assert.Empty(t, ComputeETag(make(chan int)), "unencodable body has no tag")
+}
+
+func TestETagHandling_EmptyTag(t *testing.T) {
+	router := gin.New()
+	router.GET("/resource", func(c *gin.Context) {
+		SetETag(c, "")
+		if CheckIfNoneMatch(c, "") {
+			return
+		}
+		RespondWithData(c, http.StatusOK, gin.H{"id": "1"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/resource", nil)
+	req.Header.Set("If-None-Match", "*")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("ETag"))
+}
+
+func TestETagHandling(t *testing.T) {
+	tag := ComputeETag(gin.H{"id": "1"})
+
+	newRouter := func() *gin.Engine {
+		router := gin.New()
+		router.GET("/resource", func(c *gin.Context) {
+			SetETag(c, tag)
+			if CheckIfNoneMatch(c, tag) {
+				return
+			}
+			RespondWithData(c, http.StatusOK, gin.H{"id": "1"})
+		})
+		return router
+	}
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		wantStatus  int
+	}{
+		{name: "no header", ifNoneMatch: "", wantStatus: http.StatusOK},
+		{name: "matching tag", ifNoneMatch: tag, wantStatus: http.StatusNotModified},
+		{name: "stale tag", ifNoneMatch: `"stale"`, wantStatus: http.StatusOK},
+		{name: "tag in list", ifNoneMatch: `"stale", ` + tag, wantStatus: http.StatusNotModified},
+		{name: "weak tag", ifNoneMatch: "W/" + tag, wantStatus: http.StatusNotModified},
+		{name: "wildcard", ifNoneMatch: "*", wantStatus: http.StatusNotModified},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/resource", nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			w := httptest.NewRecorder()
+			newRouter().ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tag, w.Header().Get("ETag"))
+			assert.Equal(t, "private, max-age=0", w.Header().Get("Cache-Control"))
+			if tt.wantStatus == http.StatusNotModified {
+				assert.Empty(t, w.Body.String())
+			} else {
+				assert.Contains(t, w.Body.String(), `"id":"1"`)
+			}
+		})
+	}
+}
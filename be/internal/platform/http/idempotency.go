@@ -0,0 +1,152 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying the client-generated key
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotencyReplayedHeader is set on responses served from the cache
+	IdempotencyReplayedHeader = "Idempotent-Replayed"
+	// DefaultIdempotencyTTL is how long a cached response is replayed
+	DefaultIdempotencyTTL = 24 * time.Hour
+
+	maxIdempotencyKeyLength = 255
+	idempotencyLockTTL      = 30 * time.Second
+	// idempotencyWriteTimeout bounds the Redis writes made after the handler returns
+	idempotencyWriteTimeout = 2 * time.Second
+)
+
+// cachedResponse is the Redis representation of a replayable response
+type cachedResponse struct {
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// bodyCaptureWriter tees the response body so it can be cached after the handler runs
+type bodyCaptureWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *bodyCaptureWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyCaptureWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// IdempotencyMiddleware replays the cached response for requests that repeat an Idempotency-Key.
+// Keys are scoped per user as idempotency:{sha256(userID+key)}; only 2xx responses are cached,
+// so a retry after a failure is processed again. Requests without the header pass through.
+// A concurrent request with the same key receives 409 while the first one is in flight.
+// Redis errors fail open, and a nil rdb disables replay entirely.
+// Place this AFTER AuthMiddleware in the middleware chain.
+func IdempotencyMiddleware(rdb *redis.Client, ttl time.Duration, logger *zap.Logger) gin.HandlerFunc {
+	if rdb == nil {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	return func(c *gin.Context) {
+		idempotencyKey := c.GetHeader(IdempotencyKeyHeader)
+		if idempotencyKey == "" {
+			c.Next()
+			return
+		}
+		if len(idempotencyKey) > maxIdempotencyKeyLength {
+			RespondWithError(c, http.StatusBadRequest, "INVALID_IDEMPOTENCY_KEY", "Idempotency-Key must be at most 255 characters")
+			c.Abort()
+			return
+		}
+
+		userID := c.GetString("user_id")
+		sum := sha256.Sum256([]byte(userID + idempotencyKey))
+		key := "idempotency:" + hex.EncodeToString(sum[:])
+		lockKey := key + ":lock"
+
+		ctx := c.Request.Context()
+
+		raw, err := rdb.Get(ctx, key).Bytes()
+		switch {
+		case err == nil:
+			var cached cachedResponse
+			if err := json.Unmarshal(raw, &cached); err == nil {
+				c.Header(IdempotencyReplayedHeader, "true")
+				c.Data(cached.Status, cached.ContentType, cached.Body)
+				c.Abort()
+				return
+			}
+			logger.Warn("idempotency: discarding malformed cache entry", zap.String("key", key))
+		case !errors.Is(err, redis.Nil):
+			logger.Warn("idempotency fail-open: redis error", zap.String("key", key), zap.Error(err))
+			c.Next()
+			return
+		}
+
+		acquired, err := rdb.SetNX(ctx, lockKey, 1, idempotencyLockTTL).Result()
+		if err != nil {
+			logger.Warn("idempotency fail-open: redis error", zap.String("key", lockKey), zap.Error(err))
+			c.Next()
+			return
+		}
+		if !acquired {
+			RespondWithError(c, http.StatusConflict, "IDEMPOTENCY_KEY_IN_USE", "A request with this Idempotency-Key is already being processed")
+			c.Abort()
+			return
+		}
+		defer func() {
+			unlockCtx, cancel := afterHandlerContext(ctx)
+			defer cancel()
+			rdb.Del(unlockCtx, lockKey)
+		}()
+
+		writer := &bodyCaptureWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+
+		c.Next()
+
+		status := writer.Status()
+		if status < http.StatusOK || status >= http.StatusMultipleChoices {
+			return
+		}
+
+		payload, err := json.Marshal(cachedResponse{
+			Status:      status,
+			ContentType: writer.Header().Get("Content-Type"),
+			Body:        writer.body.Bytes(),
+		})
+		if err != nil {
+			return
+		}
+		setCtx, cancel := afterHandlerContext(ctx)
+		defer cancel()
+		if err := rdb.Set(setCtx, key, payload, ttl).Err(); err != nil {
+			logger.Warn("idempotency: failed to cache response", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+// afterHandlerContext returns a context for the writes made once the handler has
+// run. It outlives the request, so a client that disconnects after a successful
+// write still gets its response cached and the lock released.
+func afterHandlerContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), idempotencyWriteTimeout)
+}
@@ -0,0 +1,179 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupIdempotencyRouter(rdb *redis.Client, userID string, calls *int) *gin.Engine {
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	})
+	router.Use(IdempotencyMiddleware(rdb, DefaultIdempotencyTTL, zap.NewNop()))
+	router.POST("/applications", func(c *gin.Context) {
+		*calls++
+		c.JSON(http.StatusCreated, gin.H{"id": "app-1", "call": *calls})
+	})
+	return router
+}
+
+func postWithIdempotencyKey(router *gin.Engine, key string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/applications", strings.NewReader(`{}`))
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("cache miss executes handler and stores response", func(t *testing.T) {
+		mr, rdb := setupRateLimitTest(t)
+		calls := 0
+		router := setupIdempotencyRouter(rdb, "user-1", &calls)
+
+		w := postWithIdempotencyKey(router, "key-1")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 1, calls)
+		assert.Empty(t, w.Header().Get(IdempotencyReplayedHeader))
+
+		keys := mr.Keys()
+		require.Len(t, keys, 1)
+		assert.True(t, strings.HasPrefix(keys[0], "idempotency:"))
+		assert.Equal(t, DefaultIdempotencyTTL, mr.TTL(keys[0]))
+	})
+
+	t.Run("cache hit replays response without executing handler", func(t *testing.T) {
+		_, rdb := setupRateLimitTest(t)
+		calls := 0
+		router := setupIdempotencyRouter(rdb, "user-1", &calls)
+
+		first := postWithIdempotencyKey(router, "key-1")
+		second := postWithIdempotencyKey(router, "key-1")
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, http.StatusCreated, second.Code)
+		assert.Equal(t, first.Body.String(), second.Body.String())
+		assert.Equal(t, "true", second.Header().Get(IdempotencyReplayedHeader))
+		assert.Contains(t, second.Header().Get("Content-Type"), "application/json")
+	})
+
+	t.Run("expired cache executes handler again", func(t *testing.T) {
+		mr, rdb := setupRateLimitTest(t)
+		calls := 0
+		router := setupIdempotencyRouter(rdb, "user-1", &calls)
+
+		postWithIdempotencyKey(router, "key-1")
+		mr.FastForward(DefaultIdempotencyTTL + time.Second)
+		w := postWithIdempotencyKey(router, "key-1")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 2, calls)
+		assert.Empty(t, w.Header().Get(IdempotencyReplayedHeader))
+	})
+
+	t.Run("keys are scoped per user", func(t *testing.T) {
+		_, rdb := setupRateLimitTest(t)
+		calls := 0
+		postWithIdempotencyKey(setupIdempotencyRouter(rdb, "user-1", &calls), "shared-key")
+		postWithIdempotencyKey(setupIdempotencyRouter(rdb, "user-2", &calls), "shared-key")
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("requests without key are not cached", func(t *testing.T) {
+		mr, rdb := setupRateLimitTest(t)
+		calls := 0
+		router := setupIdempotencyRouter(rdb, "user-1", &calls)
+
+		postWithIdempotencyKey(router, "")
+		postWithIdempotencyKey(router, "")
+
+		assert.Equal(t, 2, calls)
+		assert.Empty(t, mr.Keys())
+	})
+
+	t.Run("error responses are not cached", func(t *testing.T) {
+		mr, rdb := setupRateLimitTest(t)
+		router := gin.New()
+		router.Use(IdempotencyMiddleware(rdb, DefaultIdempotencyTTL, zap.NewNop()))
+		router.POST("/applications", func(c *gin.Context) {
+			c.JSON(http.StatusBadRequest, gin.H{"code": "VALIDATION_ERROR"})
+		})
+
+		w := postWithIdempotencyKey(router, "key-1")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Empty(t, mr.Keys())
+	})
+
+	t.Run("caches the response and releases the lock after the client goes away", func(t *testing.T) {
+		mr, rdb := setupRateLimitTest(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		router := gin.New()
+		router.Use(IdempotencyMiddleware(rdb, DefaultIdempotencyTTL, zap.NewNop()))
+		router.POST("/applications", func(c *gin.Context) {
+			c.JSON(http.StatusCreated, gin.H{"id": "app-1"})
+			cancel()
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/applications", strings.NewReader(`{}`)).WithContext(ctx)
+		req.Header.Set(IdempotencyKeyHeader, "key-1")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		keys := mr.Keys()
+		require.Len(t, keys, 1)
+		assert.False(t, strings.HasSuffix(keys[0], ":lock"))
+		assert.Equal(t, DefaultIdempotencyTTL, mr.TTL(keys[0]))
+	})
+
+	t.Run("rejects overly long key", func(t *testing.T) {
+		_, rdb := setupRateLimitTest(t)
+		calls := 0
+		router := setupIdempotencyRouter(rdb, "user-1", &calls)
+
+		w := postWithIdempotencyKey(router, strings.Repeat("k", 256))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("fails open when redis is unavailable", func(t *testing.T) {
+		mr, rdb := setupRateLimitTest(t)
+		mr.Close()
+		calls := 0
+		router := setupIdempotencyRouter(rdb, "user-1", &calls)
+
+		w := postWithIdempotencyKey(router, "key-1")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("passes through when redis is disabled", func(t *testing.T) {
+		calls := 0
+		router := setupIdempotencyRouter(nil, "user-1", &calls)
+
+		postWithIdempotencyKey(router, "key-1")
+		w := postWithIdempotencyKey(router, "key-1")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 2, calls)
+	})
+}
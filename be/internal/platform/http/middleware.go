@@ -20,6 +20,21 @@ func isValidRequestID(id string) bool {
 	return len(id) <= maxRequestIDLength && validRequestIDRegex.MatchString(id)
 }
 
+// Chain combines middleware into one handler that runs them in order and
+// stops at the first one that aborts. Only the last handler may call c.Next,
+// which runs the rest of the route's chain as usual; the ones before it must not,
+// or the route would run before the handlers that follow them.
+func Chain(handlers ...gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, handler := range handlers {
+			handler(c)
+			if c.IsAborted() {
+				return
+			}
+		}
+	}
+}
+
 // RequestIDMiddleware adds a unique request ID to each request.
 // Client-supplied IDs are validated; invalid values are replaced with a new UUID.
 func RequestIDMiddleware() gin.HandlerFunc {
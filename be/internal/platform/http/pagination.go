@@ -1,8 +1,11 @@
 package http
 
 import (
+	"errors"
+	"net/http"
 	"strconv"
 
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	"github.com/gin-gonic/gin"
 )
 
@@ -16,6 +19,9 @@ const (
 type PaginationParams struct {
 	Limit  int
 	Offset int
+	// Cursor is set when the cursor query parameter is present, even empty,
+	// and switches the list to keyset pagination; Offset is then ignored
+	Cursor *keyset.Cursor
 }
 
 // PaginationMeta represents pagination metadata in responses
@@ -23,6 +29,9 @@ type PaginationMeta struct {
 	Limit  int `json:"limit"`
 	Offset int `json:"offset"`
 	Total  int `json:"total"`
+	// Set only for cursor pagination
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    *bool  `json:"has_more,omitempty"`
 }
 
 // PaginatedResponse represents a paginated response
@@ -58,10 +67,22 @@ func ParsePaginationParams(c *gin.Context) (*PaginationParams, error) {
 		offset = parsedOffset
 	}
 
-	return &PaginationParams{
+	params := &PaginationParams{
 		Limit:  limit,
 		Offset: offset,
-	}, nil
+	}
+
+	// Parse cursor; an empty value requests the first page in cursor mode
+	if token, ok := c.GetQuery("cursor"); ok {
+		cursor, err := keyset.Decode(token)
+		if err != nil || limit == 0 {
+			return nil, ErrInvalidPaginationParams
+		}
+		params.Cursor = cursor
+		params.Offset = 0
+	}
+
+	return params, nil
 }
 
 // RespondWithPagination sends a paginated response
@@ -75,3 +96,33 @@ func RespondWithPagination(c *gin.Context, statusCode int, items interface{}, li
 		},
 	})
 }
+
+// RespondWithCursorPagination sends a cursor-paginated response; a nil next cursor means there are no more items
+func RespondWithCursorPagination(c *gin.Context, statusCode int, items interface{}, limit, total int, next *keyset.Cursor) {
+	meta := PaginationMeta{
+		Limit: limit,
+		Total: total,
+	}
+	hasMore := next != nil
+	meta.HasMore = &hasMore
+	if hasMore {
+		meta.NextCursor = next.Encode()
+	}
+	c.JSON(statusCode, PaginatedResponse{
+		Items:      items,
+		Pagination: meta,
+	})
+}
+
+// RespondWithCursorError responds with 400 when err is a cursor pagination error and reports whether it did
+func RespondWithCursorError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, keyset.ErrInvalidCursor):
+		RespondWithError(c, http.StatusBadRequest, "INVALID_CURSOR", "Invalid cursor")
+	case errors.Is(err, keyset.ErrUnsupportedSort):
+		RespondWithError(c, http.StatusBadRequest, "UNSUPPORTED_CURSOR_SORT", "This sort cannot be used with cursor pagination")
+	default:
+		return false
+	}
+	return true
+}
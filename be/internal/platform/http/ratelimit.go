@@ -2,7 +2,9 @@ package http
 
 import (
 	"fmt"
+	"math/rand/v2"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -18,22 +20,33 @@ type RateLimitConfig struct {
 	Window time.Duration
 	// KeyPrefix is prepended to the rate limit key (e.g., "auth", "api")
 	KeyPrefix string
+	// PerRoute counts each route separately instead of sharing one budget
+	PerRoute bool
+	// ReadOnly limits only GET and HEAD requests and lets others through
+	ReadOnly bool
 }
 
-// rateLimitScript atomically increments the counter and sets TTL only on first access.
-// Returns the current count after increment.
+// rateLimitScript implements a sliding window log: timestamps of accepted
+// requests live in a sorted set, entries older than the window are trimmed
+// and the request is only recorded when it fits.
+// Returns {1, 0} when allowed, or {0, ms until the oldest entry expires}.
 var rateLimitScript = redis.NewScript(`
 local key = KEYS[1]
-local count = redis.call("INCR", key)
-if count == 1 then
-    redis.call("EXPIRE", key, ARGV[1])
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+redis.call("ZREMRANGEBYSCORE", key, "-inf", now - window)
+if redis.call("ZCARD", key) >= limit then
+    local oldest = redis.call("ZRANGE", key, 0, 0, "WITHSCORES")
+    return {0, tonumber(oldest[2]) + window - now}
 end
-return count
+redis.call("ZADD", key, now, ARGV[4])
+redis.call("PEXPIRE", key, window)
+return {1, 0}
 `)
 
 // RateLimitMiddleware creates a Redis-based rate limiting middleware.
-// Limits requests by client IP using a fixed-window counter in Redis.
-// Uses a Lua script for atomic INCR + EXPIRE to prevent TTL-less keys on crashes.
+// Limits requests by client IP using a sliding window in Redis.
 func RateLimitMiddleware(rdb *redis.Client, cfg RateLimitConfig, logger *zap.Logger) gin.HandlerFunc {
 	return rateLimitByKey(rdb, cfg, logger, func(c *gin.Context) string {
 		return c.ClientIP()
@@ -55,15 +68,34 @@ func UserRateLimitMiddleware(rdb *redis.Client, cfg RateLimitConfig, logger *zap
 }
 
 func rateLimitByKey(rdb *redis.Client, cfg RateLimitConfig, logger *zap.Logger, keyFn func(*gin.Context) string) gin.HandlerFunc {
+	// Without Redis, requests are not limited (same as fail-open on Redis errors)
+	if rdb == nil {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
 	return func(c *gin.Context) {
-		identity := keyFn(c)
-		key := fmt.Sprintf("ratelimit:%s:%s", cfg.KeyPrefix, identity)
+		if cfg.ReadOnly && c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		key := fmt.Sprintf("ratelimit:%s:%s", cfg.KeyPrefix, keyFn(c))
+		if cfg.PerRoute {
+			route := c.FullPath()
+			if route == "" {
+				route = c.Request.URL.Path
+			}
+			key += ":" + route
+		}
 
 		ctx := c.Request.Context()
 
-		windowSeconds := int(cfg.Window.Seconds())
-		result, err := rateLimitScript.Run(ctx, rdb, []string{key}, windowSeconds).Int64()
-		if err != nil {
+		now := time.Now().UnixMilli()
+		member := fmt.Sprintf("%d-%d", now, rand.Int64())
+		result, err := rateLimitScript.Run(ctx, rdb, []string{key}, now, cfg.Window.Milliseconds(), cfg.MaxRequests, member).Int64Slice()
+		if err != nil || len(result) != 2 {
 			// On Redis error, allow the request (fail open) but log
 			logger.Warn("rate limiter fail-open: redis error",
 				zap.String("key", key),
@@ -73,7 +105,12 @@ func rateLimitByKey(rdb *redis.Client, cfg RateLimitConfig, logger *zap.Logger,
 			return
 		}
 
-		if result > int64(cfg.MaxRequests) {
+		if result[0] == 0 {
+			retryAfter := (result[1] + 999) / 1000
+			if retryAfter < 1 {
+				retryAfter = 1
+			}
+			c.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
 			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
 				"code":    "RATE_LIMIT_EXCEEDED",
 				"message": "Too many requests, please try again later",
@@ -3,6 +3,7 @@ package http
 import (
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
@@ -103,6 +104,23 @@ func TestRateLimitMiddleware(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
+
+	t.Run("passes through when redis is disabled", func(t *testing.T) {
+		router := gin.New()
+		router.Use(RateLimitMiddleware(nil, cfg, logger))
+		router.GET("/ping", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"message": "pong"})
+		})
+
+		for i := range cfg.MaxRequests + 2 {
+			req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+			req.RemoteAddr = "192.168.1.1:1234"
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code, "request %d should be allowed", i+1)
+		}
+	})
 }
 
 func TestUserRateLimitMiddleware(t *testing.T) {
@@ -218,3 +236,179 @@ func TestUserRateLimitMiddleware(t *testing.T) {
 		assert.True(t, found, "expected Redis key with IP fallback on non-string user_id, got keys: %v", keys)
 	})
 }
+
+func TestRateLimitMiddleware_SlidingWindow(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger := zap.NewNop()
+
+	send := func(router *gin.Engine, method, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = "192.168.1.1:1234"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("sets Retry-After when the limit is exceeded", func(t *testing.T) {
+		_, rdb := setupRateLimitTest(t)
+		router := gin.New()
+		router.Use(RateLimitMiddleware(rdb, RateLimitConfig{MaxRequests: 1, Window: time.Minute, KeyPrefix: "test"}, logger))
+		router.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+		require.Equal(t, http.StatusOK, send(router, http.MethodGet, "/ping").Code)
+		w := send(router, http.MethodGet, "/ping")
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		retryAfter, err := strconv.Atoi(w.Header().Get("Retry-After"))
+		require.NoError(t, err)
+		assert.True(t, retryAfter > 0 && retryAfter <= 60, "Retry-After %d should be within the window", retryAfter)
+	})
+
+	t.Run("admits requests again once earlier ones leave the window", func(t *testing.T) {
+		_, rdb := setupRateLimitTest(t)
+		router := gin.New()
+		router.Use(RateLimitMiddleware(rdb, RateLimitConfig{MaxRequests: 2, Window: 100 * time.Millisecond, KeyPrefix: "test"}, logger))
+		router.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+		require.Equal(t, http.StatusOK, send(router, http.MethodGet, "/ping").Code)
+		require.Equal(t, http.StatusOK, send(router, http.MethodGet, "/ping").Code)
+		require.Equal(t, http.StatusTooManyRequests, send(router, http.MethodGet, "/ping").Code)
+
+		time.Sleep(150 * time.Millisecond)
+
+		assert.Equal(t, http.StatusOK, send(router, http.MethodGet, "/ping").Code)
+	})
+
+	t.Run("counts routes separately when PerRoute is set", func(t *testing.T) {
+		mr, rdb := setupRateLimitTest(t)
+		router := gin.New()
+		router.Use(RateLimitMiddleware(rdb, RateLimitConfig{MaxRequests: 1, Window: time.Minute, KeyPrefix: "read", PerRoute: true}, logger))
+		router.GET("/items/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+		router.GET("/other", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+		require.Equal(t, http.StatusOK, send(router, http.MethodGet, "/items/1").Code)
+		assert.Equal(t, http.StatusTooManyRequests, send(router, http.MethodGet, "/items/2").Code, "path parameters share the route budget")
+		assert.Equal(t, http.StatusOK, send(router, http.MethodGet, "/other").Code)
+		assert.True(t, mr.Exists("ratelimit:read:192.168.1.1:/items/:id"))
+	})
+
+	t.Run("ignores writes when ReadOnly is set", func(t *testing.T) {
+		_, rdb := setupRateLimitTest(t)
+		router := gin.New()
+		router.Use(RateLimitMiddleware(rdb, RateLimitConfig{MaxRequests: 1, Window: time.Minute, KeyPrefix: "read", ReadOnly: true}, logger))
+		router.GET("/items", func(c *gin.Context) { c.Status(http.StatusOK) })
+		router.POST("/items", func(c *gin.Context) { c.Status(http.StatusCreated) })
+
+		require.Equal(t, http.StatusOK, send(router, http.MethodGet, "/items").Code)
+		require.Equal(t, http.StatusTooManyRequests, send(router, http.MethodGet, "/items").Code)
+
+		assert.Equal(t, http.StatusCreated, send(router, http.MethodPost, "/items").Code)
+		assert.Equal(t, http.StatusCreated, send(router, http.MethodPost, "/items").Code)
+	})
+
+	t.Run("returns after the rest of the chain has run", func(t *testing.T) {
+		_, rdb := setupRateLimitTest(t)
+		var order []string
+		limiter := RateLimitMiddleware(rdb, RateLimitConfig{MaxRequests: 1, Window: time.Minute, KeyPrefix: "test"}, logger)
+		router := gin.New()
+		router.GET("/ping",
+			func(c *gin.Context) {
+				limiter(c)
+				order = append(order, "after limiter")
+			},
+			func(c *gin.Context) { order = append(order, "handler") },
+		)
+
+		send(router, http.MethodGet, "/ping")
+
+		assert.Equal(t, []string{"handler", "after limiter"}, order)
+	})
+
+	t.Run("fails open when Redis is down", func(t *testing.T) {
+		mr, rdb := setupRateLimitTest(t)
+		mr.SetError("connection refused")
+		router := gin.New()
+		router.Use(RateLimitMiddleware(rdb, RateLimitConfig{MaxRequests: 1, Window: time.Minute, KeyPrefix: "test"}, logger))
+		router.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+		assert.Equal(t, http.StatusOK, send(router, http.MethodGet, "/ping").Code)
+		assert.Equal(t, http.StatusOK, send(router, http.MethodGet, "/ping").Code)
+	})
+}
+
+func TestChain(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("runs handlers in order before the route", func(t *testing.T) {
+		var order []string
+		router := gin.New()
+		router.GET("/ping",
+			Chain(
+				func(c *gin.Context) { order = append(order, "first") },
+				func(c *gin.Context) { order = append(order, "second") },
+			),
+			func(c *gin.Context) { order = append(order, "handler") },
+		)
+
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ping", nil))
+
+		assert.Equal(t, []string{"first", "second", "handler"}, order)
+	})
+
+	t.Run("lets the last handler run the route with c.Next", func(t *testing.T) {
+		var order []string
+		router := gin.New()
+		router.GET("/ping",
+			Chain(
+				func(c *gin.Context) { order = append(order, "first") },
+				func(c *gin.Context) {
+					c.Next()
+					order = append(order, "after route")
+				},
+			),
+			func(c *gin.Context) { order = append(order, "handler") },
+		)
+
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ping", nil))
+
+		assert.Equal(t, []string{"first", "handler", "after route"}, order)
+	})
+
+	t.Run("runs a rate limiter last", func(t *testing.T) {
+		_, rdb := setupRateLimitTest(t)
+		var order []string
+		router := gin.New()
+		router.GET("/ping",
+			Chain(
+				func(c *gin.Context) { order = append(order, "auth") },
+				UserRateLimitMiddleware(rdb, RateLimitConfig{MaxRequests: 1, Window: time.Minute, KeyPrefix: "read"}, zap.NewNop()),
+			),
+			func(c *gin.Context) { order = append(order, "handler") },
+		)
+
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ping", nil))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ping", nil))
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, []string{"auth", "handler", "auth"}, order)
+	})
+
+	t.Run("stops at the first handler that aborts", func(t *testing.T) {
+		var order []string
+		router := gin.New()
+		router.GET("/ping",
+			Chain(
+				func(c *gin.Context) { c.AbortWithStatus(http.StatusUnauthorized) },
+				func(c *gin.Context) { order = append(order, "second") },
+			),
+			func(c *gin.Context) { order = append(order, "handler") },
+		)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ping", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Empty(t, order)
+	})
+}
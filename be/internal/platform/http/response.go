@@ -1,7 +1,9 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -39,6 +41,19 @@ func RespondWithData(c *gin.Context, statusCode int, data interface{}) {
 	c.JSON(statusCode, data)
 }
 
+// AddPreloadLinks adds a Link header hinting that the client will likely request
+// the given paths next. Clients and proxies may use it to prefetch them.
+func AddPreloadLinks(c *gin.Context, paths ...string) {
+	if len(paths) == 0 {
+		return
+	}
+	links := make([]string, 0, len(paths))
+	for _, p := range paths {
+		links = append(links, fmt.Sprintf("<%s>; rel=preload", p))
+	}
+	c.Writer.Header().Add("Link", strings.Join(links, ", "))
+}
+
 // Health response structure
 type HealthResponse struct {
 	Status   string            `json:"status"`
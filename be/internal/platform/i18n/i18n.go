@@ -0,0 +1,84 @@
+// Package i18n translates API error codes into user-facing messages.
+// Messages are loaded from the embedded locales/*.json files, one file per locale,
+// each mapping an error code to its message.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"path"
+	"strings"
+)
+
+// DefaultLocale is used when a message is missing in the requested locale
+const DefaultLocale = "en"
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// messages maps locale → error code → message
+var messages = mustLoad()
+
+func mustLoad() map[string]map[string]string {
+	files, err := localeFiles.ReadDir("locales")
+	if err != nil {
+		panic("i18n: failed to read locales: " + err.Error())
+	}
+
+	loaded := make(map[string]map[string]string, len(files))
+	for _, file := range files {
+		data, err := localeFiles.ReadFile(path.Join("locales", file.Name()))
+		if err != nil {
+			panic("i18n: failed to read " + file.Name() + ": " + err.Error())
+		}
+
+		var catalog map[string]string
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			panic("i18n: invalid " + file.Name() + ": " + err.Error())
+		}
+		loaded[strings.TrimSuffix(file.Name(), ".json")] = catalog
+	}
+	return loaded
+}
+
+// Translate returns the message for errorCode in the given locale.
+// Regional locales such as "es-MX" fall back to their language ("es"), and
+// missing locales or messages fall back to English. Unknown codes return the
+// generic internal error message.
+func Translate(locale, errorCode string) string {
+	for _, candidate := range []string{normalize(locale), baseLanguage(locale), DefaultLocale} {
+		if msg, ok := messages[candidate][errorCode]; ok {
+			return msg
+		}
+	}
+	return messages[DefaultLocale]["INTERNAL_ERROR"]
+}
+
+// supportedLocales is the allowlist of BCP-47 tags accepted as a user locale,
+// in normalized (lower-case, hyphenated) form. "ua" is not a language subtag
+// but is what the frontend sends for Ukrainian, so it is kept alongside "uk".
+var supportedLocales = map[string]bool{
+	"ar": true, "de": true, "de-at": true, "de-de": true,
+	"en": true, "en-au": true, "en-ca": true, "en-gb": true, "en-us": true,
+	"es": true, "es-419": true, "es-es": true, "es-mx": true,
+	"fr": true, "fr-ca": true, "fr-fr": true,
+	"hi": true, "it": true, "ja": true, "ko": true, "nl": true, "pl": true,
+	"pt": true, "pt-br": true, "pt-pt": true,
+	"ru": true, "sv": true, "tr": true, "ua": true, "uk": true,
+	"zh": true, "zh-cn": true, "zh-tw": true,
+}
+
+// ValidateLocale reports whether locale is a supported BCP-47 tag.
+// Matching ignores case and accepts "_" as the subtag separator.
+func ValidateLocale(locale string) bool {
+	return supportedLocales[normalize(locale)]
+}
+
+func normalize(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+func baseLanguage(locale string) string {
+	lang, _, _ := strings.Cut(normalize(locale), "-")
+	return lang
+}
@@ -0,0 +1,79 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale string
+		code   string
+		want   string
+	}{
+		{"english", "en", "APPLICATION_NOT_FOUND", "Application not found"},
+		{"spanish", "es", "APPLICATION_NOT_FOUND", "Candidatura no encontrada"},
+		{"regional locale uses its language", "es-MX", "COMPANY_NOT_FOUND", "Empresa no encontrada"},
+		{"locale is case and separator insensitive", "ES_es", "COMPANY_NOT_FOUND", "Empresa no encontrada"},
+		{"missing locale falls back to english", "fr", "APPLICATION_NOT_FOUND", "Application not found"},
+		{"empty locale falls back to english", "", "JOB_NOT_FOUND", "Job not found"},
+		{"unknown code returns internal error", "es", "NO_SUCH_CODE", "Internal server error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Translate(tt.locale, tt.code))
+		})
+	}
+}
+
+func TestTranslate_MissingMessageFallsBackToEnglish(t *testing.T) {
+	messages["xx"] = map[string]string{"JOB_NOT_FOUND": "xx job"}
+	t.Cleanup(func() { delete(messages, "xx") })
+
+	assert.Equal(t, "xx job", Translate("xx", "JOB_NOT_FOUND"))
+	assert.Equal(t, "Company not found", Translate("xx", "COMPANY_NOT_FOUND"))
+}
+
+func TestValidateLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   bool
+	}{
+		{"en", true},
+		{"en-US", true},
+		{"es", true},
+		{"es-MX", true},
+		{"es-419", true},
+		{"fr", true},
+		{"de", true},
+		{"ja", true},
+		{"zh-CN", true},
+		{"pt_BR", true},
+		{"ua", true},
+		{"uk", true},
+		{"", false},
+		{" ", false},
+		{"xx-ZZ", false},
+		{"gibberish", false},
+		{"en-", false},
+		{"en-US-x-private", false},
+		{"EN-us; DROP TABLE users", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidateLocale(tt.locale))
+		})
+	}
+}
+
+func TestCatalogsCoverEnglishCodes(t *testing.T) {
+	for locale, catalog := range messages {
+		for code := range messages[DefaultLocale] {
+			assert.Contains(t, catalog, code, "locale %s is missing %s", locale, code)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Package keyset implements opaque cursors for keyset (seek) pagination.
+//
+// A page continues after the last item of the previous one by comparing the
+// (sort value, id) pair instead of skipping rows with OFFSET, so deep pages
+// cost the same as the first one.
+package keyset
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidCursor is returned when a cursor token or its sort value cannot be decoded
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrUnsupportedSort is returned when the requested sort cannot be paginated by cursor
+	ErrUnsupportedSort = errors.New("sort is not supported with cursor pagination")
+)
+
+// Cursor identifies the last item of a page by its sort value and ID.
+// A cursor without LastID requests the first page.
+type Cursor struct {
+	LastID    string `json:"last_id"`
+	LastValue string `json:"last_value"`
+}
+
+// IsStart reports whether the cursor requests the first page
+func (c *Cursor) IsStart() bool {
+	return c.LastID == ""
+}
+
+// Encode returns the opaque token clients pass back as ?cursor=
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Decode parses a token produced by Encode. An empty token yields a start cursor.
+func Decode(token string) (*Cursor, error) {
+	if token == "" {
+		return &Cursor{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if _, err := uuid.Parse(c.LastID); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// TimeValue formats a timestamp sort value for a cursor
+func TimeValue(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// Column is a sort expression that can be paginated by cursor
+type Column struct {
+	// Expr is the SQL expression rows are ordered by
+	Expr string
+	// Param wraps the cursor placeholder, e.g. "LOWER(%s)"; empty uses the placeholder as is
+	Param string
+	// Parse converts Cursor.LastValue into a query argument
+	Parse func(value string) (any, error)
+}
+
+// TextColumn orders by a text expression
+func TextColumn(expr string) Column {
+	return Column{Expr: expr, Parse: func(value string) (any, error) { return value, nil }}
+}
+
+// LowerTextColumn orders case-insensitively by LOWER(expr); cursor values keep the original text
+func LowerTextColumn(expr string) Column {
+	col := TextColumn("LOWER(" + expr + ")")
+	col.Param = "LOWER(%s)"
+	return col
+}
+
+// TimeColumn orders by a timestamp expression; values are formatted with TimeValue
+func TimeColumn(expr string) Column {
+	return Column{Expr: expr, Parse: func(value string) (any, error) {
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		return t, nil
+	}}
+}
+
+// IntColumn orders by an integer expression
+func IntColumn(expr string) Column {
+	return Column{Expr: expr, Parse: func(value string) (any, error) {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		return n, nil
+	}}
+}
+
+// Condition returns the comparison that continues after cur, e.g.
+// "(a.applied_at, a.id) < ($4, $5)", and args with the cursor values appended.
+// A start cursor yields no condition.
+func (col Column) Condition(idExpr string, desc bool, cur *Cursor, args []any) (string, []any, error) {
+	if cur == nil || cur.IsStart() {
+		return "", args, nil
+	}
+	value, err := col.Parse(cur.LastValue)
+	if err != nil {
+		return "", nil, err
+	}
+	op := ">"
+	if desc {
+		op = "<"
+	}
+	args = append(args, value, cur.LastID)
+	valueParam := fmt.Sprintf("$%d", len(args)-1)
+	if col.Param != "" {
+		valueParam = fmt.Sprintf(col.Param, valueParam)
+	}
+	return fmt.Sprintf("(%s, %s) %s (%s, $%d)", col.Expr, idExpr, op, valueParam, len(args)), args, nil
+}
+
+// OrderBy returns the ORDER BY list matching Condition, with the ID breaking ties
+func (col Column) OrderBy(idExpr string, desc bool) string {
+	dir := "ASC"
+	if desc {
+		dir = "DESC"
+	}
+	return col.Expr + " " + dir + ", " + idExpr + " " + dir
+}
+
+// Trim drops the extra item fetched beyond limit to detect a further page.
+// It returns the cursor of the last kept item, or nil when there is no next page.
+func Trim[T any](items []T, limit int, cursorOf func(T) Cursor) ([]T, *Cursor) {
+	if len(items) <= limit {
+		return items, nil
+	}
+	items = items[:limit]
+	if limit == 0 {
+		return items, nil
+	}
+	next := cursorOf(items[limit-1])
+	return items, &next
+}
@@ -0,0 +1,122 @@
+package keyset
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testID = "5b1f2f5e-8d7a-4c36-9a52-1f0f4c2e9b10"
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	t.Run("round-trips a cursor", func(t *testing.T) {
+		cursor := Cursor{LastID: testID, LastValue: "2026-03-01T10:00:00.123456Z"}
+
+		decoded, err := Decode(cursor.Encode())
+
+		require.NoError(t, err)
+		assert.Equal(t, cursor, *decoded)
+		assert.False(t, decoded.IsStart())
+	})
+
+	t.Run("decodes an empty token as the first page", func(t *testing.T) {
+		decoded, err := Decode("")
+
+		require.NoError(t, err)
+		assert.True(t, decoded.IsStart())
+	})
+
+	for _, tt := range []struct {
+		name  string
+		token string
+	}{
+		{name: "not base64", token: "%%%"},
+		{name: "not JSON", token: base64.RawURLEncoding.EncodeToString([]byte("last_id"))},
+		{name: "missing ID", token: Cursor{LastValue: "x"}.Encode()},
+		{name: "ID is not a UUID", token: Cursor{LastID: "1 OR 1=1", LastValue: "x"}.Encode()},
+	} {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			decoded, err := Decode(tt.token)
+
+			assert.ErrorIs(t, err, ErrInvalidCursor)
+			assert.Nil(t, decoded)
+		})
+	}
+}
+
+func TestColumn_Condition(t *testing.T) {
+	cursor := &Cursor{LastID: testID, LastValue: "2026-03-01T10:00:00Z"}
+
+	t.Run("continues descending pages with less-than", func(t *testing.T) {
+		cond, args, err := TimeColumn("a.applied_at").Condition("a.id", true, cursor, []any{"user-123"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "(a.applied_at, a.id) < ($2, $3)", cond)
+		assert.Equal(t, []any{"user-123", time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC), testID}, args)
+	})
+
+	t.Run("continues ascending pages with greater-than", func(t *testing.T) {
+		cond, args, err := TextColumn("c.name").Condition("c.id", false, &Cursor{LastID: testID, LastValue: "Acme"}, []any{"user-123", 20, 0})
+
+		require.NoError(t, err)
+		assert.Equal(t, "(c.name, c.id) > ($4, $5)", cond)
+		assert.Equal(t, []any{"user-123", 20, 0, "Acme", testID}, args)
+	})
+
+	t.Run("wraps the placeholder for case-insensitive text", func(t *testing.T) {
+		cond, _, err := LowerTextColumn("j.title").Condition("j.id", false, &Cursor{LastID: testID, LastValue: "Go Developer"}, []any{"user-123"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "(LOWER(j.title), j.id) > (LOWER($2), $3)", cond)
+	})
+
+	t.Run("parses integer values", func(t *testing.T) {
+		_, args, err := IntColumn("COUNT(a.id)").Condition("c.id", true, &Cursor{LastID: testID, LastValue: "7"}, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, []any{7, testID}, args)
+	})
+
+	t.Run("adds nothing for the first page", func(t *testing.T) {
+		cond, args, err := TimeColumn("a.applied_at").Condition("a.id", true, &Cursor{}, []any{"user-123"})
+
+		require.NoError(t, err)
+		assert.Empty(t, cond)
+		assert.Equal(t, []any{"user-123"}, args)
+	})
+
+	t.Run("rejects values of the wrong type", func(t *testing.T) {
+		_, _, err := TimeColumn("a.applied_at").Condition("a.id", true, &Cursor{LastID: testID, LastValue: "Acme"}, nil)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+
+		_, _, err = IntColumn("COUNT(a.id)").Condition("c.id", true, &Cursor{LastID: testID, LastValue: "many"}, nil)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
+
+func TestColumn_OrderBy(t *testing.T) {
+	assert.Equal(t, "a.status DESC, a.id DESC", TextColumn("a.status").OrderBy("a.id", true))
+	assert.Equal(t, "LOWER(j.title) ASC, j.id ASC", LowerTextColumn("j.title").OrderBy("j.id", false))
+}
+
+func TestTrim(t *testing.T) {
+	cursorOf := func(id string) Cursor { return Cursor{LastID: id} }
+
+	t.Run("returns the cursor of the last kept item when more follow", func(t *testing.T) {
+		page, next := Trim([]string{"a", "b", "c"}, 2, cursorOf)
+
+		assert.Equal(t, []string{"a", "b"}, page)
+		require.NotNil(t, next)
+		assert.Equal(t, "b", next.LastID)
+	})
+
+	t.Run("returns no cursor on the last page", func(t *testing.T) {
+		page, next := Trim([]string{"a", "b"}, 2, cursorOf)
+
+		assert.Equal(t, []string{"a", "b"}, page)
+		assert.Nil(t, next)
+	})
+}
@@ -0,0 +1,170 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
+)
+
+const namespace = "jobber"
+
+// unmatchedRoute labels requests that match no registered route, so unknown
+// paths do not create a label value each
+const unmatchedRoute = "unmatched"
+
+// statusCountTimeout bounds the database count run on each scrape of the
+// applications gauge
+const statusCountTimeout = 5 * time.Second
+
+// Metrics holds the Prometheus collectors served on /metrics
+type Metrics struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// New creates the collectors, including the Go runtime and process metrics
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of HTTP requests by method, route and status code.",
+		}, []string{"method", "route", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method and route.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests,
+		m.duration,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// Middleware records the count and latency of requests per route. Requests
+// to skipPaths, such as the health check, are not recorded.
+func (m *Metrics) Middleware(skipPaths ...string) gin.HandlerFunc {
+	skip := make(map[string]struct{}, len(skipPaths))
+	for _, path := range skipPaths {
+		skip[path] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		if _, ok := skip[c.Request.URL.Path]; ok {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		m.requests.WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).Inc()
+		m.duration.WithLabelValues(c.Request.Method, route).Observe(time.Since(start).Seconds())
+	}
+}
+
+// RegisterPostgresPool exposes the connection counts of the database pool
+func (m *Metrics) RegisterPostgresPool(pool *pgxpool.Pool) {
+	gauge := func(name, help string, value func(*pgxpool.Stat) int32) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "db_pool",
+			Name:      name,
+			Help:      help,
+		}, func() float64 { return float64(value(pool.Stat())) })
+	}
+
+	m.registry.MustRegister(
+		gauge("acquired_conns", "Connections currently in use.", (*pgxpool.Stat).AcquiredConns),
+		gauge("idle_conns", "Idle connections in the pool.", (*pgxpool.Stat).IdleConns),
+		gauge("total_conns", "All connections in the pool.", (*pgxpool.Stat).TotalConns),
+	)
+}
+
+// RegisterRedisPool exposes the connection pool stats of the Redis client;
+// it is a no-op when the server runs without Redis
+func (m *Metrics) RegisterRedisPool(client *redis.Client) {
+	if client == nil {
+		return
+	}
+
+	opts := func(name, help string) prometheus.Opts {
+		return prometheus.Opts{Namespace: namespace, Subsystem: "redis_pool", Name: name, Help: help}
+	}
+	stat := func(value func(*redis.PoolStats) uint32) func() float64 {
+		return func() float64 { return float64(value(client.PoolStats())) }
+	}
+
+	m.registry.MustRegister(
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts(opts("total_conns", "All connections in the pool.")),
+			stat(func(s *redis.PoolStats) uint32 { return s.TotalConns })),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts(opts("idle_conns", "Idle connections in the pool.")),
+			stat(func(s *redis.PoolStats) uint32 { return s.IdleConns })),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts(opts("stale_conns", "Stale connections removed from the pool.")),
+			stat(func(s *redis.PoolStats) uint32 { return s.StaleConns })),
+		prometheus.NewCounterFunc(prometheus.CounterOpts(opts("hits_total", "Times a free connection was found in the pool.")),
+			stat(func(s *redis.PoolStats) uint32 { return s.Hits })),
+		prometheus.NewCounterFunc(prometheus.CounterOpts(opts("misses_total", "Times a free connection was not found in the pool.")),
+			stat(func(s *redis.PoolStats) uint32 { return s.Misses })),
+		prometheus.NewCounterFunc(prometheus.CounterOpts(opts("timeouts_total", "Times waiting for a connection timed out.")),
+			stat(func(s *redis.PoolStats) uint32 { return s.Timeouts })),
+	)
+}
+
+// RegisterApplicationsByStatus exposes the number of applications across all
+// users by status. count runs on each scrape rather than on every write; a nil
+// result leaves the gauge out of that scrape.
+func (m *Metrics) RegisterApplicationsByStatus(count func(ctx context.Context) map[string]int) {
+	m.registry.MustRegister(&statusCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "applications_total"),
+			"Number of applications across all users by status.",
+			[]string{"status"}, nil,
+		),
+		count: count,
+	})
+}
+
+// statusCollector reports the applications gauge from a fresh count at scrape time
+type statusCollector struct {
+	desc  *prometheus.Desc
+	count func(ctx context.Context) map[string]int
+}
+
+func (c *statusCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *statusCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), statusCountTimeout)
+	defer cancel()
+
+	for status, n := range c.count(ctx) {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(n), status)
+	}
+}
@@ -17,25 +17,10 @@ import (
 func RunMigrations(ctx context.Context, cfg config.DatabaseConfig, log *logger.Logger, migrationsPath string) error {
 	log.Info("Starting database migrations", zap.String("path", migrationsPath))
 
-	// Build migration source URL
-	sourceURL := fmt.Sprintf("file://%s", migrationsPath)
-	
-	// Build database URL for migrations
-	databaseURL := fmt.Sprintf(
-		"postgres://%s:%s@%s:%s/%s?sslmode=%s",
-		cfg.User,
-		cfg.Password,
-		cfg.Host,
-		cfg.Port,
-		cfg.DBName,
-		cfg.SSLMode,
-	)
-
-	// Create migrator instance
-	m, err := migrate.New(sourceURL, databaseURL)
+	m, err := newMigrator(cfg, migrationsPath)
 	if err != nil {
 		log.Error("Failed to create migrator", zap.Error(err))
-		return fmt.Errorf("failed to create migrator: %w", err)
+		return err
 	}
 	defer m.Close()
 
@@ -74,3 +59,79 @@ func RunMigrations(ctx context.Context, cfg config.DatabaseConfig, log *logger.L
 
 	return nil
 }
+
+// RunMigrationsDown reverts the last steps migrations, one at a time,
+// logging each version as it is rolled back
+func RunMigrationsDown(ctx context.Context, cfg config.DatabaseConfig, log *logger.Logger, migrationsPath string, steps int) error {
+	if steps < 1 {
+		return fmt.Errorf("steps must be at least 1, got %d", steps)
+	}
+
+	log.Info("Starting database rollback",
+		zap.String("path", migrationsPath),
+		zap.Int("steps", steps),
+	)
+
+	m, err := newMigrator(cfg, migrationsPath)
+	if err != nil {
+		log.Error("Failed to create migrator", zap.Error(err))
+		return err
+	}
+	defer m.Close()
+
+	for i := 0; i < steps; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		version, dirty, err := m.Version()
+		if errors.Is(err, migrate.ErrNilVersion) {
+			log.Info("No more migrations to roll back", zap.Int("reverted", i))
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get migration version: %w", err)
+		}
+		if dirty {
+			return fmt.Errorf("database is dirty at version %d, fix it before rolling back", version)
+		}
+
+		log.Info("Reverting migration", zap.Uint("version", version))
+		if err := m.Steps(-1); err != nil {
+			log.Error("Rollback failed", zap.Error(err), zap.Uint("version", version))
+			return fmt.Errorf("failed to revert migration %d: %w", version, err)
+		}
+	}
+
+	version, _, err := m.Version()
+	switch {
+	case errors.Is(err, migrate.ErrNilVersion):
+		log.Info("Database rollback completed, no migrations applied")
+	case err != nil:
+		log.Warn("Could not get migration version after rollback", zap.Error(err))
+	default:
+		log.Info("Database rollback completed", zap.Uint("version", version))
+	}
+
+	return nil
+}
+
+// newMigrator creates a migrate instance for the given database and migrations directory
+func newMigrator(cfg config.DatabaseConfig, migrationsPath string) (*migrate.Migrate, error) {
+	sourceURL := fmt.Sprintf("file://%s", migrationsPath)
+	databaseURL := fmt.Sprintf(
+		"postgres://%s:%s@%s:%s/%s?sslmode=%s",
+		cfg.User,
+		cfg.Password,
+		cfg.Host,
+		cfg.Port,
+		cfg.DBName,
+		cfg.SSLMode,
+	)
+
+	m, err := migrate.New(sourceURL, databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migrator: %w", err)
+	}
+	return m, nil
+}
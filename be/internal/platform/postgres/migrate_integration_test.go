@@ -0,0 +1,100 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/internal/config"
+	"github.com/andreypavlenko/jobber/internal/platform/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tcPostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestRunMigrationsDown(t *testing.T) {
+	ctx := context.Background()
+
+	pgContainer, err := tcPostgres.Run(ctx,
+		"postgres:16-alpine",
+		tcPostgres.WithDatabase("jobber_migrate_test"),
+		tcPostgres.WithUsername("test"),
+		tcPostgres.WithPassword("test"),
+		tcPostgres.BasicWaitStrategies(),
+	)
+	require.NoError(t, err)
+	defer pgContainer.Terminate(ctx) //nolint:errcheck
+
+	host, err := pgContainer.Host(ctx)
+	require.NoError(t, err)
+	port, err := pgContainer.MappedPort(ctx, "5432")
+	require.NoError(t, err)
+
+	cfg := config.DatabaseConfig{
+		Host:            host,
+		Port:            port.Port(),
+		User:            "test",
+		Password:        "test",
+		DBName:          "jobber_migrate_test",
+		SSLMode:         "disable",
+		MaxConns:        2,
+		MaxIdleConns:    1,
+		ConnMaxLifetime: time.Minute,
+	}
+
+	dir := t.TempDir()
+	writeMigration(t, dir, "000001_create_first.up.sql", "CREATE TABLE first_table (id INT PRIMARY KEY);")
+	writeMigration(t, dir, "000001_create_first.down.sql", "DROP TABLE first_table;")
+	writeMigration(t, dir, "000002_create_second.up.sql", "CREATE TABLE second_table (id INT PRIMARY KEY);")
+	writeMigration(t, dir, "000002_create_second.down.sql", "DROP TABLE second_table;")
+
+	log, err := logger.New("info", "json")
+	require.NoError(t, err)
+
+	require.NoError(t, RunMigrations(ctx, cfg, log, dir))
+
+	client, err := New(ctx, cfg)
+	require.NoError(t, err)
+	defer client.Close()
+
+	assert.True(t, tableExists(t, client, "first_table"))
+	assert.True(t, tableExists(t, client, "second_table"))
+
+	require.NoError(t, RunMigrationsDown(ctx, cfg, log, dir, 1))
+
+	assert.True(t, tableExists(t, client, "first_table"))
+	assert.False(t, tableExists(t, client, "second_table"))
+
+	var version int
+	require.NoError(t, client.Pool.QueryRow(ctx, "SELECT version FROM schema_migrations").Scan(&version))
+	assert.Equal(t, 1, version)
+
+	t.Run("rejects invalid steps", func(t *testing.T) {
+		assert.Error(t, RunMigrationsDown(ctx, cfg, log, dir, 0))
+		assert.True(t, tableExists(t, client, "first_table"))
+	})
+
+	t.Run("stops when nothing is left to roll back", func(t *testing.T) {
+		require.NoError(t, RunMigrationsDown(ctx, cfg, log, dir, 5))
+		assert.False(t, tableExists(t, client, "first_table"))
+	})
+}
+
+func writeMigration(t *testing.T, dir, name, sql string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(sql), 0o600))
+}
+
+func tableExists(t *testing.T, client *Client, table string) bool {
+	t.Helper()
+	var exists bool
+	err := client.Pool.QueryRow(context.Background(),
+		"SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = $1)", table,
+	).Scan(&exists)
+	require.NoError(t, err)
+	return exists
+}
@@ -2,13 +2,19 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/andreypavlenko/jobber/internal/config"
 	"github.com/redis/go-redis/v9"
 )
 
-// Client represents a Redis client
+// ErrUnavailable is returned by a Client that has no Redis connection
+var ErrUnavailable = errors.New("redis unavailable")
+
+// Client represents a Redis client.
+// A nil *Client stands for "running without Redis": its methods are safe to
+// call and Raw returns nil, which Redis-backed features treat as disabled.
 type Client struct {
 	*redis.Client
 }
@@ -23,13 +29,38 @@ func New(ctx context.Context, cfg config.RedisConfig) (*Client, error) {
 
 	// Verify connection
 	if err := rdb.Ping(ctx).Err(); err != nil {
+		_ = rdb.Close()
 		return nil, fmt.Errorf("unable to connect to Redis: %w", err)
 	}
 
 	return &Client{Client: rdb}, nil
 }
 
+// Available reports whether the client is backed by a Redis connection
+func (c *Client) Available() bool {
+	return c != nil && c.Client != nil
+}
+
+// Raw returns the underlying go-redis client, or nil when Redis is unavailable
+func (c *Client) Raw() *redis.Client {
+	if !c.Available() {
+		return nil
+	}
+	return c.Client
+}
+
 // Health checks the Redis health
 func (c *Client) Health(ctx context.Context) error {
+	if !c.Available() {
+		return ErrUnavailable
+	}
 	return c.Ping(ctx).Err()
 }
+
+// Close closes the connection; it is a no-op when Redis is unavailable
+func (c *Client) Close() error {
+	if !c.Available() {
+		return nil
+	}
+	return c.Client.Close()
+}
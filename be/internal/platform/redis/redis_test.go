@@ -0,0 +1,51 @@
+package redis
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/andreypavlenko/jobber/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func redisConfig(addr string) config.RedisConfig {
+	host, port, _ := net.SplitHostPort(addr)
+	return config.RedisConfig{Host: host, Port: port}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("connects to a running server", func(t *testing.T) {
+		mr := miniredis.RunT(t)
+
+		client, err := New(context.Background(), redisConfig(mr.Addr()))
+		require.NoError(t, err)
+		defer client.Close()
+
+		assert.True(t, client.Available())
+		assert.NotNil(t, client.Raw())
+		assert.NoError(t, client.Health(context.Background()))
+	})
+
+	t.Run("returns error when server is unreachable", func(t *testing.T) {
+		mr := miniredis.RunT(t)
+		addr := mr.Addr()
+		mr.Close()
+
+		client, err := New(context.Background(), redisConfig(addr))
+
+		assert.Error(t, err)
+		assert.Nil(t, client)
+	})
+}
+
+func TestClient_Unavailable(t *testing.T) {
+	var client *Client
+
+	assert.False(t, client.Available())
+	assert.Nil(t, client.Raw())
+	assert.ErrorIs(t, client.Health(context.Background()), ErrUnavailable)
+	assert.NoError(t, client.Close())
+}
@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/andreypavlenko/jobber/internal/platform/circuitbreaker"
+)
+
+// ErrStorageUnavailable is returned while the storage circuit breaker is open.
+var ErrStorageUnavailable = errors.New("storage is temporarily unavailable")
+
+const (
+	// s3FailureThreshold is the number of consecutive failures that opens the breaker
+	s3FailureThreshold = 5
+	// s3OpenTimeout is how long the breaker stays open before allowing a probe request
+	s3OpenTimeout = 30 * time.Second
+)
+
+// ObjectStorage is the set of object storage operations used by the application.
+// Implemented by S3Client and CircuitBreaker.
+type ObjectStorage interface {
+	GeneratePresignedUploadURL(ctx context.Context, key string, contentType string, expiry time.Duration) (string, error)
+	GeneratePresignedDownloadURL(ctx context.Context, key string, expiry time.Duration) (string, error)
+	PutObject(ctx context.Context, key string, contentType string, data []byte) error
+	DeleteObject(ctx context.Context, key string) error
+	GetObject(ctx context.Context, key string) ([]byte, error)
+	ObjectExists(ctx context.Context, key string) (bool, error)
+}
+
+var (
+	_ ObjectStorage = (*S3Client)(nil)
+	_ ObjectStorage = (*CircuitBreaker)(nil)
+)
+
+// CircuitBreaker wraps an ObjectStorage so that an S3 outage fails fast with
+// ErrStorageUnavailable instead of every request hanging until timeout.
+//
+// After 5 consecutive failures the breaker opens; after 30 seconds it lets a single
+// probe request through, closing on success and reopening on failure.
+// Client errors (4xx responses such as NoSuchKey) and canceled requests are not
+// counted as failures. State is kept in memory and is safe for concurrent use.
+type CircuitBreaker struct {
+	inner   ObjectStorage
+	breaker *circuitbreaker.Breaker
+}
+
+// NewCircuitBreaker wraps storage with the default S3 circuit breaker settings
+func NewCircuitBreaker(storage ObjectStorage) *CircuitBreaker {
+	return newCircuitBreaker(storage, s3FailureThreshold, s3OpenTimeout)
+}
+
+func newCircuitBreaker(storage ObjectStorage, threshold int, timeout time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		inner:   storage,
+		breaker: circuitbreaker.New("s3", threshold, timeout),
+	}
+}
+
+// State returns the current breaker state
+func (cb *CircuitBreaker) State() circuitbreaker.State {
+	return cb.breaker.State()
+}
+
+// GeneratePresignedUploadURL generates a presigned upload URL.
+// Presigning is a local operation, so it is refused while the breaker is open
+// but never used as the half-open probe.
+func (cb *CircuitBreaker) GeneratePresignedUploadURL(ctx context.Context, key string, contentType string, expiry time.Duration) (string, error) {
+	if cb.breaker.State() == circuitbreaker.StateOpen {
+		return "", ErrStorageUnavailable
+	}
+	return cb.inner.GeneratePresignedUploadURL(ctx, key, contentType, expiry)
+}
+
+// GeneratePresignedDownloadURL generates a presigned download URL.
+// Like uploads, it is refused while the breaker is open.
+func (cb *CircuitBreaker) GeneratePresignedDownloadURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	if cb.breaker.State() == circuitbreaker.StateOpen {
+		return "", ErrStorageUnavailable
+	}
+	return cb.inner.GeneratePresignedDownloadURL(ctx, key, expiry)
+}
+
+// PutObject uploads an object through the breaker
+func (cb *CircuitBreaker) PutObject(ctx context.Context, key string, contentType string, data []byte) error {
+	return cb.execute(func() error {
+		return cb.inner.PutObject(ctx, key, contentType, data)
+	})
+}
+
+// DeleteObject deletes an object through the breaker
+func (cb *CircuitBreaker) DeleteObject(ctx context.Context, key string) error {
+	return cb.execute(func() error {
+		return cb.inner.DeleteObject(ctx, key)
+	})
+}
+
+// GetObject downloads an object through the breaker
+func (cb *CircuitBreaker) GetObject(ctx context.Context, key string) ([]byte, error) {
+	var data []byte
+	err := cb.execute(func() error {
+		var innerErr error
+		data, innerErr = cb.inner.GetObject(ctx, key)
+		return innerErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ObjectExists checks for an object through the breaker
+func (cb *CircuitBreaker) ObjectExists(ctx context.Context, key string) (bool, error) {
+	var exists bool
+	err := cb.execute(func() error {
+		var innerErr error
+		exists, innerErr = cb.inner.ObjectExists(ctx, key)
+		return innerErr
+	})
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// execute runs fn through the breaker. Errors that do not indicate a storage
+// outage are returned to the caller without being recorded as failures.
+func (cb *CircuitBreaker) execute(fn func() error) error {
+	var callErr error
+	err := cb.breaker.Execute(func() error {
+		callErr = fn()
+		if callErr != nil && !isStorageFailure(callErr) {
+			return nil
+		}
+		return callErr
+	})
+	if errors.Is(err, circuitbreaker.ErrCircuitOpen) {
+		return ErrStorageUnavailable
+	}
+	return callErr
+}
+
+// isStorageFailure reports whether err indicates that storage itself is unhealthy
+func isStorageFailure(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var respErr interface{ HTTPStatusCode() int }
+	if errors.As(err, &respErr) && respErr.HTTPStatusCode() < http.StatusInternalServerError {
+		return false
+	}
+	return true
+}
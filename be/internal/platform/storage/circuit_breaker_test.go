@@ -0,0 +1,255 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/internal/platform/circuitbreaker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errS3Down = errors.New("connection refused")
+
+// fakeStorage is an ObjectStorage whose GetObject result is controlled by the test
+type fakeStorage struct {
+	mu    sync.Mutex
+	err   error
+	calls int
+}
+
+func (f *fakeStorage) setErr(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
+
+func (f *fakeStorage) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func (f *fakeStorage) result() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	return f.err
+}
+
+func (f *fakeStorage) GeneratePresignedUploadURL(ctx context.Context, key string, contentType string, expiry time.Duration) (string, error) {
+	return "https://s3.test/upload/" + key, f.result()
+}
+
+func (f *fakeStorage) GeneratePresignedDownloadURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	return "https://s3.test/download/" + key, f.result()
+}
+
+func (f *fakeStorage) PutObject(ctx context.Context, key string, contentType string, data []byte) error {
+	return f.result()
+}
+
+func (f *fakeStorage) DeleteObject(ctx context.Context, key string) error {
+	return f.result()
+}
+
+func (f *fakeStorage) GetObject(ctx context.Context, key string) ([]byte, error) {
+	if err := f.result(); err != nil {
+		return nil, err
+	}
+	return []byte("data"), nil
+}
+
+func (f *fakeStorage) ObjectExists(ctx context.Context, key string) (bool, error) {
+	return true, f.result()
+}
+
+// httpStatusError mimics the SDK's response error which exposes HTTPStatusCode
+type httpStatusError struct{ status int }
+
+func (e *httpStatusError) Error() string       { return "response error" }
+func (e *httpStatusError) HTTPStatusCode() int { return e.status }
+
+func tripBreaker(t *testing.T, cb *CircuitBreaker, failures int) {
+	t.Helper()
+	for range failures {
+		_, err := cb.GetObject(context.Background(), "key")
+		require.ErrorIs(t, err, errS3Down)
+	}
+}
+
+func TestCircuitBreaker_StateTransitions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("starts closed and passes calls through", func(t *testing.T) {
+		inner := &fakeStorage{}
+		cb := NewCircuitBreaker(inner)
+
+		data, err := cb.GetObject(ctx, "key")
+
+		require.NoError(t, err)
+		assert.Equal(t, []byte("data"), data)
+		assert.Equal(t, circuitbreaker.StateClosed, cb.State())
+	})
+
+	t.Run("stays closed below the failure threshold", func(t *testing.T) {
+		inner := &fakeStorage{err: errS3Down}
+		cb := NewCircuitBreaker(inner)
+
+		tripBreaker(t, cb, s3FailureThreshold-1)
+
+		assert.Equal(t, circuitbreaker.StateClosed, cb.State())
+	})
+
+	t.Run("success resets the consecutive failure count", func(t *testing.T) {
+		inner := &fakeStorage{err: errS3Down}
+		cb := NewCircuitBreaker(inner)
+
+		tripBreaker(t, cb, s3FailureThreshold-1)
+		inner.setErr(nil)
+		require.NoError(t, cb.DeleteObject(ctx, "key"))
+		inner.setErr(errS3Down)
+		tripBreaker(t, cb, s3FailureThreshold-1)
+
+		assert.Equal(t, circuitbreaker.StateClosed, cb.State())
+	})
+
+	t.Run("closed to open after five consecutive failures", func(t *testing.T) {
+		inner := &fakeStorage{err: errS3Down}
+		cb := NewCircuitBreaker(inner)
+
+		tripBreaker(t, cb, s3FailureThreshold)
+
+		assert.Equal(t, circuitbreaker.StateOpen, cb.State())
+	})
+
+	t.Run("open breaker fails fast without calling storage", func(t *testing.T) {
+		inner := &fakeStorage{err: errS3Down}
+		cb := NewCircuitBreaker(inner)
+		tripBreaker(t, cb, s3FailureThreshold)
+		callsBefore := inner.callCount()
+
+		_, getErr := cb.GetObject(ctx, "key")
+		putErr := cb.PutObject(ctx, "key", "application/pdf", []byte("data"))
+		deleteErr := cb.DeleteObject(ctx, "key")
+		_, existsErr := cb.ObjectExists(ctx, "key")
+		_, uploadErr := cb.GeneratePresignedUploadURL(ctx, "key", "application/pdf", time.Minute)
+		_, downloadErr := cb.GeneratePresignedDownloadURL(ctx, "key", time.Minute)
+
+		assert.ErrorIs(t, getErr, ErrStorageUnavailable)
+		assert.ErrorIs(t, putErr, ErrStorageUnavailable)
+		assert.ErrorIs(t, deleteErr, ErrStorageUnavailable)
+		assert.ErrorIs(t, existsErr, ErrStorageUnavailable)
+		assert.ErrorIs(t, uploadErr, ErrStorageUnavailable)
+		assert.ErrorIs(t, downloadErr, ErrStorageUnavailable)
+		assert.Equal(t, callsBefore, inner.callCount())
+	})
+
+	t.Run("open to half-open after the timeout", func(t *testing.T) {
+		inner := &fakeStorage{err: errS3Down}
+		cb := newCircuitBreaker(inner, s3FailureThreshold, 20*time.Millisecond)
+		tripBreaker(t, cb, s3FailureThreshold)
+
+		time.Sleep(30 * time.Millisecond)
+
+		assert.Equal(t, circuitbreaker.StateHalfOpen, cb.State())
+	})
+
+	t.Run("half-open to closed when the probe succeeds", func(t *testing.T) {
+		inner := &fakeStorage{err: errS3Down}
+		cb := newCircuitBreaker(inner, s3FailureThreshold, 20*time.Millisecond)
+		tripBreaker(t, cb, s3FailureThreshold)
+		time.Sleep(30 * time.Millisecond)
+
+		inner.setErr(nil)
+		_, err := cb.GetObject(ctx, "key")
+
+		require.NoError(t, err)
+		assert.Equal(t, circuitbreaker.StateClosed, cb.State())
+	})
+
+	t.Run("half-open to open when the probe fails", func(t *testing.T) {
+		inner := &fakeStorage{err: errS3Down}
+		cb := newCircuitBreaker(inner, s3FailureThreshold, 20*time.Millisecond)
+		tripBreaker(t, cb, s3FailureThreshold)
+		time.Sleep(30 * time.Millisecond)
+
+		_, err := cb.GetObject(ctx, "key")
+
+		assert.ErrorIs(t, err, errS3Down)
+		assert.Equal(t, circuitbreaker.StateOpen, cb.State())
+	})
+
+	t.Run("presigning does not consume the half-open probe", func(t *testing.T) {
+		inner := &fakeStorage{err: errS3Down}
+		cb := newCircuitBreaker(inner, s3FailureThreshold, 20*time.Millisecond)
+		tripBreaker(t, cb, s3FailureThreshold)
+		time.Sleep(30 * time.Millisecond)
+
+		inner.setErr(nil)
+		_, err := cb.GeneratePresignedDownloadURL(ctx, "key", time.Minute)
+
+		require.NoError(t, err)
+		assert.Equal(t, circuitbreaker.StateHalfOpen, cb.State())
+	})
+}
+
+func TestCircuitBreaker_NonFailureErrors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("client errors do not open the breaker", func(t *testing.T) {
+		notFound := &httpStatusError{status: 404}
+		inner := &fakeStorage{err: notFound}
+		cb := NewCircuitBreaker(inner)
+
+		for range s3FailureThreshold * 2 {
+			_, err := cb.GetObject(ctx, "missing")
+			assert.ErrorIs(t, err, notFound)
+		}
+
+		assert.Equal(t, circuitbreaker.StateClosed, cb.State())
+	})
+
+	t.Run("server errors open the breaker", func(t *testing.T) {
+		inner := &fakeStorage{err: &httpStatusError{status: 503}}
+		cb := NewCircuitBreaker(inner)
+
+		for range s3FailureThreshold {
+			_, _ = cb.GetObject(ctx, "key")
+		}
+
+		assert.Equal(t, circuitbreaker.StateOpen, cb.State())
+	})
+
+	t.Run("canceled requests do not open the breaker", func(t *testing.T) {
+		inner := &fakeStorage{err: context.Canceled}
+		cb := NewCircuitBreaker(inner)
+
+		for range s3FailureThreshold {
+			_, _ = cb.GetObject(ctx, "key")
+		}
+
+		assert.Equal(t, circuitbreaker.StateClosed, cb.State())
+	})
+}
+
+func TestCircuitBreaker_ConcurrentUse(t *testing.T) {
+	inner := &fakeStorage{err: errS3Down}
+	cb := NewCircuitBreaker(inner)
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = cb.GetObject(context.Background(), "key")
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, circuitbreaker.StateOpen, cb.State())
+	assert.LessOrEqual(t, inner.callCount(), 50)
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"strings"
+)
+
+// Document content types recognised by DetectDocumentType
+const (
+	ContentTypePDF  = "application/pdf"
+	ContentTypeDOC  = "application/msword"
+	ContentTypeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	ContentTypeText = "text/plain"
+)
+
+var (
+	pdfMagic = []byte("%PDF-")
+	// Word 97-2003 files are OLE compound documents with a WordDocument stream
+	oleMagic         = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+	wordStreamName   = []byte("W\x00o\x00r\x00d\x00D\x00o\x00c\x00u\x00m\x00e\x00n\x00t\x00")
+	zipMagic         = []byte("PK\x03\x04")
+	docxMainDocument = "word/document.xml"
+)
+
+// DetectDocumentType returns the content type of a PDF, DOC, DOCX or plain text
+// file from its contents, regardless of the type the client declared.
+// It returns false for any other kind of file.
+func DetectDocumentType(data []byte) (string, bool) {
+	switch {
+	case bytes.HasPrefix(data, pdfMagic):
+		return ContentTypePDF, true
+	case bytes.HasPrefix(data, oleMagic):
+		if bytes.Contains(data, wordStreamName) {
+			return ContentTypeDOC, true
+		}
+		return "", false
+	case bytes.HasPrefix(data, zipMagic):
+		if isDOCX(data) {
+			return ContentTypeDOCX, true
+		}
+		return "", false
+	}
+	if len(data) > 0 && strings.HasPrefix(http.DetectContentType(data), ContentTypeText) {
+		return ContentTypeText, true
+	}
+	return "", false
+}
+
+// isDOCX reports whether data is a zip archive holding a Word main document
+func isDOCX(data []byte) bool {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return false
+	}
+	for _, file := range archive.File {
+		if file.Name == docxMainDocument {
+			return true
+		}
+	}
+	return false
+}
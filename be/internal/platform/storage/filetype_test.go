@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func zipWith(t *testing.T, names ...string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range names {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte("<xml/>"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestDetectDocumentType(t *testing.T) {
+	doc := append(append([]byte{}, oleMagic...), wordStreamName...)
+	xls := append(append([]byte{}, oleMagic...), []byte("W\x00o\x00r\x00k\x00b\x00o\x00o\x00k\x00")...)
+
+	tests := []struct {
+		name        string
+		data        []byte
+		contentType string
+		ok          bool
+	}{
+		{"pdf", []byte("%PDF-1.7\n%\xe2\xe3\xcf\xd3"), ContentTypePDF, true},
+		{"doc", doc, ContentTypeDOC, true},
+		{"docx", zipWith(t, "[Content_Types].xml", "word/document.xml"), ContentTypeDOCX, true},
+		{"text", []byte("Dear hiring manager,\nI am writing to apply."), ContentTypeText, true},
+		{"other OLE document", xls, "", false},
+		{"zip without a Word document", zipWith(t, "readme.txt"), "", false},
+		{"png", []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"), "", false},
+		{"html", []byte("<html><body>resume</body></html>"), "", false},
+		{"empty", nil, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contentType, ok := DetectDocumentType(tt.data)
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.contentType, contentType)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -109,6 +110,22 @@ func (c *S3Client) GeneratePresignedDownloadURL(ctx context.Context, key string,
 	return request.URL, nil
 }
 
+// PutObject uploads data to S3 under the given key
+func (c *S3Client) PutObject(ctx context.Context, key string, contentType string, data []byte) error {
+	_, err := c.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(c.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+
+	return nil
+}
+
 // DeleteObject deletes an object from S3
 func (c *S3Client) DeleteObject(ctx context.Context, key string) error {
 	_, err := c.client.DeleteObject(ctx, &s3.DeleteObjectInput{
@@ -0,0 +1,66 @@
+// Package urlutil provides helpers for working with user-supplied URLs.
+package urlutil
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// ErrInvalidURL is returned when a URL cannot be parsed or has no valid host.
+var ErrInvalidURL = errors.New("invalid URL")
+
+// NormalizeURL converts a URL into a canonical form so that the same posting
+// pasted from different places is stored identically:
+//
+//   - scheme and host are lowercased (https is assumed when the scheme is missing)
+//   - a leading "www." is stripped from the host
+//   - utm_* tracking parameters are removed from the query
+//   - trailing slashes are removed from the path
+func NormalizeURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", ErrInvalidURL
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", ErrInvalidURL
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", ErrInvalidURL
+	}
+
+	host := strings.ToLower(u.Hostname())
+	host = strings.TrimPrefix(host, "www.")
+	if host == "" || strings.HasPrefix(host, ".") || strings.HasSuffix(host, ".") || strings.ContainsAny(host, " _") {
+		return "", ErrInvalidURL
+	}
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if port := u.Port(); port != "" {
+		host = host + ":" + port
+	}
+	u.Host = host
+
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = strings.TrimRight(u.RawPath, "/")
+
+	if u.RawQuery != "" {
+		query := u.Query()
+		for key := range query {
+			if strings.HasPrefix(strings.ToLower(key), "utm_") {
+				query.Del(key)
+			}
+		}
+		u.RawQuery = query.Encode()
+	}
+
+	return u.String(), nil
+}
@@ -0,0 +1,73 @@
+package urlutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "already canonical", input: "https://linkedin.com/jobs/123", expected: "https://linkedin.com/jobs/123"},
+		{name: "strips www", input: "https://www.linkedin.com/jobs/123", expected: "https://linkedin.com/jobs/123"},
+		{name: "strips trailing slash", input: "https://linkedin.com/jobs/123/", expected: "https://linkedin.com/jobs/123"},
+		{name: "strips multiple trailing slashes", input: "https://linkedin.com/jobs/123///", expected: "https://linkedin.com/jobs/123"},
+		{name: "root path slash", input: "https://example.com/", expected: "https://example.com"},
+		{name: "lowercases scheme", input: "HTTPS://example.com/jobs", expected: "https://example.com/jobs"},
+		{name: "lowercases host", input: "https://Jobs.Example.COM/Careers", expected: "https://jobs.example.com/Careers"},
+		{name: "lowercases host and strips uppercase www", input: "https://WWW.Example.com/a", expected: "https://example.com/a"},
+		{name: "preserves path case", input: "https://example.com/Jobs/Senior-Go", expected: "https://example.com/Jobs/Senior-Go"},
+		{name: "strips single utm param", input: "https://example.com/job?utm_source=twitter", expected: "https://example.com/job"},
+		{name: "strips all utm params", input: "https://example.com/job?utm_source=a&utm_medium=b&utm_campaign=c&utm_term=d&utm_content=e", expected: "https://example.com/job"},
+		{name: "strips utm params case-insensitively", input: "https://example.com/job?UTM_Source=a", expected: "https://example.com/job"},
+		{name: "keeps non-utm params", input: "https://example.com/job?id=42&utm_source=x", expected: "https://example.com/job?id=42"},
+		{name: "sorts remaining params", input: "https://example.com/job?b=2&a=1", expected: "https://example.com/job?a=1&b=2"},
+		{name: "strips trailing slash before query", input: "https://example.com/job/?id=7", expected: "https://example.com/job?id=7"},
+		{name: "keeps http scheme", input: "http://example.com/job", expected: "http://example.com/job"},
+		{name: "keeps port", input: "https://www.example.com:8443/job/", expected: "https://example.com:8443/job"},
+		{name: "adds scheme when missing", input: "www.example.com/job", expected: "https://example.com/job"},
+		{name: "trims surrounding whitespace", input: "  https://example.com/job  ", expected: "https://example.com/job"},
+		{name: "keeps fragment", input: "https://example.com/careers#/job/9", expected: "https://example.com/careers#/job/9"},
+		{name: "keeps subdomain other than www", input: "https://boards.greenhouse.io/acme/jobs/1", expected: "https://boards.greenhouse.io/acme/jobs/1"},
+		{name: "all rules combined", input: "HTTPS://WWW.Example.com/Jobs/1/?utm_source=li&ref=abc", expected: "https://example.com/Jobs/1?ref=abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NormalizeURL(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestNormalizeURL_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty string", input: ""},
+		{name: "whitespace only", input: "   "},
+		{name: "unsupported scheme", input: "ftp://example.com/job"},
+		{name: "javascript scheme", input: "javascript://alert(1)"},
+		{name: "missing host", input: "https:///jobs/1"},
+		{name: "only www", input: "https://www."},
+		{name: "malformed", input: "https://exa mple.com/job"},
+		{name: "bad port", input: "https://example.com:abc/job"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NormalizeURL(tt.input)
+
+			assert.ErrorIs(t, err, ErrInvalidURL)
+			assert.Empty(t, result)
+		})
+	}
+}
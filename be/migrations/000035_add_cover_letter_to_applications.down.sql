@@ -0,0 +1,3 @@
+ALTER TABLE applications
+    DROP COLUMN IF EXISTS cover_letter_storage_type,
+    DROP COLUMN IF EXISTS cover_letter_url;
@@ -0,0 +1,3 @@
+ALTER TABLE applications
+    ADD COLUMN cover_letter_url TEXT,
+    ADD COLUMN cover_letter_storage_type VARCHAR(20) CHECK (cover_letter_storage_type IN ('external', 's3'));
@@ -0,0 +1 @@
+ALTER TABLE applications ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_applications_share_token;
+ALTER TABLE applications DROP COLUMN IF EXISTS share_token;
@@ -0,0 +1,3 @@
+ALTER TABLE applications ADD COLUMN share_token UUID;
+
+CREATE UNIQUE INDEX idx_applications_share_token ON applications (share_token) WHERE share_token IS NOT NULL;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS goals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    goal_type VARCHAR(20) NOT NULL CHECK (goal_type IN ('applications', 'offers')),
+    target_value INTEGER NOT NULL CHECK (target_value > 0),
+    target_date DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_goals_user_id ON goals (user_id, created_at DESC);
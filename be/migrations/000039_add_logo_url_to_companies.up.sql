@@ -0,0 +1 @@
+ALTER TABLE companies ADD COLUMN logo_url TEXT;
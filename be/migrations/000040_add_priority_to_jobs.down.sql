@@ -0,0 +1,6 @@
+-- Remove priority index
+DROP INDEX IF EXISTS idx_jobs_user_priority_created;
+
+-- Remove priority column from jobs table
+ALTER TABLE jobs
+DROP COLUMN IF EXISTS priority;
@@ -0,0 +1,6 @@
+-- Add priority column to jobs table
+ALTER TABLE jobs
+ADD COLUMN priority VARCHAR(10) NOT NULL DEFAULT 'medium' CHECK (priority IN ('low', 'medium', 'high'));
+
+-- Index the high-priority shortcut lookup
+CREATE INDEX idx_jobs_user_priority_created ON jobs(user_id, priority, created_at DESC);
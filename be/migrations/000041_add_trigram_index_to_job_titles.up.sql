@@ -0,0 +1,4 @@
+-- Enable trigram matching for similar job title lookups
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_jobs_title_trgm ON jobs USING gin (title gin_trgm_ops);
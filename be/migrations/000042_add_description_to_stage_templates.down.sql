@@ -0,0 +1,3 @@
+-- Remove description column from stage templates
+ALTER TABLE stage_templates
+DROP COLUMN IF EXISTS description;
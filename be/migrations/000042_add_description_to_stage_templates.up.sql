@@ -0,0 +1,3 @@
+-- Add optional description to stage templates
+ALTER TABLE stage_templates
+ADD COLUMN description TEXT;
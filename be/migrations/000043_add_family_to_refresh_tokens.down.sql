@@ -0,0 +1,7 @@
+-- Remove refresh token family index
+DROP INDEX IF EXISTS idx_refresh_tokens_family_id;
+
+-- Remove family columns from refresh tokens
+ALTER TABLE refresh_tokens
+DROP COLUMN IF EXISTS generation,
+DROP COLUMN IF EXISTS family_id;
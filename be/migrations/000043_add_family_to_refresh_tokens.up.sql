@@ -0,0 +1,10 @@
+-- Group rotated refresh tokens into families so a reused (already rotated)
+-- token can revoke every token descended from the same login
+ALTER TABLE refresh_tokens ADD COLUMN family_id UUID;
+ALTER TABLE refresh_tokens ADD COLUMN generation INT NOT NULL DEFAULT 0;
+
+-- Existing tokens each start their own family
+UPDATE refresh_tokens SET family_id = id WHERE family_id IS NULL;
+ALTER TABLE refresh_tokens ALTER COLUMN family_id SET NOT NULL;
+
+CREATE INDEX idx_refresh_tokens_family_id ON refresh_tokens(family_id);
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS update_tag_relations_updated_at ON tag_relations;
+DROP INDEX IF EXISTS idx_tag_relations_added_by_user_id;
+ALTER TABLE tag_relations DROP COLUMN IF EXISTS added_by_user_id;
+ALTER TABLE tag_relations DROP COLUMN IF EXISTS updated_at;
@@ -0,0 +1,18 @@
+-- Audit columns for tag relations: who attached the tag and when the row last changed
+ALTER TABLE tag_relations ADD COLUMN updated_at TIMESTAMP;
+ALTER TABLE tag_relations ADD COLUMN added_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL;
+
+-- Existing relations were added by the tag owner and never changed
+UPDATE tag_relations tr
+SET updated_at = tr.created_at,
+    added_by_user_id = t.user_id
+FROM tags t
+WHERE t.id = tr.tag_id;
+
+ALTER TABLE tag_relations ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE tag_relations ALTER COLUMN updated_at SET NOT NULL;
+
+CREATE INDEX idx_tag_relations_added_by_user_id ON tag_relations(added_by_user_id);
+
+CREATE TRIGGER update_tag_relations_updated_at BEFORE UPDATE ON tag_relations
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
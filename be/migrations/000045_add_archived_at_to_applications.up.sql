@@ -0,0 +1,5 @@
+-- Record when an application was archived
+ALTER TABLE applications ADD COLUMN archived_at TIMESTAMP;
+
+-- Already archived applications fall back to their last update time
+UPDATE applications SET archived_at = updated_at WHERE status = 'archived' AND archived_at IS NULL;
@@ -0,0 +1,2 @@
+-- Pinned comments are listed before the rest of an application's comments
+ALTER TABLE comments ADD COLUMN is_pinned BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_comments_search;
+DROP INDEX IF EXISTS idx_applications_search;
+DROP INDEX IF EXISTS idx_companies_search;
+DROP INDEX IF EXISTS idx_jobs_search;
@@ -0,0 +1,9 @@
+-- Full-text search indexes; the expressions must match the search queries exactly.
+-- The 'simple' configuration avoids English-only stemming for multilingual content.
+CREATE INDEX IF NOT EXISTS idx_jobs_search ON jobs USING gin (to_tsvector('simple', coalesce(title, '') || ' ' || coalesce(notes, '')));
+
+CREATE INDEX IF NOT EXISTS idx_companies_search ON companies USING gin (to_tsvector('simple', name));
+
+CREATE INDEX IF NOT EXISTS idx_applications_search ON applications USING gin (to_tsvector('simple', name));
+
+CREATE INDEX IF NOT EXISTS idx_comments_search ON comments USING gin (to_tsvector('simple', content));
@@ -0,0 +1,9 @@
+ALTER TABLE applications
+DROP COLUMN IF EXISTS negotiated_salary,
+DROP COLUMN IF EXISTS offered_salary;
+
+ALTER TABLE jobs
+DROP CONSTRAINT IF EXISTS jobs_salary_range_check,
+DROP COLUMN IF EXISTS salary_currency,
+DROP COLUMN IF EXISTS salary_max,
+DROP COLUMN IF EXISTS salary_min;
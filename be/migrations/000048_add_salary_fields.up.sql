@@ -0,0 +1,12 @@
+-- Advertised salary range of a job, in whole units of salary_currency
+ALTER TABLE jobs
+ADD COLUMN salary_min INTEGER CHECK (salary_min >= 0),
+ADD COLUMN salary_max INTEGER CHECK (salary_max >= 0),
+ADD COLUMN salary_currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+ADD CONSTRAINT jobs_salary_range_check CHECK (salary_min IS NULL OR salary_max IS NULL OR salary_min <= salary_max);
+
+-- Salary offered for an application and the amount negotiated in a counter-offer,
+-- in the currency of the job
+ALTER TABLE applications
+ADD COLUMN offered_salary INTEGER CHECK (offered_salary >= 0),
+ADD COLUMN negotiated_salary INTEGER CHECK (negotiated_salary >= 0);
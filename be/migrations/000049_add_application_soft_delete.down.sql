@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_applications_trash;
+ALTER TABLE applications DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,4 @@
+-- Deleted applications go to the trash until restored or permanently deleted
+ALTER TABLE applications ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_applications_trash ON applications(user_id, deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS company_contacts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    title VARCHAR(255),
+    email VARCHAR(255),
+    linkedin_url TEXT,
+    notes TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_company_contacts_company_id ON company_contacts (company_id, name);
+CREATE INDEX idx_company_contacts_user_id ON company_contacts (user_id);
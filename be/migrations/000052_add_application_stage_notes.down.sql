@@ -0,0 +1 @@
+ALTER TABLE application_stages DROP COLUMN IF EXISTS notes;
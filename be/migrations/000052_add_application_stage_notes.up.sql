@@ -0,0 +1,2 @@
+-- Notes about a stage, edited in place rather than appended like comments
+ALTER TABLE application_stages ADD COLUMN notes TEXT;
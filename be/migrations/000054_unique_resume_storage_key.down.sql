@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_resumes_storage_key;
+CREATE INDEX idx_resumes_storage_key ON resumes(storage_key) WHERE storage_key IS NOT NULL;
@@ -0,0 +1,4 @@
+-- A storage key belongs to a single resume, so deleting one resume cannot
+-- remove a file another resume still points to
+DROP INDEX IF EXISTS idx_resumes_storage_key;
+CREATE UNIQUE INDEX idx_resumes_storage_key ON resumes(storage_key) WHERE storage_key IS NOT NULL;
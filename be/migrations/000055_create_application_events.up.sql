@@ -0,0 +1,14 @@
+-- Append-only audit trail of changes to applications, written in the same
+-- transaction as the change it describes. Events go away only with the
+-- application when it is deleted permanently.
+CREATE TABLE IF NOT EXISTS application_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_application_events_application_created
+    ON application_events(application_id, created_at DESC, id DESC);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_applications_user_deadline;
+
+ALTER TABLE applications DROP COLUMN IF EXISTS deadline_at;
@@ -0,0 +1,4 @@
+-- Date by which the user still has to act on an application, e.g. submit an assessment
+ALTER TABLE applications ADD COLUMN deadline_at TIMESTAMPTZ;
+
+CREATE INDEX idx_applications_user_deadline ON applications (user_id, deadline_at);
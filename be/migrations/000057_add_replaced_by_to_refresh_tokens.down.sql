@@ -0,0 +1,2 @@
+-- Remove replacement tracking from refresh tokens
+ALTER TABLE refresh_tokens DROP COLUMN IF EXISTS replaced_by;
@@ -0,0 +1,3 @@
+-- Record which token replaced a rotated refresh token, so presenting a rotated
+-- token again can be told apart from one revoked by logout
+ALTER TABLE refresh_tokens ADD COLUMN replaced_by TEXT;
@@ -0,0 +1,4 @@
+-- Remove client info from refresh tokens
+ALTER TABLE refresh_tokens
+DROP COLUMN IF EXISTS ip_address,
+DROP COLUMN IF EXISTS user_agent;
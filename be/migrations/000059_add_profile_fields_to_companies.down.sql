@@ -0,0 +1,10 @@
+-- Remove company profile details
+DROP INDEX IF EXISTS idx_companies_user_size;
+DROP INDEX IF EXISTS idx_companies_user_industry;
+
+ALTER TABLE companies
+DROP COLUMN IF EXISTS founded_year,
+DROP COLUMN IF EXISTS linkedin_url,
+DROP COLUMN IF EXISTS website_url,
+DROP COLUMN IF EXISTS size,
+DROP COLUMN IF EXISTS industry;
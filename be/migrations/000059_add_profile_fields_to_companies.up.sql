@@ -0,0 +1,10 @@
+-- Company profile details used for filtering and research
+ALTER TABLE companies ADD COLUMN industry VARCHAR(100);
+ALTER TABLE companies ADD COLUMN size VARCHAR(50)
+    CHECK (size IN ('startup', 'small', 'medium', 'large', 'enterprise'));
+ALTER TABLE companies ADD COLUMN website_url TEXT;
+ALTER TABLE companies ADD COLUMN linkedin_url TEXT;
+ALTER TABLE companies ADD COLUMN founded_year SMALLINT;
+
+CREATE INDEX idx_companies_user_industry ON companies(user_id, LOWER(industry)) WHERE industry IS NOT NULL;
+CREATE INDEX idx_companies_user_size ON companies(user_id, size) WHERE size IS NOT NULL;
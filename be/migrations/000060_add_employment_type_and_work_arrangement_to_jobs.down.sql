@@ -0,0 +1,4 @@
+-- Remove employment type and work arrangement from jobs
+ALTER TABLE jobs
+DROP COLUMN IF EXISTS work_arrangement,
+DROP COLUMN IF EXISTS employment_type;
@@ -0,0 +1,5 @@
+-- Structured employment type and work arrangement of a job
+ALTER TABLE jobs ADD COLUMN employment_type VARCHAR(50)
+    CHECK (employment_type IN ('full_time', 'part_time', 'contract', 'internship', 'freelance'));
+ALTER TABLE jobs ADD COLUMN work_arrangement VARCHAR(50)
+    CHECK (work_arrangement IN ('remote', 'onsite', 'hybrid'));
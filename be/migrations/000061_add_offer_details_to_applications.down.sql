@@ -0,0 +1,5 @@
+-- Remove offer details from applications
+ALTER TABLE applications
+DROP COLUMN IF EXISTS benefits_notes,
+DROP COLUMN IF EXISTS start_date,
+DROP COLUMN IF EXISTS equity_percent;
@@ -0,0 +1,5 @@
+-- Offer details recorded alongside the offered salary, used to compare offers
+ALTER TABLE applications ADD COLUMN equity_percent DECIMAL(5,2)
+    CHECK (equity_percent >= 0 AND equity_percent <= 100);
+ALTER TABLE applications ADD COLUMN start_date DATE;
+ALTER TABLE applications ADD COLUMN benefits_notes TEXT;
@@ -0,0 +1,4 @@
+-- Remove the referral contact from applications
+ALTER TABLE applications
+DROP COLUMN IF EXISTS referral_contact_email,
+DROP COLUMN IF EXISTS referral_contact_name;
@@ -0,0 +1,3 @@
+-- Who referred the user for an application, instead of a note on the job
+ALTER TABLE applications ADD COLUMN referral_contact_name VARCHAR(255);
+ALTER TABLE applications ADD COLUMN referral_contact_email VARCHAR(255);
@@ -0,0 +1,10 @@
+-- Contacts involved in an application, e.g. the recruiter or an interviewer
+CREATE TABLE IF NOT EXISTS application_contacts (
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    contact_id UUID NOT NULL REFERENCES company_contacts(id) ON DELETE CASCADE,
+    role VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (application_id, contact_id)
+);
+
+CREATE INDEX idx_application_contacts_contact_id ON application_contacts (contact_id);
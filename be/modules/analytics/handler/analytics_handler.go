@@ -1,21 +1,65 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"path"
+	"strconv"
+	"time"
 
 	"github.com/andreypavlenko/jobber/internal/platform/auth"
 	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
-	_ "github.com/andreypavlenko/jobber/modules/analytics/model" // swag: OverviewAnalytics
+	"github.com/andreypavlenko/jobber/modules/analytics/model"
 	"github.com/andreypavlenko/jobber/modules/analytics/service"
 	"github.com/gin-gonic/gin"
 )
 
+// CacheInvalidator flushes a user's cached analytics
+type CacheInvalidator interface {
+	InvalidateAnalytics(ctx context.Context, userID string) error
+}
+
 type AnalyticsHandler struct {
-	service *service.AnalyticsService
+	service service.Analytics
+	cache   CacheInvalidator
+}
+
+// NewAnalyticsHandler creates an analytics handler; cache is flushed by
+// InvalidateCache and may be nil when analytics are not cached
+func NewAnalyticsHandler(service service.Analytics, cache CacheInvalidator) *AnalyticsHandler {
+	return &AnalyticsHandler{service: service, cache: cache}
 }
 
-func NewAnalyticsHandler(service *service.AnalyticsService) *AnalyticsHandler {
-	return &AnalyticsHandler{service: service}
+// parseFilter builds the analytics filter from the optional from/to query
+// parameters, responding with 400 when a date is malformed
+func parseFilter(c *gin.Context, userID string) (model.AnalyticsFilter, bool) {
+	filter := model.AnalyticsFilter{UserID: userID}
+	for _, bound := range []struct {
+		param string
+		dst   **time.Time
+	}{{"from", &filter.From}, {"to", &filter.To}} {
+		raw := c.Query(bound.param)
+		if raw == "" {
+			continue
+		}
+		date, err := time.Parse(model.ReportDateLayout, raw)
+		if err != nil {
+			respondWithAnalyticsError(c, model.ErrInvalidDateRange)
+			return filter, false
+		}
+		*bound.dst = &date
+	}
+	return filter, true
+}
+
+// respondWithAnalyticsError responds 400 for invalid query parameters and 500 otherwise
+func respondWithAnalyticsError(c *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	switch model.GetErrorCode(err) {
+	case model.CodeInvalidGranularity, model.CodeInvalidPeriod, model.CodeInvalidMonths, model.CodeInvalidTop, model.CodeInvalidDateRange:
+		statusCode = http.StatusBadRequest
+	}
+	httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
 }
 
 // GetOverview godoc
@@ -24,7 +68,10 @@ func NewAnalyticsHandler(service *service.AnalyticsService) *AnalyticsHandler {
 // @Tags analytics
 // @Security BearerAuth
 // @Produce json
+// @Param from query string false "Only applications applied on or after this date (YYYY-MM-DD)"
+// @Param to query string false "Only applications applied on or before this date (YYYY-MM-DD)"
 // @Success 200 {object} model.OverviewAnalytics
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid date range"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 500 {object} httpPlatform.ErrorResponse
 // @Router /analytics/overview [get]
@@ -33,12 +80,19 @@ func (h *AnalyticsHandler) GetOverview(c *gin.Context) {
 	if !ok {
 		return
 	}
+	filter, ok := parseFilter(c, userID)
+	if !ok {
+		return
+	}
 
-	analytics, err := h.service.GetOverview(c.Request.Context(), userID)
+	analytics, err := h.service.GetOverview(c.Request.Context(), filter)
 	if err != nil {
-		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "ANALYTICS_ERROR", "Failed to get overview analytics")
+		respondWithAnalyticsError(c, err)
 		return
 	}
+	// The dashboard loads the funnel and stage metrics alongside the overview
+	base := path.Dir(c.Request.URL.Path)
+	httpPlatform.AddPreloadLinks(c, base+"/funnel", base+"/stages")
 	httpPlatform.RespondWithData(c, http.StatusOK, analytics)
 }
 
@@ -48,7 +102,10 @@ func (h *AnalyticsHandler) GetOverview(c *gin.Context) {
 // @Tags analytics
 // @Security BearerAuth
 // @Produce json
+// @Param from query string false "Only applications applied on or after this date (YYYY-MM-DD)"
+// @Param to query string false "Only applications applied on or before this date (YYYY-MM-DD)"
 // @Success 200 {object} model.FunnelAnalytics
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid date range"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 500 {object} httpPlatform.ErrorResponse
 // @Router /analytics/funnel [get]
@@ -57,10 +114,14 @@ func (h *AnalyticsHandler) GetFunnel(c *gin.Context) {
 	if !ok {
 		return
 	}
+	filter, ok := parseFilter(c, userID)
+	if !ok {
+		return
+	}
 
-	analytics, err := h.service.GetFunnel(c.Request.Context(), userID)
+	analytics, err := h.service.GetFunnel(c.Request.Context(), filter)
 	if err != nil {
-		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "ANALYTICS_ERROR", "Failed to get funnel analytics")
+		respondWithAnalyticsError(c, err)
 		return
 	}
 	httpPlatform.RespondWithData(c, http.StatusOK, analytics)
@@ -72,7 +133,10 @@ func (h *AnalyticsHandler) GetFunnel(c *gin.Context) {
 // @Tags analytics
 // @Security BearerAuth
 // @Produce json
+// @Param from query string false "Only applications applied on or after this date (YYYY-MM-DD)"
+// @Param to query string false "Only applications applied on or before this date (YYYY-MM-DD)"
 // @Success 200 {object} model.StageTimeAnalytics
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid date range"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 500 {object} httpPlatform.ErrorResponse
 // @Router /analytics/stages [get]
@@ -81,10 +145,50 @@ func (h *AnalyticsHandler) GetStageTime(c *gin.Context) {
 	if !ok {
 		return
 	}
+	filter, ok := parseFilter(c, userID)
+	if !ok {
+		return
+	}
 
-	analytics, err := h.service.GetStageTime(c.Request.Context(), userID)
+	analytics, err := h.service.GetStageTime(c.Request.Context(), filter)
 	if err != nil {
-		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "ANALYTICS_ERROR", "Failed to get stage time analytics")
+		respondWithAnalyticsError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, analytics)
+}
+
+// GetStageBottlenecks godoc
+// @Summary Get stage bottlenecks
+// @Description Get the slowest pipeline stages with their drop-off rates. A stage is a bottleneck when its average duration exceeds 1.5 times the average across all stages.
+// @Tags analytics
+// @Security BearerAuth
+// @Produce json
+// @Param top query int false "Number of stages to return, 1-20 (default: 3)"
+// @Success 200 {object} model.StageBottleneckAnalytics
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid top"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /analytics/stages/bottlenecks [get]
+func (h *AnalyticsHandler) GetStageBottlenecks(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	top := model.DefaultBottleneckTop
+	if raw := c.Query("top"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			respondWithAnalyticsError(c, model.ErrInvalidTop)
+			return
+		}
+		top = parsed
+	}
+
+	analytics, err := h.service.GetStageBottlenecks(c.Request.Context(), userID, top)
+	if err != nil {
+		respondWithAnalyticsError(c, err)
 		return
 	}
 	httpPlatform.RespondWithData(c, http.StatusOK, analytics)
@@ -96,7 +200,10 @@ func (h *AnalyticsHandler) GetStageTime(c *gin.Context) {
 // @Tags analytics
 // @Security BearerAuth
 // @Produce json
+// @Param from query string false "Only applications applied on or after this date (YYYY-MM-DD)"
+// @Param to query string false "Only applications applied on or before this date (YYYY-MM-DD)"
 // @Success 200 {object} model.ResumeAnalytics
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid date range"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 500 {object} httpPlatform.ErrorResponse
 // @Router /analytics/resumes [get]
@@ -105,10 +212,14 @@ func (h *AnalyticsHandler) GetResumeEffectiveness(c *gin.Context) {
 	if !ok {
 		return
 	}
+	filter, ok := parseFilter(c, userID)
+	if !ok {
+		return
+	}
 
-	analytics, err := h.service.GetResumeEffectiveness(c.Request.Context(), userID)
+	analytics, err := h.service.GetResumeEffectiveness(c.Request.Context(), filter)
 	if err != nil {
-		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "ANALYTICS_ERROR", "Failed to get resume effectiveness analytics")
+		respondWithAnalyticsError(c, err)
 		return
 	}
 	httpPlatform.RespondWithData(c, http.StatusOK, analytics)
@@ -120,7 +231,10 @@ func (h *AnalyticsHandler) GetResumeEffectiveness(c *gin.Context) {
 // @Tags analytics
 // @Security BearerAuth
 // @Produce json
+// @Param from query string false "Only applications applied on or after this date (YYYY-MM-DD)"
+// @Param to query string false "Only applications applied on or before this date (YYYY-MM-DD)"
 // @Success 200 {object} model.SourceAnalytics
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid date range"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 500 {object} httpPlatform.ErrorResponse
 // @Router /analytics/sources [get]
@@ -129,15 +243,163 @@ func (h *AnalyticsHandler) GetSourceAnalytics(c *gin.Context) {
 	if !ok {
 		return
 	}
+	filter, ok := parseFilter(c, userID)
+	if !ok {
+		return
+	}
+
+	analytics, err := h.service.GetSourceAnalytics(c.Request.Context(), filter)
+	if err != nil {
+		respondWithAnalyticsError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, analytics)
+}
+
+// GetWorkArrangementAnalytics godoc
+// @Summary Get work arrangement analytics
+// @Description Get the number of applications in each status per job work arrangement (remote, onsite, hybrid or unspecified)
+// @Tags analytics
+// @Security BearerAuth
+// @Produce json
+// @Param from query string false "Only applications applied on or after this date (YYYY-MM-DD)"
+// @Param to query string false "Only applications applied on or before this date (YYYY-MM-DD)"
+// @Success 200 {object} model.WorkArrangementAnalytics
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid date range"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /analytics/work-arrangement [get]
+func (h *AnalyticsHandler) GetWorkArrangementAnalytics(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	filter, ok := parseFilter(c, userID)
+	if !ok {
+		return
+	}
 
-	analytics, err := h.service.GetSourceAnalytics(c.Request.Context(), userID)
+	analytics, err := h.service.GetWorkArrangementAnalytics(c.Request.Context(), filter)
 	if err != nil {
-		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "ANALYTICS_ERROR", "Failed to get source analytics")
+		respondWithAnalyticsError(c, err)
 		return
 	}
 	httpPlatform.RespondWithData(c, http.StatusOK, analytics)
 }
 
+// GetSourceTrend godoc
+// @Summary Get source trend
+// @Description Get the number of applications per job source for each of the last months
+// @Tags analytics
+// @Security BearerAuth
+// @Produce json
+// @Param months query int false "Number of months including the current one, 1-24 (default: 6)"
+// @Success 200 {object} model.SourceTrend
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid months"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /analytics/sources/trend [get]
+func (h *AnalyticsHandler) GetSourceTrend(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	months := model.DefaultSourceTrendMonths
+	if raw := c.Query("months"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			respondWithAnalyticsError(c, model.ErrInvalidMonths)
+			return
+		}
+		months = parsed
+	}
+
+	trend, err := h.service.GetSourceTrend(c.Request.Context(), userID, months)
+	if err != nil {
+		respondWithAnalyticsError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, trend)
+}
+
+// GetCohortAnalytics godoc
+// @Summary Get cohort analytics
+// @Description Get outcome metrics grouped by the period applications were started
+// @Tags analytics
+// @Security BearerAuth
+// @Produce json
+// @Param granularity query string false "Cohort period: week, month, quarter (default: month)"
+// @Success 200 {object} model.CohortAnalytics
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid granularity"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /analytics/cohort [get]
+func (h *AnalyticsHandler) GetCohortAnalytics(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	analytics, err := h.service.GetCohortAnalytics(c.Request.Context(), userID, c.Query("granularity"))
+	if err != nil {
+		respondWithAnalyticsError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, analytics)
+}
+
+// GetActivityTimeSeries godoc
+// @Summary Get application activity over time
+// @Description Get the number of applications per period for a dashboard chart: the last 12 weeks, 12 months or 8 quarters, oldest first. Each bucket is dated by the first day of its period; periods without applications have a zero count.
+// @Tags analytics
+// @Security BearerAuth
+// @Produce json
+// @Param period query string false "Bucket period: week, month, quarter (default: week)"
+// @Success 200 {object} model.ActivityAnalytics
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid period"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /analytics/activity [get]
+func (h *AnalyticsHandler) GetActivityTimeSeries(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	activity, err := h.service.GetActivityTimeSeries(c.Request.Context(), userID, c.Query("period"))
+	if err != nil {
+		respondWithAnalyticsError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, activity)
+}
+
+// InvalidateCache godoc
+// @Summary Invalidate cached analytics
+// @Description Flush all cached analytics of the authenticated user so the next requests are recomputed
+// @Tags analytics
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /analytics/cache/invalidate [post]
+func (h *AnalyticsHandler) InvalidateCache(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	if h.cache != nil {
+		if err := h.cache.InvalidateAnalytics(c.Request.Context(), userID); err != nil {
+			respondWithAnalyticsError(c, err)
+			return
+		}
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Analytics cache invalidated"})
+}
+
 // RegisterRoutes registers analytics routes
 func (h *AnalyticsHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
 	analytics := router.Group("/analytics")
@@ -146,7 +408,13 @@ func (h *AnalyticsHandler) RegisterRoutes(router *gin.RouterGroup, authMiddlewar
 		analytics.GET("/overview", h.GetOverview)
 		analytics.GET("/funnel", h.GetFunnel)
 		analytics.GET("/stages", h.GetStageTime)
+		analytics.GET("/stages/bottlenecks", h.GetStageBottlenecks)
 		analytics.GET("/resumes", h.GetResumeEffectiveness)
 		analytics.GET("/sources", h.GetSourceAnalytics)
+		analytics.GET("/sources/trend", h.GetSourceTrend)
+		analytics.GET("/work-arrangement", h.GetWorkArrangementAnalytics)
+		analytics.GET("/cohort", h.GetCohortAnalytics)
+		analytics.GET("/activity", h.GetActivityTimeSeries)
+		analytics.POST("/cache/invalidate", h.InvalidateCache)
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/andreypavlenko/jobber/modules/analytics/model"
 	"github.com/andreypavlenko/jobber/modules/analytics/service"
@@ -17,44 +18,84 @@ import (
 
 // MockAnalyticsRepository implements the repository interface for testing
 type MockAnalyticsRepository struct {
-	GetOverviewFunc            func(ctx context.Context, userID string) (*model.OverviewAnalytics, error)
-	GetFunnelFunc              func(ctx context.Context, userID string) (*model.FunnelAnalytics, error)
-	GetStageTimeFunc           func(ctx context.Context, userID string) (*model.StageTimeAnalytics, error)
-	GetResumeEffectivenessFunc func(ctx context.Context, userID string) (*model.ResumeAnalytics, error)
-	GetSourceAnalyticsFunc     func(ctx context.Context, userID string) (*model.SourceAnalytics, error)
+	GetOverviewFunc                 func(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error)
+	GetFunnelFunc                   func(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error)
+	GetStageTimeFunc                func(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error)
+	GetStageBottlenecksFunc         func(ctx context.Context, userID string, top int) (*model.StageBottleneckAnalytics, error)
+	GetResumeEffectivenessFunc      func(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error)
+	GetSourceAnalyticsFunc          func(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error)
+	GetWorkArrangementAnalyticsFunc func(ctx context.Context, filter model.AnalyticsFilter) (*model.WorkArrangementAnalytics, error)
+	GetCohortAnalyticsFunc          func(ctx context.Context, userID, granularity string) (*model.CohortAnalytics, error)
+	GetActivityTimeSeriesFunc       func(ctx context.Context, userID, period string) (*model.ActivityAnalytics, error)
+	GetSourceTrendFunc              func(ctx context.Context, userID string, months int) (*model.SourceTrend, error)
 }
 
-func (m *MockAnalyticsRepository) GetOverview(ctx context.Context, userID string) (*model.OverviewAnalytics, error) {
+func (m *MockAnalyticsRepository) GetOverview(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
 	if m.GetOverviewFunc != nil {
-		return m.GetOverviewFunc(ctx, userID)
+		return m.GetOverviewFunc(ctx, filter)
 	}
 	return nil, nil
 }
 
-func (m *MockAnalyticsRepository) GetFunnel(ctx context.Context, userID string) (*model.FunnelAnalytics, error) {
+func (m *MockAnalyticsRepository) GetFunnel(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
 	if m.GetFunnelFunc != nil {
-		return m.GetFunnelFunc(ctx, userID)
+		return m.GetFunnelFunc(ctx, filter)
 	}
 	return nil, nil
 }
 
-func (m *MockAnalyticsRepository) GetStageTime(ctx context.Context, userID string) (*model.StageTimeAnalytics, error) {
+func (m *MockAnalyticsRepository) GetStageTime(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error) {
 	if m.GetStageTimeFunc != nil {
-		return m.GetStageTimeFunc(ctx, userID)
+		return m.GetStageTimeFunc(ctx, filter)
 	}
 	return nil, nil
 }
 
-func (m *MockAnalyticsRepository) GetResumeEffectiveness(ctx context.Context, userID string) (*model.ResumeAnalytics, error) {
+func (m *MockAnalyticsRepository) GetStageBottlenecks(ctx context.Context, userID string, top int) (*model.StageBottleneckAnalytics, error) {
+	if m.GetStageBottlenecksFunc != nil {
+		return m.GetStageBottlenecksFunc(ctx, userID, top)
+	}
+	return nil, nil
+}
+
+func (m *MockAnalyticsRepository) GetResumeEffectiveness(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error) {
 	if m.GetResumeEffectivenessFunc != nil {
-		return m.GetResumeEffectivenessFunc(ctx, userID)
+		return m.GetResumeEffectivenessFunc(ctx, filter)
 	}
 	return nil, nil
 }
 
-func (m *MockAnalyticsRepository) GetSourceAnalytics(ctx context.Context, userID string) (*model.SourceAnalytics, error) {
+func (m *MockAnalyticsRepository) GetSourceAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error) {
 	if m.GetSourceAnalyticsFunc != nil {
-		return m.GetSourceAnalyticsFunc(ctx, userID)
+		return m.GetSourceAnalyticsFunc(ctx, filter)
+	}
+	return nil, nil
+}
+
+func (m *MockAnalyticsRepository) GetWorkArrangementAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.WorkArrangementAnalytics, error) {
+	if m.GetWorkArrangementAnalyticsFunc != nil {
+		return m.GetWorkArrangementAnalyticsFunc(ctx, filter)
+	}
+	return nil, nil
+}
+
+func (m *MockAnalyticsRepository) GetCohortAnalytics(ctx context.Context, userID, granularity string) (*model.CohortAnalytics, error) {
+	if m.GetCohortAnalyticsFunc != nil {
+		return m.GetCohortAnalyticsFunc(ctx, userID, granularity)
+	}
+	return nil, nil
+}
+
+func (m *MockAnalyticsRepository) GetActivityTimeSeries(ctx context.Context, userID, period string) (*model.ActivityAnalytics, error) {
+	if m.GetActivityTimeSeriesFunc != nil {
+		return m.GetActivityTimeSeriesFunc(ctx, userID, period)
+	}
+	return nil, nil
+}
+
+func (m *MockAnalyticsRepository) GetSourceTrend(ctx context.Context, userID string, months int) (*model.SourceTrend, error) {
+	if m.GetSourceTrendFunc != nil {
+		return m.GetSourceTrendFunc(ctx, userID, months)
 	}
 	return nil, nil
 }
@@ -85,13 +126,13 @@ func TestAnalyticsHandler_GetOverview(t *testing.T) {
 		}
 
 		mockRepo := &MockAnalyticsRepository{
-			GetOverviewFunc: func(ctx context.Context, uid string) (*model.OverviewAnalytics, error) {
+			GetOverviewFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
 				return expectedOverview, nil
 			},
 		}
 
 		svc := service.NewAnalyticsService(mockRepo)
-		handler := NewAnalyticsHandler(svc)
+		handler := NewAnalyticsHandler(svc, nil)
 
 		router := setupTestRouter()
 		router.GET("/analytics/overview", mockAuthMiddleware(userID), handler.GetOverview)
@@ -107,17 +148,18 @@ func TestAnalyticsHandler_GetOverview(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, expectedOverview.TotalApplications, response.TotalApplications)
 		assert.Equal(t, expectedOverview.ResponseRate, response.ResponseRate)
+		assert.Equal(t, "</analytics/funnel>; rel=preload, </analytics/stages>; rel=preload", w.Header().Get("Link"))
 	})
 
 	t.Run("returns 500 on service error", func(t *testing.T) {
 		mockRepo := &MockAnalyticsRepository{
-			GetOverviewFunc: func(ctx context.Context, uid string) (*model.OverviewAnalytics, error) {
+			GetOverviewFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
 				return nil, errors.New("database error")
 			},
 		}
 
 		svc := service.NewAnalyticsService(mockRepo)
-		handler := NewAnalyticsHandler(svc)
+		handler := NewAnalyticsHandler(svc, nil)
 
 		router := setupTestRouter()
 		router.GET("/analytics/overview", mockAuthMiddleware(userID), handler.GetOverview)
@@ -130,6 +172,107 @@ func TestAnalyticsHandler_GetOverview(t *testing.T) {
 	})
 }
 
+func TestAnalyticsHandler_DateRange(t *testing.T) {
+	userID := "user-123"
+	from := time.Now().UTC().AddDate(0, -2, 0).Truncate(24 * time.Hour)
+	to := from.AddDate(0, 1, 0)
+
+	setup := func(repo *MockAnalyticsRepository) *gin.Engine {
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(repo), nil)
+		router := setupTestRouter()
+		handler.RegisterRoutes(router.Group("/api/v1"), mockAuthMiddleware(userID))
+		return router
+	}
+	get := func(router *gin.Engine, path string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("passes the range to every filtered endpoint", func(t *testing.T) {
+		var filters []model.AnalyticsFilter
+		record := func(f model.AnalyticsFilter) { filters = append(filters, f) }
+		repo := &MockAnalyticsRepository{
+			GetOverviewFunc: func(ctx context.Context, f model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
+				record(f)
+				return &model.OverviewAnalytics{}, nil
+			},
+			GetFunnelFunc: func(ctx context.Context, f model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
+				record(f)
+				return &model.FunnelAnalytics{}, nil
+			},
+			GetStageTimeFunc: func(ctx context.Context, f model.AnalyticsFilter) (*model.StageTimeAnalytics, error) {
+				record(f)
+				return &model.StageTimeAnalytics{}, nil
+			},
+			GetResumeEffectivenessFunc: func(ctx context.Context, f model.AnalyticsFilter) (*model.ResumeAnalytics, error) {
+				record(f)
+				return &model.ResumeAnalytics{}, nil
+			},
+			GetSourceAnalyticsFunc: func(ctx context.Context, f model.AnalyticsFilter) (*model.SourceAnalytics, error) {
+				record(f)
+				return &model.SourceAnalytics{}, nil
+			},
+		}
+		router := setup(repo)
+		query := "?from=" + from.Format("2006-01-02") + "&to=" + to.Format("2006-01-02")
+
+		for _, endpoint := range []string{"overview", "funnel", "stages", "resumes", "sources"} {
+			w := get(router, "/api/v1/analytics/"+endpoint+query)
+			assert.Equal(t, http.StatusOK, w.Code, endpoint)
+		}
+
+		require.Len(t, filters, 5)
+		for _, f := range filters {
+			assert.Equal(t, userID, f.UserID)
+			require.NotNil(t, f.From)
+			require.NotNil(t, f.To)
+			assert.True(t, from.Equal(*f.From))
+			assert.True(t, to.Equal(*f.To))
+		}
+	})
+
+	t.Run("leaves the range open without parameters", func(t *testing.T) {
+		repo := &MockAnalyticsRepository{
+			GetFunnelFunc: func(ctx context.Context, f model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
+				assert.False(t, f.HasRange())
+				return &model.FunnelAnalytics{}, nil
+			},
+		}
+
+		w := get(setup(repo), "/api/v1/analytics/funnel")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	for _, tt := range []struct {
+		name  string
+		query string
+	}{
+		{name: "malformed date", query: "from=01/02/2024"},
+		{name: "from after to", query: "from=" + to.Format("2006-01-02") + "&to=" + from.Format("2006-01-02")},
+		{name: "from more than five years ago", query: "from=" + time.Now().AddDate(-5, 0, -2).Format("2006-01-02")},
+	} {
+		t.Run("returns 400 for "+tt.name, func(t *testing.T) {
+			w := get(setup(&MockAnalyticsRepository{}), "/api/v1/analytics/sources?"+tt.query)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), "INVALID_DATE_RANGE")
+		})
+	}
+
+	t.Run("translates the error message to the request locale", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/analytics/sources?from=01/02/2024", nil)
+		req.Header.Set("Accept-Language", "es")
+		w := httptest.NewRecorder()
+		setup(&MockAnalyticsRepository{}).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "from y to deben ser fechas YYYY-MM-DD")
+	})
+}
+
 func TestAnalyticsHandler_GetFunnel(t *testing.T) {
 	userID := "user-123"
 
@@ -142,13 +285,13 @@ func TestAnalyticsHandler_GetFunnel(t *testing.T) {
 		}
 
 		mockRepo := &MockAnalyticsRepository{
-			GetFunnelFunc: func(ctx context.Context, uid string) (*model.FunnelAnalytics, error) {
+			GetFunnelFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
 				return expectedFunnel, nil
 			},
 		}
 
 		svc := service.NewAnalyticsService(mockRepo)
-		handler := NewAnalyticsHandler(svc)
+		handler := NewAnalyticsHandler(svc, nil)
 
 		router := setupTestRouter()
 		router.GET("/analytics/funnel", mockAuthMiddleware(userID), handler.GetFunnel)
@@ -168,13 +311,13 @@ func TestAnalyticsHandler_GetFunnel(t *testing.T) {
 
 	t.Run("returns 500 on service error", func(t *testing.T) {
 		mockRepo := &MockAnalyticsRepository{
-			GetFunnelFunc: func(ctx context.Context, uid string) (*model.FunnelAnalytics, error) {
+			GetFunnelFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
 				return nil, errors.New("database error")
 			},
 		}
 
 		svc := service.NewAnalyticsService(mockRepo)
-		handler := NewAnalyticsHandler(svc)
+		handler := NewAnalyticsHandler(svc, nil)
 
 		router := setupTestRouter()
 		router.GET("/analytics/funnel", mockAuthMiddleware(userID), handler.GetFunnel)
@@ -198,13 +341,13 @@ func TestAnalyticsHandler_GetStageTime(t *testing.T) {
 		}
 
 		mockRepo := &MockAnalyticsRepository{
-			GetStageTimeFunc: func(ctx context.Context, uid string) (*model.StageTimeAnalytics, error) {
+			GetStageTimeFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error) {
 				return expectedStageTime, nil
 			},
 		}
 
 		svc := service.NewAnalyticsService(mockRepo)
-		handler := NewAnalyticsHandler(svc)
+		handler := NewAnalyticsHandler(svc, nil)
 
 		router := setupTestRouter()
 		router.GET("/analytics/stages", mockAuthMiddleware(userID), handler.GetStageTime)
@@ -224,13 +367,13 @@ func TestAnalyticsHandler_GetStageTime(t *testing.T) {
 
 	t.Run("returns 500 on service error", func(t *testing.T) {
 		mockRepo := &MockAnalyticsRepository{
-			GetStageTimeFunc: func(ctx context.Context, uid string) (*model.StageTimeAnalytics, error) {
+			GetStageTimeFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error) {
 				return nil, errors.New("database error")
 			},
 		}
 
 		svc := service.NewAnalyticsService(mockRepo)
-		handler := NewAnalyticsHandler(svc)
+		handler := NewAnalyticsHandler(svc, nil)
 
 		router := setupTestRouter()
 		router.GET("/analytics/stages", mockAuthMiddleware(userID), handler.GetStageTime)
@@ -261,13 +404,13 @@ func TestAnalyticsHandler_GetResumeEffectiveness(t *testing.T) {
 		}
 
 		mockRepo := &MockAnalyticsRepository{
-			GetResumeEffectivenessFunc: func(ctx context.Context, uid string) (*model.ResumeAnalytics, error) {
+			GetResumeEffectivenessFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error) {
 				return expectedResumes, nil
 			},
 		}
 
 		svc := service.NewAnalyticsService(mockRepo)
-		handler := NewAnalyticsHandler(svc)
+		handler := NewAnalyticsHandler(svc, nil)
 
 		router := setupTestRouter()
 		router.GET("/analytics/resumes", mockAuthMiddleware(userID), handler.GetResumeEffectiveness)
@@ -287,13 +430,13 @@ func TestAnalyticsHandler_GetResumeEffectiveness(t *testing.T) {
 
 	t.Run("returns 500 on service error", func(t *testing.T) {
 		mockRepo := &MockAnalyticsRepository{
-			GetResumeEffectivenessFunc: func(ctx context.Context, uid string) (*model.ResumeAnalytics, error) {
+			GetResumeEffectivenessFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error) {
 				return nil, errors.New("database error")
 			},
 		}
 
 		svc := service.NewAnalyticsService(mockRepo)
-		handler := NewAnalyticsHandler(svc)
+		handler := NewAnalyticsHandler(svc, nil)
 
 		router := setupTestRouter()
 		router.GET("/analytics/resumes", mockAuthMiddleware(userID), handler.GetResumeEffectiveness)
@@ -318,13 +461,13 @@ func TestAnalyticsHandler_GetSourceAnalytics(t *testing.T) {
 		}
 
 		mockRepo := &MockAnalyticsRepository{
-			GetSourceAnalyticsFunc: func(ctx context.Context, uid string) (*model.SourceAnalytics, error) {
+			GetSourceAnalyticsFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error) {
 				return expectedSources, nil
 			},
 		}
 
 		svc := service.NewAnalyticsService(mockRepo)
-		handler := NewAnalyticsHandler(svc)
+		handler := NewAnalyticsHandler(svc, nil)
 
 		router := setupTestRouter()
 		router.GET("/analytics/sources", mockAuthMiddleware(userID), handler.GetSourceAnalytics)
@@ -345,13 +488,13 @@ func TestAnalyticsHandler_GetSourceAnalytics(t *testing.T) {
 
 	t.Run("returns 500 on service error", func(t *testing.T) {
 		mockRepo := &MockAnalyticsRepository{
-			GetSourceAnalyticsFunc: func(ctx context.Context, uid string) (*model.SourceAnalytics, error) {
+			GetSourceAnalyticsFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error) {
 				return nil, errors.New("database error")
 			},
 		}
 
 		svc := service.NewAnalyticsService(mockRepo)
-		handler := NewAnalyticsHandler(svc)
+		handler := NewAnalyticsHandler(svc, nil)
 
 		router := setupTestRouter()
 		router.GET("/analytics/sources", mockAuthMiddleware(userID), handler.GetSourceAnalytics)
@@ -364,27 +507,490 @@ func TestAnalyticsHandler_GetSourceAnalytics(t *testing.T) {
 	})
 }
 
+func TestAnalyticsHandler_GetWorkArrangementAnalytics(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("returns arrangements for the date range", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetWorkArrangementAnalyticsFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.WorkArrangementAnalytics, error) {
+				require.NotNil(t, filter.From)
+				assert.Equal(t, "2024-01-01", filter.From.Format("2006-01-02"))
+				return &model.WorkArrangementAnalytics{
+					Arrangements: []model.WorkArrangementMetrics{
+						{WorkArrangement: "hybrid", ApplicationsCount: 5, ActiveCount: 3, RejectedCount: 2},
+					},
+				}, nil
+			},
+		}
+
+		svc := service.NewAnalyticsService(mockRepo)
+		handler := NewAnalyticsHandler(svc, nil)
+
+		router := setupTestRouter()
+		router.GET("/analytics/work-arrangement", mockAuthMiddleware(userID), handler.GetWorkArrangementAnalytics)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/work-arrangement?from=2024-01-01", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response model.WorkArrangementAnalytics
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Arrangements, 1)
+		assert.Equal(t, "hybrid", response.Arrangements[0].WorkArrangement)
+		assert.Equal(t, 3, response.Arrangements[0].ActiveCount)
+	})
+
+	t.Run("returns 400 for an invalid range", func(t *testing.T) {
+		svc := service.NewAnalyticsService(&MockAnalyticsRepository{})
+		handler := NewAnalyticsHandler(svc, nil)
+
+		router := setupTestRouter()
+		router.GET("/analytics/work-arrangement", mockAuthMiddleware(userID), handler.GetWorkArrangementAnalytics)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/work-arrangement?from=2024-06-30&to=2024-01-01", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAnalyticsHandler_GetCohortAnalytics(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("returns cohorts with requested granularity", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetCohortAnalyticsFunc: func(ctx context.Context, uid, granularity string) (*model.CohortAnalytics, error) {
+				assert.Equal(t, "quarter", granularity)
+				return &model.CohortAnalytics{
+					Granularity: granularity,
+					Cohorts: []model.CohortMetrics{
+						{CohortPeriod: "2026-07-01", ApplicationsStarted: 10, OffersReceived: 1, RejectionRate: 40.0, StillActive: 5},
+					},
+				}, nil
+			},
+		}
+
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(mockRepo), nil)
+
+		router := setupTestRouter()
+		router.GET("/analytics/cohort", mockAuthMiddleware(userID), handler.GetCohortAnalytics)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/cohort?granularity=quarter", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response model.CohortAnalytics
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Cohorts, 1)
+		assert.Equal(t, "2026-07-01", response.Cohorts[0].CohortPeriod)
+		assert.Equal(t, 40.0, response.Cohorts[0].RejectionRate)
+	})
+
+	t.Run("returns 400 for invalid granularity", func(t *testing.T) {
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(&MockAnalyticsRepository{}), nil)
+
+		router := setupTestRouter()
+		router.GET("/analytics/cohort", mockAuthMiddleware(userID), handler.GetCohortAnalytics)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/cohort?granularity=day", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "INVALID_GRANULARITY")
+	})
+
+	t.Run("returns 500 on service error", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetCohortAnalyticsFunc: func(ctx context.Context, uid, granularity string) (*model.CohortAnalytics, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(mockRepo), nil)
+
+		router := setupTestRouter()
+		router.GET("/analytics/cohort", mockAuthMiddleware(userID), handler.GetCohortAnalytics)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/cohort", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestAnalyticsHandler_GetActivityTimeSeries(t *testing.T) {
+	userID := "user-123"
+
+	send := func(handler *AnalyticsHandler, url string) *httptest.ResponseRecorder {
+		router := setupTestRouter()
+		router.GET("/analytics/activity", mockAuthMiddleware(userID), handler.GetActivityTimeSeries)
+
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("returns buckets for the requested period", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetActivityTimeSeriesFunc: func(ctx context.Context, uid, period string) (*model.ActivityAnalytics, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, "month", period)
+				return &model.ActivityAnalytics{Period: period, Buckets: []model.ActivityBucket{
+					{Date: "2026-09-01", Count: 0},
+					{Date: "2026-10-01", Count: 3},
+				}}, nil
+			},
+		}
+
+		w := send(NewAnalyticsHandler(service.NewAnalyticsService(mockRepo), nil), "/analytics/activity?period=month")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response model.ActivityAnalytics
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "month", response.Period)
+		require.Len(t, response.Buckets, 2)
+		assert.Equal(t, model.ActivityBucket{Date: "2026-10-01", Count: 3}, response.Buckets[1])
+	})
+
+	t.Run("defaults to week", func(t *testing.T) {
+		var captured string
+		mockRepo := &MockAnalyticsRepository{
+			GetActivityTimeSeriesFunc: func(ctx context.Context, uid, period string) (*model.ActivityAnalytics, error) {
+				captured = period
+				return &model.ActivityAnalytics{Period: period}, nil
+			},
+		}
+
+		w := send(NewAnalyticsHandler(service.NewAnalyticsService(mockRepo), nil), "/analytics/activity")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, model.ActivityPeriodWeek, captured)
+	})
+
+	t.Run("returns 400 for invalid period", func(t *testing.T) {
+		w := send(NewAnalyticsHandler(service.NewAnalyticsService(&MockAnalyticsRepository{}), nil), "/analytics/activity?period=day")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "INVALID_PERIOD")
+	})
+
+	t.Run("returns 500 on service error", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetActivityTimeSeriesFunc: func(ctx context.Context, uid, period string) (*model.ActivityAnalytics, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		w := send(NewAnalyticsHandler(service.NewAnalyticsService(mockRepo), nil), "/analytics/activity")
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestAnalyticsHandler_GetSourceTrend(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("defaults to six months", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetSourceTrendFunc: func(ctx context.Context, uid string, months int) (*model.SourceTrend, error) {
+				assert.Equal(t, model.DefaultSourceTrendMonths, months)
+				return &model.SourceTrend{Sources: []model.SourceMonthlyTrend{
+					{Source: "LinkedIn", Months: []model.SourceTrendMonth{{Month: "2026-02", Count: 3}}},
+				}}, nil
+			},
+		}
+
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(mockRepo), nil)
+
+		router := setupTestRouter()
+		router.GET("/analytics/sources/trend", mockAuthMiddleware(userID), handler.GetSourceTrend)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/sources/trend", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response model.SourceTrend
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Sources, 1)
+		assert.Equal(t, "LinkedIn", response.Sources[0].Source)
+		assert.Equal(t, []model.SourceTrendMonth{{Month: "2026-02", Count: 3}}, response.Sources[0].Months)
+	})
+
+	t.Run("passes requested months", func(t *testing.T) {
+		var captured int
+		mockRepo := &MockAnalyticsRepository{
+			GetSourceTrendFunc: func(ctx context.Context, uid string, months int) (*model.SourceTrend, error) {
+				captured = months
+				return &model.SourceTrend{}, nil
+			},
+		}
+
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(mockRepo), nil)
+
+		router := setupTestRouter()
+		router.GET("/analytics/sources/trend", mockAuthMiddleware(userID), handler.GetSourceTrend)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/sources/trend?months=12", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 12, captured)
+	})
+
+	for _, months := range []string{"0", "25", "abc"} {
+		t.Run("returns 400 for months="+months, func(t *testing.T) {
+			handler := NewAnalyticsHandler(service.NewAnalyticsService(&MockAnalyticsRepository{}), nil)
+
+			router := setupTestRouter()
+			router.GET("/analytics/sources/trend", mockAuthMiddleware(userID), handler.GetSourceTrend)
+
+			req, _ := http.NewRequest(http.MethodGet, "/analytics/sources/trend?months="+months, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), "INVALID_MONTHS")
+		})
+	}
+
+	t.Run("returns 500 on service error", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetSourceTrendFunc: func(ctx context.Context, uid string, months int) (*model.SourceTrend, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(mockRepo), nil)
+
+		router := setupTestRouter()
+		router.GET("/analytics/sources/trend", mockAuthMiddleware(userID), handler.GetSourceTrend)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/sources/trend", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestAnalyticsHandler_GetStageBottlenecks(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("defaults to top three", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetStageBottlenecksFunc: func(ctx context.Context, uid string, top int) (*model.StageBottleneckAnalytics, error) {
+				assert.Equal(t, model.DefaultBottleneckTop, top)
+				return &model.StageBottleneckAnalytics{
+					GlobalAvgDays: 8,
+					Stages: []model.StageBottleneck{
+						{StageName: "Take-Home", AvgDays: 16, DropOffPct: 40},
+						{StageName: "Phone Screen", AvgDays: 4, DropOffPct: 10},
+					},
+				}, nil
+			},
+		}
+
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(mockRepo), nil)
+
+		router := setupTestRouter()
+		router.GET("/analytics/stages/bottlenecks", mockAuthMiddleware(userID), handler.GetStageBottlenecks)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/stages/bottlenecks", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response model.StageBottleneckAnalytics
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Stages, 2)
+		assert.Equal(t, "Take-Home", response.Stages[0].StageName)
+		assert.True(t, response.Stages[0].IsBottleneck)
+		assert.False(t, response.Stages[1].IsBottleneck)
+	})
+
+	t.Run("passes requested top", func(t *testing.T) {
+		var captured int
+		mockRepo := &MockAnalyticsRepository{
+			GetStageBottlenecksFunc: func(ctx context.Context, uid string, top int) (*model.StageBottleneckAnalytics, error) {
+				captured = top
+				return &model.StageBottleneckAnalytics{}, nil
+			},
+		}
+
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(mockRepo), nil)
+
+		router := setupTestRouter()
+		router.GET("/analytics/stages/bottlenecks", mockAuthMiddleware(userID), handler.GetStageBottlenecks)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/stages/bottlenecks?top=5", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 5, captured)
+	})
+
+	for _, top := range []string{"0", "21", "abc"} {
+		t.Run("returns 400 for top="+top, func(t *testing.T) {
+			handler := NewAnalyticsHandler(service.NewAnalyticsService(&MockAnalyticsRepository{}), nil)
+
+			router := setupTestRouter()
+			router.GET("/analytics/stages/bottlenecks", mockAuthMiddleware(userID), handler.GetStageBottlenecks)
+
+			req, _ := http.NewRequest(http.MethodGet, "/analytics/stages/bottlenecks?top="+top, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), "INVALID_TOP")
+		})
+	}
+
+	t.Run("returns 500 on service error", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetStageBottlenecksFunc: func(ctx context.Context, uid string, top int) (*model.StageBottleneckAnalytics, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(mockRepo), nil)
+
+		router := setupTestRouter()
+		router.GET("/analytics/stages/bottlenecks", mockAuthMiddleware(userID), handler.GetStageBottlenecks)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/stages/bottlenecks", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+// MockCacheInvalidator records analytics cache invalidations
+type MockCacheInvalidator struct {
+	InvalidateFunc func(ctx context.Context, userID string) error
+}
+
+func (m *MockCacheInvalidator) InvalidateAnalytics(ctx context.Context, userID string) error {
+	if m.InvalidateFunc != nil {
+		return m.InvalidateFunc(ctx, userID)
+	}
+	return nil
+}
+
+func TestAnalyticsHandler_InvalidateCache(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("flushes the user's cache", func(t *testing.T) {
+		var invalidated string
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(&MockAnalyticsRepository{}), &MockCacheInvalidator{
+			InvalidateFunc: func(ctx context.Context, uid string) error {
+				invalidated = uid
+				return nil
+			},
+		})
+
+		router := setupTestRouter()
+		router.POST("/analytics/cache/invalidate", mockAuthMiddleware(userID), handler.InvalidateCache)
+
+		req, _ := http.NewRequest(http.MethodPost, "/analytics/cache/invalidate", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, userID, invalidated)
+	})
+
+	t.Run("succeeds without a cache", func(t *testing.T) {
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(&MockAnalyticsRepository{}), nil)
+
+		router := setupTestRouter()
+		router.POST("/analytics/cache/invalidate", mockAuthMiddleware(userID), handler.InvalidateCache)
+
+		req, _ := http.NewRequest(http.MethodPost, "/analytics/cache/invalidate", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("returns 500 when the cache fails", func(t *testing.T) {
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(&MockAnalyticsRepository{}), &MockCacheInvalidator{
+			InvalidateFunc: func(ctx context.Context, uid string) error {
+				return errors.New("redis down")
+			},
+		})
+
+		router := setupTestRouter()
+		router.POST("/analytics/cache/invalidate", mockAuthMiddleware(userID), handler.InvalidateCache)
+
+		req, _ := http.NewRequest(http.MethodPost, "/analytics/cache/invalidate", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("returns 401 without auth", func(t *testing.T) {
+		handler := NewAnalyticsHandler(service.NewAnalyticsService(&MockAnalyticsRepository{}), nil)
+
+		router := setupTestRouter()
+		router.POST("/analytics/cache/invalidate", handler.InvalidateCache)
+
+		req, _ := http.NewRequest(http.MethodPost, "/analytics/cache/invalidate", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
 func TestAnalyticsHandler_RegisterRoutes(t *testing.T) {
 	mockRepo := &MockAnalyticsRepository{
-		GetOverviewFunc: func(ctx context.Context, uid string) (*model.OverviewAnalytics, error) {
+		GetOverviewFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
 			return &model.OverviewAnalytics{}, nil
 		},
-		GetFunnelFunc: func(ctx context.Context, uid string) (*model.FunnelAnalytics, error) {
+		GetFunnelFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
 			return &model.FunnelAnalytics{}, nil
 		},
-		GetStageTimeFunc: func(ctx context.Context, uid string) (*model.StageTimeAnalytics, error) {
+		GetStageTimeFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error) {
 			return &model.StageTimeAnalytics{}, nil
 		},
-		GetResumeEffectivenessFunc: func(ctx context.Context, uid string) (*model.ResumeAnalytics, error) {
+		GetStageBottlenecksFunc: func(ctx context.Context, uid string, top int) (*model.StageBottleneckAnalytics, error) {
+			return &model.StageBottleneckAnalytics{}, nil
+		},
+		GetResumeEffectivenessFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error) {
 			return &model.ResumeAnalytics{}, nil
 		},
-		GetSourceAnalyticsFunc: func(ctx context.Context, uid string) (*model.SourceAnalytics, error) {
+		GetSourceAnalyticsFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error) {
 			return &model.SourceAnalytics{}, nil
 		},
+		GetCohortAnalyticsFunc: func(ctx context.Context, uid, granularity string) (*model.CohortAnalytics, error) {
+			return &model.CohortAnalytics{}, nil
+		},
+		GetSourceTrendFunc: func(ctx context.Context, uid string, months int) (*model.SourceTrend, error) {
+			return &model.SourceTrend{}, nil
+		},
+		GetActivityTimeSeriesFunc: func(ctx context.Context, uid, period string) (*model.ActivityAnalytics, error) {
+			return &model.ActivityAnalytics{}, nil
+		},
 	}
 
 	svc := service.NewAnalyticsService(mockRepo)
-	handler := NewAnalyticsHandler(svc)
+	handler := NewAnalyticsHandler(svc, nil)
 
 	router := setupTestRouter()
 	v1 := router.Group("/api/v1")
@@ -397,8 +1003,14 @@ func TestAnalyticsHandler_RegisterRoutes(t *testing.T) {
 		{http.MethodGet, "/api/v1/analytics/overview"},
 		{http.MethodGet, "/api/v1/analytics/funnel"},
 		{http.MethodGet, "/api/v1/analytics/stages"},
+		{http.MethodGet, "/api/v1/analytics/stages/bottlenecks"},
 		{http.MethodGet, "/api/v1/analytics/resumes"},
 		{http.MethodGet, "/api/v1/analytics/sources"},
+		{http.MethodGet, "/api/v1/analytics/sources/trend"},
+		{http.MethodGet, "/api/v1/analytics/work-arrangement"},
+		{http.MethodGet, "/api/v1/analytics/cohort"},
+		{http.MethodGet, "/api/v1/analytics/activity"},
+		{http.MethodPost, "/api/v1/analytics/cache/invalidate"},
 	}
 
 	for _, route := range routes {
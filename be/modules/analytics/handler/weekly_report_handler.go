@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andreypavlenko/jobber/internal/platform/auth"
+	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/modules/analytics/service"
+	"github.com/gin-gonic/gin"
+)
+
+type WeeklyReportHandler struct {
+	service *service.WeeklyReportService
+}
+
+func NewWeeklyReportHandler(service *service.WeeklyReportService) *WeeklyReportHandler {
+	return &WeeklyReportHandler{service: service}
+}
+
+// GetWeeklyReport godoc
+// @Summary Get weekly report
+// @Description Get a structured report of the authenticated user's activity over the 7 days ending today (UTC)
+// @Tags analytics
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} model.WeeklyReport
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /analytics/weekly-report [get]
+func (h *WeeklyReportHandler) GetWeeklyReport(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.service.Generate(c.Request.Context(), userID)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "ANALYTICS_ERROR", "Failed to generate weekly report")
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, report)
+}
+
+// RegisterRoutes registers weekly report routes
+func (h *WeeklyReportHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	analytics := router.Group("/analytics")
+	analytics.Use(authMiddleware)
+	{
+		analytics.GET("/weekly-report", h.GetWeeklyReport)
+	}
+}
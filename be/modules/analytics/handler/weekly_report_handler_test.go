@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/analytics/model"
+	"github.com/andreypavlenko/jobber/modules/analytics/service"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubWeeklyActivityRepository struct {
+	err error
+}
+
+func (r *stubWeeklyActivityRepository) GetWeeklyActivity(ctx context.Context, userID string, from, to time.Time) (*model.WeeklyActivity, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &model.WeeklyActivity{NewApplications: 2}, nil
+}
+
+func (r *stubWeeklyActivityRepository) CountApplicationEventsByDay(ctx context.Context, userID string, from, to time.Time) (map[string]int, error) {
+	return map[string]int{}, nil
+}
+
+type stubReminderCounter struct{}
+
+func (stubReminderCounter) CountDue(ctx context.Context, userID string, from, to time.Time) (int, error) {
+	return 1, nil
+}
+
+func TestWeeklyReportHandler_GetWeeklyReport(t *testing.T) {
+	t.Run("returns report", func(t *testing.T) {
+		handler := NewWeeklyReportHandler(service.NewWeeklyReportService(&stubWeeklyActivityRepository{}, stubReminderCounter{}))
+		router := setupTestRouter()
+		router.GET("/analytics/weekly-report", mockAuthMiddleware("user-123"), handler.GetWeeklyReport)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/weekly-report", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"new_applications":2`)
+		assert.Contains(t, w.Body.String(), `"reminders_due":1`)
+	})
+
+	t.Run("returns 500 on repository error", func(t *testing.T) {
+		handler := NewWeeklyReportHandler(service.NewWeeklyReportService(&stubWeeklyActivityRepository{err: errors.New("db error")}, stubReminderCounter{}))
+		router := setupTestRouter()
+		router.GET("/analytics/weekly-report", mockAuthMiddleware("user-123"), handler.GetWeeklyReport)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/weekly-report", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("returns 401 without user", func(t *testing.T) {
+		handler := NewWeeklyReportHandler(service.NewWeeklyReportService(&stubWeeklyActivityRepository{}, stubReminderCounter{}))
+		router := setupTestRouter()
+		router.GET("/analytics/weekly-report", handler.GetWeeklyReport)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/weekly-report", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
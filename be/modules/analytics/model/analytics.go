@@ -1,5 +1,40 @@
 package model
 
+import "time"
+
+// MaxDateRangeYears is how far back an analytics date range may start
+const MaxDateRangeYears = 5
+
+// AnalyticsFilter scopes analytics to a user's applications, optionally only
+// those applied between From and To. Both bounds are dates (UTC midnight) and
+// inclusive; a nil bound leaves that side open.
+type AnalyticsFilter struct {
+	UserID string
+	From   *time.Time
+	To     *time.Time
+}
+
+// HasRange reports whether the filter restricts the application date
+func (f AnalyticsFilter) HasRange() bool {
+	return f.From != nil || f.To != nil
+}
+
+// Validate checks that From is not after To and that neither bound is more
+// than MaxDateRangeYears before now
+func (f AnalyticsFilter) Validate(now time.Time) error {
+	now = now.UTC()
+	earliest := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(-MaxDateRangeYears, 0, 0)
+	for _, bound := range []*time.Time{f.From, f.To} {
+		if bound != nil && bound.Before(earliest) {
+			return ErrInvalidDateRange
+		}
+	}
+	if f.From != nil && f.To != nil && f.From.After(*f.To) {
+		return ErrInvalidDateRange
+	}
+	return nil
+}
+
 // OverviewAnalytics contains high-level application statistics
 type OverviewAnalytics struct {
 	TotalApplications      int     `json:"total_applications"`
@@ -7,6 +42,12 @@ type OverviewAnalytics struct {
 	ClosedApplications     int     `json:"closed_applications"`
 	ResponseRate           float64 `json:"response_rate"`
 	AvgDaysToFirstResponse float64 `json:"avg_days_to_first_response"`
+	// Salary averages over applications with an offer, ignoring currency; 0 without data
+	AvgOfferedSalary  float64 `json:"avg_offered_salary"`
+	AvgAcceptedSalary float64 `json:"avg_accepted_salary"`
+	// Applications with a deadline, and those whose deadline passed while still active
+	ApplicationsWithDeadlineCount    int `json:"applications_with_deadline_count"`
+	ApplicationsOverdueDeadlineCount int `json:"applications_overdue_deadline_count"`
 }
 
 // FunnelStage represents a single stage in the application funnel
@@ -38,6 +79,40 @@ type StageTimeAnalytics struct {
 	Stages []StageTimeMetrics `json:"stages"`
 }
 
+// Bounds of the top parameter accepted by GetStageBottlenecks
+const (
+	DefaultBottleneckTop = 3
+	MaxBottleneckTop     = 20
+)
+
+// BottleneckFactor is how many times slower than the global average a stage
+// must be to count as a bottleneck
+const BottleneckFactor = 1.5
+
+// StageBottleneck contains timing and drop-off metrics for a single stage
+type StageBottleneck struct {
+	StageName    string  `json:"stage_name"`
+	AvgDays      float64 `json:"avg_days"`
+	DropOffPct   float64 `json:"drop_off_pct"` // share of applications closed while this was their latest stage
+	IsBottleneck bool    `json:"is_bottleneck"`
+}
+
+// StageBottleneckAnalytics contains the slowest stages, slowest first.
+// GlobalAvgDays is the mean of avg_days across all stages, not only the returned ones.
+type StageBottleneckAnalytics struct {
+	GlobalAvgDays float64           `json:"global_avg_days"`
+	Stages        []StageBottleneck `json:"stages"`
+}
+
+// MarkBottlenecks flags the stages whose average duration exceeds
+// BottleneckFactor times the global average
+func (a *StageBottleneckAnalytics) MarkBottlenecks() {
+	threshold := a.GlobalAvgDays * BottleneckFactor
+	for i := range a.Stages {
+		a.Stages[i].IsBottleneck = a.Stages[i].AvgDays > threshold
+	}
+}
+
 // ResumeEffectiveness contains effectiveness metrics for a resume
 type ResumeEffectiveness struct {
 	ResumeID          string `json:"resume_id"`
@@ -55,13 +130,146 @@ type ResumeAnalytics struct {
 
 // SourceMetrics contains metrics for a single job source
 type SourceMetrics struct {
-	SourceName        string  `json:"source_name"`
-	ApplicationsCount int     `json:"applications_count"`
-	ResponsesCount    int     `json:"responses_count"`
-	ConversionRate    float64 `json:"conversion_rate"`
+	SourceName             string  `json:"source_name"`
+	ApplicationsCount      int     `json:"applications_count"`
+	ResponsesCount         int     `json:"responses_count"`
+	ConversionRate         float64 `json:"conversion_rate"`
+	ReferralsCount         int     `json:"referrals_count"`
+	ReferralConversionRate float64 `json:"referral_conversion_rate"`
 }
 
 // SourceAnalytics contains metrics for all job sources
 type SourceAnalytics struct {
 	Sources []SourceMetrics `json:"sources"`
 }
+
+// UnspecifiedWorkArrangement groups applications to jobs without a work arrangement
+const UnspecifiedWorkArrangement = "unspecified"
+
+// WorkArrangementMetrics counts the applications to jobs with one work arrangement, by status
+type WorkArrangementMetrics struct {
+	WorkArrangement   string `json:"work_arrangement"` // remote, onsite, hybrid or unspecified
+	ApplicationsCount int    `json:"applications_count"`
+	ActiveCount       int    `json:"active_count"`
+	OnHoldCount       int    `json:"on_hold_count"`
+	OfferCount        int    `json:"offer_count"`
+	RejectedCount     int    `json:"rejected_count"`
+	ArchivedCount     int    `json:"archived_count"`
+}
+
+// WorkArrangementAnalytics contains application status counts for every work arrangement applied to
+type WorkArrangementAnalytics struct {
+	Arrangements []WorkArrangementMetrics `json:"arrangements"`
+}
+
+// Bounds of the months window accepted by GetSourceTrend
+const (
+	DefaultSourceTrendMonths = 6
+	MaxSourceTrendMonths     = 24
+)
+
+// SourceTrendMonth is the number of applications from a source in one month
+type SourceTrendMonth struct {
+	Month string `json:"month"` // YYYY-MM
+	Count int    `json:"count"`
+}
+
+// SourceMonthlyTrend is a source's application count per month, oldest first.
+// Every month of the window is present, with zero counts for quiet months.
+type SourceMonthlyTrend struct {
+	Source string             `json:"source"`
+	Months []SourceTrendMonth `json:"months"`
+}
+
+// SourceTrend contains monthly application counts for every job source used in the window
+type SourceTrend struct {
+	Sources []SourceMonthlyTrend `json:"sources"`
+}
+
+// Cohort granularities supported by GetCohortAnalytics
+const (
+	GranularityWeek    = "week"
+	GranularityMonth   = "month"
+	GranularityQuarter = "quarter"
+)
+
+// IsValidGranularity reports whether g is a supported cohort granularity
+func IsValidGranularity(g string) bool {
+	switch g {
+	case GranularityWeek, GranularityMonth, GranularityQuarter:
+		return true
+	default:
+		return false
+	}
+}
+
+// CohortMetrics contains outcome metrics for applications started in the same period
+type CohortMetrics struct {
+	CohortPeriod        string  `json:"cohort_period"`
+	ApplicationsStarted int     `json:"applications_started"`
+	OffersReceived      int     `json:"offers_received"`
+	RejectionRate       float64 `json:"rejection_rate"`
+	StillActive         int     `json:"still_active"`
+}
+
+// CohortAnalytics contains cohort metrics grouped by application start period
+type CohortAnalytics struct {
+	Granularity string          `json:"granularity"`
+	Cohorts     []CohortMetrics `json:"cohorts"`
+}
+
+// Activity time series periods; each bucket of the series spans one period
+const (
+	ActivityPeriodWeek    = "week"
+	ActivityPeriodMonth   = "month"
+	ActivityPeriodQuarter = "quarter"
+)
+
+// ActivityBucketCount returns how many periods, ending with the current one,
+// the activity time series covers, and false for an unsupported period
+func ActivityBucketCount(period string) (int, bool) {
+	switch period {
+	case ActivityPeriodWeek, ActivityPeriodMonth:
+		return 12, true
+	case ActivityPeriodQuarter:
+		return 8, true
+	default:
+		return 0, false
+	}
+}
+
+// ActivityBucket is the number of applications in the period starting on Date (YYYY-MM-DD)
+type ActivityBucket struct {
+	Date  string `json:"date"`
+	Count int    `json:"count"`
+}
+
+// ActivityAnalytics is the application count per period, oldest first;
+// periods without applications are included with a zero count
+type ActivityAnalytics struct {
+	Period  string           `json:"period"`
+	Buckets []ActivityBucket `json:"buckets"`
+}
+
+// WeeklyActivity contains application activity counts for a date range
+type WeeklyActivity struct {
+	NewApplications   int
+	StageProgressions int
+	OffersReceived    int
+	Rejections        int
+}
+
+// WeeklyReport summarises the user's job search over the last 7 days
+type WeeklyReport struct {
+	WeekStart         string  `json:"week_start"`
+	WeekEnd           string  `json:"week_end"`
+	NewApplications   int     `json:"new_applications"`
+	StageProgressions int     `json:"stage_progressions"`
+	RemindersDue      int     `json:"reminders_due"`
+	OffersReceived    int     `json:"offers_received"`
+	Rejections        int     `json:"rejections"`
+	MostActiveDay     *string `json:"most_active_day"` // Date with the most application events, null if none
+}
+
+// ReportDateLayout is the date format used in weekly reports
+const ReportDateLayout = "2006-01-02"
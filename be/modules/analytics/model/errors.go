@@ -0,0 +1,48 @@
+package model
+
+import (
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
+
+var (
+	// ErrInvalidGranularity is returned when an unsupported cohort granularity is requested
+	ErrInvalidGranularity = &DomainError{Code: CodeInvalidGranularity, Message: "invalid granularity"}
+
+	// ErrInvalidPeriod is returned when an unsupported activity period is requested
+	ErrInvalidPeriod = &DomainError{Code: CodeInvalidPeriod, Message: "invalid period"}
+
+	// ErrInvalidMonths is returned when the source trend window is out of range
+	ErrInvalidMonths = &DomainError{Code: CodeInvalidMonths, Message: "invalid months"}
+
+	// ErrInvalidTop is returned when the number of bottleneck stages requested is out of range
+	ErrInvalidTop = &DomainError{Code: CodeInvalidTop, Message: "invalid top"}
+
+	// ErrInvalidDateRange is returned when the from/to range is reversed or starts too far back
+	ErrInvalidDateRange = &DomainError{Code: CodeInvalidDateRange, Message: "invalid date range"}
+)
+
+// ErrorCode represents error codes
+type ErrorCode string
+
+const (
+	CodeInvalidGranularity ErrorCode = "INVALID_GRANULARITY"
+	CodeInvalidPeriod      ErrorCode = "INVALID_PERIOD"
+	CodeInvalidMonths      ErrorCode = "INVALID_MONTHS"
+	CodeInvalidTop         ErrorCode = "INVALID_TOP"
+	CodeInvalidDateRange   ErrorCode = "INVALID_DATE_RANGE"
+	CodeAnalyticsError     ErrorCode = "ANALYTICS_ERROR"
+)
+
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
+// GetErrorCode maps errors to error codes; unknown errors map to CodeAnalyticsError
+func GetErrorCode(err error) ErrorCode {
+	return domainerr.CodeOf(err, CodeAnalyticsError)
+}
+
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
+}
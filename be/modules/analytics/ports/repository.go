@@ -2,24 +2,60 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/andreypavlenko/jobber/modules/analytics/model"
 )
 
-// AnalyticsRepository defines the interface for analytics data access
+// AnalyticsRepository defines the interface for analytics data access.
+// Methods taking an AnalyticsFilter only count applications applied within its range.
 type AnalyticsRepository interface {
 	// GetOverview returns high-level application statistics
-	GetOverview(ctx context.Context, userID string) (*model.OverviewAnalytics, error)
+	GetOverview(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error)
 
 	// GetFunnel returns stage-based funnel metrics
-	GetFunnel(ctx context.Context, userID string) (*model.FunnelAnalytics, error)
+	GetFunnel(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error)
 
 	// GetStageTime returns timing metrics per stage
-	GetStageTime(ctx context.Context, userID string) (*model.StageTimeAnalytics, error)
+	GetStageTime(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error)
+
+	// GetStageBottlenecks returns the top slowest stages with their drop-off rates
+	// and the average duration across all stages
+	GetStageBottlenecks(ctx context.Context, userID string, top int) (*model.StageBottleneckAnalytics, error)
 
 	// GetResumeEffectiveness returns effectiveness metrics per resume
-	GetResumeEffectiveness(ctx context.Context, userID string) (*model.ResumeAnalytics, error)
+	GetResumeEffectiveness(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error)
 
 	// GetSourceAnalytics returns metrics grouped by job source
-	GetSourceAnalytics(ctx context.Context, userID string) (*model.SourceAnalytics, error)
+	GetSourceAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error)
+
+	// GetWorkArrangementAnalytics returns application counts per job work arrangement and status
+	GetWorkArrangementAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.WorkArrangementAnalytics, error)
+
+	// GetSourceTrend returns application counts per job source and month for the last months months
+	GetSourceTrend(ctx context.Context, userID string, months int) (*model.SourceTrend, error)
+
+	// GetCohortAnalytics returns outcome metrics grouped by the period applications were started
+	GetCohortAnalytics(ctx context.Context, userID, granularity string) (*model.CohortAnalytics, error)
+
+	// GetActivityTimeSeries returns the number of applications per period over
+	// the last ActivityBucketCount(period) periods
+	GetActivityTimeSeries(ctx context.Context, userID, period string) (*model.ActivityAnalytics, error)
+}
+
+// WeeklyActivityRepository provides application activity for weekly reports.
+// Ranges are half-open: from is inclusive, to is exclusive.
+type WeeklyActivityRepository interface {
+	// GetWeeklyActivity returns application activity counts in the range
+	GetWeeklyActivity(ctx context.Context, userID string, from, to time.Time) (*model.WeeklyActivity, error)
+
+	// CountApplicationEventsByDay returns the number of application events
+	// (applications, stages and comments created) per day, keyed by YYYY-MM-DD
+	CountApplicationEventsByDay(ctx context.Context, userID string, from, to time.Time) (map[string]int, error)
+}
+
+// ReminderCounter counts reminders for weekly reports
+type ReminderCounter interface {
+	// CountDue returns the number of open reminders due in the range
+	CountDue(ctx context.Context, userID string, from, to time.Time) (int, error)
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/andreypavlenko/jobber/modules/analytics/model"
 	"github.com/jackc/pgx/v5"
@@ -27,16 +28,38 @@ func NewAnalyticsRepositoryWithPool(pool DBPool) *AnalyticsRepository {
 	return &AnalyticsRepository{pool: pool}
 }
 
+// appliedWithin returns a condition restricting the applied_at column col to the
+// filter's date range and appends the bounds to args. It is empty without a range.
+func appliedWithin(col string, filter model.AnalyticsFilter, args []any) (string, []any) {
+	cond := ""
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		cond += fmt.Sprintf(" AND %s >= $%d", col, len(args))
+	}
+	if filter.To != nil {
+		// To is an inclusive date, so compare against the start of the following day
+		args = append(args, filter.To.AddDate(0, 0, 1))
+		cond += fmt.Sprintf(" AND %s < $%d", col, len(args))
+	}
+	return cond, args
+}
+
 // GetOverview returns high-level application statistics
-func (r *AnalyticsRepository) GetOverview(ctx context.Context, userID string) (*model.OverviewAnalytics, error) {
+func (r *AnalyticsRepository) GetOverview(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
+	inRange, args := appliedWithin("a.applied_at", filter, []any{filter.UserID})
 	query := `
 		WITH app_stats AS (
 			SELECT
 				COUNT(*) AS total,
 				COUNT(*) FILTER (WHERE status IN ('active', 'on_hold')) AS active,
-				COUNT(*) FILTER (WHERE status IN ('rejected', 'offer', 'archived')) AS closed
-			FROM applications
-			WHERE user_id = $1
+				COUNT(*) FILTER (WHERE status IN ('rejected', 'offer', 'archived')) AS closed,
+				AVG(offered_salary) FILTER (WHERE status = 'offer') AS avg_offered_salary,
+				-- The accepted amount is the negotiated one when a counter-offer was made
+				AVG(COALESCE(negotiated_salary, offered_salary)) FILTER (WHERE status = 'offer') AS avg_accepted_salary,
+				COUNT(*) FILTER (WHERE deadline_at IS NOT NULL) AS with_deadline,
+				COUNT(*) FILTER (WHERE deadline_at < NOW() AND status = 'active') AS overdue_deadline
+			FROM applications a
+			WHERE a.user_id = $1 AND a.deleted_at IS NULL` + inRange + `
 		),
 		response_stats AS (
 			-- Applications that have at least one stage beyond "Applied"
@@ -45,23 +68,26 @@ func (r *AnalyticsRepository) GetOverview(ctx context.Context, userID string) (*
 			FROM applications a
 			JOIN application_stages ast ON ast.application_id = a.id
 			JOIN stage_templates st ON st.id = ast.stage_template_id
-			WHERE a.user_id = $1
+			WHERE a.user_id = $1 AND a.deleted_at IS NULL` + inRange + `
 			AND st."order" > 1
 		),
 		first_response_time AS (
-			-- Average days to first response (first stage after "Applied")
+			-- Average days from applying to the first stage after "Applied";
+			-- applications without a response yield no lateral row and are excluded
 			SELECT
-				AVG(EXTRACT(EPOCH FROM (ast.started_at - a.applied_at)) / 86400) AS avg_days
+				AVG(EXTRACT(EPOCH FROM (first_response.started_at - a.applied_at)) / 86400) AS avg_days
 			FROM applications a
-			JOIN (
-				SELECT DISTINCT ON (application_id) 
-					application_id, started_at
+			CROSS JOIN LATERAL (
+				SELECT ast.started_at
 				FROM application_stages ast
 				JOIN stage_templates st ON st.id = ast.stage_template_id
-				WHERE st."order" > 1
-				ORDER BY application_id, ast."order" ASC
-			) ast ON ast.application_id = a.id
-			WHERE a.user_id = $1
+				WHERE ast.application_id = a.id
+				AND st."order" > 1
+				AND ast.started_at IS NOT NULL
+				ORDER BY ast.started_at ASC
+				LIMIT 1
+			) first_response
+			WHERE a.user_id = $1 AND a.deleted_at IS NULL` + inRange + `
 		)
 		SELECT
 			COALESCE(app_stats.total, 0) AS total_applications,
@@ -72,19 +98,27 @@ func (r *AnalyticsRepository) GetOverview(ctx context.Context, userID string) (*
 					ROUND((response_stats.apps_with_response::numeric / app_stats.total) * 100, 2)
 				ELSE 0 
 			END AS response_rate,
-			COALESCE(ROUND(first_response_time.avg_days::numeric, 2), 0) AS avg_days_to_first_response
+			COALESCE(ROUND(first_response_time.avg_days::numeric, 2), 0) AS avg_days_to_first_response,
+			COALESCE(ROUND(app_stats.avg_offered_salary, 2), 0) AS avg_offered_salary,
+			COALESCE(ROUND(app_stats.avg_accepted_salary, 2), 0) AS avg_accepted_salary,
+			COALESCE(app_stats.with_deadline, 0) AS applications_with_deadline_count,
+			COALESCE(app_stats.overdue_deadline, 0) AS applications_overdue_deadline_count
 		FROM app_stats
 		CROSS JOIN response_stats
 		CROSS JOIN first_response_time
 	`
 
 	analytics := &model.OverviewAnalytics{}
-	err := r.pool.QueryRow(ctx, query, userID).Scan(
+	err := r.pool.QueryRow(ctx, query, args...).Scan(
 		&analytics.TotalApplications,
 		&analytics.ActiveApplications,
 		&analytics.ClosedApplications,
 		&analytics.ResponseRate,
 		&analytics.AvgDaysToFirstResponse,
+		&analytics.AvgOfferedSalary,
+		&analytics.AvgAcceptedSalary,
+		&analytics.ApplicationsWithDeadlineCount,
+		&analytics.ApplicationsOverdueDeadlineCount,
 	)
 	if err != nil {
 		return nil, err
@@ -94,19 +128,20 @@ func (r *AnalyticsRepository) GetOverview(ctx context.Context, userID string) (*
 }
 
 // GetFunnel returns stage-based funnel metrics
-func (r *AnalyticsRepository) GetFunnel(ctx context.Context, userID string) (*model.FunnelAnalytics, error) {
+func (r *AnalyticsRepository) GetFunnel(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
+	inRange, args := appliedWithin("a.applied_at", filter, []any{filter.UserID})
 	query := `
 		WITH total_apps AS (
-			SELECT COUNT(*) AS total FROM applications WHERE user_id = $1
+			SELECT COUNT(*) AS total FROM applications a WHERE a.user_id = $1 AND a.deleted_at IS NULL` + inRange + `
 		),
 		stage_counts AS (
 			SELECT
 				st.name AS stage_name,
 				st."order" AS stage_order,
-				COUNT(DISTINCT ast.application_id) AS app_count
+				COUNT(DISTINCT a.id) AS app_count
 			FROM stage_templates st
 			LEFT JOIN application_stages ast ON ast.stage_template_id = st.id
-			LEFT JOIN applications a ON a.id = ast.application_id AND a.user_id = $1
+			LEFT JOIN applications a ON a.id = ast.application_id AND a.user_id = $1 AND a.deleted_at IS NULL` + inRange + `
 			WHERE st.user_id = $1
 			GROUP BY st.id, st.name, st."order"
 			ORDER BY st."order"
@@ -137,7 +172,7 @@ func (r *AnalyticsRepository) GetFunnel(ctx context.Context, userID string) (*mo
 		ORDER BY stage_order
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID)
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +201,8 @@ func (r *AnalyticsRepository) GetFunnel(ctx context.Context, userID string) (*mo
 }
 
 // GetStageTime returns timing metrics per stage
-func (r *AnalyticsRepository) GetStageTime(ctx context.Context, userID string) (*model.StageTimeAnalytics, error) {
+func (r *AnalyticsRepository) GetStageTime(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error) {
+	inRange, args := appliedWithin("a.applied_at", filter, []any{filter.UserID})
 	query := `
 		WITH stage_durations AS (
 			SELECT
@@ -181,7 +217,7 @@ func (r *AnalyticsRepository) GetStageTime(ctx context.Context, userID string) (
 			FROM application_stages ast
 			JOIN stage_templates st ON st.id = ast.stage_template_id
 			JOIN applications a ON a.id = ast.application_id
-			WHERE a.user_id = $1
+			WHERE a.user_id = $1 AND a.deleted_at IS NULL` + inRange + `
 		)
 		SELECT
 			stage_name,
@@ -195,7 +231,7 @@ func (r *AnalyticsRepository) GetStageTime(ctx context.Context, userID string) (
 		ORDER BY stage_order
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID)
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -224,8 +260,72 @@ func (r *AnalyticsRepository) GetStageTime(ctx context.Context, userID string) (
 	return &model.StageTimeAnalytics{Stages: stages}, nil
 }
 
+// GetStageBottlenecks returns the top slowest stages by average duration. An application
+// drops off at a stage when it was rejected or archived with no later stage.
+func (r *AnalyticsRepository) GetStageBottlenecks(ctx context.Context, userID string, top int) (*model.StageBottleneckAnalytics, error) {
+	query := `
+		WITH stage_durations AS (
+			SELECT
+				st.name AS stage_name,
+				ast.application_id,
+				EXTRACT(EPOCH FROM (COALESCE(ast.completed_at, NOW()) - ast.started_at)) / 86400 AS duration_days,
+				a.status IN ('rejected', 'archived') AND NOT EXISTS (
+					SELECT 1 FROM application_stages later
+					WHERE later.application_id = ast.application_id AND later."order" > ast."order"
+				) AS dropped_off
+			FROM application_stages ast
+			JOIN stage_templates st ON st.id = ast.stage_template_id
+			JOIN applications a ON a.id = ast.application_id
+			WHERE a.user_id = $1 AND a.deleted_at IS NULL
+		),
+		stage_metrics AS (
+			SELECT
+				stage_name,
+				AVG(duration_days) AS avg_days,
+				COUNT(DISTINCT application_id) FILTER (WHERE dropped_off) * 100.0 / COUNT(DISTINCT application_id) AS drop_off_pct
+			FROM stage_durations
+			GROUP BY stage_name
+		)
+		SELECT
+			stage_name,
+			ROUND(avg_days::numeric, 2) AS avg_days,
+			ROUND(drop_off_pct::numeric, 2) AS drop_off_pct,
+			ROUND((AVG(avg_days) OVER ())::numeric, 2) AS global_avg_days
+		FROM stage_metrics
+		ORDER BY avg_days DESC, stage_name
+		LIMIT $2
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, top)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := &model.StageBottleneckAnalytics{}
+	for rows.Next() {
+		var stage model.StageBottleneck
+		if err := rows.Scan(
+			&stage.StageName,
+			&stage.AvgDays,
+			&stage.DropOffPct,
+			&result.GlobalAvgDays,
+		); err != nil {
+			return nil, err
+		}
+		result.Stages = append(result.Stages, stage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // GetResumeEffectiveness returns effectiveness metrics per resume
-func (r *AnalyticsRepository) GetResumeEffectiveness(ctx context.Context, userID string) (*model.ResumeAnalytics, error) {
+func (r *AnalyticsRepository) GetResumeEffectiveness(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error) {
+	inRange, args := appliedWithin("a.applied_at", filter, []any{filter.UserID})
 	query := `
 		WITH resume_stats AS (
 			SELECT
@@ -248,7 +348,7 @@ func (r *AnalyticsRepository) GetResumeEffectiveness(ctx context.Context, userID
 					)
 				) AS interviews_count
 			FROM resumes r
-			LEFT JOIN applications a ON a.resume_id = r.id AND a.user_id = $1
+			LEFT JOIN applications a ON a.resume_id = r.id AND a.user_id = $1 AND a.deleted_at IS NULL` + inRange + `
 			WHERE r.user_id = $1
 			GROUP BY r.id, r.title
 		)
@@ -267,7 +367,7 @@ func (r *AnalyticsRepository) GetResumeEffectiveness(ctx context.Context, userID
 		ORDER BY applications_count DESC, resume_title
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID)
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -296,8 +396,10 @@ func (r *AnalyticsRepository) GetResumeEffectiveness(ctx context.Context, userID
 	return &model.ResumeAnalytics{Resumes: resumes}, nil
 }
 
-// GetSourceAnalytics returns metrics grouped by job source
-func (r *AnalyticsRepository) GetSourceAnalytics(ctx context.Context, userID string) (*model.SourceAnalytics, error) {
+// GetSourceAnalytics returns metrics grouped by job source. An application counts
+// as a referral when its job source is "Referral" or it names a referral contact.
+func (r *AnalyticsRepository) GetSourceAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error) {
+	inRange, args := appliedWithin("a.applied_at", filter, []any{filter.UserID})
 	query := `
 		WITH source_stats AS (
 			SELECT
@@ -309,10 +411,21 @@ func (r *AnalyticsRepository) GetSourceAnalytics(ctx context.Context, userID str
 						JOIN stage_templates st ON st.id = ast.stage_template_id
 						WHERE ast.application_id = a.id AND st."order" > 1
 					)
-				) AS responses_count
+				) AS responses_count,
+				COUNT(DISTINCT a.id) FILTER (
+					WHERE LOWER(j.source) = 'referral' OR a.referral_contact_name IS NOT NULL
+				) AS referrals_count,
+				COUNT(DISTINCT a.id) FILTER (
+					WHERE (LOWER(j.source) = 'referral' OR a.referral_contact_name IS NOT NULL)
+					AND EXISTS (
+						SELECT 1 FROM application_stages ast
+						JOIN stage_templates st ON st.id = ast.stage_template_id
+						WHERE ast.application_id = a.id AND st."order" > 1
+					)
+				) AS referral_responses_count
 			FROM applications a
 			JOIN jobs j ON j.id = a.job_id
-			WHERE a.user_id = $1
+			WHERE a.user_id = $1 AND a.deleted_at IS NULL` + inRange + `
 			GROUP BY COALESCE(NULLIF(j.source, ''), 'Unknown')
 		)
 		SELECT
@@ -323,12 +436,18 @@ func (r *AnalyticsRepository) GetSourceAnalytics(ctx context.Context, userID str
 				WHEN applications_count > 0 
 				THEN ROUND((responses_count::numeric / applications_count) * 100, 2)
 				ELSE 0 
-			END AS conversion_rate
+			END AS conversion_rate,
+			referrals_count,
+			CASE
+				WHEN referrals_count > 0
+				THEN ROUND((referral_responses_count::numeric / referrals_count) * 100, 2)
+				ELSE 0
+			END AS referral_conversion_rate
 		FROM source_stats
 		ORDER BY applications_count DESC, source_name
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID)
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -342,6 +461,8 @@ func (r *AnalyticsRepository) GetSourceAnalytics(ctx context.Context, userID str
 			&source.ApplicationsCount,
 			&source.ResponsesCount,
 			&source.ConversionRate,
+			&source.ReferralsCount,
+			&source.ReferralConversionRate,
 		); err != nil {
 			return nil, err
 		}
@@ -354,3 +475,231 @@ func (r *AnalyticsRepository) GetSourceAnalytics(ctx context.Context, userID str
 
 	return &model.SourceAnalytics{Sources: sources}, nil
 }
+
+// GetWorkArrangementAnalytics returns the number of applications in each status
+// grouped by the work arrangement of their job; jobs without one are grouped as unspecified
+func (r *AnalyticsRepository) GetWorkArrangementAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.WorkArrangementAnalytics, error) {
+	inRange, args := appliedWithin("a.applied_at", filter, []any{filter.UserID})
+	query := `
+		SELECT
+			COALESCE(j.work_arrangement, 'unspecified') AS work_arrangement,
+			COUNT(*) AS applications_count,
+			COUNT(*) FILTER (WHERE a.status = 'active') AS active_count,
+			COUNT(*) FILTER (WHERE a.status = 'on_hold') AS on_hold_count,
+			COUNT(*) FILTER (WHERE a.status = 'offer') AS offer_count,
+			COUNT(*) FILTER (WHERE a.status = 'rejected') AS rejected_count,
+			COUNT(*) FILTER (WHERE a.status = 'archived') AS archived_count
+		FROM applications a
+		JOIN jobs j ON j.id = a.job_id
+		WHERE a.user_id = $1 AND a.deleted_at IS NULL` + inRange + `
+		GROUP BY COALESCE(j.work_arrangement, 'unspecified')
+		ORDER BY applications_count DESC, work_arrangement
+	`
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	arrangements := []model.WorkArrangementMetrics{}
+	for rows.Next() {
+		var metrics model.WorkArrangementMetrics
+		if err := rows.Scan(
+			&metrics.WorkArrangement,
+			&metrics.ApplicationsCount,
+			&metrics.ActiveCount,
+			&metrics.OnHoldCount,
+			&metrics.OfferCount,
+			&metrics.RejectedCount,
+			&metrics.ArchivedCount,
+		); err != nil {
+			return nil, err
+		}
+		arrangements = append(arrangements, metrics)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &model.WorkArrangementAnalytics{Arrangements: arrangements}, nil
+}
+
+// GetSourceTrend returns monthly application counts per job source over the
+// last months calendar months, including the current one. Sources without
+// applications in the window are omitted; quiet months of the others are zero.
+func (r *AnalyticsRepository) GetSourceTrend(ctx context.Context, userID string, months int) (*model.SourceTrend, error) {
+	query := `
+		WITH months AS (
+			SELECT generate_series(
+				date_trunc('month', now()) - ($2 - 1) * interval '1 month',
+				date_trunc('month', now()),
+				interval '1 month'
+			) AS month
+		),
+		monthly_counts AS (
+			SELECT
+				COALESCE(NULLIF(j.source, ''), 'Unknown') AS source_name,
+				date_trunc('month', a.applied_at) AS month,
+				COUNT(*) AS applications_count
+			FROM applications a
+			JOIN jobs j ON j.id = a.job_id
+			WHERE a.user_id = $1 AND a.deleted_at IS NULL
+				AND a.applied_at >= date_trunc('month', now()) - ($2 - 1) * interval '1 month'
+			GROUP BY COALESCE(NULLIF(j.source, ''), 'Unknown'), date_trunc('month', a.applied_at)
+		)
+		SELECT
+			s.source_name,
+			to_char(m.month, 'YYYY-MM') AS month,
+			COALESCE(mc.applications_count, 0) AS applications_count
+		FROM (SELECT DISTINCT source_name FROM monthly_counts) s
+		CROSS JOIN months m
+		LEFT JOIN monthly_counts mc ON mc.source_name = s.source_name AND mc.month = m.month
+		ORDER BY s.source_name, m.month
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, months)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sources := []model.SourceMonthlyTrend{}
+	for rows.Next() {
+		var sourceName string
+		var month model.SourceTrendMonth
+		if err := rows.Scan(&sourceName, &month.Month, &month.Count); err != nil {
+			return nil, err
+		}
+		// Rows are ordered by source, so each source's months are contiguous
+		if n := len(sources); n == 0 || sources[n-1].Source != sourceName {
+			sources = append(sources, model.SourceMonthlyTrend{Source: sourceName})
+		}
+		last := &sources[len(sources)-1]
+		last.Months = append(last.Months, month)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &model.SourceTrend{Sources: sources}, nil
+}
+
+// GetCohortAnalytics returns outcome metrics grouped by the period applications were started.
+// granularity must be one of week, month or quarter (validated by the service).
+func (r *AnalyticsRepository) GetCohortAnalytics(ctx context.Context, userID, granularity string) (*model.CohortAnalytics, error) {
+	query := `
+		WITH cohort_stats AS (
+			SELECT
+				date_trunc($2, a.applied_at) AS cohort_start,
+				COUNT(*) AS applications_started,
+				COUNT(*) FILTER (WHERE a.status = 'offer') AS offers_received,
+				COUNT(*) FILTER (WHERE a.status = 'rejected') AS rejected_count,
+				COUNT(*) FILTER (WHERE a.status IN ('active', 'on_hold')) AS still_active
+			FROM applications a
+			WHERE a.user_id = $1 AND a.deleted_at IS NULL
+			GROUP BY date_trunc($2, a.applied_at)
+		)
+		SELECT
+			to_char(cohort_start, 'YYYY-MM-DD') AS cohort_period,
+			applications_started,
+			offers_received,
+			CASE
+				WHEN applications_started > 0
+				THEN ROUND((rejected_count::numeric / applications_started) * 100, 2)
+				ELSE 0
+			END AS rejection_rate,
+			still_active
+		FROM cohort_stats
+		ORDER BY cohort_start DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, granularity)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var cohorts []model.CohortMetrics
+	for rows.Next() {
+		var cohort model.CohortMetrics
+		if err := rows.Scan(
+			&cohort.CohortPeriod,
+			&cohort.ApplicationsStarted,
+			&cohort.OffersReceived,
+			&cohort.RejectionRate,
+			&cohort.StillActive,
+		); err != nil {
+			return nil, err
+		}
+		cohorts = append(cohorts, cohort)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &model.CohortAnalytics{Granularity: granularity, Cohorts: cohorts}, nil
+}
+
+// activityIntervals is the length of each activity period as a PostgreSQL interval
+var activityIntervals = map[string]string{
+	model.ActivityPeriodWeek:    "1 week",
+	model.ActivityPeriodMonth:   "1 month",
+	model.ActivityPeriodQuarter: "3 months",
+}
+
+// GetActivityTimeSeries returns the number of applications per period over the
+// last ActivityBucketCount(period) periods, including the current one. Every
+// period is returned, oldest first, with zero for periods without applications.
+// period must be one of week, month or quarter (validated by the service).
+func (r *AnalyticsRepository) GetActivityTimeSeries(ctx context.Context, userID, period string) (*model.ActivityAnalytics, error) {
+	buckets, ok := model.ActivityBucketCount(period)
+	if !ok {
+		return nil, model.ErrInvalidPeriod
+	}
+
+	query := `
+		WITH buckets AS (
+			SELECT generate_series(
+				date_trunc($2, now()) - ($3::int - 1) * $4::interval,
+				date_trunc($2, now()),
+				$4::interval
+			) AS bucket
+		),
+		bucket_counts AS (
+			SELECT date_trunc($2, a.applied_at) AS bucket, COUNT(*) AS applications_count
+			FROM applications a
+			WHERE a.user_id = $1 AND a.deleted_at IS NULL
+				AND a.applied_at >= date_trunc($2, now()) - ($3::int - 1) * $4::interval
+			GROUP BY date_trunc($2, a.applied_at)
+		)
+		SELECT to_char(b.bucket, 'YYYY-MM-DD'), COALESCE(bc.applications_count, 0)
+		FROM buckets b
+		LEFT JOIN bucket_counts bc ON bc.bucket = b.bucket
+		ORDER BY b.bucket
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, period, buckets, activityIntervals[period])
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	series := make([]model.ActivityBucket, 0, buckets)
+	for rows.Next() {
+		var bucket model.ActivityBucket
+		if err := rows.Scan(&bucket.Date, &bucket.Count); err != nil {
+			return nil, err
+		}
+		series = append(series, bucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &model.ActivityAnalytics{Period: period, Buckets: series}, nil
+}
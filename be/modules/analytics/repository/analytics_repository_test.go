@@ -3,7 +3,9 @@ package repository
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/andreypavlenko/jobber/modules/analytics/model"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -22,13 +24,39 @@ func TestAnalyticsRepository_GetOverview(t *testing.T) {
 			WithArgs(userID).
 			WillReturnError(assert.AnError)
 
-		result, err := repo.GetOverview(context.Background(), userID)
+		result, err := repo.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("restricts applications to the date range", func(t *testing.T) {
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+		rows := pgxmock.NewRows([]string{
+			"total_applications",
+			"active_applications",
+			"closed_applications",
+			"response_rate",
+			"avg_days_to_first_response",
+			"avg_offered_salary",
+			"avg_accepted_salary",
+			"applications_with_deadline_count",
+			"applications_overdue_deadline_count",
+		}).AddRow(3, 2, 1, 0.0, 0.0, 0.0, 0.0, 0, 0)
+
+		mock.ExpectQuery(`WHERE a\.user_id = \$1 AND a\.deleted_at IS NULL AND a\.applied_at >= \$2 AND a\.applied_at < \$3`).
+			WithArgs(userID, from, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)).
+			WillReturnRows(rows)
+
+		result, err := repo.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID, From: &from, To: &to})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.TotalApplications)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("returns overview analytics successfully", func(t *testing.T) {
 		rows := pgxmock.NewRows([]string{
 			"total_applications",
@@ -36,13 +64,17 @@ func TestAnalyticsRepository_GetOverview(t *testing.T) {
 			"closed_applications",
 			"response_rate",
 			"avg_days_to_first_response",
-		}).AddRow(10, 5, 5, 50.0, 3.5)
+			"avg_offered_salary",
+			"avg_accepted_salary",
+			"applications_with_deadline_count",
+			"applications_overdue_deadline_count",
+		}).AddRow(10, 5, 5, 50.0, 3.5, 95000.0, 100000.0, 4, 1)
 
-		mock.ExpectQuery("WITH app_stats AS").
+		mock.ExpectQuery(`deadline_at < NOW\(\) AND status = 'active'\) AS overdue_deadline`).
 			WithArgs(userID).
 			WillReturnRows(rows)
 
-		result, err := repo.GetOverview(context.Background(), userID)
+		result, err := repo.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		assert.Equal(t, 10, result.TotalApplications)
@@ -50,6 +82,10 @@ func TestAnalyticsRepository_GetOverview(t *testing.T) {
 		assert.Equal(t, 5, result.ClosedApplications)
 		assert.Equal(t, 50.0, result.ResponseRate)
 		assert.Equal(t, 3.5, result.AvgDaysToFirstResponse)
+		assert.Equal(t, 95000.0, result.AvgOfferedSalary)
+		assert.Equal(t, 100000.0, result.AvgAcceptedSalary)
+		assert.Equal(t, 4, result.ApplicationsWithDeadlineCount)
+		assert.Equal(t, 1, result.ApplicationsOverdueDeadlineCount)
 
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
@@ -61,23 +97,69 @@ func TestAnalyticsRepository_GetOverview(t *testing.T) {
 			"closed_applications",
 			"response_rate",
 			"avg_days_to_first_response",
-		}).AddRow(0, 0, 0, 0.0, 0.0)
+			"avg_offered_salary",
+			"avg_accepted_salary",
+			"applications_with_deadline_count",
+			"applications_overdue_deadline_count",
+		}).AddRow(0, 0, 0, 0.0, 0.0, 0.0, 0.0, 0, 0)
 
 		mock.ExpectQuery("WITH app_stats AS").
 			WithArgs(userID).
 			WillReturnRows(rows)
 
-		result, err := repo.GetOverview(context.Background(), userID)
+		result, err := repo.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		assert.Equal(t, 0, result.TotalApplications)
 		assert.Equal(t, 0, result.ActiveApplications)
 		assert.Equal(t, 0.0, result.ResponseRate)
+		assert.Equal(t, 0.0, result.AvgOfferedSalary)
+		assert.Equal(t, 0.0, result.AvgAcceptedSalary)
 
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
+func TestAnalyticsRepository_GetOverview_AvgDaysToFirstResponse(t *testing.T) {
+	var capturedSQL string
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(_, actualSQL string) error {
+		capturedSQL = actualSQL
+		return nil
+	})))
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := NewAnalyticsRepositoryWithPool(mock)
+	userID := "user-123"
+
+	// Three applications: first response after 5 days, after 10 days, and none.
+	// The unanswered application has no lateral row, so the average is (5 + 10) / 2.
+	rows := pgxmock.NewRows([]string{
+		"total_applications",
+		"active_applications",
+		"closed_applications",
+		"response_rate",
+		"avg_days_to_first_response",
+		"avg_offered_salary",
+		"avg_accepted_salary",
+		"applications_with_deadline_count",
+		"applications_overdue_deadline_count",
+	}).AddRow(3, 3, 0, 66.67, 7.5, 0.0, 0.0, 0, 0)
+
+	mock.ExpectQuery("WITH app_stats AS").
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	result, err := repo.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
+
+	require.NoError(t, err)
+	assert.Equal(t, 7.5, result.AvgDaysToFirstResponse)
+	assert.Contains(t, capturedSQL, "CROSS JOIN LATERAL")
+	assert.Contains(t, capturedSQL, "first_response.started_at - a.applied_at")
+	assert.Contains(t, capturedSQL, "ast.started_at IS NOT NULL")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestAnalyticsRepository_GetFunnel(t *testing.T) {
 	mock, err := pgxmock.NewPool()
 	require.NoError(t, err)
@@ -91,7 +173,7 @@ func TestAnalyticsRepository_GetFunnel(t *testing.T) {
 			WithArgs(userID).
 			WillReturnError(assert.AnError)
 
-		result, err := repo.GetFunnel(context.Background(), userID)
+		result, err := repo.GetFunnel(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -115,7 +197,7 @@ func TestAnalyticsRepository_GetFunnel(t *testing.T) {
 			WithArgs(userID).
 			WillReturnRows(rows)
 
-		result, err := repo.GetFunnel(context.Background(), userID)
+		result, err := repo.GetFunnel(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		require.Len(t, result.Stages, 4)
@@ -145,7 +227,7 @@ func TestAnalyticsRepository_GetFunnel(t *testing.T) {
 			WithArgs(userID).
 			WillReturnRows(rows)
 
-		result, err := repo.GetFunnel(context.Background(), userID)
+		result, err := repo.GetFunnel(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		assert.Empty(t, result.Stages)
@@ -178,7 +260,7 @@ func TestAnalyticsRepository_GetStageTime(t *testing.T) {
 			WithArgs(userID).
 			WillReturnRows(rows)
 
-		result, err := repo.GetStageTime(context.Background(), userID)
+		result, err := repo.GetStageTime(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		require.Len(t, result.Stages, 2)
@@ -206,10 +288,56 @@ func TestAnalyticsRepository_GetStageTime(t *testing.T) {
 			WithArgs(userID).
 			WillReturnRows(rows)
 
-		result, err := repo.GetStageTime(context.Background(), userID)
+		result, err := repo.GetStageTime(context.Background(), model.AnalyticsFilter{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Stages)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAnalyticsRepository_GetStageBottlenecks(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := NewAnalyticsRepositoryWithPool(mock)
+	userID := "user-123"
+
+	t.Run("returns slowest stages with the global average", func(t *testing.T) {
+		rows := pgxmock.NewRows([]string{"stage_name", "avg_days", "drop_off_pct", "global_avg_days"}).
+			AddRow("Take-Home", 16.0, 40.0, 8.0).
+			AddRow("Phone Screen", 4.0, 10.0, 8.0)
+
+		mock.ExpectQuery("WITH stage_durations AS").
+			WithArgs(userID, 2).
+			WillReturnRows(rows)
+
+		result, err := repo.GetStageBottlenecks(context.Background(), userID, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, 8.0, result.GlobalAvgDays)
+		require.Len(t, result.Stages, 2)
+		assert.Equal(t, "Take-Home", result.Stages[0].StageName)
+		assert.Equal(t, 16.0, result.Stages[0].AvgDays)
+		assert.Equal(t, 40.0, result.Stages[0].DropOffPct)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns empty for no stages", func(t *testing.T) {
+		rows := pgxmock.NewRows([]string{"stage_name", "avg_days", "drop_off_pct", "global_avg_days"})
+
+		mock.ExpectQuery("WITH stage_durations AS").
+			WithArgs(userID, 3).
+			WillReturnRows(rows)
+
+		result, err := repo.GetStageBottlenecks(context.Background(), userID, 3)
 
 		require.NoError(t, err)
 		assert.Empty(t, result.Stages)
+		assert.Zero(t, result.GlobalAvgDays)
 
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
@@ -239,7 +367,7 @@ func TestAnalyticsRepository_GetResumeEffectiveness(t *testing.T) {
 			WithArgs(userID).
 			WillReturnRows(rows)
 
-		result, err := repo.GetResumeEffectiveness(context.Background(), userID)
+		result, err := repo.GetResumeEffectiveness(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		require.Len(t, result.Resumes, 2)
@@ -270,7 +398,7 @@ func TestAnalyticsRepository_GetResumeEffectiveness(t *testing.T) {
 			WithArgs(userID).
 			WillReturnRows(rows)
 
-		result, err := repo.GetResumeEffectiveness(context.Background(), userID)
+		result, err := repo.GetResumeEffectiveness(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		assert.Empty(t, result.Resumes)
@@ -293,16 +421,18 @@ func TestAnalyticsRepository_GetSourceAnalytics(t *testing.T) {
 			"applications_count",
 			"responses_count",
 			"conversion_rate",
+			"referrals_count",
+			"referral_conversion_rate",
 		}).
-			AddRow("LinkedIn", 50, 25, 50.0).
-			AddRow("Indeed", 30, 10, 33.33).
-			AddRow("Unknown", 20, 5, 25.0)
+			AddRow("LinkedIn", 50, 25, 50.0, 4, 75.0).
+			AddRow("Indeed", 30, 10, 33.33, 0, 0.0).
+			AddRow("Unknown", 20, 5, 25.0, 1, 0.0)
 
 		mock.ExpectQuery("WITH source_stats AS").
 			WithArgs(userID).
 			WillReturnRows(rows)
 
-		result, err := repo.GetSourceAnalytics(context.Background(), userID)
+		result, err := repo.GetSourceAnalytics(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		require.Len(t, result.Sources, 3)
@@ -311,6 +441,8 @@ func TestAnalyticsRepository_GetSourceAnalytics(t *testing.T) {
 		assert.Equal(t, 50, result.Sources[0].ApplicationsCount)
 		assert.Equal(t, 25, result.Sources[0].ResponsesCount)
 		assert.Equal(t, 50.0, result.Sources[0].ConversionRate)
+		assert.Equal(t, 4, result.Sources[0].ReferralsCount)
+		assert.Equal(t, 75.0, result.Sources[0].ReferralConversionRate)
 
 		assert.Equal(t, "Indeed", result.Sources[1].SourceName)
 		assert.Equal(t, 33.33, result.Sources[1].ConversionRate)
@@ -318,19 +450,42 @@ func TestAnalyticsRepository_GetSourceAnalytics(t *testing.T) {
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("applies an open-ended range", func(t *testing.T) {
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		rows := pgxmock.NewRows([]string{
+			"source_name",
+			"applications_count",
+			"responses_count",
+			"conversion_rate",
+			"referrals_count",
+			"referral_conversion_rate",
+		})
+
+		mock.ExpectQuery(`WHERE a\.user_id = \$1 AND a\.deleted_at IS NULL AND a\.applied_at >= \$2\s+GROUP BY`).
+			WithArgs(userID, from).
+			WillReturnRows(rows)
+
+		_, err := repo.GetSourceAnalytics(context.Background(), model.AnalyticsFilter{UserID: userID, From: &from})
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("returns empty for no applications", func(t *testing.T) {
 		rows := pgxmock.NewRows([]string{
 			"source_name",
 			"applications_count",
 			"responses_count",
 			"conversion_rate",
+			"referrals_count",
+			"referral_conversion_rate",
 		})
 
 		mock.ExpectQuery("WITH source_stats AS").
 			WithArgs(userID).
 			WillReturnRows(rows)
 
-		result, err := repo.GetSourceAnalytics(context.Background(), userID)
+		result, err := repo.GetSourceAnalytics(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		assert.Empty(t, result.Sources)
@@ -338,3 +493,222 @@ func TestAnalyticsRepository_GetSourceAnalytics(t *testing.T) {
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+func TestAnalyticsRepository_GetWorkArrangementAnalytics(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := NewAnalyticsRepositoryWithPool(mock)
+	userID := "user-123"
+	columns := []string{
+		"work_arrangement",
+		"applications_count",
+		"active_count",
+		"on_hold_count",
+		"offer_count",
+		"rejected_count",
+		"archived_count",
+	}
+
+	t.Run("returns status counts per arrangement", func(t *testing.T) {
+		rows := pgxmock.NewRows(columns).
+			AddRow("remote", 12, 6, 1, 2, 3, 0).
+			AddRow(model.UnspecifiedWorkArrangement, 4, 1, 0, 0, 2, 1)
+
+		mock.ExpectQuery(`GROUP BY COALESCE\(j\.work_arrangement, 'unspecified'\)`).
+			WithArgs(userID).
+			WillReturnRows(rows)
+
+		result, err := repo.GetWorkArrangementAnalytics(context.Background(), model.AnalyticsFilter{UserID: userID})
+
+		require.NoError(t, err)
+		require.Len(t, result.Arrangements, 2)
+		assert.Equal(t, model.WorkArrangementMetrics{
+			WorkArrangement: "remote", ApplicationsCount: 12, ActiveCount: 6, OnHoldCount: 1, OfferCount: 2, RejectedCount: 3,
+		}, result.Arrangements[0])
+		assert.Equal(t, model.UnspecifiedWorkArrangement, result.Arrangements[1].WorkArrangement)
+		assert.Equal(t, 1, result.Arrangements[1].ArchivedCount)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("applies the date range", func(t *testing.T) {
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+
+		mock.ExpectQuery(`a\.applied_at >= \$2 AND a\.applied_at < \$3\s+GROUP BY`).
+			WithArgs(userID, from, to.AddDate(0, 0, 1)).
+			WillReturnRows(pgxmock.NewRows(columns))
+
+		result, err := repo.GetWorkArrangementAnalytics(context.Background(), model.AnalyticsFilter{UserID: userID, From: &from, To: &to})
+
+		require.NoError(t, err)
+		assert.NotNil(t, result.Arrangements)
+		assert.Empty(t, result.Arrangements)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAnalyticsRepository_GetCohortAnalytics(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := NewAnalyticsRepositoryWithPool(mock)
+	userID := "user-123"
+	columns := []string{
+		"cohort_period",
+		"applications_started",
+		"offers_received",
+		"rejection_rate",
+		"still_active",
+	}
+
+	t.Run("current month cohort has zero offers when all applications are active", func(t *testing.T) {
+		currentMonth := time.Now().UTC().Format("2006-01") + "-01"
+		rows := pgxmock.NewRows(columns).
+			AddRow(currentMonth, 4, 0, 0.0, 4).
+			AddRow("2026-01-01", 10, 2, 50.0, 3)
+
+		mock.ExpectQuery("WITH cohort_stats AS").
+			WithArgs(userID, "month").
+			WillReturnRows(rows)
+
+		result, err := repo.GetCohortAnalytics(context.Background(), userID, "month")
+
+		require.NoError(t, err)
+		assert.Equal(t, "month", result.Granularity)
+		require.Len(t, result.Cohorts, 2)
+
+		current := result.Cohorts[0]
+		assert.Equal(t, currentMonth, current.CohortPeriod)
+		assert.Equal(t, 4, current.ApplicationsStarted)
+		assert.Equal(t, 0, current.OffersReceived)
+		assert.Equal(t, 0.0, current.RejectionRate)
+		assert.Equal(t, current.ApplicationsStarted, current.StillActive)
+
+		assert.Equal(t, 2, result.Cohorts[1].OffersReceived)
+		assert.Equal(t, 50.0, result.Cohorts[1].RejectionRate)
+
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns error when query fails", func(t *testing.T) {
+		mock.ExpectQuery("WITH cohort_stats AS").
+			WithArgs(userID, "week").
+			WillReturnError(assert.AnError)
+
+		result, err := repo.GetCohortAnalytics(context.Background(), userID, "week")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAnalyticsRepository_GetActivityTimeSeries(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := NewAnalyticsRepositoryWithPool(mock)
+	userID := "user-123"
+
+	t.Run("returns zero-filled buckets oldest first", func(t *testing.T) {
+		rows := pgxmock.NewRows([]string{"bucket", "applications_count"}).
+			AddRow("2026-09-28", 0).
+			AddRow("2026-10-05", 4).
+			AddRow("2026-10-12", 1)
+
+		mock.ExpectQuery("WITH buckets AS").
+			WithArgs(userID, "week", 12, "1 week").
+			WillReturnRows(rows)
+
+		result, err := repo.GetActivityTimeSeries(context.Background(), userID, "week")
+
+		require.NoError(t, err)
+		assert.Equal(t, "week", result.Period)
+		assert.Equal(t, []model.ActivityBucket{
+			{Date: "2026-09-28", Count: 0},
+			{Date: "2026-10-05", Count: 4},
+			{Date: "2026-10-12", Count: 1},
+		}, result.Buckets)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("covers eight quarters of three months", func(t *testing.T) {
+		mock.ExpectQuery("WITH buckets AS").
+			WithArgs(userID, "quarter", 8, "3 months").
+			WillReturnRows(pgxmock.NewRows([]string{"bucket", "applications_count"}))
+
+		result, err := repo.GetActivityTimeSeries(context.Background(), userID, "quarter")
+
+		require.NoError(t, err)
+		assert.NotNil(t, result.Buckets)
+		assert.Empty(t, result.Buckets)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects unsupported period", func(t *testing.T) {
+		result, err := repo.GetActivityTimeSeries(context.Background(), userID, "day")
+
+		assert.ErrorIs(t, err, model.ErrInvalidPeriod)
+		assert.Nil(t, result)
+	})
+}
+
+func TestAnalyticsRepository_GetSourceTrend(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := NewAnalyticsRepositoryWithPool(mock)
+	userID := "user-123"
+
+	t.Run("groups monthly rows per source", func(t *testing.T) {
+		rows := pgxmock.NewRows([]string{"source_name", "month", "applications_count"}).
+			AddRow("LinkedIn", "2026-01", 5).
+			AddRow("LinkedIn", "2026-02", 3).
+			AddRow("Referral", "2026-01", 0).
+			AddRow("Referral", "2026-02", 2)
+
+		mock.ExpectQuery("date_trunc\\('month', a.applied_at\\)").
+			WithArgs(userID, 2).
+			WillReturnRows(rows)
+
+		result, err := repo.GetSourceTrend(context.Background(), userID, 2)
+
+		require.NoError(t, err)
+		require.Len(t, result.Sources, 2)
+		assert.Equal(t, "LinkedIn", result.Sources[0].Source)
+		assert.Equal(t, []model.SourceTrendMonth{{Month: "2026-01", Count: 5}, {Month: "2026-02", Count: 3}}, result.Sources[0].Months)
+		assert.Equal(t, "Referral", result.Sources[1].Source)
+		assert.Equal(t, 0, result.Sources[1].Months[0].Count)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns empty list when there are no applications", func(t *testing.T) {
+		mock.ExpectQuery("WITH months AS").
+			WithArgs(userID, 6).
+			WillReturnRows(pgxmock.NewRows([]string{"source_name", "month", "applications_count"}))
+
+		result, err := repo.GetSourceTrend(context.Background(), userID, 6)
+
+		require.NoError(t, err)
+		assert.NotNil(t, result.Sources)
+		assert.Empty(t, result.Sources)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns error when query fails", func(t *testing.T) {
+		mock.ExpectQuery("WITH months AS").
+			WithArgs(userID, 6).
+			WillReturnError(assert.AnError)
+
+		result, err := repo.GetSourceTrend(context.Background(), userID, 6)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/analytics/model"
+)
+
+// GetWeeklyActivity returns application activity counts in [from, to).
+// Offers and rejections are counted by applications updated in the range with that status.
+// Applications in the trash are not counted.
+func (r *AnalyticsRepository) GetWeeklyActivity(ctx context.Context, userID string, from, to time.Time) (*model.WeeklyActivity, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM applications
+				WHERE user_id = $1 AND deleted_at IS NULL AND created_at >= $2 AND created_at < $3),
+			(SELECT COUNT(*) FROM application_stages s
+				JOIN applications a ON a.id = s.application_id
+				WHERE a.user_id = $1 AND a.deleted_at IS NULL AND s.created_at >= $2 AND s.created_at < $3),
+			(SELECT COUNT(*) FROM applications
+				WHERE user_id = $1 AND deleted_at IS NULL AND status = 'offer' AND updated_at >= $2 AND updated_at < $3),
+			(SELECT COUNT(*) FROM applications
+				WHERE user_id = $1 AND deleted_at IS NULL AND status = 'rejected' AND updated_at >= $2 AND updated_at < $3)
+	`
+
+	activity := &model.WeeklyActivity{}
+	err := r.pool.QueryRow(ctx, query, userID, from, to).Scan(
+		&activity.NewApplications,
+		&activity.StageProgressions,
+		&activity.OffersReceived,
+		&activity.Rejections,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+// CountApplicationEventsByDay returns application, stage and comment creations per day in [from, to).
+// Events of applications in the trash are not counted.
+func (r *AnalyticsRepository) CountApplicationEventsByDay(ctx context.Context, userID string, from, to time.Time) (map[string]int, error) {
+	query := `
+		SELECT TO_CHAR(event_at, 'YYYY-MM-DD') AS day, COUNT(*)
+		FROM (
+			SELECT created_at AS event_at FROM applications
+			WHERE user_id = $1 AND deleted_at IS NULL AND created_at >= $2 AND created_at < $3
+			UNION ALL
+			SELECT s.created_at FROM application_stages s
+			JOIN applications a ON a.id = s.application_id
+			WHERE a.user_id = $1 AND a.deleted_at IS NULL AND s.created_at >= $2 AND s.created_at < $3
+			UNION ALL
+			SELECT c.created_at FROM comments c
+			JOIN applications a ON a.id = c.application_id
+			WHERE c.user_id = $1 AND a.deleted_at IS NULL AND c.created_at >= $2 AND c.created_at < $3
+		) events
+		GROUP BY day
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var day string
+		var count int
+		if err := rows.Scan(&day, &count); err != nil {
+			return nil, err
+		}
+		counts[day] = count
+	}
+	return counts, rows.Err()
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsRepository_CountApplicationEventsByDay(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	repo := NewAnalyticsRepositoryWithPool(mock)
+	from := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	mock.ExpectQuery(`WHERE user_id = \$1 AND deleted_at IS NULL AND created_at >= \$2[\s\S]+WHERE a.user_id = \$1 AND a.deleted_at IS NULL AND s.created_at >= \$2[\s\S]+WHERE c.user_id = \$1 AND a.deleted_at IS NULL AND c.created_at >= \$2`).
+		WithArgs("user-123", from, to).
+		WillReturnRows(pgxmock.NewRows([]string{"day", "count"}).
+			AddRow("2026-10-12", 3).
+			AddRow("2026-10-14", 1))
+
+	days, err := repo.CountApplicationEventsByDay(context.Background(), "user-123", from, to)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"2026-10-12": 3, "2026-10-14": 1}, days)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/andreypavlenko/jobber/modules/analytics/model"
 	"github.com/andreypavlenko/jobber/modules/analytics/ports"
@@ -9,33 +10,105 @@ import (
 
 type AnalyticsService struct {
 	repo ports.AnalyticsRepository
+	now  func() time.Time
 }
 
 func NewAnalyticsService(repo ports.AnalyticsRepository) *AnalyticsService {
-	return &AnalyticsService{repo: repo}
+	return &AnalyticsService{repo: repo, now: time.Now}
 }
 
 // GetOverview returns high-level application statistics
-func (s *AnalyticsService) GetOverview(ctx context.Context, userID string) (*model.OverviewAnalytics, error) {
-	return s.repo.GetOverview(ctx, userID)
+func (s *AnalyticsService) GetOverview(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
+	if err := filter.Validate(s.now()); err != nil {
+		return nil, err
+	}
+	return s.repo.GetOverview(ctx, filter)
 }
 
 // GetFunnel returns stage-based funnel metrics
-func (s *AnalyticsService) GetFunnel(ctx context.Context, userID string) (*model.FunnelAnalytics, error) {
-	return s.repo.GetFunnel(ctx, userID)
+func (s *AnalyticsService) GetFunnel(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
+	if err := filter.Validate(s.now()); err != nil {
+		return nil, err
+	}
+	return s.repo.GetFunnel(ctx, filter)
 }
 
 // GetStageTime returns timing metrics per stage
-func (s *AnalyticsService) GetStageTime(ctx context.Context, userID string) (*model.StageTimeAnalytics, error) {
-	return s.repo.GetStageTime(ctx, userID)
+func (s *AnalyticsService) GetStageTime(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error) {
+	if err := filter.Validate(s.now()); err != nil {
+		return nil, err
+	}
+	return s.repo.GetStageTime(ctx, filter)
+}
+
+// GetStageBottlenecks returns the top slowest stages, flagging those that take
+// more than BottleneckFactor times the average stage duration.
+// top must be between 1 and MaxBottleneckTop.
+func (s *AnalyticsService) GetStageBottlenecks(ctx context.Context, userID string, top int) (*model.StageBottleneckAnalytics, error) {
+	if top < 1 || top > model.MaxBottleneckTop {
+		return nil, model.ErrInvalidTop
+	}
+	analytics, err := s.repo.GetStageBottlenecks(ctx, userID, top)
+	if err != nil {
+		return nil, err
+	}
+	analytics.MarkBottlenecks()
+	return analytics, nil
 }
 
 // GetResumeEffectiveness returns effectiveness metrics per resume
-func (s *AnalyticsService) GetResumeEffectiveness(ctx context.Context, userID string) (*model.ResumeAnalytics, error) {
-	return s.repo.GetResumeEffectiveness(ctx, userID)
+func (s *AnalyticsService) GetResumeEffectiveness(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error) {
+	if err := filter.Validate(s.now()); err != nil {
+		return nil, err
+	}
+	return s.repo.GetResumeEffectiveness(ctx, filter)
 }
 
 // GetSourceAnalytics returns metrics grouped by job source
-func (s *AnalyticsService) GetSourceAnalytics(ctx context.Context, userID string) (*model.SourceAnalytics, error) {
-	return s.repo.GetSourceAnalytics(ctx, userID)
+func (s *AnalyticsService) GetSourceAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error) {
+	if err := filter.Validate(s.now()); err != nil {
+		return nil, err
+	}
+	return s.repo.GetSourceAnalytics(ctx, filter)
+}
+
+// GetWorkArrangementAnalytics returns application counts per job work arrangement and status
+func (s *AnalyticsService) GetWorkArrangementAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.WorkArrangementAnalytics, error) {
+	if err := filter.Validate(s.now()); err != nil {
+		return nil, err
+	}
+	return s.repo.GetWorkArrangementAnalytics(ctx, filter)
+}
+
+// GetSourceTrend returns monthly application counts per job source.
+// months must be between 1 and MaxSourceTrendMonths.
+func (s *AnalyticsService) GetSourceTrend(ctx context.Context, userID string, months int) (*model.SourceTrend, error) {
+	if months < 1 || months > model.MaxSourceTrendMonths {
+		return nil, model.ErrInvalidMonths
+	}
+	return s.repo.GetSourceTrend(ctx, userID, months)
+}
+
+// GetCohortAnalytics returns outcome metrics grouped by application start period.
+// An empty granularity defaults to month.
+func (s *AnalyticsService) GetCohortAnalytics(ctx context.Context, userID, granularity string) (*model.CohortAnalytics, error) {
+	if granularity == "" {
+		granularity = model.GranularityMonth
+	}
+	if !model.IsValidGranularity(granularity) {
+		return nil, model.ErrInvalidGranularity
+	}
+	return s.repo.GetCohortAnalytics(ctx, userID, granularity)
+}
+
+// GetActivityTimeSeries returns the number of applications per week, month or
+// quarter for a dashboard chart. An empty period defaults to week.
+func (s *AnalyticsService) GetActivityTimeSeries(ctx context.Context, userID, period string) (*model.ActivityAnalytics, error) {
+	if period == "" {
+		period = model.ActivityPeriodWeek
+	}
+	if _, ok := model.ActivityBucketCount(period); !ok {
+		return nil, model.ErrInvalidPeriod
+	}
+	return s.repo.GetActivityTimeSeries(ctx, userID, period)
 }
@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/andreypavlenko/jobber/modules/analytics/model"
 	"github.com/stretchr/testify/assert"
@@ -12,44 +14,84 @@ import (
 
 // MockAnalyticsRepository is a mock implementation of the AnalyticsRepository interface
 type MockAnalyticsRepository struct {
-	GetOverviewFunc            func(ctx context.Context, userID string) (*model.OverviewAnalytics, error)
-	GetFunnelFunc              func(ctx context.Context, userID string) (*model.FunnelAnalytics, error)
-	GetStageTimeFunc           func(ctx context.Context, userID string) (*model.StageTimeAnalytics, error)
-	GetResumeEffectivenessFunc func(ctx context.Context, userID string) (*model.ResumeAnalytics, error)
-	GetSourceAnalyticsFunc     func(ctx context.Context, userID string) (*model.SourceAnalytics, error)
+	GetOverviewFunc                 func(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error)
+	GetFunnelFunc                   func(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error)
+	GetStageTimeFunc                func(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error)
+	GetStageBottlenecksFunc         func(ctx context.Context, userID string, top int) (*model.StageBottleneckAnalytics, error)
+	GetResumeEffectivenessFunc      func(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error)
+	GetSourceAnalyticsFunc          func(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error)
+	GetWorkArrangementAnalyticsFunc func(ctx context.Context, filter model.AnalyticsFilter) (*model.WorkArrangementAnalytics, error)
+	GetCohortAnalyticsFunc          func(ctx context.Context, userID, granularity string) (*model.CohortAnalytics, error)
+	GetActivityTimeSeriesFunc       func(ctx context.Context, userID, period string) (*model.ActivityAnalytics, error)
+	GetSourceTrendFunc              func(ctx context.Context, userID string, months int) (*model.SourceTrend, error)
 }
 
-func (m *MockAnalyticsRepository) GetOverview(ctx context.Context, userID string) (*model.OverviewAnalytics, error) {
+func (m *MockAnalyticsRepository) GetOverview(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
 	if m.GetOverviewFunc != nil {
-		return m.GetOverviewFunc(ctx, userID)
+		return m.GetOverviewFunc(ctx, filter)
 	}
 	return nil, nil
 }
 
-func (m *MockAnalyticsRepository) GetFunnel(ctx context.Context, userID string) (*model.FunnelAnalytics, error) {
+func (m *MockAnalyticsRepository) GetFunnel(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
 	if m.GetFunnelFunc != nil {
-		return m.GetFunnelFunc(ctx, userID)
+		return m.GetFunnelFunc(ctx, filter)
 	}
 	return nil, nil
 }
 
-func (m *MockAnalyticsRepository) GetStageTime(ctx context.Context, userID string) (*model.StageTimeAnalytics, error) {
+func (m *MockAnalyticsRepository) GetStageTime(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error) {
 	if m.GetStageTimeFunc != nil {
-		return m.GetStageTimeFunc(ctx, userID)
+		return m.GetStageTimeFunc(ctx, filter)
 	}
 	return nil, nil
 }
 
-func (m *MockAnalyticsRepository) GetResumeEffectiveness(ctx context.Context, userID string) (*model.ResumeAnalytics, error) {
+func (m *MockAnalyticsRepository) GetStageBottlenecks(ctx context.Context, userID string, top int) (*model.StageBottleneckAnalytics, error) {
+	if m.GetStageBottlenecksFunc != nil {
+		return m.GetStageBottlenecksFunc(ctx, userID, top)
+	}
+	return nil, nil
+}
+
+func (m *MockAnalyticsRepository) GetResumeEffectiveness(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error) {
 	if m.GetResumeEffectivenessFunc != nil {
-		return m.GetResumeEffectivenessFunc(ctx, userID)
+		return m.GetResumeEffectivenessFunc(ctx, filter)
 	}
 	return nil, nil
 }
 
-func (m *MockAnalyticsRepository) GetSourceAnalytics(ctx context.Context, userID string) (*model.SourceAnalytics, error) {
+func (m *MockAnalyticsRepository) GetSourceAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error) {
 	if m.GetSourceAnalyticsFunc != nil {
-		return m.GetSourceAnalyticsFunc(ctx, userID)
+		return m.GetSourceAnalyticsFunc(ctx, filter)
+	}
+	return nil, nil
+}
+
+func (m *MockAnalyticsRepository) GetWorkArrangementAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.WorkArrangementAnalytics, error) {
+	if m.GetWorkArrangementAnalyticsFunc != nil {
+		return m.GetWorkArrangementAnalyticsFunc(ctx, filter)
+	}
+	return nil, nil
+}
+
+func (m *MockAnalyticsRepository) GetCohortAnalytics(ctx context.Context, userID, granularity string) (*model.CohortAnalytics, error) {
+	if m.GetCohortAnalyticsFunc != nil {
+		return m.GetCohortAnalyticsFunc(ctx, userID, granularity)
+	}
+	return nil, nil
+}
+
+func (m *MockAnalyticsRepository) GetActivityTimeSeries(ctx context.Context, userID, period string) (*model.ActivityAnalytics, error) {
+	if m.GetActivityTimeSeriesFunc != nil {
+		return m.GetActivityTimeSeriesFunc(ctx, userID, period)
+	}
+	return nil, nil
+}
+
+func (m *MockAnalyticsRepository) GetSourceTrend(ctx context.Context, userID string, months int) (*model.SourceTrend, error) {
+	if m.GetSourceTrendFunc != nil {
+		return m.GetSourceTrendFunc(ctx, userID, months)
 	}
 	return nil, nil
 }
@@ -67,14 +109,14 @@ func TestAnalyticsService_GetOverview(t *testing.T) {
 		}
 
 		mockRepo := &MockAnalyticsRepository{
-			GetOverviewFunc: func(ctx context.Context, uid string) (*model.OverviewAnalytics, error) {
-				assert.Equal(t, userID, uid)
+			GetOverviewFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
+				assert.Equal(t, userID, filter.UserID)
 				return expectedOverview, nil
 			},
 		}
 
 		service := NewAnalyticsService(mockRepo)
-		result, err := service.GetOverview(context.Background(), userID)
+		result, err := service.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		assert.Equal(t, expectedOverview, result)
@@ -84,19 +126,78 @@ func TestAnalyticsService_GetOverview(t *testing.T) {
 		expectedError := errors.New("database error")
 
 		mockRepo := &MockAnalyticsRepository{
-			GetOverviewFunc: func(ctx context.Context, uid string) (*model.OverviewAnalytics, error) {
+			GetOverviewFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
 				return nil, expectedError
 			},
 		}
 
 		service := NewAnalyticsService(mockRepo)
-		result, err := service.GetOverview(context.Background(), userID)
+		result, err := service.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		assert.Nil(t, result)
 		assert.Equal(t, expectedError, err)
 	})
 }
 
+func TestAnalyticsService_DateRange(t *testing.T) {
+	userID := "user-123"
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	date := func(year int, month time.Month, day int) *time.Time {
+		d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	t.Run("passes a valid range to the repository", func(t *testing.T) {
+		filter := model.AnalyticsFilter{UserID: userID, From: date(2024, 1, 1), To: date(2024, 6, 30)}
+		mockRepo := &MockAnalyticsRepository{
+			GetFunnelFunc: func(ctx context.Context, f model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
+				assert.Equal(t, filter, f)
+				return &model.FunnelAnalytics{}, nil
+			},
+		}
+
+		service := NewAnalyticsService(mockRepo)
+		service.now = func() time.Time { return now }
+		_, err := service.GetFunnel(context.Background(), filter)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("accepts a single-day range starting five years ago", func(t *testing.T) {
+		service := NewAnalyticsService(&MockAnalyticsRepository{})
+		service.now = func() time.Time { return now }
+
+		_, err := service.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID, From: date(2021, 3, 15), To: date(2021, 3, 15)})
+
+		require.NoError(t, err)
+	})
+
+	for _, tt := range []struct {
+		name   string
+		filter model.AnalyticsFilter
+	}{
+		{name: "from after to", filter: model.AnalyticsFilter{UserID: userID, From: date(2024, 6, 30), To: date(2024, 1, 1)}},
+		{name: "from more than five years ago", filter: model.AnalyticsFilter{UserID: userID, From: date(2021, 3, 14)}},
+		{name: "to more than five years ago", filter: model.AnalyticsFilter{UserID: userID, To: date(2020, 12, 31)}},
+	} {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			mockRepo := &MockAnalyticsRepository{
+				GetSourceAnalyticsFunc: func(ctx context.Context, f model.AnalyticsFilter) (*model.SourceAnalytics, error) {
+					t.Fatal("repository must not be queried")
+					return nil, nil
+				},
+			}
+
+			service := NewAnalyticsService(mockRepo)
+			service.now = func() time.Time { return now }
+			result, err := service.GetSourceAnalytics(context.Background(), tt.filter)
+
+			assert.ErrorIs(t, err, model.ErrInvalidDateRange)
+			assert.Nil(t, result)
+		})
+	}
+}
+
 func TestAnalyticsService_GetFunnel(t *testing.T) {
 	userID := "user-123"
 
@@ -109,14 +210,14 @@ func TestAnalyticsService_GetFunnel(t *testing.T) {
 		}
 
 		mockRepo := &MockAnalyticsRepository{
-			GetFunnelFunc: func(ctx context.Context, uid string) (*model.FunnelAnalytics, error) {
-				assert.Equal(t, userID, uid)
+			GetFunnelFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
+				assert.Equal(t, userID, filter.UserID)
 				return expectedFunnel, nil
 			},
 		}
 
 		service := NewAnalyticsService(mockRepo)
-		result, err := service.GetFunnel(context.Background(), userID)
+		result, err := service.GetFunnel(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		assert.Equal(t, expectedFunnel, result)
@@ -127,13 +228,13 @@ func TestAnalyticsService_GetFunnel(t *testing.T) {
 		expectedError := errors.New("database error")
 
 		mockRepo := &MockAnalyticsRepository{
-			GetFunnelFunc: func(ctx context.Context, uid string) (*model.FunnelAnalytics, error) {
+			GetFunnelFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
 				return nil, expectedError
 			},
 		}
 
 		service := NewAnalyticsService(mockRepo)
-		result, err := service.GetFunnel(context.Background(), userID)
+		result, err := service.GetFunnel(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		assert.Nil(t, result)
 		assert.Equal(t, expectedError, err)
@@ -152,14 +253,14 @@ func TestAnalyticsService_GetStageTime(t *testing.T) {
 		}
 
 		mockRepo := &MockAnalyticsRepository{
-			GetStageTimeFunc: func(ctx context.Context, uid string) (*model.StageTimeAnalytics, error) {
-				assert.Equal(t, userID, uid)
+			GetStageTimeFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error) {
+				assert.Equal(t, userID, filter.UserID)
 				return expectedStageTime, nil
 			},
 		}
 
 		service := NewAnalyticsService(mockRepo)
-		result, err := service.GetStageTime(context.Background(), userID)
+		result, err := service.GetStageTime(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		assert.Equal(t, expectedStageTime, result)
@@ -169,13 +270,13 @@ func TestAnalyticsService_GetStageTime(t *testing.T) {
 		expectedError := errors.New("database error")
 
 		mockRepo := &MockAnalyticsRepository{
-			GetStageTimeFunc: func(ctx context.Context, uid string) (*model.StageTimeAnalytics, error) {
+			GetStageTimeFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error) {
 				return nil, expectedError
 			},
 		}
 
 		service := NewAnalyticsService(mockRepo)
-		result, err := service.GetStageTime(context.Background(), userID)
+		result, err := service.GetStageTime(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		assert.Nil(t, result)
 		assert.Equal(t, expectedError, err)
@@ -200,14 +301,14 @@ func TestAnalyticsService_GetResumeEffectiveness(t *testing.T) {
 		}
 
 		mockRepo := &MockAnalyticsRepository{
-			GetResumeEffectivenessFunc: func(ctx context.Context, uid string) (*model.ResumeAnalytics, error) {
-				assert.Equal(t, userID, uid)
+			GetResumeEffectivenessFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error) {
+				assert.Equal(t, userID, filter.UserID)
 				return expectedResumes, nil
 			},
 		}
 
 		service := NewAnalyticsService(mockRepo)
-		result, err := service.GetResumeEffectiveness(context.Background(), userID)
+		result, err := service.GetResumeEffectiveness(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		assert.Equal(t, expectedResumes, result)
@@ -217,13 +318,13 @@ func TestAnalyticsService_GetResumeEffectiveness(t *testing.T) {
 		expectedError := errors.New("database error")
 
 		mockRepo := &MockAnalyticsRepository{
-			GetResumeEffectivenessFunc: func(ctx context.Context, uid string) (*model.ResumeAnalytics, error) {
+			GetResumeEffectivenessFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error) {
 				return nil, expectedError
 			},
 		}
 
 		service := NewAnalyticsService(mockRepo)
-		result, err := service.GetResumeEffectiveness(context.Background(), userID)
+		result, err := service.GetResumeEffectiveness(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		assert.Nil(t, result)
 		assert.Equal(t, expectedError, err)
@@ -242,14 +343,14 @@ func TestAnalyticsService_GetSourceAnalytics(t *testing.T) {
 		}
 
 		mockRepo := &MockAnalyticsRepository{
-			GetSourceAnalyticsFunc: func(ctx context.Context, uid string) (*model.SourceAnalytics, error) {
-				assert.Equal(t, userID, uid)
+			GetSourceAnalyticsFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error) {
+				assert.Equal(t, userID, filter.UserID)
 				return expectedSources, nil
 			},
 		}
 
 		service := NewAnalyticsService(mockRepo)
-		result, err := service.GetSourceAnalytics(context.Background(), userID)
+		result, err := service.GetSourceAnalytics(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		require.NoError(t, err)
 		assert.Equal(t, expectedSources, result)
@@ -259,15 +360,254 @@ func TestAnalyticsService_GetSourceAnalytics(t *testing.T) {
 		expectedError := errors.New("database error")
 
 		mockRepo := &MockAnalyticsRepository{
-			GetSourceAnalyticsFunc: func(ctx context.Context, uid string) (*model.SourceAnalytics, error) {
+			GetSourceAnalyticsFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error) {
 				return nil, expectedError
 			},
 		}
 
 		service := NewAnalyticsService(mockRepo)
-		result, err := service.GetSourceAnalytics(context.Background(), userID)
+		result, err := service.GetSourceAnalytics(context.Background(), model.AnalyticsFilter{UserID: userID})
 
 		assert.Nil(t, result)
 		assert.Equal(t, expectedError, err)
 	})
 }
+
+func TestAnalyticsService_GetStageBottlenecks(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("flags stages slower than 1.5x the global average", func(t *testing.T) {
+		// Global average is (16 + 4 + 4) / 3 = 8, so the threshold is 12 days
+		mockRepo := &MockAnalyticsRepository{
+			GetStageBottlenecksFunc: func(ctx context.Context, uid string, top int) (*model.StageBottleneckAnalytics, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, 3, top)
+				return &model.StageBottleneckAnalytics{
+					GlobalAvgDays: 8,
+					Stages: []model.StageBottleneck{
+						{StageName: "Take-Home", AvgDays: 16, DropOffPct: 40},
+						{StageName: "Phone Screen", AvgDays: 4, DropOffPct: 10},
+						{StageName: "Onsite", AvgDays: 4, DropOffPct: 25},
+					},
+				}, nil
+			},
+		}
+
+		service := NewAnalyticsService(mockRepo)
+		result, err := service.GetStageBottlenecks(context.Background(), userID, 3)
+
+		require.NoError(t, err)
+		require.Len(t, result.Stages, 3)
+		assert.True(t, result.Stages[0].IsBottleneck)
+		assert.False(t, result.Stages[1].IsBottleneck)
+		assert.False(t, result.Stages[2].IsBottleneck)
+	})
+
+	t.Run("a stage exactly at the threshold is not a bottleneck", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetStageBottlenecksFunc: func(ctx context.Context, uid string, top int) (*model.StageBottleneckAnalytics, error) {
+				return &model.StageBottleneckAnalytics{
+					GlobalAvgDays: 4,
+					Stages:        []model.StageBottleneck{{StageName: "Onsite", AvgDays: 6}},
+				}, nil
+			},
+		}
+
+		service := NewAnalyticsService(mockRepo)
+		result, err := service.GetStageBottlenecks(context.Background(), userID, 1)
+
+		require.NoError(t, err)
+		assert.False(t, result.Stages[0].IsBottleneck)
+	})
+
+	t.Run("rejects top outside the allowed range", func(t *testing.T) {
+		service := NewAnalyticsService(&MockAnalyticsRepository{})
+		for _, top := range []int{0, model.MaxBottleneckTop + 1} {
+			result, err := service.GetStageBottlenecks(context.Background(), userID, top)
+			assert.ErrorIs(t, err, model.ErrInvalidTop)
+			assert.Nil(t, result)
+		}
+	})
+
+	t.Run("returns repository error", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetStageBottlenecksFunc: func(ctx context.Context, uid string, top int) (*model.StageBottleneckAnalytics, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		service := NewAnalyticsService(mockRepo)
+		result, err := service.GetStageBottlenecks(context.Background(), userID, 3)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestAnalyticsService_GetSourceTrend(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("returns months nested per source", func(t *testing.T) {
+		months := func(jan, feb int) []model.SourceTrendMonth {
+			return []model.SourceTrendMonth{{Month: "2026-01", Count: jan}, {Month: "2026-02", Count: feb}}
+		}
+		mockRepo := &MockAnalyticsRepository{
+			GetSourceTrendFunc: func(ctx context.Context, uid string, m int) (*model.SourceTrend, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, 2, m)
+				return &model.SourceTrend{Sources: []model.SourceMonthlyTrend{
+					{Source: "LinkedIn", Months: months(5, 3)},
+					{Source: "Referral", Months: months(0, 2)},
+					{Source: "Unknown", Months: months(1, 0)},
+				}}, nil
+			},
+		}
+
+		service := NewAnalyticsService(mockRepo)
+		result, err := service.GetSourceTrend(context.Background(), userID, 2)
+		require.NoError(t, err)
+
+		data, err := json.Marshal(result)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"sources":[
+			{"source":"LinkedIn","months":[{"month":"2026-01","count":5},{"month":"2026-02","count":3}]},
+			{"source":"Referral","months":[{"month":"2026-01","count":0},{"month":"2026-02","count":2}]},
+			{"source":"Unknown","months":[{"month":"2026-01","count":1},{"month":"2026-02","count":0}]}
+		]}`, string(data))
+	})
+
+	t.Run("accepts the window bounds", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetSourceTrendFunc: func(ctx context.Context, uid string, m int) (*model.SourceTrend, error) {
+				return &model.SourceTrend{}, nil
+			},
+		}
+
+		service := NewAnalyticsService(mockRepo)
+		for _, m := range []int{1, model.MaxSourceTrendMonths} {
+			_, err := service.GetSourceTrend(context.Background(), userID, m)
+			assert.NoError(t, err, "months=%d", m)
+		}
+	})
+
+	t.Run("rejects months out of range without querying", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetSourceTrendFunc: func(ctx context.Context, uid string, m int) (*model.SourceTrend, error) {
+				t.Fatal("repository should not be called")
+				return nil, nil
+			},
+		}
+
+		service := NewAnalyticsService(mockRepo)
+		for _, m := range []int{-1, 0, model.MaxSourceTrendMonths + 1} {
+			result, err := service.GetSourceTrend(context.Background(), userID, m)
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, model.ErrInvalidMonths, "months=%d", m)
+		}
+	})
+}
+
+func TestAnalyticsService_GetCohortAnalytics(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("defaults to month granularity", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetCohortAnalyticsFunc: func(ctx context.Context, uid, granularity string) (*model.CohortAnalytics, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, model.GranularityMonth, granularity)
+				return &model.CohortAnalytics{Granularity: granularity}, nil
+			},
+		}
+
+		service := NewAnalyticsService(mockRepo)
+		result, err := service.GetCohortAnalytics(context.Background(), userID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, model.GranularityMonth, result.Granularity)
+	})
+
+	t.Run("passes through valid granularity", func(t *testing.T) {
+		var captured string
+		mockRepo := &MockAnalyticsRepository{
+			GetCohortAnalyticsFunc: func(ctx context.Context, uid, granularity string) (*model.CohortAnalytics, error) {
+				captured = granularity
+				return &model.CohortAnalytics{Granularity: granularity}, nil
+			},
+		}
+
+		service := NewAnalyticsService(mockRepo)
+		_, err := service.GetCohortAnalytics(context.Background(), userID, model.GranularityWeek)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.GranularityWeek, captured)
+	})
+
+	t.Run("rejects invalid granularity without querying", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetCohortAnalyticsFunc: func(ctx context.Context, uid, granularity string) (*model.CohortAnalytics, error) {
+				t.Fatal("repository should not be called")
+				return nil, nil
+			},
+		}
+
+		service := NewAnalyticsService(mockRepo)
+		result, err := service.GetCohortAnalytics(context.Background(), userID, "year; DROP TABLE applications")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrInvalidGranularity)
+	})
+}
+
+func TestAnalyticsService_GetActivityTimeSeries(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("defaults to week", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetActivityTimeSeriesFunc: func(ctx context.Context, uid, period string) (*model.ActivityAnalytics, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, model.ActivityPeriodWeek, period)
+				return &model.ActivityAnalytics{Period: period}, nil
+			},
+		}
+
+		service := NewAnalyticsService(mockRepo)
+		result, err := service.GetActivityTimeSeries(context.Background(), userID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, model.ActivityPeriodWeek, result.Period)
+	})
+
+	t.Run("passes through valid periods", func(t *testing.T) {
+		for _, period := range []string{model.ActivityPeriodWeek, model.ActivityPeriodMonth, model.ActivityPeriodQuarter} {
+			var captured string
+			mockRepo := &MockAnalyticsRepository{
+				GetActivityTimeSeriesFunc: func(ctx context.Context, uid, p string) (*model.ActivityAnalytics, error) {
+					captured = p
+					return &model.ActivityAnalytics{Period: p}, nil
+				},
+			}
+
+			service := NewAnalyticsService(mockRepo)
+			_, err := service.GetActivityTimeSeries(context.Background(), userID, period)
+
+			require.NoError(t, err)
+			assert.Equal(t, period, captured)
+		}
+	})
+
+	t.Run("rejects invalid period without querying", func(t *testing.T) {
+		mockRepo := &MockAnalyticsRepository{
+			GetActivityTimeSeriesFunc: func(ctx context.Context, uid, period string) (*model.ActivityAnalytics, error) {
+				t.Fatal("repository should not be called")
+				return nil, nil
+			},
+		}
+
+		service := NewAnalyticsService(mockRepo)
+		result, err := service.GetActivityTimeSeries(context.Background(), userID, "year")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrInvalidPeriod)
+	})
+}
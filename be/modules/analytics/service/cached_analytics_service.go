@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/analytics/model"
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultCacheTTL bounds how stale cached analytics can get if an invalidation is missed
+const DefaultCacheTTL = 5 * time.Minute
+
+// ActivityCacheTTL is how long the activity time series is cached, independent of the configured TTL
+const ActivityCacheTTL = 10 * time.Minute
+
+// Analytics is the analytics read API, served by AnalyticsService directly
+// or through CachedAnalyticsService
+type Analytics interface {
+	GetOverview(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error)
+	GetFunnel(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error)
+	GetStageTime(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error)
+	GetStageBottlenecks(ctx context.Context, userID string, top int) (*model.StageBottleneckAnalytics, error)
+	GetResumeEffectiveness(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error)
+	GetSourceAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error)
+	GetWorkArrangementAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.WorkArrangementAnalytics, error)
+	GetSourceTrend(ctx context.Context, userID string, months int) (*model.SourceTrend, error)
+	GetCohortAnalytics(ctx context.Context, userID, granularity string) (*model.CohortAnalytics, error)
+	GetActivityTimeSeries(ctx context.Context, userID, period string) (*model.ActivityAnalytics, error)
+}
+
+// CachedAnalyticsService caches analytics results in Redis per user and endpoint.
+// Without a Redis client every call goes straight to the wrapped service.
+type CachedAnalyticsService struct {
+	inner       Analytics
+	redisClient *redis.Client
+	ttl         time.Duration
+}
+
+// NewCachedAnalyticsService wraps inner with a Redis cache; a non-positive ttl uses DefaultCacheTTL
+func NewCachedAnalyticsService(inner Analytics, redisClient *redis.Client, ttl time.Duration) *CachedAnalyticsService {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &CachedAnalyticsService{inner: inner, redisClient: redisClient, ttl: ttl}
+}
+
+func analyticsCacheKey(userID, endpoint string) string {
+	return "analytics:" + userID + ":" + endpoint
+}
+
+// rangedEndpoint suffixes the endpoint with the filter's date range, if any,
+// so each range is cached separately
+func rangedEndpoint(endpoint string, filter model.AnalyticsFilter) string {
+	if !filter.HasRange() {
+		return endpoint
+	}
+	from, to := "", ""
+	if filter.From != nil {
+		from = filter.From.Format(model.ReportDateLayout)
+	}
+	if filter.To != nil {
+		to = filter.To.Format(model.ReportDateLayout)
+	}
+	return endpoint + ":" + from + ":" + to
+}
+
+// cached returns the cached result for the endpoint or loads and caches it for the service TTL.
+// Redis errors fail open, and errors from load are never cached.
+func cached[T any](ctx context.Context, s *CachedAnalyticsService, userID, endpoint string, load func() (*T, error)) (*T, error) {
+	return cachedFor(ctx, s, s.ttl, userID, endpoint, load)
+}
+
+// cachedFor is cached with an explicit TTL
+func cachedFor[T any](ctx context.Context, s *CachedAnalyticsService, ttl time.Duration, userID, endpoint string, load func() (*T, error)) (*T, error) {
+	if s.redisClient == nil {
+		return load()
+	}
+
+	key := analyticsCacheKey(userID, endpoint)
+
+	raw, err := s.redisClient.Get(ctx, key).Bytes()
+	switch {
+	case err == nil:
+		var result T
+		if jsonErr := json.Unmarshal(raw, &result); jsonErr == nil {
+			return &result, nil
+		}
+		log.Printf("[WARN] discarding malformed analytics cache entry %s", key)
+	case !errors.Is(err, redis.Nil):
+		log.Printf("[WARN] analytics cache read failed for %s: %v", key, err)
+	}
+
+	result, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	if encoded, jsonErr := json.Marshal(result); jsonErr == nil {
+		if setErr := s.redisClient.Set(ctx, key, encoded, ttl).Err(); setErr != nil {
+			log.Printf("[WARN] analytics cache write failed for %s: %v", key, setErr)
+		}
+	}
+
+	return result, nil
+}
+
+// GetOverview returns high-level application statistics
+func (s *CachedAnalyticsService) GetOverview(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
+	return cached(ctx, s, filter.UserID, rangedEndpoint("overview", filter), func() (*model.OverviewAnalytics, error) {
+		return s.inner.GetOverview(ctx, filter)
+	})
+}
+
+// GetFunnel returns stage-based funnel metrics
+func (s *CachedAnalyticsService) GetFunnel(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
+	return cached(ctx, s, filter.UserID, rangedEndpoint("funnel", filter), func() (*model.FunnelAnalytics, error) {
+		return s.inner.GetFunnel(ctx, filter)
+	})
+}
+
+// GetStageTime returns timing metrics per stage
+func (s *CachedAnalyticsService) GetStageTime(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error) {
+	return cached(ctx, s, filter.UserID, rangedEndpoint("stages", filter), func() (*model.StageTimeAnalytics, error) {
+		return s.inner.GetStageTime(ctx, filter)
+	})
+}
+
+// GetStageBottlenecks returns the top slowest stages, cached per top value
+func (s *CachedAnalyticsService) GetStageBottlenecks(ctx context.Context, userID string, top int) (*model.StageBottleneckAnalytics, error) {
+	return cached(ctx, s, userID, fmt.Sprintf("stages/bottlenecks:%d", top), func() (*model.StageBottleneckAnalytics, error) {
+		return s.inner.GetStageBottlenecks(ctx, userID, top)
+	})
+}
+
+// GetResumeEffectiveness returns effectiveness metrics per resume
+func (s *CachedAnalyticsService) GetResumeEffectiveness(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error) {
+	return cached(ctx, s, filter.UserID, rangedEndpoint("resumes", filter), func() (*model.ResumeAnalytics, error) {
+		return s.inner.GetResumeEffectiveness(ctx, filter)
+	})
+}
+
+// GetSourceAnalytics returns metrics grouped by job source
+func (s *CachedAnalyticsService) GetSourceAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error) {
+	return cached(ctx, s, filter.UserID, rangedEndpoint("sources", filter), func() (*model.SourceAnalytics, error) {
+		return s.inner.GetSourceAnalytics(ctx, filter)
+	})
+}
+
+// GetWorkArrangementAnalytics returns application counts per job work arrangement and status
+func (s *CachedAnalyticsService) GetWorkArrangementAnalytics(ctx context.Context, filter model.AnalyticsFilter) (*model.WorkArrangementAnalytics, error) {
+	return cached(ctx, s, filter.UserID, rangedEndpoint("work-arrangement", filter), func() (*model.WorkArrangementAnalytics, error) {
+		return s.inner.GetWorkArrangementAnalytics(ctx, filter)
+	})
+}
+
+// GetSourceTrend returns monthly application counts per job source, cached per months value
+func (s *CachedAnalyticsService) GetSourceTrend(ctx context.Context, userID string, months int) (*model.SourceTrend, error) {
+	return cached(ctx, s, userID, fmt.Sprintf("sources/trend:%d", months), func() (*model.SourceTrend, error) {
+		return s.inner.GetSourceTrend(ctx, userID, months)
+	})
+}
+
+// GetCohortAnalytics returns outcome metrics by start period, cached per granularity
+func (s *CachedAnalyticsService) GetCohortAnalytics(ctx context.Context, userID, granularity string) (*model.CohortAnalytics, error) {
+	return cached(ctx, s, userID, "cohort:"+granularity, func() (*model.CohortAnalytics, error) {
+		return s.inner.GetCohortAnalytics(ctx, userID, granularity)
+	})
+}
+
+// GetActivityTimeSeries returns application counts per period, cached per period for ActivityCacheTTL
+func (s *CachedAnalyticsService) GetActivityTimeSeries(ctx context.Context, userID, period string) (*model.ActivityAnalytics, error) {
+	return cachedFor(ctx, s, ActivityCacheTTL, userID, "activity:"+period, func() (*model.ActivityAnalytics, error) {
+		return s.inner.GetActivityTimeSeries(ctx, userID, period)
+	})
+}
+
+// InvalidateAnalytics drops every cached analytics result of the user so the
+// next reads reflect changed applications and stages
+func (s *CachedAnalyticsService) InvalidateAnalytics(ctx context.Context, userID string) error {
+	if s.redisClient == nil {
+		return nil
+	}
+
+	var keys []string
+	iter := s.redisClient.Scan(ctx, 0, analyticsCacheKey(userID, "*"), 100).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return s.redisClient.Del(ctx, keys...).Err()
+}
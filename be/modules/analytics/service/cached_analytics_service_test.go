@@ -0,0 +1,367 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/andreypavlenko/jobber/modules/analytics/model"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRedis(t *testing.T) (*redis.Client, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	return client, mr
+}
+
+// countingAnalyticsRepo reports total as the overview total and counts every query
+func countingAnalyticsRepo(total *int, calls *int) *MockAnalyticsRepository {
+	return &MockAnalyticsRepository{
+		GetOverviewFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
+			*calls++
+			return &model.OverviewAnalytics{TotalApplications: *total, ResponseRate: 0.5}, nil
+		},
+		GetCohortAnalyticsFunc: func(ctx context.Context, userID, granularity string) (*model.CohortAnalytics, error) {
+			*calls++
+			return &model.CohortAnalytics{Granularity: granularity}, nil
+		},
+	}
+}
+
+func TestCachedAnalyticsService_GetOverview(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("serves repeat reads from the cache", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		total, calls := 3, 0
+		svc := NewCachedAnalyticsService(NewAnalyticsService(countingAnalyticsRepo(&total, &calls)), client, time.Minute)
+
+		first, err := svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
+		require.NoError(t, err)
+		total = 4
+		second, err := svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, 3, first.TotalApplications)
+		assert.Equal(t, first, second)
+		assert.True(t, mr.Exists("analytics:user-123:overview"))
+		assert.Equal(t, time.Minute, mr.TTL("analytics:user-123:overview"))
+	})
+
+	t.Run("reloads after the TTL expires", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		total, calls := 3, 0
+		svc := NewCachedAnalyticsService(NewAnalyticsService(countingAnalyticsRepo(&total, &calls)), client, time.Minute)
+
+		_, err := svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
+		require.NoError(t, err)
+		mr.FastForward(2 * time.Minute)
+		total = 4
+		result, err := svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, 4, result.TotalApplications)
+	})
+
+	t.Run("defaults the TTL", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		total, calls := 3, 0
+		svc := NewCachedAnalyticsService(NewAnalyticsService(countingAnalyticsRepo(&total, &calls)), client, 0)
+
+		_, err := svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
+		require.NoError(t, err)
+
+		assert.Equal(t, DefaultCacheTTL, mr.TTL("analytics:user-123:overview"))
+	})
+
+	t.Run("discards a malformed cache entry", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		require.NoError(t, mr.Set("analytics:user-123:overview", "not json"))
+		total, calls := 3, 0
+		svc := NewCachedAnalyticsService(NewAnalyticsService(countingAnalyticsRepo(&total, &calls)), client, time.Minute)
+
+		result, err := svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, 3, result.TotalApplications)
+	})
+
+	t.Run("falls back to the service when Redis is down", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		mr.SetError("connection refused")
+		total, calls := 3, 0
+		svc := NewCachedAnalyticsService(NewAnalyticsService(countingAnalyticsRepo(&total, &calls)), client, time.Minute)
+
+		result, err := svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.TotalApplications)
+	})
+
+	t.Run("passes through without Redis", func(t *testing.T) {
+		total, calls := 3, 0
+		svc := NewCachedAnalyticsService(NewAnalyticsService(countingAnalyticsRepo(&total, &calls)), nil, time.Minute)
+
+		_, err := svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
+		require.NoError(t, err)
+		_, err = svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		repo := &MockAnalyticsRepository{
+			GetOverviewFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.OverviewAnalytics, error) {
+				return nil, errors.New("database error")
+			},
+		}
+		svc := NewCachedAnalyticsService(NewAnalyticsService(repo), client, time.Minute)
+
+		result, err := svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: userID})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.False(t, mr.Exists("analytics:user-123:overview"))
+	})
+}
+
+func TestCachedAnalyticsService_ParameterizedKeys(t *testing.T) {
+	client, mr := newTestRedis(t)
+	total, calls := 0, 0
+	svc := NewCachedAnalyticsService(NewAnalyticsService(countingAnalyticsRepo(&total, &calls)), client, time.Minute)
+
+	week, err := svc.GetCohortAnalytics(context.Background(), "user-123", model.GranularityWeek)
+	require.NoError(t, err)
+	month, err := svc.GetCohortAnalytics(context.Background(), "user-123", model.GranularityMonth)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, model.GranularityWeek, week.Granularity)
+	assert.Equal(t, model.GranularityMonth, month.Granularity)
+	assert.True(t, mr.Exists("analytics:user-123:cohort:week"))
+	assert.True(t, mr.Exists("analytics:user-123:cohort:month"))
+
+	_, err = svc.GetCohortAnalytics(context.Background(), "user-123", "fortnight")
+	assert.ErrorIs(t, err, model.ErrInvalidGranularity)
+}
+
+func TestCachedAnalyticsService_GetActivityTimeSeries(t *testing.T) {
+	client, mr := newTestRedis(t)
+	calls := 0
+	repo := &MockAnalyticsRepository{
+		GetActivityTimeSeriesFunc: func(ctx context.Context, userID, period string) (*model.ActivityAnalytics, error) {
+			calls++
+			return &model.ActivityAnalytics{Period: period, Buckets: []model.ActivityBucket{{Date: "2026-10-12", Count: 2}}}, nil
+		},
+	}
+	svc := NewCachedAnalyticsService(NewAnalyticsService(repo), client, time.Minute)
+
+	first, err := svc.GetActivityTimeSeries(context.Background(), "user-123", model.ActivityPeriodWeek)
+	require.NoError(t, err)
+	second, err := svc.GetActivityTimeSeries(context.Background(), "user-123", model.ActivityPeriodWeek)
+	require.NoError(t, err)
+	_, err = svc.GetActivityTimeSeries(context.Background(), "user-123", model.ActivityPeriodQuarter)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, first, second)
+	assert.Equal(t, ActivityCacheTTL, mr.TTL("analytics:user-123:activity:week"))
+	assert.True(t, mr.Exists("analytics:user-123:activity:quarter"))
+}
+
+func TestCachedAnalyticsService_DateRangeKeys(t *testing.T) {
+	client, mr := newTestRedis(t)
+	total, calls := 0, 0
+	svc := NewCachedAnalyticsService(NewAnalyticsService(countingAnalyticsRepo(&total, &calls)), client, time.Minute)
+	from := time.Now().UTC().AddDate(0, -1, 0).Truncate(24 * time.Hour)
+	to := from.AddDate(0, 0, 14)
+
+	_, err := svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: "user-123"})
+	require.NoError(t, err)
+	_, err = svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: "user-123", From: &from, To: &to})
+	require.NoError(t, err)
+	_, err = svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: "user-123", From: &from})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, calls)
+	assert.True(t, mr.Exists("analytics:user-123:overview"))
+	assert.True(t, mr.Exists("analytics:user-123:overview:"+from.Format("2006-01-02")+":"+to.Format("2006-01-02")))
+	assert.True(t, mr.Exists("analytics:user-123:overview:"+from.Format("2006-01-02")+":"))
+}
+
+func TestCachedAnalyticsService_InvalidateAnalytics(t *testing.T) {
+	t.Run("drops only the user's entries", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		total, calls := 3, 0
+		svc := NewCachedAnalyticsService(NewAnalyticsService(countingAnalyticsRepo(&total, &calls)), client, time.Minute)
+
+		_, err := svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: "user-123"})
+		require.NoError(t, err)
+		_, err = svc.GetCohortAnalytics(context.Background(), "user-123", model.GranularityMonth)
+		require.NoError(t, err)
+		_, err = svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: "user-456"})
+		require.NoError(t, err)
+
+		require.NoError(t, svc.InvalidateAnalytics(context.Background(), "user-123"))
+
+		assert.False(t, mr.Exists("analytics:user-123:overview"))
+		assert.False(t, mr.Exists("analytics:user-123:cohort:month"))
+		assert.True(t, mr.Exists("analytics:user-456:overview"))
+
+		total = 4
+		result, err := svc.GetOverview(context.Background(), model.AnalyticsFilter{UserID: "user-123"})
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.TotalApplications)
+	})
+
+	t.Run("succeeds with nothing cached", func(t *testing.T) {
+		client, _ := newTestRedis(t)
+		svc := NewCachedAnalyticsService(NewAnalyticsService(&MockAnalyticsRepository{}), client, time.Minute)
+
+		assert.NoError(t, svc.InvalidateAnalytics(context.Background(), "user-123"))
+	})
+
+	t.Run("is a no-op without Redis", func(t *testing.T) {
+		svc := NewCachedAnalyticsService(NewAnalyticsService(&MockAnalyticsRepository{}), nil, time.Minute)
+
+		assert.NoError(t, svc.InvalidateAnalytics(context.Background(), "user-123"))
+	})
+
+	t.Run("returns Redis errors", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		mr.SetError("connection refused")
+		svc := NewCachedAnalyticsService(NewAnalyticsService(&MockAnalyticsRepository{}), client, time.Minute)
+
+		assert.Error(t, svc.InvalidateAnalytics(context.Background(), "user-123"))
+	})
+}
+
+// countingAllAnalyticsRepo answers every query with an empty result and counts the calls
+func countingAllAnalyticsRepo(calls *int) *MockAnalyticsRepository {
+	return &MockAnalyticsRepository{
+		GetFunnelFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.FunnelAnalytics, error) {
+			*calls++
+			return &model.FunnelAnalytics{}, nil
+		},
+		GetStageTimeFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.StageTimeAnalytics, error) {
+			*calls++
+			return &model.StageTimeAnalytics{}, nil
+		},
+		GetStageBottlenecksFunc: func(ctx context.Context, userID string, top int) (*model.StageBottleneckAnalytics, error) {
+			*calls++
+			return &model.StageBottleneckAnalytics{}, nil
+		},
+		GetResumeEffectivenessFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.ResumeAnalytics, error) {
+			*calls++
+			return &model.ResumeAnalytics{}, nil
+		},
+		GetSourceAnalyticsFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.SourceAnalytics, error) {
+			*calls++
+			return &model.SourceAnalytics{}, nil
+		},
+		GetWorkArrangementAnalyticsFunc: func(ctx context.Context, filter model.AnalyticsFilter) (*model.WorkArrangementAnalytics, error) {
+			*calls++
+			return &model.WorkArrangementAnalytics{}, nil
+		},
+		GetSourceTrendFunc: func(ctx context.Context, userID string, months int) (*model.SourceTrend, error) {
+			*calls++
+			return &model.SourceTrend{}, nil
+		},
+	}
+}
+
+func TestCachedAnalyticsService_Endpoints(t *testing.T) {
+	filter := model.AnalyticsFilter{UserID: "user-123"}
+
+	tests := []struct {
+		name string
+		key  string
+		call func(svc *CachedAnalyticsService) error
+	}{
+		{"funnel", "analytics:user-123:funnel", func(svc *CachedAnalyticsService) error {
+			_, err := svc.GetFunnel(context.Background(), filter)
+			return err
+		}},
+		{"stage time", "analytics:user-123:stages", func(svc *CachedAnalyticsService) error {
+			_, err := svc.GetStageTime(context.Background(), filter)
+			return err
+		}},
+		{"stage bottlenecks", "analytics:user-123:stages/bottlenecks:5", func(svc *CachedAnalyticsService) error {
+			_, err := svc.GetStageBottlenecks(context.Background(), "user-123", 5)
+			return err
+		}},
+		{"resume effectiveness", "analytics:user-123:resumes", func(svc *CachedAnalyticsService) error {
+			_, err := svc.GetResumeEffectiveness(context.Background(), filter)
+			return err
+		}},
+		{"sources", "analytics:user-123:sources", func(svc *CachedAnalyticsService) error {
+			_, err := svc.GetSourceAnalytics(context.Background(), filter)
+			return err
+		}},
+		{"work arrangement", "analytics:user-123:work-arrangement", func(svc *CachedAnalyticsService) error {
+			_, err := svc.GetWorkArrangementAnalytics(context.Background(), filter)
+			return err
+		}},
+		{"source trend", "analytics:user-123:sources/trend:6", func(svc *CachedAnalyticsService) error {
+			_, err := svc.GetSourceTrend(context.Background(), "user-123", 6)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mr := newTestRedis(t)
+			calls := 0
+			svc := NewCachedAnalyticsService(NewAnalyticsService(countingAllAnalyticsRepo(&calls)), client, time.Minute)
+
+			require.NoError(t, tt.call(svc), "miss")
+			assert.Equal(t, 1, calls)
+			assert.True(t, mr.Exists(tt.key))
+
+			require.NoError(t, tt.call(svc), "hit")
+			assert.Equal(t, 1, calls, "served from the cache")
+
+			require.NoError(t, svc.InvalidateAnalytics(context.Background(), "user-123"))
+			assert.False(t, mr.Exists(tt.key))
+
+			require.NoError(t, tt.call(svc), "miss after invalidation")
+			assert.Equal(t, 2, calls)
+		})
+	}
+}
+
+func TestCachedAnalyticsService_InvalidFilterIsNotCached(t *testing.T) {
+	client, mr := newTestRedis(t)
+	calls := 0
+	svc := NewCachedAnalyticsService(NewAnalyticsService(countingAllAnalyticsRepo(&calls)), client, time.Minute)
+	to := time.Now().UTC().AddDate(0, -1, 0)
+	from := to.AddDate(0, 0, 1)
+	filter := model.AnalyticsFilter{UserID: "user-123", From: &from, To: &to}
+
+	_, err := svc.GetFunnel(context.Background(), filter)
+	assert.ErrorIs(t, err, model.ErrInvalidDateRange)
+	_, err = svc.GetStageTime(context.Background(), filter)
+	assert.ErrorIs(t, err, model.ErrInvalidDateRange)
+	_, err = svc.GetResumeEffectiveness(context.Background(), filter)
+	assert.ErrorIs(t, err, model.ErrInvalidDateRange)
+	_, err = svc.GetSourceAnalytics(context.Background(), filter)
+	assert.ErrorIs(t, err, model.ErrInvalidDateRange)
+	_, err = svc.GetWorkArrangementAnalytics(context.Background(), filter)
+	assert.ErrorIs(t, err, model.ErrInvalidDateRange)
+	_, err = svc.GetOverview(context.Background(), filter)
+	assert.ErrorIs(t, err, model.ErrInvalidDateRange)
+
+	assert.Zero(t, calls)
+	assert.Empty(t, mr.Keys())
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/analytics/model"
+	"github.com/andreypavlenko/jobber/modules/analytics/ports"
+)
+
+// weeklyReportDays is the length of the weekly report window, including today
+const weeklyReportDays = 7
+
+// WeeklyReportService builds weekly activity reports
+type WeeklyReportService struct {
+	activityRepo ports.WeeklyActivityRepository
+	reminderRepo ports.ReminderCounter
+	now          func() time.Time
+}
+
+// NewWeeklyReportService creates a new weekly report service
+func NewWeeklyReportService(activityRepo ports.WeeklyActivityRepository, reminderRepo ports.ReminderCounter) *WeeklyReportService {
+	return &WeeklyReportService{
+		activityRepo: activityRepo,
+		reminderRepo: reminderRepo,
+		now:          time.Now,
+	}
+}
+
+// Generate returns the report for the 7 days ending today (UTC)
+func (s *WeeklyReportService) Generate(ctx context.Context, userID string) (*model.WeeklyReport, error) {
+	weekStart, weekEnd := weekBounds(s.now())
+	to := weekEnd.AddDate(0, 0, 1)
+
+	activity, err := s.activityRepo.GetWeeklyActivity(ctx, userID, weekStart, to)
+	if err != nil {
+		return nil, err
+	}
+
+	remindersDue, err := s.reminderRepo.CountDue(ctx, userID, weekStart, to)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsByDay, err := s.activityRepo.CountApplicationEventsByDay(ctx, userID, weekStart, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.WeeklyReport{
+		WeekStart:         weekStart.Format(model.ReportDateLayout),
+		WeekEnd:           weekEnd.Format(model.ReportDateLayout),
+		NewApplications:   activity.NewApplications,
+		StageProgressions: activity.StageProgressions,
+		RemindersDue:      remindersDue,
+		OffersReceived:    activity.OffersReceived,
+		Rejections:        activity.Rejections,
+		MostActiveDay:     mostActiveDay(eventsByDay),
+	}, nil
+}
+
+// weekBounds returns the first and last day (UTC midnight) of the window ending on now's date
+func weekBounds(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	weekEnd := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return weekEnd.AddDate(0, 0, -(weeklyReportDays - 1)), weekEnd
+}
+
+// mostActiveDay returns the day with the most events; ties go to the earlier day
+func mostActiveDay(eventsByDay map[string]int) *string {
+	var best string
+	bestCount := 0
+	for day, count := range eventsByDay {
+		if count > bestCount || (count == bestCount && count > 0 && day < best) {
+			best, bestCount = day, count
+		}
+	}
+	if bestCount == 0 {
+		return nil
+	}
+	return &best
+}
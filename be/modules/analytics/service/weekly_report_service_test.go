@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/analytics/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type MockWeeklyActivityRepository struct {
+	GetWeeklyActivityFunc           func(ctx context.Context, userID string, from, to time.Time) (*model.WeeklyActivity, error)
+	CountApplicationEventsByDayFunc func(ctx context.Context, userID string, from, to time.Time) (map[string]int, error)
+}
+
+func (m *MockWeeklyActivityRepository) GetWeeklyActivity(ctx context.Context, userID string, from, to time.Time) (*model.WeeklyActivity, error) {
+	if m.GetWeeklyActivityFunc != nil {
+		return m.GetWeeklyActivityFunc(ctx, userID, from, to)
+	}
+	return &model.WeeklyActivity{}, nil
+}
+
+func (m *MockWeeklyActivityRepository) CountApplicationEventsByDay(ctx context.Context, userID string, from, to time.Time) (map[string]int, error) {
+	if m.CountApplicationEventsByDayFunc != nil {
+		return m.CountApplicationEventsByDayFunc(ctx, userID, from, to)
+	}
+	return map[string]int{}, nil
+}
+
+type MockReminderCounter struct {
+	CountDueFunc func(ctx context.Context, userID string, from, to time.Time) (int, error)
+}
+
+func (m *MockReminderCounter) CountDue(ctx context.Context, userID string, from, to time.Time) (int, error) {
+	if m.CountDueFunc != nil {
+		return m.CountDueFunc(ctx, userID, from, to)
+	}
+	return 0, nil
+}
+
+func TestWeeklyReportService_Generate(t *testing.T) {
+	// Wednesday afternoon, in a non-UTC zone to check the window is computed in UTC
+	now := time.Date(2026, time.June, 10, 15, 30, 0, 0, time.FixedZone("EEST", 3*60*60))
+	userID := "user-123"
+
+	t.Run("computes window boundaries for a mid-week call", func(t *testing.T) {
+		wantFrom := time.Date(2026, time.June, 4, 0, 0, 0, 0, time.UTC)
+		wantTo := time.Date(2026, time.June, 11, 0, 0, 0, 0, time.UTC)
+
+		activityRepo := &MockWeeklyActivityRepository{
+			GetWeeklyActivityFunc: func(ctx context.Context, uid string, from, to time.Time) (*model.WeeklyActivity, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, wantFrom, from)
+				assert.Equal(t, wantTo, to)
+				return &model.WeeklyActivity{NewApplications: 4, StageProgressions: 3, OffersReceived: 1, Rejections: 2}, nil
+			},
+			CountApplicationEventsByDayFunc: func(ctx context.Context, uid string, from, to time.Time) (map[string]int, error) {
+				assert.Equal(t, wantFrom, from)
+				assert.Equal(t, wantTo, to)
+				return map[string]int{"2026-06-05": 2, "2026-06-08": 5, "2026-06-10": 1}, nil
+			},
+		}
+		reminderRepo := &MockReminderCounter{
+			CountDueFunc: func(ctx context.Context, uid string, from, to time.Time) (int, error) {
+				assert.Equal(t, wantFrom, from)
+				assert.Equal(t, wantTo, to)
+				return 6, nil
+			},
+		}
+
+		svc := NewWeeklyReportService(activityRepo, reminderRepo)
+		svc.now = func() time.Time { return now }
+
+		report, err := svc.Generate(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "2026-06-04", report.WeekStart)
+		assert.Equal(t, "2026-06-10", report.WeekEnd)
+		assert.Equal(t, 4, report.NewApplications)
+		assert.Equal(t, 3, report.StageProgressions)
+		assert.Equal(t, 6, report.RemindersDue)
+		assert.Equal(t, 1, report.OffersReceived)
+		assert.Equal(t, 2, report.Rejections)
+		require.NotNil(t, report.MostActiveDay)
+		assert.Equal(t, "2026-06-08", *report.MostActiveDay)
+	})
+
+	t.Run("most active day is null without events", func(t *testing.T) {
+		svc := NewWeeklyReportService(&MockWeeklyActivityRepository{}, &MockReminderCounter{})
+		svc.now = func() time.Time { return now }
+
+		report, err := svc.Generate(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Nil(t, report.MostActiveDay)
+	})
+
+	t.Run("ties go to the earlier day", func(t *testing.T) {
+		activityRepo := &MockWeeklyActivityRepository{
+			CountApplicationEventsByDayFunc: func(ctx context.Context, uid string, from, to time.Time) (map[string]int, error) {
+				return map[string]int{"2026-06-09": 3, "2026-06-06": 3, "2026-06-07": 1}, nil
+			},
+		}
+		svc := NewWeeklyReportService(activityRepo, &MockReminderCounter{})
+		svc.now = func() time.Time { return now }
+
+		report, err := svc.Generate(context.Background(), userID)
+
+		require.NoError(t, err)
+		require.NotNil(t, report.MostActiveDay)
+		assert.Equal(t, "2026-06-06", *report.MostActiveDay)
+	})
+
+	t.Run("returns repository error", func(t *testing.T) {
+		reminderRepo := &MockReminderCounter{
+			CountDueFunc: func(ctx context.Context, uid string, from, to time.Time) (int, error) {
+				return 0, errors.New("db error")
+			},
+		}
+		svc := NewWeeklyReportService(&MockWeeklyActivityRepository{}, reminderRepo)
+
+		_, err := svc.Generate(context.Background(), userID)
+
+		assert.Error(t, err)
+	})
+}
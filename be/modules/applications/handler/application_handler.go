@@ -1,15 +1,37 @@
 package handler
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/andreypavlenko/jobber/internal/platform/auth"
 	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
+	"github.com/andreypavlenko/jobber/internal/platform/storage"
 	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/andreypavlenko/jobber/modules/applications/ports"
 	"github.com/andreypavlenko/jobber/modules/applications/service"
+	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
+	reminderModel "github.com/andreypavlenko/jobber/modules/reminders/model"
 	subModel "github.com/andreypavlenko/jobber/modules/subscriptions/model"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	maxCoverLetterSize   = 5 * 1024 * 1024 // 5MB
+	maxImportFileSize    = 5 * 1024 * 1024 // 5MB
+	maxMetadataKeyLength = 255
+	maxTagNameLength     = 100
+	maxSourceLength      = 255
+	filterDateLayout     = "2006-01-02"
 )
 
 type ApplicationHandler struct {
@@ -28,9 +50,11 @@ func NewApplicationHandler(service *service.ApplicationService) *ApplicationHand
 // @Accept json
 // @Produce json
 // @Param request body model.CreateApplicationRequest true "Application details"
+// @Param Idempotency-Key header string false "Client-generated key; retries with the same key replay the original response for 24h"
 // @Success 201 {object} model.ApplicationDTO
 // @Failure 400 {object} httpPlatform.ErrorResponse
 // @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 409 {object} httpPlatform.ErrorResponse "Request with the same Idempotency-Key in progress"
 // @Failure 500 {object} httpPlatform.ErrorResponse
 // @Router /applications [post]
 func (h *ApplicationHandler) Create(c *gin.Context) {
@@ -47,14 +71,69 @@ func (h *ApplicationHandler) Create(c *gin.Context) {
 	app, err := h.service.Create(c.Request.Context(), userID, &req)
 	if err != nil {
 		if errors.Is(err, model.ErrBothResumeTypesSet) {
-			httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeBothResumeTypesSet), model.GetErrorMessage(err))
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeBothResumeTypesSet), model.GetErrorMessage(err, auth.GetLocale(c)))
+			return
+		}
+		if errors.Is(err, model.ErrMetadataTooLarge) {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeMetadataTooLarge), model.GetErrorMessage(err, auth.GetLocale(c)))
+			return
+		}
+		if errors.Is(err, model.ErrInvalidReferralEmail) {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeInvalidReferralEmail), model.GetErrorMessage(err, auth.GetLocale(c)))
+			return
+		}
+		if errors.Is(err, subModel.ErrLimitReached) {
+			httpPlatform.RespondWithError(c, http.StatusForbidden, "PLAN_LIMIT_REACHED", "You have reached the application limit for your current plan.")
 			return
 		}
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusCreated, app)
+}
+
+// Clone godoc
+// @Summary Clone an application
+// @Description Create a copy of an application, e.g. to apply to another role at the same company. The copy keeps the resume and the stages of the original as pending stages, starts active and is applied now; tags, comments and the cover letter are not copied.
+// @Tags applications
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Application ID"
+// @Param request body model.CloneApplicationRequest false "Job and name of the copy"
+// @Success 201 {object} model.ApplicationDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 403 {object} httpPlatform.ErrorResponse "Plan limit reached"
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application or job not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/clone [post]
+func (h *ApplicationHandler) Clone(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	appID := c.Param("id")
+
+	var req model.CloneApplicationRequest
+	// Body is optional; ignore EOF/empty body errors but reject malformed JSON
+	if err := c.ShouldBindJSON(&req); err != nil && c.Request.ContentLength > 0 {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	app, err := h.service.Clone(c.Request.Context(), userID, appID, &req)
+	if err != nil {
 		if errors.Is(err, subModel.ErrLimitReached) {
 			httpPlatform.RespondWithError(c, http.StatusForbidden, "PLAN_LIMIT_REACHED", "You have reached the application limit for your current plan.")
 			return
 		}
-		httpPlatform.RespondWithError(c, http.StatusInternalServerError, string(model.GetErrorCode(err)), model.GetErrorMessage(err))
+		statusCode := http.StatusInternalServerError
+		code := model.GetErrorCode(err)
+		if code == model.CodeApplicationNotFound || code == model.CodeJobNotFound {
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(code), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
 	httpPlatform.RespondWithData(c, http.StatusCreated, app)
@@ -67,7 +146,11 @@ func (h *ApplicationHandler) Create(c *gin.Context) {
 // @Security BearerAuth
 // @Produce json
 // @Param id path string true "Application ID"
+// @Param comment_sort query string false "Order of nested comments by creation time" Enums(asc, desc) default(asc)
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {object} model.ApplicationDTO
+// @Success 304 "Not Modified"
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid comment_sort"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
 // @Failure 500 {object} httpPlatform.ErrorResponse
@@ -79,294 +162,1510 @@ func (h *ApplicationHandler) Get(c *gin.Context) {
 	}
 	appID := c.Param("id")
 
-	app, err := h.service.GetByID(c.Request.Context(), userID, appID)
+	commentSort := strings.ToLower(c.DefaultQuery("comment_sort", "asc"))
+	if commentSort != "asc" && commentSort != "desc" {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeInvalidSort), model.GetErrorMessage(model.ErrInvalidSort, auth.GetLocale(c)))
+		return
+	}
+
+	app, err := h.service.GetByIDWithCommentSort(c.Request.Context(), userID, appID, commentSort)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if model.GetErrorCode(err) == model.CodeApplicationNotFound {
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	etag := httpPlatform.ComputeETag(app)
+	httpPlatform.SetETag(c, etag)
+	if httpPlatform.CheckIfNoneMatch(c, etag) {
+		return
+	}
+	// Clients almost always load the stages next
+	httpPlatform.AddPreloadLinks(c, c.Request.URL.Path+"/stages")
+	httpPlatform.RespondWithData(c, http.StatusOK, app)
+}
+
+// List godoc
+// @Summary List applications
+// @Description Get a paginated list of job applications for the authenticated user
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param limit query int false "Number of items per page (default: 20, max: 100)"
+// @Param offset query int false "Number of items to skip (default: 0)"
+// @Param cursor query string false "Opaque cursor from pagination.next_cursor; pass it empty to start cursor pagination. Replaces offset and allows a single sort field"
+// @Param sort query string false "Comma-separated sort fields with direction, e.g. status:asc,last_activity:desc. Fields: last_activity, status, applied_at"
+// @Param sort_by query string false "Sort field when sort is not set: last_activity, status, applied_at (default: last_activity)"
+// @Param sort_dir query string false "Sort direction when sort is not set: asc, desc (default: desc)"
+// @Param status query string false "Filter by comma-separated statuses: active, on_hold, rejected, offer, archived"
+// @Param metadata_key query string false "Filter by custom metadata field name (requires metadata_value)"
+// @Param metadata_value query string false "Value the metadata field must equal, compared as text"
+// @Param tag_name query string false "Filter by tag name, e.g. remote"
+// @Param resume_id query string false "Filter by the uploaded resume used for the application"
+// @Param company_id query string false "Filter by the company of the application's job"
+// @Param source query string false "Filter by job source, case-insensitive, e.g. LinkedIn"
+// @Param applied_after query string false "Only applications applied on or after this date (YYYY-MM-DD)"
+// @Param applied_before query string false "Only applications applied on or before this date (YYYY-MM-DD)"
+// @Param tag_id query []string false "Filter by tag IDs; matches applications with any of them" collectionFormat(multi)
+// @Param include_deleted query bool false "Also list applications in the trash (default: false)"
+// @Success 200 {object} httpPlatform.PaginatedResponse{items=[]model.ApplicationDTO}
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid pagination, cursor, sort or filter parameters"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Resume not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications [get]
+func (h *ApplicationHandler) List(c *gin.Context) {
+	h.list(c, false)
+}
+
+// Trash godoc
+// @Summary List deleted applications
+// @Description List the authenticated user's applications in the trash. Accepts the same pagination, sort and filter parameters as the list endpoint.
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param limit query int false "Number of items per page (default: 20, max: 100)"
+// @Param offset query int false "Number of items to skip (default: 0)"
+// @Param cursor query string false "Opaque cursor from pagination.next_cursor; pass it empty to start cursor pagination"
+// @Param sort query string false "Comma-separated sort fields with direction, e.g. applied_at:desc"
+// @Success 200 {object} httpPlatform.PaginatedResponse{items=[]model.ApplicationDTO}
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid pagination, cursor, sort or filter parameters"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/trash [get]
+func (h *ApplicationHandler) Trash(c *gin.Context) {
+	h.list(c, true)
+}
+
+// list serves List and Trash; trash lists only soft-deleted applications
+func (h *ApplicationHandler) list(c *gin.Context, trash bool) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	
+	// Parse pagination parameters
+	pagination, err := httpPlatform.ParsePaginationParams(c)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_PAGINATION_PARAMS", "Invalid pagination parameters")
+		return
+	}
+
+	opts, ok := parseListOptions(c)
+	if !ok {
+		return
+	}
+	opts.Limit = pagination.Limit
+	opts.Offset = pagination.Offset
+	opts.OnlyDeleted = trash
+	if pagination.Cursor != nil {
+		// Fetch one extra row to tell whether another page follows
+		opts.Cursor = pagination.Cursor
+		opts.Limit++
+	}
+
+	apps, total, err := h.service.List(c.Request.Context(), userID, opts)
+	if err != nil {
+		if errors.Is(err, model.ErrResumeNotFound) {
+			httpPlatform.RespondWithError(c, http.StatusNotFound, string(model.CodeResumeNotFound), model.GetErrorMessage(err, auth.GetLocale(c)))
+			return
+		}
+		if httpPlatform.RespondWithCursorError(c, err) {
+			return
+		}
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list applications")
+		return
+	}
+
+	if pagination.Cursor != nil {
+		sortField := opts.SortFields[0].Field
+		page, next := keyset.Trim(apps, pagination.Limit, func(app *model.ApplicationDTO) keyset.Cursor {
+			return app.Cursor(sortField)
+		})
+		httpPlatform.RespondWithCursorPagination(c, http.StatusOK, page, pagination.Limit, total, next)
+		return
+	}
+	httpPlatform.RespondWithPagination(c, http.StatusOK, apps, pagination.Limit, pagination.Offset, total)
+}
+
+// parseListOptions parses the sort and filter query parameters shared by List and Export.
+// It responds with 400 and returns false when a parameter is invalid.
+func parseListOptions(c *gin.Context) (*ports.ListOptions, bool) {
+	// Parse sorting parameters; sort_by/sort_dir are kept for older clients
+	sort := c.Query("sort")
+	if sort == "" {
+		sort = c.DefaultQuery("sort_by", "last_activity") + ":" + c.DefaultQuery("sort_dir", "desc")
+	}
+	sortFields, err := parseSortFields(sort)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return nil, false
+	}
+
+	statuses := queryList(c, "status") // optional status filter, e.g. active,offer
+	validStatuses := map[string]bool{
+		"active": true, "on_hold": true, "rejected": true,
+		"offer": true, "archived": true,
+	}
+	for _, status := range statuses {
+		if !validStatuses[status] {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_STATUS", "Invalid status filter value")
+			return nil, false
+		}
+	}
+
+	metadataKey := c.Query("metadata_key")
+	metadataValue, hasMetadataValue := c.GetQuery("metadata_value")
+	if metadataKey != "" && !hasMetadataValue {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_METADATA_FILTER", "metadata_value is required when metadata_key is set")
+		return nil, false
+	}
+	if len(metadataKey) > maxMetadataKeyLength {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_METADATA_FILTER", "metadata_key is too long")
+		return nil, false
+	}
+
+	var tagName *string
+	if name := strings.TrimSpace(c.Query("tag_name")); name != "" {
+		if utf8.RuneCountInString(name) > maxTagNameLength {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_TAG_FILTER", "tag_name is too long")
+			return nil, false
+		}
+		tagName = &name
+	}
+
+	var resumeID *string
+	if id := c.Query("resume_id"); id != "" {
+		if _, err := uuid.Parse(id); err != nil {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid resume ID format")
+			return nil, false
+		}
+		resumeID = &id
+	}
+
+	var companyID *string
+	if id := c.Query("company_id"); id != "" {
+		if _, err := uuid.Parse(id); err != nil {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid company ID format")
+			return nil, false
+		}
+		companyID = &id
+	}
+
+	var source *string
+	if s := strings.TrimSpace(c.Query("source")); s != "" {
+		if utf8.RuneCountInString(s) > maxSourceLength {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "source is too long")
+			return nil, false
+		}
+		source = &s
+	}
+
+	appliedAfter, err := parseDateQuery(c, "applied_after")
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_DATE_FILTER", "applied_after must be a date in YYYY-MM-DD format")
+		return nil, false
+	}
+	appliedBefore, err := parseDateQuery(c, "applied_before")
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_DATE_FILTER", "applied_before must be a date in YYYY-MM-DD format")
+		return nil, false
+	}
+	if appliedBefore != nil {
+		// applied_before is inclusive of the whole day
+		end := appliedBefore.AddDate(0, 0, 1)
+		appliedBefore = &end
+	}
+	if appliedAfter != nil && appliedBefore != nil && !appliedAfter.Before(*appliedBefore) {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_DATE_FILTER", "applied_after must not be later than applied_before")
+		return nil, false
+	}
+
+	tagIDs := queryList(c, "tag_id")
+	for _, id := range tagIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid tag ID format")
+			return nil, false
+		}
+	}
+
+	includeDeleted, err := strconv.ParseBool(c.DefaultQuery("include_deleted", "false"))
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "include_deleted must be true or false")
+		return nil, false
+	}
+
+	return &ports.ListOptions{
+		SortFields:    sortFields,
+		Statuses:      statuses,
+		MetadataKey:   metadataKey,
+		MetadataValue: metadataValue,
+		TagName:       tagName,
+		ResumeID:      resumeID,
+		CompanyID:     companyID,
+		Source:        source,
+		AppliedAfter:  appliedAfter,
+		AppliedBefore:  appliedBefore,
+		TagIDs:         tagIDs,
+		IncludeDeleted: includeDeleted,
+	}, true
+}
+
+// Stats godoc
+// @Summary Get application counts by status
+// @Description Get lightweight per-status application counts for the authenticated user
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} model.StatusCounts
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/stats [get]
+func (h *ApplicationHandler) Stats(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	counts, err := h.service.GetStatusCounts(c.Request.Context(), userID)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, counts)
+}
+
+// Expiring godoc
+// @Summary List applications with an upcoming deadline
+// @Description List the authenticated user's applications whose deadline falls within the given number of hours, soonest deadline first
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param within_hours query int false "Number of hours ahead to include, 1-720 (default: 48)"
+// @Param limit query int false "Number of items per page (default: 20, max: 100)"
+// @Param offset query int false "Number of items to skip (default: 0)"
+// @Success 200 {object} httpPlatform.PaginatedResponse{items=[]model.ApplicationDTO}
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid within_hours or pagination parameters"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/expiring [get]
+func (h *ApplicationHandler) Expiring(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	pagination, err := httpPlatform.ParsePaginationParams(c)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_PAGINATION_PARAMS", "Invalid pagination parameters")
+		return
+	}
+
+	withinHours := model.DefaultExpiringWithinHours
+	if raw := c.Query("within_hours"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeInvalidWithinHours), model.GetErrorMessage(model.ErrInvalidWithinHours, auth.GetLocale(c)))
+			return
+		}
+		withinHours = parsed
+	}
+
+	apps, total, err := h.service.ListExpiring(c.Request.Context(), userID, withinHours, pagination.Limit, pagination.Offset)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidWithinHours) {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeInvalidWithinHours), model.GetErrorMessage(err, auth.GetLocale(c)))
+			return
+		}
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list expiring applications")
+		return
+	}
+	httpPlatform.RespondWithPagination(c, http.StatusOK, apps, pagination.Limit, pagination.Offset, total)
+}
+
+// Offers godoc
+// @Summary List offers for comparison
+// @Description List all of the authenticated user's applications in the offer status with salary, equity, start date, benefits and company, soonest deadline first. The list is not paginated.
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} model.OfferDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/offers [get]
+func (h *ApplicationHandler) Offers(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	offers, err := h.service.ListOffers(c.Request.Context(), userID)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list offers")
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, offers)
+}
+
+// Export godoc
+// @Summary Export applications as CSV
+// @Description Download the authenticated user's applications as a CSV file with the columns Name, Company, Job Title, Source, Status, Applied At, Current Stage, Tags, Last Activity. Accepts the same sort and filter parameters as the list endpoint; pagination is ignored and at most 10000 rows are exported. Timestamps are ISO-8601 in UTC, tags are separated by "; ", and missing values are left empty.
+// @Tags applications
+// @Security BearerAuth
+// @Produce text/csv
+// @Param format query string false "Export format; only csv is supported (default: csv)"
+// @Param sort query string false "Comma-separated sort fields with direction, e.g. status:asc,last_activity:desc"
+// @Param status query string false "Filter by comma-separated statuses: active, on_hold, rejected, offer, archived"
+// @Param company_id query string false "Filter by the company of the application's job"
+// @Param source query string false "Filter by job source, case-insensitive"
+// @Param applied_after query string false "Only applications applied on or after this date (YYYY-MM-DD)"
+// @Param applied_before query string false "Only applications applied on or before this date (YYYY-MM-DD)"
+// @Param tag_id query []string false "Filter by tag IDs; matches applications with any of them" collectionFormat(multi)
+// @Success 200 {file} binary "CSV file"
+// @Failure 400 {object} httpPlatform.ErrorResponse "Unsupported format or invalid sort or filter parameters"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Resume not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/export [get]
+func (h *ApplicationHandler) Export(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	if format := c.DefaultQuery("format", "csv"); format != "csv" {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_EXPORT_FORMAT", "Unsupported export format, only csv is available")
+		return
+	}
+
+	opts, ok := parseListOptions(c)
+	if !ok {
+		return
+	}
+
+	// ExportCSV writes nothing before it fails, so the headers can still be withdrawn on error
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", "attachment; filename=applications.csv")
+	if err := h.service.ExportCSV(c.Request.Context(), userID, opts, c.Writer); err != nil {
+		c.Writer.Header().Del("Content-Type")
+		c.Writer.Header().Del("Content-Disposition")
+		if errors.Is(err, model.ErrResumeNotFound) {
+			httpPlatform.RespondWithError(c, http.StatusNotFound, string(model.CodeResumeNotFound), model.GetErrorMessage(err, auth.GetLocale(c)))
+			return
+		}
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to export applications")
+	}
+}
+
+// Import godoc
+// @Summary Import applications from CSV
+// @Description Create applications from an uploaded CSV file (max 1000 rows, 5MB). Required columns: company_name, job_title, source, applied_at (YYYY-MM-DD or RFC 3339). Optional columns: status (default active), url, notes. Companies are matched by name, ignoring case, and created when missing; each row creates a job and an application. Invalid rows are skipped and listed in errors with their line number. With dry_run=true the file is only validated and a preview of what would be created is returned.
+// @Tags applications
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "CSV file"
+// @Param dry_run query bool false "Validate and preview without creating anything (default: false)"
+// @Success 200 {object} model.ImportResult
+// @Failure 400 {object} httpPlatform.ErrorResponse "Missing or invalid file, missing columns or too many rows"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/import [post]
+func (h *ApplicationHandler) Import(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	dryRun := false
+	if raw := c.Query("dry_run"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "dry_run must be true or false")
+			return
+		}
+		dryRun = parsed
+	}
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "NO_FILE", "CSV file is required")
+		return
+	}
+	defer file.Close()
+
+	if header.Size > maxImportFileSize {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "FILE_TOO_LARGE", "Import file exceeds 5MB limit")
+		return
+	}
+
+	result, err := h.service.ImportCSV(c.Request.Context(), userID, io.LimitReader(file, maxImportFileSize), dryRun)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		code := model.GetErrorCode(err)
+		if code == model.CodeInvalidImportFile || code == model.CodeTooManyImportRows {
+			statusCode = http.StatusBadRequest
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(code), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, result)
+}
+
+// queryList collects a multi-value query parameter, accepting both repeated
+// keys and comma-separated values, e.g. ?status=active,offer&status=on_hold.
+func queryList(c *gin.Context, key string) []string {
+	var values []string
+	for _, raw := range c.QueryArray(key) {
+		for _, v := range strings.Split(raw, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+// parseDateQuery parses an optional YYYY-MM-DD query parameter as midnight UTC
+func parseDateQuery(c *gin.Context, key string) (*time.Time, error) {
+	raw := strings.TrimSpace(c.Query(key))
+	if raw == "" {
+		return nil, nil
+	}
+	date, err := time.Parse(filterDateLayout, raw)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+// parseSortFields parses a comma-separated list of field:direction pairs,
+// e.g. "status:asc,last_activity:desc". The direction defaults to desc.
+func parseSortFields(sort string) ([]ports.SortField, error) {
+	segments := strings.Split(sort, ",")
+	fields := make([]ports.SortField, 0, len(segments))
+	for _, segment := range segments {
+		field, dir, _ := strings.Cut(strings.TrimSpace(segment), ":")
+		if !ports.SortableFields[field] {
+			return nil, model.ErrInvalidSort
+		}
+		dir = strings.ToLower(dir)
+		switch dir {
+		case "":
+			dir = "desc"
+		case "asc", "desc":
+		default:
+			return nil, model.ErrInvalidSort
+		}
+		fields = append(fields, ports.SortField{Field: field, Dir: dir})
+	}
+	return fields, nil
+}
+
+// Update godoc
+// @Summary Update an application
+// @Description Update the status, cover letter, custom fields, or offered and negotiated salary of a specific application
+// @Tags applications
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Application ID"
+// @Param request body model.UpdateApplicationRequest true "Updated application details"
+// @Success 200 {object} model.ApplicationDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id} [patch]
+func (h *ApplicationHandler) Update(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	appID := c.Param("id")
+	var req model.UpdateApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	app, err := h.service.Update(c.Request.Context(), userID, appID, &req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch model.GetErrorCode(err) {
+		case model.CodeApplicationNotFound:
+			statusCode = http.StatusNotFound
+		case model.CodeMetadataTooLarge, model.CodeInvalidSalary, model.CodeInvalidEquityPercent, model.CodeInvalidStartDate, model.CodeInvalidReferralEmail:
+			statusCode = http.StatusBadRequest
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, app)
+}
+
+// UpdateResume godoc
+// @Summary Switch the resume of an application
+// @Description Attach a different uploaded resume to an application. The change is recorded as a comment on the application.
+// @Tags applications
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Application ID"
+// @Param request body model.UpdateResumeRequest true "Resume to attach"
+// @Success 200 {object} model.ApplicationDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application or resume not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/resume [patch]
+func (h *ApplicationHandler) UpdateResume(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	appID := c.Param("id")
+	var req model.UpdateResumeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	app, err := h.service.UpdateResume(c.Request.Context(), userID, appID, req.ResumeID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch model.GetErrorCode(err) {
+		case model.CodeApplicationNotFound, model.CodeResumeNotFound:
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, app)
+}
+
+// Archive godoc
+// @Summary Archive an application
+// @Description Set the application status to archived and record when it was archived. The change is recorded as a comment on the application.
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Application ID"
+// @Success 200 {object} model.ApplicationDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 409 {object} httpPlatform.ErrorResponse "Application is already archived"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/archive [post]
+func (h *ApplicationHandler) Archive(c *gin.Context) {
+	h.changeArchiveStatus(c, h.service.Archive)
+}
+
+// Unarchive godoc
+// @Summary Unarchive an application
+// @Description Return an archived application to the active status and clear its archived date. The change is recorded as a comment on the application.
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Application ID"
+// @Success 200 {object} model.ApplicationDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 409 {object} httpPlatform.ErrorResponse "Application is not archived"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/unarchive [post]
+func (h *ApplicationHandler) Unarchive(c *gin.Context) {
+	h.changeArchiveStatus(c, h.service.Unarchive)
+}
+
+// changeArchiveStatus runs an archive or unarchive action for the application in the path
+func (h *ApplicationHandler) changeArchiveStatus(c *gin.Context, action func(ctx context.Context, userID, appID string) (*model.ApplicationDTO, error)) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	app, err := action(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch model.GetErrorCode(err) {
+		case model.CodeApplicationNotFound:
+			statusCode = http.StatusNotFound
+		case model.CodeInvalidStatusTransition:
+			statusCode = http.StatusConflict
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, app)
+}
+
+// BulkTag godoc
+// @Summary Add or remove tags on multiple applications
+// @Description Attach or detach up to 10 tags on up to 100 applications in one request. Adding an already attached tag is a no-op.
+// @Tags applications
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body model.BulkTagRequest true "Bulk tag request"
+// @Success 200 {object} model.BulkTagResponse
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application or tag not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/bulk-tag [patch]
+func (h *ApplicationHandler) BulkTag(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	var req model.BulkTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	result, err := h.service.BulkTag(c.Request.Context(), userID, &req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		code := model.GetErrorCode(err)
+		if code == model.CodeApplicationNotFound || code == model.CodeTagNotFound {
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(code), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, result)
+}
+
+// BulkUpdateStatus godoc
+// @Summary Set the status of multiple applications
+// @Description Set the same status on up to 100 applications in one request. The whole batch is rejected when any application does not exist or belongs to another user; the offending IDs are listed in application_ids.
+// @Tags applications
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body model.BulkStatusRequest true "Bulk status request"
+// @Success 200 {object} model.BulkStatusResponse
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid payload or status"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} model.BulkStatusErrorResponse "Applications not found"
+// @Failure 422 {object} httpPlatform.ErrorResponse "More than 100 applications"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/bulk-status [patch]
+func (h *ApplicationHandler) BulkUpdateStatus(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	var req model.BulkStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	result, err := h.service.BulkUpdateStatus(c.Request.Context(), userID, &req)
+	if err != nil {
+		code := model.GetErrorCode(err)
+		var unowned *model.UnownedApplicationsError
+		if errors.As(err, &unowned) {
+			c.JSON(http.StatusNotFound, model.BulkStatusErrorResponse{
+				ErrorCode:      string(code),
+				ErrorMessage:   model.GetErrorMessage(err, auth.GetLocale(c)),
+				ApplicationIDs: unowned.ApplicationIDs,
+			})
+			return
+		}
+		statusCode := http.StatusInternalServerError
+		switch code {
+		case model.CodeInvalidStatus:
+			statusCode = http.StatusBadRequest
+		case model.CodeTooManyApplications:
+			statusCode = http.StatusUnprocessableEntity
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(code), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, result)
+}
+
+// BulkAdvanceStage godoc
+// @Summary Advance multiple applications to the next stage
+// @Description Add the same stage to up to 20 applications. Each application is advanced independently; failures are reported per application by error code.
+// @Tags applications
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body model.BulkAdvanceStageRequest true "Bulk advance request"
+// @Success 200 {object} model.BulkAdvanceStageResponse
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Stage template not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/bulk-advance-stage [post]
+func (h *ApplicationHandler) BulkAdvanceStage(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	var req model.BulkAdvanceStageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	result, err := h.service.BulkAdvanceStage(c.Request.Context(), userID, &req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		code := model.GetErrorCode(err)
+		if code == model.CodeStageTemplateNotFound {
+			statusCode = http.StatusNotFound
+		} else if code == model.CodeTooManyApplications {
+			statusCode = http.StatusBadRequest
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(code), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, result)
+}
+
+// UploadCoverLetter godoc
+// @Summary Upload a cover letter
+// @Description Upload a cover letter file (PDF, DOC, DOCX or TXT, max 5MB) and attach it to an application, replacing any existing one. The file type is detected from its contents.
+// @Tags applications
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Application ID"
+// @Param file formData file true "Cover letter file"
+// @Success 200 {object} model.ApplicationDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Failure 503 {object} httpPlatform.ErrorResponse "Storage not configured or temporarily unavailable"
+// @Router /applications/{id}/cover-letter/upload [post]
+func (h *ApplicationHandler) UploadCoverLetter(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	appID := c.Param("id")
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "NO_FILE", "Cover letter file is required")
+		return
+	}
+	defer file.Close()
+
+	if header.Size > maxCoverLetterSize {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "FILE_TOO_LARGE", "Cover letter file exceeds 5MB limit")
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, maxCoverLetterSize+1))
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "READ_FAILED", "Failed to read cover letter file")
+		return
+	}
+	if len(data) > maxCoverLetterSize {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "FILE_TOO_LARGE", "Cover letter file exceeds 5MB limit")
+		return
+	}
+
+	app, err := h.service.UploadCoverLetter(c.Request.Context(), userID, appID, data)
+	if err != nil {
+		h.respondWithCoverLetterError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, app)
+}
+
+// GetCoverLetterDownloadURL godoc
+// @Summary Get cover letter download URL
+// @Description Generate a presigned URL for downloading an application's uploaded cover letter
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Application ID"
+// @Success 200 {object} model.CoverLetterDownloadURLResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application or cover letter not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Failure 503 {object} httpPlatform.ErrorResponse "Storage not configured or temporarily unavailable"
+// @Router /applications/{id}/cover-letter/download-url [get]
+func (h *ApplicationHandler) GetCoverLetterDownloadURL(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	appID := c.Param("id")
+
+	response, err := h.service.GenerateCoverLetterDownloadURL(c.Request.Context(), userID, appID)
+	if err != nil {
+		h.respondWithCoverLetterError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, response)
+}
+
+func (h *ApplicationHandler) respondWithCoverLetterError(c *gin.Context, err error) {
+	if errors.Is(err, storage.ErrStorageUnavailable) {
+		httpPlatform.RespondWithError(c, http.StatusServiceUnavailable, "STORAGE_UNAVAILABLE", "File storage is temporarily unavailable, please try again later")
+		return
+	}
+	statusCode := http.StatusInternalServerError
+	switch model.GetErrorCode(err) {
+	case model.CodeApplicationNotFound, model.CodeCoverLetterNotFound:
+		statusCode = http.StatusNotFound
+	case model.CodeUnsupportedCoverLetter:
+		statusCode = http.StatusBadRequest
+	case model.CodeStorageNotConfigured:
+		statusCode = http.StatusServiceUnavailable
+	}
+	httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+}
+
+// Delete godoc
+// @Summary Delete an application
+// @Description Move an application to the trash. It can be restored or permanently deleted later.
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Application ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id} [delete]
+func (h *ApplicationHandler) Delete(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	appID := c.Param("id")
+
+	if err := h.service.Delete(c.Request.Context(), userID, appID); err != nil {
+		statusCode := http.StatusInternalServerError
+		if model.GetErrorCode(err) == model.CodeApplicationNotFound {
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Application deleted successfully"})
+}
+
+// Restore godoc
+// @Summary Restore a deleted application
+// @Description Take an application out of the trash
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Application ID"
+// @Success 200 {object} model.ApplicationDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 403 {object} httpPlatform.ErrorResponse "Plan limit reached"
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found in the trash"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/restore [post]
+func (h *ApplicationHandler) Restore(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	app, err := h.service.Restore(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, subModel.ErrLimitReached) {
+			httpPlatform.RespondWithError(c, http.StatusForbidden, "PLAN_LIMIT_REACHED", "You have reached the application limit for your current plan.")
+			return
+		}
+		statusCode := http.StatusInternalServerError
+		if model.GetErrorCode(err) == model.CodeApplicationNotFound {
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, app)
+}
+
+// DeletePermanently godoc
+// @Summary Permanently delete an application
+// @Description Irreversibly delete an application, in the trash or not, with its stages, comments and reminders
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Application ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/permanent [delete]
+func (h *ApplicationHandler) DeletePermanently(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeletePermanently(c.Request.Context(), userID, c.Param("id")); err != nil {
+		statusCode := http.StatusInternalServerError
+		if model.GetErrorCode(err) == model.CodeApplicationNotFound {
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Application permanently deleted"})
+}
+
+// Share godoc
+// @Summary Share an application
+// @Description Create a public read-only link for an application. Returns the existing link if already shared
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Application ID"
+// @Success 200 {object} model.ShareApplicationResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/share [post]
+func (h *ApplicationHandler) Share(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	appID := c.Param("id")
+
+	share, err := h.service.ShareApplication(c.Request.Context(), userID, appID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if model.GetErrorCode(err) == model.CodeApplicationNotFound {
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, share)
+}
+
+// RevokeShare godoc
+// @Summary Revoke an application share link
+// @Description Disable the public read-only link for an application
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Application ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/share [delete]
+func (h *ApplicationHandler) RevokeShare(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	appID := c.Param("id")
+
+	if err := h.service.RevokeShare(c.Request.Context(), userID, appID); err != nil {
+		statusCode := http.StatusInternalServerError
+		if model.GetErrorCode(err) == model.CodeApplicationNotFound {
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Share link revoked successfully"})
+}
+
+// GetShared godoc
+// @Summary Get a shared application
+// @Description Get the public read-only view of an application by its share token. No authentication required
+// @Tags applications
+// @Produce json
+// @Param token path string true "Share token"
+// @Success 200 {object} model.ApplicationDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid share token"
+// @Failure 404 {object} httpPlatform.ErrorResponse "Shared application not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /share/{token} [get]
+func (h *ApplicationHandler) GetShared(c *gin.Context) {
+	app, err := h.service.GetShared(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch model.GetErrorCode(err) {
+		case model.CodeInvalidShareToken:
+			statusCode = http.StatusBadRequest
+		case model.CodeShareTokenNotFound:
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, app)
+}
+
+// AddStage godoc
+// @Summary Add a stage to an application
+// @Description Add a new stage to an application's timeline
+// @Tags applications
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Application ID"
+// @Param request body model.AddStageRequest true "Stage template ID, or a name for a one-off stage"
+// @Success 201 {object} model.ApplicationStageDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/stages [post]
+func (h *ApplicationHandler) AddStage(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	appID := c.Param("id")
+	var req model.AddStageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	stage, err := h.service.AddStage(c.Request.Context(), userID, appID, &req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		errCode := model.GetErrorCode(err)
+		switch errCode {
+		case model.CodeApplicationNotFound, model.CodeStageTemplateNotFound:
+			statusCode = http.StatusNotFound
+		case model.CodeAmbiguousStageInput, model.CodeStageInputRequired:
+			statusCode = http.StatusBadRequest
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusCreated, stage)
+}
+
+// UpdateStage godoc
+// @Summary Update an application stage
+// @Description Update status and other fields of a specific stage
+// @Tags applications
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Application ID"
+// @Param stageId path string true "Stage ID"
+// @Param request body model.UpdateStageRequest true "Stage update details"
+// @Success 200 {object} model.ApplicationStageDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application or stage not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/stages/{stageId} [patch]
+func (h *ApplicationHandler) UpdateStage(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	appID := c.Param("id")
+	stageID := c.Param("stageId")
+	var req model.UpdateStageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	stage, err := h.service.UpdateStage(c.Request.Context(), userID, appID, stageID, &req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if model.GetErrorCode(err) == model.CodeApplicationStageNotFound {
+			statusCode = http.StatusNotFound
+		} else if model.GetErrorCode(err) == model.CodeInvalidStatus {
+			statusCode = http.StatusBadRequest
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, stage)
+}
+
+// CompleteStage godoc
+// @Summary Complete an application stage
+// @Description Mark a specific stage as completed
+// @Tags applications
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Application ID"
+// @Param stageId path string true "Stage ID"
+// @Param request body model.CompleteStageRequest false "Completion details"
+// @Success 200 {object} model.ApplicationStageDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application or stage not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/stages/{stageId}/complete [patch]
+func (h *ApplicationHandler) CompleteStage(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	appID := c.Param("id")
+	stageID := c.Param("stageId")
+	var req model.CompleteStageRequest
+	// Body is optional; ignore EOF/empty body errors but reject malformed JSON
+	if err := c.ShouldBindJSON(&req); err != nil && c.Request.ContentLength > 0 {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	stage, err := h.service.CompleteStage(c.Request.Context(), userID, appID, stageID, &req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		errCode := model.GetErrorCode(err)
+		if errCode == model.CodeApplicationNotFound || errCode == model.CodeApplicationStageNotFound {
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, stage)
+}
+
+// ListStages godoc
+// @Summary List application stages
+// @Description Get all stages for a specific application
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Application ID"
+// @Success 200 {object} []model.ApplicationStageDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/stages [get]
+func (h *ApplicationHandler) ListStages(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	appID := c.Param("id")
+
+	stages, err := h.service.ListStages(c.Request.Context(), userID, appID)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		if model.GetErrorCode(err) == model.CodeApplicationNotFound {
 			statusCode = http.StatusNotFound
 		}
-		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
-	httpPlatform.RespondWithData(c, http.StatusOK, app)
+	httpPlatform.RespondWithData(c, http.StatusOK, stages)
 }
 
-// List godoc
-// @Summary List applications
-// @Description Get a paginated list of job applications for the authenticated user
+// ReorderStages godoc
+// @Summary Reorder the stages of an application
+// @Description Assign new orders to stages of an application. Stages not listed keep their order, orders must stay unique, and the stage with the highest order becomes the current stage.
 // @Tags applications
 // @Security BearerAuth
+// @Accept json
 // @Produce json
-// @Param limit query int false "Number of items per page (default: 20, max: 100)"
-// @Param offset query int false "Number of items to skip (default: 0)"
-// @Param sort_by query string false "Sort field: last_activity, status, applied_at (default: last_activity)"
-// @Param sort_dir query string false "Sort direction: asc, desc (default: desc)"
-// @Param status query string false "Filter by status: active, on_hold, rejected, offer, archived"
-// @Success 200 {object} httpPlatform.PaginatedResponse{items=[]model.ApplicationDTO}
-// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid pagination parameters"
+// @Param id path string true "Application ID"
+// @Param request body model.ReorderStagesRequest true "New stage orders"
+// @Success 200 {array} model.ApplicationStageDTO "Stages in their new order"
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid payload or duplicate order"
 // @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application or stage not found"
 // @Failure 500 {object} httpPlatform.ErrorResponse
-// @Router /applications [get]
-func (h *ApplicationHandler) List(c *gin.Context) {
+// @Router /applications/{id}/stages/reorder [put]
+func (h *ApplicationHandler) ReorderStages(c *gin.Context) {
 	userID, ok := auth.MustGetUserID(c)
 	if !ok {
 		return
 	}
-	
-	// Parse pagination parameters
-	pagination, err := httpPlatform.ParsePaginationParams(c)
-	if err != nil {
-		httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_PAGINATION_PARAMS", "Invalid pagination parameters")
+	appID := c.Param("id")
+	var req model.ReorderStagesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
 		return
 	}
 
-	// Parse sorting parameters
-	sortBy := c.DefaultQuery("sort_by", "last_activity")
-	sortDir := c.DefaultQuery("sort_dir", "desc")
-	status := c.Query("status") // optional status filter
-	if status != "" {
-		validStatuses := map[string]bool{
-			"active": true, "on_hold": true, "rejected": true,
-			"offer": true, "archived": true,
-		}
-		if !validStatuses[status] {
-			httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_STATUS", "Invalid status filter value")
-			return
+	if err := h.service.ReorderStages(c.Request.Context(), userID, appID, req.Stages); err != nil {
+		statusCode := http.StatusInternalServerError
+		switch model.GetErrorCode(err) {
+		case model.CodeApplicationNotFound, model.CodeApplicationStageNotFound:
+			statusCode = http.StatusNotFound
+		case model.CodeDuplicateOrder:
+			statusCode = http.StatusBadRequest
 		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
 	}
 
-	apps, total, err := h.service.List(c.Request.Context(), userID, sortBy, sortDir, status, pagination.Limit, pagination.Offset)
+	stages, err := h.service.ListStages(c.Request.Context(), userID, appID)
 	if err != nil {
-		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list applications")
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
-	httpPlatform.RespondWithPagination(c, http.StatusOK, apps, pagination.Limit, pagination.Offset, total)
+	httpPlatform.RespondWithData(c, http.StatusOK, stages)
 }
 
-// Update godoc
-// @Summary Update an application
-// @Description Update status of a specific application
+// GetNextReminder godoc
+// @Summary Get the next reminder of an application
+// @Description Get the earliest open reminder of an application that is due in the future
 // @Tags applications
 // @Security BearerAuth
-// @Accept json
 // @Produce json
 // @Param id path string true "Application ID"
-// @Param request body model.UpdateApplicationRequest true "Updated application details"
-// @Success 200 {object} model.ApplicationDTO
-// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Success 200 {object} reminderModel.ReminderDTO
+// @Success 204 "No upcoming reminder"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
 // @Failure 500 {object} httpPlatform.ErrorResponse
-// @Router /applications/{id} [patch]
-func (h *ApplicationHandler) Update(c *gin.Context) {
+// @Router /applications/{id}/reminders/next [get]
+func (h *ApplicationHandler) GetNextReminder(c *gin.Context) {
 	userID, ok := auth.MustGetUserID(c)
 	if !ok {
 		return
 	}
 	appID := c.Param("id")
-	var req model.UpdateApplicationRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
-		return
-	}
 
-	app, err := h.service.Update(c.Request.Context(), userID, appID, &req)
+	var reminder *reminderModel.ReminderDTO
+	reminder, err := h.service.GetNextReminder(c.Request.Context(), userID, appID)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		if model.GetErrorCode(err) == model.CodeApplicationNotFound {
 			statusCode = http.StatusNotFound
 		}
-		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
-	httpPlatform.RespondWithData(c, http.StatusOK, app)
+	if reminder == nil {
+		c.Status(http.StatusNoContent)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, reminder)
 }
 
-// Delete godoc
-// @Summary Delete an application
-// @Description Delete a specific application by ID
+// GetNextActions godoc
+// @Summary Get recommended next actions for an application
+// @Description Suggest next steps, such as following up or setting a reminder, based on the application's status, activity, reminders and current stage
 // @Tags applications
 // @Security BearerAuth
 // @Produce json
 // @Param id path string true "Application ID"
-// @Success 200 {object} map[string]string
+// @Success 200 {array} model.NextAction
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
 // @Failure 500 {object} httpPlatform.ErrorResponse
-// @Router /applications/{id} [delete]
-func (h *ApplicationHandler) Delete(c *gin.Context) {
+// @Router /applications/{id}/next-actions [get]
+func (h *ApplicationHandler) GetNextActions(c *gin.Context) {
 	userID, ok := auth.MustGetUserID(c)
 	if !ok {
 		return
 	}
 	appID := c.Param("id")
 
-	if err := h.service.Delete(c.Request.Context(), userID, appID); err != nil {
+	actions, err := h.service.GetNextActions(c.Request.Context(), userID, appID)
+	if err != nil {
 		statusCode := http.StatusInternalServerError
 		if model.GetErrorCode(err) == model.CodeApplicationNotFound {
 			statusCode = http.StatusNotFound
 		}
-		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
-	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Application deleted successfully"})
+	httpPlatform.RespondWithData(c, http.StatusOK, actions)
 }
 
-// AddStage godoc
-// @Summary Add a stage to an application
-// @Description Add a new stage to an application's timeline
+// ListEvents godoc
+// @Summary List an application's activity
+// @Description Get the audit trail of an application, newest first. Each event names the change (application.created, application.status_changed, stage.added, stage.completed, stage.deleted, comment.added) and carries the fields it touched; changed values are given as {"from", "to"}.
 // @Tags applications
 // @Security BearerAuth
-// @Accept json
 // @Produce json
 // @Param id path string true "Application ID"
-// @Param request body model.AddStageRequest true "Stage template ID"
-// @Success 201 {object} model.ApplicationStageDTO
-// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Param limit query int false "Number of items per page (default: 20, max: 100)"
+// @Param offset query int false "Number of items to skip (default: 0)"
+// @Success 200 {object} httpPlatform.PaginatedResponse{items=[]model.ApplicationEventDTO}
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid pagination parameters"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
 // @Failure 500 {object} httpPlatform.ErrorResponse
-// @Router /applications/{id}/stages [post]
-func (h *ApplicationHandler) AddStage(c *gin.Context) {
+// @Router /applications/{id}/events [get]
+func (h *ApplicationHandler) ListEvents(c *gin.Context) {
 	userID, ok := auth.MustGetUserID(c)
 	if !ok {
 		return
 	}
 	appID := c.Param("id")
-	var req model.AddStageRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+
+	pagination, err := httpPlatform.ParsePaginationParams(c)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_PAGINATION_PARAMS", "Invalid pagination parameters")
 		return
 	}
 
-	stage, err := h.service.AddStage(c.Request.Context(), userID, appID, &req)
+	events, total, err := h.service.ListEvents(c.Request.Context(), userID, appID, pagination.Limit, pagination.Offset)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
-		errCode := model.GetErrorCode(err)
-		if errCode == model.CodeApplicationNotFound || errCode == model.CodeStageTemplateNotFound {
+		if model.GetErrorCode(err) == model.CodeApplicationNotFound {
 			statusCode = http.StatusNotFound
 		}
-		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
-	httpPlatform.RespondWithData(c, http.StatusCreated, stage)
+	httpPlatform.RespondWithPagination(c, http.StatusOK, events, pagination.Limit, pagination.Offset, total)
 }
 
-// UpdateStage godoc
-// @Summary Update an application stage
-// @Description Update status and other fields of a specific stage
+// GetSimilarJobs godoc
+// @Summary Get jobs similar to an application's job
+// @Description Suggest other tracked jobs whose titles resemble the application's job title, most similar first
 // @Tags applications
 // @Security BearerAuth
-// @Accept json
 // @Produce json
 // @Param id path string true "Application ID"
-// @Param stageId path string true "Stage ID"
-// @Param request body model.UpdateStageRequest true "Stage update details"
-// @Success 200 {object} model.ApplicationStageDTO
-// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Param limit query int false "Maximum number of suggestions (default and max: 5)"
+// @Success 200 {array} jobModel.SimilarJobDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid limit"
 // @Failure 401 {object} httpPlatform.ErrorResponse
-// @Failure 404 {object} httpPlatform.ErrorResponse "Application or stage not found"
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
 // @Failure 500 {object} httpPlatform.ErrorResponse
-// @Router /applications/{id}/stages/{stageId} [patch]
-func (h *ApplicationHandler) UpdateStage(c *gin.Context) {
+// @Router /applications/{id}/similar-jobs [get]
+func (h *ApplicationHandler) GetSimilarJobs(c *gin.Context) {
 	userID, ok := auth.MustGetUserID(c)
 	if !ok {
 		return
 	}
 	appID := c.Param("id")
-	stageID := c.Param("stageId")
-	var req model.UpdateStageRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
-		return
+
+	limit := service.MaxSimilarJobs
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_LIMIT", "limit must be a positive integer")
+			return
+		}
+		limit = parsed
 	}
 
-	stage, err := h.service.UpdateStage(c.Request.Context(), userID, appID, stageID, &req)
+	var jobs []*jobModel.SimilarJobDTO
+	jobs, err := h.service.FindSimilarJobs(c.Request.Context(), userID, appID, limit)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
-		if model.GetErrorCode(err) == model.CodeApplicationStageNotFound {
+		if model.GetErrorCode(err) == model.CodeApplicationNotFound {
 			statusCode = http.StatusNotFound
-		} else if model.GetErrorCode(err) == model.CodeInvalidStatus {
-			statusCode = http.StatusBadRequest
 		}
-		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
-	httpPlatform.RespondWithData(c, http.StatusOK, stage)
+	httpPlatform.RespondWithData(c, http.StatusOK, jobs)
 }
 
-// CompleteStage godoc
-// @Summary Complete an application stage
-// @Description Mark a specific stage as completed
+// GetChecklist godoc
+// @Summary Get the pre-interview checklist of an application
+// @Description Suggested preparation steps for the application's current stage, with the items already marked done
 // @Tags applications
 // @Security BearerAuth
-// @Accept json
 // @Produce json
 // @Param id path string true "Application ID"
-// @Param stageId path string true "Stage ID"
-// @Param request body model.CompleteStageRequest false "Completion details"
-// @Success 200 {object} model.ApplicationStageDTO
-// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Success 200 {array} model.ChecklistItem
 // @Failure 401 {object} httpPlatform.ErrorResponse
-// @Failure 404 {object} httpPlatform.ErrorResponse "Application or stage not found"
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
 // @Failure 500 {object} httpPlatform.ErrorResponse
-// @Router /applications/{id}/stages/{stageId}/complete [patch]
-func (h *ApplicationHandler) CompleteStage(c *gin.Context) {
+// @Router /applications/{id}/checklist [get]
+func (h *ApplicationHandler) GetChecklist(c *gin.Context) {
 	userID, ok := auth.MustGetUserID(c)
 	if !ok {
 		return
 	}
-	appID := c.Param("id")
-	stageID := c.Param("stageId")
-	var req model.CompleteStageRequest
-	// Body is optional; ignore EOF/empty body errors but reject malformed JSON
-	if err := c.ShouldBindJSON(&req); err != nil && c.Request.ContentLength > 0 {
-		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+
+	items, err := h.service.GenerateChecklist(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		h.respondChecklistError(c, err)
 		return
 	}
+	httpPlatform.RespondWithData(c, http.StatusOK, items)
+}
 
-	stage, err := h.service.CompleteStage(c.Request.Context(), userID, appID, stageID, &req)
+// ToggleChecklistItem godoc
+// @Summary Toggle a checklist item
+// @Description Mark an item of the application's current checklist as done, or undo it
+// @Tags applications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Application ID"
+// @Param item_id path string true "Checklist item ID"
+// @Success 200 {object} model.ChecklistItem
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application or checklist item not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Failure 503 {object} httpPlatform.ErrorResponse "Checklist storage unavailable"
+// @Router /applications/{id}/checklist/{item_id}/toggle [patch]
+func (h *ApplicationHandler) ToggleChecklistItem(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	item, err := h.service.ToggleChecklistItem(c.Request.Context(), userID, c.Param("id"), c.Param("item_id"))
 	if err != nil {
-		statusCode := http.StatusInternalServerError
-		errCode := model.GetErrorCode(err)
-		if errCode == model.CodeApplicationNotFound || errCode == model.CodeApplicationStageNotFound {
-			statusCode = http.StatusNotFound
-		}
-		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err))
+		h.respondChecklistError(c, err)
 		return
 	}
-	httpPlatform.RespondWithData(c, http.StatusOK, stage)
+	httpPlatform.RespondWithData(c, http.StatusOK, item)
 }
 
-// ListStages godoc
-// @Summary List application stages
-// @Description Get all stages for a specific application
+func (h *ApplicationHandler) respondChecklistError(c *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	switch model.GetErrorCode(err) {
+	case model.CodeApplicationNotFound, model.CodeChecklistItemNotFound:
+		statusCode = http.StatusNotFound
+	case model.CodeChecklistUnavailable:
+		statusCode = http.StatusServiceUnavailable
+	}
+	httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+}
+
+// ExportPDF godoc
+// @Summary Export an application as PDF
+// @Description Download a one-page printable summary of an application with its stages and comments
 // @Tags applications
 // @Security BearerAuth
-// @Produce json
+// @Produce application/pdf
 // @Param id path string true "Application ID"
-// @Success 200 {object} []model.ApplicationStageDTO
+// @Success 200 {file} binary "PDF file"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
 // @Failure 500 {object} httpPlatform.ErrorResponse
-// @Router /applications/{id}/stages [get]
-func (h *ApplicationHandler) ListStages(c *gin.Context) {
+// @Router /applications/{id}/export/pdf [get]
+func (h *ApplicationHandler) ExportPDF(c *gin.Context) {
 	userID, ok := auth.MustGetUserID(c)
 	if !ok {
 		return
 	}
 	appID := c.Param("id")
 
-	stages, err := h.service.ListStages(c.Request.Context(), userID, appID)
+	data, err := h.service.ExportPDF(c.Request.Context(), userID, appID)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		if model.GetErrorCode(err) == model.CodeApplicationNotFound {
 			statusCode = http.StatusNotFound
 		}
-		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
-	httpPlatform.RespondWithData(c, http.StatusOK, stages)
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=application-%s.pdf", appID))
+	c.Data(http.StatusOK, "application/pdf", data)
 }
 
 // DeleteStage godoc
@@ -396,7 +1695,7 @@ func (h *ApplicationHandler) DeleteStage(c *gin.Context) {
 		if errCode == model.CodeApplicationNotFound || errCode == model.CodeApplicationStageNotFound {
 			statusCode = http.StatusNotFound
 		}
-		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
 	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Stage deleted successfully"})
@@ -429,10 +1728,11 @@ func (h *ApplicationHandler) CreateStageTemplate(c *gin.Context) {
 	template, err := h.service.CreateStageTemplate(c.Request.Context(), userID, &req)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
-		if model.GetErrorCode(err) == model.CodeStageNameRequired {
+		switch model.GetErrorCode(err) {
+		case model.CodeStageNameRequired, model.CodeDescriptionTooLong:
 			statusCode = http.StatusBadRequest
 		}
-		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
 	httpPlatform.RespondWithData(c, http.StatusCreated, template)
@@ -472,6 +1772,70 @@ func (h *ApplicationHandler) ListStageTemplates(c *gin.Context) {
 	httpPlatform.RespondWithPagination(c, http.StatusOK, templates, pagination.Limit, pagination.Offset, total)
 }
 
+// GetStageTemplateUsageStats godoc
+// @Summary Get stage template usage statistics
+// @Description List all stage templates of the authenticated user with how often each is used, most used first. Unused templates are included with zero uses.
+// @Tags stage-templates
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} model.StageTemplateUsage
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /stage-templates/usage-stats [get]
+func (h *ApplicationHandler) GetStageTemplateUsageStats(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	stats, err := h.service.GetStageTemplateUsageStats(c.Request.Context(), userID)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to get stage template usage stats")
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, stats)
+}
+
+// ListDefaultStageTemplates godoc
+// @Summary List recommended stage templates
+// @Description Get the recommended default stage templates as suggestions; nothing is persisted
+// @Tags stage-templates
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} model.StageTemplateDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Router /stage-templates/default [get]
+func (h *ApplicationHandler) ListDefaultStageTemplates(c *gin.Context) {
+	if _, ok := auth.MustGetUserID(c); !ok {
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, h.service.ListDefaultStageTemplates())
+}
+
+// ApplyDefaultStageTemplates godoc
+// @Summary Apply recommended stage templates
+// @Description Create the recommended default stage templates if the user has none yet. Idempotent: does nothing when templates already exist.
+// @Tags stage-templates
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} model.ApplyDefaultStageTemplatesResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /stage-templates/apply-defaults [post]
+func (h *ApplicationHandler) ApplyDefaultStageTemplates(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.ApplyDefaultStageTemplates(c.Request.Context(), userID)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to apply default stage templates")
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, result)
+}
+
 // UpdateStageTemplate godoc
 // @Summary Update a stage template
 // @Description Update details of a specific stage template
@@ -505,10 +1869,10 @@ func (h *ApplicationHandler) UpdateStageTemplate(c *gin.Context) {
 		errCode := model.GetErrorCode(err)
 		if errCode == model.CodeStageTemplateNotFound {
 			statusCode = http.StatusNotFound
-		} else if errCode == model.CodeStageNameRequired {
+		} else if errCode == model.CodeStageNameRequired || errCode == model.CodeDescriptionTooLong {
 			statusCode = http.StatusBadRequest
 		}
-		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
 	httpPlatform.RespondWithData(c, http.StatusOK, template)
@@ -541,28 +1905,146 @@ func (h *ApplicationHandler) DeleteStageTemplate(c *gin.Context) {
 		} else if errCode == model.CodeStageTemplateInUse {
 			statusCode = http.StatusConflict
 		}
-		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
 	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Stage template deleted successfully"})
 }
 
-func (h *ApplicationHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+// MergeStageTemplates godoc
+// @Summary Merge a stage template into another
+// @Description Move all application stages from one stage template to another and delete the first one
+// @Tags stage-templates
+// @Security BearerAuth
+// @Produce json
+// @Param templateId path string true "Stage template ID to merge and delete"
+// @Param intoId path string true "Stage template ID to keep"
+// @Success 200 {object} model.MergeStageTemplatesResponse
+// @Failure 400 {object} httpPlatform.ErrorResponse "Template merged into itself"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Stage template not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /stage-templates/{templateId}/merge/{intoId} [patch]
+func (h *ApplicationHandler) MergeStageTemplates(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	templateID := c.Param("templateId")
+	intoID := c.Param("intoId")
+	if templateID == intoID {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeMergeIntoSelf), model.GetErrorMessage(model.ErrMergeIntoSelf, auth.GetLocale(c)))
+		return
+	}
+
+	result, err := h.service.MergeStageTemplates(c.Request.Context(), userID, templateID, intoID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		errCode := model.GetErrorCode(err)
+		if errCode == model.CodeStageTemplateNotFound {
+			statusCode = http.StatusNotFound
+		} else if errCode == model.CodeMergeIntoSelf {
+			statusCode = http.StatusBadRequest
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, result)
+}
+
+// ReorderStageTemplates godoc
+// @Summary Reorder stage templates
+// @Description Give the stage templates of the authenticated user the order of their position in the list, starting at 1. Every template must be listed exactly once.
+// @Tags stage-templates
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body model.ReorderStageTemplatesRequest true "All template IDs in their new order"
+// @Success 200 {array} model.StageTemplateDTO "Templates in their new order"
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid payload or templates missing from the list"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Stage template not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /stage-templates/reorder [put]
+func (h *ApplicationHandler) ReorderStageTemplates(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	var req model.ReorderStageTemplatesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	templates, err := h.service.ReorderStageTemplates(c.Request.Context(), userID, req.TemplateIDs)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch model.GetErrorCode(err) {
+		case model.CodeStageTemplateNotFound:
+			statusCode = http.StatusNotFound
+		case model.CodeIncompleteTemplateOrder:
+			statusCode = http.StatusBadRequest
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, templates)
+}
+
+func (h *ApplicationHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware, idempotency gin.HandlerFunc) {
 	apps := router.Group("/applications")
 	apps.Use(authMiddleware)
 	{
-		apps.POST("", h.Create)
+		apps.POST("", idempotency, h.Create)
 		apps.GET("", h.List)
+		apps.GET("/stats", h.Stats)
+		apps.GET("/expiring", h.Expiring)
+		apps.GET("/offers", h.Offers)
+		apps.GET("/export", h.Export)
+		apps.POST("/import", h.Import)
+		apps.GET("/trash", h.Trash)
+		apps.PATCH("/bulk-tag", h.BulkTag)
+		apps.PATCH("/bulk-status", h.BulkUpdateStatus)
+		apps.POST("/bulk-advance-stage", h.BulkAdvanceStage)
 		apps.GET("/:id", h.Get)
 		apps.PATCH("/:id", h.Update)
+		apps.PATCH("/:id/resume", h.UpdateResume)
+		apps.POST("/:id/clone", h.Clone)
+		apps.POST("/:id/archive", h.Archive)
+		apps.POST("/:id/unarchive", h.Unarchive)
 		apps.DELETE("/:id", h.Delete)
+		apps.POST("/:id/restore", h.Restore)
+		apps.DELETE("/:id/permanent", h.DeletePermanently)
+		apps.POST("/:id/cover-letter/upload", h.UploadCoverLetter)
+		apps.GET("/:id/cover-letter/download-url", h.GetCoverLetterDownloadURL)
+		apps.POST("/:id/share", h.Share)
+		apps.DELETE("/:id/share", h.RevokeShare)
 		
 		// Stages
 		apps.POST("/:id/stages", h.AddStage)
 		apps.GET("/:id/stages", h.ListStages)
+		apps.PUT("/:id/stages/reorder", h.ReorderStages)
 		apps.PATCH("/:id/stages/:stageId", h.UpdateStage)
 		apps.PATCH("/:id/stages/:stageId/complete", h.CompleteStage)
 		apps.DELETE("/:id/stages/:stageId", h.DeleteStage)
+
+		// Reminders
+		apps.GET("/:id/reminders/next", h.GetNextReminder)
+
+		// Suggestions
+		apps.GET("/:id/similar-jobs", h.GetSimilarJobs)
+		apps.GET("/:id/next-actions", h.GetNextActions)
+
+		// Checklist
+		apps.GET("/:id/checklist", h.GetChecklist)
+		apps.PATCH("/:id/checklist/:item_id/toggle", h.ToggleChecklistItem)
+
+		// Export
+		apps.GET("/:id/export/pdf", h.ExportPDF)
+
+		// Activity
+		apps.GET("/:id/events", h.ListEvents)
 	}
 
 	templates := router.Group("/stage-templates")
@@ -570,7 +2052,15 @@ func (h *ApplicationHandler) RegisterRoutes(router *gin.RouterGroup, authMiddlew
 	{
 		templates.POST("", h.CreateStageTemplate)
 		templates.GET("", h.ListStageTemplates)
+		templates.GET("/default", h.ListDefaultStageTemplates)
+		templates.GET("/usage-stats", h.GetStageTemplateUsageStats)
+		templates.POST("/apply-defaults", h.ApplyDefaultStageTemplates)
+		templates.PUT("/reorder", h.ReorderStageTemplates)
 		templates.PATCH("/:templateId", h.UpdateStageTemplate)
 		templates.DELETE("/:templateId", h.DeleteStageTemplate)
+		templates.PATCH("/:templateId/merge/:intoId", h.MergeStageTemplates)
 	}
+
+	// Public, read-only view of shared applications
+	router.GET("/share/:token", h.GetShared)
 }
@@ -3,9 +3,11 @@ package model
 import (
 	"time"
 
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	commentModel "github.com/andreypavlenko/jobber/modules/comments/model"
 	companyModel "github.com/andreypavlenko/jobber/modules/companies/model"
 	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
+	reminderModel "github.com/andreypavlenko/jobber/modules/reminders/model"
 	resumeModel "github.com/andreypavlenko/jobber/modules/resumes/model"
 )
 
@@ -20,25 +22,70 @@ const (
 	StatusArchived ApplicationStatus = "archived"
 )
 
+// Cover letter storage types
+const (
+	CoverLetterStorageExternal = "external"
+	CoverLetterStorageS3       = "s3"
+)
+
+// CoverLetterFileExtensions maps the content types accepted for cover letter
+// uploads, as detected from the file contents, to the extension of their storage key
+var CoverLetterFileExtensions = map[string]string{
+	"application/pdf":    ".pdf",
+	"application/msword": ".doc",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": ".docx",
+	"text/plain": ".txt",
+}
+
 // Application represents a job application (CORE AGGREGATE)
 type Application struct {
-	ID              string
-	UserID          string
-	JobID           string
-	ResumeID        *string
-	ResumeBuilderID *string
-	Name            string
-	CurrentStageID  *string
-	Status          string // active, on_hold, rejected, offer, archived
-	AppliedAt       time.Time
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	ID                     string
+	UserID                 string
+	JobID                  string
+	ResumeID               *string
+	ResumeBuilderID        *string
+	Name                   string
+	CurrentStageID         *string
+	Status                 string  // active, on_hold, rejected, offer, archived
+	CoverLetterURL         *string // external URL, or the S3 object key when stored in S3
+	CoverLetterStorageType *string // external, s3
+	Metadata               map[string]interface{}
+	AppliedAt              time.Time
+	ArchivedAt             *time.Time // set while status is archived
+	OfferedSalary          *int       // offer amount, in the currency of the job
+	NegotiatedSalary       *int       // counter-offer amount, in the currency of the job
+	DeadlineAt             *time.Time // date by which the user still has to act
+	EquityPercent          *float64   // offered equity, percent of the company
+	StartDate              *time.Time // offered start date (date only)
+	BenefitsNotes          *string
+	ReferralContactName    *string // who referred the user, if anyone
+	ReferralContactEmail   *string // email of the referral contact
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+}
+
+// StatusCounts holds the number of applications per status for a user
+type StatusCounts struct {
+	Total              int  `json:"total"`
+	Active             int  `json:"active"`
+	OnHold             int  `json:"on_hold"`
+	Offer              int  `json:"offer"`
+	Rejected           int  `json:"rejected"`
+	Archived           int  `json:"archived"`
+	HasAnyApplications bool `json:"has_any_applications"`
+}
+
+// StageCount holds how many stages an application has and how many are completed
+type StageCount struct {
+	Total     int
+	Completed int
 }
 
 // JobNestedDTO represents a job with company information for application list
 type JobNestedDTO struct {
 	ID      string                    `json:"id"`
 	Title   string                    `json:"title"`
+	Source  *string                   `json:"source,omitempty"`
 	Company *companyModel.CompanyDTO  `json:"company,omitempty"`
 }
 
@@ -58,12 +105,30 @@ type ApplicationDTO struct {
 	CreatedAt          time.Time                 `json:"created_at"`
 	UpdatedAt          time.Time                 `json:"updated_at"`
 	LastActivityAt     time.Time                 `json:"last_activity_at"`
+	ArchivedAt         *time.Time                `json:"archived_at,omitempty"`
+	OfferedSalary      *int                      `json:"offered_salary,omitempty"`
+	NegotiatedSalary   *int                      `json:"negotiated_salary,omitempty"`
+	DeadlineAt         *time.Time                `json:"deadline_at,omitempty"`
+	EquityPercent      *float64                  `json:"equity_percent,omitempty"`
+	StartDate          *string                   `json:"start_date,omitempty"` // YYYY-MM-DD
+	BenefitsNotes      *string                   `json:"benefits_notes,omitempty"`
+	ReferralContactName  *string                 `json:"referral_contact_name,omitempty"`
+	ReferralContactEmail *string                 `json:"referral_contact_email,omitempty"`
+	DeletedAt          *time.Time                `json:"deleted_at,omitempty"` // set while in the trash
 	CurrentStageID     *string                   `json:"current_stage_id,omitempty"`
 	CurrentStageName   *string                   `json:"current_stage_name,omitempty"`
+	CoverLetterURL         *string               `json:"cover_letter_url,omitempty"`
+	CoverLetterStorageType *string               `json:"cover_letter_storage_type,omitempty"`
+	Metadata               map[string]interface{} `json:"metadata"`
 	Job                *JobNestedDTO             `json:"job"`
 	Resume             *ResumeNestedDTO          `json:"resume"`
 	ApplicationComments []*commentModel.CommentDTO `json:"application_comments,omitempty"`
 	StageComments      []*commentModel.CommentDTO `json:"stage_comments,omitempty"`
+	NextReminder       *reminderModel.ReminderDTO `json:"next_reminder,omitempty"`
+	StageCount          int                        `json:"stage_count"`
+	CompletedStageCount int                        `json:"completed_stage_count"`
+	PinCount            int                        `json:"pin_count"` // number of pinned comments
+	TagIDs              []string                   `json:"tag_ids,omitempty"`
 }
 
 // NewApplicationDTO creates a new ApplicationDTO with nested entities
@@ -83,14 +148,26 @@ func NewApplicationDTO(
 		CreatedAt:      app.CreatedAt,
 		UpdatedAt:      app.UpdatedAt,
 		LastActivityAt: lastActivityAt,
+		ArchivedAt:     app.ArchivedAt,
+		OfferedSalary:    app.OfferedSalary,
+		NegotiatedSalary: app.NegotiatedSalary,
+		DeadlineAt:       app.DeadlineAt,
+		EquityPercent:    app.EquityPercent,
+		StartDate:        FormatDate(app.StartDate),
+		BenefitsNotes:    app.BenefitsNotes,
+		ReferralContactName:  app.ReferralContactName,
+		ReferralContactEmail: app.ReferralContactEmail,
 		CurrentStageID: app.CurrentStageID,
+		Metadata:       app.Metadata,
 	}
+	dto.SetCoverLetter(app.CoverLetterURL, app.CoverLetterStorageType)
 
 	// Add job with optional company
 	if job != nil {
 		dto.Job = &JobNestedDTO{
-			ID:    job.ID,
-			Title: job.Title,
+			ID:     job.ID,
+			Title:  job.Title,
+			Source: job.Source,
 		}
 		if company != nil {
 			dto.Job.Company = company.ToDTO()
@@ -114,3 +191,56 @@ func NewApplicationDTO(
 
 	return dto
 }
+
+// SetCoverLetter sets the cover letter fields. S3 object keys are internal,
+// so only external URLs are exposed; S3 cover letters are fetched via a presigned URL.
+func (d *ApplicationDTO) SetCoverLetter(url, storageType *string) {
+	d.CoverLetterStorageType = storageType
+	if storageType != nil && *storageType == CoverLetterStorageExternal {
+		d.CoverLetterURL = url
+	}
+}
+
+// Cursor returns the keyset cursor positioned after this application for the given sort field
+func (d *ApplicationDTO) Cursor(sortField string) keyset.Cursor {
+	cursor := keyset.Cursor{LastID: d.ID}
+	switch sortField {
+	case "status":
+		cursor.LastValue = d.Status
+	case "applied_at":
+		cursor.LastValue = keyset.TimeValue(d.AppliedAt)
+	default:
+		cursor.LastValue = keyset.TimeValue(d.LastActivityAt)
+	}
+	return cursor
+}
+
+// ToShared returns a copy of the DTO that is safe to show on a public share page.
+// Private notes, custom metadata, offer details, cover letters, comments, reminders and
+// the referral contact are removed.
+func (d *ApplicationDTO) ToShared() *ApplicationDTO {
+	shared := *d
+	shared.CoverLetterURL = nil
+	shared.CoverLetterStorageType = nil
+	shared.Metadata = nil
+	shared.OfferedSalary = nil
+	shared.NegotiatedSalary = nil
+	shared.EquityPercent = nil
+	shared.StartDate = nil
+	shared.BenefitsNotes = nil
+	shared.ReferralContactName = nil
+	shared.ReferralContactEmail = nil
+	shared.ApplicationComments = nil
+	shared.StageComments = nil
+	shared.NextReminder = nil
+	if d.Job != nil {
+		job := *d.Job
+		if d.Job.Company != nil {
+			company := *d.Job.Company
+			company.Notes = nil
+			job.Company = &company
+		}
+		shared.Job = &job
+	}
+	return &shared
+}
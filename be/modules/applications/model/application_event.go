@@ -0,0 +1,56 @@
+package model
+
+import "time"
+
+// ApplicationEventType names a change recorded in an application's audit trail
+type ApplicationEventType string
+
+const (
+	EventApplicationCreated       ApplicationEventType = "application.created"
+	EventApplicationStatusChanged ApplicationEventType = "application.status_changed"
+	EventStageAdded               ApplicationEventType = "stage.added"
+	EventStageCompleted           ApplicationEventType = "stage.completed"
+	EventStageDeleted             ApplicationEventType = "stage.deleted"
+	EventCommentAdded             ApplicationEventType = "comment.added"
+)
+
+// ApplicationEvent is an immutable entry in an application's audit trail.
+// Payload holds the fields the change touched; changed values are recorded
+// as {"from": old, "to": new}.
+type ApplicationEvent struct {
+	ID            string
+	ApplicationID string
+	UserID        string
+	EventType     ApplicationEventType
+	Payload       map[string]any
+	CreatedAt     time.Time
+}
+
+// ApplicationEventDTO represents application event data transfer object
+type ApplicationEventDTO struct {
+	ID            string               `json:"id"`
+	ApplicationID string               `json:"application_id"`
+	EventType     ApplicationEventType `json:"event_type"`
+	Payload       map[string]any       `json:"payload"`
+	CreatedAt     time.Time            `json:"created_at"`
+}
+
+// ToDTO converts ApplicationEvent to ApplicationEventDTO
+func (e *ApplicationEvent) ToDTO() *ApplicationEventDTO {
+	payload := e.Payload
+	if payload == nil {
+		payload = map[string]any{}
+	}
+	return &ApplicationEventDTO{
+		ID:            e.ID,
+		ApplicationID: e.ApplicationID,
+		EventType:     e.EventType,
+		Payload:       payload,
+		CreatedAt:     e.CreatedAt,
+	}
+}
+
+// Change records a field's old and new value in an event payload
+func Change(from, to any) map[string]any {
+	return map[string]any{"from": from, "to": to}
+}
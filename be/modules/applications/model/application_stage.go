@@ -12,6 +12,7 @@ type ApplicationStage struct {
 	Order           int
 	StartedAt       time.Time
 	CompletedAt     *time.Time
+	Notes           *string
 	CreatedAt       time.Time
 }
 
@@ -25,7 +26,9 @@ type ApplicationStageDTO struct {
 	Order           int        `json:"order"`
 	StartedAt       time.Time  `json:"started_at"`
 	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+	Notes           *string    `json:"notes,omitempty"`
 	CreatedAt       time.Time  `json:"created_at"`
+	CommentCount    int        `json:"comment_count"`
 }
 
 // ToDTO converts ApplicationStage to ApplicationStageDTO
@@ -39,6 +42,7 @@ func (a *ApplicationStage) ToDTO(stageName string) *ApplicationStageDTO {
 		Order:           a.Order,
 		StartedAt:       a.StartedAt,
 		CompletedAt:     a.CompletedAt,
+		Notes:           a.Notes,
 		CreatedAt:       a.CreatedAt,
 	}
 }
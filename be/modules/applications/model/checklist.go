@@ -0,0 +1,19 @@
+package model
+
+// Checklist item categories
+const (
+	ChecklistCategoryResearch    = "research"
+	ChecklistCategoryPreparation = "preparation"
+	ChecklistCategoryTechnical   = "technical"
+	ChecklistCategoryLogistics   = "logistics"
+	ChecklistCategoryNegotiation = "negotiation"
+	ChecklistCategoryFollowUp    = "follow_up"
+)
+
+// ChecklistItem is a suggested preparation step for an application's current stage
+type ChecklistItem struct {
+	ID       string `json:"id"`
+	Item     string `json:"item"`
+	IsDone   bool   `json:"is_done"`
+	Category string `json:"category"`
+}
@@ -1,15 +1,47 @@
 package model
 
-import "errors"
+import (
+	"fmt"
+
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
 
 var (
-	ErrApplicationNotFound      = errors.New("application not found")
-	ErrStageTemplateNotFound    = errors.New("stage template not found")
-	ErrStageTemplateInUse       = errors.New("stage template is still in use by applications")
-	ErrApplicationStageNotFound = errors.New("application stage not found")
-	ErrInvalidStatus            = errors.New("invalid status")
-	ErrStageNameRequired        = errors.New("stage name is required")
-	ErrBothResumeTypesSet       = errors.New("only one of resume_id or resume_builder_id can be set")
+	ErrApplicationNotFound      = &DomainError{Code: CodeApplicationNotFound, Message: "application not found"}
+	ErrStageTemplateNotFound    = &DomainError{Code: CodeStageTemplateNotFound, Message: "stage template not found"}
+	ErrStageTemplateInUse       = &DomainError{Code: CodeStageTemplateInUse, Message: "stage template is still in use by applications"}
+	ErrApplicationStageNotFound = &DomainError{Code: CodeApplicationStageNotFound, Message: "application stage not found"}
+	ErrInvalidStatus            = &DomainError{Code: CodeInvalidStatus, Message: "invalid status"}
+	ErrStageNameRequired        = &DomainError{Code: CodeStageNameRequired, Message: "stage name is required"}
+	ErrBothResumeTypesSet       = &DomainError{Code: CodeBothResumeTypesSet, Message: "only one of resume_id or resume_builder_id can be set"}
+	ErrTagNotFound              = &DomainError{Code: CodeTagNotFound, Message: "tag not found"}
+	ErrCoverLetterNotFound      = &DomainError{Code: CodeCoverLetterNotFound, Message: "cover letter not found"}
+	ErrStorageNotConfigured     = &DomainError{Code: CodeStorageNotConfigured, Message: "file storage is not configured"}
+	ErrMetadataTooLarge         = &DomainError{Code: CodeMetadataTooLarge, Message: "metadata exceeds maximum size"}
+	ErrShareTokenNotFound       = &DomainError{Code: CodeShareTokenNotFound, Message: "shared application not found"}
+	ErrInvalidShareToken        = &DomainError{Code: CodeInvalidShareToken, Message: "invalid share token"}
+	ErrInvalidSort              = &DomainError{Code: CodeInvalidSort, Message: "invalid sort parameter"}
+	ErrResumeNotFound           = &DomainError{Code: CodeResumeNotFound, Message: "resume not found"}
+	ErrDescriptionTooLong       = &DomainError{Code: CodeDescriptionTooLong, Message: "description exceeds maximum length"}
+	ErrChecklistItemNotFound    = &DomainError{Code: CodeChecklistItemNotFound, Message: "checklist item not found"}
+	ErrChecklistUnavailable     = &DomainError{Code: CodeChecklistUnavailable, Message: "checklist storage is unavailable"}
+	ErrAmbiguousStageInput      = &DomainError{Code: CodeAmbiguousStageInput, Message: "only one of stage_template_id or name can be set"}
+	ErrStageInputRequired       = &DomainError{Code: CodeStageInputRequired, Message: "stage_template_id or name is required"}
+	ErrMergeIntoSelf            = &DomainError{Code: CodeMergeIntoSelf, Message: "cannot merge a stage template into itself"}
+	ErrTooManyApplications      = &DomainError{Code: CodeTooManyApplications, Message: "too many applications in one request"}
+	ErrInvalidStatusTransition  = &DomainError{Code: CodeInvalidStatusTransition, Message: "application cannot move to that status from its current status"}
+	ErrDuplicateOrder           = &DomainError{Code: CodeDuplicateOrder, Message: "stages cannot share the same order"}
+	ErrInvalidSalary            = &DomainError{Code: CodeInvalidSalary, Message: "salary must not be negative"}
+	ErrInvalidImportFile        = &DomainError{Code: CodeInvalidImportFile, Message: "import file is not a CSV with the required columns"}
+	ErrTooManyImportRows        = &DomainError{Code: CodeTooManyImportRows, Message: "import file has too many rows"}
+	ErrJobNotFound              = &DomainError{Code: CodeJobNotFound, Message: "job not found"}
+	ErrIncompleteTemplateOrder  = &DomainError{Code: CodeIncompleteTemplateOrder, Message: "every stage template must be listed exactly once"}
+	ErrInvalidWithinHours       = &DomainError{Code: CodeInvalidWithinHours, Message: "within_hours must be between 1 and 720"}
+	ErrInvalidEquityPercent     = &DomainError{Code: CodeInvalidEquityPercent, Message: "equity_percent must be between 0 and 100"}
+	ErrInvalidStartDate         = &DomainError{Code: CodeInvalidStartDate, Message: "start_date must be a date in YYYY-MM-DD format"}
+	ErrInvalidReferralEmail     = &DomainError{Code: CodeInvalidReferralEmail, Message: "referral_contact_email is not a valid email address"}
+	ErrUnsupportedCoverLetter   = &DomainError{Code: CodeUnsupportedCoverLetter, Message: "cover letter must be a PDF, DOC, DOCX or TXT file"}
 )
 
 type ErrorCode string
@@ -22,47 +54,60 @@ const (
 	CodeInvalidStatus            ErrorCode = "INVALID_STATUS"
 	CodeStageNameRequired        ErrorCode = "STAGE_NAME_REQUIRED"
 	CodeBothResumeTypesSet       ErrorCode = "BOTH_RESUME_TYPES_SET"
+	CodeTagNotFound              ErrorCode = "TAG_NOT_FOUND"
+	CodeCoverLetterNotFound      ErrorCode = "COVER_LETTER_NOT_FOUND"
+	CodeStorageNotConfigured     ErrorCode = "STORAGE_NOT_CONFIGURED"
+	CodeMetadataTooLarge         ErrorCode = "METADATA_TOO_LARGE"
+	CodeShareTokenNotFound       ErrorCode = "SHARE_TOKEN_NOT_FOUND"
+	CodeInvalidShareToken        ErrorCode = "INVALID_SHARE_TOKEN"
+	CodeInvalidSort              ErrorCode = "INVALID_SORT"
+	CodeResumeNotFound           ErrorCode = "RESUME_NOT_FOUND"
+	CodeDescriptionTooLong       ErrorCode = "DESCRIPTION_TOO_LONG"
+	CodeChecklistItemNotFound    ErrorCode = "CHECKLIST_ITEM_NOT_FOUND"
+	CodeChecklistUnavailable     ErrorCode = "CHECKLIST_UNAVAILABLE"
+	CodeAmbiguousStageInput      ErrorCode = "AMBIGUOUS_STAGE_INPUT"
+	CodeStageInputRequired       ErrorCode = "STAGE_INPUT_REQUIRED"
+	CodeMergeIntoSelf            ErrorCode = "MERGE_INTO_SELF"
+	CodeTooManyApplications      ErrorCode = "TOO_MANY_APPLICATIONS"
+	CodeInvalidStatusTransition  ErrorCode = "INVALID_STATUS_TRANSITION"
+	CodeDuplicateOrder           ErrorCode = "DUPLICATE_ORDER"
+	CodeInvalidSalary            ErrorCode = "INVALID_SALARY"
+	CodeInvalidImportFile        ErrorCode = "INVALID_IMPORT_FILE"
+	CodeTooManyImportRows        ErrorCode = "TOO_MANY_IMPORT_ROWS"
+	CodeJobNotFound              ErrorCode = "JOB_NOT_FOUND"
+	CodeIncompleteTemplateOrder  ErrorCode = "INCOMPLETE_TEMPLATE_ORDER"
+	CodeInvalidWithinHours       ErrorCode = "INVALID_WITHIN_HOURS"
+	CodeInvalidEquityPercent     ErrorCode = "INVALID_EQUITY_PERCENT"
+	CodeInvalidStartDate         ErrorCode = "INVALID_START_DATE"
+	CodeInvalidReferralEmail     ErrorCode = "INVALID_REFERRAL_EMAIL"
+	CodeUnsupportedCoverLetter   ErrorCode = "UNSUPPORTED_COVER_LETTER_TYPE"
 	CodeInternalError            ErrorCode = "INTERNAL_ERROR"
 )
 
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
+// UnownedApplicationsError rejects a bulk request naming applications the user
+// does not own; it matches ErrApplicationNotFound
+type UnownedApplicationsError struct {
+	ApplicationIDs []string
+}
+
+// Error implements the error interface
+func (e *UnownedApplicationsError) Error() string {
+	return fmt.Sprintf("%d applications not found", len(e.ApplicationIDs))
+}
+
+// Unwrap makes the error report as ErrApplicationNotFound
+func (e *UnownedApplicationsError) Unwrap() error {
+	return ErrApplicationNotFound
+}
+
 func GetErrorCode(err error) ErrorCode {
-	switch {
-	case errors.Is(err, ErrApplicationNotFound):
-		return CodeApplicationNotFound
-	case errors.Is(err, ErrStageTemplateNotFound):
-		return CodeStageTemplateNotFound
-	case errors.Is(err, ErrStageTemplateInUse):
-		return CodeStageTemplateInUse
-	case errors.Is(err, ErrApplicationStageNotFound):
-		return CodeApplicationStageNotFound
-	case errors.Is(err, ErrInvalidStatus):
-		return CodeInvalidStatus
-	case errors.Is(err, ErrStageNameRequired):
-		return CodeStageNameRequired
-	case errors.Is(err, ErrBothResumeTypesSet):
-		return CodeBothResumeTypesSet
-	default:
-		return CodeInternalError
-	}
+	return domainerr.CodeOf(err, CodeInternalError)
 }
 
-func GetErrorMessage(err error) string {
-	switch {
-	case errors.Is(err, ErrApplicationNotFound):
-		return "Application not found"
-	case errors.Is(err, ErrStageTemplateNotFound):
-		return "Stage template not found"
-	case errors.Is(err, ErrStageTemplateInUse):
-		return "Stage template is still in use by applications and cannot be deleted"
-	case errors.Is(err, ErrApplicationStageNotFound):
-		return "Application stage not found"
-	case errors.Is(err, ErrInvalidStatus):
-		return "Invalid status"
-	case errors.Is(err, ErrStageNameRequired):
-		return "Stage name is required"
-	case errors.Is(err, ErrBothResumeTypesSet):
-		return "Only one of resume_id or resume_builder_id can be set"
-	default:
-		return "Internal server error"
-	}
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
 }
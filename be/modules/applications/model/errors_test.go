@@ -0,0 +1,46 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetErrorCode(t *testing.T) {
+	t.Run("maps domain error", func(t *testing.T) {
+		assert.Equal(t, CodeApplicationNotFound, GetErrorCode(ErrApplicationNotFound))
+	})
+
+	t.Run("maps wrapped domain error", func(t *testing.T) {
+		err := fmt.Errorf("GetByID: %w", ErrApplicationNotFound)
+		assert.Equal(t, CodeApplicationNotFound, GetErrorCode(err))
+	})
+
+	t.Run("maps unknown error to internal error", func(t *testing.T) {
+		assert.Equal(t, CodeInternalError, GetErrorCode(errors.New("connection refused")))
+		assert.Equal(t, CodeInternalError, GetErrorCode(nil))
+	})
+}
+
+func TestDomainError_Is(t *testing.T) {
+	t.Run("matches by code", func(t *testing.T) {
+		err := &DomainError{Code: CodeApplicationNotFound, Message: "application app-1 not found"}
+		assert.True(t, errors.Is(err, ErrApplicationNotFound))
+		assert.True(t, errors.Is(fmt.Errorf("wrapped: %w", err), ErrApplicationNotFound))
+	})
+
+	t.Run("does not match other codes", func(t *testing.T) {
+		assert.False(t, errors.Is(ErrApplicationNotFound, ErrTagNotFound))
+		assert.False(t, errors.Is(ErrApplicationNotFound, errors.New("application not found")))
+	})
+
+	t.Run("extracts with errors.As", func(t *testing.T) {
+		var domainErr *DomainError
+		err := fmt.Errorf("Update: %w", ErrInvalidStatus)
+		assert.True(t, errors.As(err, &domainErr))
+		assert.Equal(t, CodeInvalidStatus, domainErr.Code)
+		assert.Equal(t, "invalid status", domainErr.Error())
+	})
+}
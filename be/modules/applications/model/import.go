@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// MaxImportRows caps how many data rows a single CSV import may contain
+const MaxImportRows = 1000
+
+// ImportRowError explains why a CSV row was skipped. Row is the line number in the file.
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+// ImportPreviewRow describes an application a dry run would create
+type ImportPreviewRow struct {
+	Row         int       `json:"row"`
+	CompanyName string    `json:"company_name"`
+	NewCompany  bool      `json:"new_company"` // no company with this name exists yet, so one would be created
+	JobTitle    string    `json:"job_title"`
+	Source      string    `json:"source"`
+	Status      string    `json:"status"`
+	AppliedAt   time.Time `json:"applied_at"`
+	URL         *string   `json:"url,omitempty"`
+}
+
+// ImportResult summarizes a CSV import. In a dry run nothing is written:
+// Created counts the rows that would be created and Preview lists them.
+type ImportResult struct {
+	DryRun  bool                `json:"dry_run"`
+	Created int                 `json:"created"`
+	Skipped int                 `json:"skipped"`
+	Errors  []ImportRowError    `json:"errors"`
+	Preview []*ImportPreviewRow `json:"preview,omitempty"`
+}
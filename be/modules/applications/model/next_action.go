@@ -0,0 +1,23 @@
+package model
+
+// Next action priorities, most urgent first
+const (
+	NextActionPriorityHigh   = "high"
+	NextActionPriorityMedium = "medium"
+	NextActionPriorityLow    = "low"
+)
+
+// Next action types
+const (
+	NextActionFollowUp     = "follow_up"
+	NextActionSetReminder  = "set_reminder"
+	NextActionScheduleCall = "schedule_call"
+)
+
+// NextAction is a recommended next step for an application, derived from its state
+type NextAction struct {
+	Type     string `json:"type"`
+	Action   string `json:"action"`
+	Priority string `json:"priority"`
+	Reason   string `json:"reason"`
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// DateLayout is the format of date-only application fields such as the offer start date
+const DateLayout = "2006-01-02"
+
+// MaxEquityPercent is the largest equity share an offer can record
+const MaxEquityPercent = 100
+
+// OfferDTO summarizes an application in the offer status for side-by-side comparison
+type OfferDTO struct {
+	ApplicationID    string     `json:"application_id"`
+	ApplicationName  string     `json:"application_name"`
+	JobID            string     `json:"job_id"`
+	JobTitle         string     `json:"job_title"`
+	CompanyID        *string    `json:"company_id,omitempty"`
+	CompanyName      *string    `json:"company_name,omitempty"`
+	SalaryCurrency   string     `json:"salary_currency"` // currency of the job, applies to both salaries
+	OfferedSalary    *int       `json:"offered_salary,omitempty"`
+	NegotiatedSalary *int       `json:"negotiated_salary,omitempty"`
+	EquityPercent    *float64   `json:"equity_percent,omitempty"`
+	StartDate        *string    `json:"start_date,omitempty"` // YYYY-MM-DD
+	BenefitsNotes    *string    `json:"benefits_notes,omitempty"`
+	DeadlineAt       *time.Time `json:"deadline_at,omitempty"`
+}
+
+// FormatDate formats a date-only value as YYYY-MM-DD, or returns nil when unset
+func FormatDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format(DateLayout)
+	return &formatted
+}
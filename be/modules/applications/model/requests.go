@@ -4,42 +4,168 @@ import "time"
 
 // CreateApplicationRequest represents a create application request
 type CreateApplicationRequest struct {
-	JobID           string    `json:"job_id" binding:"required"`
-	ResumeID        *string   `json:"resume_id"`
-	ResumeBuilderID *string   `json:"resume_builder_id"`
-	Name            string    `json:"name" binding:"max=255"` // Optional: auto-generated from job title if empty
-	AppliedAt       time.Time `json:"applied_at"`
+	JobID           string                 `json:"job_id" binding:"required"`
+	ResumeID        *string                `json:"resume_id"`
+	ResumeBuilderID *string                `json:"resume_builder_id"`
+	Name            string                 `json:"name" binding:"max=255"` // Optional: auto-generated from job title if empty
+	CoverLetterURL  *string                `json:"cover_letter_url,omitempty" binding:"omitempty,max=2048"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"` // Free-form custom fields, max 10KB serialized
+	AppliedAt       time.Time              `json:"applied_at"`
+	DeadlineAt      *time.Time             `json:"deadline_at,omitempty"`
+	// Optional: who referred the user; the email must be a valid address when set
+	ReferralContactName  *string `json:"referral_contact_name,omitempty" binding:"omitempty,max=255"`
+	ReferralContactEmail *string `json:"referral_contact_email,omitempty" binding:"omitempty,max=255"`
+}
+
+// CloneApplicationRequest represents copying an application, optionally for another job
+type CloneApplicationRequest struct {
+	JobID string `json:"job_id" binding:"omitempty,uuid"` // Optional: defaults to the original application's job
+	Name  string `json:"name" binding:"max=255"`          // Optional: the job title when job_id is given, else the original name
 }
 
 // UpdateApplicationRequest represents an update application request
 type UpdateApplicationRequest struct {
-	Status *string `json:"status,omitempty"`
+	Status               *string                `json:"status,omitempty"`
+	CoverLetterURL       *string                `json:"cover_letter_url,omitempty" binding:"omitempty,max=2048"` // Empty string removes the cover letter
+	Metadata             map[string]interface{} `json:"metadata,omitempty"`                                      // Replaces all custom fields when provided
+	OfferedSalary        *int                   `json:"offered_salary,omitempty"`                                // Offer amount, in the currency of the job
+	NegotiatedSalary     *int                   `json:"negotiated_salary,omitempty"`                             // Counter-offer amount, in the currency of the job
+	DeadlineAt           *time.Time             `json:"deadline_at,omitempty"`
+	ClearDeadline        bool                   `json:"clear_deadline,omitempty"`                                     // Removes the deadline; deadline_at is then ignored
+	EquityPercent        *float64               `json:"equity_percent,omitempty"`                                     // Offered equity, 0-100 percent
+	StartDate            *string                `json:"start_date,omitempty" binding:"omitempty,datetime=2006-01-02"` // Offered start date; empty string removes it
+	BenefitsNotes        *string                `json:"benefits_notes,omitempty" binding:"omitempty,max=5000"`        // Empty string removes the notes
+	ReferralContactName  *string                `json:"referral_contact_name,omitempty" binding:"omitempty,max=255"`  // Empty string removes the name
+	ReferralContactEmail *string                `json:"referral_contact_email,omitempty" binding:"omitempty,max=255"` // Empty string removes the email
+}
+
+// UpdateResumeRequest switches the uploaded resume attached to an application
+type UpdateResumeRequest struct {
+	ResumeID string `json:"resume_id" binding:"required,uuid"`
+}
+
+// CoverLetterDownloadURLResponse represents response with presigned cover letter download URL
+type CoverLetterDownloadURLResponse struct {
+	DownloadURL string `json:"download_url"`
+	ExpiresIn   int    `json:"expires_in"`
 }
 
 // CreateStageTemplateRequest represents a create stage template request
 type CreateStageTemplateRequest struct {
-	Name  string `json:"name" binding:"required,min=1,max=255"`
-	Order int    `json:"order" binding:"min=0"`
+	Name        string  `json:"name" binding:"required,min=1,max=255"`
+	Description *string `json:"description,omitempty"`
+	Order       int     `json:"order" binding:"min=0"`
 }
 
 // UpdateStageTemplateRequest represents an update stage template request
 type UpdateStageTemplateRequest struct {
-	Name  *string `json:"name,omitempty"`
-	Order *int    `json:"order,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Order       *int    `json:"order,omitempty"`
 }
 
-// AddStageRequest represents adding a stage to an application
+// AddStageRequest represents adding a stage to an application.
+// Exactly one of StageTemplateID or Name must be set; Name creates a new
+// stage template on the fly for one-off stages.
 type AddStageRequest struct {
-	StageTemplateID string  `json:"stage_template_id" binding:"required"`
+	StageTemplateID string  `json:"stage_template_id,omitempty"`
+	Name            *string `json:"name,omitempty"`
 	Comment         *string `json:"comment,omitempty"` // Optional comment when adding a stage
 }
 
 // CompleteStageRequest represents completing a stage
 type CompleteStageRequest struct {
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	Notes       *string    `json:"notes,omitempty"` // Replaces the stage notes; empty clears them
 }
 
 type UpdateStageRequest struct {
 	Status      *string    `json:"status,omitempty" binding:"omitempty,oneof=pending active completed skipped cancelled"`
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	Notes       *string    `json:"notes,omitempty"` // Replaces the stage notes; empty clears them
+}
+
+// Bulk tag actions
+const (
+	BulkTagActionAdd    = "add"
+	BulkTagActionRemove = "remove"
+)
+
+// BulkTagRequest represents adding or removing tags on many applications at once
+type BulkTagRequest struct {
+	ApplicationIDs []string `json:"application_ids" binding:"required,min=1,max=100,dive,uuid"`
+	TagIDs         []string `json:"tag_ids" binding:"required,min=1,max=10,dive,uuid"`
+	Action         string   `json:"action" binding:"required,oneof=add remove"`
+}
+
+// BulkTagResponse reports how many tag relations were created or removed
+type BulkTagResponse struct {
+	AffectedRelations int64 `json:"affected_relations"`
+}
+
+// Window of the expiring applications list, in hours
+const (
+	DefaultExpiringWithinHours = 48
+	MaxExpiringWithinHours     = 720
+)
+
+// MaxBulkStatusApplications caps how many applications one bulk status update may touch
+const MaxBulkStatusApplications = 100
+
+// BulkStatusRequest represents setting the same status on many applications at once
+type BulkStatusRequest struct {
+	// Batches over MaxBulkStatusApplications are rejected by the service with ErrTooManyApplications
+	ApplicationIDs []string `json:"application_ids" binding:"required,min=1,dive,uuid"`
+	Status         string   `json:"status" binding:"required"`
+}
+
+// BulkStatusResponse reports how many applications were updated
+type BulkStatusResponse struct {
+	Updated int64 `json:"updated"`
+}
+
+// BulkStatusErrorResponse is returned when some applications of a bulk status
+// update do not exist or belong to another user
+type BulkStatusErrorResponse struct {
+	ErrorCode      string   `json:"error_code"`
+	ErrorMessage   string   `json:"error_message"`
+	ApplicationIDs []string `json:"application_ids"`
+}
+
+// MaxBulkAdvanceApplications caps how many applications one bulk advance may touch
+const MaxBulkAdvanceApplications = 20
+
+// BulkAdvanceStageRequest represents moving many applications to the same next stage
+type BulkAdvanceStageRequest struct {
+	ApplicationIDs      []string `json:"application_ids" binding:"required,min=1,max=20,dive,uuid"`
+	NextStageTemplateID string   `json:"next_stage_template_id" binding:"required,uuid"`
+}
+
+// BulkAdvanceStageResponse lists the advanced applications and, for the rest,
+// the error code explaining why they were not advanced
+type BulkAdvanceStageResponse struct {
+	Advanced []string          `json:"advanced"`
+	Failed   map[string]string `json:"failed"`
+}
+
+// ReorderItem assigns a new order to one stage of an application
+type ReorderItem struct {
+	ID    string `json:"id" binding:"required,uuid"`
+	Order int    `json:"order" binding:"min=0"`
+}
+
+// ReorderStagesRequest represents reordering an application's stages
+type ReorderStagesRequest struct {
+	Stages []ReorderItem `json:"stages" binding:"required,min=1,dive"`
+}
+
+// ReorderStageTemplatesRequest lists all stage templates of the user in their new order
+type ReorderStageTemplatesRequest struct {
+	TemplateIDs []string `json:"template_ids" binding:"required,min=1,unique,dive,uuid"`
+}
+
+// ShareApplicationResponse represents the public link for a shared application
+type ShareApplicationResponse struct {
+	ShareToken string `json:"share_token"`
+	ShareURL   string `json:"share_url"`
 }
@@ -4,28 +4,86 @@ import "time"
 
 // StageTemplate represents a reusable stage definition
 type StageTemplate struct {
-	ID        string
-	UserID    string
-	Name      string
-	Order     int
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID          string
+	UserID      string
+	Name        string
+	Description *string
+	Order       int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
+// MaxStageTemplateDescriptionLength caps the length of a stage template description, in characters
+const MaxStageTemplateDescriptionLength = 500
+
 // StageTemplateDTO represents stage template data transfer object
 type StageTemplateDTO struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Order     int       `json:"order"`
-	CreatedAt time.Time `json:"created_at"`
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Description  *string   `json:"description,omitempty"`
+	Order        int       `json:"order"`
+	CreatedAt    time.Time `json:"created_at"`
+	IsSuggestion bool      `json:"is_suggestion,omitempty"`
+}
+
+// StageTemplateUsage reports how often a stage template has been used across applications
+type StageTemplateUsage struct {
+	TemplateID   string     `json:"template_id"`
+	TemplateName string     `json:"template_name"`
+	TotalUses    int        `json:"total_uses"`
+	ActiveUses   int        `json:"active_uses"` // Stages currently in the active status
+	LastUsedAt   *time.Time `json:"last_used_at"`
+}
+
+// DefaultStageTemplate is a recommended stage with guidance on what it covers
+type DefaultStageTemplate struct {
+	Name        string
+	Description string
+}
+
+// DefaultStageTemplateSet lists the recommended stages for a typical hiring pipeline, in order
+var DefaultStageTemplateSet = []DefaultStageTemplate{
+	{Name: "Applied", Description: "Application submitted and waiting for a response from the company"},
+	{Name: "Phone Screen", Description: "A 15-30 minute call with a recruiter about your background and expectations"},
+	{Name: "Technical Interview", Description: "A 45-60 minute coding or system design interview"},
+	{Name: "Take-Home Assignment", Description: "A practical task completed on your own time, usually within a few days"},
+	{Name: "Final Interview", Description: "Final round with the hiring manager or team, often covering culture fit"},
+	{Name: "Offer", Description: "Offer received; review compensation and terms before accepting"},
+}
+
+// DefaultStageTemplates returns the recommended templates as unsaved suggestions
+func DefaultStageTemplates() []*StageTemplateDTO {
+	dtos := make([]*StageTemplateDTO, len(DefaultStageTemplateSet))
+	for i, def := range DefaultStageTemplateSet {
+		description := def.Description
+		dtos[i] = &StageTemplateDTO{
+			Name:         def.Name,
+			Description:  &description,
+			Order:        i + 1,
+			IsSuggestion: true,
+		}
+	}
+	return dtos
+}
+
+// ApplyDefaultStageTemplatesResponse is returned by the apply-defaults endpoint
+type ApplyDefaultStageTemplatesResponse struct {
+	Created   int                 `json:"created"`
+	Templates []*StageTemplateDTO `json:"templates"`
+}
+
+// MergeStageTemplatesResponse reports how many stages were moved to the surviving template
+type MergeStageTemplatesResponse struct {
+	MergedStages int `json:"merged_stages"`
 }
 
 // ToDTO converts StageTemplate to StageTemplateDTO
 func (s *StageTemplate) ToDTO() *StageTemplateDTO {
 	return &StageTemplateDTO{
-		ID:        s.ID,
-		Name:      s.Name,
-		Order:     s.Order,
-		CreatedAt: s.CreatedAt,
+		ID:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		Order:       s.Order,
+		CreatedAt:   s.CreatedAt,
 	}
 }
@@ -4,16 +4,55 @@ import (
 	"context"
 	"time"
 
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	"github.com/andreypavlenko/jobber/modules/applications/model"
+	commentPorts "github.com/andreypavlenko/jobber/modules/comments/ports"
 )
 
+// SortField represents a single sort key for listing applications
+type SortField struct {
+	Field string // "last_activity", "status", "applied_at"
+	Dir   string // "asc", "desc"
+}
+
+// SortableFields is the allowlist of field names accepted in SortField.Field
+var SortableFields = map[string]bool{
+	"last_activity": true,
+	"status":        true,
+	"applied_at":    true,
+}
+
 // ListOptions represents options for listing applications
 type ListOptions struct {
-	Limit   int
-	Offset  int
-	SortBy  string // "last_activity", "status", "company", "applied_at"
-	SortDir string // "asc", "desc"
-	Status  string // optional filter: "active", "on_hold", "rejected", "offer", "archived"
+	Limit      int
+	Offset     int
+	SortFields []SortField // applied in order; empty means the repository default
+	Statuses   []string    // optional filter; matches any of "active", "on_hold", "rejected", "offer", "archived"
+	// Optional metadata filter: matches applications whose metadata->>MetadataKey equals MetadataValue
+	MetadataKey   string
+	MetadataValue string
+	// Optional tag filter: matches applications tagged with the user's tag of this name
+	TagName *string
+	// Optional resume filter: matches applications that used this uploaded resume
+	ResumeID *string
+	// Optional job filters: match applications whose job is at this company or came from this source
+	CompanyID *string
+	Source    *string
+	// Optional applied_at range: AppliedAfter is inclusive, AppliedBefore exclusive
+	AppliedAfter  *time.Time
+	AppliedBefore *time.Time
+	// Optional deadline_at range, both ends inclusive; applications without a deadline never match
+	DeadlineAfter  *time.Time
+	DeadlineBefore *time.Time
+	// Optional tag filter: matches applications tagged with any of these tag IDs
+	TagIDs []string
+	// Soft-deleted applications are hidden unless IncludeDeleted is set;
+	// OnlyDeleted lists just the trash
+	IncludeDeleted bool
+	OnlyDeleted    bool
+	// Optional keyset pagination: continues after the cursor instead of skipping Offset rows.
+	// Only a single sort field is supported, with the application ID breaking ties.
+	Cursor *keyset.Cursor
 }
 
 type ApplicationRepository interface {
@@ -22,8 +61,33 @@ type ApplicationRepository interface {
 	List(ctx context.Context, userID string, opts *ListOptions) ([]*model.Application, int, error)
 	ListEnriched(ctx context.Context, userID string, opts *ListOptions) ([]*model.ApplicationDTO, int, error)
 	Update(ctx context.Context, app *model.Application) error
+	// UpdateResume attaches an uploaded resume, clearing any resume builder reference
+	UpdateResume(ctx context.Context, userID, appID, resumeID string) error
+	// SetArchiveStatus sets the status along with archived_at (nil clears it)
+	SetArchiveStatus(ctx context.Context, userID, appID, status string, archivedAt *time.Time) error
+	// Delete moves the application to the trash
 	Delete(ctx context.Context, userID, appID string) error
+	// Restore takes the application out of the trash
+	Restore(ctx context.Context, userID, appID string) error
+	// DeletePermanently removes the application, trashed or not, along with its dependents,
+	// and returns the storage key of its uploaded cover letter, if any
+	DeletePermanently(ctx context.Context, userID, appID string) (*string, error)
 	GetLastActivityAt(ctx context.Context, appID string) (time.Time, error)
+	ListOwnedIDs(ctx context.Context, userID string, appIDs []string) ([]string, error)
+	// GetStatuses returns the status of each of appIDs the user owns, keyed by ID
+	GetStatuses(ctx context.Context, userID string, appIDs []string) (map[string]string, error)
+	// BulkUpdateStatus sets the status of the user's applications among appIDs and returns how many were updated
+	BulkUpdateStatus(ctx context.Context, userID string, appIDs []string, status string) (int64, error)
+	EnableSharing(ctx context.Context, userID, appID, token string) (string, error)
+	DisableSharing(ctx context.Context, userID, appID string) error
+	GetByShareToken(ctx context.Context, token string) (*model.Application, error)
+	// ListOffers returns the user's applications in the offer status with their job and company
+	ListOffers(ctx context.Context, userID string) ([]*model.OfferDTO, error)
+	GetStatusCounts(ctx context.Context, userID string) (*model.StatusCounts, error)
+	// GetTotalStatusCounts counts the applications of all users per status
+	GetTotalStatusCounts(ctx context.Context) (*model.StatusCounts, error)
+	// GetStageCounts returns stage totals keyed by application ID; applications without stages are absent
+	GetStageCounts(ctx context.Context, appIDs []string) (map[string]model.StageCount, error)
 }
 
 type StageTemplateRepository interface {
@@ -32,6 +96,12 @@ type StageTemplateRepository interface {
 	List(ctx context.Context, userID string, limit, offset int) ([]*model.StageTemplate, int, error)
 	Update(ctx context.Context, template *model.StageTemplate) error
 	Delete(ctx context.Context, userID, templateID string) error
+	// GetUsageStats returns every template of the user with its usage counts, most used first
+	GetUsageStats(ctx context.Context, userID string) ([]*model.StageTemplateUsage, error)
+	// MergeInto moves all stages of fromID to intoID and deletes fromID, returning the number of stages moved
+	MergeInto(ctx context.Context, fromID, intoID, userID string) (int, error)
+	// Reorder sets the orders of all the user's templates from their position in templateIDs
+	Reorder(ctx context.Context, userID string, templateIDs []string) ([]*model.StageTemplate, error)
 }
 
 type ApplicationStageRepository interface {
@@ -41,3 +111,19 @@ type ApplicationStageRepository interface {
 	Update(ctx context.Context, stage *model.ApplicationStage) error
 	Delete(ctx context.Context, stageID string) error
 }
+
+// ApplicationEventRepository stores the append-only audit trail of application changes
+type ApplicationEventRepository interface {
+	Create(ctx context.Context, event *model.ApplicationEvent) error
+	// ListByApplication returns the application's events newest first
+	ListByApplication(ctx context.Context, appID string, limit, offset int) ([]*model.ApplicationEvent, int, error)
+}
+
+// TxRepositories are repositories bound to a single transaction, so a change
+// and the events recording it are committed or rolled back together
+type TxRepositories struct {
+	Applications ApplicationRepository
+	Stages       ApplicationStageRepository
+	Comments     commentPorts.CommentRepository
+	Events       ApplicationEventRepository
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ApplicationEventRepository struct {
+	pool DBPool
+}
+
+func NewApplicationEventRepository(pool *pgxpool.Pool) *ApplicationEventRepository {
+	return &ApplicationEventRepository{pool: pool}
+}
+
+// NewApplicationEventRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewApplicationEventRepositoryWithPool(pool DBPool) *ApplicationEventRepository {
+	return &ApplicationEventRepository{pool: pool}
+}
+
+func (r *ApplicationEventRepository) Create(ctx context.Context, event *model.ApplicationEvent) error {
+	query := `
+		INSERT INTO application_events (id, application_id, user_id, event_type, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	payload := event.Payload
+	if payload == nil {
+		payload = map[string]any{}
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	event.ID = uuid.New().String()
+	event.CreatedAt = time.Now().UTC()
+
+	_, err = r.pool.Exec(ctx, query,
+		event.ID, event.ApplicationID, event.UserID, event.EventType, payloadJSON, event.CreatedAt,
+	)
+	return err
+}
+
+func (r *ApplicationEventRepository) ListByApplication(ctx context.Context, appID string, limit, offset int) ([]*model.ApplicationEvent, int, error) {
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM application_events WHERE application_id = $1`, appID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT id, application_id, user_id, event_type, payload, created_at
+		FROM application_events WHERE application_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.pool.Query(ctx, query, appID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	events := make([]*model.ApplicationEvent, 0)
+	for rows.Next() {
+		event := &model.ApplicationEvent{}
+		var payloadJSON []byte
+		if err := rows.Scan(&event.ID, &event.ApplicationID, &event.UserID, &event.EventType, &payloadJSON, &event.CreatedAt); err != nil {
+			return nil, 0, err
+		}
+		if len(payloadJSON) > 0 {
+			if err := json.Unmarshal(payloadJSON, &event.Payload); err != nil {
+				return nil, 0, err
+			}
+		}
+		events = append(events, event)
+	}
+	return events, total, rows.Err()
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationEventRepository_Create(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	payload := captureArg{}
+	mock.ExpectExec("INSERT INTO application_events").
+		WithArgs(pgxmock.AnyArg(), "app-1", "user-123", model.EventApplicationStatusChanged, &payload, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	repo := NewApplicationEventRepositoryWithPool(mock)
+	event := &model.ApplicationEvent{
+		ApplicationID: "app-1",
+		UserID:        "user-123",
+		EventType:     model.EventApplicationStatusChanged,
+		Payload:       map[string]any{"status": model.Change("active", "offer")},
+	}
+	err = repo.Create(context.Background(), event)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, event.ID)
+	assert.False(t, event.CreatedAt.IsZero())
+	assert.JSONEq(t, `{"status":{"from":"active","to":"offer"}}`, string(payload.value.([]byte)))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplicationEventRepository_ListByApplication(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("SELECT COUNT").WithArgs("app-1").
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(`ORDER BY created_at DESC, id DESC`).WithArgs("app-1", 2, 0).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "application_id", "user_id", "event_type", "payload", "created_at"}).
+			AddRow("event-2", "app-1", "user-123", model.EventStageAdded, []byte(`{"stage_name":"Interview"}`), createdAt).
+			AddRow("event-1", "app-1", "user-123", model.EventApplicationCreated, []byte(`{}`), createdAt.Add(-time.Hour)))
+
+	repo := NewApplicationEventRepositoryWithPool(mock)
+	events, total, err := repo.ListByApplication(context.Background(), "app-1", 2, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	require.Len(t, events, 2)
+	assert.Equal(t, "event-2", events[0].ID)
+	assert.Equal(t, "Interview", events[0].Payload["stage_name"])
+	assert.Equal(t, model.EventApplicationCreated, events[1].EventType)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -7,26 +7,41 @@ import (
 	"strings"
 	"time"
 
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	"github.com/andreypavlenko/jobber/modules/applications/model"
 	"github.com/andreypavlenko/jobber/modules/applications/ports"
 	companyModel "github.com/andreypavlenko/jobber/modules/companies/model"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
 type ApplicationRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 func NewApplicationRepository(pool *pgxpool.Pool) *ApplicationRepository {
 	return &ApplicationRepository{pool: pool}
 }
 
+// NewApplicationRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewApplicationRepositoryWithPool(pool DBPool) *ApplicationRepository {
+	return &ApplicationRepository{pool: pool}
+}
+
 func (r *ApplicationRepository) Create(ctx context.Context, app *model.Application) error {
 	query := `
-		INSERT INTO applications (id, user_id, job_id, resume_id, resume_builder_id, name, current_stage_id, status, applied_at, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO applications (id, user_id, job_id, resume_id, resume_builder_id, name, current_stage_id, status, cover_letter_url, cover_letter_storage_type, metadata, applied_at, deadline_at, referral_contact_name, referral_contact_email, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 	`
 
 	app.ID = uuid.New().String()
@@ -35,20 +50,20 @@ func (r *ApplicationRepository) Create(ctx context.Context, app *model.Applicati
 	app.UpdatedAt = now
 
 	_, err := r.pool.Exec(ctx, query,
-		app.ID, app.UserID, app.JobID, app.ResumeID, app.ResumeBuilderID, app.Name, app.CurrentStageID, app.Status, app.AppliedAt, app.CreatedAt, app.UpdatedAt,
+		app.ID, app.UserID, app.JobID, app.ResumeID, app.ResumeBuilderID, app.Name, app.CurrentStageID, app.Status, app.CoverLetterURL, app.CoverLetterStorageType, metadataOrEmpty(app.Metadata), app.AppliedAt, app.DeadlineAt, app.ReferralContactName, app.ReferralContactEmail, app.CreatedAt, app.UpdatedAt,
 	)
 	return err
 }
 
 func (r *ApplicationRepository) GetByID(ctx context.Context, userID, appID string) (*model.Application, error) {
 	query := `
-		SELECT id, user_id, job_id, resume_id, resume_builder_id, name, current_stage_id, status, applied_at, created_at, updated_at
-		FROM applications WHERE id = $1 AND user_id = $2
+		SELECT id, user_id, job_id, resume_id, resume_builder_id, name, current_stage_id, status, cover_letter_url, cover_letter_storage_type, metadata, applied_at, archived_at, offered_salary, negotiated_salary, deadline_at, equity_percent, start_date, benefits_notes, referral_contact_name, referral_contact_email, created_at, updated_at
+		FROM applications WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
 	`
 
 	app := &model.Application{}
 	err := r.pool.QueryRow(ctx, query, appID, userID).Scan(
-		&app.ID, &app.UserID, &app.JobID, &app.ResumeID, &app.ResumeBuilderID, &app.Name, &app.CurrentStageID, &app.Status, &app.AppliedAt, &app.CreatedAt, &app.UpdatedAt,
+		&app.ID, &app.UserID, &app.JobID, &app.ResumeID, &app.ResumeBuilderID, &app.Name, &app.CurrentStageID, &app.Status, &app.CoverLetterURL, &app.CoverLetterStorageType, &app.Metadata, &app.AppliedAt, &app.ArchivedAt, &app.OfferedSalary, &app.NegotiatedSalary, &app.DeadlineAt, &app.EquityPercent, &app.StartDate, &app.BenefitsNotes, &app.ReferralContactName, &app.ReferralContactEmail, &app.CreatedAt, &app.UpdatedAt,
 	)
 
 	if err != nil {
@@ -60,44 +75,93 @@ func (r *ApplicationRepository) GetByID(ctx context.Context, userID, appID strin
 	return app, nil
 }
 
-func (r *ApplicationRepository) List(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.Application, int, error) {
-	// Build optional status filter
-	statusFilter := ""
+// buildListFilter builds the WHERE conditions shared by List and ListEnriched.
+// The returned filter is appended after "a.user_id = $1"; args starts with userID.
+func buildListFilter(userID string, opts *ports.ListOptions) (string, []any) {
+	var filter strings.Builder
 	args := []any{userID}
-	if opts.Status != "" {
-		statusFilter = fmt.Sprintf(" AND a.status = $%d", len(args)+1)
-		args = append(args, opts.Status)
+	switch {
+	case opts.OnlyDeleted:
+		filter.WriteString(" AND a.deleted_at IS NOT NULL")
+	case !opts.IncludeDeleted:
+		filter.WriteString(" AND a.deleted_at IS NULL")
+	}
+	if len(opts.Statuses) > 0 {
+		args = append(args, opts.Statuses)
+		fmt.Fprintf(&filter, " AND a.status = ANY($%d::text[])", len(args))
+	}
+	if opts.MetadataKey != "" {
+		args = append(args, opts.MetadataKey, opts.MetadataValue)
+		fmt.Fprintf(&filter, " AND a.metadata->>$%d = $%d", len(args)-1, len(args))
+	}
+	if opts.TagName != nil {
+		args = append(args, *opts.TagName)
+		fmt.Fprintf(&filter, " AND EXISTS (SELECT 1 FROM tag_relations tr JOIN tags t ON t.id = tr.tag_id"+
+			" WHERE tr.entity_type = 'application' AND tr.entity_id = a.id AND t.name = $%d AND t.user_id = $1)", len(args))
+	}
+	if opts.ResumeID != nil {
+		args = append(args, *opts.ResumeID)
+		fmt.Fprintf(&filter, " AND a.resume_id = $%d", len(args))
+	}
+	if opts.CompanyID != nil {
+		args = append(args, *opts.CompanyID)
+		fmt.Fprintf(&filter, " AND a.job_id IN (SELECT fj.id FROM jobs fj WHERE fj.company_id = $%d AND fj.user_id = $1)", len(args))
+	}
+	if opts.Source != nil {
+		args = append(args, *opts.Source)
+		fmt.Fprintf(&filter, " AND a.job_id IN (SELECT fj.id FROM jobs fj WHERE LOWER(fj.source) = LOWER($%d) AND fj.user_id = $1)", len(args))
+	}
+	if opts.AppliedAfter != nil {
+		args = append(args, *opts.AppliedAfter)
+		fmt.Fprintf(&filter, " AND a.applied_at >= $%d", len(args))
+	}
+	if opts.AppliedBefore != nil {
+		args = append(args, *opts.AppliedBefore)
+		fmt.Fprintf(&filter, " AND a.applied_at < $%d", len(args))
 	}
+	if opts.DeadlineAfter != nil {
+		args = append(args, *opts.DeadlineAfter)
+		fmt.Fprintf(&filter, " AND a.deadline_at >= $%d", len(args))
+	}
+	if opts.DeadlineBefore != nil {
+		args = append(args, *opts.DeadlineBefore)
+		fmt.Fprintf(&filter, " AND a.deadline_at <= $%d", len(args))
+	}
+	if len(opts.TagIDs) > 0 {
+		args = append(args, opts.TagIDs)
+		fmt.Fprintf(&filter, " AND EXISTS (SELECT 1 FROM tag_relations tr JOIN tags t ON t.id = tr.tag_id"+
+			" WHERE tr.entity_type = 'application' AND tr.entity_id = a.id AND tr.tag_id = ANY($%d::uuid[]) AND t.user_id = $1)", len(args))
+	}
+	return filter.String(), args
+}
+
+// metadataOrEmpty stores missing metadata as an empty JSON object
+func metadataOrEmpty(metadata map[string]interface{}) map[string]interface{} {
+	if metadata == nil {
+		return map[string]interface{}{}
+	}
+	return metadata
+}
+
+func (r *ApplicationRepository) List(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.Application, int, error) {
+	filter, args := buildListFilter(userID, opts)
 
 	// Get total count
-	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM applications a WHERE a.user_id = $1%s`, statusFilter)
-	var total int
-	if err := r.pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+	total, err := r.count(ctx, filter, args)
+	if err != nil {
 		return nil, 0, err
 	}
 
-	// Build ORDER BY clause
-	orderBy := "applied_at DESC" // default
-	if opts.SortBy != "" {
-		sortCol := ""
-		switch opts.SortBy {
-		case "last_activity":
-			// We'll need to calculate this in a subquery
-			sortCol = "last_activity_at"
-		case "status":
-			sortCol = "status"
-		case "applied_at":
-			sortCol = "applied_at"
-		default:
-			sortCol = "applied_at"
-		}
-
-		sortDir := "DESC"
-		if strings.ToUpper(opts.SortDir) == "ASC" {
-			sortDir = "ASC"
+	orderBy, err := buildOrderBy(opts.SortFields, listSortColumns, "applied_at DESC")
+	if err != nil {
+		return nil, 0, err
+	}
+	var keysetFilter string
+	if opts.Cursor != nil {
+		keysetFilter, orderBy, args, err = buildKeyset(opts, listCursorColumns, ports.SortField{Field: "applied_at", Dir: "desc"}, args)
+		if err != nil {
+			return nil, 0, err
 		}
-
-		orderBy = fmt.Sprintf("%s %s", sortCol, sortDir)
 	}
 
 	// Get paginated results with last_activity calculation
@@ -117,13 +181,13 @@ func (r *ApplicationRepository) List(ctx context.Context, userID string, opts *p
 		)
 		SELECT
 			a.id, a.user_id, a.job_id, a.resume_id, a.resume_builder_id, a.name,
-			a.current_stage_id, a.status, a.applied_at, a.created_at, a.updated_at
+			a.current_stage_id, a.status, a.cover_letter_url, a.cover_letter_storage_type, a.metadata, a.applied_at, a.created_at, a.updated_at
 		FROM applications a
 		JOIN last_activities la ON a.id = la.app_id
-		WHERE a.user_id = $1%s
+		WHERE a.user_id = $1%s%s
 		ORDER BY %s
 		LIMIT $%d OFFSET $%d
-	`, statusFilter, statusFilter, orderBy, limitIdx, offsetIdx)
+	`, filter, filter, keysetFilter, orderBy, limitIdx, offsetIdx)
 
 	queryArgs := append(args, opts.Limit, opts.Offset)
 	rows, err := r.pool.Query(ctx, query, queryArgs...)
@@ -135,7 +199,7 @@ func (r *ApplicationRepository) List(ctx context.Context, userID string, opts *p
 	var apps []*model.Application
 	for rows.Next() {
 		app := &model.Application{}
-		if err := rows.Scan(&app.ID, &app.UserID, &app.JobID, &app.ResumeID, &app.ResumeBuilderID, &app.Name, &app.CurrentStageID, &app.Status, &app.AppliedAt, &app.CreatedAt, &app.UpdatedAt); err != nil {
+		if err := rows.Scan(&app.ID, &app.UserID, &app.JobID, &app.ResumeID, &app.ResumeBuilderID, &app.Name, &app.CurrentStageID, &app.Status, &app.CoverLetterURL, &app.CoverLetterStorageType, &app.Metadata, &app.AppliedAt, &app.CreatedAt, &app.UpdatedAt); err != nil {
 			return nil, 0, err
 		}
 		apps = append(apps, app)
@@ -145,35 +209,24 @@ func (r *ApplicationRepository) List(ctx context.Context, userID string, opts *p
 
 // ListEnriched returns enriched ApplicationDTOs via JOINs (single query, no N+1).
 func (r *ApplicationRepository) ListEnriched(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.ApplicationDTO, int, error) {
-	// Build optional status filter
-	statusFilter := ""
-	args := []any{userID}
-	if opts.Status != "" {
-		statusFilter = fmt.Sprintf(" AND a.status = $%d", len(args)+1)
-		args = append(args, opts.Status)
-	}
-
-	// Build ORDER BY clause
-	orderBy := "last_activity_at DESC" // default
-	if opts.SortBy != "" {
-		sortCol := ""
-		switch opts.SortBy {
-		case "last_activity":
-			sortCol = "last_activity_at"
-		case "status":
-			sortCol = "a.status"
-		case "applied_at":
-			sortCol = "a.applied_at"
-		default:
-			sortCol = "last_activity_at"
-		}
+	filter, args := buildListFilter(userID, opts)
 
-		sortDir := "DESC"
-		if strings.ToUpper(opts.SortDir) == "ASC" {
-			sortDir = "ASC"
-		}
+	orderBy, err := buildOrderBy(opts.SortFields, enrichedSortColumns, "last_activity_at DESC")
+	if err != nil {
+		return nil, 0, err
+	}
 
-		orderBy = fmt.Sprintf("%s %s", sortCol, sortDir)
+	// The window count only sees rows after the cursor, so cursor pages count separately
+	var keysetFilter string
+	var cursorTotal int
+	if opts.Cursor != nil {
+		if cursorTotal, err = r.count(ctx, filter, args); err != nil {
+			return nil, 0, err
+		}
+		keysetFilter, orderBy, args, err = buildKeyset(opts, enrichedCursorColumns, ports.SortField{Field: "last_activity", Dir: "desc"}, args)
+		if err != nil {
+			return nil, 0, err
+		}
 	}
 
 	limitIdx := len(args) + 1
@@ -185,23 +238,31 @@ func (r *ApplicationRepository) ListEnriched(ctx context.Context, userID string,
 			GROUP BY application_id
 		),
 		comment_activity AS (
-			SELECT application_id, MAX(created_at) as max_created
+			SELECT application_id, MAX(created_at) as max_created, COUNT(*) FILTER (WHERE is_pinned) as pin_count
 			FROM comments
 			GROUP BY application_id
 		)
 		SELECT
 			a.id, a.name, a.status, a.applied_at, a.created_at, a.updated_at,
-			a.current_stage_id,
+			a.current_stage_id, a.cover_letter_url, a.cover_letter_storage_type, a.metadata,
+			a.offered_salary, a.negotiated_salary, a.deadline_at, a.deleted_at,
+			a.equity_percent, a.start_date, a.benefits_notes, a.referral_contact_name, a.referral_contact_email,
 			GREATEST(
 				a.updated_at,
 				COALESCE(sa.max_created, a.updated_at),
 				COALESCE(ca.max_created, a.updated_at)
 			) as last_activity_at,
-			j.id, j.title,
+			j.id, j.title, j.source,
 			c.id, c.name, c.location, c.notes, c.is_favorite, c.created_at, c.updated_at,
 			r.id, r.title,
 			rb.id, rb.title,
 			st.name as current_stage_name,
+			COALESCE(ca.pin_count, 0) as pin_count,
+			ARRAY(
+				SELECT tr.tag_id::text FROM tag_relations tr
+				WHERE tr.entity_type = 'application' AND tr.entity_id = a.id
+				ORDER BY tr.created_at
+			) as tag_ids,
 			COUNT(*) OVER() as total_count
 		FROM applications a
 		LEFT JOIN stage_activity sa ON sa.application_id = a.id
@@ -212,10 +273,10 @@ func (r *ApplicationRepository) ListEnriched(ctx context.Context, userID string,
 		LEFT JOIN resume_builders rb ON rb.id = a.resume_builder_id
 		LEFT JOIN application_stages cur_stage ON cur_stage.id = a.current_stage_id
 		LEFT JOIN stage_templates st ON st.id = cur_stage.stage_template_id
-		WHERE a.user_id = $1%s
+		WHERE a.user_id = $1%s%s
 		ORDER BY %s
 		LIMIT $%d OFFSET $%d
-	`, statusFilter, orderBy, limitIdx, offsetIdx)
+	`, filter, keysetFilter, orderBy, limitIdx, offsetIdx)
 
 	queryArgs := append(args, opts.Limit, opts.Offset)
 	rows, err := r.pool.Query(ctx, query, queryArgs...)
@@ -224,12 +285,13 @@ func (r *ApplicationRepository) ListEnriched(ctx context.Context, userID string,
 	}
 	defer rows.Close()
 
-	var dtos []*model.ApplicationDTO
+	// Non-nil so that a filter matching nothing (e.g. an unknown tag name) serializes as an empty list
+	dtos := []*model.ApplicationDTO{}
 	var total int
 	for rows.Next() {
 		dto := &model.ApplicationDTO{}
 		var lastActivity time.Time
-		var jobID, jobTitle *string
+		var jobID, jobTitle, jobSource *string
 		var companyID, companyName *string
 		var companyLocation, companyNotes *string
 		var companyIsFavorite *bool
@@ -237,29 +299,38 @@ func (r *ApplicationRepository) ListEnriched(ctx context.Context, userID string,
 		var resumeID, resumeTitle *string
 		var resumeBuilderID, resumeBuilderTitle *string
 		var currentStageName *string
+		var coverLetterURL, coverLetterStorageType *string
+		var startDate *time.Time
 
 		if err := rows.Scan(
 			&dto.ID, &dto.Name, &dto.Status, &dto.AppliedAt, &dto.CreatedAt, &dto.UpdatedAt,
-			&dto.CurrentStageID,
+			&dto.CurrentStageID, &coverLetterURL, &coverLetterStorageType, &dto.Metadata,
+			&dto.OfferedSalary, &dto.NegotiatedSalary, &dto.DeadlineAt, &dto.DeletedAt,
+			&dto.EquityPercent, &startDate, &dto.BenefitsNotes, &dto.ReferralContactName, &dto.ReferralContactEmail,
 			&lastActivity,
-			&jobID, &jobTitle,
+			&jobID, &jobTitle, &jobSource,
 			&companyID, &companyName, &companyLocation, &companyNotes, &companyIsFavorite, &companyCreatedAt, &companyUpdatedAt,
 			&resumeID, &resumeTitle,
 			&resumeBuilderID, &resumeBuilderTitle,
 			&currentStageName,
+			&dto.PinCount,
+			&dto.TagIDs,
 			&total,
 		); err != nil {
 			return nil, 0, err
 		}
 
 		dto.LastActivityAt = lastActivity
+		dto.StartDate = model.FormatDate(startDate)
 		dto.CurrentStageName = currentStageName
+		dto.SetCoverLetter(coverLetterURL, coverLetterStorageType)
 
 		// Build nested Job + Company
 		if jobID != nil {
 			dto.Job = &model.JobNestedDTO{
-				ID:    *jobID,
-				Title: safeString(jobTitle),
+				ID:     *jobID,
+				Title:  safeString(jobTitle),
+				Source: jobSource,
 			}
 			if companyID != nil {
 				dto.Job.Company = &companyModel.CompanyDTO{
@@ -299,10 +370,102 @@ func (r *ApplicationRepository) ListEnriched(ctx context.Context, userID string,
 	if err := rows.Err(); err != nil {
 		return nil, 0, err
 	}
+	if opts.Cursor != nil {
+		total = cursorTotal
+	}
 
 	return dtos, total, nil
 }
 
+// count returns the number of the user's applications matching the list filter
+func (r *ApplicationRepository) count(ctx context.Context, filter string, args []any) (int, error) {
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM applications a WHERE a.user_id = $1%s`, filter)
+	var total int
+	if err := r.pool.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// Sort columns per query, keyed by the allowlisted sort field names
+var (
+	listSortColumns = map[string]string{
+		"last_activity": "last_activity_at",
+		"status":        "status",
+		"applied_at":    "applied_at",
+	}
+	enrichedSortColumns = map[string]string{
+		"last_activity": "last_activity_at",
+		"status":        "a.status",
+		"applied_at":    "a.applied_at",
+		"deadline":      "a.deadline_at", // not in ports.SortableFields; used by the expiring list
+	}
+)
+
+// Keyset columns per query for cursor pagination, keyed by the allowlisted sort field names.
+// ListEnriched cannot reference the last_activity_at alias in WHERE, so it repeats the expression.
+var (
+	listCursorColumns = map[string]keyset.Column{
+		"last_activity": keyset.TimeColumn("la.last_activity_at"),
+		"status":        keyset.TextColumn("a.status"),
+		"applied_at":    keyset.TimeColumn("a.applied_at"),
+	}
+	enrichedCursorColumns = map[string]keyset.Column{
+		"last_activity": keyset.TimeColumn("GREATEST(a.updated_at, COALESCE(sa.max_created, a.updated_at), COALESCE(ca.max_created, a.updated_at))"),
+		"status":        keyset.TextColumn("a.status"),
+		"applied_at":    keyset.TimeColumn("a.applied_at"),
+	}
+)
+
+// buildKeyset builds the cursor condition, appended after the list filter, and the
+// matching ORDER BY. Cursor pages support a single sort field; defaultSort applies when none is set.
+func buildKeyset(opts *ports.ListOptions, columns map[string]keyset.Column, defaultSort ports.SortField, args []any) (string, string, []any, error) {
+	sort := defaultSort
+	switch len(opts.SortFields) {
+	case 0:
+	case 1:
+		sort = opts.SortFields[0]
+	default:
+		return "", "", nil, keyset.ErrUnsupportedSort
+	}
+
+	col, ok := columns[sort.Field]
+	if !ok {
+		return "", "", nil, model.ErrInvalidSort
+	}
+	desc := strings.ToUpper(sort.Dir) != "ASC"
+	cond, args, err := col.Condition("a.id", desc, opts.Cursor, args)
+	if err != nil {
+		return "", "", nil, err
+	}
+	if cond != "" {
+		cond = " AND " + cond
+	}
+	return cond, col.OrderBy("a.id", desc), args, nil
+}
+
+// buildOrderBy builds an ORDER BY clause from the sort fields.
+// Field names are mapped through columns so only allowlisted columns reach the SQL.
+func buildOrderBy(fields []ports.SortField, columns map[string]string, defaultOrder string) (string, error) {
+	if len(fields) == 0 {
+		return defaultOrder, nil
+	}
+
+	parts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		col, ok := columns[f.Field]
+		if !ok {
+			return "", model.ErrInvalidSort
+		}
+		dir := "DESC"
+		if strings.ToUpper(f.Dir) == "ASC" {
+			dir = "ASC"
+		}
+		parts = append(parts, col+" "+dir)
+	}
+	return strings.Join(parts, ", "), nil
+}
+
 func safeString(s *string) string {
 	if s == nil {
 		return ""
@@ -319,12 +482,34 @@ func safeBool(b *bool) bool {
 
 func (r *ApplicationRepository) Update(ctx context.Context, app *model.Application) error {
 	query := `
-		UPDATE applications SET current_stage_id = $3, status = $4, updated_at = $5
-		WHERE id = $1 AND user_id = $2
+		UPDATE applications SET current_stage_id = $3, status = $4, cover_letter_url = $5, cover_letter_storage_type = $6, metadata = $7, updated_at = $8,
+			archived_at = CASE WHEN $4 = 'archived' THEN COALESCE(archived_at, $8) ELSE NULL END,
+			offered_salary = $9, negotiated_salary = $10, deadline_at = $11,
+			equity_percent = $12, start_date = $13, benefits_notes = $14,
+			referral_contact_name = $15, referral_contact_email = $16
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
 	`
 
 	app.UpdatedAt = time.Now().UTC()
-	result, err := r.pool.Exec(ctx, query, app.ID, app.UserID, app.CurrentStageID, app.Status, app.UpdatedAt)
+	result, err := r.pool.Exec(ctx, query, app.ID, app.UserID, app.CurrentStageID, app.Status, app.CoverLetterURL, app.CoverLetterStorageType, metadataOrEmpty(app.Metadata), app.UpdatedAt, app.OfferedSalary, app.NegotiatedSalary, app.DeadlineAt, app.EquityPercent, app.StartDate, app.BenefitsNotes, app.ReferralContactName, app.ReferralContactEmail)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrApplicationNotFound
+	}
+	return nil
+}
+
+// UpdateResume attaches an uploaded resume to the application. The resume builder
+// reference is cleared since an application uses only one kind of resume.
+func (r *ApplicationRepository) UpdateResume(ctx context.Context, userID, appID, resumeID string) error {
+	query := `
+		UPDATE applications SET resume_id = $3, resume_builder_id = NULL, updated_at = $4
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, appID, userID, resumeID, time.Now().UTC())
 	if err != nil {
 		return err
 	}
@@ -334,8 +519,27 @@ func (r *ApplicationRepository) Update(ctx context.Context, app *model.Applicati
 	return nil
 }
 
+// SetArchiveStatus moves the application to status and records archivedAt,
+// clearing archived_at when archivedAt is nil.
+func (r *ApplicationRepository) SetArchiveStatus(ctx context.Context, userID, appID, status string, archivedAt *time.Time) error {
+	query := `
+		UPDATE applications SET status = $3, archived_at = $4, updated_at = $5
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, appID, userID, status, archivedAt, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrApplicationNotFound
+	}
+	return nil
+}
+
+// Delete soft-deletes the application by setting deleted_at; it stays restorable from the trash
 func (r *ApplicationRepository) Delete(ctx context.Context, userID, appID string) error {
-	query := `DELETE FROM applications WHERE id = $1 AND user_id = $2`
+	query := `UPDATE applications SET deleted_at = NOW() WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL`
 	result, err := r.pool.Exec(ctx, query, appID, userID)
 	if err != nil {
 		return err
@@ -346,6 +550,255 @@ func (r *ApplicationRepository) Delete(ctx context.Context, userID, appID string
 	return nil
 }
 
+// Restore clears deleted_at of a trashed application
+func (r *ApplicationRepository) Restore(ctx context.Context, userID, appID string) error {
+	query := `UPDATE applications SET deleted_at = NULL WHERE id = $1 AND user_id = $2 AND deleted_at IS NOT NULL`
+	result, err := r.pool.Exec(ctx, query, appID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrApplicationNotFound
+	}
+	return nil
+}
+
+// DeletePermanently removes the application row; stages, comments and reminders cascade.
+// It returns the storage key of the application's uploaded cover letter, if any.
+func (r *ApplicationRepository) DeletePermanently(ctx context.Context, userID, appID string) (*string, error) {
+	query := `
+		DELETE FROM applications WHERE id = $1 AND user_id = $2
+		RETURNING CASE WHEN cover_letter_storage_type = 's3' THEN cover_letter_url END
+	`
+	var coverLetterKey *string
+	if err := r.pool.QueryRow(ctx, query, appID, userID).Scan(&coverLetterKey); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrApplicationNotFound
+		}
+		return nil, err
+	}
+	return coverLetterKey, nil
+}
+
+// ListOwnedIDs returns the subset of appIDs that belong to the user
+func (r *ApplicationRepository) ListOwnedIDs(ctx context.Context, userID string, appIDs []string) ([]string, error) {
+	query := `SELECT id FROM applications WHERE user_id = $1 AND id = ANY($2::uuid[]) AND deleted_at IS NULL`
+	rows, err := r.pool.Query(ctx, query, userID, appIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// GetStatuses returns the status of each of appIDs that belongs to the user, keyed by ID
+func (r *ApplicationRepository) GetStatuses(ctx context.Context, userID string, appIDs []string) (map[string]string, error) {
+	query := `SELECT id, status FROM applications WHERE id = ANY($1::uuid[]) AND user_id = $2 AND deleted_at IS NULL`
+	rows, err := r.pool.Query(ctx, query, appIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statuses := make(map[string]string, len(appIDs))
+	for rows.Next() {
+		var id, status string
+		if err := rows.Scan(&id, &status); err != nil {
+			return nil, err
+		}
+		statuses[id] = status
+	}
+	return statuses, rows.Err()
+}
+
+// BulkUpdateStatus sets the status of the user's applications among appIDs in one
+// statement. archived_at follows the status the same way as in Update.
+func (r *ApplicationRepository) BulkUpdateStatus(ctx context.Context, userID string, appIDs []string, status string) (int64, error) {
+	query := `
+		UPDATE applications SET status = $1, updated_at = $4,
+			archived_at = CASE WHEN $1 = 'archived' THEN COALESCE(archived_at, $4) ELSE NULL END
+		WHERE id = ANY($2::uuid[]) AND user_id = $3 AND deleted_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, status, appIDs, userID, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+// EnableSharing sets the application's share token unless one already exists,
+// and returns the token in effect.
+func (r *ApplicationRepository) EnableSharing(ctx context.Context, userID, appID, token string) (string, error) {
+	query := `
+		UPDATE applications SET share_token = COALESCE(share_token, $3)
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+		RETURNING share_token
+	`
+
+	var shareToken string
+	err := r.pool.QueryRow(ctx, query, appID, userID, token).Scan(&shareToken)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", model.ErrApplicationNotFound
+		}
+		return "", err
+	}
+	return shareToken, nil
+}
+
+// DisableSharing revokes the application's share token
+func (r *ApplicationRepository) DisableSharing(ctx context.Context, userID, appID string) error {
+	query := `UPDATE applications SET share_token = NULL WHERE id = $1 AND user_id = $2`
+	result, err := r.pool.Exec(ctx, query, appID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrApplicationNotFound
+	}
+	return nil
+}
+
+// GetByShareToken returns the application shared under token
+func (r *ApplicationRepository) GetByShareToken(ctx context.Context, token string) (*model.Application, error) {
+	query := `
+		SELECT id, user_id, job_id, resume_id, resume_builder_id, name, current_stage_id, status, cover_letter_url, cover_letter_storage_type, metadata, applied_at, archived_at, offered_salary, negotiated_salary, deadline_at, equity_percent, start_date, benefits_notes, referral_contact_name, referral_contact_email, created_at, updated_at
+		FROM applications WHERE share_token = $1 AND deleted_at IS NULL
+	`
+
+	app := &model.Application{}
+	err := r.pool.QueryRow(ctx, query, token).Scan(
+		&app.ID, &app.UserID, &app.JobID, &app.ResumeID, &app.ResumeBuilderID, &app.Name, &app.CurrentStageID, &app.Status, &app.CoverLetterURL, &app.CoverLetterStorageType, &app.Metadata, &app.AppliedAt, &app.ArchivedAt, &app.OfferedSalary, &app.NegotiatedSalary, &app.DeadlineAt, &app.EquityPercent, &app.StartDate, &app.BenefitsNotes, &app.ReferralContactName, &app.ReferralContactEmail, &app.CreatedAt, &app.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrShareTokenNotFound
+		}
+		return nil, err
+	}
+	return app, nil
+}
+
+// ListOffers returns the user's applications in the offer status, soonest deadline
+// first; offers without a deadline come last, most recently updated first
+func (r *ApplicationRepository) ListOffers(ctx context.Context, userID string) ([]*model.OfferDTO, error) {
+	query := `
+		SELECT a.id, a.name, j.id, j.title, c.id, c.name, j.salary_currency,
+			a.offered_salary, a.negotiated_salary, a.equity_percent, a.start_date, a.benefits_notes, a.deadline_at
+		FROM applications a
+		JOIN jobs j ON j.id = a.job_id
+		LEFT JOIN companies c ON c.id = j.company_id
+		WHERE a.user_id = $1 AND a.status = 'offer' AND a.deleted_at IS NULL
+		ORDER BY a.deadline_at ASC NULLS LAST, a.updated_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	offers := []*model.OfferDTO{}
+	for rows.Next() {
+		offer := &model.OfferDTO{}
+		var startDate *time.Time
+		if err := rows.Scan(
+			&offer.ApplicationID, &offer.ApplicationName, &offer.JobID, &offer.JobTitle, &offer.CompanyID, &offer.CompanyName, &offer.SalaryCurrency,
+			&offer.OfferedSalary, &offer.NegotiatedSalary, &offer.EquityPercent, &startDate, &offer.BenefitsNotes, &offer.DeadlineAt,
+		); err != nil {
+			return nil, err
+		}
+		offer.StartDate = model.FormatDate(startDate)
+		offers = append(offers, offer)
+	}
+	return offers, rows.Err()
+}
+
+const statusCountColumns = `
+	COUNT(*),
+	COUNT(*) FILTER (WHERE status = 'active'),
+	COUNT(*) FILTER (WHERE status = 'on_hold'),
+	COUNT(*) FILTER (WHERE status = 'offer'),
+	COUNT(*) FILTER (WHERE status = 'rejected'),
+	COUNT(*) FILTER (WHERE status = 'archived')`
+
+// GetStatusCounts returns the number of applications per status in a single scan
+func (r *ApplicationRepository) GetStatusCounts(ctx context.Context, userID string) (*model.StatusCounts, error) {
+	query := `
+		SELECT ` + statusCountColumns + `
+		FROM applications
+		WHERE user_id = $1 AND deleted_at IS NULL
+	`
+	return scanStatusCounts(r.pool.QueryRow(ctx, query, userID))
+}
+
+// GetTotalStatusCounts returns the number of applications of all users per status
+func (r *ApplicationRepository) GetTotalStatusCounts(ctx context.Context) (*model.StatusCounts, error) {
+	query := `
+		SELECT ` + statusCountColumns + `
+		FROM applications
+		WHERE deleted_at IS NULL
+	`
+	return scanStatusCounts(r.pool.QueryRow(ctx, query))
+}
+
+func scanStatusCounts(row pgx.Row) (*model.StatusCounts, error) {
+	counts := &model.StatusCounts{}
+	err := row.Scan(
+		&counts.Total, &counts.Active, &counts.OnHold, &counts.Offer, &counts.Rejected, &counts.Archived,
+	)
+	if err != nil {
+		return nil, err
+	}
+	counts.HasAnyApplications = counts.Total > 0
+	return counts, nil
+}
+
+// GetStageCounts counts the stages of several applications in a single grouped query
+func (r *ApplicationRepository) GetStageCounts(ctx context.Context, appIDs []string) (map[string]model.StageCount, error) {
+	counts := make(map[string]model.StageCount, len(appIDs))
+	if len(appIDs) == 0 {
+		return counts, nil
+	}
+
+	query := `
+		SELECT
+			application_id,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE status = 'completed')
+		FROM application_stages
+		WHERE application_id = ANY($1::uuid[])
+		GROUP BY application_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, appIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var appID string
+		var count model.StageCount
+		if err := rows.Scan(&appID, &count.Total, &count.Completed); err != nil {
+			return nil, err
+		}
+		counts[appID] = count
+	}
+	return counts, rows.Err()
+}
+
 func (r *ApplicationRepository) GetLastActivityAt(ctx context.Context, appID string) (time.Time, error) {
 	query := `
 		SELECT GREATEST(
@@ -0,0 +1,938 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/andreypavlenko/jobber/modules/applications/ports"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildListFilter(t *testing.T) {
+	userID := "user-123"
+	appliedAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	appliedBefore := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	liveOnly := " AND a.deleted_at IS NULL"
+
+	tests := []struct {
+		name         string
+		opts         *ports.ListOptions
+		expectFilter string
+		expectArgs   []any
+	}{
+		{
+			name:         "no filters",
+			opts:         &ports.ListOptions{},
+			expectFilter: liveOnly,
+			expectArgs:   []any{userID},
+		},
+		{
+			name:         "status only",
+			opts:         &ports.ListOptions{Statuses: []string{"active"}},
+			expectFilter: liveOnly + " AND a.status = ANY($2::text[])",
+			expectArgs:   []any{userID, []string{"active"}},
+		},
+		{
+			name:         "metadata only",
+			opts:         &ports.ListOptions{MetadataKey: "visa_sponsored", MetadataValue: "true"},
+			expectFilter: liveOnly + " AND a.metadata->>$2 = $3",
+			expectArgs:   []any{userID, "visa_sponsored", "true"},
+		},
+		{
+			name:         "status and metadata",
+			opts:         &ports.ListOptions{Statuses: []string{"offer"}, MetadataKey: "remote_policy", MetadataValue: "hybrid"},
+			expectFilter: liveOnly + " AND a.status = ANY($2::text[]) AND a.metadata->>$3 = $4",
+			expectArgs:   []any{userID, []string{"offer"}, "remote_policy", "hybrid"},
+		},
+		{
+			name:         "metadata key is bound as a parameter, not interpolated",
+			opts:         &ports.ListOptions{MetadataKey: "x' OR '1'='1", MetadataValue: ""},
+			expectFilter: liveOnly + " AND a.metadata->>$2 = $3",
+			expectArgs:   []any{userID, "x' OR '1'='1", ""},
+		},
+		{
+			name:         "tag name",
+			opts:         &ports.ListOptions{TagName: strPtr("remote")},
+			expectFilter: liveOnly + tagNameFilter(2),
+			expectArgs:   []any{userID, "remote"},
+		},
+		{
+			name:         "status and tag name",
+			opts:         &ports.ListOptions{Statuses: []string{"active"}, TagName: strPtr("remote")},
+			expectFilter: liveOnly + " AND a.status = ANY($2::text[])" + tagNameFilter(3),
+			expectArgs:   []any{userID, []string{"active"}, "remote"},
+		},
+		{
+			name:         "status and resume",
+			opts:         &ports.ListOptions{Statuses: []string{"active"}, ResumeID: strPtr("resume-1")},
+			expectFilter: liveOnly + " AND a.status = ANY($2::text[]) AND a.resume_id = $3",
+			expectArgs:   []any{userID, []string{"active"}, "resume-1"},
+		},
+		{
+			name:         "several statuses",
+			opts:         &ports.ListOptions{Statuses: []string{"active", "offer"}},
+			expectFilter: liveOnly + " AND a.status = ANY($2::text[])",
+			expectArgs:   []any{userID, []string{"active", "offer"}},
+		},
+		{
+			name: "company and source",
+			opts: &ports.ListOptions{CompanyID: strPtr("company-1"), Source: strPtr("LinkedIn")},
+			expectFilter: liveOnly + " AND a.job_id IN (SELECT fj.id FROM jobs fj WHERE fj.company_id = $2 AND fj.user_id = $1)" +
+				" AND a.job_id IN (SELECT fj.id FROM jobs fj WHERE LOWER(fj.source) = LOWER($3) AND fj.user_id = $1)",
+			expectArgs: []any{userID, "company-1", "LinkedIn"},
+		},
+		{
+			name:         "applied date range",
+			opts:         &ports.ListOptions{AppliedAfter: &appliedAfter, AppliedBefore: &appliedBefore},
+			expectFilter: liveOnly + " AND a.applied_at >= $2 AND a.applied_at < $3",
+			expectArgs:   []any{userID, appliedAfter, appliedBefore},
+		},
+		{
+			name:         "deadline range",
+			opts:         &ports.ListOptions{DeadlineAfter: &appliedAfter, DeadlineBefore: &appliedBefore},
+			expectFilter: liveOnly + " AND a.deadline_at >= $2 AND a.deadline_at <= $3",
+			expectArgs:   []any{userID, appliedAfter, appliedBefore},
+		},
+		{
+			name: "tag IDs",
+			opts: &ports.ListOptions{TagIDs: []string{"tag-1", "tag-2"}},
+			expectFilter: liveOnly + " AND EXISTS (SELECT 1 FROM tag_relations tr JOIN tags t ON t.id = tr.tag_id" +
+				" WHERE tr.entity_type = 'application' AND tr.entity_id = a.id AND tr.tag_id = ANY($2::uuid[]) AND t.user_id = $1)",
+			expectArgs: []any{userID, []string{"tag-1", "tag-2"}},
+		},
+		{
+			name:         "including deleted",
+			opts:         &ports.ListOptions{IncludeDeleted: true, Statuses: []string{"active"}},
+			expectFilter: " AND a.status = ANY($2::text[])",
+			expectArgs:   []any{userID, []string{"active"}},
+		},
+		{
+			name:         "trash only",
+			opts:         &ports.ListOptions{OnlyDeleted: true},
+			expectFilter: " AND a.deleted_at IS NOT NULL",
+			expectArgs:   []any{userID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, args := buildListFilter(userID, tt.opts)
+
+			assert.Equal(t, tt.expectFilter, filter)
+			assert.Equal(t, tt.expectArgs, args)
+		})
+	}
+}
+
+func TestBuildOrderBy(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []ports.SortField
+		expect string
+	}{
+		{
+			name:   "default",
+			fields: nil,
+			expect: "last_activity_at DESC",
+		},
+		{
+			name:   "single field",
+			fields: []ports.SortField{{Field: "applied_at", Dir: "asc"}},
+			expect: "a.applied_at ASC",
+		},
+		{
+			name:   "two fields",
+			fields: []ports.SortField{{Field: "status", Dir: "asc"}, {Field: "last_activity", Dir: "desc"}},
+			expect: "a.status ASC, last_activity_at DESC",
+		},
+		{
+			name: "three fields",
+			fields: []ports.SortField{
+				{Field: "status", Dir: "desc"},
+				{Field: "applied_at", Dir: "asc"},
+				{Field: "last_activity", Dir: ""},
+			},
+			expect: "a.status DESC, a.applied_at ASC, last_activity_at DESC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderBy, err := buildOrderBy(tt.fields, enrichedSortColumns, "last_activity_at DESC")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, orderBy)
+		})
+	}
+
+	t.Run("rejects unknown field", func(t *testing.T) {
+		fields := []ports.SortField{{Field: "status", Dir: "asc"}, {Field: "name; DROP TABLE applications", Dir: "asc"}}
+		_, err := buildOrderBy(fields, enrichedSortColumns, "last_activity_at DESC")
+		assert.ErrorIs(t, err, model.ErrInvalidSort)
+	})
+
+	t.Run("allowlist matches sortable fields", func(t *testing.T) {
+		for field := range ports.SortableFields {
+			assert.Contains(t, listSortColumns, field)
+			assert.Contains(t, enrichedSortColumns, field)
+		}
+	})
+}
+
+func TestBuildKeyset(t *testing.T) {
+	cursor := &keyset.Cursor{LastID: "5b1f2f5e-8d7a-4c36-9a52-1f0f4c2e9b10", LastValue: "offer"}
+
+	t.Run("continues after the cursor", func(t *testing.T) {
+		opts := &ports.ListOptions{SortFields: []ports.SortField{{Field: "status", Dir: "asc"}}, Cursor: cursor}
+
+		cond, orderBy, args, err := buildKeyset(opts, enrichedCursorColumns, ports.SortField{Field: "last_activity", Dir: "desc"}, []any{"user-123"})
+
+		require.NoError(t, err)
+		assert.Equal(t, " AND (a.status, a.id) > ($2, $3)", cond)
+		assert.Equal(t, "a.status ASC, a.id ASC", orderBy)
+		assert.Equal(t, []any{"user-123", "offer", cursor.LastID}, args)
+	})
+
+	t.Run("uses the default sort for the first page", func(t *testing.T) {
+		opts := &ports.ListOptions{Cursor: &keyset.Cursor{}}
+
+		cond, orderBy, args, err := buildKeyset(opts, listCursorColumns, ports.SortField{Field: "applied_at", Dir: "desc"}, []any{"user-123"})
+
+		require.NoError(t, err)
+		assert.Empty(t, cond)
+		assert.Equal(t, "a.applied_at DESC, a.id DESC", orderBy)
+		assert.Equal(t, []any{"user-123"}, args)
+	})
+
+	t.Run("rejects several sort fields", func(t *testing.T) {
+		opts := &ports.ListOptions{
+			SortFields: []ports.SortField{{Field: "status", Dir: "asc"}, {Field: "applied_at", Dir: "desc"}},
+			Cursor:     cursor,
+		}
+
+		_, _, _, err := buildKeyset(opts, enrichedCursorColumns, ports.SortField{Field: "last_activity", Dir: "desc"}, []any{"user-123"})
+
+		assert.ErrorIs(t, err, keyset.ErrUnsupportedSort)
+	})
+
+	t.Run("rejects a cursor value of the wrong type", func(t *testing.T) {
+		opts := &ports.ListOptions{SortFields: []ports.SortField{{Field: "applied_at", Dir: "desc"}}, Cursor: cursor}
+
+		_, _, _, err := buildKeyset(opts, enrichedCursorColumns, ports.SortField{Field: "last_activity", Dir: "desc"}, []any{"user-123"})
+
+		assert.ErrorIs(t, err, keyset.ErrInvalidCursor)
+	})
+
+	t.Run("allowlist matches sortable fields", func(t *testing.T) {
+		for field := range ports.SortableFields {
+			assert.Contains(t, listCursorColumns, field)
+			assert.Contains(t, enrichedCursorColumns, field)
+		}
+	})
+}
+
+func TestApplicationRepository_ListEnriched_Cursor(t *testing.T) {
+	var capturedSQL []string
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(_, actualSQL string) error {
+		capturedSQL = append(capturedSQL, actualSQL)
+		return nil
+	})))
+	require.NoError(t, err)
+	defer mock.Close()
+
+	lastID := "5b1f2f5e-8d7a-4c36-9a52-1f0f4c2e9b10"
+	appliedAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("").WithArgs("user-123").WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(42))
+	mock.ExpectQuery("").
+		WithArgs("user-123", appliedAt, lastID, 21, 0).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+	repo := NewApplicationRepositoryWithPool(mock)
+	apps, total, err := repo.ListEnriched(context.Background(), "user-123", &ports.ListOptions{
+		Limit:      21,
+		SortFields: []ports.SortField{{Field: "applied_at", Dir: "desc"}},
+		Cursor:     &keyset.Cursor{LastID: lastID, LastValue: keyset.TimeValue(appliedAt)},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, apps)
+	assert.Equal(t, 42, total, "total counts every match, not just the rows after the cursor")
+	require.Len(t, capturedSQL, 2)
+	assert.Contains(t, capturedSQL[0], "SELECT COUNT(*) FROM applications a WHERE a.user_id = $1")
+	assert.Contains(t, capturedSQL[1], "WHERE a.user_id = $1 AND a.deleted_at IS NULL AND (a.applied_at, a.id) < ($2, $3)")
+	assert.Contains(t, capturedSQL[1], "ORDER BY a.applied_at DESC, a.id DESC")
+	assert.Contains(t, capturedSQL[1], "LIMIT $4 OFFSET $5")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func tagNameFilter(idx int) string {
+	return " AND EXISTS (SELECT 1 FROM tag_relations tr JOIN tags t ON t.id = tr.tag_id" +
+		" WHERE tr.entity_type = 'application' AND tr.entity_id = a.id AND t.name = $" + strconv.Itoa(idx) + " AND t.user_id = $1)"
+}
+
+// captureArg is a pgxmock argument matcher that records the value it was given
+type captureArg struct {
+	value interface{}
+}
+
+func (a *captureArg) Match(v interface{}) bool {
+	a.value = v
+	return true
+}
+
+func TestApplicationRepository_GetStageCounts(t *testing.T) {
+	t.Run("counts stages of all applications in one grouped query", func(t *testing.T) {
+		var capturedSQL string
+		mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(_, actualSQL string) error {
+			capturedSQL = actualSQL
+			return nil
+		})))
+		require.NoError(t, err)
+		defer mock.Close()
+
+		appIDs := []string{"app-1", "app-2", "app-3"}
+		rows := pgxmock.NewRows([]string{"application_id", "total", "completed"}).
+			AddRow("app-1", 3, 2).
+			AddRow("app-2", 1, 0)
+		mock.ExpectQuery("").WithArgs(appIDs).WillReturnRows(rows)
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		counts, err := repo.GetStageCounts(context.Background(), appIDs)
+
+		require.NoError(t, err)
+		assert.Contains(t, capturedSQL, "application_id = ANY($1::uuid[])")
+		assert.Contains(t, capturedSQL, "GROUP BY application_id")
+		assert.Contains(t, capturedSQL, "COUNT(*) FILTER (WHERE status = 'completed')")
+		assert.Equal(t, map[string]model.StageCount{
+			"app-1": {Total: 3, Completed: 2},
+			"app-2": {Total: 1, Completed: 0},
+		}, counts)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skips the query for no applications", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		counts, err := repo.GetStageCounts(context.Background(), nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, counts)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns database error", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("GROUP BY").WithArgs([]string{"app-1"}).WillReturnError(errors.New("db error"))
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		counts, err := repo.GetStageCounts(context.Background(), []string{"app-1"})
+
+		assert.Error(t, err)
+		assert.Nil(t, counts)
+	})
+}
+
+func TestApplicationRepository_GetStatusCounts(t *testing.T) {
+	t.Run("counts statuses in a single filtered query", func(t *testing.T) {
+		var capturedSQL string
+		mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(_, actualSQL string) error {
+			capturedSQL = actualSQL
+			return nil
+		})))
+		require.NoError(t, err)
+		defer mock.Close()
+
+		userArg := &captureArg{}
+		rows := pgxmock.NewRows([]string{"total", "active", "on_hold", "offer", "rejected", "archived"}).
+			AddRow(7, 3, 1, 1, 2, 0)
+		mock.ExpectQuery("").WithArgs(userArg).WillReturnRows(rows)
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		counts, err := repo.GetStatusCounts(context.Background(), "user-123")
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-123", userArg.value)
+		for _, status := range []string{"active", "on_hold", "offer", "rejected", "archived"} {
+			assert.Contains(t, capturedSQL, "COUNT(*) FILTER (WHERE status = '"+status+"')")
+		}
+		assert.Contains(t, capturedSQL, "FROM applications")
+		assert.Contains(t, capturedSQL, "WHERE user_id = $1")
+		assert.Equal(t, &model.StatusCounts{
+			Total: 7, Active: 3, OnHold: 1, Offer: 1, Rejected: 2, Archived: 0,
+			HasAnyApplications: true,
+		}, counts)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("reports no applications for empty counts", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		rows := pgxmock.NewRows([]string{"total", "active", "on_hold", "offer", "rejected", "archived"}).
+			AddRow(0, 0, 0, 0, 0, 0)
+		mock.ExpectQuery("FILTER").WithArgs("user-123").WillReturnRows(rows)
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		counts, err := repo.GetStatusCounts(context.Background(), "user-123")
+
+		require.NoError(t, err)
+		assert.False(t, counts.HasAnyApplications)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns database error", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FILTER").WithArgs("user-123").WillReturnError(errors.New("db error"))
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		counts, err := repo.GetStatusCounts(context.Background(), "user-123")
+
+		assert.Error(t, err)
+		assert.Nil(t, counts)
+	})
+}
+
+func TestApplicationRepository_ListOffers(t *testing.T) {
+	t.Run("lists offers soonest deadline first", func(t *testing.T) {
+		var capturedSQL string
+		mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(_, actualSQL string) error {
+			capturedSQL = actualSQL
+			return nil
+		})))
+		require.NoError(t, err)
+		defer mock.Close()
+
+		companyID, companyName := "company-1", "Acme"
+		salary, equity, benefits := 120000, 0.5, "Health, 25 days PTO"
+		startDate := time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)
+		deadline := time.Date(2026, 10, 20, 17, 0, 0, 0, time.UTC)
+		rows := pgxmock.NewRows([]string{
+			"id", "name", "job_id", "title", "company_id", "company_name", "salary_currency",
+			"offered_salary", "negotiated_salary", "equity_percent", "start_date", "benefits_notes", "deadline_at",
+		}).
+			AddRow("app-1", "Acme backend", "job-1", "Backend Engineer", &companyID, &companyName, "EUR",
+				&salary, nil, &equity, &startDate, &benefits, &deadline).
+			AddRow("app-2", "Side gig", "job-2", "Consultant", nil, nil, "USD",
+				nil, nil, nil, nil, nil, nil)
+		mock.ExpectQuery("").WithArgs("user-123").WillReturnRows(rows)
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		offers, err := repo.ListOffers(context.Background(), "user-123")
+
+		require.NoError(t, err)
+		assert.Contains(t, capturedSQL, "a.status = 'offer' AND a.deleted_at IS NULL")
+		assert.Contains(t, capturedSQL, "ORDER BY a.deadline_at ASC NULLS LAST")
+		require.Len(t, offers, 2)
+		assert.Equal(t, "app-1", offers[0].ApplicationID)
+		assert.Equal(t, "Backend Engineer", offers[0].JobTitle)
+		assert.Equal(t, &companyName, offers[0].CompanyName)
+		assert.Equal(t, "EUR", offers[0].SalaryCurrency)
+		assert.Equal(t, &salary, offers[0].OfferedSalary)
+		assert.Equal(t, &equity, offers[0].EquityPercent)
+		require.NotNil(t, offers[0].StartDate)
+		assert.Equal(t, "2026-11-02", *offers[0].StartDate)
+		assert.Equal(t, &deadline, offers[0].DeadlineAt)
+		assert.Nil(t, offers[1].CompanyName)
+		assert.Nil(t, offers[1].StartDate)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an empty list without offers", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("").WithArgs("user-123").WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		offers, err := repo.ListOffers(context.Background(), "user-123")
+
+		require.NoError(t, err)
+		assert.NotNil(t, offers)
+		assert.Empty(t, offers)
+	})
+}
+
+func TestApplicationRepository_GetTotalStatusCounts(t *testing.T) {
+	var capturedSQL string
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(_, actualSQL string) error {
+		capturedSQL = actualSQL
+		return nil
+	})))
+	require.NoError(t, err)
+	defer mock.Close()
+
+	rows := pgxmock.NewRows([]string{"total", "active", "on_hold", "offer", "rejected", "archived"}).
+		AddRow(12, 5, 1, 2, 3, 1)
+	mock.ExpectQuery("").WillReturnRows(rows)
+
+	repo := NewApplicationRepositoryWithPool(mock)
+	counts, err := repo.GetTotalStatusCounts(context.Background())
+
+	require.NoError(t, err)
+	assert.NotContains(t, capturedSQL, "user_id", "counts cover all users")
+	assert.Contains(t, capturedSQL, "WHERE deleted_at IS NULL")
+	assert.Equal(t, &model.StatusCounts{
+		Total: 12, Active: 5, OnHold: 1, Offer: 2, Rejected: 3, Archived: 1,
+		HasAnyApplications: true,
+	}, counts)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplicationRepository_ListEnriched_UnknownTagName(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery("tag_relations").
+		WithArgs("user-123", "no-such-tag", 20, 0).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+	repo := NewApplicationRepositoryWithPool(mock)
+	apps, total, err := repo.ListEnriched(context.Background(), "user-123", &ports.ListOptions{
+		Limit:   20,
+		TagName: strPtr("no-such-tag"),
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, apps)
+	assert.Empty(t, apps)
+	assert.Equal(t, 0, total)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplicationRepository_UpdateResume(t *testing.T) {
+	t.Run("sets resume and clears resume builder", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`UPDATE applications SET resume_id = \$3, resume_builder_id = NULL`).
+			WithArgs("app-1", "user-123", "resume-2", pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		err = repo.UpdateResume(context.Background(), "user-123", "app-1", "resume-2")
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found when no row matches", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("UPDATE applications SET resume_id").
+			WithArgs("app-1", "other-user", "resume-2", pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		err = repo.UpdateResume(context.Background(), "other-user", "app-1", "resume-2")
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestApplicationRepository_GetStatuses(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	appIDs := []string{"app-1", "app-2", "app-3"}
+	mock.ExpectQuery(`SELECT id, status FROM applications WHERE id = ANY\(\$1::uuid\[\]\) AND user_id = \$2`).
+		WithArgs(appIDs, "user-123").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "status"}).AddRow("app-1", "active").AddRow("app-3", "offer"))
+
+	repo := NewApplicationRepositoryWithPool(mock)
+	statuses, err := repo.GetStatuses(context.Background(), "user-123", appIDs)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app-1": "active", "app-3": "offer"}, statuses)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplicationRepository_BulkUpdateStatus(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	appIDs := []string{"app-1", "app-2"}
+	mock.ExpectExec(`UPDATE applications SET status = \$1`).
+		WithArgs("archived", appIDs, "user-123", pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+
+	repo := NewApplicationRepositoryWithPool(mock)
+	updated, err := repo.BulkUpdateStatus(context.Background(), "user-123", appIDs, "archived")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), updated)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplicationRepository_SetArchiveStatus(t *testing.T) {
+	t.Run("sets status and archived_at", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		archivedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		mock.ExpectExec(`UPDATE applications SET status = \$3, archived_at = \$4`).
+			WithArgs("app-1", "user-123", "archived", &archivedAt, pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		err = repo.SetArchiveStatus(context.Background(), "user-123", "app-1", "archived", &archivedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found when no row matches", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("UPDATE applications SET status").
+			WithArgs("app-1", "other-user", "active", (*time.Time)(nil), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		err = repo.SetArchiveStatus(context.Background(), "other-user", "app-1", "active", nil)
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestApplicationRepository_SoftDelete(t *testing.T) {
+	t.Run("delete moves a live application to the trash", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`UPDATE applications SET deleted_at = NOW\(\) WHERE id = \$1 AND user_id = \$2 AND deleted_at IS NULL`).
+			WithArgs("app-1", "user-123").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		err = repo.Delete(context.Background(), "user-123", "app-1")
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("delete returns not found for a trashed application", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("UPDATE applications SET deleted_at = NOW").
+			WithArgs("app-1", "user-123").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		err = repo.Delete(context.Background(), "user-123", "app-1")
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("restore clears deleted_at of a trashed application", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`UPDATE applications SET deleted_at = NULL WHERE id = \$1 AND user_id = \$2 AND deleted_at IS NOT NULL`).
+			WithArgs("app-1", "user-123").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		err = repo.Restore(context.Background(), "user-123", "app-1")
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("restore returns not found outside the trash", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("UPDATE applications SET deleted_at = NULL").
+			WithArgs("app-1", "user-123").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		err = repo.Restore(context.Background(), "user-123", "app-1")
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("permanent delete removes the row", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`DELETE FROM applications WHERE id = \$1 AND user_id = \$2\s+RETURNING CASE WHEN cover_letter_storage_type = 's3' THEN cover_letter_url END`).
+			WithArgs("app-1", "user-123").
+			WillReturnRows(pgxmock.NewRows([]string{"cover_letter_url"}).AddRow(strPtr("cover_letters/user-123/app-1/letter.pdf")))
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		key, err := repo.DeletePermanently(context.Background(), "user-123", "app-1")
+
+		require.NoError(t, err)
+		require.NotNil(t, key)
+		assert.Equal(t, "cover_letters/user-123/app-1/letter.pdf", *key)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("permanent delete returns not found when no row matches", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("DELETE FROM applications").
+			WithArgs("app-1", "other-user").
+			WillReturnError(pgx.ErrNoRows)
+
+		repo := NewApplicationRepositoryWithPool(mock)
+		_, err = repo.DeletePermanently(context.Background(), "other-user", "app-1")
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestStageTemplateRepository_GetUsageStats(t *testing.T) {
+	t.Run("includes templates with zero uses", func(t *testing.T) {
+		var capturedSQL string
+		mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(expectedSQL, actualSQL string) error {
+			capturedSQL = actualSQL
+			return nil
+		})))
+		require.NoError(t, err)
+		defer mock.Close()
+
+		lastUsed := time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC)
+		mock.ExpectQuery("").
+			WithArgs("user-123").
+			WillReturnRows(pgxmock.NewRows([]string{"id", "name", "total_uses", "active_uses", "last_used_at"}).
+				AddRow("tpl-1", "Phone Screen", 7, 2, &lastUsed).
+				AddRow("tpl-2", "Take-Home", 0, 0, nil))
+
+		repo := NewStageTemplateRepositoryWithPool(mock)
+		stats, err := repo.GetUsageStats(context.Background(), "user-123")
+
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+		assert.Equal(t, "tpl-1", stats[0].TemplateID)
+		assert.Equal(t, 7, stats[0].TotalUses)
+		assert.Equal(t, 2, stats[0].ActiveUses)
+		require.NotNil(t, stats[0].LastUsedAt)
+		assert.Equal(t, "tpl-2", stats[1].TemplateID)
+		assert.Equal(t, 0, stats[1].TotalUses)
+		assert.Nil(t, stats[1].LastUsedAt)
+
+		normalized := strings.Join(strings.Fields(capturedSQL), " ")
+		assert.Contains(t, normalized, "FROM stage_templates st LEFT JOIN application_stages ast ON ast.stage_template_id = st.id")
+		assert.Contains(t, normalized, "ORDER BY total_uses DESC")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns empty list when user has no templates", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM stage_templates st").
+			WithArgs("user-123").
+			WillReturnRows(pgxmock.NewRows([]string{"id", "name", "total_uses", "active_uses", "last_used_at"}))
+
+		repo := NewStageTemplateRepositoryWithPool(mock)
+		stats, err := repo.GetUsageStats(context.Background(), "user-123")
+
+		require.NoError(t, err)
+		assert.NotNil(t, stats)
+		assert.Empty(t, stats)
+	})
+}
+
+func TestStageTemplateRepository_MergeInto(t *testing.T) {
+	t.Run("moves stages and deletes the merged template", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs("user-123", "tpl-1", "tpl-2").
+			WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectExec("UPDATE application_stages SET stage_template_id").
+			WithArgs("tpl-1", "tpl-2").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 5))
+		mock.ExpectExec("DELETE FROM stage_templates").
+			WithArgs("tpl-1", "user-123").
+			WillReturnResult(pgxmock.NewResult("DELETE", 1))
+		mock.ExpectCommit()
+
+		repo := NewStageTemplateRepositoryWithPool(mock)
+		merged, err := repo.MergeInto(context.Background(), "tpl-1", "tpl-2", "user-123")
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, merged)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found when a template belongs to another user", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs("user-123", "tpl-1", "tpl-2").
+			WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectRollback()
+
+		repo := NewStageTemplateRepositoryWithPool(mock)
+		merged, err := repo.MergeInto(context.Background(), "tpl-1", "tpl-2", "user-123")
+
+		assert.ErrorIs(t, err, model.ErrStageTemplateNotFound)
+		assert.Zero(t, merged)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back when moving stages fails", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs("user-123", "tpl-1", "tpl-2").
+			WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectExec("UPDATE application_stages").
+			WithArgs("tpl-1", "tpl-2").
+			WillReturnError(errors.New("db down"))
+		mock.ExpectRollback()
+
+		repo := NewStageTemplateRepositoryWithPool(mock)
+		_, err = repo.MergeInto(context.Background(), "tpl-1", "tpl-2", "user-123")
+
+		assert.Error(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestStageTemplateRepository_Reorder(t *testing.T) {
+	columns := []string{"id", "user_id", "name", "description", "order", "created_at", "updated_at"}
+
+	expectOwned := func(mock pgxmock.PgxPoolIface, ids ...string) {
+		rows := pgxmock.NewRows([]string{"id"})
+		for _, id := range ids {
+			rows.AddRow(id)
+		}
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs("user-123").
+			WillReturnRows(rows)
+	}
+
+	t.Run("sets orders from list positions in one update", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		expectOwned(mock, "tpl-1", "tpl-2", "tpl-3")
+		mock.ExpectQuery(`UPDATE stage_templates SET "order" = CASE id WHEN \$3 THEN 1 WHEN \$4 THEN 2 WHEN \$5 THEN 3 END`).
+			WithArgs("user-123", pgxmock.AnyArg(), "tpl-3", "tpl-1", "tpl-2").
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("tpl-1", "user-123", "Applied", nil, 2, now, now).
+				AddRow("tpl-2", "user-123", "Interview", nil, 3, now, now).
+				AddRow("tpl-3", "user-123", "Screening", nil, 1, now, now))
+		mock.ExpectCommit()
+
+		repo := NewStageTemplateRepositoryWithPool(mock)
+		templates, err := repo.Reorder(context.Background(), "user-123", []string{"tpl-3", "tpl-1", "tpl-2"})
+
+		require.NoError(t, err)
+		require.Len(t, templates, 3)
+		assert.Equal(t, "tpl-3", templates[0].ID)
+		assert.Equal(t, "tpl-1", templates[1].ID)
+		assert.Equal(t, "tpl-2", templates[2].ID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found for a template of another user", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		expectOwned(mock, "tpl-1", "tpl-2")
+		mock.ExpectRollback()
+
+		repo := NewStageTemplateRepositoryWithPool(mock)
+		_, err = repo.Reorder(context.Background(), "user-123", []string{"tpl-2", "tpl-other"})
+
+		assert.ErrorIs(t, err, model.ErrStageTemplateNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects a list missing a template", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		expectOwned(mock, "tpl-1", "tpl-2", "tpl-3")
+		mock.ExpectRollback()
+
+		repo := NewStageTemplateRepositoryWithPool(mock)
+		_, err = repo.Reorder(context.Background(), "user-123", []string{"tpl-2", "tpl-1"})
+
+		assert.ErrorIs(t, err, model.ErrIncompleteTemplateOrder)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects a template listed twice", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		expectOwned(mock, "tpl-1", "tpl-2")
+		mock.ExpectRollback()
+
+		repo := NewStageTemplateRepositoryWithPool(mock)
+		_, err = repo.Reorder(context.Background(), "user-123", []string{"tpl-1", "tpl-2", "tpl-1"})
+
+		assert.ErrorIs(t, err, model.ErrIncompleteTemplateOrder)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -12,37 +12,42 @@ import (
 )
 
 type ApplicationStageRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 func NewApplicationStageRepository(pool *pgxpool.Pool) *ApplicationStageRepository {
 	return &ApplicationStageRepository{pool: pool}
 }
 
+// NewApplicationStageRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewApplicationStageRepositoryWithPool(pool DBPool) *ApplicationStageRepository {
+	return &ApplicationStageRepository{pool: pool}
+}
+
 func (r *ApplicationStageRepository) Create(ctx context.Context, stage *model.ApplicationStage) error {
 	query := `
-		INSERT INTO application_stages (id, application_id, stage_template_id, status, "order", started_at, completed_at, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO application_stages (id, application_id, stage_template_id, status, "order", started_at, completed_at, notes, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
 
 	stage.ID = uuid.New().String()
 	stage.CreatedAt = time.Now().UTC()
 
 	_, err := r.pool.Exec(ctx, query,
-		stage.ID, stage.ApplicationID, stage.StageTemplateID, stage.Status, stage.Order, stage.StartedAt, stage.CompletedAt, stage.CreatedAt,
+		stage.ID, stage.ApplicationID, stage.StageTemplateID, stage.Status, stage.Order, stage.StartedAt, stage.CompletedAt, stage.Notes, stage.CreatedAt,
 	)
 	return err
 }
 
 func (r *ApplicationStageRepository) GetByID(ctx context.Context, stageID string) (*model.ApplicationStage, error) {
 	query := `
-		SELECT id, application_id, stage_template_id, status, "order", started_at, completed_at, created_at
+		SELECT id, application_id, stage_template_id, status, "order", started_at, completed_at, notes, created_at
 		FROM application_stages WHERE id = $1
 	`
 
 	stage := &model.ApplicationStage{}
 	err := r.pool.QueryRow(ctx, query, stageID).Scan(
-		&stage.ID, &stage.ApplicationID, &stage.StageTemplateID, &stage.Status, &stage.Order, &stage.StartedAt, &stage.CompletedAt, &stage.CreatedAt,
+		&stage.ID, &stage.ApplicationID, &stage.StageTemplateID, &stage.Status, &stage.Order, &stage.StartedAt, &stage.CompletedAt, &stage.Notes, &stage.CreatedAt,
 	)
 
 	if err != nil {
@@ -56,7 +61,7 @@ func (r *ApplicationStageRepository) GetByID(ctx context.Context, stageID string
 
 func (r *ApplicationStageRepository) ListByApplication(ctx context.Context, appID string) ([]*model.ApplicationStage, error) {
 	query := `
-		SELECT id, application_id, stage_template_id, status, "order", started_at, completed_at, created_at
+		SELECT id, application_id, stage_template_id, status, "order", started_at, completed_at, notes, created_at
 		FROM application_stages WHERE application_id = $1 ORDER BY "order" ASC, created_at ASC
 	`
 
@@ -69,7 +74,7 @@ func (r *ApplicationStageRepository) ListByApplication(ctx context.Context, appI
 	var stages []*model.ApplicationStage
 	for rows.Next() {
 		stage := &model.ApplicationStage{}
-		if err := rows.Scan(&stage.ID, &stage.ApplicationID, &stage.StageTemplateID, &stage.Status, &stage.Order, &stage.StartedAt, &stage.CompletedAt, &stage.CreatedAt); err != nil {
+		if err := rows.Scan(&stage.ID, &stage.ApplicationID, &stage.StageTemplateID, &stage.Status, &stage.Order, &stage.StartedAt, &stage.CompletedAt, &stage.Notes, &stage.CreatedAt); err != nil {
 			return nil, err
 		}
 		stages = append(stages, stage)
@@ -79,11 +84,11 @@ func (r *ApplicationStageRepository) ListByApplication(ctx context.Context, appI
 
 func (r *ApplicationStageRepository) Update(ctx context.Context, stage *model.ApplicationStage) error {
 	query := `
-		UPDATE application_stages SET status = $2, completed_at = $3
+		UPDATE application_stages SET status = $2, completed_at = $3, notes = $4
 		WHERE id = $1
 	`
 
-	result, err := r.pool.Exec(ctx, query, stage.ID, stage.Status, stage.CompletedAt)
+	result, err := r.pool.Exec(ctx, query, stage.ID, stage.Status, stage.CompletedAt, stage.Notes)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var applicationStageColumns = []string{"id", "application_id", "stage_template_id", "status", "order", "started_at", "completed_at", "notes", "created_at"}
+
+func TestApplicationStageRepository_Create(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	startedAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	mock.ExpectExec("INSERT INTO application_stages").
+		WithArgs(pgxmock.AnyArg(), "app-1", "tpl-1", "active", 1, startedAt, (*time.Time)(nil), (*string)(nil), pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	stage := &model.ApplicationStage{ApplicationID: "app-1", StageTemplateID: "tpl-1", Status: "active", Order: 1, StartedAt: startedAt}
+	err = NewApplicationStageRepositoryWithPool(mock).Create(context.Background(), stage)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, stage.ID)
+	assert.False(t, stage.CreatedAt.IsZero())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplicationStageRepository_GetByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(q *pgxmock.ExpectedQuery)
+		wantErr error
+	}{
+		{
+			name: "returns the stage",
+			setup: func(q *pgxmock.ExpectedQuery) {
+				q.WillReturnRows(pgxmock.NewRows(applicationStageColumns).
+					AddRow("stage-1", "app-1", "tpl-1", "active", 2, time.Now(), nil, nil, time.Now()))
+			},
+		},
+		{
+			name:    "maps missing rows to ErrApplicationStageNotFound",
+			setup:   func(q *pgxmock.ExpectedQuery) { q.WillReturnError(pgx.ErrNoRows) },
+			wantErr: model.ErrApplicationStageNotFound,
+		},
+		{
+			name:    "propagates query errors",
+			setup:   func(q *pgxmock.ExpectedQuery) { q.WillReturnError(assert.AnError) },
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setup(mock.ExpectQuery(`FROM application_stages WHERE id = \$1`).WithArgs("stage-1"))
+
+			stage, err := NewApplicationStageRepositoryWithPool(mock).GetByID(context.Background(), "stage-1")
+
+			if tt.wantErr != nil {
+				assert.Nil(t, stage)
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, 2, stage.Order)
+				assert.Nil(t, stage.CompletedAt)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestApplicationStageRepository_ListByApplication(t *testing.T) {
+	t.Run("returns stages in order", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		notes := "phone screen"
+		mock.ExpectQuery(`FROM application_stages WHERE application_id = \$1 ORDER BY "order" ASC, created_at ASC`).
+			WithArgs("app-1").
+			WillReturnRows(pgxmock.NewRows(applicationStageColumns).
+				AddRow("stage-1", "app-1", "tpl-1", "completed", 1, now, &now, &notes, now).
+				AddRow("stage-2", "app-1", "tpl-2", "active", 2, now, nil, nil, now))
+
+		stages, err := NewApplicationStageRepositoryWithPool(mock).ListByApplication(context.Background(), "app-1")
+
+		require.NoError(t, err)
+		require.Len(t, stages, 2)
+		assert.Equal(t, "stage-1", stages[0].ID)
+		require.NotNil(t, stages[0].Notes)
+		assert.Equal(t, notes, *stages[0].Notes)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM application_stages").
+			WithArgs("app-1").
+			WillReturnError(assert.AnError)
+
+		stages, err := NewApplicationStageRepositoryWithPool(mock).ListByApplication(context.Background(), "app-1")
+
+		assert.Nil(t, stages)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestApplicationStageRepository_Update(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	completedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	stage := &model.ApplicationStage{ID: "stage-1", Status: "completed", CompletedAt: &completedAt}
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`UPDATE application_stages SET status = \$2, completed_at = \$3, notes = \$4\s+WHERE id = \$1`).
+			WithArgs("stage-1", "completed", &completedAt, (*string)(nil)).
+			WillReturnResult(pgxmock.NewResult("UPDATE", result))
+	}
+	mock.ExpectExec("UPDATE application_stages").
+		WithArgs("stage-1", "completed", &completedAt, (*string)(nil)).
+		WillReturnError(assert.AnError)
+
+	repo := NewApplicationStageRepositoryWithPool(mock)
+	assert.NoError(t, repo.Update(context.Background(), stage))
+	assert.ErrorIs(t, repo.Update(context.Background(), stage), model.ErrApplicationStageNotFound)
+	assert.ErrorIs(t, repo.Update(context.Background(), stage), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplicationStageRepository_Delete(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`DELETE FROM application_stages WHERE id = \$1`).
+			WithArgs("stage-1").
+			WillReturnResult(pgxmock.NewResult("DELETE", result))
+	}
+	mock.ExpectExec("DELETE FROM application_stages").
+		WithArgs("stage-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewApplicationStageRepositoryWithPool(mock)
+	assert.NoError(t, repo.Delete(context.Background(), "stage-1"))
+	assert.ErrorIs(t, repo.Delete(context.Background(), "stage-1"), model.ErrApplicationStageNotFound)
+	assert.ErrorIs(t, repo.Delete(context.Background(), "stage-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/andreypavlenko/jobber/modules/applications/model"
@@ -13,17 +15,22 @@ import (
 )
 
 type StageTemplateRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 func NewStageTemplateRepository(pool *pgxpool.Pool) *StageTemplateRepository {
 	return &StageTemplateRepository{pool: pool}
 }
 
+// NewStageTemplateRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewStageTemplateRepositoryWithPool(pool DBPool) *StageTemplateRepository {
+	return &StageTemplateRepository{pool: pool}
+}
+
 func (r *StageTemplateRepository) Create(ctx context.Context, template *model.StageTemplate) error {
 	query := `
-		INSERT INTO stage_templates (id, user_id, name, "order", created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO stage_templates (id, user_id, name, description, "order", created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
 	template.ID = uuid.New().String()
@@ -31,19 +38,19 @@ func (r *StageTemplateRepository) Create(ctx context.Context, template *model.St
 	template.CreatedAt = now
 	template.UpdatedAt = now
 
-	_, err := r.pool.Exec(ctx, query, template.ID, template.UserID, template.Name, template.Order, template.CreatedAt, template.UpdatedAt)
+	_, err := r.pool.Exec(ctx, query, template.ID, template.UserID, template.Name, template.Description, template.Order, template.CreatedAt, template.UpdatedAt)
 	return err
 }
 
 func (r *StageTemplateRepository) GetByID(ctx context.Context, userID, templateID string) (*model.StageTemplate, error) {
 	query := `
-		SELECT id, user_id, name, "order", created_at, updated_at
+		SELECT id, user_id, name, description, "order", created_at, updated_at
 		FROM stage_templates WHERE id = $1 AND user_id = $2
 	`
 
 	template := &model.StageTemplate{}
 	err := r.pool.QueryRow(ctx, query, templateID, userID).Scan(
-		&template.ID, &template.UserID, &template.Name, &template.Order, &template.CreatedAt, &template.UpdatedAt,
+		&template.ID, &template.UserID, &template.Name, &template.Description, &template.Order, &template.CreatedAt, &template.UpdatedAt,
 	)
 
 	if err != nil {
@@ -65,7 +72,7 @@ func (r *StageTemplateRepository) List(ctx context.Context, userID string, limit
 
 	// Get paginated results
 	query := `
-		SELECT id, user_id, name, "order", created_at, updated_at
+		SELECT id, user_id, name, description, "order", created_at, updated_at
 		FROM stage_templates WHERE user_id = $1 ORDER BY "order" ASC
 		LIMIT $2 OFFSET $3
 	`
@@ -79,7 +86,7 @@ func (r *StageTemplateRepository) List(ctx context.Context, userID string, limit
 	var templates []*model.StageTemplate
 	for rows.Next() {
 		template := &model.StageTemplate{}
-		if err := rows.Scan(&template.ID, &template.UserID, &template.Name, &template.Order, &template.CreatedAt, &template.UpdatedAt); err != nil {
+		if err := rows.Scan(&template.ID, &template.UserID, &template.Name, &template.Description, &template.Order, &template.CreatedAt, &template.UpdatedAt); err != nil {
 			return nil, 0, err
 		}
 		templates = append(templates, template)
@@ -89,12 +96,12 @@ func (r *StageTemplateRepository) List(ctx context.Context, userID string, limit
 
 func (r *StageTemplateRepository) Update(ctx context.Context, template *model.StageTemplate) error {
 	query := `
-		UPDATE stage_templates SET name = $3, "order" = $4, updated_at = $5
+		UPDATE stage_templates SET name = $3, description = $4, "order" = $5, updated_at = $6
 		WHERE id = $1 AND user_id = $2
 	`
 
 	template.UpdatedAt = time.Now().UTC()
-	result, err := r.pool.Exec(ctx, query, template.ID, template.UserID, template.Name, template.Order, template.UpdatedAt)
+	result, err := r.pool.Exec(ctx, query, template.ID, template.UserID, template.Name, template.Description, template.Order, template.UpdatedAt)
 	if err != nil {
 		return err
 	}
@@ -119,3 +126,156 @@ func (r *StageTemplateRepository) Delete(ctx context.Context, userID, templateID
 	}
 	return nil
 }
+
+// MergeInto moves every application stage from one template to another and
+// deletes the emptied template, in a single transaction. Both templates must
+// belong to the user. Returns the number of stages moved.
+func (r *StageTemplateRepository) MergeInto(ctx context.Context, fromID, intoID, userID string) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // rollback is a no-op after commit
+
+	// Lock both templates so neither is changed or deleted mid-merge
+	var owned int
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM (
+			SELECT id FROM stage_templates WHERE user_id = $1 AND id IN ($2, $3) FOR UPDATE
+		) t`,
+		userID, fromID, intoID,
+	).Scan(&owned)
+	if err != nil {
+		return 0, err
+	}
+	if owned != 2 {
+		return 0, model.ErrStageTemplateNotFound
+	}
+
+	result, err := tx.Exec(ctx,
+		`UPDATE application_stages SET stage_template_id = $2 WHERE stage_template_id = $1`,
+		fromID, intoID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to move stages: %w", err)
+	}
+	merged := int(result.RowsAffected())
+
+	if _, err := tx.Exec(ctx, `DELETE FROM stage_templates WHERE id = $1 AND user_id = $2`, fromID, userID); err != nil {
+		return 0, fmt.Errorf("failed to delete merged template: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return merged, nil
+}
+
+// Reorder gives each of the user's templates the order of its position in
+// templateIDs, starting at 1, with a single update in a transaction. The list
+// must name every template of the user exactly once: unknown IDs return
+// ErrStageTemplateNotFound and missing or repeated ones ErrIncompleteTemplateOrder.
+// Returns the templates in their new order.
+func (r *StageTemplateRepository) Reorder(ctx context.Context, userID string, templateIDs []string) ([]*model.StageTemplate, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // rollback is a no-op after commit
+
+	// Lock the user's templates so none is added or deleted mid-reorder
+	rows, err := tx.Query(ctx, `SELECT id FROM stage_templates WHERE user_id = $1 FOR UPDATE`, userID)
+	if err != nil {
+		return nil, err
+	}
+	owned := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		owned[id] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	listed := make(map[string]bool, len(templateIDs))
+	for _, id := range templateIDs {
+		if !owned[id] {
+			return nil, model.ErrStageTemplateNotFound
+		}
+		listed[id] = true
+	}
+	if len(listed) != len(templateIDs) || len(listed) != len(owned) {
+		return nil, model.ErrIncompleteTemplateOrder
+	}
+
+	args := []any{userID, time.Now().UTC()}
+	var cases strings.Builder
+	for i, id := range templateIDs {
+		args = append(args, id)
+		fmt.Fprintf(&cases, " WHEN $%d THEN %d", len(args), i+1)
+	}
+	query := `
+		UPDATE stage_templates SET "order" = CASE id` + cases.String() + ` END, updated_at = $2
+		WHERE user_id = $1
+		RETURNING id, user_id, name, description, "order", created_at, updated_at
+	`
+	rows, err = tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update template order: %w", err)
+	}
+	templates := make([]*model.StageTemplate, len(templateIDs))
+	for rows.Next() {
+		template := &model.StageTemplate{}
+		if err := rows.Scan(&template.ID, &template.UserID, &template.Name, &template.Description, &template.Order, &template.CreatedAt, &template.UpdatedAt); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		templates[template.Order-1] = template
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to update template order: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return templates, nil
+}
+
+// GetUsageStats aggregates application stages per template. Templates are the
+// driving side of a LEFT JOIN so unused templates are reported with zero uses.
+func (r *StageTemplateRepository) GetUsageStats(ctx context.Context, userID string) ([]*model.StageTemplateUsage, error) {
+	query := `
+		SELECT st.id, st.name,
+			COUNT(ast.id) AS total_uses,
+			COUNT(ast.id) FILTER (WHERE ast.status = 'active') AS active_uses,
+			MAX(ast.started_at) AS last_used_at
+		FROM stage_templates st
+		LEFT JOIN application_stages ast ON ast.stage_template_id = st.id
+		WHERE st.user_id = $1
+		GROUP BY st.id, st.name
+		ORDER BY total_uses DESC, st.name ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := []*model.StageTemplateUsage{}
+	for rows.Next() {
+		u := &model.StageTemplateUsage{}
+		if err := rows.Scan(&u.TemplateID, &u.TemplateName, &u.TotalUses, &u.ActiveUses, &u.LastUsedAt); err != nil {
+			return nil, err
+		}
+		stats = append(stats, u)
+	}
+	return stats, rows.Err()
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var stageTemplateColumns = []string{"id", "user_id", "name", "description", "order", "created_at", "updated_at"}
+
+func TestStageTemplateRepository_Create(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec("INSERT INTO stage_templates").
+		WithArgs(pgxmock.AnyArg(), "user-1", "Onsite", (*string)(nil), 3, pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	template := &model.StageTemplate{UserID: "user-1", Name: "Onsite", Order: 3}
+	err = NewStageTemplateRepositoryWithPool(mock).Create(context.Background(), template)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, template.ID)
+	assert.Equal(t, template.CreatedAt, template.UpdatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStageTemplateRepository_GetByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(q *pgxmock.ExpectedQuery)
+		wantErr error
+	}{
+		{
+			name: "returns the user's template",
+			setup: func(q *pgxmock.ExpectedQuery) {
+				q.WillReturnRows(pgxmock.NewRows(stageTemplateColumns).
+					AddRow("tpl-1", "user-1", "Onsite", nil, 3, time.Now(), time.Now()))
+			},
+		},
+		{
+			name:    "maps missing rows to ErrStageTemplateNotFound",
+			setup:   func(q *pgxmock.ExpectedQuery) { q.WillReturnError(pgx.ErrNoRows) },
+			wantErr: model.ErrStageTemplateNotFound,
+		},
+		{
+			name:    "propagates query errors",
+			setup:   func(q *pgxmock.ExpectedQuery) { q.WillReturnError(assert.AnError) },
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setup(mock.ExpectQuery(`FROM stage_templates WHERE id = \$1 AND user_id = \$2`).WithArgs("tpl-1", "user-1"))
+
+			template, err := NewStageTemplateRepositoryWithPool(mock).GetByID(context.Background(), "user-1", "tpl-1")
+
+			if tt.wantErr != nil {
+				assert.Nil(t, template)
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "Onsite", template.Name)
+				assert.Equal(t, 3, template.Order)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestStageTemplateRepository_List(t *testing.T) {
+	t.Run("returns a page with the total", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM stage_templates WHERE user_id = \$1`).
+			WithArgs("user-1").
+			WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(5))
+		mock.ExpectQuery(`FROM stage_templates WHERE user_id = \$1 ORDER BY "order" ASC\s+LIMIT \$2 OFFSET \$3`).
+			WithArgs("user-1", 2, 0).
+			WillReturnRows(pgxmock.NewRows(stageTemplateColumns).
+				AddRow("tpl-1", "user-1", "Applied", nil, 1, now, now).
+				AddRow("tpl-2", "user-1", "Screen", nil, 2, now, now))
+
+		templates, total, err := NewStageTemplateRepositoryWithPool(mock).List(context.Background(), "user-1", 2, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, total)
+		require.Len(t, templates, 2)
+		assert.Equal(t, "tpl-1", templates[0].ID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates count errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("SELECT COUNT").
+			WithArgs("user-1").
+			WillReturnError(assert.AnError)
+
+		templates, total, err := NewStageTemplateRepositoryWithPool(mock).List(context.Background(), "user-1", 2, 0)
+
+		assert.Nil(t, templates)
+		assert.Zero(t, total)
+		assert.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates page query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("SELECT COUNT").
+			WithArgs("user-1").
+			WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(5))
+		mock.ExpectQuery("FROM stage_templates").
+			WithArgs("user-1", 2, 0).
+			WillReturnError(assert.AnError)
+
+		templates, _, err := NewStageTemplateRepositoryWithPool(mock).List(context.Background(), "user-1", 2, 0)
+
+		assert.Nil(t, templates)
+		assert.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestStageTemplateRepository_Update(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	template := &model.StageTemplate{ID: "tpl-1", UserID: "user-1", Name: "Onsite", Order: 4}
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`UPDATE stage_templates SET name = \$3, description = \$4, "order" = \$5, updated_at = \$6\s+WHERE id = \$1 AND user_id = \$2`).
+			WithArgs("tpl-1", "user-1", "Onsite", (*string)(nil), 4, pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", result))
+	}
+	mock.ExpectExec("UPDATE stage_templates").
+		WithArgs("tpl-1", "user-1", "Onsite", (*string)(nil), 4, pgxmock.AnyArg()).
+		WillReturnError(assert.AnError)
+
+	repo := NewStageTemplateRepositoryWithPool(mock)
+	require.NoError(t, repo.Update(context.Background(), template))
+	assert.False(t, template.UpdatedAt.IsZero())
+	assert.ErrorIs(t, repo.Update(context.Background(), template), model.ErrStageTemplateNotFound)
+	assert.ErrorIs(t, repo.Update(context.Background(), template), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStageTemplateRepository_Delete(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`DELETE FROM stage_templates WHERE id = \$1 AND user_id = \$2`).
+			WithArgs("tpl-1", "user-1").
+			WillReturnResult(pgxmock.NewResult("DELETE", result))
+	}
+	mock.ExpectExec("DELETE FROM stage_templates").
+		WithArgs("tpl-1", "user-1").
+		WillReturnError(&pgconn.PgError{Code: "23503"})
+	mock.ExpectExec("DELETE FROM stage_templates").
+		WithArgs("tpl-1", "user-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewStageTemplateRepositoryWithPool(mock)
+	assert.NoError(t, repo.Delete(context.Background(), "user-1", "tpl-1"))
+	assert.ErrorIs(t, repo.Delete(context.Background(), "user-1", "tpl-1"), model.ErrStageTemplateNotFound)
+	assert.ErrorIs(t, repo.Delete(context.Background(), "user-1", "tpl-1"), model.ErrStageTemplateInUse)
+	assert.ErrorIs(t, repo.Delete(context.Background(), "user-1", "tpl-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/andreypavlenko/jobber/modules/applications/ports"
+	commentRepo "github.com/andreypavlenko/jobber/modules/comments/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+// NewTxRepositories binds the application, stage, comment and event repositories to tx
+func NewTxRepositories(tx pgx.Tx) *ports.TxRepositories {
+	return &ports.TxRepositories{
+		Applications: NewApplicationRepositoryWithPool(tx),
+		Stages:       &ApplicationStageRepository{pool: tx},
+		Comments:     commentRepo.NewCommentRepositoryWithPool(tx),
+		Events:       NewApplicationEventRepositoryWithPool(tx),
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/andreypavlenko/jobber/modules/applications/ports"
+	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
+	"go.uber.org/zap"
+)
+
+// Clone copies an application, e.g. to apply to another role at the same company.
+// The copy keeps the resume and the stage templates of the original, each as a
+// pending stage; it starts active and applied now. Tags, comments and the cover
+// letter are not copied.
+func (s *ApplicationService) Clone(ctx context.Context, userID, appID string, req *model.CloneApplicationRequest) (*model.ApplicationDTO, error) {
+	original, err := s.appRepo.GetByID(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.limitChecker != nil {
+		if err := s.limitChecker.CheckLimit(ctx, userID, "applications"); err != nil {
+			return nil, err
+		}
+	}
+
+	name := strings.TrimSpace(req.Name)
+	jobID := original.JobID
+	if req.JobID != "" && req.JobID != original.JobID {
+		job, err := s.jobRepo.GetByID(ctx, userID, req.JobID)
+		if err != nil {
+			if errors.Is(err, jobModel.ErrJobNotFound) {
+				return nil, model.ErrJobNotFound
+			}
+			return nil, err
+		}
+		jobID = job.ID
+		if name == "" {
+			name = job.Title
+		}
+	}
+	if name == "" {
+		name = original.Name
+	}
+
+	stages, err := s.stageRepo.ListByApplication(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	clone := &model.Application{
+		UserID:          userID,
+		JobID:           jobID,
+		ResumeID:        original.ResumeID,
+		ResumeBuilderID: original.ResumeBuilderID,
+		Name:            name,
+		Status:          string(model.StatusActive),
+		AppliedAt:       now,
+	}
+
+	err = s.inTransaction(ctx, func(repos *ports.TxRepositories) error {
+		if err := repos.Applications.Create(ctx, clone); err != nil {
+			return err
+		}
+		for _, stage := range stages {
+			err := repos.Stages.Create(ctx, &model.ApplicationStage{
+				ApplicationID:   clone.ID,
+				StageTemplateID: stage.StageTemplateID,
+				Status:          "pending",
+				Order:           stage.Order,
+				StartedAt:       now,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return recordEvent(ctx, repos.Events, userID, clone.ID, model.EventApplicationCreated, map[string]any{
+			"name":        clone.Name,
+			"job_id":      clone.JobID,
+			"status":      clone.Status,
+			"applied_at":  clone.AppliedAt,
+			"cloned_from": original.ID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateProfile(ctx, userID)
+	s.invalidateAnalytics(ctx, userID)
+
+	s.log.Info("application cloned",
+		zap.String("application_id", clone.ID),
+		zap.String("cloned_from", original.ID),
+		zap.Int("stages", len(stages)),
+		zap.String("user_id", userID))
+
+	return s.buildApplicationDTO(ctx, userID, clone)
+}
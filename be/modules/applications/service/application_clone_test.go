@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_Clone(t *testing.T) {
+	userID := "user-123"
+	resumeID := "resume-1"
+
+	setup := func() (*ApplicationService, *MockApplicationRepository, *MockStageRepository, *MockJobRepository, *[]*model.Application, *[]*model.ApplicationStage) {
+		svc, appRepo, stageRepo, _, jobRepo, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			if aid != "app-1" {
+				return nil, model.ErrApplicationNotFound
+			}
+			return &model.Application{
+				ID:       aid,
+				UserID:   uid,
+				JobID:    "job-1",
+				ResumeID: &resumeID,
+				Name:     "Backend Engineer",
+				Status:   "rejected",
+			}, nil
+		}
+		var created []*model.Application
+		appRepo.CreateFunc = func(_ context.Context, app *model.Application) error {
+			app.ID = "app-2"
+			created = append(created, app)
+			return nil
+		}
+		stageRepo.ListByApplicationFunc = func(_ context.Context, aid string) ([]*model.ApplicationStage, error) {
+			if aid != "app-1" {
+				return nil, nil
+			}
+			return []*model.ApplicationStage{
+				{ID: "stage-1", StageTemplateID: "tpl-1", Status: "completed", Order: 1},
+				{ID: "stage-2", StageTemplateID: "tpl-2", Status: "active", Order: 2},
+			}, nil
+		}
+		var stages []*model.ApplicationStage
+		stageRepo.CreateFunc = func(_ context.Context, stage *model.ApplicationStage) error {
+			stages = append(stages, stage)
+			return nil
+		}
+		jobRepo.GetByIDFunc = func(_ context.Context, _, jid string) (*jobModel.Job, error) {
+			if jid == "job-missing" {
+				return nil, jobModel.ErrJobNotFound
+			}
+			return &jobModel.Job{ID: jid, Title: "Staff Engineer"}, nil
+		}
+		return svc, appRepo, stageRepo, jobRepo, &created, &stages
+	}
+
+	t.Run("copies the resume and stage templates as pending stages", func(t *testing.T) {
+		svc, _, _, _, created, stages := setup()
+
+		dto, err := svc.Clone(context.Background(), userID, "app-1", &model.CloneApplicationRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "app-2", dto.ID)
+		require.Len(t, *created, 1)
+		clone := (*created)[0]
+		assert.Equal(t, "job-1", clone.JobID)
+		assert.Equal(t, "Backend Engineer", clone.Name)
+		assert.Equal(t, "active", clone.Status)
+		assert.Equal(t, &resumeID, clone.ResumeID)
+		assert.False(t, clone.AppliedAt.IsZero())
+
+		require.Len(t, *stages, 2)
+		for i, stage := range *stages {
+			assert.Equal(t, "app-2", stage.ApplicationID)
+			assert.Equal(t, "pending", stage.Status)
+			assert.Equal(t, i+1, stage.Order)
+			assert.Nil(t, stage.CompletedAt)
+		}
+		assert.Equal(t, "tpl-1", (*stages)[0].StageTemplateID)
+		assert.Equal(t, "tpl-2", (*stages)[1].StageTemplateID)
+	})
+
+	t.Run("names the clone after the new job", func(t *testing.T) {
+		svc, _, _, _, created, _ := setup()
+
+		_, err := svc.Clone(context.Background(), userID, "app-1", &model.CloneApplicationRequest{JobID: "job-2"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "job-2", (*created)[0].JobID)
+		assert.Equal(t, "Staff Engineer", (*created)[0].Name)
+	})
+
+	t.Run("keeps an explicit name", func(t *testing.T) {
+		svc, _, _, _, created, _ := setup()
+
+		_, err := svc.Clone(context.Background(), userID, "app-1", &model.CloneApplicationRequest{JobID: "job-2", Name: "  Platform team  "})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Platform team", (*created)[0].Name)
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		svc, _, _, _, created, _ := setup()
+
+		_, err := svc.Clone(context.Background(), userID, "app-1", &model.CloneApplicationRequest{JobID: "job-missing"})
+
+		assert.ErrorIs(t, err, model.ErrJobNotFound)
+		assert.Empty(t, *created)
+	})
+
+	t.Run("unknown application", func(t *testing.T) {
+		svc, _, _, _, created, _ := setup()
+
+		_, err := svc.Clone(context.Background(), userID, "app-missing", &model.CloneApplicationRequest{})
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+		assert.Empty(t, *created)
+	})
+
+	t.Run("records the creation with its origin", func(t *testing.T) {
+		svc, _, _, _, _, _ := setup()
+		mock, events := withEventLog(t, svc)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		_, err := svc.Clone(context.Background(), userID, "app-1", &model.CloneApplicationRequest{})
+
+		require.NoError(t, err)
+		require.Len(t, events.Events, 1)
+		assert.Equal(t, model.EventApplicationCreated, events.Events[0].EventType)
+		assert.Equal(t, "app-2", events.Events[0].ApplicationID)
+		assert.Equal(t, "app-1", events.Events[0].Payload["cloned_from"])
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/andreypavlenko/jobber/modules/applications/ports"
+)
+
+// ListExpiring returns the user's applications whose deadline falls between now and
+// withinHours from now, soonest first. withinHours must be between 1 and
+// MaxExpiringWithinHours.
+func (s *ApplicationService) ListExpiring(ctx context.Context, userID string, withinHours, limit, offset int) ([]*model.ApplicationDTO, int, error) {
+	if withinHours < 1 || withinHours > model.MaxExpiringWithinHours {
+		return nil, 0, model.ErrInvalidWithinHours
+	}
+
+	now := time.Now().UTC()
+	until := now.Add(time.Duration(withinHours) * time.Hour)
+	return s.List(ctx, userID, &ports.ListOptions{
+		Limit:          limit,
+		Offset:         offset,
+		SortFields:     []ports.SortField{{Field: "deadline", Dir: "asc"}},
+		DeadlineAfter:  &now,
+		DeadlineBefore: &until,
+	})
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/andreypavlenko/jobber/modules/applications/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_ListExpiring(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("lists deadlines within the window, soonest first", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		var got *ports.ListOptions
+		appRepo.ListEnrichedFunc = func(_ context.Context, _ string, opts *ports.ListOptions) ([]*model.ApplicationDTO, int, error) {
+			got = opts
+			return []*model.ApplicationDTO{}, 0, nil
+		}
+
+		before := time.Now().UTC()
+		_, total, err := svc.ListExpiring(context.Background(), userID, 48, 20, 40)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, total)
+		require.NotNil(t, got)
+		assert.Equal(t, 20, got.Limit)
+		assert.Equal(t, 40, got.Offset)
+		assert.Equal(t, []ports.SortField{{Field: "deadline", Dir: "asc"}}, got.SortFields)
+		require.NotNil(t, got.DeadlineAfter)
+		require.NotNil(t, got.DeadlineBefore)
+		assert.False(t, got.DeadlineAfter.Before(before))
+		assert.Equal(t, 48*time.Hour, got.DeadlineBefore.Sub(*got.DeadlineAfter))
+	})
+
+	t.Run("rejects a window out of range", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.ListEnrichedFunc = func(_ context.Context, _ string, _ *ports.ListOptions) ([]*model.ApplicationDTO, int, error) {
+			t.Fatal("ListEnriched should not be called")
+			return nil, 0, nil
+		}
+
+		for _, hours := range []int{0, -1, model.MaxExpiringWithinHours + 1} {
+			_, _, err := svc.ListExpiring(context.Background(), userID, hours, 20, 0)
+			assert.ErrorIs(t, err, model.ErrInvalidWithinHours)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/andreypavlenko/jobber/modules/applications/ports"
+	commentModel "github.com/andreypavlenko/jobber/modules/comments/model"
+	"github.com/jackc/pgx/v5"
+)
+
+// TxRepositoryFactory binds the repositories to a transaction, see repository.NewTxRepositories
+type TxRepositoryFactory func(tx pgx.Tx) *ports.TxRepositories
+
+// ListEvents returns the audit trail of an application, newest first
+func (s *ApplicationService) ListEvents(ctx context.Context, userID, appID string, limit, offset int) ([]*model.ApplicationEventDTO, int, error) {
+	if _, err := s.appRepo.GetByID(ctx, userID, appID); err != nil {
+		return nil, 0, err
+	}
+	if s.eventRepo == nil {
+		return []*model.ApplicationEventDTO{}, 0, nil
+	}
+
+	events, total, err := s.eventRepo.ListByApplication(ctx, appID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dtos := make([]*model.ApplicationEventDTO, len(events))
+	for i, event := range events {
+		dtos[i] = event.ToDTO()
+	}
+	return dtos, total, nil
+}
+
+// CreateComment saves a user's comment on an application and records it in the
+// audit trail in the same transaction
+func (s *ApplicationService) CreateComment(ctx context.Context, comment *commentModel.Comment) error {
+	return s.inTransaction(ctx, func(repos *ports.TxRepositories) error {
+		if err := repos.Comments.Create(ctx, comment); err != nil {
+			return err
+		}
+		return recordEvent(ctx, repos.Events, comment.UserID, comment.ApplicationID, model.EventCommentAdded, commentEventPayload(comment.ID, comment.StageID, comment.Content))
+	})
+}
+
+// inTransaction runs write with repositories bound to one transaction, so a change
+// and its events are committed together. Without an event log write gets the
+// service's own repositories and no event repository.
+func (s *ApplicationService) inTransaction(ctx context.Context, write func(repos *ports.TxRepositories) error) error {
+	if s.txRepos == nil {
+		return write(&ports.TxRepositories{
+			Applications: s.appRepo,
+			Stages:       s.stageRepo,
+			Comments:     s.commentRepo,
+		})
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // rollback is a no-op after commit
+
+	if err := write(s.txRepos(tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// txEvents returns the event repository bound to tx, or nil without an event log
+func (s *ApplicationService) txEvents(tx pgx.Tx) ports.ApplicationEventRepository {
+	if s.txRepos == nil {
+		return nil
+	}
+	return s.txRepos(tx).Events
+}
+
+// recordEvent appends an event to the application's audit trail; a nil repository records nothing
+func recordEvent(ctx context.Context, events ports.ApplicationEventRepository, userID, appID string, eventType model.ApplicationEventType, payload map[string]any) error {
+	if events == nil {
+		return nil
+	}
+	event := &model.ApplicationEvent{
+		ApplicationID: appID,
+		UserID:        userID,
+		EventType:     eventType,
+		Payload:       payload,
+	}
+	if err := events.Create(ctx, event); err != nil {
+		return fmt.Errorf("failed to record %s event: %w", eventType, err)
+	}
+	return nil
+}
+
+// createApplication inserts app and records its creation in one transaction
+func (s *ApplicationService) createApplication(ctx context.Context, app *model.Application) error {
+	return s.inTransaction(ctx, func(repos *ports.TxRepositories) error {
+		if err := repos.Applications.Create(ctx, app); err != nil {
+			return err
+		}
+		return recordEvent(ctx, repos.Events, app.UserID, app.ID, model.EventApplicationCreated, map[string]any{
+			"name":       app.Name,
+			"job_id":     app.JobID,
+			"status":     app.Status,
+			"applied_at": app.AppliedAt,
+		})
+	})
+}
+
+func statusChangedPayload(oldStatus, newStatus string) map[string]any {
+	return map[string]any{"status": model.Change(oldStatus, newStatus)}
+}
+
+func stageEventPayload(stage *model.ApplicationStage, stageName string) map[string]any {
+	return map[string]any{
+		"stage_id":          stage.ID,
+		"stage_template_id": stage.StageTemplateID,
+		"stage_name":        stageName,
+	}
+}
+
+// stageCompletedPayload records a stage moving from oldStatus to completed
+func stageCompletedPayload(stage *model.ApplicationStage, stageName, oldStatus string) map[string]any {
+	payload := stageEventPayload(stage, stageName)
+	payload["status"] = model.Change(oldStatus, "completed")
+	payload["completed_at"] = stage.CompletedAt
+	return payload
+}
+
+func commentEventPayload(commentID string, stageID *string, content string) map[string]any {
+	return map[string]any{
+		"comment_id": commentID,
+		"stage_id":   stageID,
+		"content":    content,
+	}
+}
@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/andreypavlenko/jobber/modules/applications/ports"
+	commentModel "github.com/andreypavlenko/jobber/modules/comments/model"
+	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockEventRepository implements ports.ApplicationEventRepository and keeps the events it was given
+type MockEventRepository struct {
+	CreateFunc            func(ctx context.Context, event *model.ApplicationEvent) error
+	ListByApplicationFunc func(ctx context.Context, appID string, limit, offset int) ([]*model.ApplicationEvent, int, error)
+	Events                []*model.ApplicationEvent
+}
+
+func (m *MockEventRepository) Create(ctx context.Context, event *model.ApplicationEvent) error {
+	if m.CreateFunc != nil {
+		if err := m.CreateFunc(ctx, event); err != nil {
+			return err
+		}
+	}
+	m.Events = append(m.Events, event)
+	return nil
+}
+
+func (m *MockEventRepository) ListByApplication(ctx context.Context, appID string, limit, offset int) ([]*model.ApplicationEvent, int, error) {
+	if m.ListByApplicationFunc != nil {
+		return m.ListByApplicationFunc(ctx, appID, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (m *MockEventRepository) types() []model.ApplicationEventType {
+	types := make([]model.ApplicationEventType, len(m.Events))
+	for i, event := range m.Events {
+		types[i] = event.EventType
+	}
+	return types
+}
+
+// withEventLog enables the event log on svc, binding its mock repositories to a pgxmock transaction
+func withEventLog(t *testing.T, svc *ApplicationService) (pgxmock.PgxPoolIface, *MockEventRepository) {
+	t.Helper()
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	t.Cleanup(mock.Close)
+	svc.pool = mock
+
+	events := &MockEventRepository{}
+	svc.eventRepo = events
+	svc.txRepos = func(tx pgx.Tx) *ports.TxRepositories {
+		return &ports.TxRepositories{
+			Applications: svc.appRepo,
+			Stages:       svc.stageRepo,
+			Comments:     svc.commentRepo,
+			Events:       events,
+		}
+	}
+	return mock, events
+}
+
+func TestApplicationService_Create_RecordsEvent(t *testing.T) {
+	setup := func(t *testing.T) (*ApplicationService, pgxmock.PgxPoolIface, *MockEventRepository) {
+		svc, appRepo, _, _, jobRepo, _, _, _ := createTestService()
+		jobRepo.GetByIDFunc = func(_ context.Context, _, jid string) (*jobModel.Job, error) {
+			return &jobModel.Job{ID: jid, Title: "Software Engineer"}, nil
+		}
+		appRepo.CreateFunc = func(_ context.Context, app *model.Application) error {
+			app.ID = "app-1"
+			return nil
+		}
+		mock, events := withEventLog(t, svc)
+		return svc, mock, events
+	}
+
+	t.Run("records the creation in the same transaction", func(t *testing.T) {
+		svc, mock, events := setup(t)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		_, err := svc.Create(context.Background(), "user-123", &model.CreateApplicationRequest{JobID: "job-1"})
+
+		require.NoError(t, err)
+		require.Len(t, events.Events, 1)
+		event := events.Events[0]
+		assert.Equal(t, model.EventApplicationCreated, event.EventType)
+		assert.Equal(t, "app-1", event.ApplicationID)
+		assert.Equal(t, "user-123", event.UserID)
+		assert.Equal(t, "Software Engineer", event.Payload["name"])
+		assert.Equal(t, "active", event.Payload["status"])
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back the application when the event fails", func(t *testing.T) {
+		svc, mock, events := setup(t)
+		dbErr := errors.New("insert failed")
+		events.CreateFunc = func(_ context.Context, _ *model.ApplicationEvent) error {
+			return dbErr
+		}
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		result, err := svc.Create(context.Background(), "user-123", &model.CreateApplicationRequest{JobID: "job-1"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, dbErr)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestApplicationService_Update_RecordsStatusChange(t *testing.T) {
+	setup := func(t *testing.T) (*ApplicationService, pgxmock.PgxPoolIface, *MockEventRepository) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, Status: "active"}, nil
+		}
+		appRepo.UpdateFunc = func(_ context.Context, _ *model.Application) error {
+			return nil
+		}
+		mock, events := withEventLog(t, svc)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+		return svc, mock, events
+	}
+
+	t.Run("records the old and new status", func(t *testing.T) {
+		svc, mock, events := setup(t)
+
+		offer := "offer"
+		_, err := svc.Update(context.Background(), "user-123", "app-1", &model.UpdateApplicationRequest{Status: &offer})
+
+		require.NoError(t, err)
+		require.Len(t, events.Events, 1)
+		assert.Equal(t, model.EventApplicationStatusChanged, events.Events[0].EventType)
+		assert.Equal(t, model.Change("active", "offer"), events.Events[0].Payload["status"])
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("records nothing when the status is unchanged", func(t *testing.T) {
+		svc, mock, events := setup(t)
+
+		active := "active"
+		_, err := svc.Update(context.Background(), "user-123", "app-1", &model.UpdateApplicationRequest{Status: &active})
+
+		require.NoError(t, err)
+		assert.Empty(t, events.Events)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestApplicationService_CompleteStage_RecordsEvent(t *testing.T) {
+	setup := func(t *testing.T, status string) (*ApplicationService, *MockEventRepository) {
+		svc, appRepo, stageRepo, templateRepo, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid}, nil
+		}
+		stageRepo.GetByIDFunc = func(_ context.Context, sid string) (*model.ApplicationStage, error) {
+			return &model.ApplicationStage{ID: sid, ApplicationID: "app-1", StageTemplateID: "template-1", Status: status}, nil
+		}
+		stageRepo.UpdateFunc = func(_ context.Context, _ *model.ApplicationStage) error {
+			return nil
+		}
+		templateRepo.GetByIDFunc = func(_ context.Context, _, tid string) (*model.StageTemplate, error) {
+			return &model.StageTemplate{ID: tid, Name: "Phone Screen"}, nil
+		}
+		mock, events := withEventLog(t, svc)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+		return svc, events
+	}
+
+	t.Run("records the completion", func(t *testing.T) {
+		svc, events := setup(t, "active")
+
+		_, err := svc.CompleteStage(context.Background(), "user-123", "app-1", "stage-1", &model.CompleteStageRequest{})
+
+		require.NoError(t, err)
+		require.Len(t, events.Events, 1)
+		payload := events.Events[0].Payload
+		assert.Equal(t, model.EventStageCompleted, events.Events[0].EventType)
+		assert.Equal(t, "stage-1", payload["stage_id"])
+		assert.Equal(t, "Phone Screen", payload["stage_name"])
+		assert.Equal(t, model.Change("active", "completed"), payload["status"])
+	})
+
+	t.Run("records nothing for an already completed stage", func(t *testing.T) {
+		svc, events := setup(t, "completed")
+
+		_, err := svc.CompleteStage(context.Background(), "user-123", "app-1", "stage-1", &model.CompleteStageRequest{})
+
+		require.NoError(t, err)
+		assert.Empty(t, events.Events)
+	})
+}
+
+func TestApplicationService_AddStage_RecordsEvents(t *testing.T) {
+	svc, appRepo, stageRepo, templateRepo, _, _, _, _ := createTestService()
+	currentStageID := "stage-0"
+	appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+		return &model.Application{ID: aid, UserID: uid, CurrentStageID: &currentStageID}, nil
+	}
+	templateRepo.GetByIDFunc = func(_ context.Context, _, tid string) (*model.StageTemplate, error) {
+		return &model.StageTemplate{ID: tid, Name: map[string]string{"template-1": "Phone Screen", "template-2": "Onsite"}[tid]}, nil
+	}
+	stageRepo.ListByApplicationFunc = func(_ context.Context, aid string) ([]*model.ApplicationStage, error) {
+		return []*model.ApplicationStage{{ID: currentStageID, ApplicationID: aid, StageTemplateID: "template-1", Status: "active"}}, nil
+	}
+	stageRepo.GetByIDFunc = func(_ context.Context, sid string) (*model.ApplicationStage, error) {
+		return &model.ApplicationStage{ID: sid, ApplicationID: "app-1", StageTemplateID: "template-1", Status: "active"}, nil
+	}
+	mock, events := withEventLog(t, svc)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE application_stages SET status").
+		WithArgs(currentStageID, "completed", pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("INSERT INTO application_stages").
+		WithArgs(pgxmock.AnyArg(), "app-1", "template-2", "active", 1, pgxmock.AnyArg(), nil, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("UPDATE applications SET current_stage_id").
+		WithArgs("app-1", pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("INSERT INTO comments").
+		WithArgs(pgxmock.AnyArg(), "user-123", "app-1", pgxmock.AnyArg(), "Meeting the team", pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	comment := "Meeting the team"
+	result, err := svc.AddStage(context.Background(), "user-123", "app-1", &model.AddStageRequest{StageTemplateID: "template-2", Comment: &comment})
+
+	require.NoError(t, err)
+	assert.Equal(t, []model.ApplicationEventType{model.EventStageCompleted, model.EventStageAdded, model.EventCommentAdded}, events.types())
+	assert.Equal(t, "Phone Screen", events.Events[0].Payload["stage_name"])
+	assert.Equal(t, result.ID, events.Events[1].Payload["stage_id"])
+	assert.Equal(t, "Onsite", events.Events[1].Payload["stage_name"])
+	assert.Equal(t, "Meeting the team", events.Events[2].Payload["content"])
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplicationService_DeleteStage_RecordsEvent(t *testing.T) {
+	svc, appRepo, stageRepo, templateRepo, _, _, _, _ := createTestService()
+	appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+		return &model.Application{ID: aid, UserID: uid}, nil
+	}
+	stageRepo.GetByIDFunc = func(_ context.Context, sid string) (*model.ApplicationStage, error) {
+		return &model.ApplicationStage{ID: sid, ApplicationID: "app-1", StageTemplateID: "template-1", Status: "completed"}, nil
+	}
+	templateRepo.GetByIDFunc = func(_ context.Context, _, tid string) (*model.StageTemplate, error) {
+		return &model.StageTemplate{ID: tid, Name: "Phone Screen"}, nil
+	}
+	mock, events := withEventLog(t, svc)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM application_stages").WithArgs("stage-1").WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectCommit()
+
+	err := svc.DeleteStage(context.Background(), "user-123", "app-1", "stage-1")
+
+	require.NoError(t, err)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, model.EventStageDeleted, events.Events[0].EventType)
+	assert.Equal(t, "Phone Screen", events.Events[0].Payload["stage_name"])
+	assert.Equal(t, "completed", events.Events[0].Payload["status"])
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplicationService_CreateComment(t *testing.T) {
+	t.Run("saves the comment and records it", func(t *testing.T) {
+		svc, _, _, _, _, _, _, commentRepo := createTestService()
+		commentRepo.CreateFunc = func(_ context.Context, comment *commentModel.Comment) error {
+			comment.ID = "comment-1"
+			return nil
+		}
+		mock, events := withEventLog(t, svc)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		err := svc.CreateComment(context.Background(), &commentModel.Comment{UserID: "user-123", ApplicationID: "app-1", Content: "Sent a thank-you note"})
+
+		require.NoError(t, err)
+		require.Len(t, events.Events, 1)
+		assert.Equal(t, model.EventCommentAdded, events.Events[0].EventType)
+		assert.Equal(t, "comment-1", events.Events[0].Payload["comment_id"])
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("saves the comment without an event log", func(t *testing.T) {
+		svc, _, _, _, _, _, _, commentRepo := createTestService()
+		created := false
+		commentRepo.CreateFunc = func(_ context.Context, _ *commentModel.Comment) error {
+			created = true
+			return nil
+		}
+
+		err := svc.CreateComment(context.Background(), &commentModel.Comment{UserID: "user-123", ApplicationID: "app-1", Content: "Note"})
+
+		require.NoError(t, err)
+		assert.True(t, created)
+	})
+}
+
+func TestApplicationService_ListEvents(t *testing.T) {
+	t.Run("returns the application's events", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid}, nil
+		}
+		createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		svc.eventRepo = &MockEventRepository{
+			ListByApplicationFunc: func(_ context.Context, appID string, limit, offset int) ([]*model.ApplicationEvent, int, error) {
+				return []*model.ApplicationEvent{{ID: "event-1", ApplicationID: appID, EventType: model.EventApplicationCreated, CreatedAt: createdAt}}, 1, nil
+			},
+		}
+
+		events, total, err := svc.ListEvents(context.Background(), "user-123", "app-1", 20, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, events, 1)
+		assert.Equal(t, "event-1", events[0].ID)
+		assert.Equal(t, map[string]any{}, events[0].Payload)
+	})
+
+	t.Run("returns not found for another user's application", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(_ context.Context, _, _ string) (*model.Application, error) {
+			return nil, model.ErrApplicationNotFound
+		}
+		svc.eventRepo = &MockEventRepository{
+			ListByApplicationFunc: func(_ context.Context, _ string, _, _ int) ([]*model.ApplicationEvent, int, error) {
+				t.Fatal("events of a foreign application are not listed")
+				return nil, 0, nil
+			},
+		}
+
+		_, _, err := svc.ListEvents(context.Background(), "other-user", "app-1", 20, 0)
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+}
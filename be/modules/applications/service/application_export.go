@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/andreypavlenko/jobber/modules/applications/ports"
+)
+
+// MaxExportRows caps how many applications a single CSV export contains
+const MaxExportRows = 10000
+
+// csvExportHeader is the header row of the applications CSV export
+var csvExportHeader = []string{"Name", "Company", "Job Title", "Source", "Status", "Applied At", "Current Stage", "Tags", "Last Activity"}
+
+// ExportCSV writes the applications matching opts as CSV, one row per application
+// in the order List returns them. Pagination in opts is ignored; at most
+// MaxExportRows rows are written. All data is loaded before the first write,
+// so nothing has been written to w when an error is returned.
+func (s *ApplicationService) ExportCSV(ctx context.Context, userID string, opts *ports.ListOptions, w io.Writer) error {
+	exportOpts := *opts
+	exportOpts.Limit = MaxExportRows
+	exportOpts.Offset = 0
+
+	apps, _, err := s.List(ctx, userID, &exportOpts)
+	if err != nil {
+		return err
+	}
+
+	tagNames := map[string]string{}
+	if s.tagRepo != nil {
+		tags, err := s.tagRepo.List(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for _, tag := range tags {
+			tagNames[tag.ID] = tag.Name
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvExportHeader); err != nil {
+		return err
+	}
+	for _, app := range apps {
+		if err := writer.Write(csvExportRow(app, tagNames)); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvExportRow formats one application; missing optional fields become empty cells
+func csvExportRow(app *model.ApplicationDTO, tagNames map[string]string) []string {
+	var company, jobTitle, source string
+	if app.Job != nil {
+		jobTitle = app.Job.Title
+		if app.Job.Source != nil {
+			source = *app.Job.Source
+		}
+		if app.Job.Company != nil {
+			company = app.Job.Company.Name
+		}
+	}
+
+	var currentStage string
+	if app.CurrentStageName != nil {
+		currentStage = *app.CurrentStageName
+	}
+
+	tags := make([]string, 0, len(app.TagIDs))
+	for _, id := range app.TagIDs {
+		if name, ok := tagNames[id]; ok {
+			tags = append(tags, name)
+		}
+	}
+
+	return []string{
+		csvCell(app.Name),
+		csvCell(company),
+		csvCell(jobTitle),
+		csvCell(source),
+		app.Status,
+		csvTime(app.AppliedAt),
+		csvCell(currentStage),
+		csvCell(strings.Join(tags, "; ")),
+		csvTime(app.LastActivityAt),
+	}
+}
+
+// csvTime formats t as ISO-8601 in UTC, or an empty cell for the zero time
+func csvTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// csvCell neutralizes user-entered text that spreadsheets would otherwise
+// evaluate as a formula by prefixing it with a single quote
+func csvCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/andreypavlenko/jobber/modules/applications/ports"
+	companyModel "github.com/andreypavlenko/jobber/modules/companies/model"
+	tagModel "github.com/andreypavlenko/jobber/modules/tags/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_ExportCSV(t *testing.T) {
+	userID := "user-123"
+	appliedAt := time.Date(2024, 3, 1, 9, 30, 0, 0, time.FixedZone("CET", 3600))
+	lastActivity := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	t.Run("writes one row per application", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		tagRepo := &MockTagRepository{
+			ListFunc: func(_ context.Context, uid string) ([]*tagModel.Tag, error) {
+				assert.Equal(t, userID, uid)
+				return []*tagModel.Tag{{ID: "tag-1", Name: "remote"}, {ID: "tag-2", Name: "referral"}}, nil
+			},
+		}
+		svc.tagRepo = tagRepo
+
+		source := "LinkedIn"
+		stage := "Phone Screen"
+		var got *ports.ListOptions
+		appRepo.ListEnrichedFunc = func(_ context.Context, _ string, opts *ports.ListOptions) ([]*model.ApplicationDTO, int, error) {
+			got = opts
+			return []*model.ApplicationDTO{
+				{
+					Name:             "Acme Backend",
+					Status:           "active",
+					AppliedAt:        appliedAt,
+					LastActivityAt:   lastActivity,
+					CurrentStageName: &stage,
+					TagIDs:           []string{"tag-2", "tag-1"},
+					Job: &model.JobNestedDTO{
+						Title:   "Backend Engineer",
+						Source:  &source,
+						Company: &companyModel.CompanyDTO{Name: "Acme, Inc."},
+					},
+				},
+				{Name: "=HYPERLINK(\"x\")", Status: "rejected", AppliedAt: appliedAt, LastActivityAt: lastActivity},
+			}, 2, nil
+		}
+
+		var buf bytes.Buffer
+		opts := &ports.ListOptions{Limit: 20, Offset: 40, Statuses: []string{"active"}}
+		err := svc.ExportCSV(context.Background(), userID, opts, &buf)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Name,Company,Job Title,Source,Status,Applied At,Current Stage,Tags,Last Activity\n"+
+			"Acme Backend,\"Acme, Inc.\",Backend Engineer,LinkedIn,active,2024-03-01T08:30:00Z,Phone Screen,referral; remote,2024-03-04T12:00:00Z\n"+
+			"\"'=HYPERLINK(\"\"x\"\")\",,,,rejected,2024-03-01T08:30:00Z,,,2024-03-04T12:00:00Z\n", buf.String())
+
+		require.NotNil(t, got)
+		assert.Equal(t, MaxExportRows, got.Limit)
+		assert.Equal(t, 0, got.Offset)
+		assert.Equal(t, []string{"active"}, got.Statuses)
+		// the caller's options are left untouched
+		assert.Equal(t, 20, opts.Limit)
+		assert.Equal(t, 40, opts.Offset)
+	})
+
+	t.Run("writes only the header when nothing matches", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.ListEnrichedFunc = func(_ context.Context, _ string, _ *ports.ListOptions) ([]*model.ApplicationDTO, int, error) {
+			return []*model.ApplicationDTO{}, 0, nil
+		}
+
+		var buf bytes.Buffer
+		err := svc.ExportCSV(context.Background(), userID, &ports.ListOptions{}, &buf)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Name,Company,Job Title,Source,Status,Applied At,Current Stage,Tags,Last Activity\n", buf.String())
+	})
+
+	t.Run("writes nothing on error", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		svc.tagRepo = &MockTagRepository{
+			ListFunc: func(_ context.Context, _ string) ([]*tagModel.Tag, error) {
+				return nil, errors.New("db error")
+			},
+		}
+		appRepo.ListEnrichedFunc = func(_ context.Context, _ string, _ *ports.ListOptions) ([]*model.ApplicationDTO, int, error) {
+			return []*model.ApplicationDTO{{Name: "Acme", Status: "active"}}, 1, nil
+		}
+
+		var buf bytes.Buffer
+		err := svc.ExportCSV(context.Background(), userID, &ports.ListOptions{}, &buf)
+
+		assert.Error(t, err)
+		assert.Empty(t, buf.String())
+	})
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andreypavlenko/jobber/internal/platform/urlutil"
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	companyModel "github.com/andreypavlenko/jobber/modules/companies/model"
+	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
+	"go.uber.org/zap"
+)
+
+// importRequiredColumns must all be present in the header of an import file;
+// status, url and notes are optional and other columns are ignored
+var importRequiredColumns = []string{"company_name", "job_title", "source", "applied_at"}
+
+// maxImportTextLength matches the length limit of company names and job titles
+const maxImportTextLength = 255
+
+// importRow is a validated row of an import file
+type importRow struct {
+	line        int
+	companyName string
+	jobTitle    string
+	source      string
+	appliedAt   time.Time
+	status      string
+	url         *string
+	notes       *string
+}
+
+// ImportCSV creates applications from a CSV file with the columns company_name,
+// job_title, source and applied_at, and optionally status, url and notes.
+// Companies are matched by name, ignoring case, and created when missing; every
+// row gets a new job and an application for it. Invalid rows are skipped and
+// reported in Errors, as are rows that fail to save; rows are not rolled back
+// together. With dryRun nothing is written and Preview lists what would be created.
+func (s *ApplicationService) ImportCSV(ctx context.Context, userID string, r io.Reader, dryRun bool) (*model.ImportResult, error) {
+	rows, rowErrors, err := parseImportCSV(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.ImportResult{
+		DryRun:  dryRun,
+		Skipped: len(rowErrors),
+		Errors:  rowErrors,
+	}
+	if dryRun {
+		result.Preview = []*model.ImportPreviewRow{}
+	}
+
+	// companyIDs caches lookups by lower-cased name; an empty ID marks a company a dry run would create
+	companyIDs := map[string]string{}
+	for _, row := range rows {
+		if dryRun {
+			companyID, err := s.importCompanyID(ctx, userID, row.companyName, companyIDs, false)
+			if err != nil {
+				return nil, err
+			}
+			result.Preview = append(result.Preview, &model.ImportPreviewRow{
+				Row:         row.line,
+				CompanyName: row.companyName,
+				NewCompany:  companyID == "",
+				JobTitle:    row.jobTitle,
+				Source:      row.source,
+				Status:      row.status,
+				AppliedAt:   row.appliedAt,
+				URL:         row.url,
+			})
+			result.Created++
+			continue
+		}
+
+		if message := s.importRow(ctx, userID, row, companyIDs); message != "" {
+			result.Skipped++
+			result.Errors = append(result.Errors, model.ImportRowError{Row: row.line, Message: message})
+			continue
+		}
+		result.Created++
+	}
+
+	sort.SliceStable(result.Errors, func(i, j int) bool { return result.Errors[i].Row < result.Errors[j].Row })
+
+	if !dryRun && result.Created > 0 {
+		s.invalidateProfile(ctx, userID)
+		s.invalidateAnalytics(ctx, userID)
+	}
+
+	s.log.Info("applications imported",
+		zap.String("user_id", userID),
+		zap.Bool("dry_run", dryRun),
+		zap.Int("created", result.Created),
+		zap.Int("skipped", result.Skipped))
+
+	return result, nil
+}
+
+// importRow creates the company if needed, a job and an application for one row.
+// It returns the message to report when the row could not be imported.
+func (s *ApplicationService) importRow(ctx context.Context, userID string, row *importRow, companyIDs map[string]string) string {
+	if s.limitChecker != nil {
+		for _, resource := range []string{"jobs", "applications"} {
+			if err := s.limitChecker.CheckLimit(ctx, userID, resource); err != nil {
+				return err.Error()
+			}
+		}
+	}
+
+	companyID, err := s.importCompanyID(ctx, userID, row.companyName, companyIDs, true)
+	if err != nil {
+		s.log.Warn("import failed to resolve company", zap.Int("row", row.line), zap.Error(err))
+		return "failed to save company"
+	}
+
+	source := row.source
+	job := &jobModel.Job{
+		UserID:    userID,
+		CompanyID: &companyID,
+		Title:     row.jobTitle,
+		Source:    &source,
+		URL:       row.url,
+		Notes:     row.notes,
+	}
+	if err := s.jobRepo.Create(ctx, job); err != nil {
+		s.log.Warn("import failed to create job", zap.Int("row", row.line), zap.Error(err))
+		return "failed to save job"
+	}
+
+	app := &model.Application{
+		UserID:    userID,
+		JobID:     job.ID,
+		Name:      row.jobTitle,
+		Status:    row.status,
+		AppliedAt: row.appliedAt,
+	}
+	if row.status == string(model.StatusArchived) {
+		archivedAt := time.Now().UTC()
+		app.ArchivedAt = &archivedAt
+	}
+	if err := s.createApplication(ctx, app); err != nil {
+		s.log.Warn("import failed to create application", zap.Int("row", row.line), zap.Error(err))
+		return "failed to save application"
+	}
+	return ""
+}
+
+// importCompanyID returns the ID of the user's company with the given name, creating
+// it when create is set. Without create a missing company yields an empty ID.
+func (s *ApplicationService) importCompanyID(ctx context.Context, userID, name string, companyIDs map[string]string, create bool) (string, error) {
+	key := strings.ToLower(name)
+	if id, ok := companyIDs[key]; ok && (id != "" || !create) {
+		return id, nil
+	}
+
+	company, err := s.companyRepo.GetByName(ctx, userID, name)
+	switch {
+	case err == nil:
+		companyIDs[key] = company.ID
+		return company.ID, nil
+	case !errors.Is(err, companyModel.ErrCompanyNotFound):
+		return "", err
+	case !create:
+		companyIDs[key] = ""
+		return "", nil
+	}
+
+	company = &companyModel.Company{UserID: userID, Name: name}
+	if err := s.companyRepo.Create(ctx, company); err != nil {
+		return "", err
+	}
+	companyIDs[key] = company.ID
+	return company.ID, nil
+}
+
+// parseImportCSV reads and validates an import file. Invalid rows are returned as
+// row errors; malformed CSV, a missing header column or more than MaxImportRows
+// rows fail the whole file.
+func parseImportCSV(r io.Reader) ([]*importRow, []model.ImportRowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, model.ErrInvalidImportFile
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, dup := columns[name]; !dup {
+			columns[name] = i
+		}
+	}
+	for _, name := range importRequiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, model.ErrInvalidImportFile
+		}
+	}
+
+	rows := []*importRow{}
+	rowErrors := []model.ImportRowError{}
+	for count := 0; ; count++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, model.ErrInvalidImportFile
+		}
+		if count == model.MaxImportRows {
+			return nil, nil, model.ErrTooManyImportRows
+		}
+
+		line, _ := reader.FieldPos(0)
+		cell := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row, err := parseImportRow(cell)
+		if err != nil {
+			rowErrors = append(rowErrors, model.ImportRowError{Row: line, Message: err.Error()})
+			continue
+		}
+		row.line = line
+		rows = append(rows, row)
+	}
+	return rows, rowErrors, nil
+}
+
+// parseImportRow validates the cells of one row; the error message is shown to the user
+func parseImportRow(cell func(name string) string) (*importRow, error) {
+	for _, name := range importRequiredColumns {
+		if cell(name) == "" {
+			return nil, fmt.Errorf("%s is required", name)
+		}
+	}
+	for _, name := range []string{"company_name", "job_title", "source"} {
+		if len(cell(name)) > maxImportTextLength {
+			return nil, fmt.Errorf("%s must be at most %d characters", name, maxImportTextLength)
+		}
+	}
+
+	appliedAt, err := parseImportDate(cell("applied_at"))
+	if err != nil {
+		return nil, errors.New("applied_at must be a date in YYYY-MM-DD or RFC 3339 format")
+	}
+
+	status := strings.ToLower(cell("status"))
+	if status == "" {
+		status = string(model.StatusActive)
+	}
+	switch model.ApplicationStatus(status) {
+	case model.StatusActive, model.StatusOnHold, model.StatusRejected, model.StatusOffer, model.StatusArchived:
+	default:
+		return nil, errors.New("status must be one of active, on_hold, rejected, offer, archived")
+	}
+
+	row := &importRow{
+		companyName: cell("company_name"),
+		jobTitle:    cell("job_title"),
+		source:      cell("source"),
+		appliedAt:   appliedAt,
+		status:      status,
+	}
+	if raw := cell("url"); raw != "" {
+		normalized, err := urlutil.NormalizeURL(raw)
+		if err != nil {
+			return nil, errors.New("url is not a valid http or https URL")
+		}
+		row.url = &normalized
+	}
+	if notes := cell("notes"); notes != "" {
+		row.notes = &notes
+	}
+	return row, nil
+}
+
+// parseImportDate accepts a plain date, taken as midnight UTC, or an RFC 3339 timestamp
+func parseImportDate(value string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
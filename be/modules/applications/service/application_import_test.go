@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	companyModel "github.com/andreypavlenko/jobber/modules/companies/model"
+	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_ImportCSV(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("creates companies, jobs and applications", func(t *testing.T) {
+		svc, appRepo, _, _, jobRepo, companyRepo, _, _ := createTestService()
+
+		companyRepo.GetByNameFunc = func(_ context.Context, uid, name string) (*companyModel.Company, error) {
+			assert.Equal(t, userID, uid)
+			if strings.EqualFold(name, "acme") {
+				return &companyModel.Company{ID: "company-acme", Name: "Acme"}, nil
+			}
+			return nil, companyModel.ErrCompanyNotFound
+		}
+		var createdCompanies []*companyModel.Company
+		companyRepo.CreateFunc = func(_ context.Context, company *companyModel.Company) error {
+			company.ID = fmt.Sprintf("company-new-%d", len(createdCompanies)+1)
+			createdCompanies = append(createdCompanies, company)
+			return nil
+		}
+		var jobs []*jobModel.Job
+		jobRepo.CreateFunc = func(_ context.Context, job *jobModel.Job) error {
+			job.ID = fmt.Sprintf("job-%d", len(jobs)+1)
+			jobs = append(jobs, job)
+			return nil
+		}
+		var apps []*model.Application
+		appRepo.CreateFunc = func(_ context.Context, app *model.Application) error {
+			apps = append(apps, app)
+			return nil
+		}
+
+		csv := "Company_Name,job_title,source,applied_at,status,url,notes\n" +
+			"ACME,Backend Engineer,LinkedIn,2024-03-01,,https://www.acme.com/jobs/1?utm_source=x,Referred by Ann\n" +
+			"Globex,Frontend Engineer,Referral,2024-03-02T10:00:00+02:00,offer,,\n" +
+			"globex,Platform Engineer,Website,2024-03-03,archived,,\n"
+
+		result, err := svc.ImportCSV(context.Background(), userID, strings.NewReader(csv), false)
+
+		require.NoError(t, err)
+		assert.False(t, result.DryRun)
+		assert.Equal(t, 3, result.Created)
+		assert.Equal(t, 0, result.Skipped)
+		assert.Empty(t, result.Errors)
+		assert.Nil(t, result.Preview)
+
+		require.Len(t, createdCompanies, 1, "companies are created once per name, ignoring case")
+		assert.Equal(t, "Globex", createdCompanies[0].Name)
+		assert.Equal(t, userID, createdCompanies[0].UserID)
+
+		require.Len(t, jobs, 3)
+		assert.Equal(t, "company-acme", *jobs[0].CompanyID)
+		assert.Equal(t, "Backend Engineer", jobs[0].Title)
+		assert.Equal(t, "LinkedIn", *jobs[0].Source)
+		assert.Equal(t, "https://acme.com/jobs/1", *jobs[0].URL)
+		assert.Equal(t, "Referred by Ann", *jobs[0].Notes)
+		assert.Equal(t, "company-new-1", *jobs[1].CompanyID)
+		assert.Equal(t, "company-new-1", *jobs[2].CompanyID)
+		assert.Nil(t, jobs[1].URL)
+		assert.Nil(t, jobs[1].Notes)
+
+		require.Len(t, apps, 3)
+		assert.Equal(t, "job-1", apps[0].JobID)
+		assert.Equal(t, "Backend Engineer", apps[0].Name)
+		assert.Equal(t, "active", apps[0].Status)
+		assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), apps[0].AppliedAt)
+		assert.Equal(t, "offer", apps[1].Status)
+		assert.Equal(t, time.Date(2024, 3, 2, 8, 0, 0, 0, time.UTC), apps[1].AppliedAt)
+		assert.Nil(t, apps[1].ArchivedAt)
+		assert.Equal(t, "archived", apps[2].Status)
+		assert.NotNil(t, apps[2].ArchivedAt)
+	})
+
+	t.Run("skips invalid rows and reports them by line", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		created := 0
+		appRepo.CreateFunc = func(_ context.Context, _ *model.Application) error {
+			created++
+			return nil
+		}
+
+		csv := "company_name,job_title,source,applied_at,status,url\n" +
+			"Acme,Backend Engineer,LinkedIn,2024-03-01,,\n" +
+			",Missing Company,LinkedIn,2024-03-01,,\n" +
+			"Acme,Bad Date,LinkedIn,03/01/2024,,\n" +
+			"Acme,Bad Status,LinkedIn,2024-03-01,pending,\n" +
+			"Acme,Bad URL,LinkedIn,2024-03-01,,ftp://acme.com\n" +
+			"Acme,Short Row\n" +
+			"Acme,Second Valid,Website,2024-03-05,on_hold,\n"
+
+		result, err := svc.ImportCSV(context.Background(), userID, strings.NewReader(csv), false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Created)
+		assert.Equal(t, 5, result.Skipped)
+		assert.Equal(t, 2, created)
+		assert.Equal(t, []model.ImportRowError{
+			{Row: 3, Message: "company_name is required"},
+			{Row: 4, Message: "applied_at must be a date in YYYY-MM-DD or RFC 3339 format"},
+			{Row: 5, Message: "status must be one of active, on_hold, rejected, offer, archived"},
+			{Row: 6, Message: "url is not a valid http or https URL"},
+			{Row: 7, Message: "source is required"},
+		}, result.Errors)
+	})
+
+	t.Run("reports rows that fail to save and keeps going", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		calls := 0
+		appRepo.CreateFunc = func(_ context.Context, _ *model.Application) error {
+			calls++
+			if calls == 1 {
+				return errors.New("database error")
+			}
+			return nil
+		}
+
+		csv := "company_name,job_title,source,applied_at\n" +
+			"Acme,First,LinkedIn,2024-03-01\n" +
+			"Acme,Second,LinkedIn,2024-03-02\n"
+
+		result, err := svc.ImportCSV(context.Background(), userID, strings.NewReader(csv), false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Created)
+		assert.Equal(t, 1, result.Skipped)
+		assert.Equal(t, []model.ImportRowError{{Row: 2, Message: "failed to save application"}}, result.Errors)
+	})
+
+	t.Run("reports rows over the plan limit", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		svc.limitChecker = &MockAppLimitChecker{CheckLimitFunc: func(_ context.Context, _, resource string) error {
+			if resource == "applications" {
+				return errors.New("plan limit reached")
+			}
+			return nil
+		}}
+		appRepo.CreateFunc = func(_ context.Context, _ *model.Application) error {
+			t.Fatal("application should not be created")
+			return nil
+		}
+
+		csv := "company_name,job_title,source,applied_at\nAcme,First,LinkedIn,2024-03-01\n"
+
+		result, err := svc.ImportCSV(context.Background(), userID, strings.NewReader(csv), false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Created)
+		assert.Equal(t, []model.ImportRowError{{Row: 2, Message: "plan limit reached"}}, result.Errors)
+	})
+
+	t.Run("dry run previews without writing", func(t *testing.T) {
+		svc, appRepo, _, _, jobRepo, companyRepo, _, _ := createTestService()
+		lookups := 0
+		companyRepo.GetByNameFunc = func(_ context.Context, _, name string) (*companyModel.Company, error) {
+			lookups++
+			if name == "Acme" {
+				return &companyModel.Company{ID: "company-acme", Name: "Acme"}, nil
+			}
+			return nil, companyModel.ErrCompanyNotFound
+		}
+		companyRepo.CreateFunc = func(_ context.Context, _ *companyModel.Company) error {
+			t.Fatal("company should not be created in a dry run")
+			return nil
+		}
+		jobRepo.CreateFunc = func(_ context.Context, _ *jobModel.Job) error {
+			t.Fatal("job should not be created in a dry run")
+			return nil
+		}
+		appRepo.CreateFunc = func(_ context.Context, _ *model.Application) error {
+			t.Fatal("application should not be created in a dry run")
+			return nil
+		}
+
+		csv := "company_name,job_title,source,applied_at,status\n" +
+			"Acme,Backend Engineer,LinkedIn,2024-03-01,\n" +
+			"Globex,Frontend Engineer,Referral,2024-03-02,rejected\n" +
+			"GLOBEX,Platform Engineer,Referral,2024-03-03,\n" +
+			"Initech,,Referral,2024-03-03,\n"
+
+		result, err := svc.ImportCSV(context.Background(), userID, strings.NewReader(csv), true)
+
+		require.NoError(t, err)
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 3, result.Created)
+		assert.Equal(t, 1, result.Skipped)
+		assert.Equal(t, []model.ImportRowError{{Row: 5, Message: "job_title is required"}}, result.Errors)
+		assert.Equal(t, 2, lookups, "company lookups are cached by name")
+
+		require.Len(t, result.Preview, 3)
+		assert.Equal(t, &model.ImportPreviewRow{
+			Row: 2, CompanyName: "Acme", NewCompany: false, JobTitle: "Backend Engineer",
+			Source: "LinkedIn", Status: "active", AppliedAt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		}, result.Preview[0])
+		assert.True(t, result.Preview[1].NewCompany)
+		assert.Equal(t, "rejected", result.Preview[1].Status)
+		assert.True(t, result.Preview[2].NewCompany)
+	})
+
+	t.Run("rejects a file without the required columns", func(t *testing.T) {
+		svc, _, _, _, _, _, _, _ := createTestService()
+
+		result, err := svc.ImportCSV(context.Background(), userID, strings.NewReader("company_name,job_title,applied_at\nAcme,Engineer,2024-03-01\n"), false)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrInvalidImportFile)
+	})
+
+	t.Run("rejects an empty file", func(t *testing.T) {
+		svc, _, _, _, _, _, _, _ := createTestService()
+
+		_, err := svc.ImportCSV(context.Background(), userID, strings.NewReader(""), true)
+
+		assert.ErrorIs(t, err, model.ErrInvalidImportFile)
+	})
+
+	t.Run("accepts a header with a byte order mark", func(t *testing.T) {
+		svc, _, _, _, _, _, _, _ := createTestService()
+
+		result, err := svc.ImportCSV(context.Background(), userID, strings.NewReader("\ufeffcompany_name,job_title,source,applied_at\nAcme,Engineer,LinkedIn,2024-03-01\n"), true)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Created)
+	})
+
+	t.Run("rejects more than the maximum number of rows", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.CreateFunc = func(_ context.Context, _ *model.Application) error {
+			t.Fatal("nothing should be created when the file is too large")
+			return nil
+		}
+
+		var b strings.Builder
+		b.WriteString("company_name,job_title,source,applied_at\n")
+		for i := 0; i <= model.MaxImportRows; i++ {
+			b.WriteString("Acme,Engineer,LinkedIn,2024-03-01\n")
+		}
+
+		result, err := svc.ImportCSV(context.Background(), userID, strings.NewReader(b.String()), false)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrTooManyImportRows)
+	})
+
+	t.Run("accepts exactly the maximum number of rows", func(t *testing.T) {
+		svc, _, _, _, _, _, _, _ := createTestService()
+
+		var b strings.Builder
+		b.WriteString("company_name,job_title,source,applied_at\n")
+		for i := 0; i < model.MaxImportRows; i++ {
+			b.WriteString("Acme,Engineer,LinkedIn,2024-03-01\n")
+		}
+
+		result, err := svc.ImportCSV(context.Background(), userID, strings.NewReader(b.String()), true)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.MaxImportRows, result.Created)
+	})
+}
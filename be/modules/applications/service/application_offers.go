@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+)
+
+// ListOffers returns every application of the user in the offer status with the
+// offer details needed to compare them, soonest decision deadline first
+func (s *ApplicationService) ListOffers(ctx context.Context, userID string) ([]*model.OfferDTO, error) {
+	return s.appRepo.ListOffers(ctx, userID)
+}
+
+// applyOfferDetails copies the equity, start date and benefits from an update
+// request onto the application. An empty start date or benefits text clears it.
+func applyOfferDetails(app *model.Application, req *model.UpdateApplicationRequest) error {
+	if req.EquityPercent != nil {
+		if *req.EquityPercent < 0 || *req.EquityPercent > model.MaxEquityPercent {
+			return model.ErrInvalidEquityPercent
+		}
+		app.EquityPercent = req.EquityPercent
+	}
+
+	if req.StartDate != nil {
+		if *req.StartDate == "" {
+			app.StartDate = nil
+		} else {
+			startDate, err := time.Parse(model.DateLayout, *req.StartDate)
+			if err != nil {
+				return model.ErrInvalidStartDate
+			}
+			app.StartDate = &startDate
+		}
+	}
+
+	if req.BenefitsNotes != nil {
+		notes := strings.TrimSpace(*req.BenefitsNotes)
+		if notes == "" {
+			app.BenefitsNotes = nil
+		} else {
+			app.BenefitsNotes = &notes
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_ListOffers(t *testing.T) {
+	svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+	appRepo.ListOffersFunc = func(_ context.Context, uid string) ([]*model.OfferDTO, error) {
+		assert.Equal(t, "user-123", uid)
+		return []*model.OfferDTO{{ApplicationID: "app-1", JobTitle: "Backend Engineer"}}, nil
+	}
+
+	offers, err := svc.ListOffers(context.Background(), "user-123")
+
+	require.NoError(t, err)
+	require.Len(t, offers, 1)
+	assert.Equal(t, "app-1", offers[0].ApplicationID)
+}
+
+func TestApplicationService_Update_OfferDetails(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+	startDate := time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)
+	equity := 0.25
+	benefits := "Health insurance"
+
+	setup := func() (*ApplicationService, *MockApplicationRepository, **model.Application) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{
+				ID: aid, UserID: uid, JobID: "job-1", Status: "offer",
+				EquityPercent: &equity, StartDate: &startDate, BenefitsNotes: &benefits,
+			}, nil
+		}
+		var updated *model.Application
+		appRepo.UpdateFunc = func(_ context.Context, app *model.Application) error {
+			updated = app
+			return nil
+		}
+		return svc, appRepo, &updated
+	}
+
+	t.Run("sets equity, start date and benefits", func(t *testing.T) {
+		svc, _, updated := setup()
+		newEquity := 1.5
+
+		dto, err := svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{
+			EquityPercent: &newEquity,
+			StartDate:     strPtr("2027-01-04"),
+			BenefitsNotes: strPtr("  Remote stipend  "),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, *updated)
+		assert.Equal(t, 1.5, *(*updated).EquityPercent)
+		assert.Equal(t, time.Date(2027, 1, 4, 0, 0, 0, 0, time.UTC), *(*updated).StartDate)
+		assert.Equal(t, "Remote stipend", *(*updated).BenefitsNotes)
+		require.NotNil(t, dto.StartDate)
+		assert.Equal(t, "2027-01-04", *dto.StartDate)
+	})
+
+	t.Run("empty start date and benefits clear them", func(t *testing.T) {
+		svc, _, updated := setup()
+
+		_, err := svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{
+			StartDate:     strPtr(""),
+			BenefitsNotes: strPtr("  "),
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, (*updated).StartDate)
+		assert.Nil(t, (*updated).BenefitsNotes)
+		assert.Equal(t, &equity, (*updated).EquityPercent)
+	})
+
+	t.Run("rejects equity out of range", func(t *testing.T) {
+		for _, value := range []float64{-0.01, 100.01} {
+			svc, _, updated := setup()
+
+			_, err := svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{EquityPercent: &value})
+
+			assert.ErrorIs(t, err, model.ErrInvalidEquityPercent)
+			assert.Nil(t, *updated)
+		}
+	})
+
+	t.Run("rejects a malformed start date", func(t *testing.T) {
+		svc, _, updated := setup()
+
+		_, err := svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{StartDate: strPtr("02/11/2026")})
+
+		assert.ErrorIs(t, err, model.ErrInvalidStartDate)
+		assert.Nil(t, *updated)
+	})
+}
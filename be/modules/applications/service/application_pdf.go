@@ -0,0 +1,135 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	commentModel "github.com/andreypavlenko/jobber/modules/comments/model"
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Layout of the application summary PDF, in millimetres on an A4 page
+const (
+	pdfMargin     = 15.0
+	pdfLineHeight = 6.0
+	pdfDateLayout = "Jan 2, 2006"
+)
+
+// ExportPDF renders a one-page printable summary of an application: company,
+// job title, status, applied date, stages with dates, and comments.
+// Content that does not fit on the page is cut off.
+func (s *ApplicationService) ExportPDF(ctx context.Context, userID, appID string) ([]byte, error) {
+	dto, err := s.GetByID(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	stages, err := s.ListStages(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	return renderApplicationPDF(dto, stages)
+}
+
+// renderApplicationPDF lays out the summary on a single A4 page
+func renderApplicationPDF(app *model.ApplicationDTO, stages []*model.ApplicationStageDTO) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(false, pdfMargin)
+	pdf.AddPage()
+
+	// Core fonts are cp1252; translate UTF-8 input so accented text renders
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pageWidth, pageHeight := pdf.GetPageSize()
+	contentWidth := pageWidth - 2*pdfMargin
+
+	// fits reports whether h more millimetres fit on the page
+	fits := func(h float64) bool {
+		return pdf.GetY()+h <= pageHeight-pdfMargin
+	}
+	line := func(style string, size float64, text string) bool {
+		if !fits(pdfLineHeight) {
+			return false
+		}
+		pdf.SetFont("Helvetica", style, size)
+		pdf.CellFormat(contentWidth, pdfLineHeight, tr(text), "", 1, "L", false, 0, "")
+		return true
+	}
+	heading := func(text string) bool {
+		pdf.Ln(pdfLineHeight / 2)
+		return line("B", 13, text)
+	}
+
+	companyName, jobTitle := "-", "-"
+	if app.Job != nil {
+		jobTitle = app.Job.Title
+		if app.Job.Company != nil {
+			companyName = app.Job.Company.Name
+		}
+	}
+
+	title := app.Name
+	if title == "" {
+		title = jobTitle
+	}
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.MultiCell(contentWidth, 9, tr(title), "", "L", false)
+	pdf.Ln(pdfLineHeight / 2)
+
+	line("", 11, "Company: "+companyName)
+	line("", 11, "Job title: "+jobTitle)
+	line("", 11, "Status: "+app.Status)
+	line("", 11, "Applied: "+app.AppliedAt.Format(pdfDateLayout))
+	if app.CurrentStageName != nil {
+		line("", 11, "Current stage: "+*app.CurrentStageName)
+	}
+
+	if heading("Stages") {
+		if len(stages) == 0 {
+			line("I", 10, "No stages yet")
+		}
+		for _, stage := range stages {
+			dates := "started " + stage.StartedAt.Format(pdfDateLayout)
+			if stage.CompletedAt != nil {
+				dates += ", completed " + stage.CompletedAt.Format(pdfDateLayout)
+			}
+			if !line("", 10, fmt.Sprintf("%d. %s (%s) - %s", stage.Order, stage.StageName, stage.Status, dates)) {
+				break
+			}
+		}
+	}
+
+	stageNames := make(map[string]string, len(stages))
+	for _, stage := range stages {
+		stageNames[stage.ID] = stage.StageName
+	}
+	comments := append(append([]*commentModel.CommentDTO{}, app.ApplicationComments...), app.StageComments...)
+
+	if heading("Comments") {
+		if len(comments) == 0 {
+			line("I", 10, "No comments yet")
+		}
+		for _, comment := range comments {
+			label := comment.CreatedAt.Format(pdfDateLayout)
+			if comment.StageID != nil {
+				if name, ok := stageNames[*comment.StageID]; ok {
+					label += " - " + name
+				}
+			}
+			if !line("B", 10, label) || !fits(pdfLineHeight) {
+				break
+			}
+			pdf.SetFont("Helvetica", "", 10)
+			pdf.MultiCell(contentWidth, 5, tr(comment.Content), "", "L", false)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render application PDF: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	commentModel "github.com/andreypavlenko/jobber/modules/comments/model"
+	companyModel "github.com/andreypavlenko/jobber/modules/companies/model"
+	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_ExportPDF(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+	appliedAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	setup := func() (*ApplicationService, *MockApplicationRepository, *MockStageRepository, *MockCommentRepository) {
+		svc, appRepo, stageRepo, templateRepo, jobRepo, companyRepo, _, commentRepo := createTestService()
+
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Status: "active", AppliedAt: appliedAt}, nil
+		}
+		companyID := "company-1"
+		jobRepo.GetByIDFunc = func(_ context.Context, _, jid string) (*jobModel.Job, error) {
+			return &jobModel.Job{ID: jid, Title: "Backend Engineer", CompanyID: &companyID}, nil
+		}
+		companyRepo.GetByIDFunc = func(_ context.Context, _, cid string) (*companyModel.Company, error) {
+			return &companyModel.Company{ID: cid, Name: "Acme Café"}, nil
+		}
+		templateRepo.ListFunc = func(_ context.Context, _ string, _, _ int) ([]*model.StageTemplate, int, error) {
+			return []*model.StageTemplate{{ID: "template-1", Name: "Phone Screen", Order: 1}}, 1, nil
+		}
+		completedAt := appliedAt.Add(72 * time.Hour)
+		stageRepo.ListByApplicationFunc = func(_ context.Context, _ string) ([]*model.ApplicationStage, error) {
+			return []*model.ApplicationStage{
+				{ID: "stage-1", StageTemplateID: "template-1", Status: "completed", Order: 1, StartedAt: appliedAt, CompletedAt: &completedAt},
+			}, nil
+		}
+		stageID := "stage-1"
+		commentRepo.ListByApplicationFunc = func(_ context.Context, _, _ string, _ ...string) ([]*commentModel.Comment, error) {
+			return []*commentModel.Comment{
+				{ID: "comment-1", Content: "Referred by a former colleague", CreatedAt: appliedAt},
+				{ID: "comment-2", StageID: &stageID, Content: "Went well", CreatedAt: completedAt},
+			}, nil
+		}
+		return svc, appRepo, stageRepo, commentRepo
+	}
+
+	t.Run("renders a single-page PDF", func(t *testing.T) {
+		svc, _, _, _ := setup()
+
+		data, err := svc.ExportPDF(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(data, []byte("%PDF-")), "output should start with the PDF magic bytes")
+		assert.Equal(t, 1, bytes.Count(data, []byte("/Type /Page\n")))
+	})
+
+	t.Run("stays on one page when content overflows", func(t *testing.T) {
+		svc, _, _, commentRepo := setup()
+		commentRepo.ListByApplicationFunc = func(_ context.Context, _, _ string, _ ...string) ([]*commentModel.Comment, error) {
+			comments := make([]*commentModel.Comment, 100)
+			for i := range comments {
+				comments[i] = &commentModel.Comment{ID: "c", Content: strings.Repeat("note ", 40), CreatedAt: appliedAt}
+			}
+			return comments, nil
+		}
+
+		data, err := svc.ExportPDF(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, bytes.Count(data, []byte("/Type /Page\n")))
+	})
+
+	t.Run("returns not found for another user's application", func(t *testing.T) {
+		svc, appRepo, _, _ := setup()
+		appRepo.GetByIDFunc = func(_ context.Context, _, _ string) (*model.Application, error) {
+			return nil, model.ErrApplicationNotFound
+		}
+
+		data, err := svc.ExportPDF(context.Background(), userID, appID)
+
+		assert.Nil(t, data)
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+
+	t.Run("returns error when stages cannot be loaded", func(t *testing.T) {
+		svc, _, stageRepo, _ := setup()
+		stageRepo.ListByApplicationFunc = func(_ context.Context, _ string) ([]*model.ApplicationStage, error) {
+			return nil, errors.New("db down")
+		}
+
+		data, err := svc.ExportPDF(context.Background(), userID, appID)
+
+		assert.Nil(t, data)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"net/mail"
+	"strings"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+)
+
+// applyReferralContact sets the referral contact of the application from the
+// request fields; nil leaves a field unchanged and a blank value clears it.
+// The email must be a plain address such as jane@example.com.
+func applyReferralContact(app *model.Application, name, email *string) error {
+	if email != nil {
+		trimmed := strings.TrimSpace(*email)
+		if trimmed == "" {
+			app.ReferralContactEmail = nil
+		} else {
+			if !isValidEmail(trimmed) {
+				return model.ErrInvalidReferralEmail
+			}
+			app.ReferralContactEmail = &trimmed
+		}
+	}
+
+	if name != nil {
+		trimmed := strings.TrimSpace(*name)
+		if trimmed == "" {
+			app.ReferralContactName = nil
+		} else {
+			app.ReferralContactName = &trimmed
+		}
+	}
+	return nil
+}
+
+// isValidEmail reports whether value is a bare email address, without a display name
+func isValidEmail(value string) bool {
+	addr, err := mail.ParseAddress(value)
+	return err == nil && addr.Address == value
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_Create_ReferralContact(t *testing.T) {
+	userID := "user-123"
+
+	setup := func() (*ApplicationService, *[]*model.Application) {
+		svc, appRepo, _, _, jobRepo, _, _, _ := createTestService()
+		jobRepo.GetByIDFunc = func(_ context.Context, _, jid string) (*jobModel.Job, error) {
+			return &jobModel.Job{ID: jid, Title: "Software Engineer"}, nil
+		}
+		var created []*model.Application
+		appRepo.CreateFunc = func(_ context.Context, app *model.Application) error {
+			app.ID = "app-1"
+			created = append(created, app)
+			return nil
+		}
+		return svc, &created
+	}
+
+	t.Run("stores the referral contact", func(t *testing.T) {
+		svc, created := setup()
+
+		dto, err := svc.Create(context.Background(), userID, &model.CreateApplicationRequest{
+			JobID:                "job-1",
+			ReferralContactName:  strPtr("  Jane Doe "),
+			ReferralContactEmail: strPtr("jane@example.com"),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, *created, 1)
+		assert.Equal(t, "Jane Doe", *(*created)[0].ReferralContactName)
+		assert.Equal(t, "jane@example.com", *(*created)[0].ReferralContactEmail)
+		require.NotNil(t, dto.ReferralContactName)
+		assert.Equal(t, "Jane Doe", *dto.ReferralContactName)
+	})
+
+	t.Run("rejects an invalid email", func(t *testing.T) {
+		for _, email := range []string{"jane", "Jane <jane@example.com>", "jane@"} {
+			svc, created := setup()
+
+			_, err := svc.Create(context.Background(), userID, &model.CreateApplicationRequest{
+				JobID:                "job-1",
+				ReferralContactEmail: strPtr(email),
+			})
+
+			assert.ErrorIs(t, err, model.ErrInvalidReferralEmail, email)
+			assert.Empty(t, *created)
+		}
+	})
+}
+
+func TestApplicationService_Update_ReferralContact(t *testing.T) {
+	name := "Jane Doe"
+	email := "jane@example.com"
+
+	setup := func() (*ApplicationService, **model.Application) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{
+				ID: aid, UserID: uid, JobID: "job-1", Status: "active",
+				ReferralContactName: &name, ReferralContactEmail: &email,
+			}, nil
+		}
+		var updated *model.Application
+		appRepo.UpdateFunc = func(_ context.Context, app *model.Application) error {
+			updated = app
+			return nil
+		}
+		return svc, &updated
+	}
+
+	t.Run("empty values clear the contact", func(t *testing.T) {
+		svc, updated := setup()
+
+		_, err := svc.Update(context.Background(), "user-123", "app-1", &model.UpdateApplicationRequest{
+			ReferralContactName:  strPtr(""),
+			ReferralContactEmail: strPtr(" "),
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, (*updated).ReferralContactName)
+		assert.Nil(t, (*updated).ReferralContactEmail)
+	})
+
+	t.Run("omitted fields are kept", func(t *testing.T) {
+		svc, updated := setup()
+
+		_, err := svc.Update(context.Background(), "user-123", "app-1", &model.UpdateApplicationRequest{
+			ReferralContactEmail: strPtr("john@example.com"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &name, (*updated).ReferralContactName)
+		assert.Equal(t, "john@example.com", *(*updated).ReferralContactEmail)
+	})
+
+	t.Run("rejects an invalid email", func(t *testing.T) {
+		svc, updated := setup()
+
+		_, err := svc.Update(context.Background(), "user-123", "app-1", &model.UpdateApplicationRequest{
+			ReferralContactEmail: strPtr("not-an-email"),
+		})
+
+		assert.ErrorIs(t, err, model.ErrInvalidReferralEmail)
+		assert.Nil(t, *updated)
+	})
+}
@@ -2,33 +2,68 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/andreypavlenko/jobber/internal/platform/logger"
+	"github.com/andreypavlenko/jobber/internal/platform/storage"
 	"github.com/andreypavlenko/jobber/modules/applications/model"
 	"github.com/andreypavlenko/jobber/modules/applications/ports"
 	commentModel "github.com/andreypavlenko/jobber/modules/comments/model"
 	commentPorts "github.com/andreypavlenko/jobber/modules/comments/ports"
 	companyModel "github.com/andreypavlenko/jobber/modules/companies/model"
 	companyPorts "github.com/andreypavlenko/jobber/modules/companies/ports"
+	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
 	jobPorts "github.com/andreypavlenko/jobber/modules/jobs/ports"
+	reminderModel "github.com/andreypavlenko/jobber/modules/reminders/model"
+	reminderPorts "github.com/andreypavlenko/jobber/modules/reminders/ports"
 	resumeModel "github.com/andreypavlenko/jobber/modules/resumes/model"
 	resumePorts "github.com/andreypavlenko/jobber/modules/resumes/ports"
 	rbPorts "github.com/andreypavlenko/jobber/modules/resumebuilder/ports"
+	tagModel "github.com/andreypavlenko/jobber/modules/tags/model"
+	tagPorts "github.com/andreypavlenko/jobber/modules/tags/ports"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
+// MaxSimilarJobs caps the similar job suggestions returned for an application
+const MaxSimilarJobs = 5
+
 // LimitChecker checks subscription limits before resource creation.
 type LimitChecker interface {
 	CheckLimit(ctx context.Context, userID, resource string) error
 }
 
+// ProfileInvalidator drops the cached user profile when tracked entity counts change.
+type ProfileInvalidator interface {
+	InvalidateProfile(ctx context.Context, userID string) error
+}
+
+// AnalyticsInvalidator drops the cached analytics when applications or stages change.
+type AnalyticsInvalidator interface {
+	InvalidateAnalytics(ctx context.Context, userID string) error
+}
+
+// StatusChangeNotifier is told when an application's status changes, e.g. to deliver webhooks.
+// Implementations must not block the caller.
+type StatusChangeNotifier interface {
+	NotifyStatusChanged(ctx context.Context, userID, appID, oldStatus, newStatus string)
+}
+
+// TxBeginner starts the transactions used for multi-table writes; satisfied by *pgxpool.Pool
+type TxBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
 type ApplicationService struct {
-	pool            *pgxpool.Pool
+	pool            TxBeginner
 	appRepo         ports.ApplicationRepository
 	stageRepo       ports.ApplicationStageRepository
 	templateRepo    ports.StageTemplateRepository
@@ -37,42 +72,134 @@ type ApplicationService struct {
 	resumeRepo      resumePorts.ResumeRepository
 	resumeBuilderRepo rbPorts.ResumeBuilderRepository
 	commentRepo     commentPorts.CommentRepository
+	tagRepo         tagPorts.TagRepository
+	reminderRepo    reminderPorts.ReminderRepository
+	storage         storage.ObjectStorage
 	log             *logger.Logger
 	limitChecker    LimitChecker
+	profileCache    ProfileInvalidator
+	analyticsCache  AnalyticsInvalidator
+	statusNotifier  StatusChangeNotifier
+	redisClient     *redis.Client
+	eventRepo       ports.ApplicationEventRepository
+	txRepos         TxRepositoryFactory
+	frontendURL     string
+}
+
+// ApplicationServiceConfig holds all dependencies for ApplicationService.
+// The repositories from AppRepo to CommentRepo are required; every other
+// dependency is optional and its feature is off while it is nil.
+type ApplicationServiceConfig struct {
+	Pool              *pgxpool.Pool
+	AppRepo           ports.ApplicationRepository
+	StageRepo         ports.ApplicationStageRepository
+	TemplateRepo      ports.StageTemplateRepository
+	JobRepo           jobPorts.JobRepository
+	CompanyRepo       companyPorts.CompanyRepository
+	ResumeRepo        resumePorts.ResumeRepository
+	ResumeBuilderRepo rbPorts.ResumeBuilderRepository
+	CommentRepo       commentPorts.CommentRepository
+	Logger            *logger.Logger
+	LimitChecker      LimitChecker
+	// FrontendURL is where share links point to, e.g. https://app.example.com;
+	// without it share links are relative paths
+	FrontendURL string
+
+	// TagRepo is used for bulk tagging and the tags column of exports
+	TagRepo tagPorts.TagRepository
+	// ReminderRepo resolves the next reminder and completes reminders of closed applications
+	ReminderRepo reminderPorts.ReminderRepository
+	// Storage holds cover letter files; without it uploads return ErrStorageNotConfigured
+	Storage storage.ObjectStorage
+	// ProfileCache is invalidated on create and delete
+	ProfileCache ProfileInvalidator
+	// AnalyticsCache is invalidated whenever an application or stage changes
+	AnalyticsCache AnalyticsInvalidator
+	// StatusNotifier is told about status changes made by Update, Archive and Unarchive
+	StatusNotifier StatusChangeNotifier
+	// RedisClient stores checklist progress; without it checklists are returned
+	// without progress and toggling fails with ErrChecklistUnavailable
+	RedisClient *redis.Client
+	// EventRepo and TxRepos enable the audit trail of application changes. Each
+	// change is then written in a transaction together with the event that records it.
+	EventRepo ports.ApplicationEventRepository
+	TxRepos   TxRepositoryFactory
 }
 
-func NewApplicationService(
-	pool *pgxpool.Pool,
-	appRepo ports.ApplicationRepository,
-	stageRepo ports.ApplicationStageRepository,
-	templateRepo ports.StageTemplateRepository,
-	jobRepo jobPorts.JobRepository,
-	companyRepo companyPorts.CompanyRepository,
-	resumeRepo resumePorts.ResumeRepository,
-	resumeBuilderRepo rbPorts.ResumeBuilderRepository,
-	commentRepo commentPorts.CommentRepository,
-	log *logger.Logger,
-	limitChecker ...LimitChecker,
-) *ApplicationService {
+// NewApplicationService creates a new application service
+func NewApplicationService(cfg ApplicationServiceConfig) *ApplicationService {
+	log := cfg.Logger
 	if log == nil {
 		log = &logger.Logger{Logger: zap.NewNop()}
 	}
-	svc := &ApplicationService{
-		pool:              pool,
-		appRepo:           appRepo,
-		stageRepo:         stageRepo,
-		templateRepo:      templateRepo,
-		jobRepo:           jobRepo,
-		companyRepo:       companyRepo,
-		resumeRepo:        resumeRepo,
-		resumeBuilderRepo: resumeBuilderRepo,
-		commentRepo:       commentRepo,
+	return &ApplicationService{
+		pool:              cfg.Pool,
+		appRepo:           cfg.AppRepo,
+		stageRepo:         cfg.StageRepo,
+		templateRepo:      cfg.TemplateRepo,
+		jobRepo:           cfg.JobRepo,
+		companyRepo:       cfg.CompanyRepo,
+		resumeRepo:        cfg.ResumeRepo,
+		resumeBuilderRepo: cfg.ResumeBuilderRepo,
+		commentRepo:       cfg.CommentRepo,
+		tagRepo:           cfg.TagRepo,
+		reminderRepo:      cfg.ReminderRepo,
+		storage:           cfg.Storage,
 		log:               log,
+		limitChecker:      cfg.LimitChecker,
+		profileCache:      cfg.ProfileCache,
+		analyticsCache:    cfg.AnalyticsCache,
+		statusNotifier:    cfg.StatusNotifier,
+		redisClient:       cfg.RedisClient,
+		eventRepo:         cfg.EventRepo,
+		txRepos:           cfg.TxRepos,
+		frontendURL:       strings.TrimRight(cfg.FrontendURL, "/"),
+	}
+}
+
+// StatusCounts counts the applications of all users per status, for the
+// metrics scrape. Failures only log and return nil.
+func (s *ApplicationService) StatusCounts(ctx context.Context) map[string]int {
+	counts, err := s.appRepo.GetTotalStatusCounts(ctx)
+	if err != nil {
+		s.log.Warn("failed to count applications for metrics", zap.Error(err))
+		return nil
+	}
+	return map[string]int{
+		"active":   counts.Active,
+		"on_hold":  counts.OnHold,
+		"offer":    counts.Offer,
+		"rejected": counts.Rejected,
+		"archived": counts.Archived,
+	}
+}
+
+// notifyStatusChanged tells the notifier, if any, about a status change
+func (s *ApplicationService) notifyStatusChanged(ctx context.Context, userID, appID, oldStatus, newStatus string) {
+	if s.statusNotifier == nil || oldStatus == newStatus {
+		return
+	}
+	s.statusNotifier.NotifyStatusChanged(ctx, userID, appID, oldStatus, newStatus)
+}
+
+// invalidateAnalytics drops the user's cached analytics; failures only log
+func (s *ApplicationService) invalidateAnalytics(ctx context.Context, userID string) {
+	if s.analyticsCache == nil {
+		return
 	}
-	if len(limitChecker) > 0 {
-		svc.limitChecker = limitChecker[0]
+	if err := s.analyticsCache.InvalidateAnalytics(ctx, userID); err != nil {
+		s.log.Warn("failed to invalidate analytics cache", zap.String("user_id", userID), zap.Error(err))
+	}
+}
+
+// invalidateProfile drops the user's cached profile counts; failures only log
+func (s *ApplicationService) invalidateProfile(ctx context.Context, userID string) {
+	if s.profileCache == nil {
+		return
+	}
+	if err := s.profileCache.InvalidateProfile(ctx, userID); err != nil {
+		s.log.Warn("failed to invalidate profile cache", zap.String("user_id", userID), zap.Error(err))
 	}
-	return svc
 }
 
 func (s *ApplicationService) Create(ctx context.Context, userID string, req *model.CreateApplicationRequest) (*model.ApplicationDTO, error) {
@@ -81,6 +208,12 @@ func (s *ApplicationService) Create(ctx context.Context, userID string, req *mod
 		return nil, model.ErrBothResumeTypesSet
 	}
 
+	if req.Metadata != nil {
+		if err := validateMetadata(req.Metadata); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check subscription limit
 	if s.limitChecker != nil {
 		if err := s.limitChecker.CheckLimit(ctx, userID, "applications"); err != nil {
@@ -111,18 +244,34 @@ func (s *ApplicationService) Create(ctx context.Context, userID string, req *mod
 		ResumeBuilderID: req.ResumeBuilderID,
 		Name:            name,
 		Status:          "active",
+		Metadata:        req.Metadata,
 		AppliedAt:       appliedAt,
+		DeadlineAt:      req.DeadlineAt,
+	}
+	if req.CoverLetterURL != nil {
+		setExternalCoverLetter(app, *req.CoverLetterURL)
+	}
+	if err := applyReferralContact(app, req.ReferralContactName, req.ReferralContactEmail); err != nil {
+		return nil, err
 	}
 
-	if err := s.appRepo.Create(ctx, app); err != nil {
+	if err := s.createApplication(ctx, app); err != nil {
 		return nil, err
 	}
+	s.invalidateProfile(ctx, userID)
+	s.invalidateAnalytics(ctx, userID)
 
 	// Fetch related entities for the response
 	return s.buildApplicationDTO(ctx, userID, app)
 }
 
 func (s *ApplicationService) GetByID(ctx context.Context, userID, appID string) (*model.ApplicationDTO, error) {
+	return s.GetByIDWithCommentSort(ctx, userID, appID, "asc")
+}
+
+// GetByIDWithCommentSort is GetByID with the nested comments ordered by creation
+// time in the given direction ("asc" or "desc")
+func (s *ApplicationService) GetByIDWithCommentSort(ctx context.Context, userID, appID, commentSort string) (*model.ApplicationDTO, error) {
 	app, err := s.appRepo.GetByID(ctx, userID, appID)
 	if err != nil {
 		return nil, err
@@ -135,7 +284,7 @@ func (s *ApplicationService) GetByID(ctx context.Context, userID, appID string)
 	}
 
 	// Fetch and split comments
-	comments, err := s.commentRepo.ListByApplication(ctx, appID)
+	comments, err := s.commentRepo.ListByApplication(ctx, appID, commentSort)
 	if err != nil {
 		// Log error but don't fail the request
 		s.log.Warn("failed to fetch comments for application", zap.String("application_id", appID), zap.Error(err))
@@ -146,6 +295,9 @@ func (s *ApplicationService) GetByID(ctx context.Context, userID, appID string)
 
 		for _, comment := range comments {
 			commentDTO := comment.ToDTO()
+			if comment.IsPinned {
+				dto.PinCount++
+			}
 			if comment.StageID == nil {
 				applicationComments = append(applicationComments, commentDTO)
 			} else {
@@ -232,19 +384,141 @@ func (s *ApplicationService) buildApplicationDTO(ctx context.Context, userID str
 		}
 	}
 
+	// Count stages
+	stages, err := s.stageRepo.ListByApplication(ctx, app.ID)
+	if err != nil {
+		s.log.Warn("failed to fetch stages for DTO", zap.String("application_id", app.ID), zap.Error(err))
+	} else {
+		dto.StageCount = len(stages)
+		for _, stage := range stages {
+			if stage.Status == "completed" {
+				dto.CompletedStageCount++
+			}
+		}
+	}
+
+	// Attach the next upcoming reminder (optional)
+	nextReminder, err := s.nextReminder(ctx, app.ID)
+	if err != nil {
+		s.log.Warn("failed to fetch next reminder", zap.String("application_id", app.ID), zap.Error(err))
+	} else {
+		dto.NextReminder = nextReminder
+	}
+
 	return dto, nil
 }
 
-func (s *ApplicationService) List(ctx context.Context, userID string, sortBy, sortDir, status string, limit, offset int) ([]*model.ApplicationDTO, int, error) {
-	opts := &ports.ListOptions{
-		Limit:   limit,
-		Offset:  offset,
-		SortBy:  sortBy,
-		SortDir: sortDir,
-		Status:  status,
+// GetNextReminder returns the earliest upcoming open reminder of an application,
+// or nil when there is none
+func (s *ApplicationService) GetNextReminder(ctx context.Context, userID, appID string) (*reminderModel.ReminderDTO, error) {
+	// Verify application belongs to user
+	if _, err := s.appRepo.GetByID(ctx, userID, appID); err != nil {
+		return nil, err
+	}
+	return s.nextReminder(ctx, appID)
+}
+
+// isValidStatus reports whether status is one an application can be set to
+func isValidStatus(status string) bool {
+	switch model.ApplicationStatus(status) {
+	case model.StatusActive, model.StatusOnHold, model.StatusRejected, model.StatusOffer, model.StatusArchived:
+		return true
 	}
+	return false
+}
 
-	return s.appRepo.ListEnriched(ctx, userID, opts)
+// isTerminalStatus reports whether an application in status needs no further follow-up
+func isTerminalStatus(status string) bool {
+	return status == string(model.StatusRejected) || status == string(model.StatusArchived)
+}
+
+// completeReminders marks the open reminders of a closed application as done.
+// Failures are logged so they never block the status change.
+func (s *ApplicationService) completeReminders(ctx context.Context, userID, appID string) {
+	if s.reminderRepo == nil {
+		return
+	}
+	if err := s.reminderRepo.MarkAllDoneByApplication(ctx, userID, appID); err != nil {
+		s.log.Warn("failed to complete reminders of closed application", zap.String("application_id", appID), zap.Error(err))
+	}
+}
+
+// nextReminder resolves the next reminder of an application; nil when none is upcoming
+func (s *ApplicationService) nextReminder(ctx context.Context, appID string) (*reminderModel.ReminderDTO, error) {
+	if s.reminderRepo == nil {
+		return nil, nil
+	}
+	reminder, err := s.reminderRepo.GetNextForApplication(ctx, appID)
+	if err != nil {
+		if errors.Is(err, reminderModel.ErrReminderNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return reminder.ToDTO(), nil
+}
+
+// FindSimilarJobs suggests other jobs of the user whose titles resemble the
+// application's job title. limit is clamped to 1..MaxSimilarJobs.
+func (s *ApplicationService) FindSimilarJobs(ctx context.Context, userID, appID string, limit int) ([]*jobModel.SimilarJobDTO, error) {
+	// Verify application belongs to user
+	app, err := s.appRepo.GetByID(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.jobRepo.GetByID(ctx, userID, app.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > MaxSimilarJobs {
+		limit = MaxSimilarJobs
+	}
+	return s.jobRepo.FindSimilarByTitle(ctx, userID, job.Title, job.ID, limit)
+}
+
+func (s *ApplicationService) List(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.ApplicationDTO, int, error) {
+	// GetByID is scoped to the user, so filtering by someone else's resume is reported as not found
+	if opts.ResumeID != nil {
+		if _, err := s.resumeRepo.GetByID(ctx, userID, *opts.ResumeID); err != nil {
+			if errors.Is(err, resumeModel.ErrResumeNotFound) {
+				return nil, 0, model.ErrResumeNotFound
+			}
+			return nil, 0, err
+		}
+	}
+
+	apps, total, err := s.appRepo.ListEnriched(ctx, userID, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(apps) == 0 {
+		return apps, total, nil
+	}
+
+	// Batch the stage counts for the whole page to avoid a query per application
+	appIDs := make([]string, len(apps))
+	for i, app := range apps {
+		appIDs[i] = app.ID
+	}
+	counts, err := s.appRepo.GetStageCounts(ctx, appIDs)
+	if err != nil {
+		s.log.Warn("failed to fetch stage counts", zap.Int("applications", len(appIDs)), zap.Error(err))
+		return apps, total, nil
+	}
+	for _, app := range apps {
+		count := counts[app.ID]
+		app.StageCount = count.Total
+		app.CompletedStageCount = count.Completed
+	}
+
+	return apps, total, nil
+}
+
+// GetStatusCounts returns quick per-status application counts for the user
+func (s *ApplicationService) GetStatusCounts(ctx context.Context, userID string) (*model.StatusCounts, error) {
+	return s.appRepo.GetStatusCounts(ctx, userID)
 }
 
 func (s *ApplicationService) Update(ctx context.Context, userID, appID string, req *model.UpdateApplicationRequest) (*model.ApplicationDTO, error) {
@@ -253,31 +527,384 @@ func (s *ApplicationService) Update(ctx context.Context, userID, appID string, r
 		return nil, err
 	}
 
+	previousStatus := app.Status
 	if req.Status != nil {
-		// Validate status
-		validStatuses := map[string]bool{
-			string(model.StatusActive):   true,
-			string(model.StatusOnHold):   true,
-			string(model.StatusRejected): true,
-			string(model.StatusOffer):    true,
-			string(model.StatusArchived): true,
-		}
-		if !validStatuses[*req.Status] {
+		if !isValidStatus(*req.Status) {
 			return nil, model.ErrInvalidStatus
 		}
 		app.Status = *req.Status
 	}
 
-	if err := s.appRepo.Update(ctx, app); err != nil {
+	var replacedCoverLetterKey *string
+	if req.CoverLetterURL != nil {
+		replacedCoverLetterKey = uploadedCoverLetterKey(app)
+		setExternalCoverLetter(app, *req.CoverLetterURL)
+	}
+
+	if req.Metadata != nil {
+		if err := validateMetadata(req.Metadata); err != nil {
+			return nil, err
+		}
+		app.Metadata = req.Metadata
+	}
+
+	if (req.OfferedSalary != nil && *req.OfferedSalary < 0) || (req.NegotiatedSalary != nil && *req.NegotiatedSalary < 0) {
+		return nil, model.ErrInvalidSalary
+	}
+	if req.OfferedSalary != nil {
+		app.OfferedSalary = req.OfferedSalary
+	}
+	if req.NegotiatedSalary != nil {
+		app.NegotiatedSalary = req.NegotiatedSalary
+	}
+
+	if req.ClearDeadline {
+		app.DeadlineAt = nil
+	} else if req.DeadlineAt != nil {
+		app.DeadlineAt = req.DeadlineAt
+	}
+
+	if err := applyOfferDetails(app, req); err != nil {
 		return nil, err
 	}
+	if err := applyReferralContact(app, req.ReferralContactName, req.ReferralContactEmail); err != nil {
+		return nil, err
+	}
+
+	err = s.inTransaction(ctx, func(repos *ports.TxRepositories) error {
+		if err := repos.Applications.Update(ctx, app); err != nil {
+			return err
+		}
+		if app.Status == previousStatus {
+			return nil
+		}
+		return recordEvent(ctx, repos.Events, userID, appID, model.EventApplicationStatusChanged, statusChangedPayload(previousStatus, app.Status))
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.deleteCoverLetterFile(ctx, replacedCoverLetterKey)
+	s.invalidateAnalytics(ctx, userID)
+	if req.Status != nil && isTerminalStatus(*req.Status) {
+		s.completeReminders(ctx, userID, appID)
+	}
+	s.notifyStatusChanged(ctx, userID, appID, previousStatus, app.Status)
 
 	// Return DTO with nested entities
 	return s.buildApplicationDTO(ctx, userID, app)
 }
 
+// UpdateResume switches the uploaded resume attached to an application and
+// records the change as a comment on the application
+func (s *ApplicationService) UpdateResume(ctx context.Context, userID, appID, resumeID string) (*model.ApplicationDTO, error) {
+	app, err := s.appRepo.GetByID(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	// GetByID is scoped to the user, so a resume owned by someone else is reported as not found
+	resume, err := s.resumeRepo.GetByID(ctx, userID, resumeID)
+	if err != nil {
+		if errors.Is(err, resumeModel.ErrResumeNotFound) {
+			return nil, model.ErrResumeNotFound
+		}
+		return nil, err
+	}
+
+	if err := s.appRepo.UpdateResume(ctx, userID, appID, resume.ID); err != nil {
+		return nil, err
+	}
+	app.ResumeID = &resume.ID
+	app.ResumeBuilderID = nil
+	s.invalidateAnalytics(ctx, userID)
+
+	comment := &commentModel.Comment{
+		UserID:        userID,
+		ApplicationID: appID,
+		Content:       "Resume updated to: " + resume.Title,
+	}
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		s.log.Error("failed to create comment for resume change", zap.String("application_id", appID), zap.Error(err))
+	}
+
+	s.log.Info("application resume updated",
+		zap.String("application_id", appID),
+		zap.String("resume_id", resume.ID),
+	)
+
+	return s.buildApplicationDTO(ctx, userID, app)
+}
+
+// Archive moves an application to the archived status and records when it happened.
+// Archiving an already archived application returns ErrInvalidStatusTransition.
+func (s *ApplicationService) Archive(ctx context.Context, userID, appID string) (*model.ApplicationDTO, error) {
+	app, err := s.appRepo.GetByID(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+	if app.Status == string(model.StatusArchived) {
+		return nil, model.ErrInvalidStatusTransition
+	}
+
+	archivedAt := time.Now().UTC()
+	return s.setArchiveStatus(ctx, app, string(model.StatusArchived), &archivedAt, "Application archived")
+}
+
+// Unarchive returns an archived application to the active status.
+// Only archived applications can be unarchived; anything else returns ErrInvalidStatusTransition.
+func (s *ApplicationService) Unarchive(ctx context.Context, userID, appID string) (*model.ApplicationDTO, error) {
+	app, err := s.appRepo.GetByID(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+	if app.Status != string(model.StatusArchived) {
+		return nil, model.ErrInvalidStatusTransition
+	}
+
+	return s.setArchiveStatus(ctx, app, string(model.StatusActive), nil, "Application unarchived")
+}
+
+// setArchiveStatus persists the archive state change and leaves an audit comment on the application
+func (s *ApplicationService) setArchiveStatus(ctx context.Context, app *model.Application, status string, archivedAt *time.Time, auditText string) (*model.ApplicationDTO, error) {
+	previousStatus := app.Status
+	err := s.inTransaction(ctx, func(repos *ports.TxRepositories) error {
+		if err := repos.Applications.SetArchiveStatus(ctx, app.UserID, app.ID, status, archivedAt); err != nil {
+			return err
+		}
+		return recordEvent(ctx, repos.Events, app.UserID, app.ID, model.EventApplicationStatusChanged, statusChangedPayload(previousStatus, status))
+	})
+	if err != nil {
+		return nil, err
+	}
+	app.Status = status
+	app.ArchivedAt = archivedAt
+	s.invalidateAnalytics(ctx, app.UserID)
+	if isTerminalStatus(status) {
+		s.completeReminders(ctx, app.UserID, app.ID)
+	}
+	s.notifyStatusChanged(ctx, app.UserID, app.ID, previousStatus, status)
+
+	comment := &commentModel.Comment{
+		UserID:        app.UserID,
+		ApplicationID: app.ID,
+		Content:       auditText,
+	}
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		s.log.Error("failed to create comment for archive status change", zap.String("application_id", app.ID), zap.Error(err))
+	}
+
+	s.log.Info("application archive status changed",
+		zap.String("application_id", app.ID),
+		zap.String("old_status", previousStatus),
+		zap.String("new_status", status),
+	)
+
+	return s.buildApplicationDTO(ctx, app.UserID, app)
+}
+
+// maxMetadataSize is the maximum size of serialized application metadata
+const maxMetadataSize = 10 * 1024 // 10KB
+
+// validateMetadata checks that metadata stays within maxMetadataSize once serialized
+func validateMetadata(metadata map[string]interface{}) error {
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to serialize metadata: %w", err)
+	}
+	if len(data) > maxMetadataSize {
+		return model.ErrMetadataTooLarge
+	}
+	return nil
+}
+
+// setExternalCoverLetter points the application at an externally hosted cover letter.
+// An empty URL removes the cover letter.
+func setExternalCoverLetter(app *model.Application, url string) {
+	url = strings.TrimSpace(url)
+	if url == "" {
+		app.CoverLetterURL = nil
+		app.CoverLetterStorageType = nil
+		return
+	}
+	storageType := model.CoverLetterStorageExternal
+	app.CoverLetterURL = &url
+	app.CoverLetterStorageType = &storageType
+}
+
+// uploadedCoverLetterKey returns the storage key of the application's uploaded
+// cover letter, or nil when it has none or links an external one
+func uploadedCoverLetterKey(app *model.Application) *string {
+	if app.CoverLetterStorageType == nil || *app.CoverLetterStorageType != model.CoverLetterStorageS3 {
+		return nil
+	}
+	return app.CoverLetterURL
+}
+
+// deleteCoverLetterFile removes a cover letter file the application no longer
+// references. Failures are logged and otherwise ignored; an orphaned file is
+// less harmful than failing a request whose database change already succeeded.
+func (s *ApplicationService) deleteCoverLetterFile(ctx context.Context, key *string) {
+	if key == nil || s.storage == nil {
+		return
+	}
+	if err := s.storage.DeleteObject(ctx, *key); err != nil {
+		s.log.Error("failed to delete cover letter file", zap.String("key", *key), zap.Error(err))
+	}
+}
+
+// UploadCoverLetter stores a cover letter file in S3 under cover_letters/{userID}/{appID}/{uuid}{ext}
+// and attaches it to the application, replacing any previous cover letter. The file
+// type is detected from its contents; anything but PDF, DOC, DOCX or plain text
+// returns ErrUnsupportedCoverLetter.
+func (s *ApplicationService) UploadCoverLetter(ctx context.Context, userID, appID string, data []byte) (*model.ApplicationDTO, error) {
+	contentType, _ := storage.DetectDocumentType(data)
+	ext, ok := model.CoverLetterFileExtensions[contentType]
+	if !ok {
+		return nil, model.ErrUnsupportedCoverLetter
+	}
+
+	if s.storage == nil {
+		return nil, model.ErrStorageNotConfigured
+	}
+
+	app, err := s.appRepo.GetByID(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+	previousKey := uploadedCoverLetterKey(app)
+
+	storageKey := fmt.Sprintf("cover_letters/%s/%s/%s%s", userID, appID, uuid.New().String(), ext)
+	if err := s.storage.PutObject(ctx, storageKey, contentType, data); err != nil {
+		return nil, fmt.Errorf("failed to upload cover letter: %w", err)
+	}
+
+	storageType := model.CoverLetterStorageS3
+	app.CoverLetterURL = &storageKey
+	app.CoverLetterStorageType = &storageType
+
+	if err := s.appRepo.Update(ctx, app); err != nil {
+		s.deleteCoverLetterFile(ctx, &storageKey)
+		return nil, err
+	}
+	s.deleteCoverLetterFile(ctx, previousKey)
+
+	s.log.Info("cover letter uploaded",
+		zap.String("user_id", userID),
+		zap.String("application_id", appID),
+		zap.Int("size", len(data)))
+
+	return s.buildApplicationDTO(ctx, userID, app)
+}
+
+// GenerateCoverLetterDownloadURL returns a presigned URL for an application's uploaded cover letter
+func (s *ApplicationService) GenerateCoverLetterDownloadURL(ctx context.Context, userID, appID string) (*model.CoverLetterDownloadURLResponse, error) {
+	if s.storage == nil {
+		return nil, model.ErrStorageNotConfigured
+	}
+
+	app, err := s.appRepo.GetByID(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	if app.CoverLetterStorageType == nil || *app.CoverLetterStorageType != model.CoverLetterStorageS3 || app.CoverLetterURL == nil {
+		return nil, model.ErrCoverLetterNotFound
+	}
+
+	// Generate presigned download URL (15 minutes expiry)
+	expiry := 15 * time.Minute
+	downloadURL, err := s.storage.GeneratePresignedDownloadURL(ctx, *app.CoverLetterURL, expiry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate download URL: %w", err)
+	}
+
+	return &model.CoverLetterDownloadURLResponse{
+		DownloadURL: downloadURL,
+		ExpiresIn:   int(expiry.Seconds()),
+	}, nil
+}
+
+// ShareApplication enables a public read-only link for an application.
+// Sharing an already shared application returns the existing link.
+func (s *ApplicationService) ShareApplication(ctx context.Context, userID, appID string) (*model.ShareApplicationResponse, error) {
+	token, err := s.appRepo.EnableSharing(ctx, userID, appID, uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ShareApplicationResponse{
+		ShareToken: token,
+		ShareURL:   s.frontendURL + "/share/" + token,
+	}, nil
+}
+
+// RevokeShare disables the public link for an application
+func (s *ApplicationService) RevokeShare(ctx context.Context, userID, appID string) error {
+	return s.appRepo.DisableSharing(ctx, userID, appID)
+}
+
+// GetShared returns the public, read-only view of a shared application
+func (s *ApplicationService) GetShared(ctx context.Context, token string) (*model.ApplicationDTO, error) {
+	if _, err := uuid.Parse(token); err != nil {
+		return nil, model.ErrInvalidShareToken
+	}
+
+	app, err := s.appRepo.GetByShareToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	dto, err := s.buildApplicationDTO(ctx, app.UserID, app)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToShared(), nil
+}
+
+// Delete moves an application to the trash, from where it can be restored
 func (s *ApplicationService) Delete(ctx context.Context, userID, appID string) error {
-	return s.appRepo.Delete(ctx, userID, appID)
+	if err := s.appRepo.Delete(ctx, userID, appID); err != nil {
+		return err
+	}
+	s.invalidateProfile(ctx, userID)
+	s.invalidateAnalytics(ctx, userID)
+	return nil
+}
+
+// Restore takes an application out of the trash and returns it.
+// Applications that are not in the trash return ErrApplicationNotFound.
+// Trashed applications do not count towards the plan limit, so the limit
+// is checked again before one comes back.
+func (s *ApplicationService) Restore(ctx context.Context, userID, appID string) (*model.ApplicationDTO, error) {
+	if s.limitChecker != nil {
+		if err := s.limitChecker.CheckLimit(ctx, userID, "applications"); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.appRepo.Restore(ctx, userID, appID); err != nil {
+		return nil, err
+	}
+	s.invalidateProfile(ctx, userID)
+	s.invalidateAnalytics(ctx, userID)
+
+	app, err := s.appRepo.GetByID(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildApplicationDTO(ctx, userID, app)
+}
+
+// DeletePermanently irreversibly deletes an application, whether or not it is in the trash,
+// along with its uploaded cover letter file
+func (s *ApplicationService) DeletePermanently(ctx context.Context, userID, appID string) error {
+	coverLetterKey, err := s.appRepo.DeletePermanently(ctx, userID, appID)
+	if err != nil {
+		return err
+	}
+	s.deleteCoverLetterFile(ctx, coverLetterKey)
+	s.invalidateProfile(ctx, userID)
+	s.invalidateAnalytics(ctx, userID)
+	return nil
 }
 
 // Stage management
@@ -285,14 +912,22 @@ func (s *ApplicationService) Delete(ctx context.Context, userID, appID string) e
 // AddStage adds a new stage to an application following append-only semantics.
 // All write operations are wrapped in a database transaction for atomicity.
 func (s *ApplicationService) AddStage(ctx context.Context, userID, appID string, req *model.AddStageRequest) (*model.ApplicationStageDTO, error) {
+	hasTemplate := req.StageTemplateID != ""
+	hasName := req.Name != nil && strings.TrimSpace(*req.Name) != ""
+	if hasTemplate && hasName {
+		return nil, model.ErrAmbiguousStageInput
+	}
+	if !hasTemplate && !hasName {
+		return nil, model.ErrStageInputRequired
+	}
+
 	// Verify application belongs to user (read, outside tx)
 	app, err := s.appRepo.GetByID(ctx, userID, appID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Verify stage template exists and belongs to user (read, outside tx)
-	template, err := s.templateRepo.GetByID(ctx, userID, req.StageTemplateID)
+	template, err := s.resolveStageTemplate(ctx, userID, req)
 	if err != nil {
 		return nil, err
 	}
@@ -313,6 +948,7 @@ func (s *ApplicationService) AddStage(ctx context.Context, userID, appID string,
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback(ctx) //nolint:errcheck // rollback is a no-op after commit
+	events := s.txEvents(tx)
 
 	// Complete the current active stage (if any)
 	if app.CurrentStageID != nil && *app.CurrentStageID != "" {
@@ -333,6 +969,26 @@ func (s *ApplicationService) AddStage(ctx context.Context, userID, appID string,
 			if err != nil {
 				return nil, fmt.Errorf("failed to complete current stage: %w", err)
 			}
+			previousStatus := currentStage.Status
+			currentStage.Status = "completed"
+			currentStage.CompletedAt = &now
+			if err := recordEvent(ctx, events, userID, appID, model.EventStageCompleted, stageCompletedPayload(currentStage, previousStageName, previousStatus)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Create the ad-hoc template in the same transaction so a failed stage
+	// insert does not leave it behind; it is ordered after the user's others
+	if template.ID == "" {
+		template.ID = uuid.New().String()
+		_, err = tx.Exec(ctx,
+			`INSERT INTO stage_templates (id, user_id, name, "order", created_at, updated_at)
+			 VALUES ($1, $2, $3, COALESCE((SELECT MAX("order") FROM stage_templates WHERE user_id = $2), 0) + 1, $4, $4)`,
+			template.ID, userID, template.Name, now,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stage template: %w", err)
 		}
 	}
 
@@ -342,7 +998,7 @@ func (s *ApplicationService) AddStage(ctx context.Context, userID, appID string,
 	_, err = tx.Exec(ctx,
 		`INSERT INTO application_stages (id, application_id, stage_template_id, status, "order", started_at, completed_at, created_at)
 		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
-		newStageID, appID, req.StageTemplateID, "active", order, now, nil, createdAt,
+		newStageID, appID, template.ID, "active", order, now, nil, createdAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create stage: %w", err)
@@ -357,24 +1013,43 @@ func (s *ApplicationService) AddStage(ctx context.Context, userID, appID string,
 		return nil, fmt.Errorf("failed to update application current stage: %w", err)
 	}
 
-	// Commit transaction
-	if err := tx.Commit(ctx); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	// Build the stage DTO for the response
+	stage := &model.ApplicationStage{
+		ID:              newStageID,
+		ApplicationID:   appID,
+		StageTemplateID: template.ID,
+		Status:          "active",
+		Order:           order,
+		StartedAt:       now,
+		CreatedAt:       createdAt,
+	}
+	if err := recordEvent(ctx, events, userID, appID, model.EventStageAdded, stageEventPayload(stage, template.Name)); err != nil {
+		return nil, err
 	}
 
-	// Create comment if provided (outside tx — non-critical)
+	// Save the optional comment in the same transaction so a failed insert
+	// rolls back the stage change as well
 	if req.Comment != nil && strings.TrimSpace(*req.Comment) != "" {
-		comment := &commentModel.Comment{
-			UserID:        userID,
-			ApplicationID: appID,
-			StageID:       &newStageID,
-			Content:       strings.TrimSpace(*req.Comment),
+		commentID, content := uuid.New().String(), strings.TrimSpace(*req.Comment)
+		_, err = tx.Exec(ctx,
+			`INSERT INTO comments (id, user_id, application_id, stage_id, content, created_at, updated_at)
+			 VALUES ($1, $2, $3, $4, $5, $6, $6)`,
+			commentID, userID, appID, newStageID, content, createdAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stage comment: %w", err)
 		}
-		if err := s.commentRepo.Create(ctx, comment); err != nil {
-			s.log.Error("failed to create comment for stage", zap.Error(err))
+		if err := recordEvent(ctx, events, userID, appID, model.EventCommentAdded, commentEventPayload(commentID, &newStageID, content)); err != nil {
+			return nil, err
 		}
 	}
 
+	// Commit transaction
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	s.invalidateAnalytics(ctx, userID)
+
 	// Log the stage change
 	if previousStageName != "" {
 		s.log.Info("stage changed",
@@ -389,20 +1064,19 @@ func (s *ApplicationService) AddStage(ctx context.Context, userID, appID string,
 			zap.String("user_id", userID))
 	}
 
-	// Build the stage DTO for the response
-	stage := &model.ApplicationStage{
-		ID:              newStageID,
-		ApplicationID:   appID,
-		StageTemplateID: req.StageTemplateID,
-		Status:          "active",
-		Order:           order,
-		StartedAt:       now,
-		CreatedAt:       createdAt,
-	}
-
 	return stage.ToDTO(template.Name), nil
 }
 
+// resolveStageTemplate returns the stage template for a new stage. A template
+// ID is verified to exist and belong to the user (read, outside tx); a bare
+// name yields an unsaved template that AddStage inserts alongside the stage.
+func (s *ApplicationService) resolveStageTemplate(ctx context.Context, userID string, req *model.AddStageRequest) (*model.StageTemplate, error) {
+	if req.StageTemplateID != "" {
+		return s.templateRepo.GetByID(ctx, userID, req.StageTemplateID)
+	}
+	return &model.StageTemplate{UserID: userID, Name: strings.TrimSpace(*req.Name)}, nil
+}
+
 func (s *ApplicationService) CompleteStage(ctx context.Context, userID, appID, stageID string, req *model.CompleteStageRequest) (*model.ApplicationStageDTO, error) {
 	// Verify application belongs to user
 	_, err := s.appRepo.GetByID(ctx, userID, appID)
@@ -419,23 +1093,37 @@ func (s *ApplicationService) CompleteStage(ctx context.Context, userID, appID, s
 		return nil, model.ErrApplicationStageNotFound
 	}
 
+	// Get template for the DTO and the audit trail
+	template, err := s.templateRepo.GetByID(ctx, userID, stage.StageTemplateID)
+	if err != nil {
+		return nil, err
+	}
+
 	completedAt := time.Now().UTC()
 	if req.CompletedAt != nil {
 		completedAt = *req.CompletedAt
 	}
 
+	previousStatus := stage.Status
 	stage.Status = "completed"
 	stage.CompletedAt = &completedAt
-
-	if err := s.stageRepo.Update(ctx, stage); err != nil {
-		return nil, err
+	if req.Notes != nil {
+		stage.Notes = stageNotes(*req.Notes)
 	}
 
-	// Get template for DTO
-	template, err := s.templateRepo.GetByID(ctx, userID, stage.StageTemplateID)
+	err = s.inTransaction(ctx, func(repos *ports.TxRepositories) error {
+		if err := repos.Stages.Update(ctx, stage); err != nil {
+			return err
+		}
+		if previousStatus == "completed" {
+			return nil
+		}
+		return recordEvent(ctx, repos.Events, userID, appID, model.EventStageCompleted, stageCompletedPayload(stage, template.Name, previousStatus))
+	})
 	if err != nil {
 		return nil, err
 	}
+	s.invalidateAnalytics(ctx, userID)
 
 	return stage.ToDTO(template.Name), nil
 }
@@ -463,10 +1151,21 @@ func (s *ApplicationService) ListStages(ctx context.Context, userID, appID strin
 		templateMap[t.ID] = t.Name
 	}
 
+	stageIDs := make([]string, len(stages))
+	for i, stage := range stages {
+		stageIDs[i] = stage.ID
+	}
+
+	commentCounts, err := s.commentRepo.CountByStage(ctx, stageIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	dtos := make([]*model.ApplicationStageDTO, len(stages))
 	for i, stage := range stages {
 		stageName := templateMap[stage.StageTemplateID]
 		dtos[i] = stage.ToDTO(stageName)
+		dtos[i].CommentCount = commentCounts[stage.ID]
 	}
 
 	return dtos, nil
@@ -478,11 +1177,15 @@ func (s *ApplicationService) CreateStageTemplate(ctx context.Context, userID str
 	if strings.TrimSpace(req.Name) == "" {
 		return nil, model.ErrStageNameRequired
 	}
+	if err := validateStageTemplateDescription(req.Description); err != nil {
+		return nil, err
+	}
 
 	template := &model.StageTemplate{
-		UserID: userID,
-		Name:   strings.TrimSpace(req.Name),
-		Order:  req.Order,
+		UserID:      userID,
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		Order:       req.Order,
 	}
 
 	if err := s.templateRepo.Create(ctx, template); err != nil {
@@ -504,6 +1207,53 @@ func (s *ApplicationService) ListStageTemplates(ctx context.Context, userID stri
 	return dtos, total, nil
 }
 
+// MergeStageTemplates moves every stage using fromID to intoID and deletes fromID,
+// e.g. to clean up "Phone Screen" and "Phone screen" duplicates
+func (s *ApplicationService) MergeStageTemplates(ctx context.Context, userID, fromID, intoID string) (*model.MergeStageTemplatesResponse, error) {
+	if fromID == intoID {
+		return nil, model.ErrMergeIntoSelf
+	}
+
+	merged, err := s.templateRepo.MergeInto(ctx, fromID, intoID, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateAnalytics(ctx, userID)
+
+	s.log.Info("stage templates merged",
+		zap.String("from_template_id", fromID),
+		zap.String("into_template_id", intoID),
+		zap.Int("merged_stages", merged),
+		zap.String("user_id", userID))
+	return &model.MergeStageTemplatesResponse{MergedStages: merged}, nil
+}
+
+// ReorderStageTemplates orders the user's stage templates as listed in templateIDs,
+// e.g. after a drag-and-drop; every template must be listed exactly once
+func (s *ApplicationService) ReorderStageTemplates(ctx context.Context, userID string, templateIDs []string) ([]*model.StageTemplateDTO, error) {
+	templates, err := s.templateRepo.Reorder(ctx, userID, templateIDs)
+	if err != nil {
+		return nil, err
+	}
+	// Funnel analytics follow the template order
+	s.invalidateAnalytics(ctx, userID)
+
+	s.log.Info("stage templates reordered",
+		zap.Int("templates", len(templates)),
+		zap.String("user_id", userID))
+
+	dtos := make([]*model.StageTemplateDTO, len(templates))
+	for i, t := range templates {
+		dtos[i] = t.ToDTO()
+	}
+	return dtos, nil
+}
+
+// GetStageTemplateUsageStats returns the user's stage templates ordered by how often they are used
+func (s *ApplicationService) GetStageTemplateUsageStats(ctx context.Context, userID string) ([]*model.StageTemplateUsage, error) {
+	return s.templateRepo.GetUsageStats(ctx, userID)
+}
+
 func (s *ApplicationService) UpdateStageTemplate(ctx context.Context, userID, templateID string, req *model.UpdateStageTemplateRequest) (*model.StageTemplateDTO, error) {
 	template, err := s.templateRepo.GetByID(ctx, userID, templateID)
 	if err != nil {
@@ -516,6 +1266,12 @@ func (s *ApplicationService) UpdateStageTemplate(ctx context.Context, userID, te
 		}
 		template.Name = strings.TrimSpace(*req.Name)
 	}
+	if req.Description != nil {
+		if err := validateStageTemplateDescription(req.Description); err != nil {
+			return nil, err
+		}
+		template.Description = req.Description
+	}
 	if req.Order != nil {
 		template.Order = *req.Order
 	}
@@ -523,11 +1279,159 @@ func (s *ApplicationService) UpdateStageTemplate(ctx context.Context, userID, te
 	if err := s.templateRepo.Update(ctx, template); err != nil {
 		return nil, err
 	}
+	if req.Name != nil {
+		// Stage analytics are reported under the template name
+		s.invalidateAnalytics(ctx, userID)
+	}
 	return template.ToDTO(), nil
 }
 
+// validateStageTemplateDescription enforces the description length limit, counted in characters
+func validateStageTemplateDescription(description *string) error {
+	if description != nil && utf8.RuneCountInString(*description) > model.MaxStageTemplateDescriptionLength {
+		return model.ErrDescriptionTooLong
+	}
+	return nil
+}
+
 func (s *ApplicationService) DeleteStageTemplate(ctx context.Context, userID, templateID string) error {
-	return s.templateRepo.Delete(ctx, userID, templateID)
+	if err := s.templateRepo.Delete(ctx, userID, templateID); err != nil {
+		return err
+	}
+	s.invalidateAnalytics(ctx, userID)
+	return nil
+}
+
+// ListDefaultStageTemplates returns the recommended stage templates without persisting them
+func (s *ApplicationService) ListDefaultStageTemplates() []*model.StageTemplateDTO {
+	return model.DefaultStageTemplates()
+}
+
+// ApplyDefaultStageTemplates creates the recommended stage templates for a user who has none.
+// It is idempotent: if the user already has any templates nothing is created.
+// All templates are inserted in a single transaction; an advisory lock on the user
+// serializes concurrent calls so the defaults cannot be created twice.
+func (s *ApplicationService) ApplyDefaultStageTemplates(ctx context.Context, userID string) (*model.ApplyDefaultStageTemplatesResponse, error) {
+	_, total, err := s.templateRepo.List(ctx, userID, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if total > 0 {
+		return &model.ApplyDefaultStageTemplatesResponse{Created: 0, Templates: []*model.StageTemplateDTO{}}, nil
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // rollback is a no-op after commit
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, userID); err != nil {
+		return nil, fmt.Errorf("failed to lock stage templates: %w", err)
+	}
+
+	var existing int
+	if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM stage_templates WHERE user_id = $1`, userID).Scan(&existing); err != nil {
+		return nil, fmt.Errorf("failed to count stage templates: %w", err)
+	}
+	if existing > 0 {
+		return &model.ApplyDefaultStageTemplatesResponse{Created: 0, Templates: []*model.StageTemplateDTO{}}, nil
+	}
+
+	now := time.Now().UTC()
+	templates := make([]*model.StageTemplateDTO, 0, len(model.DefaultStageTemplateSet))
+	for i, def := range model.DefaultStageTemplateSet {
+		description := def.Description
+		template := &model.StageTemplate{
+			ID:          uuid.New().String(),
+			UserID:      userID,
+			Name:        def.Name,
+			Description: &description,
+			Order:       i + 1,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		_, err := tx.Exec(ctx,
+			`INSERT INTO stage_templates (id, user_id, name, description, "order", created_at, updated_at)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			template.ID, template.UserID, template.Name, template.Description, template.Order, template.CreatedAt, template.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create default stage template: %w", err)
+		}
+		templates = append(templates, template.ToDTO())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.log.Info("default stage templates applied",
+		zap.String("user_id", userID),
+		zap.Int("count", len(templates)))
+
+	return &model.ApplyDefaultStageTemplatesResponse{Created: len(templates), Templates: templates}, nil
+}
+
+// BulkTag adds or removes the given tags on many applications at once.
+// Every application and tag must belong to the user; ownership is checked with one
+// query per entity type before any relation is touched. Adding a tag that is already
+// attached is a no-op, so AffectedRelations only counts relations actually changed.
+func (s *ApplicationService) BulkTag(ctx context.Context, userID string, req *model.BulkTagRequest) (*model.BulkTagResponse, error) {
+	appIDs := uniqueStrings(req.ApplicationIDs)
+	tagIDs := uniqueStrings(req.TagIDs)
+
+	ownedApps, err := s.appRepo.ListOwnedIDs(ctx, userID, appIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify applications: %w", err)
+	}
+	if len(ownedApps) != len(appIDs) {
+		return nil, model.ErrApplicationNotFound
+	}
+
+	ownedTags, err := s.tagRepo.ListOwnedIDs(ctx, userID, tagIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify tags: %w", err)
+	}
+	if len(ownedTags) != len(tagIDs) {
+		return nil, model.ErrTagNotFound
+	}
+
+	var affected int64
+	switch req.Action {
+	case model.BulkTagActionAdd:
+		affected, err = s.tagRepo.AddRelations(ctx, tagIDs, tagModel.EntityTypeApplication, appIDs)
+	case model.BulkTagActionRemove:
+		affected, err = s.tagRepo.RemoveRelations(ctx, tagIDs, tagModel.EntityTypeApplication, appIDs)
+	default:
+		return nil, fmt.Errorf("unsupported bulk tag action %q", req.Action)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s tags: %w", req.Action, err)
+	}
+
+	s.log.Info("bulk tag applied",
+		zap.String("user_id", userID),
+		zap.String("action", req.Action),
+		zap.Int("applications", len(appIDs)),
+		zap.Int("tags", len(tagIDs)),
+		zap.Int64("affected_relations", affected))
+
+	return &model.BulkTagResponse{AffectedRelations: affected}, nil
+}
+
+// uniqueStrings returns values with duplicates removed, preserving order
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
 }
 
 // UpdateStage updates a stage's status and other fields
@@ -556,6 +1460,8 @@ func (s *ApplicationService) UpdateStage(ctx context.Context, userID, appID, sta
 
 	s.log.Debug("current stage status", zap.String("status", stage.Status), zap.Any("requested_status", req.Status))
 
+	previousStatus := stage.Status
+
 	// Update status if provided
 	if req.Status != nil {
 		validStatuses := map[string]bool{
@@ -585,23 +1491,35 @@ func (s *ApplicationService) UpdateStage(ctx context.Context, userID, appID, sta
 		stage.CompletedAt = nil
 	}
 
-	s.log.Debug("about to update stage in DB", zap.String("status", stage.Status))
-
-	// Update in database
-	if err := s.stageRepo.Update(ctx, stage); err != nil {
-		s.log.Error("failed to update stage in DB", zap.Error(err))
-		return nil, err
+	if req.Notes != nil {
+		stage.Notes = stageNotes(*req.Notes)
 	}
 
-	s.log.Debug("stage updated, fetching template", zap.String("stage_template_id", stage.StageTemplateID))
+	s.log.Debug("about to update stage in DB", zap.String("status", stage.Status))
 
-	// Get template for DTO
+	// Get template for DTO and the audit trail
 	template, err := s.templateRepo.GetByID(ctx, userID, stage.StageTemplateID)
 	if err != nil {
 		s.log.Error("failed to get template", zap.String("stage_template_id", stage.StageTemplateID), zap.Error(err))
 		return nil, err
 	}
 
+	// Update in database
+	err = s.inTransaction(ctx, func(repos *ports.TxRepositories) error {
+		if err := repos.Stages.Update(ctx, stage); err != nil {
+			return err
+		}
+		if stage.Status != "completed" || previousStatus == "completed" {
+			return nil
+		}
+		return recordEvent(ctx, repos.Events, userID, appID, model.EventStageCompleted, stageCompletedPayload(stage, template.Name, previousStatus))
+	})
+	if err != nil {
+		s.log.Error("failed to update stage in DB", zap.Error(err))
+		return nil, err
+	}
+	s.invalidateAnalytics(ctx, userID)
+
 	// Log the status change
 	s.log.Info("stage status updated",
 		zap.String("application_id", appID),
@@ -612,6 +1530,15 @@ func (s *ApplicationService) UpdateStage(ctx context.Context, userID, appID, sta
 	return stage.ToDTO(template.Name), nil
 }
 
+// stageNotes trims notes for storage; blank notes clear the field.
+func stageNotes(notes string) *string {
+	trimmed := strings.TrimSpace(notes)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
 // DeleteStage deletes a stage from an application with validation.
 // If the deleted stage is the current active stage, it recalculates current_stage_id.
 // All write operations are wrapped in a database transaction for atomicity.
@@ -683,10 +1610,23 @@ func (s *ApplicationService) DeleteStage(ctx context.Context, userID, appID, sta
 		return fmt.Errorf("failed to delete stage: %w", err)
 	}
 
+	if events := s.txEvents(tx); events != nil {
+		var stageName string
+		if template, err := s.templateRepo.GetByID(ctx, userID, stage.StageTemplateID); err == nil {
+			stageName = template.Name
+		}
+		payload := stageEventPayload(stage, stageName)
+		payload["status"] = stage.Status
+		if err := recordEvent(ctx, events, userID, appID, model.EventStageDeleted, payload); err != nil {
+			return err
+		}
+	}
+
 	// Commit transaction
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
+	s.invalidateAnalytics(ctx, userID)
 
 	s.log.Info("stage deleted",
 		zap.String("application_id", appID),
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,23 +13,41 @@ import (
 	companyModel "github.com/andreypavlenko/jobber/modules/companies/model"
 	companyPorts "github.com/andreypavlenko/jobber/modules/companies/ports"
 	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
+	jobPorts "github.com/andreypavlenko/jobber/modules/jobs/ports"
+	reminderModel "github.com/andreypavlenko/jobber/modules/reminders/model"
 	rbModel "github.com/andreypavlenko/jobber/modules/resumebuilder/model"
 	rbPorts "github.com/andreypavlenko/jobber/modules/resumebuilder/ports"
 	resumeModel "github.com/andreypavlenko/jobber/modules/resumes/model"
 	resumePorts "github.com/andreypavlenko/jobber/modules/resumes/ports"
+	tagModel "github.com/andreypavlenko/jobber/modules/tags/model"
+	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 // Mock repositories
 type MockApplicationRepository struct {
-	CreateFunc            func(ctx context.Context, app *model.Application) error
-	GetByIDFunc           func(ctx context.Context, userID, appID string) (*model.Application, error)
-	ListFunc              func(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.Application, int, error)
-	ListEnrichedFunc      func(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.ApplicationDTO, int, error)
-	UpdateFunc            func(ctx context.Context, app *model.Application) error
-	DeleteFunc            func(ctx context.Context, userID, appID string) error
-	GetLastActivityAtFunc func(ctx context.Context, appID string) (time.Time, error)
+	CreateFunc               func(ctx context.Context, app *model.Application) error
+	GetByIDFunc              func(ctx context.Context, userID, appID string) (*model.Application, error)
+	ListFunc                 func(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.Application, int, error)
+	ListEnrichedFunc         func(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.ApplicationDTO, int, error)
+	UpdateFunc               func(ctx context.Context, app *model.Application) error
+	DeleteFunc               func(ctx context.Context, userID, appID string) error
+	RestoreFunc              func(ctx context.Context, userID, appID string) error
+	DeletePermanentlyFunc    func(ctx context.Context, userID, appID string) (*string, error)
+	GetLastActivityAtFunc    func(ctx context.Context, appID string) (time.Time, error)
+	ListOwnedIDsFunc         func(ctx context.Context, userID string, appIDs []string) ([]string, error)
+	GetStatusesFunc          func(ctx context.Context, userID string, appIDs []string) (map[string]string, error)
+	BulkUpdateStatusFunc     func(ctx context.Context, userID string, appIDs []string, status string) (int64, error)
+	EnableSharingFunc        func(ctx context.Context, userID, appID, token string) (string, error)
+	DisableSharingFunc       func(ctx context.Context, userID, appID string) error
+	GetByShareTokenFunc      func(ctx context.Context, token string) (*model.Application, error)
+	GetStatusCountsFunc      func(ctx context.Context, userID string) (*model.StatusCounts, error)
+	ListOffersFunc           func(ctx context.Context, userID string) ([]*model.OfferDTO, error)
+	GetTotalStatusCountsFunc func(ctx context.Context) (*model.StatusCounts, error)
+	GetStageCountsFunc       func(ctx context.Context, appIDs []string) (map[string]model.StageCount, error)
+	UpdateResumeFunc         func(ctx context.Context, userID, appID, resumeID string) error
+	SetArchiveStatusFunc     func(ctx context.Context, userID, appID, status string, archivedAt *time.Time) error
 }
 
 func (m *MockApplicationRepository) Create(ctx context.Context, app *model.Application) error {
@@ -73,6 +92,20 @@ func (m *MockApplicationRepository) Delete(ctx context.Context, userID, appID st
 	return nil
 }
 
+func (m *MockApplicationRepository) Restore(ctx context.Context, userID, appID string) error {
+	if m.RestoreFunc != nil {
+		return m.RestoreFunc(ctx, userID, appID)
+	}
+	return nil
+}
+
+func (m *MockApplicationRepository) DeletePermanently(ctx context.Context, userID, appID string) (*string, error) {
+	if m.DeletePermanentlyFunc != nil {
+		return m.DeletePermanentlyFunc(ctx, userID, appID)
+	}
+	return nil, nil
+}
+
 func (m *MockApplicationRepository) GetLastActivityAt(ctx context.Context, appID string) (time.Time, error) {
 	if m.GetLastActivityAtFunc != nil {
 		return m.GetLastActivityAtFunc(ctx, appID)
@@ -80,6 +113,94 @@ func (m *MockApplicationRepository) GetLastActivityAt(ctx context.Context, appID
 	return time.Now(), nil
 }
 
+func (m *MockApplicationRepository) ListOwnedIDs(ctx context.Context, userID string, appIDs []string) ([]string, error) {
+	if m.ListOwnedIDsFunc != nil {
+		return m.ListOwnedIDsFunc(ctx, userID, appIDs)
+	}
+	return appIDs, nil
+}
+
+func (m *MockApplicationRepository) GetStatuses(ctx context.Context, userID string, appIDs []string) (map[string]string, error) {
+	if m.GetStatusesFunc != nil {
+		return m.GetStatusesFunc(ctx, userID, appIDs)
+	}
+	statuses := make(map[string]string, len(appIDs))
+	for _, id := range appIDs {
+		statuses[id] = "active"
+	}
+	return statuses, nil
+}
+
+func (m *MockApplicationRepository) BulkUpdateStatus(ctx context.Context, userID string, appIDs []string, status string) (int64, error) {
+	if m.BulkUpdateStatusFunc != nil {
+		return m.BulkUpdateStatusFunc(ctx, userID, appIDs, status)
+	}
+	return int64(len(appIDs)), nil
+}
+
+func (m *MockApplicationRepository) EnableSharing(ctx context.Context, userID, appID, token string) (string, error) {
+	if m.EnableSharingFunc != nil {
+		return m.EnableSharingFunc(ctx, userID, appID, token)
+	}
+	return token, nil
+}
+
+func (m *MockApplicationRepository) DisableSharing(ctx context.Context, userID, appID string) error {
+	if m.DisableSharingFunc != nil {
+		return m.DisableSharingFunc(ctx, userID, appID)
+	}
+	return nil
+}
+
+func (m *MockApplicationRepository) GetByShareToken(ctx context.Context, token string) (*model.Application, error) {
+	if m.GetByShareTokenFunc != nil {
+		return m.GetByShareTokenFunc(ctx, token)
+	}
+	return nil, model.ErrShareTokenNotFound
+}
+
+func (m *MockApplicationRepository) ListOffers(ctx context.Context, userID string) ([]*model.OfferDTO, error) {
+	if m.ListOffersFunc != nil {
+		return m.ListOffersFunc(ctx, userID)
+	}
+	return []*model.OfferDTO{}, nil
+}
+
+func (m *MockApplicationRepository) GetStatusCounts(ctx context.Context, userID string) (*model.StatusCounts, error) {
+	if m.GetStatusCountsFunc != nil {
+		return m.GetStatusCountsFunc(ctx, userID)
+	}
+	return &model.StatusCounts{}, nil
+}
+
+func (m *MockApplicationRepository) GetTotalStatusCounts(ctx context.Context) (*model.StatusCounts, error) {
+	if m.GetTotalStatusCountsFunc != nil {
+		return m.GetTotalStatusCountsFunc(ctx)
+	}
+	return &model.StatusCounts{}, nil
+}
+
+func (m *MockApplicationRepository) GetStageCounts(ctx context.Context, appIDs []string) (map[string]model.StageCount, error) {
+	if m.GetStageCountsFunc != nil {
+		return m.GetStageCountsFunc(ctx, appIDs)
+	}
+	return map[string]model.StageCount{}, nil
+}
+
+func (m *MockApplicationRepository) UpdateResume(ctx context.Context, userID, appID, resumeID string) error {
+	if m.UpdateResumeFunc != nil {
+		return m.UpdateResumeFunc(ctx, userID, appID, resumeID)
+	}
+	return nil
+}
+
+func (m *MockApplicationRepository) SetArchiveStatus(ctx context.Context, userID, appID, status string, archivedAt *time.Time) error {
+	if m.SetArchiveStatusFunc != nil {
+		return m.SetArchiveStatusFunc(ctx, userID, appID, status, archivedAt)
+	}
+	return nil
+}
+
 type MockStageRepository struct {
 	CreateFunc            func(ctx context.Context, stage *model.ApplicationStage) error
 	GetByIDFunc           func(ctx context.Context, stageID string) (*model.ApplicationStage, error)
@@ -124,11 +245,14 @@ func (m *MockStageRepository) Delete(ctx context.Context, stageID string) error
 }
 
 type MockTemplateRepository struct {
-	CreateFunc  func(ctx context.Context, template *model.StageTemplate) error
-	GetByIDFunc func(ctx context.Context, userID, templateID string) (*model.StageTemplate, error)
-	ListFunc    func(ctx context.Context, userID string, limit, offset int) ([]*model.StageTemplate, int, error)
-	UpdateFunc  func(ctx context.Context, template *model.StageTemplate) error
-	DeleteFunc  func(ctx context.Context, userID, templateID string) error
+	CreateFunc        func(ctx context.Context, template *model.StageTemplate) error
+	GetByIDFunc       func(ctx context.Context, userID, templateID string) (*model.StageTemplate, error)
+	ListFunc          func(ctx context.Context, userID string, limit, offset int) ([]*model.StageTemplate, int, error)
+	UpdateFunc        func(ctx context.Context, template *model.StageTemplate) error
+	DeleteFunc        func(ctx context.Context, userID, templateID string) error
+	GetUsageStatsFunc func(ctx context.Context, userID string) ([]*model.StageTemplateUsage, error)
+	MergeIntoFunc     func(ctx context.Context, fromID, intoID, userID string) (int, error)
+	ReorderFunc       func(ctx context.Context, userID string, templateIDs []string) ([]*model.StageTemplate, error)
 }
 
 func (m *MockTemplateRepository) Create(ctx context.Context, template *model.StageTemplate) error {
@@ -166,18 +290,51 @@ func (m *MockTemplateRepository) Delete(ctx context.Context, userID, templateID
 	return nil
 }
 
+func (m *MockTemplateRepository) GetUsageStats(ctx context.Context, userID string) ([]*model.StageTemplateUsage, error) {
+	if m.GetUsageStatsFunc != nil {
+		return m.GetUsageStatsFunc(ctx, userID)
+	}
+	return []*model.StageTemplateUsage{}, nil
+}
+
+func (m *MockTemplateRepository) MergeInto(ctx context.Context, fromID, intoID, userID string) (int, error) {
+	if m.MergeIntoFunc != nil {
+		return m.MergeIntoFunc(ctx, fromID, intoID, userID)
+	}
+	return 0, nil
+}
+
+func (m *MockTemplateRepository) Reorder(ctx context.Context, userID string, templateIDs []string) ([]*model.StageTemplate, error) {
+	if m.ReorderFunc != nil {
+		return m.ReorderFunc(ctx, userID, templateIDs)
+	}
+	templates := make([]*model.StageTemplate, len(templateIDs))
+	for i, id := range templateIDs {
+		templates[i] = &model.StageTemplate{ID: id, UserID: userID, Order: i + 1}
+	}
+	return templates, nil
+}
+
 type MockJobRepository struct {
-	GetByIDFunc func(ctx context.Context, userID, jobID string) (*jobModel.Job, error)
+	CreateFunc             func(ctx context.Context, job *jobModel.Job) error
+	GetByIDFunc            func(ctx context.Context, userID, jobID string) (*jobModel.Job, error)
+	FindSimilarByTitleFunc func(ctx context.Context, userID, title, excludeID string, limit int) ([]*jobModel.SimilarJobDTO, error)
+	ListApplicationIDsFunc func(ctx context.Context, userID, jobID string) ([]string, error)
 }
 
-func (m *MockJobRepository) Create(ctx context.Context, job *jobModel.Job) error { return nil }
+func (m *MockJobRepository) Create(ctx context.Context, job *jobModel.Job) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, job)
+	}
+	return nil
+}
 func (m *MockJobRepository) GetByID(ctx context.Context, userID, jobID string) (*jobModel.Job, error) {
 	if m.GetByIDFunc != nil {
 		return m.GetByIDFunc(ctx, userID, jobID)
 	}
 	return nil, nil
 }
-func (m *MockJobRepository) List(ctx context.Context, userID string, limit, offset int, status, sortBy, sortOrder string) ([]*jobModel.JobDTO, int, error) {
+func (m *MockJobRepository) List(ctx context.Context, userID string, opts *jobPorts.ListOptions) ([]*jobModel.JobDTO, int, error) {
 	return nil, 0, nil
 }
 func (m *MockJobRepository) Update(ctx context.Context, job *jobModel.Job) error { return nil }
@@ -188,11 +345,34 @@ func (m *MockJobRepository) ToggleFavorite(ctx context.Context, userID, jobID st
 	return false, nil
 }
 
+func (m *MockJobRepository) ListHighPriority(ctx context.Context, userID string, limit int) ([]*jobModel.JobDTO, error) {
+	return nil, nil
+}
+
+func (m *MockJobRepository) FindSimilarByTitle(ctx context.Context, userID, title, excludeID string, limit int) ([]*jobModel.SimilarJobDTO, error) {
+	if m.FindSimilarByTitleFunc != nil {
+		return m.FindSimilarByTitleFunc(ctx, userID, title, excludeID, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockJobRepository) ListApplicationIDs(ctx context.Context, userID, jobID string) ([]string, error) {
+	if m.ListApplicationIDsFunc != nil {
+		return m.ListApplicationIDsFunc(ctx, userID, jobID)
+	}
+	return nil, nil
+}
+
 type MockCompanyRepository struct {
-	GetByIDFunc func(ctx context.Context, userID, companyID string) (*companyModel.Company, error)
+	CreateFunc    func(ctx context.Context, company *companyModel.Company) error
+	GetByIDFunc   func(ctx context.Context, userID, companyID string) (*companyModel.Company, error)
+	GetByNameFunc func(ctx context.Context, userID, name string) (*companyModel.Company, error)
 }
 
 func (m *MockCompanyRepository) Create(ctx context.Context, company *companyModel.Company) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, company)
+	}
 	return nil
 }
 func (m *MockCompanyRepository) GetByID(ctx context.Context, userID, companyID string) (*companyModel.Company, error) {
@@ -219,6 +399,21 @@ func (m *MockCompanyRepository) GetRelatedJobsAndApplicationsCount(ctx context.C
 func (m *MockCompanyRepository) ToggleFavorite(ctx context.Context, userID, companyID string) (bool, error) {
 	return false, nil
 }
+func (m *MockCompanyRepository) UpdateLogoURL(ctx context.Context, userID, companyID string, logoURL *string) error {
+	return nil
+}
+func (m *MockCompanyRepository) GetByName(ctx context.Context, userID, name string) (*companyModel.Company, error) {
+	if m.GetByNameFunc != nil {
+		return m.GetByNameFunc(ctx, userID, name)
+	}
+	return nil, companyModel.ErrCompanyNotFound
+}
+func (m *MockCompanyRepository) ListSimilarPairs(ctx context.Context, userID string, threshold float64) ([]*companyModel.SimilarCompanyPair, error) {
+	return nil, nil
+}
+func (m *MockCompanyRepository) Merge(ctx context.Context, userID, intoID string, fromIDs []string) error {
+	return nil
+}
 
 type MockResumeRepository struct {
 	GetByIDFunc func(ctx context.Context, userID, resumeID string) (*resumeModel.Resume, error)
@@ -243,9 +438,14 @@ func (m *MockResumeRepository) Delete(ctx context.Context, userID, resumeID stri
 	return nil
 }
 
+func (m *MockResumeRepository) CountApplications(ctx context.Context, userID, resumeID string) (int, error) {
+	return 0, nil
+}
+
 type MockCommentRepository struct {
 	CreateFunc            func(ctx context.Context, comment *commentModel.Comment) error
-	ListByApplicationFunc func(ctx context.Context, appID string, userID ...string) ([]*commentModel.Comment, error)
+	ListByApplicationFunc func(ctx context.Context, appID, sortDir string, userID ...string) ([]*commentModel.Comment, error)
+	CountByStageFunc      func(ctx context.Context, stageIDs []string) (map[string]int, error)
 }
 
 func (m *MockCommentRepository) Create(ctx context.Context, comment *commentModel.Comment) error {
@@ -254,15 +454,25 @@ func (m *MockCommentRepository) Create(ctx context.Context, comment *commentMode
 	}
 	return nil
 }
-func (m *MockCommentRepository) ListByApplication(ctx context.Context, appID string, userID ...string) ([]*commentModel.Comment, error) {
+func (m *MockCommentRepository) ListByApplication(ctx context.Context, appID, sortDir string, userID ...string) ([]*commentModel.Comment, error) {
 	if m.ListByApplicationFunc != nil {
-		return m.ListByApplicationFunc(ctx, appID, userID...)
+		return m.ListByApplicationFunc(ctx, appID, sortDir, userID...)
 	}
 	return nil, nil
 }
 func (m *MockCommentRepository) Delete(ctx context.Context, userID, commentID string) error {
 	return nil
 }
+func (m *MockCommentRepository) CountByStage(ctx context.Context, stageIDs []string) (map[string]int, error) {
+	if m.CountByStageFunc != nil {
+		return m.CountByStageFunc(ctx, stageIDs)
+	}
+	return map[string]int{}, nil
+}
+
+func (m *MockCommentRepository) SetPinned(ctx context.Context, userID, commentID string, pinned bool) (*commentModel.Comment, error) {
+	return nil, commentModel.ErrCommentNotFound
+}
 
 func strPtr(s string) *string { return &s }
 
@@ -275,7 +485,15 @@ func createTestService() (*ApplicationService, *MockApplicationRepository, *Mock
 	resumeRepo := &MockResumeRepository{}
 	commentRepo := &MockCommentRepository{}
 
-	svc := NewApplicationService(nil, appRepo, stageRepo, templateRepo, jobRepo, companyRepo, resumeRepo, nil, commentRepo, nil)
+	svc := NewApplicationService(ApplicationServiceConfig{
+		AppRepo:      appRepo,
+		StageRepo:    stageRepo,
+		TemplateRepo: templateRepo,
+		JobRepo:      jobRepo,
+		CompanyRepo:  companyRepo,
+		ResumeRepo:   resumeRepo,
+		CommentRepo:  commentRepo,
+	})
 	return svc, appRepo, stageRepo, templateRepo, jobRepo, companyRepo, resumeRepo, commentRepo
 }
 
@@ -458,7 +676,7 @@ func TestApplicationService_GetByID(t *testing.T) {
 			return &resumeModel.Resume{ID: rid, Title: "My Resume"}, nil
 		}
 
-		commentRepo.ListByApplicationFunc = func(ctx context.Context, aid string, uid ...string) ([]*commentModel.Comment, error) {
+		commentRepo.ListByApplicationFunc = func(ctx context.Context, aid, sortDir string, uid ...string) ([]*commentModel.Comment, error) {
 			return []*commentModel.Comment{}, nil
 		}
 
@@ -505,7 +723,7 @@ func TestApplicationService_GetByID(t *testing.T) {
 			return &jobModel.Job{ID: jid, Title: "Software Engineer"}, nil
 		}
 
-		commentRepo.ListByApplicationFunc = func(ctx context.Context, aid string, uid ...string) ([]*commentModel.Comment, error) {
+		commentRepo.ListByApplicationFunc = func(ctx context.Context, aid, sortDir string, uid ...string) ([]*commentModel.Comment, error) {
 			return nil, errors.New("comment fetch error")
 		}
 
@@ -542,7 +760,7 @@ func TestApplicationService_GetByID(t *testing.T) {
 		}
 
 		stageID := "stage-1"
-		commentRepo.ListByApplicationFunc = func(ctx context.Context, aid string, uid ...string) ([]*commentModel.Comment, error) {
+		commentRepo.ListByApplicationFunc = func(ctx context.Context, aid, sortDir string, uid ...string) ([]*commentModel.Comment, error) {
 			return []*commentModel.Comment{
 				{ID: "c1", ApplicationID: aid, Content: "App comment", StageID: nil},
 				{ID: "c2", ApplicationID: aid, Content: "Stage comment", StageID: &stageID},
@@ -603,6 +821,94 @@ func TestApplicationService_Update(t *testing.T) {
 		assert.Equal(t, "offer", result.Status)
 	})
 
+	t.Run("updates offer salaries", func(t *testing.T) {
+		svc, appRepo, _, _, jobRepo, _, _, _ := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID, JobID: "job-1", Status: "offer"}, nil
+		}
+
+		var updated *model.Application
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error {
+			updated = app
+			return nil
+		}
+
+		appRepo.GetLastActivityAtFunc = func(ctx context.Context, aid string) (time.Time, error) {
+			return time.Now(), nil
+		}
+
+		jobRepo.GetByIDFunc = func(ctx context.Context, uid, jid string) (*jobModel.Job, error) {
+			return &jobModel.Job{ID: jid, Title: "Software Engineer"}, nil
+		}
+
+		offered, negotiated := 100000, 110000
+		req := &model.UpdateApplicationRequest{OfferedSalary: &offered, NegotiatedSalary: &negotiated}
+
+		result, err := svc.Update(context.Background(), userID, appID, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, 100000, *updated.OfferedSalary)
+		assert.Equal(t, 110000, *updated.NegotiatedSalary)
+		assert.Equal(t, 110000, *result.NegotiatedSalary)
+	})
+
+	t.Run("sets and clears the deadline", func(t *testing.T) {
+		svc, appRepo, _, _, jobRepo, _, _, _ := createTestService()
+
+		existing := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID, JobID: "job-1", Status: "active", DeadlineAt: &existing}, nil
+		}
+
+		var updated *model.Application
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error {
+			updated = app
+			return nil
+		}
+
+		appRepo.GetLastActivityAtFunc = func(ctx context.Context, aid string) (time.Time, error) {
+			return time.Now(), nil
+		}
+
+		jobRepo.GetByIDFunc = func(ctx context.Context, uid, jid string) (*jobModel.Job, error) {
+			return &jobModel.Job{ID: jid, Title: "Software Engineer"}, nil
+		}
+
+		deadline := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+		result, err := svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{DeadlineAt: &deadline})
+
+		require.NoError(t, err)
+		assert.Equal(t, deadline, *updated.DeadlineAt)
+		assert.Equal(t, deadline, *result.DeadlineAt)
+
+		_, err = svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, existing, *updated.DeadlineAt, "omitted deadline is kept")
+
+		_, err = svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{DeadlineAt: &deadline, ClearDeadline: true})
+
+		require.NoError(t, err)
+		assert.Nil(t, updated.DeadlineAt)
+	})
+
+	t.Run("returns error for negative salary", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID, JobID: "job-1", Status: "offer"}, nil
+		}
+
+		offered := -1
+		req := &model.UpdateApplicationRequest{OfferedSalary: &offered}
+
+		result, err := svc.Update(context.Background(), userID, appID, req)
+
+		assert.ErrorIs(t, err, model.ErrInvalidSalary)
+		assert.Nil(t, result)
+	})
+
 	t.Run("returns error for invalid status", func(t *testing.T) {
 		svc, appRepo, _, _, _, _, _, _ := createTestService()
 
@@ -651,6 +957,118 @@ func TestApplicationService_Delete(t *testing.T) {
 	})
 }
 
+func TestApplicationService_Restore(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	t.Run("restores and returns the application", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		var restoredAppID string
+		appRepo.RestoreFunc = func(ctx context.Context, uid, aid string) error {
+			restoredAppID = aid
+			return nil
+		}
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Name: "Restored"}, nil
+		}
+
+		result, err := svc.Restore(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Equal(t, appID, restoredAppID)
+		assert.Equal(t, "Restored", result.Name)
+	})
+
+	t.Run("returns not found outside the trash", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		appRepo.RestoreFunc = func(ctx context.Context, uid, aid string) error {
+			return model.ErrApplicationNotFound
+		}
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			t.Fatal("application should not be loaded")
+			return nil, nil
+		}
+
+		result, err := svc.Restore(context.Background(), userID, appID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+
+	t.Run("checks the plan limit before restoring", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		var checkedResource string
+		svc.limitChecker = &MockAppLimitChecker{CheckLimitFunc: func(_ context.Context, _, resource string) error {
+			checkedResource = resource
+			return errors.New("limit reached")
+		}}
+		appRepo.RestoreFunc = func(ctx context.Context, uid, aid string) error {
+			t.Fatal("application should not be restored over the limit")
+			return nil
+		}
+
+		result, err := svc.Restore(context.Background(), userID, appID)
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "limit reached")
+		assert.Equal(t, "applications", checkedResource)
+	})
+}
+
+func TestApplicationService_DeletePermanently(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	t.Run("deletes the application permanently", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		var deletedAppID string
+		appRepo.DeletePermanentlyFunc = func(ctx context.Context, uid, aid string) (*string, error) {
+			deletedAppID = aid
+			return nil, nil
+		}
+
+		err := svc.DeletePermanently(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Equal(t, appID, deletedAppID)
+	})
+
+	t.Run("deletes the uploaded cover letter file", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		objectStorage := &MockObjectStorage{}
+		svc.storage = objectStorage
+
+		key := "cover_letters/user-123/app-1/letter.pdf"
+		appRepo.DeletePermanentlyFunc = func(ctx context.Context, uid, aid string) (*string, error) {
+			return &key, nil
+		}
+
+		err := svc.DeletePermanently(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{key}, objectStorage.DeletedKeys)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		objectStorage := &MockObjectStorage{}
+		svc.storage = objectStorage
+
+		appRepo.DeletePermanentlyFunc = func(ctx context.Context, uid, aid string) (*string, error) {
+			return nil, model.ErrApplicationNotFound
+		}
+
+		err := svc.DeletePermanently(context.Background(), userID, appID)
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+		assert.Empty(t, objectStorage.DeletedKeys)
+	})
+}
+
 func TestApplicationService_CreateStageTemplate(t *testing.T) {
 	userID := "user-123"
 
@@ -688,6 +1106,58 @@ func TestApplicationService_CreateStageTemplate(t *testing.T) {
 		assert.Nil(t, result)
 		assert.Equal(t, model.ErrStageNameRequired, err)
 	})
+
+	t.Run("stores description", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+
+		var saved *model.StageTemplate
+		templateRepo.CreateFunc = func(ctx context.Context, template *model.StageTemplate) error {
+			saved = template
+			return nil
+		}
+
+		description := "A 45-60 minute coding interview"
+		req := &model.CreateStageTemplateRequest{Name: "Technical Interview", Description: &description}
+
+		result, err := svc.CreateStageTemplate(context.Background(), userID, req)
+
+		require.NoError(t, err)
+		require.NotNil(t, result.Description)
+		assert.Equal(t, description, *result.Description)
+		assert.Equal(t, &description, saved.Description)
+	})
+
+	t.Run("returns error for description longer than 500 characters", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+
+		templateRepo.CreateFunc = func(ctx context.Context, template *model.StageTemplate) error {
+			t.Fatal("template should not be created")
+			return nil
+		}
+
+		description := strings.Repeat("a", 501)
+		req := &model.CreateStageTemplateRequest{Name: "Technical Interview", Description: &description}
+
+		result, err := svc.CreateStageTemplate(context.Background(), userID, req)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrDescriptionTooLong)
+	})
+
+	t.Run("counts description length in characters", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+
+		templateRepo.CreateFunc = func(ctx context.Context, template *model.StageTemplate) error {
+			return nil
+		}
+
+		description := strings.Repeat("é", 500)
+		req := &model.CreateStageTemplateRequest{Name: "Entrevista", Description: &description}
+
+		_, err := svc.CreateStageTemplate(context.Background(), userID, req)
+
+		assert.NoError(t, err)
+	})
 }
 
 func TestApplicationService_ListStageTemplates(t *testing.T) {
@@ -713,9 +1183,67 @@ func TestApplicationService_ListStageTemplates(t *testing.T) {
 	})
 }
 
-func TestApplicationService_AddStage(t *testing.T) {
-	t.Run("returns error when application not found", func(t *testing.T) {
-		svc, appRepo, _, _, _, _, _, _ := createTestService()
+func TestApplicationService_ListDefaultStageTemplates(t *testing.T) {
+	svc, _, _, _, _, _, _, _ := createTestService()
+
+	result := svc.ListDefaultStageTemplates()
+
+	require.Len(t, result, 6)
+	assert.Equal(t, "Applied", result[0].Name)
+	assert.Equal(t, "Offer", result[5].Name)
+	for i, tmpl := range result {
+		assert.True(t, tmpl.IsSuggestion)
+		assert.Empty(t, tmpl.ID)
+		assert.Equal(t, i+1, tmpl.Order)
+		require.NotNil(t, tmpl.Description)
+		assert.NotEmpty(t, *tmpl.Description)
+	}
+}
+
+func TestApplicationService_ApplyDefaultStageTemplates(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("is a no-op when the user already has templates", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+
+		templateRepo.ListFunc = func(ctx context.Context, uid string, limit, offset int) ([]*model.StageTemplate, int, error) {
+			assert.Equal(t, userID, uid)
+			return []*model.StageTemplate{{ID: "template-1", Name: "Custom", Order: 1}}, 3, nil
+		}
+		templateRepo.CreateFunc = func(ctx context.Context, template *model.StageTemplate) error {
+			t.Fatal("no templates should be created")
+			return nil
+		}
+
+		// pool is nil: reaching the transaction would panic, proving nothing is written
+		result, err := svc.ApplyDefaultStageTemplates(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Created)
+		assert.Empty(t, result.Templates)
+	})
+
+	t.Run("returns error when listing templates fails", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+
+		templateRepo.ListFunc = func(ctx context.Context, uid string, limit, offset int) ([]*model.StageTemplate, int, error) {
+			return nil, 0, errors.New("database error")
+		}
+
+		result, err := svc.ApplyDefaultStageTemplates(context.Background(), userID)
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+
+	t.Run("creates defaults when the user has none", func(t *testing.T) {
+		t.Skip("Requires real database pool for transaction testing")
+	})
+}
+
+func TestApplicationService_AddStage(t *testing.T) {
+	t.Run("returns error when application not found", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
 
 		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
 			return nil, model.ErrApplicationNotFound
@@ -769,6 +1297,82 @@ func TestApplicationService_AddStage(t *testing.T) {
 	})
 }
 
+func TestApplicationService_AddStage_Input(t *testing.T) {
+	name := "Coffee chat"
+	listErr := errors.New("stage list error")
+
+	// Stops AddStage right before the transaction, which needs a real pool
+	setup := func() (*ApplicationService, *MockTemplateRepository) {
+		svc, appRepo, stageRepo, templateRepo, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid}, nil
+		}
+		stageRepo.ListByApplicationFunc = func(_ context.Context, _ string) ([]*model.ApplicationStage, error) {
+			return nil, listErr
+		}
+		return svc, templateRepo
+	}
+
+	t.Run("uses the stage template when only its ID is set", func(t *testing.T) {
+		svc, templateRepo := setup()
+		var lookedUp string
+		templateRepo.GetByIDFunc = func(_ context.Context, _, tid string) (*model.StageTemplate, error) {
+			lookedUp = tid
+			return &model.StageTemplate{ID: tid, Name: "Phone Screen"}, nil
+		}
+
+		_, err := svc.AddStage(context.Background(), "user-123", "app-1", &model.AddStageRequest{StageTemplateID: "template-1"})
+
+		assert.ErrorIs(t, err, listErr)
+		assert.Equal(t, "template-1", lookedUp)
+	})
+
+	t.Run("accepts a name without a stage template", func(t *testing.T) {
+		svc, templateRepo := setup()
+		templateRepo.GetByIDFunc = func(_ context.Context, _, _ string) (*model.StageTemplate, error) {
+			t.Fatal("stage template should not be looked up")
+			return nil, nil
+		}
+
+		_, err := svc.AddStage(context.Background(), "user-123", "app-1", &model.AddStageRequest{Name: &name})
+
+		assert.ErrorIs(t, err, listErr)
+	})
+
+	t.Run("rejects both stage template and name", func(t *testing.T) {
+		svc, _ := setup()
+
+		result, err := svc.AddStage(context.Background(), "user-123", "app-1", &model.AddStageRequest{StageTemplateID: "template-1", Name: &name})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrAmbiguousStageInput)
+	})
+
+	t.Run("requires stage template or name", func(t *testing.T) {
+		svc, _ := setup()
+		blank := "   "
+
+		for _, req := range []*model.AddStageRequest{{}, {Name: &blank}} {
+			result, err := svc.AddStage(context.Background(), "user-123", "app-1", req)
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, model.ErrStageInputRequired)
+		}
+	})
+}
+
+func TestResolveStageTemplate(t *testing.T) {
+	svc, _, _, _, _, _, _, _ := createTestService()
+	name := "  Coffee chat "
+
+	template, err := svc.resolveStageTemplate(context.Background(), "user-123", &model.AddStageRequest{Name: &name})
+
+	require.NoError(t, err)
+	assert.Empty(t, template.ID, "ad-hoc template is inserted by AddStage")
+	assert.Equal(t, "user-123", template.UserID)
+	assert.Equal(t, "Coffee chat", template.Name)
+}
+
 func TestApplicationService_ListStages(t *testing.T) {
 	userID := "user-123"
 	appID := "app-1"
@@ -808,6 +1412,56 @@ func TestApplicationService_ListStages(t *testing.T) {
 		require.NoError(t, err)
 		assert.Len(t, result, 2)
 	})
+
+	t.Run("includes comment count per stage", func(t *testing.T) {
+		svc, appRepo, stageRepo, templateRepo, _, _, _, commentRepo := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID}, nil
+		}
+		stageRepo.ListByApplicationFunc = func(ctx context.Context, aid string) ([]*model.ApplicationStage, error) {
+			return []*model.ApplicationStage{
+				{ID: "stage-1", StageTemplateID: "template-1", Status: "completed"},
+				{ID: "stage-2", StageTemplateID: "template-2", Status: "active"},
+			}, nil
+		}
+		templateRepo.ListFunc = func(ctx context.Context, uid string, limit, offset int) ([]*model.StageTemplate, int, error) {
+			return []*model.StageTemplate{{ID: "template-1", Name: "Phone Screen"}, {ID: "template-2", Name: "Onsite"}}, 2, nil
+		}
+
+		var requestedIDs []string
+		commentRepo.CountByStageFunc = func(ctx context.Context, stageIDs []string) (map[string]int, error) {
+			requestedIDs = stageIDs
+			return map[string]int{"stage-1": 3}, nil
+		}
+
+		result, err := svc.ListStages(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, []string{"stage-1", "stage-2"}, requestedIDs)
+		assert.Equal(t, 3, result[0].CommentCount)
+		assert.Equal(t, 0, result[1].CommentCount)
+	})
+
+	t.Run("returns error when comment count fails", func(t *testing.T) {
+		svc, appRepo, stageRepo, _, _, _, _, commentRepo := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID}, nil
+		}
+		stageRepo.ListByApplicationFunc = func(ctx context.Context, aid string) ([]*model.ApplicationStage, error) {
+			return []*model.ApplicationStage{{ID: "stage-1", StageTemplateID: "template-1"}}, nil
+		}
+		commentRepo.CountByStageFunc = func(ctx context.Context, stageIDs []string) (map[string]int, error) {
+			return nil, errors.New("count failed")
+		}
+
+		result, err := svc.ListStages(context.Background(), userID, appID)
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
 }
 
 func TestApplicationService_UpdateStage(t *testing.T) {
@@ -1116,12 +1770,97 @@ func TestApplicationService_List(t *testing.T) {
 			return dtos, 2, nil
 		}
 
-		result, total, err := svc.List(context.Background(), userID, "created_at", "desc", "", 20, 0)
+		result, total, err := svc.List(context.Background(), userID, &ports.ListOptions{SortFields: []ports.SortField{{Field: "applied_at", Dir: "desc"}}, Limit: 20, Offset: 0})
 
 		require.NoError(t, err)
 		assert.Len(t, result, 2)
 		assert.Equal(t, 2, total)
 	})
+
+	t.Run("attaches stage counts with a single batched query", func(t *testing.T) {
+		svc, appRepo, stageRepo, _, _, _, _, _ := createTestService()
+
+		appRepo.ListEnrichedFunc = func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.ApplicationDTO, int, error) {
+			return []*model.ApplicationDTO{{ID: "app-1"}, {ID: "app-2"}}, 2, nil
+		}
+		batchCalls := 0
+		appRepo.GetStageCountsFunc = func(ctx context.Context, appIDs []string) (map[string]model.StageCount, error) {
+			batchCalls++
+			assert.Equal(t, []string{"app-1", "app-2"}, appIDs)
+			return map[string]model.StageCount{"app-1": {Total: 3, Completed: 2}}, nil
+		}
+		stageRepo.ListByApplicationFunc = func(ctx context.Context, appID string) ([]*model.ApplicationStage, error) {
+			t.Fatal("List must not load stages per application")
+			return nil, nil
+		}
+
+		result, _, err := svc.List(context.Background(), userID, &ports.ListOptions{Limit: 20})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, batchCalls)
+		require.Len(t, result, 2)
+		assert.Equal(t, 3, result[0].StageCount)
+		assert.Equal(t, 2, result[0].CompletedStageCount)
+		assert.Equal(t, 0, result[1].StageCount)
+		assert.Equal(t, 0, result[1].CompletedStageCount)
+	})
+
+	t.Run("skips the batch query for an empty page", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		appRepo.ListEnrichedFunc = func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.ApplicationDTO, int, error) {
+			return []*model.ApplicationDTO{}, 0, nil
+		}
+		appRepo.GetStageCountsFunc = func(ctx context.Context, appIDs []string) (map[string]model.StageCount, error) {
+			t.Fatal("GetStageCounts should not be called without applications")
+			return nil, nil
+		}
+
+		result, total, err := svc.List(context.Background(), userID, &ports.ListOptions{Limit: 20})
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+		assert.Equal(t, 0, total)
+	})
+
+	t.Run("returns list when stage counts fail", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		appRepo.ListEnrichedFunc = func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.ApplicationDTO, int, error) {
+			return []*model.ApplicationDTO{{ID: "app-1"}}, 1, nil
+		}
+		appRepo.GetStageCountsFunc = func(ctx context.Context, appIDs []string) (map[string]model.StageCount, error) {
+			return nil, errors.New("db error")
+		}
+
+		result, total, err := svc.List(context.Background(), userID, &ports.ListOptions{Limit: 20})
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, 0, result[0].StageCount)
+	})
+}
+
+func TestApplicationService_GetByID_StageCounts(t *testing.T) {
+	svc, appRepo, stageRepo, _, _, _, _, _ := createTestService()
+
+	appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+		return &model.Application{ID: aid, UserID: uid, JobID: "job-1"}, nil
+	}
+	stageRepo.ListByApplicationFunc = func(ctx context.Context, appID string) ([]*model.ApplicationStage, error) {
+		return []*model.ApplicationStage{
+			{ID: "stage-1", Status: "completed"},
+			{ID: "stage-2", Status: "completed"},
+			{ID: "stage-3", Status: "active"},
+		}, nil
+	}
+
+	dto, err := svc.GetByID(context.Background(), "user-123", "app-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, dto.StageCount)
+	assert.Equal(t, 2, dto.CompletedStageCount)
 }
 
 func TestApplicationService_CompleteStage(t *testing.T) {
@@ -1170,6 +1909,94 @@ func TestApplicationService_CompleteStage(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "completed", result.Status)
 	})
+
+	t.Run("records completion notes", func(t *testing.T) {
+		svc, appRepo, stageRepo, templateRepo, _, _, _, _ := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID}, nil
+		}
+		stageRepo.GetByIDFunc = func(ctx context.Context, sid string) (*model.ApplicationStage, error) {
+			return &model.ApplicationStage{ID: stageID, ApplicationID: appID, StageTemplateID: "template-1", Status: "active"}, nil
+		}
+		var saved *model.ApplicationStage
+		stageRepo.UpdateFunc = func(ctx context.Context, s *model.ApplicationStage) error {
+			saved = s
+			return nil
+		}
+		templateRepo.GetByIDFunc = func(ctx context.Context, uid, tid string) (*model.StageTemplate, error) {
+			return &model.StageTemplate{ID: "template-1", Name: "Onsite"}, nil
+		}
+
+		notes := "  Covered distributed systems, felt confident  "
+		result, err := svc.CompleteStage(context.Background(), userID, appID, stageID, &model.CompleteStageRequest{Notes: &notes})
+
+		require.NoError(t, err)
+		require.NotNil(t, saved.Notes)
+		assert.Equal(t, "Covered distributed systems, felt confident", *saved.Notes)
+		assert.Equal(t, "Covered distributed systems, felt confident", *result.Notes)
+	})
+}
+
+func TestApplicationService_UpdateStage_Notes(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+	stageID := "stage-1"
+
+	setup := func(existing *string) (*ApplicationService, **model.ApplicationStage) {
+		svc, appRepo, stageRepo, templateRepo, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID}, nil
+		}
+		stageRepo.GetByIDFunc = func(ctx context.Context, sid string) (*model.ApplicationStage, error) {
+			return &model.ApplicationStage{ID: stageID, ApplicationID: appID, StageTemplateID: "template-1", Status: "active", Notes: existing}, nil
+		}
+		saved := new(*model.ApplicationStage)
+		stageRepo.UpdateFunc = func(ctx context.Context, s *model.ApplicationStage) error {
+			*saved = s
+			return nil
+		}
+		templateRepo.GetByIDFunc = func(ctx context.Context, uid, tid string) (*model.StageTemplate, error) {
+			return &model.StageTemplate{ID: "template-1", Name: "Phone Screen"}, nil
+		}
+		return svc, saved
+	}
+
+	t.Run("replaces notes in place", func(t *testing.T) {
+		old := "Recruiter call at 10am"
+		svc, saved := setup(&old)
+
+		notes := "Asked about team size"
+		result, err := svc.UpdateStage(context.Background(), userID, appID, stageID, &model.UpdateStageRequest{Notes: &notes})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Asked about team size", *(*saved).Notes)
+		assert.Equal(t, "Asked about team size", *result.Notes)
+		assert.Equal(t, "active", result.Status, "status is unchanged")
+	})
+
+	t.Run("clears notes when blank", func(t *testing.T) {
+		old := "Recruiter call at 10am"
+		svc, saved := setup(&old)
+
+		blank := "   "
+		result, err := svc.UpdateStage(context.Background(), userID, appID, stageID, &model.UpdateStageRequest{Notes: &blank})
+
+		require.NoError(t, err)
+		assert.Nil(t, (*saved).Notes)
+		assert.Nil(t, result.Notes)
+	})
+
+	t.Run("keeps notes when not given", func(t *testing.T) {
+		old := "Recruiter call at 10am"
+		svc, saved := setup(&old)
+
+		status := "completed"
+		_, err := svc.UpdateStage(context.Background(), userID, appID, stageID, &model.UpdateStageRequest{Status: &status})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Recruiter call at 10am", *(*saved).Notes)
+	})
 }
 
 func TestApplicationService_UpdateStageTemplate(t *testing.T) {
@@ -1224,6 +2051,26 @@ func TestApplicationService_UpdateStageTemplate(t *testing.T) {
 		assert.Nil(t, result)
 		assert.Equal(t, model.ErrStageNameRequired, err)
 	})
+
+	t.Run("returns error for description longer than 500 characters", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+
+		templateRepo.GetByIDFunc = func(ctx context.Context, uid, tid string) (*model.StageTemplate, error) {
+			return &model.StageTemplate{ID: templateID, UserID: userID, Name: "Phone Screen"}, nil
+		}
+		templateRepo.UpdateFunc = func(ctx context.Context, tmpl *model.StageTemplate) error {
+			t.Fatal("template should not be updated")
+			return nil
+		}
+
+		description := strings.Repeat("a", 501)
+		req := &model.UpdateStageTemplateRequest{Description: &description}
+
+		result, err := svc.UpdateStageTemplate(context.Background(), userID, templateID, req)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrDescriptionTooLong)
+	})
 }
 
 func TestApplicationService_DeleteStageTemplate(t *testing.T) {
@@ -1246,54 +2093,140 @@ func TestApplicationService_DeleteStageTemplate(t *testing.T) {
 	})
 }
 
-func TestApplicationService_DeleteStage_CurrentStage(t *testing.T) {
+func TestApplicationService_MergeStageTemplates(t *testing.T) {
 	userID := "user-123"
-	appID := "app-1"
-	stageID := "stage-1"
-
-	t.Run("deletes current stage and recalculates", func(t *testing.T) {
-		t.Skip("Requires real database pool for transaction testing")
-		svc, appRepo, stageRepo, _, _, _, _, _ := createTestService()
 
-		app := &model.Application{
-			ID:             appID,
-			UserID:         userID,
-			CurrentStageID: &stageID,
-		}
+	t.Run("returns number of merged stages", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
 
-		stage := &model.ApplicationStage{
-			ID:            stageID,
-			ApplicationID: appID,
-			Status:        "active",
+		var gotFrom, gotInto, gotUser string
+		templateRepo.MergeIntoFunc = func(_ context.Context, fromID, intoID, uid string) (int, error) {
+			gotFrom, gotInto, gotUser = fromID, intoID, uid
+			return 3, nil
 		}
 
-		remainingStages := []*model.ApplicationStage{
-			{ID: "stage-0", ApplicationID: appID, Status: "completed"},
-		}
+		result, err := svc.MergeStageTemplates(context.Background(), userID, "template-1", "template-2")
 
-		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
-			return app, nil
-		}
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.MergedStages)
+		assert.Equal(t, "template-1", gotFrom)
+		assert.Equal(t, "template-2", gotInto)
+		assert.Equal(t, userID, gotUser)
+	})
 
-		stageRepo.GetByIDFunc = func(ctx context.Context, sid string) (*model.ApplicationStage, error) {
-			return stage, nil
+	t.Run("rejects merging a template into itself", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+		templateRepo.MergeIntoFunc = func(_ context.Context, _, _, _ string) (int, error) {
+			t.Fatal("repository should not be called")
+			return 0, nil
 		}
 
-		stageRepo.DeleteFunc = func(ctx context.Context, sid string) error {
-			return nil
-		}
+		result, err := svc.MergeStageTemplates(context.Background(), userID, "template-1", "template-1")
 
-		stageRepo.ListByApplicationFunc = func(ctx context.Context, aid string) ([]*model.ApplicationStage, error) {
-			return remainingStages, nil
-		}
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrMergeIntoSelf)
+	})
 
-		var updatedApp *model.Application
-		appRepo.UpdateFunc = func(ctx context.Context, a *model.Application) error {
-			updatedApp = a
-			return nil
+	t.Run("returns not found when a template is missing", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+		templateRepo.MergeIntoFunc = func(_ context.Context, _, _, _ string) (int, error) {
+			return 0, model.ErrStageTemplateNotFound
 		}
 
-		err := svc.DeleteStage(context.Background(), userID, appID, stageID)
+		result, err := svc.MergeStageTemplates(context.Background(), userID, "template-1", "template-2")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrStageTemplateNotFound)
+	})
+}
+
+func TestApplicationService_ReorderStageTemplates(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("returns the templates in their new order", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+		var gotIDs []string
+		templateRepo.ReorderFunc = func(_ context.Context, uid string, templateIDs []string) ([]*model.StageTemplate, error) {
+			assert.Equal(t, userID, uid)
+			gotIDs = templateIDs
+			return []*model.StageTemplate{
+				{ID: "template-2", Name: "Screening", Order: 1},
+				{ID: "template-1", Name: "Applied", Order: 2},
+			}, nil
+		}
+
+		result, err := svc.ReorderStageTemplates(context.Background(), userID, []string{"template-2", "template-1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"template-2", "template-1"}, gotIDs)
+		require.Len(t, result, 2)
+		assert.Equal(t, "template-2", result[0].ID)
+		assert.Equal(t, 1, result[0].Order)
+		assert.Equal(t, "template-1", result[1].ID)
+		assert.Equal(t, 2, result[1].Order)
+	})
+
+	t.Run("returns the repository error", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+		templateRepo.ReorderFunc = func(_ context.Context, _ string, _ []string) ([]*model.StageTemplate, error) {
+			return nil, model.ErrIncompleteTemplateOrder
+		}
+
+		result, err := svc.ReorderStageTemplates(context.Background(), userID, []string{"template-1"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrIncompleteTemplateOrder)
+	})
+}
+
+func TestApplicationService_DeleteStage_CurrentStage(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+	stageID := "stage-1"
+
+	t.Run("deletes current stage and recalculates", func(t *testing.T) {
+		t.Skip("Requires real database pool for transaction testing")
+		svc, appRepo, stageRepo, _, _, _, _, _ := createTestService()
+
+		app := &model.Application{
+			ID:             appID,
+			UserID:         userID,
+			CurrentStageID: &stageID,
+		}
+
+		stage := &model.ApplicationStage{
+			ID:            stageID,
+			ApplicationID: appID,
+			Status:        "active",
+		}
+
+		remainingStages := []*model.ApplicationStage{
+			{ID: "stage-0", ApplicationID: appID, Status: "completed"},
+		}
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return app, nil
+		}
+
+		stageRepo.GetByIDFunc = func(ctx context.Context, sid string) (*model.ApplicationStage, error) {
+			return stage, nil
+		}
+
+		stageRepo.DeleteFunc = func(ctx context.Context, sid string) error {
+			return nil
+		}
+
+		stageRepo.ListByApplicationFunc = func(ctx context.Context, aid string) ([]*model.ApplicationStage, error) {
+			return remainingStages, nil
+		}
+
+		var updatedApp *model.Application
+		appRepo.UpdateFunc = func(ctx context.Context, a *model.Application) error {
+			updatedApp = a
+			return nil
+		}
+
+		err := svc.DeleteStage(context.Background(), userID, appID, stageID)
 
 		require.NoError(t, err)
 		// The current stage should be recalculated to the remaining completed stage
@@ -1388,6 +2321,116 @@ func TestApplicationService_AddStage_WithExistingStages(t *testing.T) {
 	})
 }
 
+func TestApplicationService_AddStage_Transaction(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+	currentStageID := "stage-0"
+
+	setup := func(t *testing.T) (*ApplicationService, pgxmock.PgxPoolIface) {
+		svc, appRepo, stageRepo, templateRepo, _, _, _, commentRepo := createTestService()
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		t.Cleanup(mock.Close)
+		svc.pool = mock
+
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, CurrentStageID: &currentStageID}, nil
+		}
+		templateRepo.GetByIDFunc = func(_ context.Context, _, tid string) (*model.StageTemplate, error) {
+			return &model.StageTemplate{ID: tid, Name: "Onsite"}, nil
+		}
+		stageRepo.ListByApplicationFunc = func(_ context.Context, aid string) ([]*model.ApplicationStage, error) {
+			return []*model.ApplicationStage{{ID: currentStageID, ApplicationID: aid, StageTemplateID: "template-1", Status: "active"}}, nil
+		}
+		stageRepo.GetByIDFunc = func(_ context.Context, sid string) (*model.ApplicationStage, error) {
+			return &model.ApplicationStage{ID: sid, ApplicationID: appID, StageTemplateID: "template-1", Status: "active"}, nil
+		}
+		commentRepo.CreateFunc = func(_ context.Context, _ *commentModel.Comment) error {
+			t.Fatal("the stage comment is written inside the transaction")
+			return nil
+		}
+		return svc, mock
+	}
+
+	expectStageWrites := func(mock pgxmock.PgxPoolIface) {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE application_stages SET status").
+			WithArgs(currentStageID, "completed", pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectExec("INSERT INTO application_stages").
+			WithArgs(pgxmock.AnyArg(), appID, "template-2", "active", 1, pgxmock.AnyArg(), nil, pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec("UPDATE applications SET current_stage_id").
+			WithArgs(appID, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	}
+
+	t.Run("writes the stage and its comment in one transaction", func(t *testing.T) {
+		svc, mock := setup(t)
+		expectStageWrites(mock)
+		mock.ExpectExec("INSERT INTO comments").
+			WithArgs(pgxmock.AnyArg(), userID, appID, pgxmock.AnyArg(), "Starting technical interview", pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectCommit()
+
+		comment := "  Starting technical interview  "
+		result, err := svc.AddStage(context.Background(), userID, appID, &model.AddStageRequest{StageTemplateID: "template-2", Comment: &comment})
+
+		require.NoError(t, err)
+		assert.Equal(t, "active", result.Status)
+		assert.Equal(t, 1, result.Order)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skips a blank comment", func(t *testing.T) {
+		svc, mock := setup(t)
+		expectStageWrites(mock)
+		mock.ExpectCommit()
+
+		blank := "   "
+		_, err := svc.AddStage(context.Background(), userID, appID, &model.AddStageRequest{StageTemplateID: "template-2", Comment: &blank})
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back the stage change when the comment fails", func(t *testing.T) {
+		svc, mock := setup(t)
+		expectStageWrites(mock)
+		dbErr := errors.New("insert failed")
+		mock.ExpectExec("INSERT INTO comments").
+			WithArgs(pgxmock.AnyArg(), userID, appID, pgxmock.AnyArg(), "Starting technical interview", pgxmock.AnyArg()).
+			WillReturnError(dbErr)
+		mock.ExpectRollback()
+
+		comment := "Starting technical interview"
+		result, err := svc.AddStage(context.Background(), userID, appID, &model.AddStageRequest{StageTemplateID: "template-2", Comment: &comment})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, dbErr)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back when creating the stage fails", func(t *testing.T) {
+		svc, mock := setup(t)
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE application_stages SET status").
+			WithArgs(currentStageID, "completed", pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		dbErr := errors.New("insert failed")
+		mock.ExpectExec("INSERT INTO application_stages").
+			WithArgs(pgxmock.AnyArg(), appID, "template-2", "active", 1, pgxmock.AnyArg(), nil, pgxmock.AnyArg()).
+			WillReturnError(dbErr)
+		mock.ExpectRollback()
+
+		result, err := svc.AddStage(context.Background(), userID, appID, &model.AddStageRequest{StageTemplateID: "template-2"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, dbErr)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 // MockResumeBuilderRepository implements rbPorts.ResumeBuilderRepository (minimal)
 type MockResumeBuilderRepository struct {
 	GetByIDFunc func(ctx context.Context, id string) (*rbModel.ResumeBuilder, error)
@@ -1580,7 +2623,16 @@ func createTestServiceWithRBRepo() (*ApplicationService, *MockApplicationReposit
 	rbRepo := &MockResumeBuilderRepository{}
 	commentRepo := &MockCommentRepository{}
 
-	svc := NewApplicationService(nil, appRepo, stageRepo, templateRepo, jobRepo, companyRepo, resumeRepo, rbRepo, commentRepo, nil)
+	svc := NewApplicationService(ApplicationServiceConfig{
+		AppRepo:           appRepo,
+		StageRepo:         stageRepo,
+		TemplateRepo:      templateRepo,
+		JobRepo:           jobRepo,
+		CompanyRepo:       companyRepo,
+		ResumeRepo:        resumeRepo,
+		ResumeBuilderRepo: rbRepo,
+		CommentRepo:       commentRepo,
+	})
 	return svc, appRepo, stageRepo, templateRepo, jobRepo, companyRepo, resumeRepo, rbRepo, commentRepo
 }
 
@@ -1996,7 +3048,7 @@ func TestGetByID_CommentsAreSplit(t *testing.T) {
 			return &jobModel.Job{ID: jid, Title: "Engineer"}, nil
 		}
 
-		commentRepo.ListByApplicationFunc = func(ctx context.Context, aid string, uid ...string) ([]*commentModel.Comment, error) {
+		commentRepo.ListByApplicationFunc = func(ctx context.Context, aid, sortDir string, uid ...string) ([]*commentModel.Comment, error) {
 			return comments, nil
 		}
 
@@ -2029,7 +3081,7 @@ func TestGetByID_CommentsAreSplit(t *testing.T) {
 			return &jobModel.Job{ID: jid, Title: "Engineer"}, nil
 		}
 
-		commentRepo.ListByApplicationFunc = func(ctx context.Context, aid string, uid ...string) ([]*commentModel.Comment, error) {
+		commentRepo.ListByApplicationFunc = func(ctx context.Context, aid, sortDir string, uid ...string) ([]*commentModel.Comment, error) {
 			return nil, errors.New("comment fetch error")
 		}
 
@@ -2257,7 +3309,16 @@ func TestCreate_LimitCheckerBlocksCreation(t *testing.T) {
 		},
 	}
 
-	svc := NewApplicationService(nil, appRepo, stageRepo, templateRepo, jobRepo, companyRepo, resumeRepo, nil, commentRepo, nil, limitChecker)
+	svc := NewApplicationService(ApplicationServiceConfig{
+		AppRepo:      appRepo,
+		StageRepo:    stageRepo,
+		TemplateRepo: templateRepo,
+		JobRepo:      jobRepo,
+		CompanyRepo:  companyRepo,
+		ResumeRepo:   resumeRepo,
+		CommentRepo:  commentRepo,
+		LimitChecker: limitChecker,
+	})
 
 	req := &model.CreateApplicationRequest{
 		JobID: "job-1",
@@ -2778,7 +3839,7 @@ func TestList_RepoError(t *testing.T) {
 		return nil, 0, errors.New("list error")
 	}
 
-	result, total, err := svc.List(context.Background(), "user-123", "created_at", "desc", "", 20, 0)
+	result, total, err := svc.List(context.Background(), "user-123", &ports.ListOptions{SortFields: []ports.SortField{{Field: "applied_at", Dir: "desc"}}, Limit: 20, Offset: 0})
 
 	assert.Nil(t, result)
 	assert.Equal(t, 0, total)
@@ -2791,15 +3852,14 @@ func TestList_PassesFilterParams(t *testing.T) {
 	svc, appRepo, _, _, _, _, _, _ := createTestService()
 
 	appRepo.ListEnrichedFunc = func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.ApplicationDTO, int, error) {
-		assert.Equal(t, "updated_at", opts.SortBy)
-		assert.Equal(t, "asc", opts.SortDir)
-		assert.Equal(t, "active", opts.Status)
+		assert.Equal(t, []ports.SortField{{Field: "status", Dir: "asc"}}, opts.SortFields)
+		assert.Equal(t, []string{"active"}, opts.Statuses)
 		assert.Equal(t, 10, opts.Limit)
 		assert.Equal(t, 5, opts.Offset)
 		return []*model.ApplicationDTO{}, 0, nil
 	}
 
-	_, _, err := svc.List(context.Background(), "user-123", "updated_at", "asc", "active", 10, 5)
+	_, _, err := svc.List(context.Background(), "user-123", &ports.ListOptions{SortFields: []ports.SortField{{Field: "status", Dir: "asc"}}, Statuses: []string{"active"}, Limit: 10, Offset: 5})
 
 	require.NoError(t, err)
 }
@@ -2873,7 +3933,7 @@ func TestApplicationService_GetByID_BuildDTOError(t *testing.T) {
 			return nil, errors.New("job not found")
 		}
 
-		commentRepo.ListByApplicationFunc = func(_ context.Context, _ string, _ ...string) ([]*commentModel.Comment, error) {
+		commentRepo.ListByApplicationFunc = func(_ context.Context, _, _ string, _ ...string) ([]*commentModel.Comment, error) {
 			return nil, nil
 		}
 
@@ -2893,7 +3953,16 @@ func TestApplicationService_Create_LimitChecker(t *testing.T) {
 			},
 		}
 		appRepo := &MockApplicationRepository{}
-		svc := NewApplicationService(nil, appRepo, &MockStageRepository{}, &MockTemplateRepository{}, &MockJobRepository{}, &MockCompanyRepository{}, &MockResumeRepository{}, nil, &MockCommentRepository{}, nil, lc)
+		svc := NewApplicationService(ApplicationServiceConfig{
+			AppRepo:      appRepo,
+			StageRepo:    &MockStageRepository{},
+			TemplateRepo: &MockTemplateRepository{},
+			JobRepo:      &MockJobRepository{},
+			CompanyRepo:  &MockCompanyRepository{},
+			ResumeRepo:   &MockResumeRepository{},
+			CommentRepo:  &MockCommentRepository{},
+			LimitChecker: lc,
+		})
 
 		req := &model.CreateApplicationRequest{JobID: "job-1"}
 		result, err := svc.Create(context.Background(), "user-123", req)
@@ -2952,7 +4021,7 @@ func TestApplicationService_GetByID_WithComments(t *testing.T) {
 		}
 
 		stageID := "stage-1"
-		commentRepo.ListByApplicationFunc = func(_ context.Context, _ string, _ ...string) ([]*commentModel.Comment, error) {
+		commentRepo.ListByApplicationFunc = func(_ context.Context, _, _ string, _ ...string) ([]*commentModel.Comment, error) {
 			return []*commentModel.Comment{
 				{ID: "comment-1", ApplicationID: appID, StageID: nil, Content: "App-level comment"},
 				{ID: "comment-2", ApplicationID: appID, StageID: &stageID, Content: "Stage comment"},
@@ -2967,6 +4036,33 @@ func TestApplicationService_GetByID_WithComments(t *testing.T) {
 		assert.Len(t, result.StageComments, 1)
 	})
 
+	t.Run("counts pinned comments", func(t *testing.T) {
+		svc, appRepo, _, _, jobRepo, _, _, commentRepo := createTestService()
+
+		appRepo.GetByIDFunc = func(_ context.Context, _, _ string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID, JobID: "job-1", Status: "active"}, nil
+		}
+		jobRepo.GetByIDFunc = func(_ context.Context, _, _ string) (*jobModel.Job, error) {
+			return &jobModel.Job{ID: "job-1", Title: "Software Engineer"}, nil
+		}
+
+		stageID := "stage-1"
+		commentRepo.ListByApplicationFunc = func(_ context.Context, _, _ string, _ ...string) ([]*commentModel.Comment, error) {
+			return []*commentModel.Comment{
+				{ID: "comment-1", ApplicationID: appID, Content: "Offer received", IsPinned: true},
+				{ID: "comment-2", ApplicationID: appID, StageID: &stageID, Content: "Ask about equity", IsPinned: true},
+				{ID: "comment-3", ApplicationID: appID, Content: "Sent thank-you note"},
+			}, nil
+		}
+
+		result, err := svc.GetByID(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.PinCount)
+		require.Len(t, result.ApplicationComments, 2)
+		assert.True(t, result.ApplicationComments[0].IsPinned)
+	})
+
 	t.Run("handles comment fetch error gracefully", func(t *testing.T) {
 		svc, appRepo, _, _, jobRepo, _, _, commentRepo := createTestService()
 
@@ -2989,7 +4085,7 @@ func TestApplicationService_GetByID_WithComments(t *testing.T) {
 			return &jobModel.Job{ID: "job-1", Title: "Software Engineer"}, nil
 		}
 
-		commentRepo.ListByApplicationFunc = func(_ context.Context, _ string, _ ...string) ([]*commentModel.Comment, error) {
+		commentRepo.ListByApplicationFunc = func(_ context.Context, _, _ string, _ ...string) ([]*commentModel.Comment, error) {
 			return nil, errors.New("comments unavailable")
 		}
 
@@ -3349,3 +4445,1498 @@ func TestApplicationService_Create_FallbackName(t *testing.T) {
 		assert.Equal(t, "Untitled Application", createdApp.Name)
 	})
 }
+
+type MockTagRepository struct {
+	ListFunc            func(ctx context.Context, userID string) ([]*tagModel.Tag, error)
+	ListOwnedIDsFunc    func(ctx context.Context, userID string, tagIDs []string) ([]string, error)
+	AddRelationsFunc    func(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error)
+	RemoveRelationsFunc func(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error)
+}
+
+func (m *MockTagRepository) Create(ctx context.Context, tag *tagModel.Tag) error { return nil }
+func (m *MockTagRepository) List(ctx context.Context, userID string) ([]*tagModel.Tag, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, userID)
+	}
+	return nil, nil
+}
+func (m *MockTagRepository) GetByID(ctx context.Context, userID, tagID string) (*tagModel.Tag, error) {
+	return nil, tagModel.ErrTagNotFound
+}
+func (m *MockTagRepository) Update(ctx context.Context, tag *tagModel.Tag) error    { return nil }
+func (m *MockTagRepository) Delete(ctx context.Context, userID, tagID string) error { return nil }
+func (m *MockTagRepository) AddRelation(ctx context.Context, rel *tagModel.TagRelation) error {
+	return nil
+}
+func (m *MockTagRepository) RemoveRelation(ctx context.Context, tagID, entityType, entityID string) error {
+	return nil
+}
+func (m *MockTagRepository) EntityExists(ctx context.Context, userID, entityType, entityID string) (bool, error) {
+	return false, nil
+}
+func (m *MockTagRepository) ListByEntity(ctx context.Context, entityType, entityID string) ([]*tagModel.Tag, error) {
+	return nil, nil
+}
+func (m *MockTagRepository) ListOwnedIDs(ctx context.Context, userID string, tagIDs []string) ([]string, error) {
+	if m.ListOwnedIDsFunc != nil {
+		return m.ListOwnedIDsFunc(ctx, userID, tagIDs)
+	}
+	return tagIDs, nil
+}
+func (m *MockTagRepository) AddRelations(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+	if m.AddRelationsFunc != nil {
+		return m.AddRelationsFunc(ctx, tagIDs, entityType, entityIDs)
+	}
+	return 0, nil
+}
+func (m *MockTagRepository) RemoveRelations(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+	if m.RemoveRelationsFunc != nil {
+		return m.RemoveRelationsFunc(ctx, tagIDs, entityType, entityIDs)
+	}
+	return 0, nil
+}
+
+func TestApplicationService_BulkTag(t *testing.T) {
+	userID := "user-123"
+
+	newService := func() (*ApplicationService, *MockApplicationRepository, *MockTagRepository) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		tagRepo := &MockTagRepository{}
+		svc.tagRepo = tagRepo
+		return svc, appRepo, tagRepo
+	}
+
+	t.Run("adds tags to all applications", func(t *testing.T) {
+		svc, appRepo, tagRepo := newService()
+
+		appRepo.ListOwnedIDsFunc = func(ctx context.Context, uid string, appIDs []string) ([]string, error) {
+			assert.Equal(t, userID, uid)
+			return appIDs, nil
+		}
+		tagRepo.ListOwnedIDsFunc = func(ctx context.Context, uid string, tagIDs []string) ([]string, error) {
+			assert.Equal(t, userID, uid)
+			return tagIDs, nil
+		}
+		tagRepo.AddRelationsFunc = func(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+			assert.Equal(t, []string{"tag-1", "tag-2"}, tagIDs)
+			assert.Equal(t, tagModel.EntityTypeApplication, entityType)
+			assert.Equal(t, []string{"app-1", "app-2", "app-3"}, entityIDs)
+			return 5, nil
+		}
+		tagRepo.RemoveRelationsFunc = func(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+			t.Fatal("remove should not be called")
+			return 0, nil
+		}
+
+		req := &model.BulkTagRequest{
+			ApplicationIDs: []string{"app-1", "app-2", "app-3", "app-1"},
+			TagIDs:         []string{"tag-1", "tag-2"},
+			Action:         model.BulkTagActionAdd,
+		}
+		result, err := svc.BulkTag(context.Background(), userID, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), result.AffectedRelations)
+	})
+
+	t.Run("removes tags from all applications", func(t *testing.T) {
+		svc, _, tagRepo := newService()
+
+		tagRepo.AddRelationsFunc = func(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+			t.Fatal("add should not be called")
+			return 0, nil
+		}
+		tagRepo.RemoveRelationsFunc = func(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+			assert.Equal(t, []string{"tag-1"}, tagIDs)
+			assert.Equal(t, tagModel.EntityTypeApplication, entityType)
+			assert.Equal(t, []string{"app-1", "app-2"}, entityIDs)
+			return 2, nil
+		}
+
+		req := &model.BulkTagRequest{
+			ApplicationIDs: []string{"app-1", "app-2"},
+			TagIDs:         []string{"tag-1"},
+			Action:         model.BulkTagActionRemove,
+		}
+		result, err := svc.BulkTag(context.Background(), userID, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.AffectedRelations)
+	})
+
+	t.Run("returns not found when an application is not owned", func(t *testing.T) {
+		svc, appRepo, tagRepo := newService()
+
+		appRepo.ListOwnedIDsFunc = func(ctx context.Context, uid string, appIDs []string) ([]string, error) {
+			return []string{"app-1"}, nil
+		}
+		tagRepo.AddRelationsFunc = func(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+			t.Fatal("no relations should be written")
+			return 0, nil
+		}
+
+		req := &model.BulkTagRequest{
+			ApplicationIDs: []string{"app-1", "app-other"},
+			TagIDs:         []string{"tag-1"},
+			Action:         model.BulkTagActionAdd,
+		}
+		result, err := svc.BulkTag(context.Background(), userID, req)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+
+	t.Run("returns tag not found when a tag is not owned", func(t *testing.T) {
+		svc, _, tagRepo := newService()
+
+		tagRepo.ListOwnedIDsFunc = func(ctx context.Context, uid string, tagIDs []string) ([]string, error) {
+			return nil, nil
+		}
+		tagRepo.RemoveRelationsFunc = func(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+			t.Fatal("no relations should be removed")
+			return 0, nil
+		}
+
+		req := &model.BulkTagRequest{
+			ApplicationIDs: []string{"app-1"},
+			TagIDs:         []string{"tag-other"},
+			Action:         model.BulkTagActionRemove,
+		}
+		result, err := svc.BulkTag(context.Background(), userID, req)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrTagNotFound)
+	})
+
+	t.Run("returns error when relation write fails", func(t *testing.T) {
+		svc, _, tagRepo := newService()
+
+		tagRepo.AddRelationsFunc = func(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+			return 0, errors.New("database error")
+		}
+
+		req := &model.BulkTagRequest{
+			ApplicationIDs: []string{"app-1"},
+			TagIDs:         []string{"tag-1"},
+			Action:         model.BulkTagActionAdd,
+		}
+		result, err := svc.BulkTag(context.Background(), userID, req)
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+}
+
+// MockObjectStorage implements storage.ObjectStorage for cover letter tests
+type MockObjectStorage struct {
+	PutObjectFunc                    func(ctx context.Context, key, contentType string, data []byte) error
+	GeneratePresignedDownloadURLFunc func(ctx context.Context, key string, expiry time.Duration) (string, error)
+	DeletedKeys                      []string
+}
+
+func (m *MockObjectStorage) GeneratePresignedUploadURL(ctx context.Context, key, contentType string, expiry time.Duration) (string, error) {
+	return "", nil
+}
+func (m *MockObjectStorage) GeneratePresignedDownloadURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	if m.GeneratePresignedDownloadURLFunc != nil {
+		return m.GeneratePresignedDownloadURLFunc(ctx, key, expiry)
+	}
+	return "https://s3.test/" + key, nil
+}
+func (m *MockObjectStorage) PutObject(ctx context.Context, key, contentType string, data []byte) error {
+	if m.PutObjectFunc != nil {
+		return m.PutObjectFunc(ctx, key, contentType, data)
+	}
+	return nil
+}
+func (m *MockObjectStorage) DeleteObject(ctx context.Context, key string) error {
+	m.DeletedKeys = append(m.DeletedKeys, key)
+	return nil
+}
+func (m *MockObjectStorage) GetObject(ctx context.Context, key string) ([]byte, error) {
+	return nil, nil
+}
+func (m *MockObjectStorage) ObjectExists(ctx context.Context, key string) (bool, error) {
+	return true, nil
+}
+
+func TestApplicationService_UploadCoverLetter(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	t.Run("uploads to S3 and attaches the key", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		objectStorage := &MockObjectStorage{}
+		svc.storage = objectStorage
+
+		var putKey, putType string
+		objectStorage.PutObjectFunc = func(ctx context.Context, key, contentType string, data []byte) error {
+			putKey = key
+			putType = contentType
+			assert.Equal(t, []byte("%PDF-1.7 letter"), data)
+			return nil
+		}
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Status: "active"}, nil
+		}
+		var updated *model.Application
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error {
+			updated = app
+			return nil
+		}
+
+		result, err := svc.UploadCoverLetter(context.Background(), userID, appID, []byte("%PDF-1.7 letter"))
+
+		require.NoError(t, err)
+		assert.Regexp(t, `^cover_letters/user-123/app-1/[0-9a-f-]{36}\.pdf$`, putKey)
+		assert.Equal(t, "application/pdf", putType)
+		require.NotNil(t, updated)
+		require.NotNil(t, updated.CoverLetterURL)
+		assert.Equal(t, putKey, *updated.CoverLetterURL)
+		require.NotNil(t, updated.CoverLetterStorageType)
+		assert.Equal(t, model.CoverLetterStorageS3, *updated.CoverLetterStorageType)
+		require.NotNil(t, result.CoverLetterStorageType)
+		assert.Equal(t, model.CoverLetterStorageS3, *result.CoverLetterStorageType)
+		assert.Nil(t, result.CoverLetterURL, "S3 keys must not be exposed")
+		assert.Empty(t, objectStorage.DeletedKeys)
+	})
+
+	t.Run("deletes the replaced cover letter file after the update", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		objectStorage := &MockObjectStorage{}
+		svc.storage = objectStorage
+
+		oldKey := "cover_letters/user-123/app-1/old.pdf"
+		s3Type := model.CoverLetterStorageS3
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, CoverLetterURL: &oldKey, CoverLetterStorageType: &s3Type}, nil
+		}
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error {
+			assert.Empty(t, objectStorage.DeletedKeys, "the old file must outlive the update")
+			return nil
+		}
+
+		_, err := svc.UploadCoverLetter(context.Background(), userID, appID, []byte("Dear hiring manager,\n"))
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{oldKey}, objectStorage.DeletedKeys)
+	})
+
+	t.Run("deletes the new file when the update fails", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		var putKey string
+		objectStorage := &MockObjectStorage{
+			PutObjectFunc: func(ctx context.Context, key, contentType string, data []byte) error {
+				putKey = key
+				return nil
+			},
+		}
+		svc.storage = objectStorage
+
+		oldKey := "cover_letters/user-123/app-1/old.pdf"
+		s3Type := model.CoverLetterStorageS3
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, CoverLetterURL: &oldKey, CoverLetterStorageType: &s3Type}, nil
+		}
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error {
+			return errors.New("db error")
+		}
+
+		_, err := svc.UploadCoverLetter(context.Background(), userID, appID, []byte("%PDF-1.7 letter"))
+
+		assert.Error(t, err)
+		assert.Equal(t, []string{putKey}, objectStorage.DeletedKeys)
+	})
+
+	t.Run("rejects files that are not documents", func(t *testing.T) {
+		svc, _, _, _, _, _, _, _ := createTestService()
+		svc.storage = &MockObjectStorage{
+			PutObjectFunc: func(ctx context.Context, key, contentType string, data []byte) error {
+				t.Fatal("nothing should be uploaded")
+				return nil
+			},
+		}
+
+		result, err := svc.UploadCoverLetter(context.Background(), userID, appID, []byte("<html><body>letter</body></html>"))
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrUnsupportedCoverLetter)
+	})
+
+	t.Run("returns storage not configured without S3", func(t *testing.T) {
+		svc, _, _, _, _, _, _, _ := createTestService()
+
+		result, err := svc.UploadCoverLetter(context.Background(), userID, appID, []byte("%PDF-1.7 letter"))
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrStorageNotConfigured)
+	})
+
+	t.Run("returns not found for another user's application", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		objectStorage := &MockObjectStorage{
+			PutObjectFunc: func(ctx context.Context, key, contentType string, data []byte) error {
+				t.Fatal("nothing should be uploaded")
+				return nil
+			},
+		}
+		svc.storage = objectStorage
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return nil, model.ErrApplicationNotFound
+		}
+
+		result, err := svc.UploadCoverLetter(context.Background(), userID, appID, []byte("%PDF-1.7 letter"))
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+
+	t.Run("does not update the application when the upload fails", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		svc.storage = &MockObjectStorage{
+			PutObjectFunc: func(ctx context.Context, key, contentType string, data []byte) error {
+				return errors.New("s3 error")
+			},
+		}
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid}, nil
+		}
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error {
+			t.Fatal("application should not be updated")
+			return nil
+		}
+
+		result, err := svc.UploadCoverLetter(context.Background(), userID, appID, []byte("%PDF-1.7 letter"))
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+}
+
+func TestApplicationService_GenerateCoverLetterDownloadURL(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+	s3Type := model.CoverLetterStorageS3
+	externalType := model.CoverLetterStorageExternal
+
+	t.Run("returns presigned URL for uploaded cover letter", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		svc.storage = &MockObjectStorage{
+			GeneratePresignedDownloadURLFunc: func(ctx context.Context, key string, expiry time.Duration) (string, error) {
+				assert.Equal(t, "cover_letters/user-123/app-1", key)
+				return "https://s3.test/signed", nil
+			},
+		}
+
+		key := "cover_letters/user-123/app-1"
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, CoverLetterURL: &key, CoverLetterStorageType: &s3Type}, nil
+		}
+
+		result, err := svc.GenerateCoverLetterDownloadURL(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://s3.test/signed", result.DownloadURL)
+		assert.Equal(t, 900, result.ExpiresIn)
+	})
+
+	t.Run("returns not found when no cover letter is uploaded", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		svc.storage = &MockObjectStorage{}
+
+		url := "https://docs.example.com/letter"
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, CoverLetterURL: &url, CoverLetterStorageType: &externalType}, nil
+		}
+
+		result, err := svc.GenerateCoverLetterDownloadURL(context.Background(), userID, appID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrCoverLetterNotFound)
+	})
+
+	t.Run("returns storage not configured without S3", func(t *testing.T) {
+		svc, _, _, _, _, _, _, _ := createTestService()
+
+		result, err := svc.GenerateCoverLetterDownloadURL(context.Background(), userID, appID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrStorageNotConfigured)
+	})
+}
+
+func TestApplicationService_ExternalCoverLetter(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("create stores external cover letter URL", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		var created *model.Application
+		appRepo.CreateFunc = func(ctx context.Context, app *model.Application) error {
+			app.ID = "app-1"
+			created = app
+			return nil
+		}
+
+		url := " https://docs.example.com/letter "
+		result, err := svc.Create(context.Background(), userID, &model.CreateApplicationRequest{JobID: "job-1", Name: "App", CoverLetterURL: &url})
+
+		require.NoError(t, err)
+		require.NotNil(t, created.CoverLetterURL)
+		assert.Equal(t, "https://docs.example.com/letter", *created.CoverLetterURL)
+		require.NotNil(t, result.CoverLetterURL)
+		assert.Equal(t, "https://docs.example.com/letter", *result.CoverLetterURL)
+		assert.Equal(t, model.CoverLetterStorageExternal, *result.CoverLetterStorageType)
+	})
+
+	t.Run("update with empty URL removes cover letter", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		objectStorage := &MockObjectStorage{}
+		svc.storage = objectStorage
+
+		key := "cover_letters/user-123/app-1/letter.pdf"
+		s3Type := model.CoverLetterStorageS3
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Status: "active", CoverLetterURL: &key, CoverLetterStorageType: &s3Type}, nil
+		}
+		var updated *model.Application
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error {
+			updated = app
+			return nil
+		}
+
+		empty := ""
+		result, err := svc.Update(context.Background(), userID, "app-1", &model.UpdateApplicationRequest{CoverLetterURL: &empty})
+
+		require.NoError(t, err)
+		assert.Nil(t, updated.CoverLetterURL)
+		assert.Nil(t, updated.CoverLetterStorageType)
+		assert.Nil(t, result.CoverLetterStorageType)
+		assert.Equal(t, []string{key}, objectStorage.DeletedKeys)
+	})
+
+	t.Run("update keeps the uploaded file when the update fails", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		objectStorage := &MockObjectStorage{}
+		svc.storage = objectStorage
+
+		key := "cover_letters/user-123/app-1/letter.pdf"
+		s3Type := model.CoverLetterStorageS3
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Status: "active", CoverLetterURL: &key, CoverLetterStorageType: &s3Type}, nil
+		}
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error {
+			return errors.New("db error")
+		}
+
+		url := "https://docs.example.com/letter"
+		_, err := svc.Update(context.Background(), userID, "app-1", &model.UpdateApplicationRequest{CoverLetterURL: &url})
+
+		assert.Error(t, err)
+		assert.Empty(t, objectStorage.DeletedKeys)
+	})
+}
+
+func TestApplicationService_Metadata(t *testing.T) {
+	userID := "user-123"
+
+	oversized := map[string]interface{}{"notes": strings.Repeat("x", maxMetadataSize)}
+
+	t.Run("create stores metadata", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		var created *model.Application
+		appRepo.CreateFunc = func(ctx context.Context, app *model.Application) error {
+			app.ID = "app-1"
+			created = app
+			return nil
+		}
+
+		metadata := map[string]interface{}{"visa_sponsored": true, "remote_policy": "hybrid"}
+		result, err := svc.Create(context.Background(), userID, &model.CreateApplicationRequest{JobID: "job-1", Name: "App", Metadata: metadata})
+
+		require.NoError(t, err)
+		assert.Equal(t, metadata, created.Metadata)
+		assert.Equal(t, metadata, result.Metadata)
+	})
+
+	t.Run("create rejects metadata over 10KB", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		appRepo.CreateFunc = func(ctx context.Context, app *model.Application) error {
+			t.Fatal("application should not be created")
+			return nil
+		}
+
+		result, err := svc.Create(context.Background(), userID, &model.CreateApplicationRequest{JobID: "job-1", Metadata: oversized})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrMetadataTooLarge)
+	})
+
+	t.Run("accepts metadata exactly at the limit", func(t *testing.T) {
+		// {"k":"..."} adds 8 bytes of JSON around the value
+		metadata := map[string]interface{}{"k": strings.Repeat("x", maxMetadataSize-8)}
+
+		assert.NoError(t, validateMetadata(metadata))
+	})
+
+	t.Run("rejects metadata one byte over the limit", func(t *testing.T) {
+		metadata := map[string]interface{}{"k": strings.Repeat("x", maxMetadataSize-7)}
+
+		assert.ErrorIs(t, validateMetadata(metadata), model.ErrMetadataTooLarge)
+	})
+
+	t.Run("update replaces metadata", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Status: "active", Metadata: map[string]interface{}{"old": "value"}}, nil
+		}
+		var updated *model.Application
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error {
+			updated = app
+			return nil
+		}
+
+		metadata := map[string]interface{}{"visa_sponsored": false}
+		_, err := svc.Update(context.Background(), userID, "app-1", &model.UpdateApplicationRequest{Metadata: metadata})
+
+		require.NoError(t, err)
+		assert.Equal(t, metadata, updated.Metadata)
+	})
+
+	t.Run("update without metadata keeps existing values", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		existing := map[string]interface{}{"old": "value"}
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Status: "active", Metadata: existing}, nil
+		}
+		var updated *model.Application
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error {
+			updated = app
+			return nil
+		}
+
+		status := "offer"
+		_, err := svc.Update(context.Background(), userID, "app-1", &model.UpdateApplicationRequest{Status: &status})
+
+		require.NoError(t, err)
+		assert.Equal(t, existing, updated.Metadata)
+	})
+
+	t.Run("update rejects metadata over 10KB", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, Status: "active"}, nil
+		}
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error {
+			t.Fatal("application should not be updated")
+			return nil
+		}
+
+		result, err := svc.Update(context.Background(), userID, "app-1", &model.UpdateApplicationRequest{Metadata: oversized})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrMetadataTooLarge)
+	})
+}
+
+type mockProfileInvalidator struct {
+	calledWith []string
+}
+
+func (m *mockProfileInvalidator) InvalidateProfile(ctx context.Context, userID string) error {
+	m.calledWith = append(m.calledWith, userID)
+	return nil
+}
+
+func TestApplicationService_ProfileInvalidation(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("invalidates profile on create", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		profileCache := &mockProfileInvalidator{}
+		svc.profileCache = profileCache
+
+		appRepo.CreateFunc = func(ctx context.Context, app *model.Application) error {
+			app.ID = "app-1"
+			return nil
+		}
+
+		_, err := svc.Create(context.Background(), userID, &model.CreateApplicationRequest{JobID: "job-1", Name: "Backend role"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID}, profileCache.calledWith)
+	})
+
+	t.Run("invalidates profile on delete", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		profileCache := &mockProfileInvalidator{}
+		svc.profileCache = profileCache
+
+		appRepo.DeleteFunc = func(ctx context.Context, uid, aid string) error { return nil }
+
+		err := svc.Delete(context.Background(), userID, "app-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID}, profileCache.calledWith)
+	})
+
+	t.Run("does not invalidate when delete fails", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		profileCache := &mockProfileInvalidator{}
+		svc.profileCache = profileCache
+
+		appRepo.DeleteFunc = func(ctx context.Context, uid, aid string) error { return model.ErrApplicationNotFound }
+
+		err := svc.Delete(context.Background(), userID, "app-1")
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+		assert.Empty(t, profileCache.calledWith)
+	})
+}
+
+type mockAnalyticsInvalidator struct {
+	calledWith []string
+}
+
+func (m *mockAnalyticsInvalidator) InvalidateAnalytics(ctx context.Context, userID string) error {
+	m.calledWith = append(m.calledWith, userID)
+	return nil
+}
+
+func TestApplicationService_AnalyticsInvalidation(t *testing.T) {
+	userID := "user-123"
+	status := "offer"
+
+	t.Run("invalidates analytics on create and delete", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		analyticsCache := &mockAnalyticsInvalidator{}
+		svc.analyticsCache = analyticsCache
+
+		appRepo.CreateFunc = func(ctx context.Context, app *model.Application) error {
+			app.ID = "app-1"
+			return nil
+		}
+		appRepo.DeleteFunc = func(ctx context.Context, uid, aid string) error { return nil }
+
+		_, err := svc.Create(context.Background(), userID, &model.CreateApplicationRequest{JobID: "job-1", Name: "Backend role"})
+		require.NoError(t, err)
+		require.NoError(t, svc.Delete(context.Background(), userID, "app-1"))
+
+		assert.Equal(t, []string{userID, userID}, analyticsCache.calledWith)
+	})
+
+	t.Run("invalidates analytics on update", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		analyticsCache := &mockAnalyticsInvalidator{}
+		svc.analyticsCache = analyticsCache
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Status: "active"}, nil
+		}
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error { return nil }
+
+		_, err := svc.Update(context.Background(), userID, "app-1", &model.UpdateApplicationRequest{Status: &status})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID}, analyticsCache.calledWith)
+	})
+
+	t.Run("invalidates analytics when a stage changes", func(t *testing.T) {
+		svc, appRepo, stageRepo, templateRepo, _, _, _, _ := createTestService()
+		analyticsCache := &mockAnalyticsInvalidator{}
+		svc.analyticsCache = analyticsCache
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid}, nil
+		}
+		stageRepo.GetByIDFunc = func(ctx context.Context, sid string) (*model.ApplicationStage, error) {
+			return &model.ApplicationStage{ID: sid, ApplicationID: "app-1", StageTemplateID: "template-1", Status: "active"}, nil
+		}
+		stageRepo.UpdateFunc = func(ctx context.Context, stage *model.ApplicationStage) error { return nil }
+		templateRepo.GetByIDFunc = func(ctx context.Context, uid, tid string) (*model.StageTemplate, error) {
+			return &model.StageTemplate{ID: tid, Name: "Phone Screen"}, nil
+		}
+
+		_, err := svc.CompleteStage(context.Background(), userID, "app-1", "stage-1", &model.CompleteStageRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID}, analyticsCache.calledWith)
+	})
+
+	t.Run("does not invalidate when update fails", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		analyticsCache := &mockAnalyticsInvalidator{}
+		svc.analyticsCache = analyticsCache
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Status: "active"}, nil
+		}
+		appRepo.UpdateFunc = func(ctx context.Context, app *model.Application) error { return errors.New("database error") }
+
+		_, err := svc.Update(context.Background(), userID, "app-1", &model.UpdateApplicationRequest{Status: &status})
+
+		assert.Error(t, err)
+		assert.Empty(t, analyticsCache.calledWith)
+	})
+
+	t.Run("invalidates analytics when a stage template is renamed or deleted", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+		analyticsCache := &mockAnalyticsInvalidator{}
+		svc.analyticsCache = analyticsCache
+		newName := "Onsite"
+		order := 2
+
+		templateRepo.GetByIDFunc = func(ctx context.Context, uid, tid string) (*model.StageTemplate, error) {
+			return &model.StageTemplate{ID: tid, UserID: uid, Name: "Phone Screen"}, nil
+		}
+		templateRepo.UpdateFunc = func(ctx context.Context, template *model.StageTemplate) error { return nil }
+		templateRepo.DeleteFunc = func(ctx context.Context, uid, tid string) error { return nil }
+
+		_, err := svc.UpdateStageTemplate(context.Background(), userID, "template-1", &model.UpdateStageTemplateRequest{Order: &order})
+		require.NoError(t, err)
+		assert.Empty(t, analyticsCache.calledWith, "reordering does not change reported stages")
+
+		_, err = svc.UpdateStageTemplate(context.Background(), userID, "template-1", &model.UpdateStageTemplateRequest{Name: &newName})
+		require.NoError(t, err)
+		require.NoError(t, svc.DeleteStageTemplate(context.Background(), userID, "template-1"))
+
+		assert.Equal(t, []string{userID, userID}, analyticsCache.calledWith)
+	})
+
+	t.Run("does not invalidate when the stage template delete fails", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+		analyticsCache := &mockAnalyticsInvalidator{}
+		svc.analyticsCache = analyticsCache
+
+		templateRepo.DeleteFunc = func(ctx context.Context, uid, tid string) error { return model.ErrStageTemplateNotFound }
+
+		err := svc.DeleteStageTemplate(context.Background(), userID, "template-1")
+
+		assert.ErrorIs(t, err, model.ErrStageTemplateNotFound)
+		assert.Empty(t, analyticsCache.calledWith)
+	})
+}
+
+func TestApplicationService_UpdateResume(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+	resumeID := "resume-2"
+
+	t.Run("switches resume and records a comment", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, resumeRepo, commentRepo := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID, JobID: "job-1", ResumeID: strPtr("resume-1")}, nil
+		}
+		resumeRepo.GetByIDFunc = func(ctx context.Context, uid, rid string) (*resumeModel.Resume, error) {
+			return &resumeModel.Resume{ID: rid, UserID: uid, Title: "Backend CV v2"}, nil
+		}
+		var updatedResumeID string
+		appRepo.UpdateResumeFunc = func(ctx context.Context, uid, aid, rid string) error {
+			updatedResumeID = rid
+			return nil
+		}
+		var comment *commentModel.Comment
+		commentRepo.CreateFunc = func(ctx context.Context, c *commentModel.Comment) error {
+			comment = c
+			return nil
+		}
+
+		result, err := svc.UpdateResume(context.Background(), userID, appID, resumeID)
+
+		require.NoError(t, err)
+		assert.Equal(t, resumeID, updatedResumeID)
+		require.NotNil(t, result.Resume)
+		assert.Equal(t, resumeID, result.Resume.ID)
+		require.NotNil(t, comment)
+		assert.Equal(t, "Resume updated to: Backend CV v2", comment.Content)
+		assert.Equal(t, appID, comment.ApplicationID)
+		assert.Equal(t, userID, comment.UserID)
+		assert.Nil(t, comment.StageID)
+	})
+
+	t.Run("returns ErrResumeNotFound for another user's resume", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, resumeRepo, commentRepo := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID}, nil
+		}
+		resumeRepo.GetByIDFunc = func(ctx context.Context, uid, rid string) (*resumeModel.Resume, error) {
+			assert.Equal(t, userID, uid)
+			return nil, resumeModel.ErrResumeNotFound
+		}
+		appRepo.UpdateResumeFunc = func(ctx context.Context, uid, aid, rid string) error {
+			t.Fatal("UpdateResume must not be called for a foreign resume")
+			return nil
+		}
+		commentRepo.CreateFunc = func(ctx context.Context, c *commentModel.Comment) error {
+			t.Fatal("no comment must be created for a foreign resume")
+			return nil
+		}
+
+		result, err := svc.UpdateResume(context.Background(), userID, appID, resumeID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrResumeNotFound)
+	})
+
+	t.Run("returns error when application not found", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return nil, model.ErrApplicationNotFound
+		}
+
+		result, err := svc.UpdateResume(context.Background(), userID, appID, resumeID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+
+	t.Run("comment failure does not fail the update", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, resumeRepo, commentRepo := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID, JobID: "job-1"}, nil
+		}
+		resumeRepo.GetByIDFunc = func(ctx context.Context, uid, rid string) (*resumeModel.Resume, error) {
+			return &resumeModel.Resume{ID: rid, Title: "CV"}, nil
+		}
+		commentRepo.CreateFunc = func(ctx context.Context, c *commentModel.Comment) error {
+			return errors.New("insert failed")
+		}
+
+		result, err := svc.UpdateResume(context.Background(), userID, appID, resumeID)
+
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestApplicationService_Archive(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	t.Run("archives an active application and records a comment", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, commentRepo := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID, JobID: "job-1", Status: "active"}, nil
+		}
+		var gotStatus string
+		var gotArchivedAt *time.Time
+		appRepo.SetArchiveStatusFunc = func(ctx context.Context, uid, aid, status string, archivedAt *time.Time) error {
+			assert.Equal(t, userID, uid)
+			assert.Equal(t, appID, aid)
+			gotStatus = status
+			gotArchivedAt = archivedAt
+			return nil
+		}
+		var comment *commentModel.Comment
+		commentRepo.CreateFunc = func(ctx context.Context, c *commentModel.Comment) error {
+			comment = c
+			return nil
+		}
+
+		result, err := svc.Archive(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "archived", gotStatus)
+		require.NotNil(t, gotArchivedAt)
+		assert.Equal(t, "archived", result.Status)
+		assert.Equal(t, gotArchivedAt, result.ArchivedAt)
+		require.NotNil(t, comment)
+		assert.Equal(t, "Application archived", comment.Content)
+		assert.Equal(t, appID, comment.ApplicationID)
+	})
+
+	t.Run("returns ErrInvalidStatusTransition when already archived", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, commentRepo := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID, Status: "archived"}, nil
+		}
+		appRepo.SetArchiveStatusFunc = func(ctx context.Context, uid, aid, status string, archivedAt *time.Time) error {
+			t.Fatal("SetArchiveStatus must not be called for an archived application")
+			return nil
+		}
+		commentRepo.CreateFunc = func(ctx context.Context, c *commentModel.Comment) error {
+			t.Fatal("no comment must be created for a rejected transition")
+			return nil
+		}
+
+		result, err := svc.Archive(context.Background(), userID, appID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrInvalidStatusTransition)
+	})
+
+	t.Run("returns error when application not found", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return nil, model.ErrApplicationNotFound
+		}
+
+		result, err := svc.Archive(context.Background(), userID, appID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+}
+
+func TestApplicationService_Unarchive(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	t.Run("returns an archived application to active", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, commentRepo := createTestService()
+
+		archivedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID, JobID: "job-1", Status: "archived", ArchivedAt: &archivedAt}, nil
+		}
+		var gotStatus string
+		appRepo.SetArchiveStatusFunc = func(ctx context.Context, uid, aid, status string, archivedAt *time.Time) error {
+			gotStatus = status
+			assert.Nil(t, archivedAt)
+			return nil
+		}
+		var comment *commentModel.Comment
+		commentRepo.CreateFunc = func(ctx context.Context, c *commentModel.Comment) error {
+			comment = c
+			return nil
+		}
+
+		result, err := svc.Unarchive(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "active", gotStatus)
+		assert.Equal(t, "active", result.Status)
+		assert.Nil(t, result.ArchivedAt)
+		require.NotNil(t, comment)
+		assert.Equal(t, "Application unarchived", comment.Content)
+	})
+
+	t.Run("returns ErrInvalidStatusTransition for an active application", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID, Status: "active"}, nil
+		}
+		appRepo.SetArchiveStatusFunc = func(ctx context.Context, uid, aid, status string, archivedAt *time.Time) error {
+			t.Fatal("SetArchiveStatus must not be called for an active application")
+			return nil
+		}
+
+		result, err := svc.Unarchive(context.Background(), userID, appID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrInvalidStatusTransition)
+	})
+
+	t.Run("comment failure does not fail the unarchive", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, commentRepo := createTestService()
+
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: appID, UserID: userID, JobID: "job-1", Status: "archived"}, nil
+		}
+		commentRepo.CreateFunc = func(ctx context.Context, c *commentModel.Comment) error {
+			return errors.New("insert failed")
+		}
+
+		result, err := svc.Unarchive(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestApplicationService_GetStageTemplateUsageStats(t *testing.T) {
+	t.Run("keeps unused templates in the result", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+
+		templateRepo.GetUsageStatsFunc = func(ctx context.Context, uid string) ([]*model.StageTemplateUsage, error) {
+			assert.Equal(t, "user-123", uid)
+			return []*model.StageTemplateUsage{
+				{TemplateID: "tpl-1", TemplateName: "Phone Screen", TotalUses: 4, ActiveUses: 1},
+				{TemplateID: "tpl-2", TemplateName: "Take-Home", TotalUses: 0},
+			}, nil
+		}
+
+		stats, err := svc.GetStageTemplateUsageStats(context.Background(), "user-123")
+
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+		assert.Equal(t, "tpl-2", stats[1].TemplateID)
+		assert.Equal(t, 0, stats[1].TotalUses)
+	})
+
+	t.Run("returns repository error", func(t *testing.T) {
+		svc, _, _, templateRepo, _, _, _, _ := createTestService()
+
+		templateRepo.GetUsageStatsFunc = func(ctx context.Context, uid string) ([]*model.StageTemplateUsage, error) {
+			return nil, errors.New("db error")
+		}
+
+		stats, err := svc.GetStageTemplateUsageStats(context.Background(), "user-123")
+
+		assert.Nil(t, stats)
+		assert.Error(t, err)
+	})
+}
+
+// MockReminderRepository implements reminderPorts.ReminderRepository
+type MockReminderRepository struct {
+	GetNextForApplicationFunc    func(ctx context.Context, appID string) (*reminderModel.Reminder, error)
+	MarkAllDoneByApplicationFunc func(ctx context.Context, userID, appID string) error
+}
+
+func (m *MockReminderRepository) Create(ctx context.Context, reminder *reminderModel.Reminder) error {
+	return nil
+}
+func (m *MockReminderRepository) GetByID(ctx context.Context, userID, reminderID string) (*reminderModel.Reminder, error) {
+	return nil, reminderModel.ErrReminderNotFound
+}
+func (m *MockReminderRepository) ListByUser(ctx context.Context, userID string) ([]*reminderModel.Reminder, error) {
+	return nil, nil
+}
+func (m *MockReminderRepository) CountDue(ctx context.Context, userID string, from, to time.Time) (int, error) {
+	return 0, nil
+}
+func (m *MockReminderRepository) GetNextForApplication(ctx context.Context, appID string) (*reminderModel.Reminder, error) {
+	if m.GetNextForApplicationFunc != nil {
+		return m.GetNextForApplicationFunc(ctx, appID)
+	}
+	return nil, reminderModel.ErrReminderNotFound
+}
+func (m *MockReminderRepository) ListUpcoming(ctx context.Context, userID string, withinDays int) ([]*reminderModel.ReminderWithApplication, error) {
+	return nil, nil
+}
+func (m *MockReminderRepository) Update(ctx context.Context, reminder *reminderModel.Reminder) error {
+	return nil
+}
+func (m *MockReminderRepository) List(ctx context.Context, userID string, filter *reminderModel.ListRemindersFilter) ([]*reminderModel.Reminder, error) {
+	return nil, nil
+}
+func (m *MockReminderRepository) Delete(ctx context.Context, userID, reminderID string) error {
+	return nil
+}
+func (m *MockReminderRepository) MarkDone(ctx context.Context, userID, reminderID string) error {
+	return nil
+}
+func (m *MockReminderRepository) MarkAllDoneByApplication(ctx context.Context, userID, appID string) error {
+	if m.MarkAllDoneByApplicationFunc != nil {
+		return m.MarkAllDoneByApplicationFunc(ctx, userID, appID)
+	}
+	return nil
+}
+func (m *MockReminderRepository) ApplicationOwned(ctx context.Context, userID, appID string) (bool, error) {
+	return true, nil
+}
+
+func TestApplicationService_GetNextReminder(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	t.Run("returns the next reminder", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid}, nil
+		}
+		svc.reminderRepo = &MockReminderRepository{
+			GetNextForApplicationFunc: func(ctx context.Context, aid string) (*reminderModel.Reminder, error) {
+				return &reminderModel.Reminder{ID: "rem-1", ApplicationID: aid}, nil
+			},
+		}
+
+		result, err := svc.GetNextReminder(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, "rem-1", result.ID)
+	})
+
+	t.Run("returns nil when no reminder is upcoming", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid}, nil
+		}
+		svc.reminderRepo = &MockReminderRepository{}
+
+		result, err := svc.GetNextReminder(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("returns error when application not found", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return nil, model.ErrApplicationNotFound
+		}
+		svc.reminderRepo = &MockReminderRepository{
+			GetNextForApplicationFunc: func(ctx context.Context, aid string) (*reminderModel.Reminder, error) {
+				t.Fatal("reminders should not be looked up")
+				return nil, nil
+			},
+		}
+
+		result, err := svc.GetNextReminder(context.Background(), userID, appID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+}
+
+func TestApplicationService_CompletesRemindersOnTerminalStatus(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	setup := func(completed *[]string, completeErr error) *ApplicationService {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Status: "active"}, nil
+		}
+		svc.reminderRepo = &MockReminderRepository{
+			MarkAllDoneByApplicationFunc: func(ctx context.Context, uid, aid string) error {
+				assert.Equal(t, userID, uid)
+				*completed = append(*completed, aid)
+				return completeErr
+			},
+		}
+		return svc
+	}
+
+	for _, status := range []string{"rejected", "archived"} {
+		t.Run("completes reminders when "+status, func(t *testing.T) {
+			var completed []string
+			svc := setup(&completed, nil)
+
+			_, err := svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{Status: &status})
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{appID}, completed)
+		})
+	}
+
+	t.Run("keeps reminders for other statuses", func(t *testing.T) {
+		var completed []string
+		svc := setup(&completed, nil)
+		status := "offer"
+
+		_, err := svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{Status: &status})
+
+		require.NoError(t, err)
+		assert.Empty(t, completed)
+	})
+
+	t.Run("completes reminders when archived via Archive", func(t *testing.T) {
+		var completed []string
+		svc := setup(&completed, nil)
+
+		_, err := svc.Archive(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{appID}, completed)
+	})
+
+	t.Run("still updates when completing reminders fails", func(t *testing.T) {
+		var completed []string
+		svc := setup(&completed, errors.New("database error"))
+		status := "rejected"
+
+		result, err := svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{Status: &status})
+
+		require.NoError(t, err)
+		assert.Equal(t, "rejected", result.Status)
+		assert.Len(t, completed, 1)
+	})
+}
+
+// recordingStatusNotifier records the status changes it is told about
+type recordingStatusNotifier struct {
+	changes [][2]string
+}
+
+func (n *recordingStatusNotifier) NotifyStatusChanged(ctx context.Context, userID, appID, oldStatus, newStatus string) {
+	n.changes = append(n.changes, [2]string{oldStatus, newStatus})
+}
+
+func TestApplicationService_NotifiesStatusChanges(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	setup := func() (*ApplicationService, *recordingStatusNotifier) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Status: "active"}, nil
+		}
+		notifier := &recordingStatusNotifier{}
+		svc.statusNotifier = notifier
+		return svc, notifier
+	}
+
+	t.Run("notifies when Update changes the status", func(t *testing.T) {
+		svc, notifier := setup()
+		status := "offer"
+
+		_, err := svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{Status: &status})
+
+		require.NoError(t, err)
+		assert.Equal(t, [][2]string{{"active", "offer"}}, notifier.changes)
+	})
+
+	t.Run("does not notify when the status is unchanged", func(t *testing.T) {
+		svc, notifier := setup()
+		status := "active"
+
+		_, err := svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{Status: &status})
+
+		require.NoError(t, err)
+		assert.Empty(t, notifier.changes)
+	})
+
+	t.Run("does not notify when the status is not updated", func(t *testing.T) {
+		svc, notifier := setup()
+
+		_, err := svc.Update(context.Background(), userID, appID, &model.UpdateApplicationRequest{Metadata: map[string]any{"k": "v"}})
+
+		require.NoError(t, err)
+		assert.Empty(t, notifier.changes)
+	})
+
+	t.Run("notifies when archived", func(t *testing.T) {
+		svc, notifier := setup()
+
+		_, err := svc.Archive(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Equal(t, [][2]string{{"active", "archived"}}, notifier.changes)
+	})
+}
+
+func TestApplicationService_StatusCounts(t *testing.T) {
+	t.Run("maps the counts per status", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetTotalStatusCountsFunc = func(ctx context.Context) (*model.StatusCounts, error) {
+			return &model.StatusCounts{Total: 9, Active: 4, OnHold: 1, Offer: 2, Rejected: 1, Archived: 1}, nil
+		}
+
+		counts := svc.StatusCounts(context.Background())
+
+		assert.Equal(t, map[string]int{"active": 4, "on_hold": 1, "offer": 2, "rejected": 1, "archived": 1}, counts)
+	})
+
+	t.Run("returns nil when counting fails", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetTotalStatusCountsFunc = func(ctx context.Context) (*model.StatusCounts, error) {
+			return nil, errors.New("database error")
+		}
+
+		assert.Nil(t, svc.StatusCounts(context.Background()))
+	})
+
+	t.Run("writes do not recount", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Status: "active"}, nil
+		}
+		appRepo.DeleteFunc = func(ctx context.Context, uid, aid string) error { return nil }
+		appRepo.GetTotalStatusCountsFunc = func(ctx context.Context) (*model.StatusCounts, error) {
+			t.Fatal("writes must not run the full-table count")
+			return nil, nil
+		}
+		status := "offer"
+
+		_, err := svc.Create(context.Background(), "user-123", &model.CreateApplicationRequest{JobID: "job-1", Name: "Backend role"})
+		require.NoError(t, err)
+		_, err = svc.Update(context.Background(), "user-123", "app-1", &model.UpdateApplicationRequest{Status: &status})
+		require.NoError(t, err)
+		_, err = svc.Archive(context.Background(), "user-123", "app-1")
+		require.NoError(t, err)
+		require.NoError(t, svc.Delete(context.Background(), "user-123", "app-1"))
+	})
+}
+
+func TestApplicationService_GetByID_NextReminder(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	newService := func(reminderRepo *MockReminderRepository) *ApplicationService {
+		svc, appRepo, _, _, jobRepo, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1", Status: "active"}, nil
+		}
+		jobRepo.GetByIDFunc = func(ctx context.Context, uid, jid string) (*jobModel.Job, error) {
+			return &jobModel.Job{ID: jid, Title: "Engineer"}, nil
+		}
+		svc.reminderRepo = reminderRepo
+		return svc
+	}
+
+	t.Run("embeds the next reminder", func(t *testing.T) {
+		svc := newService(&MockReminderRepository{
+			GetNextForApplicationFunc: func(ctx context.Context, aid string) (*reminderModel.Reminder, error) {
+				return &reminderModel.Reminder{ID: "rem-1", ApplicationID: aid, Message: "Follow up"}, nil
+			},
+		})
+
+		dto, err := svc.GetByID(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		require.NotNil(t, dto.NextReminder)
+		assert.Equal(t, "rem-1", dto.NextReminder.ID)
+	})
+
+	t.Run("leaves next reminder empty when none is upcoming", func(t *testing.T) {
+		svc := newService(&MockReminderRepository{})
+
+		dto, err := svc.GetByID(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Nil(t, dto.NextReminder)
+	})
+
+	t.Run("ignores reminder lookup failures", func(t *testing.T) {
+		svc := newService(&MockReminderRepository{
+			GetNextForApplicationFunc: func(ctx context.Context, aid string) (*reminderModel.Reminder, error) {
+				return nil, errors.New("db down")
+			},
+		})
+
+		dto, err := svc.GetByID(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Nil(t, dto.NextReminder)
+	})
+}
+
+func TestApplicationService_FindSimilarJobs(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	setup := func() (*ApplicationService, *MockJobRepository) {
+		svc, appRepo, _, _, jobRepo, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, JobID: "job-1"}, nil
+		}
+		jobRepo.GetByIDFunc = func(ctx context.Context, uid, jid string) (*jobModel.Job, error) {
+			return &jobModel.Job{ID: jid, Title: "Backend Engineer"}, nil
+		}
+		return svc, jobRepo
+	}
+
+	t.Run("excludes the application's own job", func(t *testing.T) {
+		svc, jobRepo := setup()
+		jobRepo.FindSimilarByTitleFunc = func(ctx context.Context, uid, title, excludeID string, limit int) ([]*jobModel.SimilarJobDTO, error) {
+			assert.Equal(t, userID, uid)
+			assert.Equal(t, "Backend Engineer", title)
+			assert.Equal(t, "job-1", excludeID)
+			return []*jobModel.SimilarJobDTO{
+				{JobDTO: &jobModel.JobDTO{ID: "job-2", Title: "Senior Backend Engineer"}, SimilarityScore: 0.7},
+			}, nil
+		}
+
+		jobs, err := svc.FindSimilarJobs(context.Background(), userID, appID, 5)
+
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, "job-2", jobs[0].ID)
+		assert.InDelta(t, 0.7, jobs[0].SimilarityScore, 0.0001)
+	})
+
+	t.Run("enforces the limit", func(t *testing.T) {
+		tests := []struct {
+			requested int
+			want      int
+		}{
+			{requested: 3, want: 3},
+			{requested: 5, want: 5},
+			{requested: 50, want: MaxSimilarJobs},
+			{requested: 0, want: MaxSimilarJobs},
+			{requested: -1, want: MaxSimilarJobs},
+		}
+		for _, tt := range tests {
+			svc, jobRepo := setup()
+			var gotLimit int
+			jobRepo.FindSimilarByTitleFunc = func(ctx context.Context, uid, title, excludeID string, limit int) ([]*jobModel.SimilarJobDTO, error) {
+				gotLimit = limit
+				return []*jobModel.SimilarJobDTO{}, nil
+			}
+
+			_, err := svc.FindSimilarJobs(context.Background(), userID, appID, tt.requested)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, gotLimit, "requested %d", tt.requested)
+		}
+	})
+
+	t.Run("checks ownership before fetching the job", func(t *testing.T) {
+		svc, appRepo, _, _, jobRepo, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(ctx context.Context, uid, aid string) (*model.Application, error) {
+			return nil, model.ErrApplicationNotFound
+		}
+		jobRepo.GetByIDFunc = func(ctx context.Context, uid, jid string) (*jobModel.Job, error) {
+			t.Fatal("job must not be fetched for a foreign application")
+			return nil, nil
+		}
+
+		jobs, err := svc.FindSimilarJobs(context.Background(), userID, "other-app", 5)
+
+		assert.Nil(t, jobs)
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+
+	t.Run("returns job lookup error", func(t *testing.T) {
+		svc, jobRepo := setup()
+		jobRepo.GetByIDFunc = func(ctx context.Context, uid, jid string) (*jobModel.Job, error) {
+			return nil, jobModel.ErrJobNotFound
+		}
+
+		jobs, err := svc.FindSimilarJobs(context.Background(), userID, appID, 5)
+
+		assert.Nil(t, jobs)
+		assert.ErrorIs(t, err, jobModel.ErrJobNotFound)
+	})
+}
+
+func TestApplicationService_ShareApplication(t *testing.T) {
+	token := "11111111-1111-1111-1111-111111111111"
+
+	t.Run("builds the link from the frontend URL", func(t *testing.T) {
+		svc := NewApplicationService(ApplicationServiceConfig{
+			AppRepo: &MockApplicationRepository{
+				EnableSharingFunc: func(ctx context.Context, uid, aid, tok string) (string, error) {
+					return token, nil
+				},
+			},
+			FrontendURL: "https://app.example.com/",
+		})
+
+		result, err := svc.ShareApplication(context.Background(), "user-123", "app-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, token, result.ShareToken)
+		assert.Equal(t, "https://app.example.com/share/"+token, result.ShareURL)
+	})
+
+	t.Run("returns a relative link without a frontend URL", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.EnableSharingFunc = func(ctx context.Context, uid, aid, tok string) (string, error) {
+			return token, nil
+		}
+
+		result, err := svc.ShareApplication(context.Background(), "user-123", "app-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "/share/"+token, result.ShareURL)
+	})
+}
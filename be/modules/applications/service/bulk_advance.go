@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"go.uber.org/zap"
+)
+
+// BulkAdvanceStage moves each application to the given stage template via AddStage.
+// Every application is advanced in its own transaction, so a failure on one is
+// reported in Failed by error code without rolling back the others.
+func (s *ApplicationService) BulkAdvanceStage(ctx context.Context, userID string, req *model.BulkAdvanceStageRequest) (*model.BulkAdvanceStageResponse, error) {
+	appIDs := uniqueStrings(req.ApplicationIDs)
+	if len(appIDs) > model.MaxBulkAdvanceApplications {
+		return nil, model.ErrTooManyApplications
+	}
+
+	// Fail fast on a bad template instead of reporting it once per application
+	if _, err := s.templateRepo.GetByID(ctx, userID, req.NextStageTemplateID); err != nil {
+		return nil, err
+	}
+
+	result := &model.BulkAdvanceStageResponse{
+		Advanced: []string{},
+		Failed:   map[string]string{},
+	}
+	stageReq := &model.AddStageRequest{StageTemplateID: req.NextStageTemplateID}
+	for _, appID := range appIDs {
+		if _, err := s.AddStage(ctx, userID, appID, stageReq); err != nil {
+			s.log.Warn("bulk advance failed for application",
+				zap.String("application_id", appID), zap.Error(err))
+			result.Failed[appID] = string(model.GetErrorCode(err))
+			continue
+		}
+		result.Advanced = append(result.Advanced, appID)
+	}
+
+	s.log.Info("bulk stage advance applied",
+		zap.String("user_id", userID),
+		zap.String("stage_template_id", req.NextStageTemplateID),
+		zap.Int("advanced", len(result.Advanced)),
+		zap.Int("failed", len(result.Failed)))
+
+	return result, nil
+}
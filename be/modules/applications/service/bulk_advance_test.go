@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_BulkAdvanceStage(t *testing.T) {
+	userID := "user-123"
+	templateID := "template-2"
+
+	setup := func(t *testing.T) (*ApplicationService, pgxmock.PgxPoolIface, *MockApplicationRepository, *MockTemplateRepository) {
+		svc, appRepo, stageRepo, templateRepo, _, _, _, _ := createTestService()
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		t.Cleanup(mock.Close)
+		svc.pool = mock
+
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid}, nil
+		}
+		templateRepo.GetByIDFunc = func(_ context.Context, _, tid string) (*model.StageTemplate, error) {
+			return &model.StageTemplate{ID: tid, Name: "Onsite"}, nil
+		}
+		stageRepo.ListByApplicationFunc = func(_ context.Context, _ string) ([]*model.ApplicationStage, error) {
+			return nil, nil
+		}
+		return svc, mock, appRepo, templateRepo
+	}
+
+	expectAdvance := func(mock pgxmock.PgxPoolIface, appID string) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO application_stages").
+			WithArgs(pgxmock.AnyArg(), appID, templateID, "active", 0, pgxmock.AnyArg(), nil, pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec("UPDATE applications SET current_stage_id").
+			WithArgs(appID, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectCommit()
+	}
+
+	t.Run("a failing application does not roll back the others", func(t *testing.T) {
+		svc, mock, appRepo, _ := setup(t)
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			if aid == "app-2" {
+				return nil, model.ErrApplicationNotFound
+			}
+			return &model.Application{ID: aid, UserID: uid}, nil
+		}
+		expectAdvance(mock, "app-1")
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO application_stages").
+			WithArgs(pgxmock.AnyArg(), "app-3", templateID, "active", 0, pgxmock.AnyArg(), nil, pgxmock.AnyArg()).
+			WillReturnError(errors.New("deadlock detected"))
+		mock.ExpectRollback()
+		expectAdvance(mock, "app-4")
+
+		result, err := svc.BulkAdvanceStage(context.Background(), userID, &model.BulkAdvanceStageRequest{
+			ApplicationIDs:      []string{"app-1", "app-2", "app-3", "app-4"},
+			NextStageTemplateID: templateID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app-1", "app-4"}, result.Advanced)
+		assert.Equal(t, map[string]string{
+			"app-2": string(model.CodeApplicationNotFound),
+			"app-3": string(model.CodeInternalError),
+		}, result.Failed)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("advances duplicate IDs once", func(t *testing.T) {
+		svc, mock, _, _ := setup(t)
+		expectAdvance(mock, "app-1")
+
+		result, err := svc.BulkAdvanceStage(context.Background(), userID, &model.BulkAdvanceStageRequest{
+			ApplicationIDs:      []string{"app-1", "app-1"},
+			NextStageTemplateID: templateID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app-1"}, result.Advanced)
+		assert.Empty(t, result.Failed)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("enforces the application limit", func(t *testing.T) {
+		svc, mock, appRepo, _ := setup(t)
+		appRepo.GetByIDFunc = func(_ context.Context, _, _ string) (*model.Application, error) {
+			t.Fatal("no application should be advanced")
+			return nil, nil
+		}
+		ids := make([]string, model.MaxBulkAdvanceApplications+1)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("app-%d", i)
+		}
+
+		result, err := svc.BulkAdvanceStage(context.Background(), userID, &model.BulkAdvanceStageRequest{
+			ApplicationIDs:      ids,
+			NextStageTemplateID: templateID,
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrTooManyApplications)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found for another user's stage template", func(t *testing.T) {
+		svc, mock, _, templateRepo := setup(t)
+		templateRepo.GetByIDFunc = func(_ context.Context, _, _ string) (*model.StageTemplate, error) {
+			return nil, model.ErrStageTemplateNotFound
+		}
+
+		result, err := svc.BulkAdvanceStage(context.Background(), userID, &model.BulkAdvanceStageRequest{
+			ApplicationIDs:      []string{"app-1"},
+			NextStageTemplateID: templateID,
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrStageTemplateNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/andreypavlenko/jobber/modules/applications/ports"
+	"go.uber.org/zap"
+)
+
+// BulkUpdateStatus sets the same status on many applications with a single update.
+// The whole batch is rejected with an UnownedApplicationsError listing the offending
+// IDs when any application is missing or belongs to another user.
+func (s *ApplicationService) BulkUpdateStatus(ctx context.Context, userID string, req *model.BulkStatusRequest) (*model.BulkStatusResponse, error) {
+	appIDs := uniqueStrings(req.ApplicationIDs)
+	if len(appIDs) > model.MaxBulkStatusApplications {
+		return nil, model.ErrTooManyApplications
+	}
+	if !isValidStatus(req.Status) {
+		return nil, model.ErrInvalidStatus
+	}
+
+	previousStatuses, err := s.appRepo.GetStatuses(ctx, userID, appIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify applications: %w", err)
+	}
+	var unowned []string
+	for _, appID := range appIDs {
+		if _, ok := previousStatuses[appID]; !ok {
+			unowned = append(unowned, appID)
+		}
+	}
+	if len(unowned) > 0 {
+		return nil, &model.UnownedApplicationsError{ApplicationIDs: unowned}
+	}
+
+	var updated int64
+	err = s.inTransaction(ctx, func(repos *ports.TxRepositories) error {
+		updated, err = repos.Applications.BulkUpdateStatus(ctx, userID, appIDs, req.Status)
+		if err != nil {
+			return fmt.Errorf("failed to update statuses: %w", err)
+		}
+		for _, appID := range appIDs {
+			if previousStatuses[appID] == req.Status {
+				continue
+			}
+			if err := recordEvent(ctx, repos.Events, userID, appID, model.EventApplicationStatusChanged, statusChangedPayload(previousStatuses[appID], req.Status)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateAnalytics(ctx, userID)
+	for _, appID := range appIDs {
+		if isTerminalStatus(req.Status) {
+			s.completeReminders(ctx, userID, appID)
+		}
+		s.notifyStatusChanged(ctx, userID, appID, previousStatuses[appID], req.Status)
+	}
+
+	s.log.Info("bulk status update applied",
+		zap.String("user_id", userID),
+		zap.String("status", req.Status),
+		zap.Int64("updated", updated))
+
+	return &model.BulkStatusResponse{Updated: updated}, nil
+}
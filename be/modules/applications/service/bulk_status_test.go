@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_BulkUpdateStatus(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("updates owned applications with one statement", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetStatusesFunc = func(_ context.Context, uid string, appIDs []string) (map[string]string, error) {
+			assert.Equal(t, userID, uid)
+			assert.Equal(t, []string{"app-1", "app-2"}, appIDs)
+			return map[string]string{"app-1": "active", "app-2": "archived"}, nil
+		}
+		calls := 0
+		appRepo.BulkUpdateStatusFunc = func(_ context.Context, uid string, appIDs []string, status string) (int64, error) {
+			calls++
+			assert.Equal(t, []string{"app-1", "app-2"}, appIDs)
+			assert.Equal(t, "archived", status)
+			return 2, nil
+		}
+		notifier := &recordingStatusNotifier{}
+		svc.statusNotifier = notifier
+
+		result, err := svc.BulkUpdateStatus(context.Background(), userID, &model.BulkStatusRequest{
+			ApplicationIDs: []string{"app-1", "app-2", "app-1"},
+			Status:         "archived",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.Updated)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, [][2]string{{"active", "archived"}}, notifier.changes)
+	})
+
+	t.Run("records a status change event per changed application", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetStatusesFunc = func(_ context.Context, _ string, _ []string) (map[string]string, error) {
+			return map[string]string{"app-1": "active", "app-2": "offer"}, nil
+		}
+		mock, events := withEventLog(t, svc)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		_, err := svc.BulkUpdateStatus(context.Background(), userID, &model.BulkStatusRequest{
+			ApplicationIDs: []string{"app-1", "app-2"},
+			Status:         "offer",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, events.Events, 1)
+		assert.Equal(t, "app-1", events.Events[0].ApplicationID)
+		assert.Equal(t, model.Change("active", "offer"), events.Events[0].Payload["status"])
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects the batch and lists unowned applications", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetStatusesFunc = func(_ context.Context, _ string, _ []string) (map[string]string, error) {
+			return map[string]string{"app-1": "active"}, nil
+		}
+		appRepo.BulkUpdateStatusFunc = func(_ context.Context, _ string, _ []string, _ string) (int64, error) {
+			t.Fatal("no application is updated when one is not owned")
+			return 0, nil
+		}
+
+		_, err := svc.BulkUpdateStatus(context.Background(), userID, &model.BulkStatusRequest{
+			ApplicationIDs: []string{"app-1", "app-2", "app-3"},
+			Status:         "rejected",
+		})
+
+		var unowned *model.UnownedApplicationsError
+		require.ErrorAs(t, err, &unowned)
+		assert.Equal(t, []string{"app-2", "app-3"}, unowned.ApplicationIDs)
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+
+	t.Run("rejects an unknown status", func(t *testing.T) {
+		svc, _, _, _, _, _, _, _ := createTestService()
+
+		_, err := svc.BulkUpdateStatus(context.Background(), userID, &model.BulkStatusRequest{
+			ApplicationIDs: []string{"app-1"},
+			Status:         "hired",
+		})
+
+		assert.ErrorIs(t, err, model.ErrInvalidStatus)
+	})
+
+	t.Run("rejects more than the maximum applications", func(t *testing.T) {
+		svc, _, _, _, _, _, _, _ := createTestService()
+		ids := make([]string, model.MaxBulkStatusApplications+1)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("app-%d", i)
+		}
+
+		_, err := svc.BulkUpdateStatus(context.Background(), userID, &model.BulkStatusRequest{ApplicationIDs: ids, Status: "archived"})
+
+		assert.ErrorIs(t, err, model.ErrTooManyApplications)
+	})
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"go.uber.org/zap"
+)
+
+// checklistTTL expires the done-state of checklists that are no longer touched;
+// every toggle refreshes it
+const checklistTTL = 180 * 24 * time.Hour
+
+// checklistEntry is a checklist item template; its ID is stable so the done
+// state stored per application survives reordering
+type checklistEntry struct {
+	ID       string
+	Item     string
+	Category string
+}
+
+// generalChecklist applies when the application has no current stage or the
+// stage name is not one of the recognised ones
+var generalChecklist = []checklistEntry{
+	{ID: "research-company", Item: "Research the company and its products", Category: model.ChecklistCategoryResearch},
+	{ID: "review-job-description", Item: "Re-read the job description", Category: model.ChecklistCategoryPreparation},
+	{ID: "prepare-questions", Item: "Prepare questions for the interviewer", Category: model.ChecklistCategoryPreparation},
+}
+
+// checklistByStage maps a lower-cased stage name to its checklist
+var checklistByStage = map[string][]checklistEntry{
+	"applied": {
+		{ID: "research-company", Item: "Research the company and its products", Category: model.ChecklistCategoryResearch},
+		{ID: "connect-with-team", Item: "Connect with people on the team", Category: model.ChecklistCategoryFollowUp},
+		{ID: "follow-up-recruiter", Item: "Follow up with the recruiter after a week", Category: model.ChecklistCategoryFollowUp},
+	},
+	"phone screen": {
+		{ID: "prepare-introduction", Item: "Prepare a two-minute introduction", Category: model.ChecklistCategoryPreparation},
+		{ID: "research-company", Item: "Research the company and its products", Category: model.ChecklistCategoryResearch},
+		{ID: "know-salary-expectations", Item: "Decide on your salary expectations", Category: model.ChecklistCategoryNegotiation},
+		{ID: "test-call-setup", Item: "Test your phone or video call setup", Category: model.ChecklistCategoryLogistics},
+	},
+	"technical interview": {
+		{ID: "review-data-structures", Item: "Review data structures", Category: model.ChecklistCategoryTechnical},
+		{ID: "practice-system-design", Item: "Practice system design", Category: model.ChecklistCategoryTechnical},
+		{ID: "solve-practice-problems", Item: "Solve a few timed practice problems", Category: model.ChecklistCategoryTechnical},
+		{ID: "review-past-projects", Item: "Review your past projects and their trade-offs", Category: model.ChecklistCategoryPreparation},
+		{ID: "test-screen-sharing", Item: "Test your video and screen sharing setup", Category: model.ChecklistCategoryLogistics},
+	},
+	"take-home assignment": {
+		{ID: "clarify-requirements", Item: "Clarify the requirements and deadline", Category: model.ChecklistCategoryLogistics},
+		{ID: "write-tests-and-readme", Item: "Write tests and a README", Category: model.ChecklistCategoryTechnical},
+		{ID: "review-before-submitting", Item: "Review your code before submitting", Category: model.ChecklistCategoryTechnical},
+	},
+	"final interview": {
+		{ID: "prepare-star-stories", Item: "Prepare behavioral stories (STAR)", Category: model.ChecklistCategoryPreparation},
+		{ID: "research-interviewers", Item: "Research your interviewers", Category: model.ChecklistCategoryResearch},
+		{ID: "prepare-culture-questions", Item: "Prepare questions about the team and culture", Category: model.ChecklistCategoryPreparation},
+		{ID: "send-thank-you", Item: "Send a thank-you note afterwards", Category: model.ChecklistCategoryFollowUp},
+	},
+	"offer": {
+		{ID: "negotiate-salary", Item: "Negotiate salary", Category: model.ChecklistCategoryNegotiation},
+		{ID: "review-equity", Item: "Review equity terms", Category: model.ChecklistCategoryNegotiation},
+		{ID: "compare-benefits", Item: "Compare benefits and time off", Category: model.ChecklistCategoryNegotiation},
+		{ID: "confirm-start-date", Item: "Confirm the start date", Category: model.ChecklistCategoryLogistics},
+	},
+}
+
+// checklistForStage returns the checklist for a stage name, matched
+// case-insensitively, falling back to the general checklist
+func checklistForStage(stageName string) []checklistEntry {
+	if entries, ok := checklistByStage[strings.ToLower(strings.TrimSpace(stageName))]; ok {
+		return entries
+	}
+	return generalChecklist
+}
+
+func checklistKey(appID string) string {
+	return "application_checklist:" + appID
+}
+
+// GenerateChecklist returns the pre-interview checklist for the application's
+// current stage, with the items the user already marked done
+func (s *ApplicationService) GenerateChecklist(ctx context.Context, userID, appID string) ([]*model.ChecklistItem, error) {
+	entries, err := s.checklistEntries(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	done := map[string]bool{}
+	if s.redisClient != nil {
+		members, err := s.redisClient.SMembers(ctx, checklistKey(appID)).Result()
+		if err != nil {
+			// Progress is a convenience; show the checklist without it
+			s.log.Warn("failed to read checklist progress", zap.String("application_id", appID), zap.Error(err))
+		}
+		for _, id := range members {
+			done[id] = true
+		}
+	}
+
+	items := make([]*model.ChecklistItem, len(entries))
+	for i, entry := range entries {
+		items[i] = &model.ChecklistItem{
+			ID:       entry.ID,
+			Item:     entry.Item,
+			IsDone:   done[entry.ID],
+			Category: entry.Category,
+		}
+	}
+	return items, nil
+}
+
+// ToggleChecklistItem flips the done state of an item on the application's
+// current checklist and returns the updated item
+func (s *ApplicationService) ToggleChecklistItem(ctx context.Context, userID, appID, itemID string) (*model.ChecklistItem, error) {
+	if s.redisClient == nil {
+		return nil, model.ErrChecklistUnavailable
+	}
+
+	entries, err := s.checklistEntries(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry *checklistEntry
+	for i := range entries {
+		if entries[i].ID == itemID {
+			entry = &entries[i]
+			break
+		}
+	}
+	if entry == nil {
+		return nil, model.ErrChecklistItemNotFound
+	}
+
+	key := checklistKey(appID)
+	removed, err := s.redisClient.SRem(ctx, key, itemID).Result()
+	if err != nil {
+		return nil, err
+	}
+	isDone := removed == 0
+	if isDone {
+		if err := s.redisClient.SAdd(ctx, key, itemID).Err(); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.redisClient.Expire(ctx, key, checklistTTL).Err(); err != nil {
+		s.log.Warn("failed to refresh checklist expiry", zap.String("application_id", appID), zap.Error(err))
+	}
+
+	return &model.ChecklistItem{
+		ID:       entry.ID,
+		Item:     entry.Item,
+		IsDone:   isDone,
+		Category: entry.Category,
+	}, nil
+}
+
+// checklistEntries verifies ownership and picks the checklist for the
+// application's current stage
+func (s *ApplicationService) checklistEntries(ctx context.Context, userID, appID string) ([]checklistEntry, error) {
+	app, err := s.appRepo.GetByID(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	if app.CurrentStageID == nil || *app.CurrentStageID == "" {
+		return generalChecklist, nil
+	}
+
+	stage, err := s.stageRepo.GetByID(ctx, *app.CurrentStageID)
+	if err != nil {
+		return nil, err
+	}
+	template, err := s.templateRepo.GetByID(ctx, userID, stage.StageTemplateID)
+	if err != nil {
+		return nil, err
+	}
+	return checklistForStage(template.Name), nil
+}
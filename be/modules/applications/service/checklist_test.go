@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func checklistIDs(entries []checklistEntry) []string {
+	ids := make([]string, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID
+	}
+	return ids
+}
+
+func TestChecklistForStage(t *testing.T) {
+	t.Run("technical interview suggests technical preparation", func(t *testing.T) {
+		entries := checklistForStage("Technical Interview")
+
+		ids := checklistIDs(entries)
+		assert.Contains(t, ids, "review-data-structures")
+		assert.Contains(t, ids, "practice-system-design")
+		assert.Equal(t, model.ChecklistCategoryTechnical, entries[0].Category)
+	})
+
+	t.Run("offer suggests negotiation", func(t *testing.T) {
+		ids := checklistIDs(checklistForStage("Offer"))
+
+		assert.Contains(t, ids, "negotiate-salary")
+		assert.Contains(t, ids, "review-equity")
+	})
+
+	t.Run("matches stage names case-insensitively", func(t *testing.T) {
+		assert.Equal(t, checklistForStage("Phone Screen"), checklistForStage("  phone SCREEN "))
+	})
+
+	t.Run("falls back to the general checklist", func(t *testing.T) {
+		assert.Equal(t, generalChecklist, checklistForStage("Coffee Chat"))
+		assert.Equal(t, generalChecklist, checklistForStage(""))
+	})
+
+	t.Run("every default stage has a checklist", func(t *testing.T) {
+		for _, def := range model.DefaultStageTemplateSet {
+			assert.NotEqual(t, generalChecklist, checklistForStage(def.Name), def.Name)
+		}
+	})
+
+	t.Run("item IDs are unique within a checklist", func(t *testing.T) {
+		for stage, entries := range checklistByStage {
+			seen := map[string]bool{}
+			for _, e := range entries {
+				assert.False(t, seen[e.ID], "duplicate %s in %s", e.ID, stage)
+				seen[e.ID] = true
+				assert.NotEmpty(t, e.Item)
+				assert.NotEmpty(t, e.Category)
+			}
+		}
+	})
+}
+
+func TestApplicationService_Checklist(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	setup := func(t *testing.T, stageName string) (*ApplicationService, *miniredis.Miniredis, *MockApplicationRepository) {
+		svc, appRepo, stageRepo, templateRepo, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			app := &model.Application{ID: aid, UserID: uid}
+			if stageName != "" {
+				stageID := "stage-1"
+				app.CurrentStageID = &stageID
+			}
+			return app, nil
+		}
+		stageRepo.GetByIDFunc = func(_ context.Context, sid string) (*model.ApplicationStage, error) {
+			return &model.ApplicationStage{ID: sid, StageTemplateID: "template-1"}, nil
+		}
+		templateRepo.GetByIDFunc = func(_ context.Context, _, tid string) (*model.StageTemplate, error) {
+			return &model.StageTemplate{ID: tid, Name: stageName}, nil
+		}
+		mr := miniredis.RunT(t)
+		svc.redisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		return svc, mr, appRepo
+	}
+
+	t.Run("uses the current stage name", func(t *testing.T) {
+		svc, _, _ := setup(t, "Technical Interview")
+
+		items, err := svc.GenerateChecklist(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "review-data-structures", items[0].ID)
+		assert.Equal(t, "Review data structures", items[0].Item)
+	})
+
+	t.Run("uses the general checklist without a current stage", func(t *testing.T) {
+		svc, _, _ := setup(t, "")
+
+		items, err := svc.GenerateChecklist(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.Len(t, items, len(generalChecklist))
+	})
+
+	t.Run("toggle flips done state and refreshes expiry", func(t *testing.T) {
+		svc, mr, _ := setup(t, "Offer")
+
+		item, err := svc.ToggleChecklistItem(context.Background(), userID, appID, "review-equity")
+		require.NoError(t, err)
+		assert.True(t, item.IsDone)
+		assert.Equal(t, checklistTTL, mr.TTL(checklistKey(appID)))
+
+		items, err := svc.GenerateChecklist(context.Background(), userID, appID)
+		require.NoError(t, err)
+		for _, it := range items {
+			assert.Equal(t, it.ID == "review-equity", it.IsDone, it.ID)
+		}
+
+		item, err = svc.ToggleChecklistItem(context.Background(), userID, appID, "review-equity")
+		require.NoError(t, err)
+		assert.False(t, item.IsDone)
+	})
+
+	t.Run("toggle rejects items outside the current checklist", func(t *testing.T) {
+		svc, _, _ := setup(t, "Offer")
+
+		item, err := svc.ToggleChecklistItem(context.Background(), userID, appID, "practice-system-design")
+
+		assert.Nil(t, item)
+		assert.ErrorIs(t, err, model.ErrChecklistItemNotFound)
+	})
+
+	t.Run("shows checklist without progress when redis fails", func(t *testing.T) {
+		svc, mr, _ := setup(t, "Offer")
+		mr.SetError("connection refused")
+
+		items, err := svc.GenerateChecklist(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, items)
+	})
+
+	t.Run("toggle is unavailable without redis", func(t *testing.T) {
+		svc, _, _, _, _, _, _, _ := createTestService()
+
+		item, err := svc.ToggleChecklistItem(context.Background(), userID, appID, "review-equity")
+
+		assert.Nil(t, item)
+		assert.ErrorIs(t, err, model.ErrChecklistUnavailable)
+	})
+
+	t.Run("returns not found for another user's application", func(t *testing.T) {
+		svc, _, appRepo := setup(t, "Offer")
+		appRepo.GetByIDFunc = func(_ context.Context, _, _ string) (*model.Application, error) {
+			return nil, model.ErrApplicationNotFound
+		}
+
+		_, err := svc.GenerateChecklist(context.Background(), userID, appID)
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+)
+
+// Thresholds of inactivity after which a follow-up is suggested
+const (
+	followUpAfter       = 7 * 24 * time.Hour
+	urgentFollowUpAfter = 14 * 24 * time.Hour
+)
+
+// phoneScreenStage is the lower-cased stage name that triggers the schedule-call suggestion
+const phoneScreenStage = "phone screen"
+
+var nextActionPriorityRank = map[string]int{
+	model.NextActionPriorityHigh:   0,
+	model.NextActionPriorityMedium: 1,
+	model.NextActionPriorityLow:    2,
+}
+
+// GetNextActions recommends next steps for an application based on its status,
+// last activity, upcoming reminders and current stage, most urgent first
+func (s *ApplicationService) GetNextActions(ctx context.Context, userID, appID string) ([]*model.NextAction, error) {
+	app, err := s.GetByID(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+	return suggestNextActions(app, time.Now().UTC()), nil
+}
+
+// suggestNextActions applies the recommendation rules to an application.
+// Stages carry no scheduled date, so an upcoming reminder is taken as the
+// phone screen call being scheduled.
+func suggestNextActions(app *model.ApplicationDTO, now time.Time) []*model.NextAction {
+	actions := []*model.NextAction{}
+
+	open := app.Status == string(model.StatusActive) || app.Status == string(model.StatusOnHold)
+	if !open {
+		return actions
+	}
+
+	hasReminder := app.NextReminder != nil
+	inPhoneScreen := app.CurrentStageName != nil &&
+		strings.ToLower(strings.TrimSpace(*app.CurrentStageName)) == phoneScreenStage
+
+	if idle := now.Sub(app.LastActivityAt); app.Status == string(model.StatusActive) && idle >= followUpAfter {
+		priority := model.NextActionPriorityMedium
+		if idle >= urgentFollowUpAfter {
+			priority = model.NextActionPriorityHigh
+		}
+		actions = append(actions, &model.NextAction{
+			Type:     model.NextActionFollowUp,
+			Action:   "Follow up with recruiter",
+			Priority: priority,
+			Reason:   fmt.Sprintf("No activity for %d days", int(idle/(24*time.Hour))),
+		})
+	}
+
+	if inPhoneScreen && !hasReminder {
+		actions = append(actions, &model.NextAction{
+			Type:     model.NextActionScheduleCall,
+			Action:   "Schedule the call",
+			Priority: model.NextActionPriorityHigh,
+			Reason:   "The phone screen has no scheduled call",
+		})
+	}
+
+	if !hasReminder {
+		priority := model.NextActionPriorityMedium
+		if app.Status == string(model.StatusOnHold) {
+			priority = model.NextActionPriorityLow
+		}
+		actions = append(actions, &model.NextAction{
+			Type:     model.NextActionSetReminder,
+			Action:   "Set a reminder",
+			Priority: priority,
+			Reason:   "No upcoming reminders for this application",
+		})
+	}
+
+	sort.SliceStable(actions, func(i, j int) bool {
+		return nextActionPriorityRank[actions[i].Priority] < nextActionPriorityRank[actions[j].Priority]
+	})
+	return actions
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	reminderModel "github.com/andreypavlenko/jobber/modules/reminders/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestNextActions(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) time.Time { return now.Add(-time.Duration(days) * 24 * time.Hour) }
+	stage := func(name string) *string { return &name }
+	reminder := &reminderModel.ReminderDTO{ID: "rem-1"}
+
+	type want struct {
+		kind     string
+		priority string
+	}
+
+	tests := []struct {
+		name string
+		app  *model.ApplicationDTO
+		want []want
+	}{
+		{
+			name: "recent active application with a reminder needs nothing",
+			app:  &model.ApplicationDTO{Status: "active", LastActivityAt: daysAgo(2), NextReminder: reminder},
+			want: []want{},
+		},
+		{
+			name: "recent active application without a reminder",
+			app:  &model.ApplicationDTO{Status: "active", LastActivityAt: daysAgo(2)},
+			want: []want{{model.NextActionSetReminder, model.NextActionPriorityMedium}},
+		},
+		{
+			name: "active application idle for a week",
+			app:  &model.ApplicationDTO{Status: "active", LastActivityAt: daysAgo(7), NextReminder: reminder},
+			want: []want{{model.NextActionFollowUp, model.NextActionPriorityMedium}},
+		},
+		{
+			name: "active application idle for two weeks is urgent",
+			app:  &model.ApplicationDTO{Status: "active", LastActivityAt: daysAgo(20), NextReminder: reminder},
+			want: []want{{model.NextActionFollowUp, model.NextActionPriorityHigh}},
+		},
+		{
+			name: "idle application without a reminder",
+			app:  &model.ApplicationDTO{Status: "active", LastActivityAt: daysAgo(10)},
+			want: []want{
+				{model.NextActionFollowUp, model.NextActionPriorityMedium},
+				{model.NextActionSetReminder, model.NextActionPriorityMedium},
+			},
+		},
+		{
+			name: "phone screen without a scheduled call",
+			app:  &model.ApplicationDTO{Status: "active", LastActivityAt: daysAgo(1), CurrentStageName: stage("Phone Screen")},
+			want: []want{
+				{model.NextActionScheduleCall, model.NextActionPriorityHigh},
+				{model.NextActionSetReminder, model.NextActionPriorityMedium},
+			},
+		},
+		{
+			name: "phone screen with a scheduled call",
+			app:  &model.ApplicationDTO{Status: "active", LastActivityAt: daysAgo(1), CurrentStageName: stage("phone screen"), NextReminder: reminder},
+			want: []want{},
+		},
+		{
+			name: "idle phone screen without a reminder lists urgent actions first",
+			app:  &model.ApplicationDTO{Status: "active", LastActivityAt: daysAgo(8), CurrentStageName: stage("Phone Screen")},
+			want: []want{
+				{model.NextActionScheduleCall, model.NextActionPriorityHigh},
+				{model.NextActionFollowUp, model.NextActionPriorityMedium},
+				{model.NextActionSetReminder, model.NextActionPriorityMedium},
+			},
+		},
+		{
+			name: "other stages do not suggest scheduling a call",
+			app:  &model.ApplicationDTO{Status: "active", LastActivityAt: daysAgo(1), CurrentStageName: stage("Technical Interview"), NextReminder: reminder},
+			want: []want{},
+		},
+		{
+			name: "on hold application is not followed up",
+			app:  &model.ApplicationDTO{Status: "on_hold", LastActivityAt: daysAgo(30)},
+			want: []want{{model.NextActionSetReminder, model.NextActionPriorityLow}},
+		},
+		{
+			name: "rejected application needs nothing",
+			app:  &model.ApplicationDTO{Status: "rejected", LastActivityAt: daysAgo(30), CurrentStageName: stage("Phone Screen")},
+			want: []want{},
+		},
+		{
+			name: "archived application needs nothing",
+			app:  &model.ApplicationDTO{Status: "archived", LastActivityAt: daysAgo(30)},
+			want: []want{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actions := suggestNextActions(tt.app, now)
+
+			got := make([]want, len(actions))
+			for i, a := range actions {
+				got[i] = want{a.Type, a.Priority}
+				assert.NotEmpty(t, a.Action)
+				assert.NotEmpty(t, a.Reason)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("reason states the idle days", func(t *testing.T) {
+		actions := suggestNextActions(&model.ApplicationDTO{Status: "active", LastActivityAt: daysAgo(9), NextReminder: reminder}, now)
+
+		require.Len(t, actions, 1)
+		assert.Equal(t, "No activity for 9 days", actions[0].Reason)
+	})
+}
+
+func TestApplicationService_GetNextActions(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	t.Run("suggests a reminder when none is upcoming", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid, Status: "active"}, nil
+		}
+		appRepo.GetLastActivityAtFunc = func(_ context.Context, _ string) (time.Time, error) {
+			return time.Now().UTC(), nil
+		}
+		svc.reminderRepo = &MockReminderRepository{}
+
+		actions, err := svc.GetNextActions(context.Background(), userID, appID)
+
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+		assert.Equal(t, model.NextActionSetReminder, actions[0].Type)
+	})
+
+	t.Run("returns not found for another user's application", func(t *testing.T) {
+		svc, appRepo, _, _, _, _, _, _ := createTestService()
+		appRepo.GetByIDFunc = func(_ context.Context, _, _ string) (*model.Application, error) {
+			return nil, model.ErrApplicationNotFound
+		}
+
+		actions, err := svc.GetNextActions(context.Background(), userID, appID)
+
+		assert.Nil(t, actions)
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+}
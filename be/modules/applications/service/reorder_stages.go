@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"go.uber.org/zap"
+)
+
+// ReorderStages assigns new orders to stages of an application in one transaction.
+// Stages not listed keep their order; the resulting orders must be unique across
+// the application. The stage with the highest order becomes the current stage.
+func (s *ApplicationService) ReorderStages(ctx context.Context, userID, appID string, items []model.ReorderItem) error {
+	if _, err := s.appRepo.GetByID(ctx, userID, appID); err != nil {
+		return err
+	}
+
+	stages, err := s.stageRepo.ListByApplication(ctx, appID)
+	if err != nil {
+		return err
+	}
+
+	orders := make(map[string]int, len(stages))
+	for _, stage := range stages {
+		orders[stage.ID] = stage.Order
+	}
+	for _, item := range items {
+		if _, ok := orders[item.ID]; !ok {
+			return model.ErrApplicationStageNotFound
+		}
+		orders[item.ID] = item.Order
+	}
+
+	currentStageID, err := highestOrderStage(orders)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // rollback is a no-op after commit
+
+	for _, item := range items {
+		_, err = tx.Exec(ctx,
+			`UPDATE application_stages SET "order" = $2 WHERE id = $1 AND application_id = $3`,
+			item.ID, item.Order, appID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update stage order: %w", err)
+		}
+	}
+
+	_, err = tx.Exec(ctx,
+		`UPDATE applications SET current_stage_id = $2, updated_at = $3 WHERE id = $1`,
+		appID, currentStageID, time.Now().UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update application current stage: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	s.invalidateAnalytics(ctx, userID)
+
+	s.log.Info("application stages reordered",
+		zap.String("application_id", appID),
+		zap.Int("stages", len(items)),
+		zap.String("current_stage_id", currentStageID),
+	)
+	return nil
+}
+
+// highestOrderStage returns the ID of the stage with the highest order,
+// or ErrDuplicateOrder when two stages share an order
+func highestOrderStage(orders map[string]int) (string, error) {
+	seen := make(map[int]bool, len(orders))
+	var stageID string
+	for id, order := range orders {
+		if seen[order] {
+			return "", model.ErrDuplicateOrder
+		}
+		seen[order] = true
+		if stageID == "" || order > orders[stageID] {
+			stageID = id
+		}
+	}
+	return stageID, nil
+}
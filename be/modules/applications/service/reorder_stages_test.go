@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/applications/model"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationService_ReorderStages(t *testing.T) {
+	userID := "user-123"
+	appID := "app-1"
+
+	setup := func(t *testing.T) (*ApplicationService, pgxmock.PgxPoolIface, *MockApplicationRepository) {
+		svc, appRepo, stageRepo, _, _, _, _, _ := createTestService()
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		t.Cleanup(mock.Close)
+		svc.pool = mock
+
+		appRepo.GetByIDFunc = func(_ context.Context, uid, aid string) (*model.Application, error) {
+			return &model.Application{ID: aid, UserID: uid}, nil
+		}
+		stageRepo.ListByApplicationFunc = func(_ context.Context, aid string) ([]*model.ApplicationStage, error) {
+			return []*model.ApplicationStage{
+				{ID: "stage-1", ApplicationID: aid, Order: 0},
+				{ID: "stage-2", ApplicationID: aid, Order: 1},
+				{ID: "stage-3", ApplicationID: aid, Order: 2},
+			}, nil
+		}
+		return svc, mock, appRepo
+	}
+
+	expectOrder := func(mock pgxmock.PgxPoolIface, stageID string, order int) {
+		mock.ExpectExec(`UPDATE application_stages SET "order"`).
+			WithArgs(stageID, order, appID).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	}
+
+	t.Run("updates orders and moves the current stage to the highest order", func(t *testing.T) {
+		svc, mock, _ := setup(t)
+		mock.ExpectBegin()
+		expectOrder(mock, "stage-1", 5)
+		expectOrder(mock, "stage-3", 0)
+		mock.ExpectExec("UPDATE applications SET current_stage_id").
+			WithArgs(appID, "stage-1", pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectCommit()
+
+		err := svc.ReorderStages(context.Background(), userID, appID, []model.ReorderItem{
+			{ID: "stage-1", Order: 5},
+			{ID: "stage-3", Order: 0},
+		})
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("keeps unlisted stages when picking the current stage", func(t *testing.T) {
+		svc, mock, _ := setup(t)
+		mock.ExpectBegin()
+		expectOrder(mock, "stage-3", 1)
+		expectOrder(mock, "stage-2", 2)
+		mock.ExpectExec("UPDATE applications SET current_stage_id").
+			WithArgs(appID, "stage-2", pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectCommit()
+
+		err := svc.ReorderStages(context.Background(), userID, appID, []model.ReorderItem{
+			{ID: "stage-3", Order: 1},
+			{ID: "stage-2", Order: 2},
+		})
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects duplicate orders in the request", func(t *testing.T) {
+		svc, mock, _ := setup(t)
+
+		err := svc.ReorderStages(context.Background(), userID, appID, []model.ReorderItem{
+			{ID: "stage-1", Order: 7},
+			{ID: "stage-2", Order: 7},
+		})
+
+		assert.Equal(t, model.ErrDuplicateOrder, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects an order already held by an unlisted stage", func(t *testing.T) {
+		svc, mock, _ := setup(t)
+
+		err := svc.ReorderStages(context.Background(), userID, appID, []model.ReorderItem{
+			{ID: "stage-1", Order: 2},
+		})
+
+		assert.Equal(t, model.ErrDuplicateOrder, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects stages of another application", func(t *testing.T) {
+		svc, mock, _ := setup(t)
+
+		err := svc.ReorderStages(context.Background(), userID, appID, []model.ReorderItem{
+			{ID: "stage-1", Order: 4},
+			{ID: "foreign-stage", Order: 5},
+		})
+
+		assert.Equal(t, model.ErrApplicationStageNotFound, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found for another user's application", func(t *testing.T) {
+		svc, mock, appRepo := setup(t)
+		appRepo.GetByIDFunc = func(_ context.Context, _, _ string) (*model.Application, error) {
+			return nil, model.ErrApplicationNotFound
+		}
+
+		err := svc.ReorderStages(context.Background(), userID, appID, []model.ReorderItem{{ID: "stage-1", Order: 4}})
+
+		assert.Equal(t, model.ErrApplicationNotFound, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back when an update fails", func(t *testing.T) {
+		svc, mock, _ := setup(t)
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE application_stages SET "order"`).
+			WithArgs("stage-1", 4, appID).
+			WillReturnError(errors.New("connection reset"))
+		mock.ExpectRollback()
+
+		err := svc.ReorderStages(context.Background(), userID, appID, []model.ReorderItem{{ID: "stage-1", Order: 4}})
+
+		require.Error(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/andreypavlenko/jobber/modules/auth/service"
 	userModel "github.com/andreypavlenko/jobber/modules/users/model"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 // AuthHandler handles authentication HTTP requests
@@ -58,12 +60,12 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	resp, err := h.authService.Register(c.Request.Context(), &req)
 	if err != nil {
 		errorCode := userModel.GetErrorCode(err)
-		errorMessage := userModel.GetErrorMessage(err)
+		errorMessage := userModel.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusInternalServerError
 		if errorCode == userModel.CodeUserAlreadyExists {
 			statusCode = http.StatusConflict
-		} else if errorCode == userModel.CodeInvalidEmail || errorCode == userModel.CodeInvalidPassword {
+		} else if errorCode == userModel.CodeInvalidEmail || errorCode == userModel.CodeInvalidPassword || errorCode == userModel.CodeInvalidLocale {
 			statusCode = http.StatusBadRequest
 		}
 
@@ -94,10 +96,10 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	user, tokens, err := h.authService.Login(c.Request.Context(), &req)
+	user, tokens, err := h.authService.Login(c.Request.Context(), &req, clientInfo(c))
 	if err != nil {
 		errorCode := userModel.GetErrorCode(err)
-		errorMessage := userModel.GetErrorMessage(err)
+		errorMessage := userModel.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusUnauthorized
 		if errorCode == userModel.CodeEmailNotVerified {
@@ -138,7 +140,7 @@ func (h *AuthHandler) VerifyEmail(c *gin.Context) {
 
 	if err := h.authService.VerifyEmail(c.Request.Context(), req.Email, req.Code); err != nil {
 		errorCode := userModel.GetErrorCode(err)
-		errorMessage := userModel.GetErrorMessage(err)
+		errorMessage := userModel.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusBadRequest
 		if errorCode == userModel.CodeTooManyAttempts {
@@ -216,7 +218,7 @@ func (h *AuthHandler) ResetPassword(c *gin.Context) {
 
 	if err := h.authService.ResetPassword(c.Request.Context(), req.Email, req.Code, req.Password); err != nil {
 		errorCode := userModel.GetErrorCode(err)
-		errorMessage := userModel.GetErrorMessage(err)
+		errorMessage := userModel.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusBadRequest
 		if errorCode == userModel.CodeInternalError {
@@ -234,14 +236,14 @@ func (h *AuthHandler) ResetPassword(c *gin.Context) {
 
 // Refresh godoc
 // @Summary Refresh access token
-// @Description Get a new access token using a refresh token
+// @Description Get a new access token using a refresh token. The refresh token is rotated; presenting an already rotated token ends every session of that login with TOKEN_REUSE_DETECTED.
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param request body authModel.RefreshRequest true "Refresh token"
 // @Success 200 {object} authModel.AuthTokens
 // @Failure 400 {object} httpPlatform.ErrorResponse
-// @Failure 401 {object} httpPlatform.ErrorResponse "Invalid or expired refresh token"
+// @Failure 401 {object} httpPlatform.ErrorResponse "Invalid, expired or reused refresh token"
 // @Failure 500 {object} httpPlatform.ErrorResponse
 // @Router /auth/refresh [post]
 func (h *AuthHandler) Refresh(c *gin.Context) {
@@ -256,8 +258,12 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 		refreshToken = req.RefreshToken
 	}
 
-	tokens, err := h.authService.RefreshTokens(c.Request.Context(), refreshToken)
+	tokens, err := h.authService.RefreshTokens(c.Request.Context(), refreshToken, clientInfo(c))
 	if err != nil {
+		if errors.Is(err, userModel.ErrTokenReuseDetected) {
+			httpPlatform.RespondWithError(c, http.StatusUnauthorized, string(userModel.CodeTokenReuseDetected), userModel.GetErrorMessage(err, auth.GetLocale(c)))
+			return
+		}
 		httpPlatform.RespondWithError(c, http.StatusUnauthorized, string(userModel.CodeUnauthorized), "Invalid or expired refresh token")
 		return
 	}
@@ -269,7 +275,7 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 
 // Logout godoc
 // @Summary User logout
-// @Description Revoke all refresh tokens for the authenticated user
+// @Description Revoke all refresh tokens for the authenticated user, ending every session. Also served as DELETE /auth/sessions.
 // @Tags auth
 // @Security BearerAuth
 // @Produce json
@@ -277,6 +283,7 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 // @Failure 401 {object} httpPlatform.ErrorResponse "Unauthorized"
 // @Failure 500 {object} httpPlatform.ErrorResponse
 // @Router /auth/logout [post]
+// @Router /auth/sessions [delete]
 func (h *AuthHandler) Logout(c *gin.Context) {
 	userID, exists := auth.GetUserID(c)
 	if !exists {
@@ -294,6 +301,137 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Logged out successfully"})
 }
 
+// ListSessions godoc
+// @Summary List active sessions
+// @Description List the authenticated user's active sessions, most recently used first. A session lasts from login until logout or expiry and keeps its ID across token refreshes.
+// @Tags auth
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} []authModel.SessionDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse "Unauthorized"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /auth/sessions [get]
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	sessions, err := h.authService.ListSessions(c.Request.Context(), userID)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, string(userModel.CodeInternalError), "Failed to list sessions")
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, sessions)
+}
+
+// RevokeSession godoc
+// @Summary Revoke a session
+// @Description End one of the authenticated user's sessions; its refresh token stops working. Use DELETE /auth/sessions to end all sessions.
+// @Tags auth
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Session ID"
+// @Success 204
+// @Failure 401 {object} httpPlatform.ErrorResponse "Unauthorized"
+// @Failure 404 {object} httpPlatform.ErrorResponse "Session not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /auth/sessions/{id} [delete]
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	sessionID := c.Param("id")
+	if _, err := uuid.Parse(sessionID); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusNotFound, string(userModel.CodeSessionNotFound), userModel.GetErrorMessage(userModel.ErrSessionNotFound, auth.GetLocale(c)))
+		return
+	}
+
+	if err := h.authService.RevokeSession(c.Request.Context(), userID, sessionID); err != nil {
+		if errors.Is(err, userModel.ErrSessionNotFound) {
+			httpPlatform.RespondWithError(c, http.StatusNotFound, string(userModel.CodeSessionNotFound), userModel.GetErrorMessage(err, auth.GetLocale(c)))
+			return
+		}
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, string(userModel.CodeInternalError), "Failed to revoke session")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// clientInfo describes the device making the request, for the session it starts or refreshes
+func clientInfo(c *gin.Context) authModel.ClientInfo {
+	return authModel.NewClientInfo(c.Request.UserAgent(), c.ClientIP())
+}
+
+// GoogleLogin godoc
+// @Summary Sign in with Google
+// @Description Redirect to Google's consent screen. Google redirects back to /auth/google/callback.
+// @Tags auth
+// @Success 307 "Redirect to Google"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /auth/google/login [get]
+func (h *AuthHandler) GoogleLogin(c *gin.Context) {
+	url, err := h.authService.GoogleLoginURL(c.Request.Context())
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, string(userModel.CodeInternalError), "Failed to start Google sign-in")
+		return
+	}
+
+	c.Redirect(http.StatusTemporaryRedirect, url)
+}
+
+// GoogleCallback godoc
+// @Summary Complete Google sign-in
+// @Description Exchange the authorization code from Google and sign in, linking the Google account to the user with the same email or creating a user without a password. Returns the same response as password login.
+// @Tags auth
+// @Produce json
+// @Param code query string true "Authorization code"
+// @Param state query string true "State from /auth/google/login"
+// @Success 200 {object} LoginResponse
+// @Failure 400 {object} httpPlatform.ErrorResponse "Missing code or invalid state"
+// @Failure 403 {object} httpPlatform.ErrorResponse "Google email not verified"
+// @Failure 502 {object} httpPlatform.ErrorResponse "Google sign-in failed"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /auth/google/callback [get]
+func (h *AuthHandler) GoogleCallback(c *gin.Context) {
+	code := c.Query("code")
+	state := c.Query("state")
+	if code == "" || state == "" {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, string(userModel.CodeValidationError), "Missing code or state")
+		return
+	}
+
+	user, tokens, err := h.authService.GoogleLogin(c.Request.Context(), code, state, clientInfo(c))
+	if err != nil {
+		errorCode := userModel.GetErrorCode(err)
+		errorMessage := userModel.GetErrorMessage(err, auth.GetLocale(c))
+
+		statusCode := http.StatusInternalServerError
+		switch errorCode {
+		case userModel.CodeInvalidOAuthState:
+			statusCode = http.StatusBadRequest
+		case userModel.CodeOAuthEmailNotVerified:
+			statusCode = http.StatusForbidden
+		case userModel.CodeOAuthProvider:
+			statusCode = http.StatusBadGateway
+		}
+
+		httpPlatform.RespondWithError(c, statusCode, string(errorCode), errorMessage)
+		return
+	}
+
+	auth.SetTokenCookies(c, h.cookieCfg, tokens.AccessToken, h.accessExpiry, tokens.RefreshToken, h.refreshExpiry)
+
+	httpPlatform.RespondWithData(c, http.StatusOK, LoginResponse{
+		User:   user,
+		Tokens: tokens,
+	})
+}
+
 // AuthRouteConfig holds middleware for auth route registration.
 type AuthRouteConfig struct {
 	AuthMiddleware    gin.HandlerFunc
@@ -343,6 +481,14 @@ func (h *AuthHandler) RegisterRoutes(router *gin.RouterGroup, cfg AuthRouteConfi
 	authGroup.POST("/forgot-password", withEmailRL(h.ForgotPassword)...)
 	authGroup.POST("/reset-password", withCodeRL(h.ResetPassword)...)
 	authGroup.POST("/logout", cfg.AuthMiddleware, h.Logout)
+	authGroup.GET("/sessions", cfg.AuthMiddleware, h.ListSessions)
+	authGroup.DELETE("/sessions", cfg.AuthMiddleware, h.Logout)
+	authGroup.DELETE("/sessions/:id", cfg.AuthMiddleware, h.RevokeSession)
+
+	if h.authService.GoogleLoginEnabled() {
+		authGroup.GET("/google/login", withRL(h.GoogleLogin)...)
+		authGroup.GET("/google/callback", withRL(h.GoogleCallback)...)
+	}
 }
 
 // Request DTOs
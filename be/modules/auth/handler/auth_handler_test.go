@@ -29,6 +29,7 @@ type MockUserRepository struct {
 	DeleteFunc             func(ctx context.Context, userID string) error
 	SetEmailVerifiedFunc   func(ctx context.Context, userID string) error
 	UpdatePasswordHashFunc func(ctx context.Context, userID, hash string) error
+	GetByIDEnrichedFunc    func(ctx context.Context, userID string) (*userModel.UserDTO, error)
 }
 
 func (m *MockUserRepository) Create(ctx context.Context, user *userModel.User) error {
@@ -45,6 +46,13 @@ func (m *MockUserRepository) GetByID(ctx context.Context, userID string) (*userM
 	return nil, nil
 }
 
+func (m *MockUserRepository) GetByIDEnriched(ctx context.Context, userID string) (*userModel.UserDTO, error) {
+	if m.GetByIDEnrichedFunc != nil {
+		return m.GetByIDEnrichedFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
 func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*userModel.User, error) {
 	if m.GetByEmailFunc != nil {
 		return m.GetByEmailFunc(ctx, email)
@@ -82,12 +90,15 @@ func (m *MockUserRepository) UpdatePasswordHash(ctx context.Context, userID, has
 
 // MockRefreshTokenRepository implements authPorts.RefreshTokenRepository
 type MockRefreshTokenRepository struct {
-	CreateFunc           func(ctx context.Context, token *authModel.RefreshToken) error
-	GetByTokenHashFunc   func(ctx context.Context, tokenHash string) (*authModel.RefreshToken, error)
-	RevokeFunc           func(ctx context.Context, tokenHash string) error
-	RevokeIfValidFunc    func(ctx context.Context, tokenHash string) (bool, error)
-	RevokeAllForUserFunc func(ctx context.Context, userID string) error
-	DeleteExpiredFunc    func(ctx context.Context) error
+	CreateFunc             func(ctx context.Context, token *authModel.RefreshToken) error
+	GetByTokenHashFunc     func(ctx context.Context, tokenHash string) (*authModel.RefreshToken, error)
+	RevokeFunc             func(ctx context.Context, tokenHash string) error
+	RotateFunc             func(ctx context.Context, oldTokenHash string, next *authModel.RefreshToken) error
+	ListActiveSessionsFunc func(ctx context.Context, userID string) ([]*authModel.SessionDTO, error)
+	RevokeSessionFunc      func(ctx context.Context, userID, sessionID string) (bool, error)
+	RevokeAllForUserFunc   func(ctx context.Context, userID string) error
+	RevokeAllInFamilyFunc  func(ctx context.Context, familyID string) error
+	DeleteExpiredFunc      func(ctx context.Context) error
 }
 
 func (m *MockRefreshTokenRepository) Create(ctx context.Context, token *authModel.RefreshToken) error {
@@ -111,11 +122,11 @@ func (m *MockRefreshTokenRepository) Revoke(ctx context.Context, tokenHash strin
 	return nil
 }
 
-func (m *MockRefreshTokenRepository) RevokeIfValid(ctx context.Context, tokenHash string) (bool, error) {
-	if m.RevokeIfValidFunc != nil {
-		return m.RevokeIfValidFunc(ctx, tokenHash)
+func (m *MockRefreshTokenRepository) Rotate(ctx context.Context, oldTokenHash string, next *authModel.RefreshToken) error {
+	if m.RotateFunc != nil {
+		return m.RotateFunc(ctx, oldTokenHash, next)
 	}
-	return true, nil
+	return nil
 }
 
 func (m *MockRefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID string) error {
@@ -125,6 +136,27 @@ func (m *MockRefreshTokenRepository) RevokeAllForUser(ctx context.Context, userI
 	return nil
 }
 
+func (m *MockRefreshTokenRepository) ListActiveSessions(ctx context.Context, userID string) ([]*authModel.SessionDTO, error) {
+	if m.ListActiveSessionsFunc != nil {
+		return m.ListActiveSessionsFunc(ctx, userID)
+	}
+	return []*authModel.SessionDTO{}, nil
+}
+
+func (m *MockRefreshTokenRepository) RevokeSession(ctx context.Context, userID, sessionID string) (bool, error) {
+	if m.RevokeSessionFunc != nil {
+		return m.RevokeSessionFunc(ctx, userID, sessionID)
+	}
+	return true, nil
+}
+
+func (m *MockRefreshTokenRepository) RevokeAllInFamily(ctx context.Context, familyID string) error {
+	if m.RevokeAllInFamilyFunc != nil {
+		return m.RevokeAllInFamilyFunc(ctx, familyID)
+	}
+	return nil
+}
+
 func (m *MockRefreshTokenRepository) DeleteExpired(ctx context.Context) error {
 	if m.DeleteExpiredFunc != nil {
 		return m.DeleteExpiredFunc(ctx)
@@ -320,6 +352,29 @@ func TestAuthHandler_Register(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 
+	t.Run("returns 400 for unsupported locale", func(t *testing.T) {
+		mockUserRepo := &MockUserRepository{
+			CreateFunc: func(ctx context.Context, user *userModel.User) error {
+				t.Fatal("user should not be created")
+				return nil
+			},
+		}
+		svc := createTestAuthService(mockUserRepo, &MockRefreshTokenRepository{})
+		handler := NewAuthHandler(svc, auth.NewCookieConfig("test"), 15*time.Minute, 168*time.Hour)
+
+		router := setupTestRouter()
+		router.POST("/auth/register", handler.Register)
+
+		body := `{"email":"test@example.com","password":"password123","locale":"xx-ZZ"}`
+		req, _ := http.NewRequest(http.MethodPost, "/auth/register", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), string(userModel.CodeInvalidLocale))
+	})
+
 	t.Run("returns 409 for existing user", func(t *testing.T) {
 		mockUserRepo := &MockUserRepository{
 			GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
@@ -905,14 +960,11 @@ func TestAuthHandler_ResetPassword(t *testing.T) {
 func TestAuthHandler_Refresh(t *testing.T) {
 	t.Run("successfully refreshes tokens", func(t *testing.T) {
 		jwtManager := createTestJWTManager()
-		refreshToken, _ := jwtManager.GenerateRefreshToken("user-123")
+		refreshToken, _ := jwtManager.GenerateRefreshToken("user-123", "en")
 
 		mockTokenRepo := &MockRefreshTokenRepository{
-			RevokeIfValidFunc: func(ctx context.Context, hash string) (bool, error) {
-				return true, nil
-			},
-			CreateFunc: func(ctx context.Context, token *authModel.RefreshToken) error {
-				return nil
+			GetByTokenHashFunc: func(ctx context.Context, hash string) (*authModel.RefreshToken, error) {
+				return authModel.NewRefreshToken("user-123", hash, time.Now().Add(time.Hour)), nil
 			},
 		}
 
@@ -937,6 +989,42 @@ func TestAuthHandler_Refresh(t *testing.T) {
 		assert.NotEmpty(t, response.RefreshToken)
 	})
 
+	t.Run("returns 401 TOKEN_REUSE_DETECTED for a rotated token", func(t *testing.T) {
+		jwtManager := createTestJWTManager()
+		refreshToken, _ := jwtManager.GenerateRefreshToken("user-123", "en")
+
+		var revokedFamily string
+		mockTokenRepo := &MockRefreshTokenRepository{
+			GetByTokenHashFunc: func(ctx context.Context, hash string) (*authModel.RefreshToken, error) {
+				token := authModel.NewRefreshToken("user-123", hash, time.Now().Add(time.Hour))
+				token.FamilyID = "family-1"
+				replacedBy := "next-hash"
+				token.ReplacedBy = &replacedBy
+				return token, nil
+			},
+			RevokeAllInFamilyFunc: func(ctx context.Context, familyID string) error {
+				revokedFamily = familyID
+				return nil
+			},
+		}
+
+		svc := createTestAuthService(&MockUserRepository{}, mockTokenRepo)
+		handler := NewAuthHandler(svc, auth.NewCookieConfig("test"), 15*time.Minute, 168*time.Hour)
+
+		router := setupTestRouter()
+		router.POST("/auth/refresh", handler.Refresh)
+
+		body := `{"refresh_token":"` + refreshToken + `"}`
+		req, _ := http.NewRequest(http.MethodPost, "/auth/refresh", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "TOKEN_REUSE_DETECTED")
+		assert.Equal(t, "family-1", revokedFamily)
+	})
+
 	t.Run("returns 401 for invalid refresh token", func(t *testing.T) {
 		svc := createTestAuthService(&MockUserRepository{}, &MockRefreshTokenRepository{})
 		handler := NewAuthHandler(svc, auth.NewCookieConfig("test"), 15*time.Minute, 168*time.Hour)
@@ -1010,6 +1098,85 @@ func TestAuthHandler_Logout(t *testing.T) {
 	})
 }
 
+func TestAuthHandler_Sessions(t *testing.T) {
+	sessionID := "6f1c2a9e-3b4d-4e5f-8a7b-9c0d1e2f3a4b"
+
+	t.Run("lists the user's sessions", func(t *testing.T) {
+		var gotUserID string
+		mockTokenRepo := &MockRefreshTokenRepository{
+			ListActiveSessionsFunc: func(ctx context.Context, userID string) ([]*authModel.SessionDTO, error) {
+				gotUserID = userID
+				return []*authModel.SessionDTO{{ID: sessionID, UserAgent: "Firefox", IPAddress: "203.0.113.7"}}, nil
+			},
+		}
+		handler := NewAuthHandler(createTestAuthService(&MockUserRepository{}, mockTokenRepo), auth.NewCookieConfig("test"), 15*time.Minute, 168*time.Hour)
+
+		router := setupTestRouter()
+		router.GET("/auth/sessions", mockAuthMiddleware("user-123"), handler.ListSessions)
+
+		req, _ := http.NewRequest(http.MethodGet, "/auth/sessions", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "user-123", gotUserID)
+		var sessions []map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &sessions))
+		require.Len(t, sessions, 1)
+		assert.Equal(t, sessionID, sessions[0]["id"])
+		assert.Equal(t, "Firefox", sessions[0]["user_agent"])
+		assert.Equal(t, "203.0.113.7", sessions[0]["ip_address"])
+	})
+
+	revoke := func(mockTokenRepo *MockRefreshTokenRepository, id string) *httptest.ResponseRecorder {
+		handler := NewAuthHandler(createTestAuthService(&MockUserRepository{}, mockTokenRepo), auth.NewCookieConfig("test"), 15*time.Minute, 168*time.Hour)
+
+		router := setupTestRouter()
+		router.DELETE("/auth/sessions/:id", mockAuthMiddleware("user-123"), handler.RevokeSession)
+
+		req, _ := http.NewRequest(http.MethodDelete, "/auth/sessions/"+id, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("revokes a session of the user", func(t *testing.T) {
+		var gotUserID, gotSessionID string
+		w := revoke(&MockRefreshTokenRepository{
+			RevokeSessionFunc: func(ctx context.Context, userID, id string) (bool, error) {
+				gotUserID, gotSessionID = userID, id
+				return true, nil
+			},
+		}, sessionID)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, "user-123", gotUserID)
+		assert.Equal(t, sessionID, gotSessionID)
+	})
+
+	t.Run("returns 404 for a session of another user", func(t *testing.T) {
+		w := revoke(&MockRefreshTokenRepository{
+			RevokeSessionFunc: func(ctx context.Context, userID, id string) (bool, error) {
+				return false, nil
+			},
+		}, sessionID)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "SESSION_NOT_FOUND")
+	})
+
+	t.Run("returns 404 for a malformed session ID", func(t *testing.T) {
+		w := revoke(&MockRefreshTokenRepository{
+			RevokeSessionFunc: func(ctx context.Context, userID, id string) (bool, error) {
+				t.Fatal("RevokeSession should not be called")
+				return false, nil
+			},
+		}, "not-a-uuid")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
 func TestAuthHandler_RegisterRoutes(t *testing.T) {
 	svc := createTestAuthService(
 		&MockUserRepository{
@@ -1041,6 +1208,8 @@ func TestAuthHandler_RegisterRoutes(t *testing.T) {
 		{http.MethodPost, "/api/v1/auth/login"},
 		{http.MethodPost, "/api/v1/auth/refresh"},
 		{http.MethodPost, "/api/v1/auth/logout"},
+		{http.MethodGet, "/api/v1/auth/sessions"},
+		{http.MethodDelete, "/api/v1/auth/sessions"},
 		{http.MethodPost, "/api/v1/auth/verify-email"},
 		{http.MethodPost, "/api/v1/auth/resend-verification"},
 		{http.MethodPost, "/api/v1/auth/forgot-password"},
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/andreypavlenko/jobber/internal/platform/auth"
+	"github.com/andreypavlenko/jobber/internal/platform/email"
+	authModel "github.com/andreypavlenko/jobber/modules/auth/model"
+	"github.com/andreypavlenko/jobber/modules/auth/service"
+	userModel "github.com/andreypavlenko/jobber/modules/users/model"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubOAuthProvider implements authPorts.OAuthProvider
+type stubOAuthProvider struct {
+	profile *authModel.OAuthProfile
+	err     error
+}
+
+func (p *stubOAuthProvider) AuthCodeURL(state string) string {
+	return "https://accounts.example.com/auth?state=" + url.QueryEscape(state)
+}
+
+func (p *stubOAuthProvider) Exchange(ctx context.Context, code string) (*authModel.OAuthProfile, error) {
+	return p.profile, p.err
+}
+
+// stubOAuthAccountRepository implements authPorts.OAuthAccountRepository with no linked accounts
+type stubOAuthAccountRepository struct{}
+
+func (r *stubOAuthAccountRepository) Create(ctx context.Context, account *authModel.OAuthAccount) error {
+	return nil
+}
+
+func (r *stubOAuthAccountRepository) GetByProviderUserID(ctx context.Context, provider, providerUserID string) (*authModel.OAuthAccount, error) {
+	return nil, authModel.ErrOAuthAccountNotFound
+}
+
+func setupGoogleLoginRouter(t *testing.T, provider *stubOAuthProvider) http.Handler {
+	t.Helper()
+	userRepo := &MockUserRepository{
+		GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+			return &userModel.User{ID: "user-123", Email: email, Locale: "en", EmailVerified: true}, nil
+		},
+	}
+	mr := miniredis.RunT(t)
+	svc := service.NewAuthService(service.AuthServiceConfig{
+		UserRepo:          userRepo,
+		TokenRepo:         &MockRefreshTokenRepository{},
+		VerificationRepo:  &MockEmailVerificationRepository{},
+		PasswordResetRepo: &MockPasswordResetRepository{},
+		EmailSender:       &email.NoopSender{},
+		JWTManager:        createTestJWTManager(),
+		AccessExpiry:      15 * time.Minute,
+		RefreshExpiry:     7 * 24 * time.Hour,
+		GoogleProvider:    provider,
+		OAuthAccountRepo:  &stubOAuthAccountRepository{},
+		RedisClient:       redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+	})
+	handler := NewAuthHandler(svc, auth.NewCookieConfig("test"), 15*time.Minute, 168*time.Hour)
+
+	router := setupTestRouter()
+	handler.RegisterRoutes(router.Group("/api/v1"), AuthRouteConfig{AuthMiddleware: mockAuthMiddleware("user-123")})
+	return router
+}
+
+// startGoogleLogin follows /auth/google/login and returns the state it issued
+func startGoogleLogin(t *testing.T, router http.Handler) string {
+	t.Helper()
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/auth/google/login", nil))
+	require.Equal(t, http.StatusTemporaryRedirect, w.Code)
+
+	location, err := url.Parse(w.Header().Get("Location"))
+	require.NoError(t, err)
+	state := location.Query().Get("state")
+	require.NotEmpty(t, state)
+	return state
+}
+
+func googleCallback(router http.Handler, code, state string) *httptest.ResponseRecorder {
+	query := url.Values{}
+	if code != "" {
+		query.Set("code", code)
+	}
+	if state != "" {
+		query.Set("state", state)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/auth/google/callback?"+query.Encode(), nil))
+	return w
+}
+
+func TestAuthHandler_GoogleCallback(t *testing.T) {
+	verified := &authModel.OAuthProfile{ProviderUserID: "google-1", Email: "test@example.com", EmailVerified: true}
+
+	t.Run("signs in and sets the token cookies", func(t *testing.T) {
+		router := setupGoogleLoginRouter(t, &stubOAuthProvider{profile: verified})
+		state := startGoogleLogin(t, router)
+
+		w := googleCallback(router, "auth-code", state)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response LoginResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "user-123", response.User.ID)
+		assert.NotEmpty(t, response.Tokens.AccessToken)
+		assert.NotEmpty(t, w.Result().Cookies())
+	})
+
+	t.Run("returns 400 without code or state", func(t *testing.T) {
+		router := setupGoogleLoginRouter(t, &stubOAuthProvider{profile: verified})
+
+		assert.Equal(t, http.StatusBadRequest, googleCallback(router, "", "state").Code)
+		assert.Equal(t, http.StatusBadRequest, googleCallback(router, "auth-code", "").Code)
+	})
+
+	t.Run("returns 400 for an unknown state", func(t *testing.T) {
+		router := setupGoogleLoginRouter(t, &stubOAuthProvider{profile: verified})
+
+		w := googleCallback(router, "auth-code", "forged-state")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), string(userModel.CodeInvalidOAuthState))
+	})
+
+	t.Run("returns 403 for an unverified Google email", func(t *testing.T) {
+		router := setupGoogleLoginRouter(t, &stubOAuthProvider{profile: &authModel.OAuthProfile{ProviderUserID: "google-1", Email: "test@example.com"}})
+		state := startGoogleLogin(t, router)
+
+		w := googleCallback(router, "auth-code", state)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), string(userModel.CodeOAuthEmailNotVerified))
+	})
+
+	t.Run("returns 502 when Google fails", func(t *testing.T) {
+		router := setupGoogleLoginRouter(t, &stubOAuthProvider{err: userModel.ErrOAuthProvider})
+		state := startGoogleLogin(t, router)
+
+		w := googleCallback(router, "auth-code", state)
+
+		assert.Equal(t, http.StatusBadGateway, w.Code)
+	})
+}
+
+func TestAuthHandler_RegisterRoutes_WithoutGoogleLogin(t *testing.T) {
+	svc := createTestAuthService(&MockUserRepository{}, &MockRefreshTokenRepository{})
+	handler := NewAuthHandler(svc, auth.NewCookieConfig("test"), 15*time.Minute, 168*time.Hour)
+	router := setupTestRouter()
+	handler.RegisterRoutes(router.Group("/api/v1"), AuthRouteConfig{AuthMiddleware: mockAuthMiddleware("user-123")})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/auth/google/login", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// OAuth providers users can sign in with
+const (
+	ProviderGoogle = "google"
+)
+
+// ErrOAuthAccountNotFound is returned when a provider identity is not linked to a user
+var ErrOAuthAccountNotFound = errors.New("oauth account not found")
+
+// OAuthAccount links a user to their identity at an OAuth provider
+type OAuthAccount struct {
+	ID             string
+	UserID         string
+	Provider       string
+	ProviderUserID string
+	CreatedAt      time.Time
+}
+
+// OAuthProfile is the identity an OAuth provider returns after sign-in
+type OAuthProfile struct {
+	ProviderUserID string
+	Email          string
+	EmailVerified  bool
+	Name           string
+}
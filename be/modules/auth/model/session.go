@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// MaxUserAgentLength caps the stored User-Agent header, in characters
+const MaxUserAgentLength = 512
+
+// ClientInfo describes the device a session was started or last refreshed from
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+// NewClientInfo creates client info from request headers, truncating an overly long User-Agent
+func NewClientInfo(userAgent, ipAddress string) ClientInfo {
+	if runes := []rune(userAgent); len(runes) > MaxUserAgentLength {
+		userAgent = string(runes[:MaxUserAgentLength])
+	}
+	return ClientInfo{UserAgent: userAgent, IPAddress: ipAddress}
+}
+
+// SessionDTO represents an active login. Its ID is the refresh token family, so it
+// stays the same while the refresh token is rotated.
+type SessionDTO struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"` // when the user logged in
+	ExpiresAt time.Time `json:"expires_at"`
+	UserAgent string    `json:"user_agent"`
+	IPAddress string    `json:"ip_address"`
+}
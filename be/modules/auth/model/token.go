@@ -1,29 +1,55 @@
 package model
 
 import (
+	"errors"
 	"time"
+
+	"github.com/google/uuid"
 )
 
-// RefreshToken represents a refresh token in the database
+// ErrRefreshTokenInactive is returned when rotating a refresh token that is already
+// revoked, rotated or expired
+var ErrRefreshTokenInactive = errors.New("refresh token is no longer active")
+
+// RefreshToken represents a refresh token in the database.
+// Tokens rotated from the same login share a FamilyID; Generation counts the rotations.
+// ReplacedBy holds the hash of the token a rotated token was exchanged for.
 type RefreshToken struct {
-	ID        string
-	UserID    string
-	TokenHash string
-	ExpiresAt time.Time
-	CreatedAt time.Time
-	RevokedAt *time.Time
+	ID         string
+	UserID     string
+	TokenHash  string
+	FamilyID   string
+	Generation int
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	RevokedAt  *time.Time
+	ReplacedBy *string
+	Client     ClientInfo
 }
 
-// NewRefreshToken creates a new refresh token
+// NewRefreshToken creates a new refresh token that starts a new family
 func NewRefreshToken(userID, tokenHash string, expiresAt time.Time) *RefreshToken {
 	return &RefreshToken{
 		UserID:    userID,
 		TokenHash: tokenHash,
+		FamilyID:  uuid.New().String(),
 		ExpiresAt: expiresAt,
 		CreatedAt: time.Now().UTC(),
 	}
 }
 
+// Rotate creates the token that replaces t, in the same family and one generation later
+func (t *RefreshToken) Rotate(tokenHash string, expiresAt time.Time) *RefreshToken {
+	return &RefreshToken{
+		UserID:     t.UserID,
+		TokenHash:  tokenHash,
+		FamilyID:   t.FamilyID,
+		Generation: t.Generation + 1,
+		ExpiresAt:  expiresAt,
+		CreatedAt:  time.Now().UTC(),
+	}
+}
+
 // IsValid checks if the token is valid
 func (t *RefreshToken) IsValid() bool {
 	return t.RevokedAt == nil && time.Now().UTC().Before(t.ExpiresAt)
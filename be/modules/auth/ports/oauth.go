@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/andreypavlenko/jobber/modules/auth/model"
+)
+
+// OAuthAccountRepository defines the interface for OAuth account data access
+type OAuthAccountRepository interface {
+	Create(ctx context.Context, account *model.OAuthAccount) error
+	// GetByProviderUserID returns model.ErrOAuthAccountNotFound when the identity is not linked to a user
+	GetByProviderUserID(ctx context.Context, provider, providerUserID string) (*model.OAuthAccount, error)
+}
+
+// OAuthProvider runs the authorization code flow of an OAuth provider
+type OAuthProvider interface {
+	// AuthCodeURL returns the consent screen URL that redirects back with state
+	AuthCodeURL(state string) string
+	// Exchange trades an authorization code for the signed-in user's profile
+	Exchange(ctx context.Context, code string) (*model.OAuthProfile, error)
+}
@@ -11,9 +11,16 @@ type RefreshTokenRepository interface {
 	Create(ctx context.Context, token *model.RefreshToken) error
 	GetByTokenHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error)
 	Revoke(ctx context.Context, tokenHash string) error
-	// RevokeIfValid atomically revokes a token only if it is not already revoked.
-	// Returns true if the token was revoked by this call, false if already revoked/expired.
-	RevokeIfValid(ctx context.Context, tokenHash string) (bool, error)
+	// Rotate atomically revokes the active token with oldTokenHash, marks it as replaced
+	// by next and stores next. Returns model.ErrRefreshTokenInactive if the old token is
+	// already revoked, rotated or expired.
+	Rotate(ctx context.Context, oldTokenHash string, next *model.RefreshToken) error
 	RevokeAllForUser(ctx context.Context, userID string) error
+	// ListActiveSessions returns the user's sessions, one per refresh token family with an active token
+	ListActiveSessions(ctx context.Context, userID string) ([]*model.SessionDTO, error)
+	// RevokeSession revokes one of the user's sessions; false means no active session has that ID
+	RevokeSession(ctx context.Context, userID, sessionID string) (bool, error)
+	// RevokeAllInFamily revokes every token rotated from the same login
+	RevokeAllInFamily(ctx context.Context, familyID string) error
 	DeleteExpired(ctx context.Context) error
 }
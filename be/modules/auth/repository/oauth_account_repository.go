@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/auth/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// OAuthAccountRepository implements ports.OAuthAccountRepository
+type OAuthAccountRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewOAuthAccountRepository creates a new OAuth account repository
+func NewOAuthAccountRepository(pool *pgxpool.Pool) *OAuthAccountRepository {
+	return &OAuthAccountRepository{pool: pool}
+}
+
+// Create links a provider identity to a user
+func (r *OAuthAccountRepository) Create(ctx context.Context, account *model.OAuthAccount) error {
+	query := `
+		INSERT INTO oauth_accounts (id, user_id, provider, provider_user_id, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	account.ID = uuid.New().String()
+	account.CreatedAt = time.Now().UTC()
+
+	_, err := r.pool.Exec(ctx, query,
+		account.ID,
+		account.UserID,
+		account.Provider,
+		account.ProviderUserID,
+		account.CreatedAt,
+	)
+	return err
+}
+
+// GetByProviderUserID retrieves the account linked to a provider identity
+func (r *OAuthAccountRepository) GetByProviderUserID(ctx context.Context, provider, providerUserID string) (*model.OAuthAccount, error) {
+	query := `
+		SELECT id, user_id, provider, provider_user_id, created_at
+		FROM oauth_accounts
+		WHERE provider = $1 AND provider_user_id = $2
+	`
+
+	account := &model.OAuthAccount{}
+	err := r.pool.QueryRow(ctx, query, provider, providerUserID).Scan(
+		&account.ID,
+		&account.UserID,
+		&account.Provider,
+		&account.ProviderUserID,
+		&account.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrOAuthAccountNotFound
+		}
+		return nil, err
+	}
+	return account, nil
+}
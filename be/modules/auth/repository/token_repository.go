@@ -3,17 +3,27 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/andreypavlenko/jobber/modules/auth/model"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
 // RefreshTokenRepository implements ports.RefreshTokenRepository
 type RefreshTokenRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 // NewRefreshTokenRepository creates a new refresh token repository
@@ -21,21 +31,33 @@ func NewRefreshTokenRepository(pool *pgxpool.Pool) *RefreshTokenRepository {
 	return &RefreshTokenRepository{pool: pool}
 }
 
+// NewRefreshTokenRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewRefreshTokenRepositoryWithPool(pool DBPool) *RefreshTokenRepository {
+	return &RefreshTokenRepository{pool: pool}
+}
+
+const insertRefreshTokenQuery = `
+	INSERT INTO refresh_tokens (id, user_id, token_hash, family_id, generation, expires_at, created_at, user_agent, ip_address)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
 // Create creates a new refresh token
 func (r *RefreshTokenRepository) Create(ctx context.Context, token *model.RefreshToken) error {
-	query := `
-		INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, created_at)
-		VALUES ($1, $2, $3, $4, $5)
-	`
-
 	token.ID = uuid.New().String()
+	if token.FamilyID == "" {
+		token.FamilyID = token.ID
+	}
 
-	_, err := r.pool.Exec(ctx, query,
+	_, err := r.pool.Exec(ctx, insertRefreshTokenQuery,
 		token.ID,
 		token.UserID,
 		token.TokenHash,
+		token.FamilyID,
+		token.Generation,
 		token.ExpiresAt,
 		token.CreatedAt,
+		token.Client.UserAgent,
+		token.Client.IPAddress,
 	)
 
 	return err
@@ -44,7 +66,7 @@ func (r *RefreshTokenRepository) Create(ctx context.Context, token *model.Refres
 // GetByTokenHash retrieves a refresh token by its hash
 func (r *RefreshTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
 	query := `
-		SELECT id, user_id, token_hash, expires_at, created_at, revoked_at
+		SELECT id, user_id, token_hash, family_id, generation, expires_at, created_at, revoked_at, replaced_by
 		FROM refresh_tokens
 		WHERE token_hash = $1
 	`
@@ -54,9 +76,12 @@ func (r *RefreshTokenRepository) GetByTokenHash(ctx context.Context, tokenHash s
 		&token.ID,
 		&token.UserID,
 		&token.TokenHash,
+		&token.FamilyID,
+		&token.Generation,
 		&token.ExpiresAt,
 		&token.CreatedAt,
 		&token.RevokedAt,
+		&token.ReplacedBy,
 	)
 
 	if err != nil {
@@ -81,21 +106,51 @@ func (r *RefreshTokenRepository) Revoke(ctx context.Context, tokenHash string) e
 	return err
 }
 
-// RevokeIfValid atomically revokes a token only if it hasn't been revoked yet.
-// Returns true if this call performed the revocation, false if already revoked/expired.
-func (r *RefreshTokenRepository) RevokeIfValid(ctx context.Context, tokenHash string) (bool, error) {
-	query := `
+// Rotate revokes the token with oldTokenHash, records next as its replacement and
+// stores next, all in one transaction. Returns model.ErrRefreshTokenInactive when the
+// old token is already revoked, rotated or expired.
+func (r *RefreshTokenRepository) Rotate(ctx context.Context, oldTokenHash string, next *model.RefreshToken) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // rollback is a no-op after commit
+
+	result, err := tx.Exec(ctx, `
 		UPDATE refresh_tokens
-		SET revoked_at = $2
+		SET revoked_at = $2, replaced_by = $3
 		WHERE token_hash = $1 AND revoked_at IS NULL AND expires_at > $2
-	`
+	`, oldTokenHash, time.Now().UTC(), next.TokenHash)
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrRefreshTokenInactive
+	}
 
-	now := time.Now().UTC()
-	result, err := r.pool.Exec(ctx, query, tokenHash, now)
+	next.ID = uuid.New().String()
+	if next.FamilyID == "" {
+		next.FamilyID = next.ID
+	}
+	_, err = tx.Exec(ctx, insertRefreshTokenQuery,
+		next.ID,
+		next.UserID,
+		next.TokenHash,
+		next.FamilyID,
+		next.Generation,
+		next.ExpiresAt,
+		next.CreatedAt,
+		next.Client.UserAgent,
+		next.Client.IPAddress,
+	)
 	if err != nil {
-		return false, err
+		return fmt.Errorf("failed to create refresh token: %w", err)
 	}
-	return result.RowsAffected() > 0, nil
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
 }
 
 // RevokeAllForUser revokes all refresh tokens for a user
@@ -110,6 +165,65 @@ func (r *RefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID st
 	return err
 }
 
+// ListActiveSessions returns the user's sessions that still have an active refresh
+// token, most recently refreshed first. A session is a refresh token family; its
+// creation time is that of the family's first token.
+func (r *RefreshTokenRepository) ListActiveSessions(ctx context.Context, userID string) ([]*model.SessionDTO, error) {
+	query := `
+		SELECT t.family_id, f.started_at, t.expires_at, t.user_agent, t.ip_address
+		FROM refresh_tokens t
+		CROSS JOIN LATERAL (
+			SELECT MIN(ft.created_at) AS started_at FROM refresh_tokens ft WHERE ft.family_id = t.family_id
+		) f
+		WHERE t.user_id = $1 AND t.revoked_at IS NULL AND t.expires_at > $2
+		ORDER BY t.created_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []*model.SessionDTO{}
+	for rows.Next() {
+		session := &model.SessionDTO{}
+		if err := rows.Scan(&session.ID, &session.CreatedAt, &session.ExpiresAt, &session.UserAgent, &session.IPAddress); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, rows.Err()
+}
+
+// RevokeSession revokes the active tokens of one of the user's sessions.
+// Returns false when the user has no active session with that ID.
+func (r *RefreshTokenRepository) RevokeSession(ctx context.Context, userID, sessionID string) (bool, error) {
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = $3
+		WHERE family_id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > $3
+	`
+
+	result, err := r.pool.Exec(ctx, query, sessionID, userID, time.Now().UTC())
+	if err != nil {
+		return false, err
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+// RevokeAllInFamily revokes all refresh tokens rotated from the same login
+func (r *RefreshTokenRepository) RevokeAllInFamily(ctx context.Context, familyID string) error {
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = $2
+		WHERE family_id = $1 AND revoked_at IS NULL
+	`
+
+	_, err := r.pool.Exec(ctx, query, familyID, time.Now().UTC())
+	return err
+}
+
 // DeleteExpired deletes expired refresh tokens
 func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) error {
 	query := `
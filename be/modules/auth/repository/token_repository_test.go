@@ -18,18 +18,20 @@ func TestRefreshTokenRepository_Create(t *testing.T) {
 		defer mock.Close()
 
 		token := &model.RefreshToken{
-			UserID:    "user-123",
-			TokenHash: "hash123",
-			ExpiresAt: time.Now().Add(24 * time.Hour),
-			CreatedAt: time.Now(),
+			UserID:     "user-123",
+			TokenHash:  "hash123",
+			FamilyID:   "family-1",
+			Generation: 2,
+			ExpiresAt:  time.Now().Add(24 * time.Hour),
+			CreatedAt:  time.Now(),
+			Client:     model.ClientInfo{UserAgent: "Mozilla/5.0", IPAddress: "203.0.113.7"},
 		}
 
 		mock.ExpectExec("INSERT INTO refresh_tokens").
-			WithArgs(pgxmock.AnyArg(), token.UserID, token.TokenHash, token.ExpiresAt, token.CreatedAt).
+			WithArgs(pgxmock.AnyArg(), token.UserID, token.TokenHash, token.FamilyID, token.Generation, token.ExpiresAt, token.CreatedAt, "Mozilla/5.0", "203.0.113.7").
 			WillReturnResult(pgxmock.NewResult("INSERT", 1))
 
-		// Create a test wrapper
-		repo := &testRefreshTokenRepo{mock: mock}
+		repo := NewRefreshTokenRepositoryWithPool(mock)
 		err = repo.Create(context.Background(), token)
 
 		require.NoError(t, err)
@@ -54,27 +56,32 @@ func TestRefreshTokenRepository_GetByTokenHash(t *testing.T) {
 		}
 
 		rows := pgxmock.NewRows([]string{
-			"id", "user_id", "token_hash", "expires_at", "created_at", "revoked_at",
+			"id", "user_id", "token_hash", "family_id", "generation", "expires_at", "created_at", "revoked_at", "replaced_by",
 		}).AddRow(
 			expectedToken.ID,
 			expectedToken.UserID,
 			expectedToken.TokenHash,
+			"family-1",
+			1,
 			expectedToken.ExpiresAt,
 			expectedToken.CreatedAt,
 			nil,
+			nil,
 		)
 
-		mock.ExpectQuery("SELECT id, user_id, token_hash, expires_at, created_at, revoked_at").
+		mock.ExpectQuery("SELECT id, user_id, token_hash, family_id, generation, expires_at, created_at, revoked_at, replaced_by").
 			WithArgs(tokenHash).
 			WillReturnRows(rows)
 
-		repo := &testRefreshTokenRepo{mock: mock}
+		repo := NewRefreshTokenRepositoryWithPool(mock)
 		token, err := repo.GetByTokenHash(context.Background(), tokenHash)
 
 		require.NoError(t, err)
 		assert.Equal(t, expectedToken.ID, token.ID)
 		assert.Equal(t, expectedToken.UserID, token.UserID)
 		assert.Equal(t, expectedToken.TokenHash, token.TokenHash)
+		assert.Equal(t, "family-1", token.FamilyID)
+		assert.Equal(t, 1, token.Generation)
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
@@ -86,14 +93,14 @@ func TestRefreshTokenRepository_GetByTokenHash(t *testing.T) {
 		tokenHash := "nonexistent-hash"
 
 		rows := pgxmock.NewRows([]string{
-			"id", "user_id", "token_hash", "expires_at", "created_at", "revoked_at",
+			"id", "user_id", "token_hash", "family_id", "generation", "expires_at", "created_at", "revoked_at", "replaced_by",
 		})
 
-		mock.ExpectQuery("SELECT id, user_id, token_hash, expires_at, created_at, revoked_at").
+		mock.ExpectQuery("SELECT id, user_id, token_hash, family_id, generation, expires_at, created_at, revoked_at, replaced_by").
 			WithArgs(tokenHash).
 			WillReturnRows(rows)
 
-		repo := &testRefreshTokenRepo{mock: mock}
+		repo := NewRefreshTokenRepositoryWithPool(mock)
 		token, err := repo.GetByTokenHash(context.Background(), tokenHash)
 
 		assert.Error(t, err)
@@ -114,7 +121,7 @@ func TestRefreshTokenRepository_Revoke(t *testing.T) {
 			WithArgs(tokenHash, pgxmock.AnyArg()).
 			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
 
-		repo := &testRefreshTokenRepo{mock: mock}
+		repo := NewRefreshTokenRepositoryWithPool(mock)
 		err = repo.Revoke(context.Background(), tokenHash)
 
 		require.NoError(t, err)
@@ -122,6 +129,122 @@ func TestRefreshTokenRepository_Revoke(t *testing.T) {
 	})
 }
 
+func TestRefreshTokenRepository_Rotate(t *testing.T) {
+	next := &model.RefreshToken{
+		UserID:     "user-123",
+		TokenHash:  "hash-next",
+		FamilyID:   "family-1",
+		Generation: 2,
+		ExpiresAt:  time.Now().Add(24 * time.Hour),
+		CreatedAt:  time.Now(),
+	}
+
+	t.Run("revokes the old token and stores its replacement in one transaction", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`SET revoked_at = \$2, replaced_by = \$3`).
+			WithArgs("hash-old", pgxmock.AnyArg(), "hash-next").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectExec("INSERT INTO refresh_tokens").
+			WithArgs(pgxmock.AnyArg(), next.UserID, next.TokenHash, next.FamilyID, next.Generation, next.ExpiresAt, next.CreatedAt, "", "").
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectCommit()
+
+		repo := NewRefreshTokenRepositoryWithPool(mock)
+		err = repo.Rotate(context.Background(), "hash-old", next)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, next.ID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back when the old token is no longer active", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE refresh_tokens").
+			WithArgs("hash-old", pgxmock.AnyArg(), "hash-next").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+		mock.ExpectRollback()
+
+		repo := NewRefreshTokenRepositoryWithPool(mock)
+		err = repo.Rotate(context.Background(), "hash-old", next)
+
+		assert.ErrorIs(t, err, model.ErrRefreshTokenInactive)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRefreshTokenRepository_ListActiveSessions(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	startedAt := time.Now().Add(-48 * time.Hour)
+	expiresAt := time.Now().Add(24 * time.Hour)
+	rows := pgxmock.NewRows([]string{"family_id", "started_at", "expires_at", "user_agent", "ip_address"}).
+		AddRow("family-1", startedAt, expiresAt, "Mozilla/5.0", "203.0.113.7")
+
+	mock.ExpectQuery(`WHERE t\.user_id = \$1 AND t\.revoked_at IS NULL AND t\.expires_at > \$2`).
+		WithArgs("user-123", pgxmock.AnyArg()).
+		WillReturnRows(rows)
+
+	repo := NewRefreshTokenRepositoryWithPool(mock)
+	sessions, err := repo.ListActiveSessions(context.Background(), "user-123")
+
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, &model.SessionDTO{
+		ID:        "family-1",
+		CreatedAt: startedAt,
+		ExpiresAt: expiresAt,
+		UserAgent: "Mozilla/5.0",
+		IPAddress: "203.0.113.7",
+	}, sessions[0])
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshTokenRepository_RevokeSession(t *testing.T) {
+	t.Run("revokes the user's session", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`WHERE family_id = \$1 AND user_id = \$2`).
+			WithArgs("family-1", "user-123", pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		repo := NewRefreshTokenRepositoryWithPool(mock)
+		revoked, err := repo.RevokeSession(context.Background(), "user-123", "family-1")
+
+		require.NoError(t, err)
+		assert.True(t, revoked)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("reports a session of another user as not revoked", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("UPDATE refresh_tokens").
+			WithArgs("family-1", "user-456", pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		repo := NewRefreshTokenRepositoryWithPool(mock)
+		revoked, err := repo.RevokeSession(context.Background(), "user-456", "family-1")
+
+		require.NoError(t, err)
+		assert.False(t, revoked)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestRefreshTokenRepository_RevokeAllForUser(t *testing.T) {
 	t.Run("revokes all tokens for user successfully", func(t *testing.T) {
 		mock, err := pgxmock.NewPool()
@@ -134,7 +257,7 @@ func TestRefreshTokenRepository_RevokeAllForUser(t *testing.T) {
 			WithArgs(userID, pgxmock.AnyArg()).
 			WillReturnResult(pgxmock.NewResult("UPDATE", 3))
 
-		repo := &testRefreshTokenRepo{mock: mock}
+		repo := NewRefreshTokenRepositoryWithPool(mock)
 		err = repo.RevokeAllForUser(context.Background(), userID)
 
 		require.NoError(t, err)
@@ -142,6 +265,26 @@ func TestRefreshTokenRepository_RevokeAllForUser(t *testing.T) {
 	})
 }
 
+func TestRefreshTokenRepository_RevokeAllInFamily(t *testing.T) {
+	t.Run("revokes all tokens in the family successfully", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		familyID := "family-1"
+
+		mock.ExpectExec("WHERE family_id = ").
+			WithArgs(familyID, pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 4))
+
+		repo := NewRefreshTokenRepositoryWithPool(mock)
+		err = repo.RevokeAllInFamily(context.Background(), familyID)
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestRefreshTokenRepository_DeleteExpired(t *testing.T) {
 	t.Run("deletes expired tokens successfully", func(t *testing.T) {
 		mock, err := pgxmock.NewPool()
@@ -152,7 +295,7 @@ func TestRefreshTokenRepository_DeleteExpired(t *testing.T) {
 			WithArgs(pgxmock.AnyArg()).
 			WillReturnResult(pgxmock.NewResult("DELETE", 5))
 
-		repo := &testRefreshTokenRepo{mock: mock}
+		repo := NewRefreshTokenRepositoryWithPool(mock)
 		err = repo.DeleteExpired(context.Background())
 
 		require.NoError(t, err)
@@ -186,74 +329,3 @@ func TestRefreshToken_IsValid(t *testing.T) {
 		assert.False(t, token.IsValid())
 	})
 }
-
-// testRefreshTokenRepo is a test wrapper that uses pgxmock
-type testRefreshTokenRepo struct {
-	mock pgxmock.PgxPoolIface
-}
-
-func (r *testRefreshTokenRepo) Create(ctx context.Context, token *model.RefreshToken) error {
-	query := `
-		INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, created_at)
-		VALUES ($1, $2, $3, $4, $5)
-	`
-	token.ID = "test-token-id"
-	_, err := r.mock.Exec(ctx, query,
-		token.ID,
-		token.UserID,
-		token.TokenHash,
-		token.ExpiresAt,
-		token.CreatedAt,
-	)
-	return err
-}
-
-func (r *testRefreshTokenRepo) GetByTokenHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
-	query := `
-		SELECT id, user_id, token_hash, expires_at, created_at, revoked_at
-		FROM refresh_tokens
-		WHERE token_hash = $1
-	`
-	token := &model.RefreshToken{}
-	err := r.mock.QueryRow(ctx, query, tokenHash).Scan(
-		&token.ID,
-		&token.UserID,
-		&token.TokenHash,
-		&token.ExpiresAt,
-		&token.CreatedAt,
-		&token.RevokedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
-	return token, nil
-}
-
-func (r *testRefreshTokenRepo) Revoke(ctx context.Context, tokenHash string) error {
-	query := `
-		UPDATE refresh_tokens
-		SET revoked_at = $2
-		WHERE token_hash = $1 AND revoked_at IS NULL
-	`
-	_, err := r.mock.Exec(ctx, query, tokenHash, time.Now().UTC())
-	return err
-}
-
-func (r *testRefreshTokenRepo) RevokeAllForUser(ctx context.Context, userID string) error {
-	query := `
-		UPDATE refresh_tokens
-		SET revoked_at = $2
-		WHERE user_id = $1 AND revoked_at IS NULL
-	`
-	_, err := r.mock.Exec(ctx, query, userID, time.Now().UTC())
-	return err
-}
-
-func (r *testRefreshTokenRepo) DeleteExpired(ctx context.Context) error {
-	query := `
-		DELETE FROM refresh_tokens
-		WHERE expires_at < $1
-	`
-	_, err := r.mock.Exec(ctx, query, time.Now().UTC())
-	return err
-}
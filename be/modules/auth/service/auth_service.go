@@ -7,17 +7,18 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"regexp"
 	"strings"
 	"time"
 
 	"github.com/andreypavlenko/jobber/internal/platform/auth"
 	"github.com/andreypavlenko/jobber/internal/platform/email"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
 	sentryPlatform "github.com/andreypavlenko/jobber/internal/platform/sentry"
 	authModel "github.com/andreypavlenko/jobber/modules/auth/model"
 	authPorts "github.com/andreypavlenko/jobber/modules/auth/ports"
 	userModel "github.com/andreypavlenko/jobber/modules/users/model"
 	userPorts "github.com/andreypavlenko/jobber/modules/users/ports"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
@@ -40,6 +41,11 @@ type AuthService struct {
 	refreshExpiry       time.Duration
 	subscriptionCreator SubscriptionCreator
 	logger              *zap.Logger
+
+	// Google sign-in, enabled when all three are set
+	googleProvider   authPorts.OAuthProvider
+	oauthAccountRepo authPorts.OAuthAccountRepository
+	redisClient      *redis.Client
 }
 
 // AuthServiceConfig holds all dependencies for AuthService.
@@ -54,6 +60,13 @@ type AuthServiceConfig struct {
 	RefreshExpiry       time.Duration
 	SubscriptionCreator SubscriptionCreator
 	Logger              *zap.Logger
+
+	// GoogleProvider, OAuthAccountRepo and RedisClient enable signing in with
+	// Google. The CSRF state of each sign-in is kept in Redis until the callback
+	// consumes it.
+	GoogleProvider   authPorts.OAuthProvider
+	OAuthAccountRepo authPorts.OAuthAccountRepository
+	RedisClient      *redis.Client
 }
 
 // NewAuthService creates a new auth service
@@ -73,6 +86,9 @@ func NewAuthService(cfg AuthServiceConfig) *AuthService {
 		refreshExpiry:       cfg.RefreshExpiry,
 		subscriptionCreator: cfg.SubscriptionCreator,
 		logger:              l,
+		googleProvider:      cfg.GoogleProvider,
+		oauthAccountRepo:    cfg.OAuthAccountRepo,
+		redisClient:         cfg.RedisClient,
 	}
 }
 
@@ -89,10 +105,18 @@ func (s *AuthService) Register(ctx context.Context, req *authModel.RegisterReque
 	}
 
 	// Validate password (min 8, max 72 — bcrypt silently truncates beyond 72 bytes)
-	if len(req.Password) < 8 || len(req.Password) > 72 {
+	if len(req.Password) < userModel.MinPasswordLength || len(req.Password) > userModel.MaxPasswordLength {
 		return nil, userModel.ErrInvalidPassword
 	}
 
+	// Default the locale only when it was not sent at all
+	locale := req.Locale
+	if locale == "" {
+		locale = "en"
+	} else if !i18n.ValidateLocale(locale) {
+		return nil, userModel.ErrInvalidLocale
+	}
+
 	// Normalize email
 	emailAddr := strings.ToLower(strings.TrimSpace(req.Email))
 
@@ -108,12 +132,6 @@ func (s *AuthService) Register(ctx context.Context, req *authModel.RegisterReque
 		return nil, err
 	}
 
-	// Set default locale
-	locale := req.Locale
-	if locale == "" {
-		locale = "en"
-	}
-
 	// Default name to email prefix
 	name := strings.Split(emailAddr, "@")[0]
 
@@ -140,8 +158,8 @@ func (s *AuthService) Register(ctx context.Context, req *authModel.RegisterReque
 	return &RegisterResponse{Message: "Please check your email to verify your account"}, nil
 }
 
-// Login authenticates a user
-func (s *AuthService) Login(ctx context.Context, req *authModel.LoginRequest) (*userModel.UserDTO, *authModel.AuthTokens, error) {
+// Login authenticates a user and starts a session for the client
+func (s *AuthService) Login(ctx context.Context, req *authModel.LoginRequest, client authModel.ClientInfo) (*userModel.UserDTO, *authModel.AuthTokens, error) {
 	// Normalize email
 	emailAddr := strings.ToLower(strings.TrimSpace(req.Email))
 
@@ -154,6 +172,11 @@ func (s *AuthService) Login(ctx context.Context, req *authModel.LoginRequest) (*
 		return nil, nil, err
 	}
 
+	// Accounts created through OAuth have no password until the user sets one
+	if user.PasswordHash == "" {
+		return nil, nil, userModel.ErrInvalidCredentials
+	}
+
 	// Verify password
 	if err := auth.VerifyPassword(req.Password, user.PasswordHash); err != nil {
 		return nil, nil, userModel.ErrInvalidCredentials
@@ -165,7 +188,7 @@ func (s *AuthService) Login(ctx context.Context, req *authModel.LoginRequest) (*
 	}
 
 	// Generate tokens
-	tokens, err := s.generateTokens(ctx, user.ID)
+	tokens, err := s.generateTokens(ctx, user.ID, user.Locale, client, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -288,7 +311,7 @@ func (s *AuthService) ForgotPassword(ctx context.Context, emailAddr string) erro
 
 // ResetPassword resets a user's password using email, code, and new password.
 func (s *AuthService) ResetPassword(ctx context.Context, emailAddr, code, newPassword string) error {
-	if len(newPassword) < 8 || len(newPassword) > 72 {
+	if len(newPassword) < userModel.MinPasswordLength || len(newPassword) > userModel.MaxPasswordLength {
 		return userModel.ErrInvalidPassword
 	}
 
@@ -352,23 +375,36 @@ func (s *AuthService) ResetPassword(ctx context.Context, emailAddr, code, newPas
 	return nil
 }
 
-// RefreshTokens refreshes access token using refresh token.
-func (s *AuthService) RefreshTokens(ctx context.Context, refreshTokenString string) (*authModel.AuthTokens, error) {
+// RefreshTokens refreshes access token using refresh token. The refresh token is
+// rotated: it is revoked and replaced by the returned one. Presenting a token that was
+// already rotated revokes its whole family and returns ErrTokenReuseDetected.
+func (s *AuthService) RefreshTokens(ctx context.Context, refreshTokenString string, client authModel.ClientInfo) (*authModel.AuthTokens, error) {
 	claims, err := s.jwtManager.ValidateRefreshToken(refreshTokenString)
 	if err != nil {
 		return nil, errors.New("invalid refresh token")
 	}
 
 	tokenHash := auth.HashToken(refreshTokenString)
-	revoked, err := s.tokenRepo.RevokeIfValid(ctx, tokenHash)
+	stored, err := s.tokenRepo.GetByTokenHash(ctx, tokenHash)
 	if err != nil {
 		return nil, errors.New("invalid refresh token")
 	}
-	if !revoked {
+	if stored.ReplacedBy != nil {
+		return nil, s.revokeTokenFamily(ctx, stored)
+	}
+	if !stored.IsValid() {
 		return nil, errors.New("refresh token expired or revoked")
 	}
 
-	tokens, err := s.generateTokens(ctx, claims.UserID)
+	tokens, err := s.generateTokens(ctx, claims.UserID, claims.Locale, client, stored)
+	if errors.Is(err, authModel.ErrRefreshTokenInactive) {
+		// Another request revoked or rotated the token after it was read; if it
+		// was rotated, the same token has been used twice
+		if current, lookupErr := s.tokenRepo.GetByTokenHash(ctx, tokenHash); lookupErr == nil && current.ReplacedBy != nil {
+			return nil, s.revokeTokenFamily(ctx, current)
+		}
+		return nil, errors.New("refresh token expired or revoked")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -376,26 +412,71 @@ func (s *AuthService) RefreshTokens(ctx context.Context, refreshTokenString stri
 	return tokens, nil
 }
 
+// revokeTokenFamily handles refresh token reuse by revoking the token's whole family.
+// A rotated token being presented again means it was copied and both copies are in
+// use, so every session descended from that login is ended. It returns ErrTokenReuseDetected.
+func (s *AuthService) revokeTokenFamily(ctx context.Context, reused *authModel.RefreshToken) error {
+	s.logger.Warn("security alert: rotated refresh token reused, revoking token family",
+		zap.String("user_id", reused.UserID),
+		zap.String("family_id", reused.FamilyID),
+		zap.Int("generation", reused.Generation))
+
+	if err := s.tokenRepo.RevokeAllInFamily(ctx, reused.FamilyID); err != nil {
+		s.logger.Error("failed to revoke refresh token family", zap.String("family_id", reused.FamilyID), zap.Error(err))
+		sentryPlatform.CaptureError(err, map[string]string{"context": "refresh_token_reuse", "user_id": reused.UserID})
+	}
+	return userModel.ErrTokenReuseDetected
+}
+
 // Logout revokes all refresh tokens for a user
 func (s *AuthService) Logout(ctx context.Context, userID string) error {
 	return s.tokenRepo.RevokeAllForUser(ctx, userID)
 }
 
-// generateTokens generates access and refresh tokens
-func (s *AuthService) generateTokens(ctx context.Context, userID string) (*authModel.AuthTokens, error) {
-	accessToken, err := s.jwtManager.GenerateAccessToken(userID)
+// ListSessions returns the user's active sessions, most recently used first
+func (s *AuthService) ListSessions(ctx context.Context, userID string) ([]*authModel.SessionDTO, error) {
+	return s.tokenRepo.ListActiveSessions(ctx, userID)
+}
+
+// RevokeSession ends one of the user's sessions. Sessions of other users are
+// reported as not found.
+func (s *AuthService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	revoked, err := s.tokenRepo.RevokeSession(ctx, userID, sessionID)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return userModel.ErrSessionNotFound
+	}
+	return nil
+}
+
+// generateTokens generates access and refresh tokens. The refresh token
+// continues the family of parent, or starts a new family when parent is nil.
+func (s *AuthService) generateTokens(ctx context.Context, userID, locale string, client authModel.ClientInfo, parent *authModel.RefreshToken) (*authModel.AuthTokens, error) {
+	accessToken, err := s.jwtManager.GenerateAccessToken(userID, locale)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := s.jwtManager.GenerateRefreshToken(userID)
+	refreshToken, err := s.jwtManager.GenerateRefreshToken(userID, locale)
 	if err != nil {
 		return nil, err
 	}
 
 	tokenHash := auth.HashToken(refreshToken)
-	dbToken := authModel.NewRefreshToken(userID, tokenHash, time.Now().UTC().Add(s.refreshExpiry))
-	if err := s.tokenRepo.Create(ctx, dbToken); err != nil {
+	expiresAt := time.Now().UTC().Add(s.refreshExpiry)
+	if parent == nil {
+		dbToken := authModel.NewRefreshToken(userID, tokenHash, expiresAt)
+		dbToken.Client = client
+		err = s.tokenRepo.Create(ctx, dbToken)
+	} else {
+		// The parent is revoked in the same transaction that stores its replacement
+		dbToken := parent.Rotate(tokenHash, expiresAt)
+		dbToken.Client = client
+		err = s.tokenRepo.Rotate(ctx, parent.TokenHash, dbToken)
+	}
+	if err != nil {
 		return nil, err
 	}
 
@@ -441,10 +522,7 @@ func generateCode() (string, error) {
 	return fmt.Sprintf("%06d", n.Int64()), nil
 }
 
-// emailRegex is compiled once at package level to avoid recompilation on every call.
-var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
-
 // isValidEmail validates email format
-func isValidEmail(email string) bool {
-	return emailRegex.MatchString(email)
+func isValidEmail(address string) bool {
+	return email.IsValidAddress(address)
 }
@@ -12,6 +12,8 @@ import (
 	userModel "github.com/andreypavlenko/jobber/modules/users/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 // MockUserRepository implements userPorts.UserRepository
@@ -23,6 +25,7 @@ type MockUserRepository struct {
 	DeleteFunc             func(ctx context.Context, userID string) error
 	SetEmailVerifiedFunc   func(ctx context.Context, userID string) error
 	UpdatePasswordHashFunc func(ctx context.Context, userID, hash string) error
+	GetByIDEnrichedFunc    func(ctx context.Context, userID string) (*userModel.UserDTO, error)
 }
 
 func (m *MockUserRepository) Create(ctx context.Context, user *userModel.User) error {
@@ -39,6 +42,13 @@ func (m *MockUserRepository) GetByID(ctx context.Context, userID string) (*userM
 	return nil, nil
 }
 
+func (m *MockUserRepository) GetByIDEnriched(ctx context.Context, userID string) (*userModel.UserDTO, error) {
+	if m.GetByIDEnrichedFunc != nil {
+		return m.GetByIDEnrichedFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
 func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*userModel.User, error) {
 	if m.GetByEmailFunc != nil {
 		return m.GetByEmailFunc(ctx, email)
@@ -76,12 +86,15 @@ func (m *MockUserRepository) UpdatePasswordHash(ctx context.Context, userID, has
 
 // MockRefreshTokenRepository implements authPorts.RefreshTokenRepository
 type MockRefreshTokenRepository struct {
-	CreateFunc           func(ctx context.Context, token *authModel.RefreshToken) error
-	GetByTokenHashFunc   func(ctx context.Context, tokenHash string) (*authModel.RefreshToken, error)
-	RevokeFunc           func(ctx context.Context, tokenHash string) error
-	RevokeIfValidFunc    func(ctx context.Context, tokenHash string) (bool, error)
-	RevokeAllForUserFunc func(ctx context.Context, userID string) error
-	DeleteExpiredFunc    func(ctx context.Context) error
+	CreateFunc             func(ctx context.Context, token *authModel.RefreshToken) error
+	GetByTokenHashFunc     func(ctx context.Context, tokenHash string) (*authModel.RefreshToken, error)
+	RevokeFunc             func(ctx context.Context, tokenHash string) error
+	RotateFunc             func(ctx context.Context, oldTokenHash string, next *authModel.RefreshToken) error
+	ListActiveSessionsFunc func(ctx context.Context, userID string) ([]*authModel.SessionDTO, error)
+	RevokeSessionFunc      func(ctx context.Context, userID, sessionID string) (bool, error)
+	RevokeAllForUserFunc   func(ctx context.Context, userID string) error
+	RevokeAllInFamilyFunc  func(ctx context.Context, familyID string) error
+	DeleteExpiredFunc      func(ctx context.Context) error
 }
 
 func (m *MockRefreshTokenRepository) Create(ctx context.Context, token *authModel.RefreshToken) error {
@@ -105,11 +118,11 @@ func (m *MockRefreshTokenRepository) Revoke(ctx context.Context, tokenHash strin
 	return nil
 }
 
-func (m *MockRefreshTokenRepository) RevokeIfValid(ctx context.Context, tokenHash string) (bool, error) {
-	if m.RevokeIfValidFunc != nil {
-		return m.RevokeIfValidFunc(ctx, tokenHash)
+func (m *MockRefreshTokenRepository) Rotate(ctx context.Context, oldTokenHash string, next *authModel.RefreshToken) error {
+	if m.RotateFunc != nil {
+		return m.RotateFunc(ctx, oldTokenHash, next)
 	}
-	return true, nil
+	return nil
 }
 
 func (m *MockRefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID string) error {
@@ -119,6 +132,27 @@ func (m *MockRefreshTokenRepository) RevokeAllForUser(ctx context.Context, userI
 	return nil
 }
 
+func (m *MockRefreshTokenRepository) ListActiveSessions(ctx context.Context, userID string) ([]*authModel.SessionDTO, error) {
+	if m.ListActiveSessionsFunc != nil {
+		return m.ListActiveSessionsFunc(ctx, userID)
+	}
+	return []*authModel.SessionDTO{}, nil
+}
+
+func (m *MockRefreshTokenRepository) RevokeSession(ctx context.Context, userID, sessionID string) (bool, error) {
+	if m.RevokeSessionFunc != nil {
+		return m.RevokeSessionFunc(ctx, userID, sessionID)
+	}
+	return true, nil
+}
+
+func (m *MockRefreshTokenRepository) RevokeAllInFamily(ctx context.Context, familyID string) error {
+	if m.RevokeAllInFamilyFunc != nil {
+		return m.RevokeAllInFamilyFunc(ctx, familyID)
+	}
+	return nil
+}
+
 func (m *MockRefreshTokenRepository) DeleteExpired(ctx context.Context) error {
 	if m.DeleteExpiredFunc != nil {
 		return m.DeleteExpiredFunc(ctx)
@@ -372,6 +406,53 @@ func TestAuthService_Register(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "en", createdUser.Locale)
 	})
+
+	t.Run("rejects an unsupported locale before creating the user", func(t *testing.T) {
+		mockUserRepo := &MockUserRepository{
+			GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+				return nil, userModel.ErrUserNotFound
+			},
+			CreateFunc: func(ctx context.Context, user *userModel.User) error {
+				t.Fatal("user should not be created")
+				return nil
+			},
+		}
+		svc := createTestService(mockUserRepo, &MockRefreshTokenRepository{})
+
+		for _, locale := range []string{"xx-ZZ", "gibberish", " "} {
+			resp, err := svc.Register(context.Background(), &authModel.RegisterRequest{
+				Email:    "test@example.com",
+				Password: "password123",
+				Locale:   locale,
+			})
+
+			assert.Nil(t, resp, locale)
+			assert.ErrorIs(t, err, userModel.ErrInvalidLocale, locale)
+		}
+	})
+
+	t.Run("stores a supported regional locale", func(t *testing.T) {
+		var createdUser *userModel.User
+		mockUserRepo := &MockUserRepository{
+			GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+				return nil, userModel.ErrUserNotFound
+			},
+			CreateFunc: func(ctx context.Context, user *userModel.User) error {
+				createdUser = user
+				return nil
+			},
+		}
+		svc := createTestService(mockUserRepo, &MockRefreshTokenRepository{})
+
+		_, err := svc.Register(context.Background(), &authModel.RegisterRequest{
+			Email:    "test@example.com",
+			Password: "password123",
+			Locale:   "es-MX",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "es-MX", createdUser.Locale)
+	})
 }
 
 func TestAuthService_Login(t *testing.T) {
@@ -407,7 +488,7 @@ func TestAuthService_Login(t *testing.T) {
 			Password: "password123",
 		}
 
-		user, tokens, err := svc.Login(context.Background(), req)
+		user, tokens, err := svc.Login(context.Background(), req, authModel.ClientInfo{})
 
 		require.NoError(t, err)
 		assert.NotNil(t, user)
@@ -437,7 +518,7 @@ func TestAuthService_Login(t *testing.T) {
 			Password: "password123",
 		}
 
-		user, tokens, err := svc.Login(context.Background(), req)
+		user, tokens, err := svc.Login(context.Background(), req, authModel.ClientInfo{})
 
 		assert.Nil(t, user)
 		assert.Nil(t, tokens)
@@ -458,7 +539,7 @@ func TestAuthService_Login(t *testing.T) {
 			Password: "password123",
 		}
 
-		user, tokens, err := svc.Login(context.Background(), req)
+		user, tokens, err := svc.Login(context.Background(), req, authModel.ClientInfo{})
 
 		assert.Nil(t, user)
 		assert.Nil(t, tokens)
@@ -487,7 +568,7 @@ func TestAuthService_Login(t *testing.T) {
 			Password: "wrong-password",
 		}
 
-		user, tokens, err := svc.Login(context.Background(), req)
+		user, tokens, err := svc.Login(context.Background(), req, authModel.ClientInfo{})
 
 		assert.Nil(t, user)
 		assert.Nil(t, tokens)
@@ -523,7 +604,7 @@ func TestAuthService_Login(t *testing.T) {
 			Password: "password123",
 		}
 
-		_, _, err := svc.Login(context.Background(), req)
+		_, _, err := svc.Login(context.Background(), req, authModel.ClientInfo{})
 
 		require.NoError(t, err)
 		assert.Equal(t, "test@example.com", queriedEmail)
@@ -745,31 +826,37 @@ func TestAuthService_ResetPassword(t *testing.T) {
 func TestAuthService_RefreshTokens(t *testing.T) {
 	t.Run("successfully refreshes tokens with valid refresh token", func(t *testing.T) {
 		jwtManager := createTestJWTManager()
-		refreshToken, _ := jwtManager.GenerateRefreshToken("user-123")
+		refreshToken, _ := jwtManager.GenerateRefreshToken("user-123", "en")
 
+		var rotatedHash string
+		var next *authModel.RefreshToken
 		mockTokenRepo := &MockRefreshTokenRepository{
-			RevokeIfValidFunc: func(ctx context.Context, hash string) (bool, error) {
-				return true, nil
+			GetByTokenHashFunc: func(ctx context.Context, hash string) (*authModel.RefreshToken, error) {
+				return authModel.NewRefreshToken("user-123", hash, time.Now().Add(time.Hour)), nil
 			},
-			CreateFunc: func(ctx context.Context, token *authModel.RefreshToken) error {
+			RotateFunc: func(ctx context.Context, oldTokenHash string, token *authModel.RefreshToken) error {
+				rotatedHash, next = oldTokenHash, token
 				return nil
 			},
 		}
 
 		svc := createTestService(&MockUserRepository{}, mockTokenRepo)
 
-		tokens, err := svc.RefreshTokens(context.Background(), refreshToken)
+		tokens, err := svc.RefreshTokens(context.Background(), refreshToken, authModel.ClientInfo{})
 
 		require.NoError(t, err)
 		assert.NotNil(t, tokens)
 		assert.NotEmpty(t, tokens.AccessToken)
 		assert.NotEmpty(t, tokens.RefreshToken)
+		assert.Equal(t, auth.HashToken(refreshToken), rotatedHash)
+		require.NotNil(t, next)
+		assert.Equal(t, auth.HashToken(tokens.RefreshToken), next.TokenHash)
 	})
 
 	t.Run("returns error for invalid refresh token", func(t *testing.T) {
 		svc := createTestService(&MockUserRepository{}, &MockRefreshTokenRepository{})
 
-		tokens, err := svc.RefreshTokens(context.Background(), "invalid-token")
+		tokens, err := svc.RefreshTokens(context.Background(), "invalid-token", authModel.ClientInfo{})
 
 		assert.Nil(t, tokens)
 		assert.Error(t, err)
@@ -778,21 +865,234 @@ func TestAuthService_RefreshTokens(t *testing.T) {
 
 	t.Run("returns error for revoked refresh token", func(t *testing.T) {
 		jwtManager := createTestJWTManager()
-		refreshToken, _ := jwtManager.GenerateRefreshToken("user-123")
+		refreshToken, _ := jwtManager.GenerateRefreshToken("user-123", "en")
 
+		familyRevoked := false
 		mockTokenRepo := &MockRefreshTokenRepository{
-			RevokeIfValidFunc: func(ctx context.Context, hash string) (bool, error) {
-				return false, nil
+			GetByTokenHashFunc: func(ctx context.Context, hash string) (*authModel.RefreshToken, error) {
+				token := authModel.NewRefreshToken("user-123", hash, time.Now().Add(time.Hour))
+				revokedAt := time.Now()
+				token.RevokedAt = &revokedAt
+				return token, nil
+			},
+			RevokeAllInFamilyFunc: func(ctx context.Context, familyID string) error {
+				familyRevoked = true
+				return nil
 			},
 		}
 
 		svc := createTestService(&MockUserRepository{}, mockTokenRepo)
 
-		tokens, err := svc.RefreshTokens(context.Background(), refreshToken)
+		tokens, err := svc.RefreshTokens(context.Background(), refreshToken, authModel.ClientInfo{})
 
 		assert.Nil(t, tokens)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "expired or revoked")
+		assert.False(t, familyRevoked, "a token revoked by logout is not a reuse")
+	})
+}
+
+// memoryTokenStore backs MockRefreshTokenRepository with a map so token
+// rotation can be followed across several refreshes
+type memoryTokenStore struct {
+	tokens map[string]*authModel.RefreshToken
+}
+
+func newMemoryTokenRepo() (*MockRefreshTokenRepository, *memoryTokenStore) {
+	store := &memoryTokenStore{tokens: map[string]*authModel.RefreshToken{}}
+	revoke := func(token *authModel.RefreshToken) {
+		now := time.Now().UTC()
+		token.RevokedAt = &now
+	}
+	repo := &MockRefreshTokenRepository{
+		CreateFunc: func(_ context.Context, token *authModel.RefreshToken) error {
+			stored := *token
+			store.tokens[token.TokenHash] = &stored
+			return nil
+		},
+		GetByTokenHashFunc: func(_ context.Context, hash string) (*authModel.RefreshToken, error) {
+			token, ok := store.tokens[hash]
+			if !ok {
+				return nil, errors.New("token not found")
+			}
+			copied := *token
+			return &copied, nil
+		},
+		RotateFunc: func(_ context.Context, oldTokenHash string, next *authModel.RefreshToken) error {
+			token, ok := store.tokens[oldTokenHash]
+			if !ok || !token.IsValid() {
+				return authModel.ErrRefreshTokenInactive
+			}
+			revoke(token)
+			token.ReplacedBy = &next.TokenHash
+			stored := *next
+			store.tokens[next.TokenHash] = &stored
+			return nil
+		},
+		RevokeAllInFamilyFunc: func(_ context.Context, familyID string) error {
+			for _, token := range store.tokens {
+				if token.FamilyID == familyID && token.RevokedAt == nil {
+					revoke(token)
+				}
+			}
+			return nil
+		},
+	}
+	return repo, store
+}
+
+func (s *memoryTokenStore) get(refreshToken string) *authModel.RefreshToken {
+	return s.tokens[auth.HashToken(refreshToken)]
+}
+
+func TestAuthService_RefreshTokens_ReuseDetection(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (*AuthService, *memoryTokenStore, *observer.ObservedLogs) {
+		tokenRepo, store := newMemoryTokenRepo()
+		core, logs := observer.New(zap.WarnLevel)
+		svc := NewAuthService(AuthServiceConfig{
+			UserRepo:          &MockUserRepository{},
+			TokenRepo:         tokenRepo,
+			VerificationRepo:  &MockEmailVerificationRepository{},
+			PasswordResetRepo: &MockPasswordResetRepository{},
+			EmailSender:       &email.NoopSender{},
+			JWTManager:        createTestJWTManager(),
+			AccessExpiry:      15 * time.Minute,
+			RefreshExpiry:     7 * 24 * time.Hour,
+			Logger:            zap.New(core),
+		})
+		return svc, store, logs
+	}
+
+	t.Run("rotation keeps the family and increments the generation", func(t *testing.T) {
+		svc, store, _ := setup()
+		login, err := svc.generateTokens(ctx, "user-123", "en", authModel.ClientInfo{}, nil)
+		require.NoError(t, err)
+
+		rotated, err := svc.RefreshTokens(ctx, login.RefreshToken, authModel.ClientInfo{})
+		require.NoError(t, err)
+
+		first, second := store.get(login.RefreshToken), store.get(rotated.RefreshToken)
+		require.NotNil(t, second)
+		require.NotNil(t, first.ReplacedBy)
+		assert.Equal(t, second.TokenHash, *first.ReplacedBy)
+		assert.NotEmpty(t, first.FamilyID)
+		assert.Equal(t, first.FamilyID, second.FamilyID)
+		assert.Equal(t, 0, first.Generation)
+		assert.Equal(t, 1, second.Generation)
+		assert.NotNil(t, first.RevokedAt)
+		assert.Nil(t, second.RevokedAt)
+	})
+
+	t.Run("reusing a revoked token revokes the whole family", func(t *testing.T) {
+		svc, store, logs := setup()
+		login, err := svc.generateTokens(ctx, "user-123", "en", authModel.ClientInfo{}, nil)
+		require.NoError(t, err)
+		otherLogin, err := svc.generateTokens(ctx, "user-123", "en", authModel.ClientInfo{}, nil)
+		require.NoError(t, err)
+
+		// The legitimate client rotates twice; the attacker holds the first token
+		second, err := svc.RefreshTokens(ctx, login.RefreshToken, authModel.ClientInfo{})
+		require.NoError(t, err)
+		third, err := svc.RefreshTokens(ctx, second.RefreshToken, authModel.ClientInfo{})
+		require.NoError(t, err)
+
+		tokens, err := svc.RefreshTokens(ctx, login.RefreshToken, authModel.ClientInfo{})
+
+		assert.Nil(t, tokens)
+		assert.ErrorIs(t, err, userModel.ErrTokenReuseDetected)
+		for _, rt := range []string{login.RefreshToken, second.RefreshToken, third.RefreshToken} {
+			assert.NotNil(t, store.get(rt).RevokedAt)
+		}
+		assert.Nil(t, store.get(otherLogin.RefreshToken).RevokedAt, "other sessions are not affected")
+		assert.Equal(t, 1, logs.FilterMessageSnippet("security alert").Len())
+
+		// The newest token of the family no longer works either
+		_, err = svc.RefreshTokens(ctx, third.RefreshToken, authModel.ClientInfo{})
+		assert.Error(t, err)
+	})
+
+	t.Run("a token rotated by a concurrent refresh counts as reused", func(t *testing.T) {
+		svc, store, logs := setup()
+		login, err := svc.generateTokens(ctx, "user-123", "en", authModel.ClientInfo{}, nil)
+		require.NoError(t, err)
+
+		// The token is read as active, then another request rotates it first
+		tokenRepo := svc.tokenRepo.(*MockRefreshTokenRepository)
+		rotate := tokenRepo.RotateFunc
+		tokenRepo.RotateFunc = func(ctx context.Context, oldTokenHash string, next *authModel.RefreshToken) error {
+			tokenRepo.RotateFunc = rotate
+			_, err := svc.RefreshTokens(ctx, login.RefreshToken, authModel.ClientInfo{})
+			require.NoError(t, err)
+			return rotate(ctx, oldTokenHash, next)
+		}
+
+		tokens, err := svc.RefreshTokens(ctx, login.RefreshToken, authModel.ClientInfo{})
+
+		assert.Nil(t, tokens)
+		assert.ErrorIs(t, err, userModel.ErrTokenReuseDetected)
+		for _, token := range store.tokens {
+			assert.NotNil(t, token.RevokedAt)
+		}
+		assert.Equal(t, 1, logs.FilterMessageSnippet("security alert").Len())
+	})
+
+	t.Run("unknown token does not revoke anything", func(t *testing.T) {
+		svc, _, logs := setup()
+		jwtManager := createTestJWTManager()
+		unknown, _ := jwtManager.GenerateRefreshToken("user-123", "en")
+
+		tokens, err := svc.RefreshTokens(ctx, unknown, authModel.ClientInfo{})
+
+		assert.Nil(t, tokens)
+		assert.Error(t, err)
+		assert.Equal(t, 0, logs.Len())
+	})
+}
+
+func TestAuthService_Sessions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("records the client on login and refresh", func(t *testing.T) {
+		tokenRepo, store := newMemoryTokenRepo()
+		svc := createTestService(&MockUserRepository{}, tokenRepo)
+
+		login, err := svc.generateTokens(ctx, "user-123", "en", authModel.ClientInfo{UserAgent: "Firefox", IPAddress: "203.0.113.7"}, nil)
+		require.NoError(t, err)
+		rotated, err := svc.RefreshTokens(ctx, login.RefreshToken, authModel.ClientInfo{UserAgent: "Firefox", IPAddress: "198.51.100.4"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "203.0.113.7", store.get(login.RefreshToken).Client.IPAddress)
+		assert.Equal(t, authModel.ClientInfo{UserAgent: "Firefox", IPAddress: "198.51.100.4"}, store.get(rotated.RefreshToken).Client)
+	})
+
+	t.Run("revokes a session of the user", func(t *testing.T) {
+		var gotUserID, gotSessionID string
+		svc := createTestService(&MockUserRepository{}, &MockRefreshTokenRepository{
+			RevokeSessionFunc: func(_ context.Context, userID, sessionID string) (bool, error) {
+				gotUserID, gotSessionID = userID, sessionID
+				return true, nil
+			},
+		})
+
+		err := svc.RevokeSession(ctx, "user-123", "family-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-123", gotUserID)
+		assert.Equal(t, "family-1", gotSessionID)
+	})
+
+	t.Run("reports an unknown or foreign session as not found", func(t *testing.T) {
+		svc := createTestService(&MockUserRepository{}, &MockRefreshTokenRepository{
+			RevokeSessionFunc: func(_ context.Context, _, _ string) (bool, error) {
+				return false, nil
+			},
+		})
+
+		err := svc.RevokeSession(ctx, "user-123", "family-1")
+
+		assert.ErrorIs(t, err, userModel.ErrSessionNotFound)
 	})
 }
 
@@ -1330,41 +1630,41 @@ func TestAuthService_VerifyEmail_Additional(t *testing.T) {
 // --- RefreshTokens additional edge cases ---
 
 func TestAuthService_RefreshTokens_Additional(t *testing.T) {
-	t.Run("returns error when RevokeIfValid fails", func(t *testing.T) {
+	t.Run("returns error when the token lookup fails", func(t *testing.T) {
 		jwtManager := createTestJWTManager()
-		refreshToken, _ := jwtManager.GenerateRefreshToken("user-123")
+		refreshToken, _ := jwtManager.GenerateRefreshToken("user-123", "en")
 
 		mockTokenRepo := &MockRefreshTokenRepository{
-			RevokeIfValidFunc: func(ctx context.Context, hash string) (bool, error) {
-				return false, errors.New("db error")
+			GetByTokenHashFunc: func(ctx context.Context, hash string) (*authModel.RefreshToken, error) {
+				return nil, errors.New("db error")
 			},
 		}
 
 		svc := createTestService(&MockUserRepository{}, mockTokenRepo)
 
-		tokens, err := svc.RefreshTokens(context.Background(), refreshToken)
+		tokens, err := svc.RefreshTokens(context.Background(), refreshToken, authModel.ClientInfo{})
 
 		assert.Nil(t, tokens)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid refresh token")
 	})
 
-	t.Run("returns error when token store create fails", func(t *testing.T) {
+	t.Run("returns error when token rotation fails", func(t *testing.T) {
 		jwtManager := createTestJWTManager()
-		refreshToken, _ := jwtManager.GenerateRefreshToken("user-123")
+		refreshToken, _ := jwtManager.GenerateRefreshToken("user-123", "en")
 
 		mockTokenRepo := &MockRefreshTokenRepository{
-			RevokeIfValidFunc: func(ctx context.Context, hash string) (bool, error) {
-				return true, nil
+			GetByTokenHashFunc: func(ctx context.Context, hash string) (*authModel.RefreshToken, error) {
+				return authModel.NewRefreshToken("user-123", hash, time.Now().Add(time.Hour)), nil
 			},
-			CreateFunc: func(ctx context.Context, token *authModel.RefreshToken) error {
+			RotateFunc: func(ctx context.Context, oldTokenHash string, next *authModel.RefreshToken) error {
 				return errors.New("token store error")
 			},
 		}
 
 		svc := createTestService(&MockUserRepository{}, mockTokenRepo)
 
-		tokens, err := svc.RefreshTokens(context.Background(), refreshToken)
+		tokens, err := svc.RefreshTokens(context.Background(), refreshToken, authModel.ClientInfo{})
 
 		assert.Nil(t, tokens)
 		assert.Error(t, err)
@@ -1515,7 +1815,7 @@ func TestAuthService_Login_Additional(t *testing.T) {
 			Password: "password123",
 		}
 
-		user, tokens, err := svc.Login(context.Background(), req)
+		user, tokens, err := svc.Login(context.Background(), req, authModel.ClientInfo{})
 
 		assert.Nil(t, user)
 		assert.Nil(t, tokens)
@@ -1549,7 +1849,7 @@ func TestAuthService_Login_Additional(t *testing.T) {
 			Password: "password123",
 		}
 
-		user, tokens, err := svc.Login(context.Background(), req)
+		user, tokens, err := svc.Login(context.Background(), req, authModel.ClientInfo{})
 
 		assert.Nil(t, user)
 		assert.Nil(t, tokens)
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	authModel "github.com/andreypavlenko/jobber/modules/auth/model"
+	userModel "github.com/andreypavlenko/jobber/modules/users/model"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	oauthLoginStateTTL    = 10 * time.Minute
+	oauthLoginStatePrefix = "oauth_login_state:"
+)
+
+// GoogleLoginEnabled reports whether the service was configured for Google sign-in
+func (s *AuthService) GoogleLoginEnabled() bool {
+	return s.googleProvider != nil && s.oauthAccountRepo != nil && s.redisClient != nil
+}
+
+// GoogleLoginURL returns the Google consent screen URL for a new sign-in
+func (s *AuthService) GoogleLoginURL(ctx context.Context) (string, error) {
+	randomBytes := make([]byte, 32)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+	state := hex.EncodeToString(randomBytes)
+
+	if err := s.redisClient.Set(ctx, oauthLoginStatePrefix+state, "1", oauthLoginStateTTL).Err(); err != nil {
+		return "", err
+	}
+	return s.googleProvider.AuthCodeURL(state), nil
+}
+
+// GoogleLogin completes a Google sign-in. The user is found by their linked
+// Google account, then by email; otherwise a user without a password is
+// created. Google must have verified the email, since it is what links the
+// Google account to an existing user.
+func (s *AuthService) GoogleLogin(ctx context.Context, code, state string, client authModel.ClientInfo) (*userModel.UserDTO, *authModel.AuthTokens, error) {
+	// GETDEL makes each state single-use
+	if err := s.redisClient.GetDel(ctx, oauthLoginStatePrefix+state).Err(); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil, userModel.ErrInvalidOAuthState
+		}
+		return nil, nil, err
+	}
+
+	profile, err := s.googleProvider.Exchange(ctx, code)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !profile.EmailVerified {
+		return nil, nil, userModel.ErrOAuthEmailNotVerified
+	}
+
+	user, err := s.oauthUser(ctx, authModel.ProviderGoogle, profile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := s.generateTokens(ctx, user.ID, user.Locale, client, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user.ToDTO(), tokens, nil
+}
+
+// oauthUser returns the user linked to the provider identity, linking or creating one by email
+func (s *AuthService) oauthUser(ctx context.Context, provider string, profile *authModel.OAuthProfile) (*userModel.User, error) {
+	account, err := s.oauthAccountRepo.GetByProviderUserID(ctx, provider, profile.ProviderUserID)
+	if err == nil {
+		return s.userRepo.GetByID(ctx, account.UserID)
+	}
+	if !errors.Is(err, authModel.ErrOAuthAccountNotFound) {
+		return nil, err
+	}
+
+	emailAddr := strings.ToLower(strings.TrimSpace(profile.Email))
+	user, err := s.userRepo.GetByEmail(ctx, emailAddr)
+	switch {
+	case err == nil:
+		if err := s.claimUnverifiedUser(ctx, user); err != nil {
+			return nil, err
+		}
+	case errors.Is(err, userModel.ErrUserNotFound):
+		if user, err = s.createOAuthUser(ctx, emailAddr, profile.Name); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	if err := s.oauthAccountRepo.Create(ctx, &authModel.OAuthAccount{
+		UserID:         user.ID,
+		Provider:       provider,
+		ProviderUserID: profile.ProviderUserID,
+	}); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// claimUnverifiedUser verifies the email of a user who never confirmed it and
+// drops their password: whoever registered it did not prove they own the
+// address, so they must not keep access to the account of the OAuth user
+func (s *AuthService) claimUnverifiedUser(ctx context.Context, user *userModel.User) error {
+	if user.EmailVerified {
+		return nil
+	}
+	if err := s.userRepo.SetEmailVerified(ctx, user.ID); err != nil {
+		return err
+	}
+	if err := s.userRepo.UpdatePasswordHash(ctx, user.ID, ""); err != nil {
+		return err
+	}
+	user.EmailVerified = true
+	user.PasswordHash = ""
+	s.logger.Info("unverified user claimed through oauth sign-in", zap.String("user_id", user.ID))
+	return nil
+}
+
+// createOAuthUser creates a verified user without a password
+func (s *AuthService) createOAuthUser(ctx context.Context, emailAddr, name string) (*userModel.User, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > userModel.MaxNameLength {
+		name = strings.Split(emailAddr, "@")[0]
+	}
+
+	user := userModel.NewUser(emailAddr, name, "", "en")
+	user.EmailVerified = true
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if s.subscriptionCreator != nil {
+		if err := s.subscriptionCreator.EnsureFreeSubscription(ctx, user.ID); err != nil {
+			return nil, fmt.Errorf("failed to create free subscription for user %s: %w", user.ID, err)
+		}
+	}
+	return user, nil
+}
@@ -0,0 +1,455 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/andreypavlenko/jobber/internal/platform/email"
+	authModel "github.com/andreypavlenko/jobber/modules/auth/model"
+	userModel "github.com/andreypavlenko/jobber/modules/users/model"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockOAuthProvider implements authPorts.OAuthProvider
+type MockOAuthProvider struct {
+	ExchangeFunc func(ctx context.Context, code string) (*authModel.OAuthProfile, error)
+}
+
+func (m *MockOAuthProvider) AuthCodeURL(state string) string {
+	return "https://accounts.example.com/auth?state=" + url.QueryEscape(state)
+}
+
+func (m *MockOAuthProvider) Exchange(ctx context.Context, code string) (*authModel.OAuthProfile, error) {
+	if m.ExchangeFunc != nil {
+		return m.ExchangeFunc(ctx, code)
+	}
+	return nil, errors.New("not implemented")
+}
+
+// MockOAuthAccountRepository implements authPorts.OAuthAccountRepository
+type MockOAuthAccountRepository struct {
+	CreateFunc              func(ctx context.Context, account *authModel.OAuthAccount) error
+	GetByProviderUserIDFunc func(ctx context.Context, provider, providerUserID string) (*authModel.OAuthAccount, error)
+}
+
+func (m *MockOAuthAccountRepository) Create(ctx context.Context, account *authModel.OAuthAccount) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, account)
+	}
+	return nil
+}
+
+func (m *MockOAuthAccountRepository) GetByProviderUserID(ctx context.Context, provider, providerUserID string) (*authModel.OAuthAccount, error) {
+	if m.GetByProviderUserIDFunc != nil {
+		return m.GetByProviderUserIDFunc(ctx, provider, providerUserID)
+	}
+	return nil, authModel.ErrOAuthAccountNotFound
+}
+
+func TestAuthService_GoogleLogin(t *testing.T) {
+	profile := &authModel.OAuthProfile{ProviderUserID: "google-1", Email: "Ann@Example.com", EmailVerified: true, Name: "Ann Lee"}
+
+	setup := func(t *testing.T, userRepo *MockUserRepository, accountRepo *MockOAuthAccountRepository) (*AuthService, string) {
+		t.Helper()
+		mr := miniredis.RunT(t)
+		svc := NewAuthService(AuthServiceConfig{
+			UserRepo:          userRepo,
+			TokenRepo:         &MockRefreshTokenRepository{},
+			VerificationRepo:  &MockEmailVerificationRepository{},
+			PasswordResetRepo: &MockPasswordResetRepository{},
+			EmailSender:       &email.NoopSender{},
+			JWTManager:        createTestJWTManager(),
+			AccessExpiry:      15 * time.Minute,
+			RefreshExpiry:     7 * 24 * time.Hour,
+			GoogleProvider: &MockOAuthProvider{
+				ExchangeFunc: func(ctx context.Context, code string) (*authModel.OAuthProfile, error) {
+					assert.Equal(t, "auth-code", code)
+					return profile, nil
+				},
+			},
+			OAuthAccountRepo: accountRepo,
+			RedisClient:      redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+		})
+
+		loginURL, err := svc.GoogleLoginURL(context.Background())
+		require.NoError(t, err)
+		parsed, err := url.Parse(loginURL)
+		require.NoError(t, err)
+		state := parsed.Query().Get("state")
+		require.Len(t, state, 64)
+		return svc, state
+	}
+
+	t.Run("signs in the user linked to the Google account", func(t *testing.T) {
+		userRepo := &MockUserRepository{
+			GetByIDFunc: func(ctx context.Context, userID string) (*userModel.User, error) {
+				return &userModel.User{ID: userID, Email: "ann@example.com", Locale: "es", EmailVerified: true}, nil
+			},
+			GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+				t.Fatal("linked accounts are not looked up by email")
+				return nil, nil
+			},
+		}
+		accountRepo := &MockOAuthAccountRepository{
+			GetByProviderUserIDFunc: func(ctx context.Context, provider, providerUserID string) (*authModel.OAuthAccount, error) {
+				assert.Equal(t, authModel.ProviderGoogle, provider)
+				assert.Equal(t, "google-1", providerUserID)
+				return &authModel.OAuthAccount{UserID: "user-1"}, nil
+			},
+			CreateFunc: func(ctx context.Context, account *authModel.OAuthAccount) error {
+				t.Fatal("an existing link is not created again")
+				return nil
+			},
+		}
+		svc, state := setup(t, userRepo, accountRepo)
+
+		user, tokens, err := svc.GoogleLogin(context.Background(), "auth-code", state, authModel.ClientInfo{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", user.ID)
+		assert.NotEmpty(t, tokens.AccessToken)
+		assert.NotEmpty(t, tokens.RefreshToken)
+	})
+
+	t.Run("creates a verified user without a password", func(t *testing.T) {
+		var created *userModel.User
+		userRepo := &MockUserRepository{
+			GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+				assert.Equal(t, "ann@example.com", email)
+				return nil, userModel.ErrUserNotFound
+			},
+			CreateFunc: func(ctx context.Context, user *userModel.User) error {
+				user.ID = "user-new"
+				created = user
+				return nil
+			},
+		}
+		var linked *authModel.OAuthAccount
+		accountRepo := &MockOAuthAccountRepository{
+			CreateFunc: func(ctx context.Context, account *authModel.OAuthAccount) error {
+				linked = account
+				return nil
+			},
+		}
+		svc, state := setup(t, userRepo, accountRepo)
+		subscriptions := 0
+		svc.subscriptionCreator = &MockSubscriptionCreator{EnsureFreeSubscriptionFunc: func(ctx context.Context, userID string) error {
+			subscriptions++
+			return nil
+		}}
+
+		user, _, err := svc.GoogleLogin(context.Background(), "auth-code", state, authModel.ClientInfo{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-new", user.ID)
+		require.NotNil(t, created)
+		assert.Equal(t, "ann@example.com", created.Email)
+		assert.Equal(t, "Ann Lee", created.Name)
+		assert.Empty(t, created.PasswordHash)
+		assert.True(t, created.EmailVerified)
+		assert.Equal(t, 1, subscriptions)
+		assert.Equal(t, &authModel.OAuthAccount{UserID: "user-new", Provider: authModel.ProviderGoogle, ProviderUserID: "google-1"}, linked)
+	})
+
+	t.Run("links an existing user with the same email", func(t *testing.T) {
+		userRepo := &MockUserRepository{
+			GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+				return &userModel.User{ID: "user-1", Email: email, PasswordHash: "hash", EmailVerified: true}, nil
+			},
+			CreateFunc: func(ctx context.Context, user *userModel.User) error {
+				t.Fatal("no user should be created")
+				return nil
+			},
+			UpdatePasswordHashFunc: func(ctx context.Context, userID, hash string) error {
+				t.Fatal("a verified user keeps their password")
+				return nil
+			},
+		}
+		var linked *authModel.OAuthAccount
+		svc, state := setup(t, userRepo, &MockOAuthAccountRepository{
+			CreateFunc: func(ctx context.Context, account *authModel.OAuthAccount) error {
+				linked = account
+				return nil
+			},
+		})
+
+		user, _, err := svc.GoogleLogin(context.Background(), "auth-code", state, authModel.ClientInfo{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", user.ID)
+		require.NotNil(t, linked)
+		assert.Equal(t, "user-1", linked.UserID)
+	})
+
+	t.Run("drops the password of an unverified user it links", func(t *testing.T) {
+		verified, clearedHash := false, "unchanged"
+		userRepo := &MockUserRepository{
+			GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+				return &userModel.User{ID: "user-1", Email: email, PasswordHash: "someone-elses-hash"}, nil
+			},
+			SetEmailVerifiedFunc: func(ctx context.Context, userID string) error {
+				verified = true
+				return nil
+			},
+			UpdatePasswordHashFunc: func(ctx context.Context, userID, hash string) error {
+				clearedHash = hash
+				return nil
+			},
+		}
+		svc, state := setup(t, userRepo, &MockOAuthAccountRepository{})
+
+		_, _, err := svc.GoogleLogin(context.Background(), "auth-code", state, authModel.ClientInfo{})
+
+		require.NoError(t, err)
+		assert.True(t, verified)
+		assert.Empty(t, clearedHash)
+	})
+
+	t.Run("rejects an email Google has not verified", func(t *testing.T) {
+		svc, state := setup(t, &MockUserRepository{}, &MockOAuthAccountRepository{})
+		svc.googleProvider = &MockOAuthProvider{ExchangeFunc: func(ctx context.Context, code string) (*authModel.OAuthProfile, error) {
+			return &authModel.OAuthProfile{ProviderUserID: "google-1", Email: "ann@example.com"}, nil
+		}}
+
+		_, _, err := svc.GoogleLogin(context.Background(), "auth-code", state, authModel.ClientInfo{})
+
+		assert.ErrorIs(t, err, userModel.ErrOAuthEmailNotVerified)
+	})
+
+	t.Run("rejects an unknown state", func(t *testing.T) {
+		svc, _ := setup(t, &MockUserRepository{}, &MockOAuthAccountRepository{})
+
+		_, _, err := svc.GoogleLogin(context.Background(), "auth-code", "forged-state", authModel.ClientInfo{})
+
+		assert.ErrorIs(t, err, userModel.ErrInvalidOAuthState)
+	})
+
+	t.Run("accepts each state once", func(t *testing.T) {
+		userRepo := &MockUserRepository{
+			GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+				return &userModel.User{ID: "user-1", Email: email, EmailVerified: true}, nil
+			},
+		}
+		svc, state := setup(t, userRepo, &MockOAuthAccountRepository{})
+
+		_, _, err := svc.GoogleLogin(context.Background(), "auth-code", state, authModel.ClientInfo{})
+		require.NoError(t, err)
+		_, _, err = svc.GoogleLogin(context.Background(), "auth-code", state, authModel.ClientInfo{})
+
+		assert.ErrorIs(t, err, userModel.ErrInvalidOAuthState)
+	})
+
+	t.Run("returns provider errors", func(t *testing.T) {
+		svc, state := setup(t, &MockUserRepository{}, &MockOAuthAccountRepository{})
+		svc.googleProvider = &MockOAuthProvider{ExchangeFunc: func(ctx context.Context, code string) (*authModel.OAuthProfile, error) {
+			return nil, userModel.ErrOAuthProvider
+		}}
+
+		_, _, err := svc.GoogleLogin(context.Background(), "auth-code", state, authModel.ClientInfo{})
+
+		assert.ErrorIs(t, err, userModel.ErrOAuthProvider)
+	})
+
+	t.Run("falls back to the email name for a missing profile name", func(t *testing.T) {
+		var created *userModel.User
+		userRepo := &MockUserRepository{
+			GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+				return nil, userModel.ErrUserNotFound
+			},
+			CreateFunc: func(ctx context.Context, user *userModel.User) error {
+				created = user
+				return nil
+			},
+		}
+		svc, state := setup(t, userRepo, &MockOAuthAccountRepository{})
+		svc.googleProvider = &MockOAuthProvider{ExchangeFunc: func(ctx context.Context, code string) (*authModel.OAuthProfile, error) {
+			return &authModel.OAuthProfile{ProviderUserID: "google-1", Email: "ann@example.com", EmailVerified: true, Name: "  "}, nil
+		}}
+
+		_, _, err := svc.GoogleLogin(context.Background(), "auth-code", state, authModel.ClientInfo{})
+
+		require.NoError(t, err)
+		require.NotNil(t, created)
+		assert.Equal(t, "ann", created.Name)
+	})
+
+	repoErr := errors.New("database error")
+
+	failureTests := []struct {
+		name        string
+		userRepo    *MockUserRepository
+		accountRepo *MockOAuthAccountRepository
+		subErr      error
+	}{
+		{
+			name:     "account lookup fails",
+			userRepo: &MockUserRepository{},
+			accountRepo: &MockOAuthAccountRepository{
+				GetByProviderUserIDFunc: func(ctx context.Context, provider, providerUserID string) (*authModel.OAuthAccount, error) {
+					return nil, repoErr
+				},
+			},
+		},
+		{
+			name: "linked user lookup fails",
+			userRepo: &MockUserRepository{
+				GetByIDFunc: func(ctx context.Context, userID string) (*userModel.User, error) {
+					return nil, repoErr
+				},
+			},
+			accountRepo: &MockOAuthAccountRepository{
+				GetByProviderUserIDFunc: func(ctx context.Context, provider, providerUserID string) (*authModel.OAuthAccount, error) {
+					return &authModel.OAuthAccount{UserID: "user-1"}, nil
+				},
+			},
+		},
+		{
+			name: "email lookup fails",
+			userRepo: &MockUserRepository{
+				GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+					return nil, repoErr
+				},
+			},
+			accountRepo: &MockOAuthAccountRepository{},
+		},
+		{
+			name: "verifying an unverified user fails",
+			userRepo: &MockUserRepository{
+				GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+					return &userModel.User{ID: "user-1", Email: email, PasswordHash: "hash"}, nil
+				},
+				SetEmailVerifiedFunc: func(ctx context.Context, userID string) error {
+					return repoErr
+				},
+			},
+			accountRepo: &MockOAuthAccountRepository{},
+		},
+		{
+			name: "dropping the password of an unverified user fails",
+			userRepo: &MockUserRepository{
+				GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+					return &userModel.User{ID: "user-1", Email: email, PasswordHash: "hash"}, nil
+				},
+				UpdatePasswordHashFunc: func(ctx context.Context, userID, hash string) error {
+					return repoErr
+				},
+			},
+			accountRepo: &MockOAuthAccountRepository{},
+		},
+		{
+			name: "creating the user fails",
+			userRepo: &MockUserRepository{
+				GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+					return nil, userModel.ErrUserNotFound
+				},
+				CreateFunc: func(ctx context.Context, user *userModel.User) error {
+					return repoErr
+				},
+			},
+			accountRepo: &MockOAuthAccountRepository{},
+		},
+		{
+			name: "creating the free subscription fails",
+			userRepo: &MockUserRepository{
+				GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+					return nil, userModel.ErrUserNotFound
+				},
+			},
+			accountRepo: &MockOAuthAccountRepository{},
+			subErr:      repoErr,
+		},
+		{
+			name: "linking the account fails",
+			userRepo: &MockUserRepository{
+				GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+					return &userModel.User{ID: "user-1", Email: email, EmailVerified: true}, nil
+				},
+			},
+			accountRepo: &MockOAuthAccountRepository{
+				CreateFunc: func(ctx context.Context, account *authModel.OAuthAccount) error {
+					return repoErr
+				},
+			},
+		},
+	}
+
+	for _, tt := range failureTests {
+		t.Run("returns an error when "+tt.name, func(t *testing.T) {
+			svc, state := setup(t, tt.userRepo, tt.accountRepo)
+			if tt.subErr != nil {
+				svc.subscriptionCreator = &MockSubscriptionCreator{EnsureFreeSubscriptionFunc: func(ctx context.Context, userID string) error {
+					return tt.subErr
+				}}
+			}
+
+			user, tokens, err := svc.GoogleLogin(context.Background(), "auth-code", state, authModel.ClientInfo{})
+
+			assert.ErrorIs(t, err, repoErr)
+			assert.Nil(t, user)
+			assert.Nil(t, tokens)
+		})
+	}
+}
+
+func TestAuthService_GoogleLogin_Redis(t *testing.T) {
+	newService := func(t *testing.T) (*AuthService, *miniredis.Miniredis) {
+		t.Helper()
+		mr := miniredis.RunT(t)
+		svc := NewAuthService(AuthServiceConfig{
+			UserRepo:         &MockUserRepository{},
+			TokenRepo:        &MockRefreshTokenRepository{},
+			JWTManager:       createTestJWTManager(),
+			GoogleProvider:   &MockOAuthProvider{},
+			OAuthAccountRepo: &MockOAuthAccountRepository{},
+			RedisClient:      redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+		})
+		return svc, mr
+	}
+
+	t.Run("is enabled only when fully configured", func(t *testing.T) {
+		svc, _ := newService(t)
+		assert.True(t, svc.GoogleLoginEnabled())
+
+		svc.redisClient = nil
+		assert.False(t, svc.GoogleLoginEnabled())
+	})
+
+	t.Run("login URL fails when the state cannot be stored", func(t *testing.T) {
+		svc, mr := newService(t)
+		mr.SetError("connection refused")
+
+		loginURL, err := svc.GoogleLoginURL(context.Background())
+
+		assert.Error(t, err)
+		assert.Empty(t, loginURL)
+	})
+
+	t.Run("login fails when the state cannot be read", func(t *testing.T) {
+		svc, mr := newService(t)
+		mr.SetError("connection refused")
+
+		_, _, err := svc.GoogleLogin(context.Background(), "auth-code", "state", authModel.ClientInfo{})
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, userModel.ErrInvalidOAuthState)
+	})
+}
+
+func TestAuthService_Login_PasswordlessUser(t *testing.T) {
+	userRepo := &MockUserRepository{
+		GetByEmailFunc: func(ctx context.Context, email string) (*userModel.User, error) {
+			return &userModel.User{ID: "user-1", Email: email, PasswordHash: "", EmailVerified: true}, nil
+		},
+	}
+	svc := createTestService(userRepo, &MockRefreshTokenRepository{})
+
+	_, _, err := svc.Login(context.Background(), &authModel.LoginRequest{Email: "ann@example.com", Password: ""}, authModel.ClientInfo{})
+
+	assert.ErrorIs(t, err, userModel.ErrInvalidCredentials)
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	authModel "github.com/andreypavlenko/jobber/modules/auth/model"
+	userModel "github.com/andreypavlenko/jobber/modules/users/model"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+// googleUserInfoURL is Google's OpenID Connect userinfo endpoint
+const googleUserInfoURL = "https://openidconnect.googleapis.com/v1/userinfo"
+
+// GoogleProvider implements ports.OAuthProvider for signing in with Google
+type GoogleProvider struct {
+	oauthConfig *oauth2.Config
+	userInfoURL string
+}
+
+// NewGoogleProvider creates a Google sign-in provider that asks for the user's email and profile
+func NewGoogleProvider(clientID, clientSecret, redirectURL string) *GoogleProvider {
+	return &GoogleProvider{
+		oauthConfig: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       []string{"openid", "email", "profile"},
+			Endpoint:     google.Endpoint,
+		},
+		userInfoURL: googleUserInfoURL,
+	}
+}
+
+// AuthCodeURL returns the Google consent screen URL
+func (p *GoogleProvider) AuthCodeURL(state string) string {
+	return p.oauthConfig.AuthCodeURL(state)
+}
+
+// Exchange trades the authorization code for a token and reads the user's profile with it
+func (p *GoogleProvider) Exchange(ctx context.Context, code string) (*authModel.OAuthProfile, error) {
+	token, err := p.oauthConfig.Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", userModel.ErrOAuthProvider, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.userInfoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.oauthConfig.Client(ctx, token).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", userModel.ErrOAuthProvider, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: userinfo returned status %d", userModel.ErrOAuthProvider, resp.StatusCode)
+	}
+
+	var info struct {
+		Sub           string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("%w: %v", userModel.ErrOAuthProvider, err)
+	}
+	if info.Sub == "" || info.Email == "" {
+		return nil, fmt.Errorf("%w: userinfo is missing sub or email", userModel.ErrOAuthProvider)
+	}
+
+	return &authModel.OAuthProfile{
+		ProviderUserID: info.Sub,
+		Email:          info.Email,
+		EmailVerified:  info.EmailVerified,
+		Name:           info.Name,
+	}, nil
+}
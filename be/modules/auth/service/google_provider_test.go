@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	userModel "github.com/andreypavlenko/jobber/modules/users/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func newTestGoogleProvider(t *testing.T, userInfo http.HandlerFunc) *GoogleProvider {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if r.Form.Get("code") != "auth-code" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"access-token","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/userinfo", userInfo)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	provider := NewGoogleProvider("client-id", "client-secret", "https://api.example.com/api/v1/auth/google/callback")
+	provider.oauthConfig.Endpoint = oauth2.Endpoint{AuthURL: server.URL + "/auth", TokenURL: server.URL + "/token"}
+	provider.userInfoURL = server.URL + "/userinfo"
+	return provider
+}
+
+func TestGoogleProvider_AuthCodeURL(t *testing.T) {
+	provider := NewGoogleProvider("client-id", "client-secret", "https://api.example.com/callback")
+
+	authURL := provider.AuthCodeURL("state-1")
+
+	assert.Contains(t, authURL, "https://accounts.google.com/")
+	assert.Contains(t, authURL, "state=state-1")
+	assert.Contains(t, authURL, "scope=openid+email+profile")
+}
+
+func TestGoogleProvider_Exchange(t *testing.T) {
+	t.Run("returns the signed-in profile", func(t *testing.T) {
+		provider := newTestGoogleProvider(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"sub":"google-1","email":"ann@example.com","email_verified":true,"name":"Ann Lee"}`))
+		})
+
+		profile, err := provider.Exchange(context.Background(), "auth-code")
+
+		require.NoError(t, err)
+		assert.Equal(t, "google-1", profile.ProviderUserID)
+		assert.Equal(t, "ann@example.com", profile.Email)
+		assert.True(t, profile.EmailVerified)
+		assert.Equal(t, "Ann Lee", profile.Name)
+	})
+
+	t.Run("reports a rejected code as a provider error", func(t *testing.T) {
+		provider := newTestGoogleProvider(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("userinfo should not be requested")
+		})
+
+		_, err := provider.Exchange(context.Background(), "bad-code")
+
+		assert.ErrorIs(t, err, userModel.ErrOAuthProvider)
+	})
+
+	t.Run("reports a failed userinfo request as a provider error", func(t *testing.T) {
+		provider := newTestGoogleProvider(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+
+		_, err := provider.Exchange(context.Background(), "auth-code")
+
+		assert.ErrorIs(t, err, userModel.ErrOAuthProvider)
+	})
+
+	t.Run("requires the subject and email", func(t *testing.T) {
+		provider := newTestGoogleProvider(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"sub":"google-1"}`))
+		})
+
+		_, err := provider.Exchange(context.Background(), "auth-code")
+
+		assert.ErrorIs(t, err, userModel.ErrOAuthProvider)
+	})
+}
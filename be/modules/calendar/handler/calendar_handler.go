@@ -83,7 +83,7 @@ func (h *CalendarHandler) Disconnect(c *gin.Context) {
 
 	if err := h.service.Disconnect(c.Request.Context(), userID); err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusInternalServerError
 		if errorCode == model.CodeNotConnected {
@@ -114,7 +114,7 @@ func (h *CalendarHandler) CreateEvent(c *gin.Context) {
 	event, err := h.service.CreateEvent(c.Request.Context(), userID, &req)
 	if err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusInternalServerError
 		switch errorCode {
@@ -151,7 +151,7 @@ func (h *CalendarHandler) DeleteEvent(c *gin.Context) {
 
 	if err := h.service.DeleteEvent(c.Request.Context(), userID, stageID); err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusInternalServerError
 		switch errorCode {
@@ -6,12 +6,20 @@ import (
 
 	"github.com/andreypavlenko/jobber/modules/calendar/model"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
 // StageRepository implements ports.CalendarStageRepository
 type StageRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 // NewStageRepository creates a new stage repository for calendar operations
@@ -19,6 +27,11 @@ func NewStageRepository(pool *pgxpool.Pool) *StageRepository {
 	return &StageRepository{pool: pool}
 }
 
+// NewStageRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewStageRepositoryWithPool(pool DBPool) *StageRepository {
+	return &StageRepository{pool: pool}
+}
+
 // SetCalendarEventID sets the calendar event ID on a stage
 func (r *StageRepository) SetCalendarEventID(ctx context.Context, stageID, eventID string) error {
 	query := `UPDATE application_stages SET calendar_event_id = $2 WHERE id = $1`
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/calendar/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStageRepository_SetCalendarEventID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`UPDATE application_stages SET calendar_event_id = \$2 WHERE id = \$1`).
+			WithArgs("stage-1", "event-1").
+			WillReturnResult(pgxmock.NewResult("UPDATE", result))
+	}
+	mock.ExpectExec("UPDATE application_stages").
+		WithArgs("stage-1", "event-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewStageRepositoryWithPool(mock)
+	assert.NoError(t, repo.SetCalendarEventID(context.Background(), "stage-1", "event-1"))
+	assert.ErrorIs(t, repo.SetCalendarEventID(context.Background(), "stage-1", "event-1"), model.ErrStageNotFound)
+	assert.ErrorIs(t, repo.SetCalendarEventID(context.Background(), "stage-1", "event-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStageRepository_ClearCalendarEventID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`UPDATE application_stages SET calendar_event_id = NULL WHERE id = \$1`).
+			WithArgs("stage-1").
+			WillReturnResult(pgxmock.NewResult("UPDATE", result))
+	}
+	mock.ExpectExec("UPDATE application_stages").
+		WithArgs("stage-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewStageRepositoryWithPool(mock)
+	assert.NoError(t, repo.ClearCalendarEventID(context.Background(), "stage-1"))
+	assert.ErrorIs(t, repo.ClearCalendarEventID(context.Background(), "stage-1"), model.ErrStageNotFound)
+	assert.ErrorIs(t, repo.ClearCalendarEventID(context.Background(), "stage-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStageRepository_GetCalendarEventID(t *testing.T) {
+	eventID := "event-1"
+	empty := ""
+
+	tests := []struct {
+		name    string
+		setup   func(q *pgxmock.ExpectedQuery)
+		want    string
+		wantErr error
+	}{
+		{
+			name: "returns the linked event",
+			setup: func(q *pgxmock.ExpectedQuery) {
+				q.WillReturnRows(pgxmock.NewRows([]string{"calendar_event_id"}).AddRow(&eventID))
+			},
+			want: eventID,
+		},
+		{
+			name: "reports a stage without an event",
+			setup: func(q *pgxmock.ExpectedQuery) {
+				q.WillReturnRows(pgxmock.NewRows([]string{"calendar_event_id"}).AddRow(nil))
+			},
+			wantErr: model.ErrEventNotFound,
+		},
+		{
+			name: "treats an empty event id as missing",
+			setup: func(q *pgxmock.ExpectedQuery) {
+				q.WillReturnRows(pgxmock.NewRows([]string{"calendar_event_id"}).AddRow(&empty))
+			},
+			wantErr: model.ErrEventNotFound,
+		},
+		{
+			name:    "maps missing rows to ErrStageNotFound",
+			setup:   func(q *pgxmock.ExpectedQuery) { q.WillReturnError(pgx.ErrNoRows) },
+			wantErr: model.ErrStageNotFound,
+		},
+		{
+			name:    "propagates query errors",
+			setup:   func(q *pgxmock.ExpectedQuery) { q.WillReturnError(assert.AnError) },
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setup(mock.ExpectQuery(`SELECT calendar_event_id FROM application_stages WHERE id = \$1`).WithArgs("stage-1"))
+
+			got, err := NewStageRepositoryWithPool(mock).GetCalendarEventID(context.Background(), "stage-1")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestStageRepository_GetStageUserID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`JOIN applications a ON a.id = s.application_id\s+WHERE s.id = \$1`).
+		WithArgs("stage-1").
+		WillReturnRows(pgxmock.NewRows([]string{"user_id"}).AddRow("user-1"))
+	mock.ExpectQuery("FROM application_stages s").
+		WithArgs("stage-1").
+		WillReturnError(pgx.ErrNoRows)
+	mock.ExpectQuery("FROM application_stages s").
+		WithArgs("stage-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewStageRepositoryWithPool(mock)
+	userID, err := repo.GetStageUserID(context.Background(), "stage-1")
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", userID)
+
+	_, err = repo.GetStageUserID(context.Background(), "stage-1")
+	assert.ErrorIs(t, err, model.ErrStageNotFound)
+
+	_, err = repo.GetStageUserID(context.Background(), "stage-1")
+	assert.ErrorIs(t, err, assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -13,7 +13,7 @@ import (
 
 // TokenRepository implements ports.CalendarTokenRepository
 type TokenRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 // NewTokenRepository creates a new token repository
@@ -21,6 +21,11 @@ func NewTokenRepository(pool *pgxpool.Pool) *TokenRepository {
 	return &TokenRepository{pool: pool}
 }
 
+// NewTokenRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewTokenRepositoryWithPool(pool DBPool) *TokenRepository {
+	return &TokenRepository{pool: pool}
+}
+
 // Upsert inserts or updates a calendar token for a user
 func (r *TokenRepository) Upsert(ctx context.Context, token *model.CalendarToken) error {
 	now := time.Now().UTC()
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/calendar/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenRepository_Upsert(t *testing.T) {
+	t.Run("keeps an existing id", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`INSERT INTO google_calendar_tokens .*ON CONFLICT \(user_id\)`).
+			WithArgs("token-1", "user-1", "blob", "nonce", pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		token := &model.CalendarToken{ID: "token-1", UserID: "user-1", TokenBlob: "blob", TokenNonce: "nonce"}
+		err = NewTokenRepositoryWithPool(mock).Upsert(context.Background(), token)
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("generates an id for a new token", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("INSERT INTO google_calendar_tokens").
+			WithArgs(pgxmock.AnyArg(), "user-1", "blob", "nonce", pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnError(assert.AnError)
+
+		token := &model.CalendarToken{UserID: "user-1", TokenBlob: "blob", TokenNonce: "nonce"}
+		err = NewTokenRepositoryWithPool(mock).Upsert(context.Background(), token)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTokenRepository_GetByUserID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`FROM google_calendar_tokens\s+WHERE user_id = \$1`).
+		WithArgs("user-1").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "token_blob", "token_nonce", "created_at", "updated_at"}).
+			AddRow("token-1", "user-1", "blob", "nonce", now, now))
+	mock.ExpectQuery("FROM google_calendar_tokens").
+		WithArgs("user-1").
+		WillReturnError(pgx.ErrNoRows)
+	mock.ExpectQuery("FROM google_calendar_tokens").
+		WithArgs("user-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewTokenRepositoryWithPool(mock)
+	token, err := repo.GetByUserID(context.Background(), "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, "blob", token.TokenBlob)
+	assert.Equal(t, "nonce", token.TokenNonce)
+
+	token, err = repo.GetByUserID(context.Background(), "user-1")
+	assert.Nil(t, token)
+	assert.ErrorIs(t, err, model.ErrNotConnected)
+
+	_, err = repo.GetByUserID(context.Background(), "user-1")
+	assert.ErrorIs(t, err, assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRepository_Delete(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`DELETE FROM google_calendar_tokens WHERE user_id = \$1`).
+			WithArgs("user-1").
+			WillReturnResult(pgxmock.NewResult("DELETE", result))
+	}
+	mock.ExpectExec("DELETE FROM google_calendar_tokens").
+		WithArgs("user-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewTokenRepositoryWithPool(mock)
+	assert.NoError(t, repo.Delete(context.Background(), "user-1"))
+	assert.ErrorIs(t, repo.Delete(context.Background(), "user-1"), model.ErrNotConnected)
+	assert.ErrorIs(t, repo.Delete(context.Background(), "user-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"net/http"
 
 	"github.com/andreypavlenko/jobber/internal/platform/auth"
@@ -48,7 +50,7 @@ func (h *CommentHandler) Create(c *gin.Context) {
 		errorCode := string(model.CodeInternalError)
 		errorMessage := "Failed to create comment"
 		
-		if err == model.ErrContentRequired {
+		if errors.Is(err, model.ErrContentRequired) {
 			statusCode = http.StatusBadRequest
 			errorCode = string(model.CodeContentRequired)
 			errorMessage = "Content is required"
@@ -67,6 +69,7 @@ func (h *CommentHandler) Create(c *gin.Context) {
 // @Security BearerAuth
 // @Produce json
 // @Param id path string true "Application ID"
+// @Param sort_dir query string false "Sort direction by creation time: asc, desc (default: asc)"
 // @Success 200 {object} []model.CommentDTO
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 500 {object} httpPlatform.ErrorResponse
@@ -78,7 +81,12 @@ func (h *CommentHandler) ListByApplication(c *gin.Context) {
 	}
 	appID := c.Param("id")
 
-	comments, err := h.service.ListByApplication(c.Request.Context(), appID, userID)
+	listComments := h.service.ListByApplication
+	if c.DefaultQuery("sort_dir", "asc") == "desc" {
+		listComments = h.service.ListByApplicationNewestFirst
+	}
+
+	comments, err := listComments(c.Request.Context(), appID, userID)
 	if err != nil {
 		httpPlatform.RespondWithError(c, http.StatusInternalServerError, string(model.CodeInternalError), "Failed to list comments")
 		return
@@ -110,7 +118,7 @@ func (h *CommentHandler) Delete(c *gin.Context) {
 		errorCode := string(model.CodeInternalError)
 		errorMessage := "Failed to delete comment"
 		
-		if err == model.ErrCommentNotFound {
+		if errors.Is(err, model.ErrCommentNotFound) {
 			statusCode = http.StatusNotFound
 			errorCode = string(model.CodeCommentNotFound)
 			errorMessage = "Comment not found"
@@ -122,12 +130,65 @@ func (h *CommentHandler) Delete(c *gin.Context) {
 	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Comment deleted successfully"})
 }
 
+// Pin godoc
+// @Summary Pin a comment
+// @Description Pin a comment so it is listed before the other comments of its application
+// @Tags comments
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Comment ID"
+// @Success 200 {object} model.CommentDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Comment not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /comments/{id}/pin [post]
+func (h *CommentHandler) Pin(c *gin.Context) {
+	h.setPinned(c, h.service.Pin)
+}
+
+// Unpin godoc
+// @Summary Unpin a comment
+// @Description Remove the pin from a comment
+// @Tags comments
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Comment ID"
+// @Success 200 {object} model.CommentDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Comment not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /comments/{id}/unpin [post]
+func (h *CommentHandler) Unpin(c *gin.Context) {
+	h.setPinned(c, h.service.Unpin)
+}
+
+// setPinned runs a pin or unpin action for the comment in the path
+func (h *CommentHandler) setPinned(c *gin.Context, action func(ctx context.Context, userID, commentID string) (*model.CommentDTO, error)) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	comment, err := action(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, model.ErrCommentNotFound) {
+			httpPlatform.RespondWithError(c, http.StatusNotFound, string(model.CodeCommentNotFound), "Comment not found")
+			return
+		}
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, string(model.CodeInternalError), "Failed to update comment")
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, comment)
+}
+
 func (h *CommentHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
 	comments := router.Group("/comments")
 	comments.Use(authMiddleware)
 	{
 		comments.POST("", h.Create)
 		comments.DELETE("/:id", h.Delete)
+		comments.POST("/:id/pin", h.Pin)
+		comments.POST("/:id/unpin", h.Unpin)
 	}
 	
 	// Comments for applications (nested route)
@@ -19,8 +19,10 @@ import (
 // MockCommentRepository implements ports.CommentRepository
 type MockCommentRepository struct {
 	CreateFunc            func(ctx context.Context, comment *model.Comment) error
-	ListByApplicationFunc func(ctx context.Context, appID string, userID ...string) ([]*model.Comment, error)
+	ListByApplicationFunc func(ctx context.Context, appID, sortDir string, userID ...string) ([]*model.Comment, error)
 	DeleteFunc            func(ctx context.Context, userID, commentID string) error
+	CountByStageFunc      func(ctx context.Context, stageIDs []string) (map[string]int, error)
+	SetPinnedFunc         func(ctx context.Context, userID, commentID string, pinned bool) (*model.Comment, error)
 }
 
 func (m *MockCommentRepository) Create(ctx context.Context, comment *model.Comment) error {
@@ -30,9 +32,9 @@ func (m *MockCommentRepository) Create(ctx context.Context, comment *model.Comme
 	return nil
 }
 
-func (m *MockCommentRepository) ListByApplication(ctx context.Context, appID string, userID ...string) ([]*model.Comment, error) {
+func (m *MockCommentRepository) ListByApplication(ctx context.Context, appID, sortDir string, userID ...string) ([]*model.Comment, error) {
 	if m.ListByApplicationFunc != nil {
-		return m.ListByApplicationFunc(ctx, appID, userID...)
+		return m.ListByApplicationFunc(ctx, appID, sortDir, userID...)
 	}
 	return nil, nil
 }
@@ -44,6 +46,20 @@ func (m *MockCommentRepository) Delete(ctx context.Context, userID, commentID st
 	return nil
 }
 
+func (m *MockCommentRepository) CountByStage(ctx context.Context, stageIDs []string) (map[string]int, error) {
+	if m.CountByStageFunc != nil {
+		return m.CountByStageFunc(ctx, stageIDs)
+	}
+	return map[string]int{}, nil
+}
+
+func (m *MockCommentRepository) SetPinned(ctx context.Context, userID, commentID string, pinned bool) (*model.Comment, error) {
+	if m.SetPinnedFunc != nil {
+		return m.SetPinnedFunc(ctx, userID, commentID, pinned)
+	}
+	return nil, model.ErrCommentNotFound
+}
+
 func setupTestRouter() *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	return gin.New()
@@ -69,7 +85,7 @@ func TestCommentHandler_Create(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCommentService(mockRepo)
+		svc := service.NewCommentService(mockRepo, nil)
 		handler := NewCommentHandler(svc)
 
 		router := setupTestRouter()
@@ -91,7 +107,7 @@ func TestCommentHandler_Create(t *testing.T) {
 
 	t.Run("returns 401 when not authenticated", func(t *testing.T) {
 		mockRepo := &MockCommentRepository{}
-		svc := service.NewCommentService(mockRepo)
+		svc := service.NewCommentService(mockRepo, nil)
 		handler := NewCommentHandler(svc)
 
 		router := setupTestRouter()
@@ -108,7 +124,7 @@ func TestCommentHandler_Create(t *testing.T) {
 
 	t.Run("returns 400 for invalid request", func(t *testing.T) {
 		mockRepo := &MockCommentRepository{}
-		svc := service.NewCommentService(mockRepo)
+		svc := service.NewCommentService(mockRepo, nil)
 		handler := NewCommentHandler(svc)
 
 		router := setupTestRouter()
@@ -125,7 +141,7 @@ func TestCommentHandler_Create(t *testing.T) {
 
 	t.Run("returns 400 for empty content", func(t *testing.T) {
 		mockRepo := &MockCommentRepository{}
-		svc := service.NewCommentService(mockRepo)
+		svc := service.NewCommentService(mockRepo, nil)
 		handler := NewCommentHandler(svc)
 
 		router := setupTestRouter()
@@ -152,12 +168,12 @@ func TestCommentHandler_ListByApplication(t *testing.T) {
 		}
 
 		mockRepo := &MockCommentRepository{
-			ListByApplicationFunc: func(ctx context.Context, aid string, uid ...string) ([]*model.Comment, error) {
+			ListByApplicationFunc: func(ctx context.Context, aid, sortDir string, uid ...string) ([]*model.Comment, error) {
 				return expectedComments, nil
 			},
 		}
 
-		svc := service.NewCommentService(mockRepo)
+		svc := service.NewCommentService(mockRepo, nil)
 		handler := NewCommentHandler(svc)
 
 		router := setupTestRouter()
@@ -175,9 +191,39 @@ func TestCommentHandler_ListByApplication(t *testing.T) {
 		assert.Len(t, response, 2)
 	})
 
+	t.Run("returns newest first with sort_dir=desc", func(t *testing.T) {
+		mockRepo := &MockCommentRepository{
+			ListByApplicationFunc: func(ctx context.Context, aid, sortDir string, uid ...string) ([]*model.Comment, error) {
+				return []*model.Comment{
+					{ID: "comment-1", ApplicationID: appID, Content: "First", CreatedAt: time.Now().Add(-time.Hour)},
+					{ID: "comment-2", ApplicationID: appID, Content: "Second", CreatedAt: time.Now()},
+				}, nil
+			},
+		}
+
+		svc := service.NewCommentService(mockRepo, nil)
+		handler := NewCommentHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/applications/:id/comments", mockAuthMiddleware(userID), handler.ListByApplication)
+
+		req, _ := http.NewRequest(http.MethodGet, "/applications/"+appID+"/comments?sort_dir=desc", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response []model.CommentDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+		require.Len(t, response, 2)
+		assert.Equal(t, "comment-2", response[0].ID)
+		assert.Equal(t, "comment-1", response[1].ID)
+	})
+
 	t.Run("returns 401 when not authenticated", func(t *testing.T) {
 		mockRepo := &MockCommentRepository{}
-		svc := service.NewCommentService(mockRepo)
+		svc := service.NewCommentService(mockRepo, nil)
 		handler := NewCommentHandler(svc)
 
 		router := setupTestRouter()
@@ -202,7 +248,7 @@ func TestCommentHandler_Delete(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCommentService(mockRepo)
+		svc := service.NewCommentService(mockRepo, nil)
 		handler := NewCommentHandler(svc)
 
 		router := setupTestRouter()
@@ -222,7 +268,7 @@ func TestCommentHandler_Delete(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCommentService(mockRepo)
+		svc := service.NewCommentService(mockRepo, nil)
 		handler := NewCommentHandler(svc)
 
 		router := setupTestRouter()
@@ -237,7 +283,7 @@ func TestCommentHandler_Delete(t *testing.T) {
 
 	t.Run("returns 401 when not authenticated", func(t *testing.T) {
 		mockRepo := &MockCommentRepository{}
-		svc := service.NewCommentService(mockRepo)
+		svc := service.NewCommentService(mockRepo, nil)
 		handler := NewCommentHandler(svc)
 
 		router := setupTestRouter()
@@ -251,21 +297,99 @@ func TestCommentHandler_Delete(t *testing.T) {
 	})
 }
 
+func TestCommentHandler_PinUnpin(t *testing.T) {
+	userID := "user-123"
+	commentID := "comment-1"
+
+	newHandler := func() *CommentHandler {
+		mockRepo := &MockCommentRepository{
+			SetPinnedFunc: func(ctx context.Context, uid, cid string, pinned bool) (*model.Comment, error) {
+				if cid != commentID {
+					return nil, model.ErrCommentNotFound
+				}
+				return &model.Comment{ID: cid, UserID: uid, ApplicationID: "app-1", Content: "Offer received", IsPinned: pinned}, nil
+			},
+		}
+		return NewCommentHandler(service.NewCommentService(mockRepo, nil))
+	}
+
+	t.Run("pins comment", func(t *testing.T) {
+		handler := newHandler()
+
+		router := setupTestRouter()
+		router.POST("/comments/:id/pin", mockAuthMiddleware(userID), handler.Pin)
+
+		req, _ := http.NewRequest(http.MethodPost, "/comments/"+commentID+"/pin", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response model.CommentDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.IsPinned)
+	})
+
+	t.Run("unpins comment", func(t *testing.T) {
+		handler := newHandler()
+
+		router := setupTestRouter()
+		router.POST("/comments/:id/unpin", mockAuthMiddleware(userID), handler.Unpin)
+
+		req, _ := http.NewRequest(http.MethodPost, "/comments/"+commentID+"/unpin", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response model.CommentDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.False(t, response.IsPinned)
+	})
+
+	t.Run("returns 404 when comment not found", func(t *testing.T) {
+		handler := newHandler()
+
+		router := setupTestRouter()
+		router.POST("/comments/:id/pin", mockAuthMiddleware(userID), handler.Pin)
+
+		req, _ := http.NewRequest(http.MethodPost, "/comments/nonexistent/pin", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("returns 401 when not authenticated", func(t *testing.T) {
+		handler := newHandler()
+
+		router := setupTestRouter()
+		router.POST("/comments/:id/pin", handler.Pin)
+
+		req, _ := http.NewRequest(http.MethodPost, "/comments/"+commentID+"/pin", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
 func TestCommentHandler_RegisterRoutes(t *testing.T) {
 	mockRepo := &MockCommentRepository{
 		CreateFunc: func(ctx context.Context, comment *model.Comment) error {
 			comment.ID = "comment-1"
 			return nil
 		},
-		ListByApplicationFunc: func(ctx context.Context, aid string, uid ...string) ([]*model.Comment, error) {
+		ListByApplicationFunc: func(ctx context.Context, aid, sortDir string, uid ...string) ([]*model.Comment, error) {
 			return []*model.Comment{}, nil
 		},
 		DeleteFunc: func(ctx context.Context, uid, cid string) error {
 			return nil
 		},
+		SetPinnedFunc: func(ctx context.Context, uid, cid string, pinned bool) (*model.Comment, error) {
+			return &model.Comment{ID: cid, IsPinned: pinned}, nil
+		},
 	}
 
-	svc := service.NewCommentService(mockRepo)
+	svc := service.NewCommentService(mockRepo, nil)
 	handler := NewCommentHandler(svc)
 
 	router := setupTestRouter()
@@ -278,6 +402,8 @@ func TestCommentHandler_RegisterRoutes(t *testing.T) {
 	}{
 		{http.MethodPost, "/api/v1/comments"},
 		{http.MethodDelete, "/api/v1/comments/test-id"},
+		{http.MethodPost, "/api/v1/comments/test-id/pin"},
+		{http.MethodPost, "/api/v1/comments/test-id/unpin"},
 		{http.MethodGet, "/api/v1/applications/test-id/comments"},
 	}
 
@@ -11,6 +11,7 @@ type Comment struct {
 	ApplicationID string
 	StageID       *string
 	Content       string
+	IsPinned      bool
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
@@ -20,6 +21,7 @@ type CommentDTO struct {
 	ApplicationID string     `json:"application_id"`
 	StageID       *string    `json:"stage_id,omitempty"`
 	Content       string     `json:"content"`
+	IsPinned      bool       `json:"is_pinned"`
 	CreatedAt     time.Time  `json:"created_at"`
 }
 
@@ -29,6 +31,7 @@ func (c *Comment) ToDTO() *CommentDTO {
 		ApplicationID: c.ApplicationID,
 		StageID:       c.StageID,
 		Content:       c.Content,
+		IsPinned:      c.IsPinned,
 		CreatedAt:     c.CreatedAt,
 	}
 }
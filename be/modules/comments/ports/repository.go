@@ -8,6 +8,12 @@ import (
 
 type CommentRepository interface {
 	Create(ctx context.Context, comment *model.Comment) error
-	ListByApplication(ctx context.Context, appID string, userID ...string) ([]*model.Comment, error)
+	// ListByApplication returns the application's comments, pinned ones first, then ordered
+	// by created_at; sortDir is "asc" (the default when empty) or "desc"
+	ListByApplication(ctx context.Context, appID, sortDir string, userID ...string) ([]*model.Comment, error)
 	Delete(ctx context.Context, userID, commentID string) error
+	// SetPinned pins or unpins the user's comment and returns it
+	SetPinned(ctx context.Context, userID, commentID string, pinned bool) (*model.Comment, error)
+	// CountByStage returns comment counts keyed by stage ID; stages without comments are omitted
+	CountByStage(ctx context.Context, stageIDs []string) (map[string]int, error)
 }
@@ -2,21 +2,37 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/andreypavlenko/jobber/modules/comments/model"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
 type CommentRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 func NewCommentRepository(pool *pgxpool.Pool) *CommentRepository {
 	return &CommentRepository{pool: pool}
 }
 
+// NewCommentRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewCommentRepositoryWithPool(pool DBPool) *CommentRepository {
+	return &CommentRepository{pool: pool}
+}
+
 func (r *CommentRepository) Create(ctx context.Context, comment *model.Comment) error {
 	query := `
 		INSERT INTO comments (id, user_id, application_id, stage_id, content, created_at, updated_at)
@@ -31,19 +47,24 @@ func (r *CommentRepository) Create(ctx context.Context, comment *model.Comment)
 	return err
 }
 
-func (r *CommentRepository) ListByApplication(ctx context.Context, appID string, userID ...string) ([]*model.Comment, error) {
+func (r *CommentRepository) ListByApplication(ctx context.Context, appID, sortDir string, userID ...string) ([]*model.Comment, error) {
+	order := "ASC"
+	if strings.EqualFold(sortDir, "desc") {
+		order = "DESC"
+	}
+
 	query := `
-		SELECT c.id, c.user_id, c.application_id, c.stage_id, c.content, c.created_at, c.updated_at
+		SELECT c.id, c.user_id, c.application_id, c.stage_id, c.content, c.is_pinned, c.created_at, c.updated_at
 		FROM comments c
 	`
 	var args []interface{}
 
 	if len(userID) > 0 && userID[0] != "" {
 		query += ` JOIN applications a ON c.application_id = a.id AND a.user_id = $1
-		WHERE c.application_id = $2 ORDER BY c.created_at ASC`
+		WHERE c.application_id = $2 ORDER BY c.is_pinned DESC, c.created_at ` + order
 		args = append(args, userID[0], appID)
 	} else {
-		query += ` WHERE c.application_id = $1 ORDER BY c.created_at ASC`
+		query += ` WHERE c.application_id = $1 ORDER BY c.is_pinned DESC, c.created_at ` + order
 		args = append(args, appID)
 	}
 
@@ -56,7 +77,7 @@ func (r *CommentRepository) ListByApplication(ctx context.Context, appID string,
 	var comments []*model.Comment
 	for rows.Next() {
 		c := &model.Comment{}
-		if err := rows.Scan(&c.ID, &c.UserID, &c.ApplicationID, &c.StageID, &c.Content, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		if err := rows.Scan(&c.ID, &c.UserID, &c.ApplicationID, &c.StageID, &c.Content, &c.IsPinned, &c.CreatedAt, &c.UpdatedAt); err != nil {
 			return nil, err
 		}
 		comments = append(comments, c)
@@ -64,6 +85,53 @@ func (r *CommentRepository) ListByApplication(ctx context.Context, appID string,
 	return comments, rows.Err()
 }
 
+// CountByStage returns the number of comments per stage for the given stage IDs.
+// Stages without comments are absent from the returned map.
+func (r *CommentRepository) CountByStage(ctx context.Context, stageIDs []string) (map[string]int, error) {
+	counts := make(map[string]int)
+	if len(stageIDs) == 0 {
+		return counts, nil
+	}
+
+	query := `SELECT stage_id, COUNT(*) FROM comments WHERE stage_id = ANY($1) GROUP BY stage_id`
+	rows, err := r.pool.Query(ctx, query, stageIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var stageID string
+		var count int
+		if err := rows.Scan(&stageID, &count); err != nil {
+			return nil, err
+		}
+		counts[stageID] = count
+	}
+	return counts, rows.Err()
+}
+
+// SetPinned pins or unpins a comment owned by the user and returns the updated comment
+func (r *CommentRepository) SetPinned(ctx context.Context, userID, commentID string, pinned bool) (*model.Comment, error) {
+	query := `
+		UPDATE comments SET is_pinned = $3, updated_at = $4
+		WHERE id = $1 AND user_id = $2
+		RETURNING id, user_id, application_id, stage_id, content, is_pinned, created_at, updated_at
+	`
+
+	c := &model.Comment{}
+	err := r.pool.QueryRow(ctx, query, commentID, userID, pinned, time.Now().UTC()).Scan(
+		&c.ID, &c.UserID, &c.ApplicationID, &c.StageID, &c.Content, &c.IsPinned, &c.CreatedAt, &c.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrCommentNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
 func (r *CommentRepository) Delete(ctx context.Context, userID, commentID string) error {
 	query := `DELETE FROM comments WHERE id = $1 AND user_id = $2`
 	result, err := r.pool.Exec(ctx, query, commentID, userID)
@@ -148,6 +148,121 @@ func TestCommentRepository_Delete(t *testing.T) {
 }
 
 // testCommentRepo is a test wrapper that uses pgxmock
+func TestCommentRepository_ListByApplication_PinnedFirst(t *testing.T) {
+	tests := []struct {
+		name    string
+		sortDir string
+		userID  []string
+		args    []interface{}
+		order   string
+	}{
+		{name: "scoped to user", userID: []string{"user-123"}, args: []interface{}{"user-123", "app-1"}, order: "ORDER BY c.is_pinned DESC, c.created_at ASC"},
+		{name: "unscoped", args: []interface{}{"app-1"}, order: "ORDER BY c.is_pinned DESC, c.created_at ASC"},
+		{name: "ascending", sortDir: "asc", args: []interface{}{"app-1"}, order: "ORDER BY c.is_pinned DESC, c.created_at ASC"},
+		{name: "descending", sortDir: "desc", userID: []string{"user-123"}, args: []interface{}{"user-123", "app-1"}, order: "ORDER BY c.is_pinned DESC, c.created_at DESC"},
+		{name: "unknown direction falls back to ascending", sortDir: "sideways", args: []interface{}{"app-1"}, order: "ORDER BY c.is_pinned DESC, c.created_at ASC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedSQL string
+			mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(_, actualSQL string) error {
+				capturedSQL = actualSQL
+				return nil
+			})))
+			require.NoError(t, err)
+			defer mock.Close()
+
+			mock.ExpectQuery("SELECT").
+				WithArgs(tt.args...).
+				WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "application_id", "stage_id", "content", "is_pinned", "created_at", "updated_at"}))
+
+			repo := NewCommentRepositoryWithPool(mock)
+			_, err = repo.ListByApplication(context.Background(), "app-1", tt.sortDir, tt.userID...)
+
+			require.NoError(t, err)
+			assert.Contains(t, capturedSQL, tt.order)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepository_SetPinned(t *testing.T) {
+	columns := []string{"id", "user_id", "application_id", "stage_id", "content", "is_pinned", "created_at", "updated_at"}
+
+	t.Run("pins the user's comment", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`UPDATE comments SET is_pinned = \$3, updated_at = \$4\s+WHERE id = \$1 AND user_id = \$2`).
+			WithArgs("comment-1", "user-123", true, pgxmock.AnyArg()).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("comment-1", "user-123", "app-1", nil, "Offer received", true, now, now))
+
+		repo := NewCommentRepositoryWithPool(mock)
+		comment, err := repo.SetPinned(context.Background(), "user-123", "comment-1", true)
+
+		require.NoError(t, err)
+		assert.Equal(t, "comment-1", comment.ID)
+		assert.True(t, comment.IsPinned)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found for another user's comment", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("UPDATE comments SET is_pinned").
+			WithArgs("comment-1", "other-user", false, pgxmock.AnyArg()).
+			WillReturnError(pgx.ErrNoRows)
+
+		repo := NewCommentRepositoryWithPool(mock)
+		comment, err := repo.SetPinned(context.Background(), "other-user", "comment-1", false)
+
+		assert.Nil(t, comment)
+		assert.ErrorIs(t, err, model.ErrCommentNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentRepository_CountByStage(t *testing.T) {
+	t.Run("returns counts keyed by stage", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		stageIDs := []string{"stage-1", "stage-2", "stage-3"}
+		mock.ExpectQuery(`SELECT stage_id, COUNT\(\*\) FROM comments WHERE stage_id = ANY\(\$1\) GROUP BY stage_id`).
+			WithArgs(stageIDs).
+			WillReturnRows(pgxmock.NewRows([]string{"stage_id", "count"}).
+				AddRow("stage-1", 2).
+				AddRow("stage-3", 5))
+
+		repo := NewCommentRepositoryWithPool(mock)
+		counts, err := repo.CountByStage(context.Background(), stageIDs)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"stage-1": 2, "stage-3": 5}, counts)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skips query for empty stage list", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		repo := NewCommentRepositoryWithPool(mock)
+		counts, err := repo.CountByStage(context.Background(), nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, counts)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 type testCommentRepo struct {
 	mock pgxmock.PgxPoolIface
 }
@@ -2,18 +2,28 @@ package service
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	"github.com/andreypavlenko/jobber/modules/comments/model"
 	"github.com/andreypavlenko/jobber/modules/comments/ports"
 )
 
+// ApplicationCommentRecorder saves a comment together with its entry in the
+// application's audit trail; satisfied by the applications service
+type ApplicationCommentRecorder interface {
+	CreateComment(ctx context.Context, comment *model.Comment) error
+}
+
 type CommentService struct {
-	repo ports.CommentRepository
+	repo     ports.CommentRepository
+	recorder ApplicationCommentRecorder
 }
 
-func NewCommentService(repo ports.CommentRepository) *CommentService {
-	return &CommentService{repo: repo}
+// NewCommentService creates a comment service. When recorder is set, new comments
+// are routed through it so they appear in the application's audit trail.
+func NewCommentService(repo ports.CommentRepository, recorder ApplicationCommentRecorder) *CommentService {
+	return &CommentService{repo: repo, recorder: recorder}
 }
 
 func (s *CommentService) Create(ctx context.Context, userID string, req *model.CreateCommentRequest) (*model.CommentDTO, error) {
@@ -28,14 +38,18 @@ func (s *CommentService) Create(ctx context.Context, userID string, req *model.C
 		Content:       strings.TrimSpace(req.Content),
 	}
 
-	if err := s.repo.Create(ctx, comment); err != nil {
+	create := s.repo.Create
+	if s.recorder != nil {
+		create = s.recorder.CreateComment
+	}
+	if err := create(ctx, comment); err != nil {
 		return nil, err
 	}
 	return comment.ToDTO(), nil
 }
 
 func (s *CommentService) ListByApplication(ctx context.Context, appID string, userID ...string) ([]*model.CommentDTO, error) {
-	comments, err := s.repo.ListByApplication(ctx, appID, userID...)
+	comments, err := s.repo.ListByApplication(ctx, appID, "asc", userID...)
 	if err != nil {
 		return nil, err
 	}
@@ -47,6 +61,43 @@ func (s *CommentService) ListByApplication(ctx context.Context, appID string, us
 	return dtos, nil
 }
 
+// ListByApplicationNewestFirst returns the application's comments ordered newest first,
+// pinned comments still leading. The repository yields oldest first, so the list is
+// reversed in place and the pinned comments are moved back to the front.
+func (s *CommentService) ListByApplicationNewestFirst(ctx context.Context, appID string, userID ...string) ([]*model.CommentDTO, error) {
+	dtos, err := s.ListByApplication(ctx, appID, userID...)
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(dtos)-1; i < j; i, j = i+1, j-1 {
+		dtos[i], dtos[j] = dtos[j], dtos[i]
+	}
+	sort.SliceStable(dtos, func(i, j int) bool {
+		return dtos[i].IsPinned && !dtos[j].IsPinned
+	})
+	return dtos, nil
+}
+
+// Pin marks the user's comment as pinned
+func (s *CommentService) Pin(ctx context.Context, userID, commentID string) (*model.CommentDTO, error) {
+	return s.setPinned(ctx, userID, commentID, true)
+}
+
+// Unpin removes the pin from the user's comment
+func (s *CommentService) Unpin(ctx context.Context, userID, commentID string) (*model.CommentDTO, error) {
+	return s.setPinned(ctx, userID, commentID, false)
+}
+
+// setPinned updates the pin flag; the repository scopes the update to the
+// user, so another user's comment is reported as not found
+func (s *CommentService) setPinned(ctx context.Context, userID, commentID string, pinned bool) (*model.CommentDTO, error) {
+	comment, err := s.repo.SetPinned(ctx, userID, commentID, pinned)
+	if err != nil {
+		return nil, err
+	}
+	return comment.ToDTO(), nil
+}
+
 func (s *CommentService) Delete(ctx context.Context, userID, commentID string) error {
 	return s.repo.Delete(ctx, userID, commentID)
 }
@@ -14,8 +14,10 @@ import (
 // MockCommentRepository implements ports.CommentRepository
 type MockCommentRepository struct {
 	CreateFunc            func(ctx context.Context, comment *model.Comment) error
-	ListByApplicationFunc func(ctx context.Context, appID string, userID ...string) ([]*model.Comment, error)
+	ListByApplicationFunc func(ctx context.Context, appID, sortDir string, userID ...string) ([]*model.Comment, error)
 	DeleteFunc            func(ctx context.Context, userID, commentID string) error
+	CountByStageFunc      func(ctx context.Context, stageIDs []string) (map[string]int, error)
+	SetPinnedFunc         func(ctx context.Context, userID, commentID string, pinned bool) (*model.Comment, error)
 }
 
 func (m *MockCommentRepository) Create(ctx context.Context, comment *model.Comment) error {
@@ -25,9 +27,9 @@ func (m *MockCommentRepository) Create(ctx context.Context, comment *model.Comme
 	return nil
 }
 
-func (m *MockCommentRepository) ListByApplication(ctx context.Context, appID string, userID ...string) ([]*model.Comment, error) {
+func (m *MockCommentRepository) ListByApplication(ctx context.Context, appID, sortDir string, userID ...string) ([]*model.Comment, error) {
 	if m.ListByApplicationFunc != nil {
-		return m.ListByApplicationFunc(ctx, appID, userID...)
+		return m.ListByApplicationFunc(ctx, appID, sortDir, userID...)
 	}
 	return nil, nil
 }
@@ -39,6 +41,20 @@ func (m *MockCommentRepository) Delete(ctx context.Context, userID, commentID st
 	return nil
 }
 
+func (m *MockCommentRepository) CountByStage(ctx context.Context, stageIDs []string) (map[string]int, error) {
+	if m.CountByStageFunc != nil {
+		return m.CountByStageFunc(ctx, stageIDs)
+	}
+	return map[string]int{}, nil
+}
+
+func (m *MockCommentRepository) SetPinned(ctx context.Context, userID, commentID string, pinned bool) (*model.Comment, error) {
+	if m.SetPinnedFunc != nil {
+		return m.SetPinnedFunc(ctx, userID, commentID, pinned)
+	}
+	return nil, model.ErrCommentNotFound
+}
+
 func TestCommentService_Create(t *testing.T) {
 	userID := "user-123"
 
@@ -52,7 +68,7 @@ func TestCommentService_Create(t *testing.T) {
 			},
 		}
 
-		svc := NewCommentService(mockRepo)
+		svc := NewCommentService(mockRepo, nil)
 		req := &model.CreateCommentRequest{
 			ApplicationID: "app-1",
 			Content:       "This is a comment",
@@ -68,7 +84,7 @@ func TestCommentService_Create(t *testing.T) {
 
 	t.Run("returns error for empty content", func(t *testing.T) {
 		mockRepo := &MockCommentRepository{}
-		svc := NewCommentService(mockRepo)
+		svc := NewCommentService(mockRepo, nil)
 		req := &model.CreateCommentRequest{
 			ApplicationID: "app-1",
 			Content:       "   ",
@@ -92,7 +108,7 @@ func TestCommentService_Create(t *testing.T) {
 			},
 		}
 
-		svc := NewCommentService(mockRepo)
+		svc := NewCommentService(mockRepo, nil)
 		req := &model.CreateCommentRequest{
 			ApplicationID: "app-1",
 			StageID:       &stageID,
@@ -116,7 +132,7 @@ func TestCommentService_Create(t *testing.T) {
 			},
 		}
 
-		svc := NewCommentService(mockRepo)
+		svc := NewCommentService(mockRepo, nil)
 		req := &model.CreateCommentRequest{
 			ApplicationID: "app-1",
 			Content:       "  Comment with whitespace  ",
@@ -137,7 +153,7 @@ func TestCommentService_Create(t *testing.T) {
 			},
 		}
 
-		svc := NewCommentService(mockRepo)
+		svc := NewCommentService(mockRepo, nil)
 		req := &model.CreateCommentRequest{
 			ApplicationID: "app-1",
 			Content:       "Test comment",
@@ -148,6 +164,35 @@ func TestCommentService_Create(t *testing.T) {
 		assert.Nil(t, result)
 		assert.Equal(t, expectedError, err)
 	})
+
+	t.Run("creates through the application comment recorder when set", func(t *testing.T) {
+		mockRepo := &MockCommentRepository{
+			CreateFunc: func(ctx context.Context, comment *model.Comment) error {
+				t.Fatal("the recorder saves the comment")
+				return nil
+			},
+		}
+		recorder := recorderFunc(func(ctx context.Context, comment *model.Comment) error {
+			comment.ID = "comment-1"
+			return nil
+		})
+
+		svc := NewCommentService(mockRepo, recorder)
+		result, err := svc.Create(context.Background(), userID, &model.CreateCommentRequest{
+			ApplicationID: "app-1",
+			Content:       "Recorded comment",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "comment-1", result.ID)
+	})
+}
+
+// recorderFunc implements ApplicationCommentRecorder
+type recorderFunc func(ctx context.Context, comment *model.Comment) error
+
+func (f recorderFunc) CreateComment(ctx context.Context, comment *model.Comment) error {
+	return f(ctx, comment)
 }
 
 func TestCommentService_ListByApplication(t *testing.T) {
@@ -173,13 +218,13 @@ func TestCommentService_ListByApplication(t *testing.T) {
 		}
 
 		mockRepo := &MockCommentRepository{
-			ListByApplicationFunc: func(ctx context.Context, aid string, uid ...string) ([]*model.Comment, error) {
+			ListByApplicationFunc: func(ctx context.Context, aid, sortDir string, uid ...string) ([]*model.Comment, error) {
 				assert.Equal(t, appID, aid)
 				return expectedComments, nil
 			},
 		}
 
-		svc := NewCommentService(mockRepo)
+		svc := NewCommentService(mockRepo, nil)
 		result, err := svc.ListByApplication(context.Background(), appID, userID)
 
 		require.NoError(t, err)
@@ -190,12 +235,12 @@ func TestCommentService_ListByApplication(t *testing.T) {
 
 	t.Run("returns empty list", func(t *testing.T) {
 		mockRepo := &MockCommentRepository{
-			ListByApplicationFunc: func(ctx context.Context, aid string, uid ...string) ([]*model.Comment, error) {
+			ListByApplicationFunc: func(ctx context.Context, aid, sortDir string, uid ...string) ([]*model.Comment, error) {
 				return []*model.Comment{}, nil
 			},
 		}
 
-		svc := NewCommentService(mockRepo)
+		svc := NewCommentService(mockRepo, nil)
 		result, err := svc.ListByApplication(context.Background(), appID, userID)
 
 		require.NoError(t, err)
@@ -206,12 +251,12 @@ func TestCommentService_ListByApplication(t *testing.T) {
 		expectedError := errors.New("database error")
 
 		mockRepo := &MockCommentRepository{
-			ListByApplicationFunc: func(ctx context.Context, aid string, uid ...string) ([]*model.Comment, error) {
+			ListByApplicationFunc: func(ctx context.Context, aid, sortDir string, uid ...string) ([]*model.Comment, error) {
 				return nil, expectedError
 			},
 		}
 
-		svc := NewCommentService(mockRepo)
+		svc := NewCommentService(mockRepo, nil)
 		result, err := svc.ListByApplication(context.Background(), appID, userID)
 
 		assert.Nil(t, result)
@@ -219,6 +264,117 @@ func TestCommentService_ListByApplication(t *testing.T) {
 	})
 }
 
+func TestCommentService_ListByApplicationNewestFirst(t *testing.T) {
+	appID := "app-1"
+	userID := "user-123"
+
+	t.Run("reverses repository order", func(t *testing.T) {
+		mockRepo := &MockCommentRepository{
+			ListByApplicationFunc: func(ctx context.Context, aid, sortDir string, uid ...string) ([]*model.Comment, error) {
+				return []*model.Comment{
+					{ID: "comment-1", Content: "Oldest"},
+					{ID: "comment-2", Content: "Middle"},
+					{ID: "comment-3", Content: "Newest"},
+				}, nil
+			},
+		}
+
+		svc := NewCommentService(mockRepo, nil)
+		result, err := svc.ListByApplicationNewestFirst(context.Background(), appID, userID)
+
+		require.NoError(t, err)
+		require.Len(t, result, 3)
+		assert.Equal(t, "comment-3", result[0].ID)
+		assert.Equal(t, "comment-2", result[1].ID)
+		assert.Equal(t, "comment-1", result[2].ID)
+	})
+
+	t.Run("returns error from repository", func(t *testing.T) {
+		expectedError := errors.New("database error")
+
+		mockRepo := &MockCommentRepository{
+			ListByApplicationFunc: func(ctx context.Context, aid, sortDir string, uid ...string) ([]*model.Comment, error) {
+				return nil, expectedError
+			},
+		}
+
+		svc := NewCommentService(mockRepo, nil)
+		result, err := svc.ListByApplicationNewestFirst(context.Background(), appID, userID)
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+	})
+}
+
+func TestCommentService_ListByApplicationNewestFirst_PinnedFirst(t *testing.T) {
+	mockRepo := &MockCommentRepository{
+		ListByApplicationFunc: func(ctx context.Context, aid, sortDir string, uid ...string) ([]*model.Comment, error) {
+			// Repository order: pinned first, then oldest first
+			return []*model.Comment{
+				{ID: "pinned-old", IsPinned: true},
+				{ID: "pinned-new", IsPinned: true},
+				{ID: "comment-1"},
+				{ID: "comment-2"},
+			}, nil
+		},
+	}
+
+	svc := NewCommentService(mockRepo, nil)
+	result, err := svc.ListByApplicationNewestFirst(context.Background(), "app-1", "user-123")
+
+	require.NoError(t, err)
+	ids := make([]string, len(result))
+	for i, c := range result {
+		ids[i] = c.ID
+	}
+	assert.Equal(t, []string{"pinned-new", "pinned-old", "comment-2", "comment-1"}, ids)
+}
+
+func TestCommentService_PinUnpin(t *testing.T) {
+	userID := "user-123"
+	commentID := "comment-1"
+
+	newRepo := func(calls *[]bool) *MockCommentRepository {
+		return &MockCommentRepository{
+			SetPinnedFunc: func(ctx context.Context, uid, cid string, pinned bool) (*model.Comment, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, commentID, cid)
+				*calls = append(*calls, pinned)
+				return &model.Comment{ID: cid, UserID: uid, ApplicationID: "app-1", Content: "Offer received", IsPinned: pinned}, nil
+			},
+		}
+	}
+
+	t.Run("pin then unpin toggles the flag", func(t *testing.T) {
+		var calls []bool
+		svc := NewCommentService(newRepo(&calls), nil)
+
+		pinned, err := svc.Pin(context.Background(), userID, commentID)
+		require.NoError(t, err)
+		assert.True(t, pinned.IsPinned)
+
+		unpinned, err := svc.Unpin(context.Background(), userID, commentID)
+		require.NoError(t, err)
+		assert.False(t, unpinned.IsPinned)
+
+		assert.Equal(t, []bool{true, false}, calls)
+	})
+
+	t.Run("returns not found for another user's comment", func(t *testing.T) {
+		mockRepo := &MockCommentRepository{
+			SetPinnedFunc: func(ctx context.Context, uid, cid string, pinned bool) (*model.Comment, error) {
+				return nil, model.ErrCommentNotFound
+			},
+		}
+
+		svc := NewCommentService(mockRepo, nil)
+		result, err := svc.Pin(context.Background(), "other-user", commentID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrCommentNotFound)
+	})
+}
+
 func TestCommentService_Delete(t *testing.T) {
 	userID := "user-123"
 	commentID := "comment-1"
@@ -233,7 +389,7 @@ func TestCommentService_Delete(t *testing.T) {
 			},
 		}
 
-		svc := NewCommentService(mockRepo)
+		svc := NewCommentService(mockRepo, nil)
 		err := svc.Delete(context.Background(), userID, commentID)
 
 		require.NoError(t, err)
@@ -247,7 +403,7 @@ func TestCommentService_Delete(t *testing.T) {
 			},
 		}
 
-		svc := NewCommentService(mockRepo)
+		svc := NewCommentService(mockRepo, nil)
 		err := svc.Delete(context.Background(), userID, commentID)
 
 		assert.Equal(t, model.ErrCommentNotFound, err)
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andreypavlenko/jobber/internal/platform/auth"
+	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/andreypavlenko/jobber/modules/companies/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ApplicationContactHandler handles the applications linked to a company contact
+type ApplicationContactHandler struct {
+	service *service.ApplicationContactService
+}
+
+// NewApplicationContactHandler creates a new application contact handler
+func NewApplicationContactHandler(service *service.ApplicationContactService) *ApplicationContactHandler {
+	return &ApplicationContactHandler{service: service}
+}
+
+// List godoc
+// @Summary List a contact's applications
+// @Description Get the authenticated user's applications the contact was involved in, most recently linked first
+// @Tags companies
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Company ID"
+// @Param contactId path string true "Contact ID"
+// @Success 200 {array} model.ContactApplicationDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Company or contact not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/{id}/contacts/{contactId}/applications [get]
+func (h *ApplicationContactHandler) List(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	applications, err := h.service.ListApplications(c.Request.Context(), userID, c.Param("id"), c.Param("contactId"))
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, applications)
+}
+
+// Link godoc
+// @Summary Link a contact to an application
+// @Description Record that the contact was involved in one of the authenticated user's applications, with an optional role such as recruiter or interviewer
+// @Tags companies
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Company ID"
+// @Param contactId path string true "Contact ID"
+// @Param request body model.LinkContactApplicationRequest true "Application and role"
+// @Success 201 {object} model.ContactApplicationDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Company, contact or application not found"
+// @Failure 409 {object} httpPlatform.ErrorResponse "Contact already linked to the application"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/{id}/contacts/{contactId}/applications [post]
+func (h *ApplicationContactHandler) Link(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	var req model.LinkContactApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	link, err := h.service.Link(c.Request.Context(), userID, c.Param("id"), c.Param("contactId"), &req)
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusCreated, link)
+}
+
+// Update godoc
+// @Summary Update a contact's role in an application
+// @Description Change the role of the contact in one of the authenticated user's applications; an empty role clears it
+// @Tags companies
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Company ID"
+// @Param contactId path string true "Contact ID"
+// @Param applicationId path string true "Application ID"
+// @Param request body model.UpdateContactApplicationRequest true "New role"
+// @Success 200 {object} model.ContactApplicationDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Company, contact or link not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/{id}/contacts/{contactId}/applications/{applicationId} [patch]
+func (h *ApplicationContactHandler) Update(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	var req model.UpdateContactApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	link, err := h.service.UpdateRole(c.Request.Context(), userID, c.Param("id"), c.Param("contactId"), c.Param("applicationId"), &req)
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, link)
+}
+
+// Unlink godoc
+// @Summary Unlink a contact from an application
+// @Description Remove the contact from one of the authenticated user's applications
+// @Tags companies
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Company ID"
+// @Param contactId path string true "Contact ID"
+// @Param applicationId path string true "Application ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Company, contact or link not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/{id}/contacts/{contactId}/applications/{applicationId} [delete]
+func (h *ApplicationContactHandler) Unlink(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	if err := h.service.Unlink(c.Request.Context(), userID, c.Param("id"), c.Param("contactId"), c.Param("applicationId")); err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Contact unlinked successfully"})
+}
+
+func (h *ApplicationContactHandler) respondWithError(c *gin.Context, err error) {
+	errorCode := model.GetErrorCode(err)
+	statusCode := http.StatusInternalServerError
+	switch errorCode {
+	case model.CodeCompanyNotFound, model.CodeContactNotFound, model.CodeApplicationNotFound, model.CodeApplicationContactNotFound:
+		statusCode = http.StatusNotFound
+	case model.CodeApplicationContactExists:
+		statusCode = http.StatusConflict
+	}
+	httpPlatform.RespondWithError(c, statusCode, string(errorCode), model.GetErrorMessage(err, auth.GetLocale(c)))
+}
+
+// RegisterRoutes registers the routes for the applications linked to a company contact
+func (h *ApplicationContactHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	applications := router.Group("/companies/:id/contacts/:contactId/applications")
+	applications.Use(authMiddleware)
+	{
+		applications.GET("", h.List)
+		applications.POST("", h.Link)
+		applications.PATCH("/:applicationId", h.Update)
+		applications.DELETE("/:applicationId", h.Unlink)
+	}
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/andreypavlenko/jobber/modules/companies/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockApplicationContactRepository implements ports.ApplicationContactRepository
+type MockApplicationContactRepository struct {
+	CreateFunc        func(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error)
+	ListByContactFunc func(ctx context.Context, userID, contactID string) ([]*model.ContactApplication, error)
+	UpdateRoleFunc    func(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error)
+	DeleteFunc        func(ctx context.Context, userID, contactID, applicationID string) error
+}
+
+func (m *MockApplicationContactRepository) Create(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, userID, contactID, applicationID, role)
+	}
+	return &model.ContactApplication{ApplicationID: applicationID, Role: role}, nil
+}
+
+func (m *MockApplicationContactRepository) ListByContact(ctx context.Context, userID, contactID string) ([]*model.ContactApplication, error) {
+	if m.ListByContactFunc != nil {
+		return m.ListByContactFunc(ctx, userID, contactID)
+	}
+	return []*model.ContactApplication{}, nil
+}
+
+func (m *MockApplicationContactRepository) UpdateRole(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error) {
+	if m.UpdateRoleFunc != nil {
+		return m.UpdateRoleFunc(ctx, userID, contactID, applicationID, role)
+	}
+	return nil, model.ErrApplicationContactNotFound
+}
+
+func (m *MockApplicationContactRepository) Delete(ctx context.Context, userID, contactID, applicationID string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID, contactID, applicationID)
+	}
+	return model.ErrApplicationContactNotFound
+}
+
+// setupApplicationContactRouter serves the routes as user-123, who owns
+// company-1 and its contact contact-1
+func setupApplicationContactRouter(linkRepo *MockApplicationContactRepository) *gin.Engine {
+	companyRepo := &MockCompanyRepository{
+		GetByIDFunc: func(ctx context.Context, userID, companyID string) (*model.Company, error) {
+			if userID != "user-123" || companyID != "company-1" {
+				return nil, model.ErrCompanyNotFound
+			}
+			return &model.Company{ID: companyID, UserID: userID, Name: "Acme"}, nil
+		},
+	}
+	contactRepo := &MockContactRepository{
+		GetByIDFunc: func(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error) {
+			if userID != "user-123" || companyID != "company-1" || contactID != "contact-1" {
+				return nil, model.ErrContactNotFound
+			}
+			return &model.Contact{ID: contactID, CompanyID: companyID, UserID: userID, Name: "Jane"}, nil
+		},
+	}
+	router := setupTestRouter()
+	handler := NewApplicationContactHandler(service.NewApplicationContactService(companyRepo, contactRepo, linkRepo))
+	handler.RegisterRoutes(router.Group("/api/v1"), mockAuthMiddleware("user-123"))
+	return router
+}
+
+func TestApplicationContactHandler_List(t *testing.T) {
+	linkedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("lists the user's applications of the contact", func(t *testing.T) {
+		linkRepo := &MockApplicationContactRepository{
+			ListByContactFunc: func(ctx context.Context, userID, contactID string) ([]*model.ContactApplication, error) {
+				assert.Equal(t, "user-123", userID, "applications are scoped to the user")
+				assert.Equal(t, "contact-1", contactID)
+				return []*model.ContactApplication{
+					{ApplicationID: "app-1", ApplicationName: "Backend Engineer", ApplicationStatus: "active", Role: "recruiter", LinkedAt: linkedAt},
+				}, nil
+			},
+		}
+
+		w := sendContactRequest(setupApplicationContactRouter(linkRepo), http.MethodGet, "/api/v1/companies/company-1/contacts/contact-1/applications", "")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var applications []model.ContactApplicationDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &applications))
+		require.Len(t, applications, 1)
+		assert.Equal(t, "app-1", applications[0].ApplicationID)
+		assert.Equal(t, "Backend Engineer", applications[0].ApplicationName)
+		assert.Equal(t, "recruiter", applications[0].Role)
+	})
+
+	tests := []struct {
+		name     string
+		path     string
+		wantCode string
+	}{
+		{"returns 404 for another user's contact", "/api/v1/companies/company-1/contacts/contact-2/applications", "CONTACT_NOT_FOUND"},
+		{"returns 404 for another user's company", "/api/v1/companies/company-2/contacts/contact-1/applications", "COMPANY_NOT_FOUND"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			linkRepo := &MockApplicationContactRepository{
+				ListByContactFunc: func(ctx context.Context, userID, contactID string) ([]*model.ContactApplication, error) {
+					t.Fatal("links of a contact the user does not own must not be read")
+					return nil, nil
+				},
+			}
+
+			w := sendContactRequest(setupApplicationContactRouter(linkRepo), http.MethodGet, tt.path, "")
+
+			assert.Equal(t, http.StatusNotFound, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantCode)
+		})
+	}
+}
+
+func TestApplicationContactHandler_Link(t *testing.T) {
+	const applicationID = "3f1c2d4e-5a6b-4c7d-8e9f-0a1b2c3d4e5f"
+
+	tests := []struct {
+		name       string
+		body       string
+		repoErr    error
+		wantStatus int
+		wantCode   string
+	}{
+		{"links the application", `{"application_id":"` + applicationID + `","role":" recruiter "}`, nil, http.StatusCreated, `"role":"recruiter"`},
+		{"rejects a missing application id", `{"role":"recruiter"}`, nil, http.StatusBadRequest, "VALIDATION_ERROR"},
+		{"rejects a malformed application id", `{"application_id":"app-1"}`, nil, http.StatusBadRequest, "VALIDATION_ERROR"},
+		{"returns 404 for another user's application", `{"application_id":"` + applicationID + `"}`, model.ErrApplicationNotFound, http.StatusNotFound, "APPLICATION_NOT_FOUND"},
+		{"returns 409 when already linked", `{"application_id":"` + applicationID + `"}`, model.ErrApplicationContactExists, http.StatusConflict, "APPLICATION_CONTACT_EXISTS"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			linkRepo := &MockApplicationContactRepository{
+				CreateFunc: func(ctx context.Context, userID, contactID, appID, role string) (*model.ContactApplication, error) {
+					assert.Equal(t, "user-123", userID)
+					assert.Equal(t, "contact-1", contactID)
+					if tt.repoErr != nil {
+						return nil, tt.repoErr
+					}
+					return &model.ContactApplication{ApplicationID: appID, Role: role}, nil
+				},
+			}
+
+			w := sendContactRequest(setupApplicationContactRouter(linkRepo), http.MethodPost, "/api/v1/companies/company-1/contacts/contact-1/applications", tt.body)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantCode)
+		})
+	}
+}
+
+func TestApplicationContactHandler_Update(t *testing.T) {
+	t.Run("updates the role", func(t *testing.T) {
+		linkRepo := &MockApplicationContactRepository{
+			UpdateRoleFunc: func(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error) {
+				assert.Equal(t, "user-123", userID)
+				return &model.ContactApplication{ApplicationID: applicationID, Role: role}, nil
+			},
+		}
+
+		w := sendContactRequest(setupApplicationContactRouter(linkRepo), http.MethodPatch, "/api/v1/companies/company-1/contacts/contact-1/applications/app-1", `{"role":"interviewer"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"role":"interviewer"`)
+	})
+
+	t.Run("returns 404 for an unlinked application", func(t *testing.T) {
+		w := sendContactRequest(setupApplicationContactRouter(&MockApplicationContactRepository{}), http.MethodPatch, "/api/v1/companies/company-1/contacts/contact-1/applications/app-2", `{"role":"interviewer"}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "APPLICATION_CONTACT_NOT_FOUND")
+	})
+}
+
+func TestApplicationContactHandler_Unlink(t *testing.T) {
+	t.Run("unlinks the application", func(t *testing.T) {
+		linkRepo := &MockApplicationContactRepository{
+			DeleteFunc: func(ctx context.Context, userID, contactID, applicationID string) error {
+				assert.Equal(t, "user-123", userID)
+				assert.Equal(t, "app-1", applicationID)
+				return nil
+			},
+		}
+
+		w := sendContactRequest(setupApplicationContactRouter(linkRepo), http.MethodDelete, "/api/v1/companies/company-1/contacts/contact-1/applications/app-1", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("returns 404 for an unlinked application", func(t *testing.T) {
+		w := sendContactRequest(setupApplicationContactRouter(&MockApplicationContactRepository{}), http.MethodDelete, "/api/v1/companies/company-1/contacts/contact-1/applications/app-2", "")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "APPLICATION_CONTACT_NOT_FOUND")
+	})
+}
@@ -2,9 +2,11 @@ package handler
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/andreypavlenko/jobber/internal/platform/auth"
 	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	"github.com/andreypavlenko/jobber/modules/companies/model"
 	"github.com/andreypavlenko/jobber/modules/companies/ports"
 	"github.com/andreypavlenko/jobber/modules/companies/service"
@@ -50,10 +52,10 @@ func (h *CompanyHandler) Create(c *gin.Context) {
 	company, err := h.service.Create(c.Request.Context(), userID, &req)
 	if err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 		
 		statusCode := http.StatusInternalServerError
-		if errorCode == model.CodeCompanyNameRequired {
+		if isCompanyValidationError(errorCode) {
 			statusCode = http.StatusBadRequest
 		}
 		
@@ -71,7 +73,9 @@ func (h *CompanyHandler) Create(c *gin.Context) {
 // @Security BearerAuth
 // @Produce json
 // @Param id path string true "Company ID"
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {object} model.CompanyDTO
+// @Success 304 "Not Modified"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 404 {object} httpPlatform.ErrorResponse "Company not found"
 // @Failure 500 {object} httpPlatform.ErrorResponse
@@ -88,7 +92,7 @@ func (h *CompanyHandler) Get(c *gin.Context) {
 	company, err := h.service.GetByID(c.Request.Context(), userID, companyID)
 	if err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 		
 		statusCode := http.StatusInternalServerError
 		if errorCode == model.CodeCompanyNotFound {
@@ -99,6 +103,11 @@ func (h *CompanyHandler) Get(c *gin.Context) {
 		return
 	}
 
+	etag := httpPlatform.ComputeETag(company)
+	httpPlatform.SetETag(c, etag)
+	if httpPlatform.CheckIfNoneMatch(c, etag) {
+		return
+	}
 	httpPlatform.RespondWithData(c, http.StatusOK, company)
 }
 
@@ -110,10 +119,13 @@ func (h *CompanyHandler) Get(c *gin.Context) {
 // @Produce json
 // @Param limit query int false "Number of items per page (default: 20, max: 100)"
 // @Param offset query int false "Number of items to skip (default: 0)"
+// @Param cursor query string false "Opaque cursor from pagination.next_cursor; pass it empty to start cursor pagination. Replaces offset; not available when sorting by last_activity"
 // @Param sort_by query string false "Sort field: name, last_activity, applications_count (default: name)"
 // @Param sort_dir query string false "Sort direction: asc, desc (default: asc)"
+// @Param industry query string false "Only companies in this industry (case-insensitive)"
+// @Param size query string false "Only companies of this size: startup, small, medium, large, enterprise"
 // @Success 200 {object} httpPlatform.PaginatedResponse{items=[]model.CompanyDTO}
-// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid pagination parameters"
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid pagination parameters, cursor or size"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 500 {object} httpPlatform.ErrorResponse
 // @Router /companies [get]
@@ -145,19 +157,42 @@ func (h *CompanyHandler) List(c *gin.Context) {
 		sortBy = "name"
 	}
 
+	size := strings.ToLower(strings.TrimSpace(c.Query("size")))
+	if size != "" && !model.IsValidCompanySize(size) {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeInvalidCompanySize), model.GetErrorMessage(model.ErrInvalidCompanySize, auth.GetLocale(c)))
+		return
+	}
+
 	opts := &ports.ListOptions{
-		Limit:   pagination.Limit,
-		Offset:  pagination.Offset,
-		SortBy:  sortBy,
-		SortDir: sortDir,
+		Limit:    pagination.Limit,
+		Offset:   pagination.Offset,
+		SortBy:   sortBy,
+		SortDir:  sortDir,
+		Industry: strings.TrimSpace(c.Query("industry")),
+		Size:     size,
+	}
+	if pagination.Cursor != nil {
+		// Fetch one extra row to tell whether another page follows
+		opts.Cursor = pagination.Cursor
+		opts.Limit++
 	}
 
 	companies, total, err := h.service.List(c.Request.Context(), userID, opts)
 	if err != nil {
+		if httpPlatform.RespondWithCursorError(c, err) {
+			return
+		}
 		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list companies")
 		return
 	}
 
+	if pagination.Cursor != nil {
+		page, next := keyset.Trim(companies, pagination.Limit, func(company *model.CompanyDTO) keyset.Cursor {
+			return company.Cursor(sortBy)
+		})
+		httpPlatform.RespondWithCursorPagination(c, http.StatusOK, page, pagination.Limit, total, next)
+		return
+	}
 	httpPlatform.RespondWithPagination(c, http.StatusOK, companies, pagination.Limit, pagination.Offset, total)
 }
 
@@ -194,12 +229,12 @@ func (h *CompanyHandler) Update(c *gin.Context) {
 	company, err := h.service.Update(c.Request.Context(), userID, companyID, &req)
 	if err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 		
 		statusCode := http.StatusInternalServerError
 		if errorCode == model.CodeCompanyNotFound {
 			statusCode = http.StatusNotFound
-		} else if errorCode == model.CodeCompanyNameRequired {
+		} else if isCompanyValidationError(errorCode) {
 			statusCode = http.StatusBadRequest
 		}
 		
@@ -210,6 +245,57 @@ func (h *CompanyHandler) Update(c *gin.Context) {
 	httpPlatform.RespondWithData(c, http.StatusOK, company)
 }
 
+// UpdateLogoURL godoc
+// @Summary Set a company logo
+// @Description Set the logo URL of a company. The URL must end in an image extension or serve an image content type; an empty value clears the logo
+// @Tags companies
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Company ID"
+// @Param request body model.UpdateLogoURLRequest true "Logo URL"
+// @Success 200 {object} model.CompanyDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid or inaccessible logo URL"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Company not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/{id}/logo-url [patch]
+func (h *CompanyHandler) UpdateLogoURL(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	companyID := c.Param("id")
+
+	var req model.UpdateLogoURLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	if err := h.service.UpdateLogoURL(c.Request.Context(), userID, companyID, req.LogoURL); err != nil {
+		errorCode := model.GetErrorCode(err)
+		statusCode := http.StatusInternalServerError
+		switch errorCode {
+		case model.CodeCompanyNotFound:
+			statusCode = http.StatusNotFound
+		case model.CodeInvalidLogoURL, model.CodeLogoURLNotAccessible:
+			statusCode = http.StatusBadRequest
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(errorCode), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+
+	company, err := h.service.GetByID(c.Request.Context(), userID, companyID)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, company)
+}
+
 // Delete godoc
 // @Summary Delete a company
 // @Description Delete a specific company by ID
@@ -233,7 +319,7 @@ func (h *CompanyHandler) Delete(c *gin.Context) {
 
 	if err := h.service.Delete(c.Request.Context(), userID, companyID); err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 		
 		statusCode := http.StatusInternalServerError
 		if errorCode == model.CodeCompanyNotFound {
@@ -304,7 +390,7 @@ func (h *CompanyHandler) ToggleFavorite(c *gin.Context) {
 	isFavorite, err := h.service.ToggleFavorite(c.Request.Context(), userID, companyID)
 	if err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusInternalServerError
 		if errorCode == model.CodeCompanyNotFound {
@@ -318,6 +404,130 @@ func (h *CompanyHandler) ToggleFavorite(c *gin.Context) {
 	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"is_favorite": isFavorite})
 }
 
+// ExportNotes godoc
+// @Summary Export company notes as Markdown
+// @Description Download the company's notes as a Markdown file headed by the company name and location
+// @Tags companies
+// @Security BearerAuth
+// @Produce text/markdown
+// @Param id path string true "Company ID"
+// @Success 200 {file} binary "Markdown file"
+// @Success 204 "Company has no notes"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Company not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/{id}/notes/export [get]
+func (h *CompanyHandler) ExportNotes(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	companyID := c.Param("id")
+
+	export, err := h.service.ExportNotes(c.Request.Context(), userID, companyID)
+	if err != nil {
+		errorCode := model.GetErrorCode(err)
+		statusCode := http.StatusInternalServerError
+		if errorCode == model.CodeCompanyNotFound {
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(errorCode), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	if export == nil {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename="+export.Filename)
+	c.Data(http.StatusOK, "text/markdown; charset=utf-8", export.Content)
+}
+
+// FindDuplicates godoc
+// @Summary Find duplicate companies
+// @Description Group the companies of the authenticated user whose names are similar (trigram similarity of at least 0.7). The oldest company of each group is the canonical one.
+// @Tags companies
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} model.DuplicateGroupDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/duplicates [get]
+func (h *CompanyHandler) FindDuplicates(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	groups, err := h.service.FindDuplicates(c.Request.Context(), userID)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to find duplicate companies")
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, groups)
+}
+
+// Merge godoc
+// @Summary Merge companies
+// @Description Merge other companies into a company: their jobs, contacts and tags move to it, their notes are appended to its notes, one company per line, and they are deleted. Runs in a single transaction.
+// @Tags companies
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Company ID to keep"
+// @Param request body model.MergeCompaniesRequest true "Companies to merge and delete"
+// @Success 200 {object} model.CompanyDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Company not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/{id}/merge [post]
+func (h *CompanyHandler) Merge(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	companyID := c.Param("id")
+
+	var req model.MergeCompaniesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	company, err := h.service.Merge(c.Request.Context(), userID, companyID, &req)
+	if err != nil {
+		errorCode := model.GetErrorCode(err)
+		statusCode := http.StatusInternalServerError
+		switch errorCode {
+		case model.CodeCompanyNotFound:
+			statusCode = http.StatusNotFound
+		case model.CodeCompanyMergeIntoSelf:
+			statusCode = http.StatusBadRequest
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(errorCode), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, company)
+}
+
+// isCompanyValidationError reports whether a company create or update failed on invalid input
+func isCompanyValidationError(code model.ErrorCode) bool {
+	switch code {
+	case model.CodeCompanyNameRequired, model.CodeInvalidCompanySize, model.CodeInvalidFoundedYear,
+		model.CodeInvalidWebsiteURL, model.CodeInvalidLinkedInURL:
+		return true
+	}
+	return false
+}
+
 // RegisterRoutes registers company routes
 func (h *CompanyHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
 	companies := router.Group("/companies")
@@ -325,10 +535,14 @@ func (h *CompanyHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware
 	{
 		companies.POST("", h.Create)
 		companies.GET("", h.List)
+		companies.GET("/duplicates", h.FindDuplicates)
 		companies.GET("/:id", h.Get)
 		companies.GET("/:id/related-counts", h.GetRelatedCounts)
+		companies.GET("/:id/notes/export", h.ExportNotes)
 		companies.PATCH("/:id", h.Update)
+		companies.PATCH("/:id/logo-url", h.UpdateLogoURL)
 		companies.DELETE("/:id", h.Delete)
 		companies.POST("/:id/favorite", h.ToggleFavorite)
+		companies.POST("/:id/merge", h.Merge)
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,6 +28,9 @@ type MockCompanyRepository struct {
 	DeleteFunc                            func(ctx context.Context, userID, companyID string) error
 	GetRelatedJobsAndApplicationsCountFunc func(ctx context.Context, userID, companyID string) (jobsCount, appsCount int, err error)
 	ToggleFavoriteFunc                     func(ctx context.Context, userID, companyID string) (bool, error)
+	UpdateLogoURLFunc                      func(ctx context.Context, userID, companyID string, logoURL *string) error
+	ListSimilarPairsFunc                   func(ctx context.Context, userID string, threshold float64) ([]*model.SimilarCompanyPair, error)
+	MergeFunc                              func(ctx context.Context, userID, intoID string, fromIDs []string) error
 }
 
 func (m *MockCompanyRepository) Create(ctx context.Context, company *model.Company) error {
@@ -85,6 +89,31 @@ func (m *MockCompanyRepository) ToggleFavorite(ctx context.Context, userID, comp
 	return false, nil
 }
 
+func (m *MockCompanyRepository) UpdateLogoURL(ctx context.Context, userID, companyID string, logoURL *string) error {
+	if m.UpdateLogoURLFunc != nil {
+		return m.UpdateLogoURLFunc(ctx, userID, companyID, logoURL)
+	}
+	return nil
+}
+
+func (m *MockCompanyRepository) GetByName(ctx context.Context, userID, name string) (*model.Company, error) {
+	return nil, model.ErrCompanyNotFound
+}
+
+func (m *MockCompanyRepository) ListSimilarPairs(ctx context.Context, userID string, threshold float64) ([]*model.SimilarCompanyPair, error) {
+	if m.ListSimilarPairsFunc != nil {
+		return m.ListSimilarPairsFunc(ctx, userID, threshold)
+	}
+	return nil, nil
+}
+
+func (m *MockCompanyRepository) Merge(ctx context.Context, userID, intoID string, fromIDs []string) error {
+	if m.MergeFunc != nil {
+		return m.MergeFunc(ctx, userID, intoID, fromIDs)
+	}
+	return nil
+}
+
 func setupTestRouter() *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	return gin.New()
@@ -118,7 +147,7 @@ func TestCompanyHandler_Create(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -140,7 +169,7 @@ func TestCompanyHandler_Create(t *testing.T) {
 
 	t.Run("returns 401 when not authenticated", func(t *testing.T) {
 		mockRepo := &MockCompanyRepository{}
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -157,7 +186,7 @@ func TestCompanyHandler_Create(t *testing.T) {
 
 	t.Run("returns 400 for invalid request", func(t *testing.T) {
 		mockRepo := &MockCompanyRepository{}
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -174,7 +203,7 @@ func TestCompanyHandler_Create(t *testing.T) {
 
 	t.Run("returns 400 for empty name", func(t *testing.T) {
 		mockRepo := &MockCompanyRepository{}
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -188,6 +217,24 @@ func TestCompanyHandler_Create(t *testing.T) {
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
+
+	t.Run("returns 400 for out of range founded year", func(t *testing.T) {
+		mockRepo := &MockCompanyRepository{}
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
+		handler := NewCompanyHandler(svc)
+
+		router := setupTestRouter()
+		router.POST("/companies", mockAuthMiddleware(userID), handler.Create)
+
+		body := `{"name":"Acme","founded_year":1700}`
+		req, _ := http.NewRequest(http.MethodPost, "/companies", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "INVALID_FOUNDED_YEAR")
+	})
 }
 
 func TestCompanyHandler_Get(t *testing.T) {
@@ -206,7 +253,7 @@ func TestCompanyHandler_Get(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -224,6 +271,53 @@ func TestCompanyHandler_Get(t *testing.T) {
 		assert.Equal(t, expectedDTO.Name, response.Name)
 	})
 
+	t.Run("includes job counts", func(t *testing.T) {
+		mockRepo := &MockCompanyRepository{
+			GetByIDEnrichedFunc: func(ctx context.Context, uid, cid string) (*model.CompanyDTO, error) {
+				return &model.CompanyDTO{ID: cid, Name: "Test Company", JobsCount: 5, ActiveJobsCount: 3}, nil
+			},
+		}
+
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
+		handler := NewCompanyHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/companies/:id", mockAuthMiddleware(userID), handler.Get)
+
+		req, _ := http.NewRequest(http.MethodGet, "/companies/"+companyID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, float64(5), response["jobs_count"])
+		assert.Equal(t, float64(3), response["active_jobs_count"])
+	})
+
+	t.Run("includes zero job counts", func(t *testing.T) {
+		mockRepo := &MockCompanyRepository{
+			GetByIDEnrichedFunc: func(ctx context.Context, uid, cid string) (*model.CompanyDTO, error) {
+				return &model.CompanyDTO{ID: cid, Name: "Test Company"}, nil
+			},
+		}
+
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
+		handler := NewCompanyHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/companies/:id", mockAuthMiddleware(userID), handler.Get)
+
+		req, _ := http.NewRequest(http.MethodGet, "/companies/"+companyID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"jobs_count":0`)
+		assert.Contains(t, w.Body.String(), `"active_jobs_count":0`)
+	})
+
 	t.Run("returns 404 when company not found", func(t *testing.T) {
 		mockRepo := &MockCompanyRepository{
 			GetByIDEnrichedFunc: func(ctx context.Context, uid, cid string) (*model.CompanyDTO, error) {
@@ -231,7 +325,7 @@ func TestCompanyHandler_Get(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -260,7 +354,7 @@ func TestCompanyHandler_List(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -272,6 +366,47 @@ func TestCompanyHandler_List(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
+
+	t.Run("passes industry and size filters", func(t *testing.T) {
+		var got *ports.ListOptions
+		mockRepo := &MockCompanyRepository{
+			ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.CompanyDTO, int, error) {
+				got = opts
+				return []*model.CompanyDTO{}, 0, nil
+			},
+		}
+
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
+		handler := NewCompanyHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/companies", mockAuthMiddleware(userID), handler.List)
+
+		req, _ := http.NewRequest(http.MethodGet, "/companies?industry=Fintech&size=Startup", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		require.NotNil(t, got)
+		assert.Equal(t, "Fintech", got.Industry)
+		assert.Equal(t, "startup", got.Size)
+	})
+
+	t.Run("returns 400 for unknown size", func(t *testing.T) {
+		mockRepo := &MockCompanyRepository{}
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
+		handler := NewCompanyHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/companies", mockAuthMiddleware(userID), handler.List)
+
+		req, _ := http.NewRequest(http.MethodGet, "/companies?size=huge", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "INVALID_COMPANY_SIZE")
+	})
 }
 
 func TestCompanyHandler_Update(t *testing.T) {
@@ -302,7 +437,7 @@ func TestCompanyHandler_Update(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -324,7 +459,7 @@ func TestCompanyHandler_Update(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -340,6 +475,81 @@ func TestCompanyHandler_Update(t *testing.T) {
 	})
 }
 
+func TestCompanyHandler_UpdateLogoURL(t *testing.T) {
+	userID := "user-123"
+	companyID := "company-1"
+
+	newRouter := func(mockRepo *MockCompanyRepository) *gin.Engine {
+		handler := NewCompanyHandler(service.NewCompanyService(mockRepo, nil, nil, nil))
+		router := setupTestRouter()
+		router.PATCH("/companies/:id/logo-url", mockAuthMiddleware(userID), handler.UpdateLogoURL)
+		return router
+	}
+
+	t.Run("sets logo and returns company", func(t *testing.T) {
+		logoURL := "https://cdn.example.com/acme.svg"
+		var saved *string
+		mockRepo := &MockCompanyRepository{
+			GetByIDFunc: func(ctx context.Context, uid, cid string) (*model.Company, error) {
+				return &model.Company{ID: cid, UserID: uid}, nil
+			},
+			UpdateLogoURLFunc: func(ctx context.Context, uid, cid string, url *string) error {
+				saved = url
+				return nil
+			},
+			GetByIDEnrichedFunc: func(ctx context.Context, uid, cid string) (*model.CompanyDTO, error) {
+				return &model.CompanyDTO{ID: cid, Name: "Acme", LogoURL: saved}, nil
+			},
+		}
+
+		body := `{"logo_url":"` + logoURL + `"}`
+		req, _ := http.NewRequest(http.MethodPatch, "/companies/"+companyID+"/logo-url", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		newRouter(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response model.CompanyDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.NotNil(t, response.LogoURL)
+		assert.Equal(t, logoURL, *response.LogoURL)
+	})
+
+	t.Run("returns 400 for invalid logo URL", func(t *testing.T) {
+		mockRepo := &MockCompanyRepository{
+			GetByIDFunc: func(ctx context.Context, uid, cid string) (*model.Company, error) {
+				return &model.Company{ID: cid, UserID: uid}, nil
+			},
+		}
+
+		body := `{"logo_url":"not a url"}`
+		req, _ := http.NewRequest(http.MethodPatch, "/companies/"+companyID+"/logo-url", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		newRouter(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeInvalidLogoURL))
+	})
+
+	t.Run("returns 404 when company not found", func(t *testing.T) {
+		mockRepo := &MockCompanyRepository{
+			GetByIDFunc: func(ctx context.Context, uid, cid string) (*model.Company, error) {
+				return nil, model.ErrCompanyNotFound
+			},
+		}
+
+		body := `{"logo_url":"https://cdn.example.com/acme.png"}`
+		req, _ := http.NewRequest(http.MethodPatch, "/companies/nonexistent/logo-url", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		newRouter(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
 func TestCompanyHandler_Delete(t *testing.T) {
 	userID := "user-123"
 	companyID := "company-1"
@@ -360,7 +570,7 @@ func TestCompanyHandler_Delete(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -380,7 +590,7 @@ func TestCompanyHandler_Delete(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -405,7 +615,7 @@ func TestCompanyHandler_GetRelatedCounts(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -451,7 +661,7 @@ func TestCompanyHandler_RegisterRoutes(t *testing.T) {
 		},
 	}
 
-	svc := service.NewCompanyService(mockRepo)
+	svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 	handler := NewCompanyHandler(svc)
 
 	router := setupTestRouter()
@@ -464,11 +674,15 @@ func TestCompanyHandler_RegisterRoutes(t *testing.T) {
 	}{
 		{http.MethodPost, "/api/v1/companies"},
 		{http.MethodGet, "/api/v1/companies"},
+		{http.MethodGet, "/api/v1/companies/duplicates"},
 		{http.MethodGet, "/api/v1/companies/test-id"},
 		{http.MethodGet, "/api/v1/companies/test-id/related-counts"},
+		{http.MethodGet, "/api/v1/companies/test-id/notes/export"},
 		{http.MethodPatch, "/api/v1/companies/test-id"},
+		{http.MethodPatch, "/api/v1/companies/test-id/logo-url"},
 		{http.MethodDelete, "/api/v1/companies/test-id"},
 		{http.MethodPost, "/api/v1/companies/test-id/favorite"},
+		{http.MethodPost, "/api/v1/companies/test-id/merge"},
 	}
 
 	for _, route := range routes {
@@ -502,7 +716,7 @@ func TestCompanyHandler_ToggleFavorite(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -523,7 +737,7 @@ func TestCompanyHandler_ToggleFavorite(t *testing.T) {
 			},
 		}
 
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -538,7 +752,7 @@ func TestCompanyHandler_ToggleFavorite(t *testing.T) {
 
 	t.Run("returns 401 without auth", func(t *testing.T) {
 		mockRepo := &MockCompanyRepository{}
-		svc := service.NewCompanyService(mockRepo)
+		svc := service.NewCompanyService(mockRepo, nil, nil, nil)
 		handler := NewCompanyHandler(svc)
 
 		router := setupTestRouter()
@@ -551,3 +765,216 @@ func TestCompanyHandler_ToggleFavorite(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, w.Code)
 	})
 }
+
+func TestCompanyHandler_Get_ETag(t *testing.T) {
+	userID := "user-123"
+	companyID := "company-1"
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	applicationsCount := 1
+
+	newRouter := func() *gin.Engine {
+		mockRepo := &MockCompanyRepository{
+			GetByIDEnrichedFunc: func(ctx context.Context, uid, cid string) (*model.CompanyDTO, error) {
+				return &model.CompanyDTO{ID: cid, Name: "Test Company", UpdatedAt: updatedAt, ApplicationsCount: applicationsCount}, nil
+			},
+		}
+		handler := NewCompanyHandler(service.NewCompanyService(mockRepo, nil, nil, nil))
+		router := setupTestRouter()
+		router.GET("/companies/:id", mockAuthMiddleware(userID), handler.Get)
+		return router
+	}
+
+	t.Run("returns 200 with ETag on cache miss", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/companies/"+companyID, nil)
+		w := httptest.NewRecorder()
+		newRouter().ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEmpty(t, w.Header().Get("ETag"))
+		assert.Equal(t, "private, max-age=0", w.Header().Get("Cache-Control"))
+		assert.Contains(t, w.Body.String(), "Test Company")
+	})
+
+	t.Run("returns 304 on cache hit", func(t *testing.T) {
+		// The tag hashes the response body, so take it from a first response
+		first := httptest.NewRecorder()
+		newRouter().ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/companies/"+companyID, nil))
+		etag := first.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+
+		req, _ := http.NewRequest(http.MethodGet, "/companies/"+companyID, nil)
+		req.Header.Set("If-None-Match", etag)
+		w := httptest.NewRecorder()
+		newRouter().ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("embedded counts change the tag without an update", func(t *testing.T) {
+		first := httptest.NewRecorder()
+		newRouter().ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/companies/"+companyID, nil))
+
+		applicationsCount = 2
+		defer func() { applicationsCount = 1 }()
+
+		req := httptest.NewRequest(http.MethodGet, "/companies/"+companyID, nil)
+		req.Header.Set("If-None-Match", first.Header().Get("ETag"))
+		w := httptest.NewRecorder()
+		newRouter().ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEqual(t, first.Header().Get("ETag"), w.Header().Get("ETag"))
+	})
+}
+
+func TestCompanyHandler_ExportNotes(t *testing.T) {
+	userID := "user-123"
+	companyID := "company-1"
+
+	setup := func(company *model.Company, err error) *gin.Engine {
+		mockRepo := &MockCompanyRepository{
+			GetByIDFunc: func(ctx context.Context, uid, cid string) (*model.Company, error) {
+				return company, err
+			},
+		}
+		handler := NewCompanyHandler(service.NewCompanyService(mockRepo, nil, nil, nil))
+
+		router := setupTestRouter()
+		router.GET("/companies/:id/notes/export", mockAuthMiddleware(userID), handler.ExportNotes)
+		return router
+	}
+
+	send := func(router *gin.Engine) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(http.MethodGet, "/companies/"+companyID+"/notes/export", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("returns notes as markdown file", func(t *testing.T) {
+		notes := "Series B, ~200 people.\nhttps://acme.example.com/careers"
+		location := "Berlin"
+		router := setup(&model.Company{ID: companyID, Name: "Acme Corp", Location: &location, Notes: &notes}, nil)
+
+		w := send(router)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/markdown; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=Acme-Corp-notes.md", w.Header().Get("Content-Disposition"))
+		lines := strings.Split(w.Body.String(), "\n")
+		assert.Equal(t, "# Acme Corp", lines[0])
+		assert.Contains(t, w.Body.String(), "- **Location:** Berlin")
+		assert.Contains(t, w.Body.String(), "https://acme.example.com/careers")
+	})
+
+	t.Run("returns 204 when notes are empty", func(t *testing.T) {
+		blank := "   "
+		for _, notes := range []*string{nil, &blank} {
+			router := setup(&model.Company{ID: companyID, Name: "Acme Corp", Notes: notes}, nil)
+
+			w := send(router)
+
+			assert.Equal(t, http.StatusNoContent, w.Code)
+			assert.Empty(t, w.Body.String())
+		}
+	})
+
+	t.Run("returns 404 when company not found", func(t *testing.T) {
+		router := setup(nil, model.ErrCompanyNotFound)
+
+		w := send(router)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCompanyHandler_FindDuplicates(t *testing.T) {
+	userID := "user-123"
+
+	mockRepo := &MockCompanyRepository{
+		ListSimilarPairsFunc: func(_ context.Context, _ string, _ float64) ([]*model.SimilarCompanyPair, error) {
+			return []*model.SimilarCompanyPair{{
+				First:  &model.Company{ID: "company-1", Name: "Acme"},
+				Second: &model.Company{ID: "company-2", Name: "Acme Inc", CreatedAt: time.Now()},
+			}}, nil
+		},
+	}
+
+	handler := NewCompanyHandler(service.NewCompanyService(mockRepo, nil, nil, nil))
+	router := setupTestRouter()
+	router.GET("/companies/duplicates", mockAuthMiddleware(userID), handler.FindDuplicates)
+
+	req, _ := http.NewRequest(http.MethodGet, "/companies/duplicates", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response []model.DuplicateGroupDTO
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response, 1)
+	assert.Equal(t, "Acme", response[0].Canonical.Name)
+	require.Len(t, response[0].Duplicates, 1)
+	assert.Equal(t, "Acme Inc", response[0].Duplicates[0].Name)
+}
+
+func TestCompanyHandler_Merge(t *testing.T) {
+	userID := "user-123"
+	companyID := "11111111-1111-1111-1111-111111111111"
+	duplicateID := "22222222-2222-2222-2222-222222222222"
+
+	send := func(mockRepo *MockCompanyRepository, body string) *httptest.ResponseRecorder {
+		handler := NewCompanyHandler(service.NewCompanyService(mockRepo, nil, nil, nil))
+		router := setupTestRouter()
+		router.POST("/companies/:id/merge", mockAuthMiddleware(userID), handler.Merge)
+
+		req, _ := http.NewRequest(http.MethodPost, "/companies/"+companyID+"/merge", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("returns the merged company", func(t *testing.T) {
+		mockRepo := &MockCompanyRepository{
+			MergeFunc: func(_ context.Context, _, intoID string, fromIDs []string) error {
+				assert.Equal(t, companyID, intoID)
+				assert.Equal(t, []string{duplicateID}, fromIDs)
+				return nil
+			},
+			GetByIDEnrichedFunc: func(_ context.Context, _, cid string) (*model.CompanyDTO, error) {
+				return &model.CompanyDTO{ID: cid, Name: "Acme"}, nil
+			},
+		}
+
+		w := send(mockRepo, `{"merge_from_ids":["`+duplicateID+`"]}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), companyID)
+	})
+
+	t.Run("returns 400 when merging a company into itself", func(t *testing.T) {
+		w := send(&MockCompanyRepository{}, `{"merge_from_ids":["`+companyID+`"]}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeCompanyMergeIntoSelf))
+	})
+
+	t.Run("returns 400 for an empty list", func(t *testing.T) {
+		w := send(&MockCompanyRepository{}, `{"merge_from_ids":[]}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("returns 404 when a company is not found", func(t *testing.T) {
+		mockRepo := &MockCompanyRepository{
+			MergeFunc: func(_ context.Context, _, _ string, _ []string) error {
+				return model.ErrCompanyNotFound
+			},
+		}
+
+		w := send(mockRepo, `{"merge_from_ids":["`+duplicateID+`"]}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
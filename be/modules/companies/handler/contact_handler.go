@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andreypavlenko/jobber/internal/platform/auth"
+	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/andreypavlenko/jobber/modules/companies/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ContactHandler handles company contact HTTP requests
+type ContactHandler struct {
+	service *service.ContactService
+}
+
+// NewContactHandler creates a new company contact handler
+func NewContactHandler(service *service.ContactService) *ContactHandler {
+	return &ContactHandler{service: service}
+}
+
+// Create godoc
+// @Summary Add a company contact
+// @Description Add a person at one of the authenticated user's companies. The email, if given, must be a valid address and the LinkedIn URL must point to linkedin.com.
+// @Tags companies
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Company ID"
+// @Param request body model.CreateContactRequest true "Contact details"
+// @Success 201 {object} model.ContactDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Company not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/{id}/contacts [post]
+func (h *ContactHandler) Create(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	var req model.CreateContactRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	contact, err := h.service.Create(c.Request.Context(), userID, c.Param("id"), &req)
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusCreated, contact)
+}
+
+// List godoc
+// @Summary List company contacts
+// @Description Get the contacts of a company, ordered by name
+// @Tags companies
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Company ID"
+// @Success 200 {array} model.ContactDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Company not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/{id}/contacts [get]
+func (h *ContactHandler) List(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	contacts, err := h.service.List(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, contacts)
+}
+
+// Get godoc
+// @Summary Get a company contact
+// @Description Get a single contact of one of the authenticated user's companies
+// @Tags companies
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Company ID"
+// @Param contactId path string true "Contact ID"
+// @Success 200 {object} model.ContactDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Company or contact not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/{id}/contacts/{contactId} [get]
+func (h *ContactHandler) Get(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	contact, err := h.service.Get(c.Request.Context(), userID, c.Param("id"), c.Param("contactId"))
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, contact)
+}
+
+// Update godoc
+// @Summary Update a company contact
+// @Description Update the given fields of a contact; an empty title, email, linkedin_url or notes clears it
+// @Tags companies
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Company ID"
+// @Param contactId path string true "Contact ID"
+// @Param request body model.UpdateContactRequest true "Fields to update"
+// @Success 200 {object} model.ContactDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Company or contact not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/{id}/contacts/{contactId} [patch]
+func (h *ContactHandler) Update(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	var req model.UpdateContactRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	contact, err := h.service.Update(c.Request.Context(), userID, c.Param("id"), c.Param("contactId"), &req)
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, contact)
+}
+
+// Delete godoc
+// @Summary Delete a company contact
+// @Description Delete a contact of a company
+// @Tags companies
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Company ID"
+// @Param contactId path string true "Contact ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Company or contact not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /companies/{id}/contacts/{contactId} [delete]
+func (h *ContactHandler) Delete(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), userID, c.Param("id"), c.Param("contactId")); err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Contact deleted successfully"})
+}
+
+func (h *ContactHandler) respondWithError(c *gin.Context, err error) {
+	errorCode := model.GetErrorCode(err)
+	statusCode := http.StatusInternalServerError
+	switch errorCode {
+	case model.CodeCompanyNotFound, model.CodeContactNotFound:
+		statusCode = http.StatusNotFound
+	case model.CodeContactNameRequired, model.CodeInvalidContactEmail, model.CodeInvalidLinkedInURL:
+		statusCode = http.StatusBadRequest
+	}
+	httpPlatform.RespondWithError(c, statusCode, string(errorCode), model.GetErrorMessage(err, auth.GetLocale(c)))
+}
+
+// RegisterRoutes registers company contact routes
+func (h *ContactHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	contacts := router.Group("/companies/:id/contacts")
+	contacts.Use(authMiddleware)
+	{
+		contacts.POST("", h.Create)
+		contacts.GET("", h.List)
+		contacts.GET("/:contactId", h.Get)
+		contacts.PATCH("/:contactId", h.Update)
+		contacts.DELETE("/:contactId", h.Delete)
+	}
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/andreypavlenko/jobber/modules/companies/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockContactRepository implements ports.ContactRepository
+type MockContactRepository struct {
+	GetByIDFunc       func(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error)
+	ListByCompanyFunc func(ctx context.Context, userID, companyID string) ([]*model.Contact, error)
+}
+
+func (m *MockContactRepository) Create(ctx context.Context, contact *model.Contact) error {
+	contact.ID = "contact-1"
+	return nil
+}
+
+func (m *MockContactRepository) GetByID(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, userID, companyID, contactID)
+	}
+	return nil, model.ErrContactNotFound
+}
+
+func (m *MockContactRepository) ListByCompany(ctx context.Context, userID, companyID string) ([]*model.Contact, error) {
+	if m.ListByCompanyFunc != nil {
+		return m.ListByCompanyFunc(ctx, userID, companyID)
+	}
+	return []*model.Contact{}, nil
+}
+
+func (m *MockContactRepository) Update(ctx context.Context, contact *model.Contact) error {
+	return nil
+}
+
+func (m *MockContactRepository) Delete(ctx context.Context, userID, companyID, contactID string) error {
+	return model.ErrContactNotFound
+}
+
+func setupContactRouter(contactRepo *MockContactRepository) *gin.Engine {
+	companyRepo := &MockCompanyRepository{
+		GetByIDFunc: func(ctx context.Context, userID, companyID string) (*model.Company, error) {
+			if companyID != "company-1" {
+				return nil, model.ErrCompanyNotFound
+			}
+			return &model.Company{ID: companyID, UserID: userID, Name: "Acme"}, nil
+		},
+	}
+	router := setupTestRouter()
+	handler := NewContactHandler(service.NewContactService(companyRepo, contactRepo))
+	handler.RegisterRoutes(router.Group("/api/v1"), mockAuthMiddleware("user-123"))
+	return router
+}
+
+func sendContactRequest(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestContactHandler_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		body       string
+		wantStatus int
+		wantCode   string
+	}{
+		{"creates contact", "/api/v1/companies/company-1/contacts", `{"name":"Jane","email":"jane@acme.com","linkedin_url":"https://linkedin.com/in/jane"}`, http.StatusCreated, ""},
+		{"rejects missing name", "/api/v1/companies/company-1/contacts", `{"title":"Recruiter"}`, http.StatusBadRequest, "VALIDATION_ERROR"},
+		{"rejects invalid email", "/api/v1/companies/company-1/contacts", `{"name":"Jane","email":"jane"}`, http.StatusBadRequest, "INVALID_CONTACT_EMAIL"},
+		{"rejects non-LinkedIn URL", "/api/v1/companies/company-1/contacts", `{"name":"Jane","linkedin_url":"https://example.com/jane"}`, http.StatusBadRequest, "INVALID_LINKEDIN_URL"},
+		{"returns 404 for unknown company", "/api/v1/companies/company-2/contacts", `{"name":"Jane"}`, http.StatusNotFound, "COMPANY_NOT_FOUND"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := sendContactRequest(setupContactRouter(&MockContactRepository{}), http.MethodPost, tt.path, tt.body)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantCode != "" {
+				assert.Contains(t, w.Body.String(), tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestContactHandler_List(t *testing.T) {
+	contactRepo := &MockContactRepository{ListByCompanyFunc: func(ctx context.Context, userID, companyID string) ([]*model.Contact, error) {
+		return []*model.Contact{{ID: "contact-1", CompanyID: companyID, Name: "Jane"}}, nil
+	}}
+
+	w := sendContactRequest(setupContactRouter(contactRepo), http.MethodGet, "/api/v1/companies/company-1/contacts", "")
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var contacts []model.ContactDTO
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &contacts))
+	require.Len(t, contacts, 1)
+	assert.Equal(t, "Jane", contacts[0].Name)
+}
+
+func TestContactHandler_Get(t *testing.T) {
+	contactRepo := &MockContactRepository{GetByIDFunc: func(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error) {
+		if userID != "user-123" || contactID != "contact-1" {
+			return nil, model.ErrContactNotFound
+		}
+		return &model.Contact{ID: contactID, CompanyID: companyID, UserID: userID, Name: "Jane"}, nil
+	}}
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantCode   string
+	}{
+		{"returns the contact", "/api/v1/companies/company-1/contacts/contact-1", http.StatusOK, `"name":"Jane"`},
+		{"returns 404 for unknown contact", "/api/v1/companies/company-1/contacts/missing", http.StatusNotFound, "CONTACT_NOT_FOUND"},
+		{"returns 404 for unknown company", "/api/v1/companies/company-2/contacts/contact-1", http.StatusNotFound, "COMPANY_NOT_FOUND"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := sendContactRequest(setupContactRouter(contactRepo), http.MethodGet, tt.path, "")
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantCode)
+		})
+	}
+}
+
+func TestContactHandler_Update(t *testing.T) {
+	t.Run("updates contact", func(t *testing.T) {
+		contactRepo := &MockContactRepository{GetByIDFunc: func(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error) {
+			return &model.Contact{ID: contactID, CompanyID: companyID, UserID: userID, Name: "Jane"}, nil
+		}}
+
+		w := sendContactRequest(setupContactRouter(contactRepo), http.MethodPatch, "/api/v1/companies/company-1/contacts/contact-1", `{"title":"Hiring Manager"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"title":"Hiring Manager"`)
+	})
+
+	t.Run("returns 404 for unknown contact", func(t *testing.T) {
+		w := sendContactRequest(setupContactRouter(&MockContactRepository{}), http.MethodPatch, "/api/v1/companies/company-1/contacts/missing", `{"title":"CTO"}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "CONTACT_NOT_FOUND")
+	})
+}
+
+func TestContactHandler_Delete(t *testing.T) {
+	w := sendContactRequest(setupContactRouter(&MockContactRepository{}), http.MethodDelete, "/api/v1/companies/company-1/contacts/missing", "")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "CONTACT_NOT_FOUND")
+}
@@ -1,6 +1,11 @@
 package model
 
-import "time"
+import (
+	"strconv"
+	"time"
+
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
+)
 
 // Company represents a company entity
 type Company struct {
@@ -9,24 +14,50 @@ type Company struct {
 	Name       string
 	Location   *string
 	Notes      *string
+	LogoURL    *string
 	IsFavorite bool
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	// Profile details; all optional
+	Industry    *string
+	Size        *string
+	WebsiteURL  *string
+	LinkedInURL *string
+	FoundedYear *int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 // CompanyDTO represents company data transfer object with enriched fields
 type CompanyDTO struct {
-	ID                      string     `json:"id"`
-	Name                    string     `json:"name"`
-	Location                *string    `json:"location,omitempty"`
-	Notes                   *string    `json:"notes,omitempty"`
-	IsFavorite              bool       `json:"is_favorite"`
-	CreatedAt               time.Time  `json:"created_at"`
-	UpdatedAt               time.Time  `json:"updated_at"`
-	ApplicationsCount       int        `json:"applications_count"`
-	ActiveApplicationsCount int        `json:"active_applications_count"`
-	DerivedStatus           string     `json:"derived_status"`
-	LastActivityAt          *time.Time `json:"last_activity_at,omitempty"`
+	ID                      string        `json:"id"`
+	Name                    string        `json:"name"`
+	Location                *string       `json:"location,omitempty"`
+	Notes                   *string       `json:"notes,omitempty"`
+	LogoURL                 *string       `json:"logo_url,omitempty"`
+	IsFavorite              bool          `json:"is_favorite"`
+	Industry                *string       `json:"industry,omitempty"`
+	Size                    *string       `json:"size,omitempty"`
+	WebsiteURL              *string       `json:"website_url,omitempty"`
+	LinkedInURL             *string       `json:"linkedin_url,omitempty"`
+	FoundedYear             *int          `json:"founded_year,omitempty"`
+	CreatedAt               time.Time     `json:"created_at"`
+	UpdatedAt               time.Time     `json:"updated_at"`
+	ApplicationsCount       int           `json:"applications_count"`
+	ActiveApplicationsCount int           `json:"active_applications_count"`
+	JobsCount               int           `json:"jobs_count"`
+	ActiveJobsCount         int           `json:"active_jobs_count"`
+	DerivedStatus           string        `json:"derived_status"`
+	LastActivityAt          *time.Time    `json:"last_activity_at,omitempty"`
+	TagIDs                  []string      `json:"tag_ids,omitempty"`
+	Contacts                []*ContactDTO `json:"contacts,omitempty"` // only set when fetching a single company
+}
+
+// Cursor returns the keyset cursor positioned after this company for the given sort field
+func (d *CompanyDTO) Cursor(sortBy string) keyset.Cursor {
+	cursor := keyset.Cursor{LastID: d.ID, LastValue: d.Name}
+	if sortBy == "applications_count" {
+		cursor.LastValue = strconv.Itoa(d.ApplicationsCount)
+	}
+	return cursor
 }
 
 // CompanyStatus represents the derived status of a company
@@ -38,15 +69,66 @@ const (
 	CompanyStatusInterviewing CompanyStatus = "interviewing" // Has applications past "Applied" stage
 )
 
+// CompanySize is the headcount bracket of a company
+type CompanySize string
+
+const (
+	CompanySizeStartup    CompanySize = "startup"
+	CompanySizeSmall      CompanySize = "small"
+	CompanySizeMedium     CompanySize = "medium"
+	CompanySizeLarge      CompanySize = "large"
+	CompanySizeEnterprise CompanySize = "enterprise"
+)
+
+// IsValidCompanySize reports whether size is one of the known company sizes
+func IsValidCompanySize(size string) bool {
+	switch CompanySize(size) {
+	case CompanySizeStartup, CompanySizeSmall, CompanySizeMedium, CompanySizeLarge, CompanySizeEnterprise:
+		return true
+	}
+	return false
+}
+
+// MinFoundedYear is the earliest accepted founding year; the latest is next year
+const MinFoundedYear = 1800
+
 // ToDTO converts Company to CompanyDTO
 func (c *Company) ToDTO() *CompanyDTO {
 	return &CompanyDTO{
-		ID:         c.ID,
-		Name:       c.Name,
-		Location:   c.Location,
-		Notes:      c.Notes,
-		IsFavorite: c.IsFavorite,
-		CreatedAt:  c.CreatedAt,
-		UpdatedAt:  c.UpdatedAt,
+		ID:          c.ID,
+		Name:        c.Name,
+		Location:    c.Location,
+		Notes:       c.Notes,
+		LogoURL:     c.LogoURL,
+		IsFavorite:  c.IsFavorite,
+		Industry:    c.Industry,
+		Size:        c.Size,
+		WebsiteURL:  c.WebsiteURL,
+		LinkedInURL: c.LinkedInURL,
+		FoundedYear: c.FoundedYear,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
 	}
 }
+
+// DuplicateNameSimilarity is the pg_trgm similarity from which two company names count as duplicates
+const DuplicateNameSimilarity = 0.7
+
+// SimilarCompanyPair is two companies of a user with similar names; First is the older one
+type SimilarCompanyPair struct {
+	First  *Company
+	Second *Company
+}
+
+// DuplicateGroupDTO is a set of companies with similar names. The oldest company is
+// the canonical one the others can be merged into.
+type DuplicateGroupDTO struct {
+	Canonical  *CompanyDTO   `json:"canonical"`
+	Duplicates []*CompanyDTO `json:"duplicates"`
+}
+
+// NotesExport is a company's notes rendered as a downloadable Markdown file
+type NotesExport struct {
+	Filename string
+	Content  []byte
+}
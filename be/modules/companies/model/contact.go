@@ -0,0 +1,74 @@
+package model
+
+import "time"
+
+// Contact is a person the user knows at a company
+type Contact struct {
+	ID          string
+	CompanyID   string
+	UserID      string
+	Name        string
+	Title       *string
+	Email       *string
+	LinkedInURL *string
+	Notes       *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ContactDTO represents contact data transfer object
+type ContactDTO struct {
+	ID          string    `json:"id"`
+	CompanyID   string    `json:"company_id"`
+	Name        string    `json:"name"`
+	Title       *string   `json:"title,omitempty"`
+	Email       *string   `json:"email,omitempty"`
+	LinkedInURL *string   `json:"linkedin_url,omitempty"`
+	Notes       *string   `json:"notes,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ToDTO converts Contact to ContactDTO
+func (c *Contact) ToDTO() *ContactDTO {
+	return &ContactDTO{
+		ID:          c.ID,
+		CompanyID:   c.CompanyID,
+		Name:        c.Name,
+		Title:       c.Title,
+		Email:       c.Email,
+		LinkedInURL: c.LinkedInURL,
+		Notes:       c.Notes,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+// ContactApplication is an application a contact was involved in, with their role in it
+type ContactApplication struct {
+	ApplicationID     string
+	ApplicationName   string
+	ApplicationStatus string
+	Role              string
+	LinkedAt          time.Time
+}
+
+// ContactApplicationDTO represents an application linked to a contact
+type ContactApplicationDTO struct {
+	ApplicationID     string    `json:"application_id"`
+	ApplicationName   string    `json:"application_name"`
+	ApplicationStatus string    `json:"application_status"`
+	Role              string    `json:"role"`
+	LinkedAt          time.Time `json:"linked_at"`
+}
+
+// ToDTO converts ContactApplication to ContactApplicationDTO
+func (a *ContactApplication) ToDTO() *ContactApplicationDTO {
+	return &ContactApplicationDTO{
+		ApplicationID:     a.ApplicationID,
+		ApplicationName:   a.ApplicationName,
+		ApplicationStatus: a.ApplicationStatus,
+		Role:              a.Role,
+		LinkedAt:          a.LinkedAt,
+	}
+}
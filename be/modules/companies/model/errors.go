@@ -1,44 +1,88 @@
 package model
 
-import "errors"
+import (
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
 
 var (
 	// ErrCompanyNotFound is returned when a company is not found
-	ErrCompanyNotFound = errors.New("company not found")
+	ErrCompanyNotFound = &DomainError{Code: CodeCompanyNotFound, Message: "company not found"}
 
 	// ErrCompanyNameRequired is returned when company name is empty
-	ErrCompanyNameRequired = errors.New("company name is required")
+	ErrCompanyNameRequired = &DomainError{Code: CodeCompanyNameRequired, Message: "company name is required"}
+
+	// ErrInvalidLogoURL is returned when a logo URL is malformed or does not point to an image
+	ErrInvalidLogoURL = &DomainError{Code: CodeInvalidLogoURL, Message: "logo URL must point to an image"}
+
+	// ErrLogoURLNotAccessible is returned when a logo URL cannot be reached to verify its content type
+	ErrLogoURLNotAccessible = &DomainError{Code: CodeLogoURLNotAccessible, Message: "logo URL is not accessible"}
+
+	// ErrContactNotFound is returned when a company contact is not found
+	ErrContactNotFound = &DomainError{Code: CodeContactNotFound, Message: "contact not found"}
+
+	// ErrContactNameRequired is returned when a contact name is empty
+	ErrContactNameRequired = &DomainError{Code: CodeContactNameRequired, Message: "contact name is required"}
+
+	// ErrInvalidContactEmail is returned when a contact email is malformed
+	ErrInvalidContactEmail = &DomainError{Code: CodeInvalidContactEmail, Message: "contact email is invalid"}
+
+	// ErrInvalidLinkedInURL is returned when a LinkedIn URL does not point to linkedin.com
+	ErrInvalidLinkedInURL = &DomainError{Code: CodeInvalidLinkedInURL, Message: "LinkedIn URL must point to linkedin.com"}
+
+	// ErrInvalidCompanySize is returned when a company size is not one of the known sizes
+	ErrInvalidCompanySize = &DomainError{Code: CodeInvalidCompanySize, Message: "company size must be one of startup, small, medium, large, enterprise"}
+
+	// ErrInvalidFoundedYear is returned when a founding year is before 1800 or after next year
+	ErrInvalidFoundedYear = &DomainError{Code: CodeInvalidFoundedYear, Message: "founded year is out of range"}
+
+	// ErrInvalidWebsiteURL is returned when a company website URL is malformed
+	ErrInvalidWebsiteURL = &DomainError{Code: CodeInvalidWebsiteURL, Message: "website URL is invalid"}
+
+	// ErrCompanyMergeIntoSelf is returned when a company is listed among the companies merged into it
+	ErrCompanyMergeIntoSelf = &DomainError{Code: CodeCompanyMergeIntoSelf, Message: "company cannot be merged into itself"}
+
+	// ErrApplicationNotFound is returned when an application linked to a contact is not found
+	ErrApplicationNotFound = &DomainError{Code: CodeApplicationNotFound, Message: "application not found"}
+
+	// ErrApplicationContactNotFound is returned when a contact is not linked to an application
+	ErrApplicationContactNotFound = &DomainError{Code: CodeApplicationContactNotFound, Message: "contact is not linked to the application"}
+
+	// ErrApplicationContactExists is returned when a contact is already linked to an application
+	ErrApplicationContactExists = &DomainError{Code: CodeApplicationContactExists, Message: "contact is already linked to the application"}
 )
 
 // ErrorCode represents error codes
 type ErrorCode string
 
 const (
-	CodeCompanyNotFound     ErrorCode = "COMPANY_NOT_FOUND"
-	CodeCompanyNameRequired ErrorCode = "COMPANY_NAME_REQUIRED"
-	CodeInternalError       ErrorCode = "INTERNAL_ERROR"
+	CodeCompanyNotFound            ErrorCode = "COMPANY_NOT_FOUND"
+	CodeCompanyNameRequired        ErrorCode = "COMPANY_NAME_REQUIRED"
+	CodeInvalidLogoURL             ErrorCode = "INVALID_LOGO_URL"
+	CodeLogoURLNotAccessible       ErrorCode = "LOGO_URL_NOT_ACCESSIBLE"
+	CodeContactNotFound            ErrorCode = "CONTACT_NOT_FOUND"
+	CodeContactNameRequired        ErrorCode = "CONTACT_NAME_REQUIRED"
+	CodeInvalidContactEmail        ErrorCode = "INVALID_CONTACT_EMAIL"
+	CodeInvalidLinkedInURL         ErrorCode = "INVALID_LINKEDIN_URL"
+	CodeInvalidCompanySize         ErrorCode = "INVALID_COMPANY_SIZE"
+	CodeInvalidFoundedYear         ErrorCode = "INVALID_FOUNDED_YEAR"
+	CodeInvalidWebsiteURL          ErrorCode = "INVALID_WEBSITE_URL"
+	CodeCompanyMergeIntoSelf       ErrorCode = "COMPANY_MERGE_INTO_SELF"
+	CodeApplicationNotFound        ErrorCode = "APPLICATION_NOT_FOUND"
+	CodeApplicationContactNotFound ErrorCode = "APPLICATION_CONTACT_NOT_FOUND"
+	CodeApplicationContactExists   ErrorCode = "APPLICATION_CONTACT_EXISTS"
+	CodeInternalError              ErrorCode = "INTERNAL_ERROR"
 )
 
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
 // GetErrorCode maps errors to error codes
 func GetErrorCode(err error) ErrorCode {
-	switch {
-	case errors.Is(err, ErrCompanyNotFound):
-		return CodeCompanyNotFound
-	case errors.Is(err, ErrCompanyNameRequired):
-		return CodeCompanyNameRequired
-	default:
-		return CodeInternalError
-	}
+	return domainerr.CodeOf(err, CodeInternalError)
 }
 
-// GetErrorMessage returns a user-friendly error message
-func GetErrorMessage(err error) string {
-	switch {
-	case errors.Is(err, ErrCompanyNotFound):
-		return "Company not found"
-	case errors.Is(err, ErrCompanyNameRequired):
-		return "Company name is required"
-	default:
-		return "Internal server error"
-	}
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
 }
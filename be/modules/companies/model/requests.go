@@ -2,14 +2,65 @@ package model
 
 // CreateCompanyRequest represents a create company request
 type CreateCompanyRequest struct {
-	Name     string  `json:"name" binding:"required,min=1,max=255"`
-	Location *string `json:"location,omitempty"`
-	Notes    *string `json:"notes,omitempty"`
+	Name        string  `json:"name" binding:"required,min=1,max=255"`
+	Location    *string `json:"location,omitempty"`
+	Notes       *string `json:"notes,omitempty"`
+	Industry    *string `json:"industry,omitempty" binding:"omitempty,max=100"`
+	Size        *string `json:"size,omitempty"`
+	WebsiteURL  *string `json:"website_url,omitempty" binding:"omitempty,max=2048"`
+	LinkedInURL *string `json:"linkedin_url,omitempty" binding:"omitempty,max=2048"`
+	FoundedYear *int    `json:"founded_year,omitempty"`
 }
 
-// UpdateCompanyRequest represents an update company request
+// UpdateCompanyRequest represents an update company request.
+// Empty industry, size, website_url or linkedin_url and a founded_year of 0 clear the field.
 type UpdateCompanyRequest struct {
-	Name     *string `json:"name,omitempty"`
-	Location *string `json:"location,omitempty"`
-	Notes    *string `json:"notes,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Location    *string `json:"location,omitempty"`
+	Notes       *string `json:"notes,omitempty"`
+	Industry    *string `json:"industry,omitempty" binding:"omitempty,max=100"`
+	Size        *string `json:"size,omitempty"`
+	WebsiteURL  *string `json:"website_url,omitempty" binding:"omitempty,max=2048"`
+	LinkedInURL *string `json:"linkedin_url,omitempty" binding:"omitempty,max=2048"`
+	FoundedYear *int    `json:"founded_year,omitempty"`
+}
+
+// UpdateLogoURLRequest represents a request to set or clear a company logo
+type UpdateLogoURLRequest struct {
+	LogoURL string `json:"logo_url"`
+}
+
+// MergeCompaniesRequest lists the companies to merge into another one and delete
+type MergeCompaniesRequest struct {
+	MergeFromIDs []string `json:"merge_from_ids" binding:"required,min=1,max=50,dive,uuid"`
+}
+
+// CreateContactRequest represents a create company contact request
+type CreateContactRequest struct {
+	Name        string  `json:"name" binding:"required,min=1,max=255"`
+	Title       *string `json:"title,omitempty" binding:"omitempty,max=255"`
+	Email       *string `json:"email,omitempty" binding:"omitempty,max=255"`
+	LinkedInURL *string `json:"linkedin_url,omitempty" binding:"omitempty,max=2048"`
+	Notes       *string `json:"notes,omitempty"`
+}
+
+// UpdateContactRequest represents an update company contact request.
+// Empty title, email, linkedin_url or notes clear the field.
+type UpdateContactRequest struct {
+	Name        *string `json:"name,omitempty" binding:"omitempty,max=255"`
+	Title       *string `json:"title,omitempty" binding:"omitempty,max=255"`
+	Email       *string `json:"email,omitempty" binding:"omitempty,max=255"`
+	LinkedInURL *string `json:"linkedin_url,omitempty" binding:"omitempty,max=2048"`
+	Notes       *string `json:"notes,omitempty"`
+}
+
+// LinkContactApplicationRequest links a contact to one of the user's applications
+type LinkContactApplicationRequest struct {
+	ApplicationID string `json:"application_id" binding:"required,uuid"`
+	Role          string `json:"role" binding:"max=100"`
+}
+
+// UpdateContactApplicationRequest changes the contact's role in an application
+type UpdateContactApplicationRequest struct {
+	Role string `json:"role" binding:"max=100"`
 }
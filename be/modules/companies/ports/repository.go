@@ -3,6 +3,7 @@ package ports
 import (
 	"context"
 
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	"github.com/andreypavlenko/jobber/modules/companies/model"
 )
 
@@ -12,16 +13,49 @@ type ListOptions struct {
 	Offset  int
 	SortBy  string // "name", "last_activity", "applications_count"
 	SortDir string // "asc", "desc"
+	// Industry matches case-insensitively; Size must be one of the company sizes
+	Industry string
+	Size     string
+	// Cursor switches to keyset pagination for the name and applications_count sorts
+	Cursor *keyset.Cursor
 }
 
 // CompanyRepository defines the interface for company data access
 type CompanyRepository interface {
 	Create(ctx context.Context, company *model.Company) error
 	GetByID(ctx context.Context, userID, companyID string) (*model.Company, error)
+	// GetByName returns the user's oldest company whose name matches case-insensitively
+	GetByName(ctx context.Context, userID, name string) (*model.Company, error)
 	GetByIDEnriched(ctx context.Context, userID, companyID string) (*model.CompanyDTO, error)
 	List(ctx context.Context, userID string, opts *ListOptions) ([]*model.CompanyDTO, int, error)
 	Update(ctx context.Context, company *model.Company) error
 	Delete(ctx context.Context, userID, companyID string) error
 	GetRelatedJobsAndApplicationsCount(ctx context.Context, userID, companyID string) (jobsCount, appsCount int, err error)
 	ToggleFavorite(ctx context.Context, userID, companyID string) (bool, error)
+	UpdateLogoURL(ctx context.Context, userID, companyID string, logoURL *string) error
+	// ListSimilarPairs returns every pair of the user's companies whose names are at least threshold similar
+	ListSimilarPairs(ctx context.Context, userID string, threshold float64) ([]*model.SimilarCompanyPair, error)
+	// Merge moves everything attached to fromIDs to intoID and deletes fromIDs in one transaction
+	Merge(ctx context.Context, userID, intoID string, fromIDs []string) error
+}
+
+// ContactRepository defines the interface for company contact data access
+type ContactRepository interface {
+	Create(ctx context.Context, contact *model.Contact) error
+	GetByID(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error)
+	ListByCompany(ctx context.Context, userID, companyID string) ([]*model.Contact, error)
+	Update(ctx context.Context, contact *model.Contact) error
+	Delete(ctx context.Context, userID, companyID, contactID string) error
+}
+
+// ApplicationContactRepository defines the interface for the links between
+// contacts and the applications they were involved in. Every method only
+// matches applications and contacts that belong to userID.
+type ApplicationContactRepository interface {
+	// Create links the contact to the application; it returns ErrApplicationNotFound
+	// unless the application belongs to the user
+	Create(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error)
+	ListByContact(ctx context.Context, userID, contactID string) ([]*model.ContactApplication, error)
+	UpdateRole(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error)
+	Delete(ctx context.Context, userID, contactID, applicationID string) error
 }
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ApplicationContactRepository implements ports.ApplicationContactRepository
+type ApplicationContactRepository struct {
+	pool DBPool
+}
+
+// NewApplicationContactRepository creates a new application contact repository
+func NewApplicationContactRepository(pool *pgxpool.Pool) *ApplicationContactRepository {
+	return &ApplicationContactRepository{pool: pool}
+}
+
+// NewApplicationContactRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewApplicationContactRepositoryWithPool(pool DBPool) *ApplicationContactRepository {
+	return &ApplicationContactRepository{pool: pool}
+}
+
+// Create links a contact to an application. Both must belong to the user and
+// trashed applications cannot be linked.
+func (r *ApplicationContactRepository) Create(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error) {
+	query := `
+		WITH linked AS (
+			INSERT INTO application_contacts (application_id, contact_id, role, created_at)
+			SELECT a.id, c.id, $4, $5
+			FROM applications a
+			JOIN company_contacts c ON c.id = $2 AND c.user_id = $1
+			WHERE a.id = $3 AND a.user_id = $1 AND a.deleted_at IS NULL
+			RETURNING application_id, role, created_at
+		)
+		SELECT l.application_id, a.name, a.status, l.role, l.created_at
+		FROM linked l
+		JOIN applications a ON a.id = l.application_id
+	`
+
+	link, err := scanContactApplication(r.pool.QueryRow(ctx, query, userID, contactID, applicationID, role, time.Now().UTC()))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, model.ErrApplicationNotFound
+		case errors.As(err, &pgErr) && pgErr.Code == "23505":
+			return nil, model.ErrApplicationContactExists
+		}
+		return nil, err
+	}
+	return link, nil
+}
+
+// ListByContact lists the user's applications the contact was involved in, most recently linked first
+func (r *ApplicationContactRepository) ListByContact(ctx context.Context, userID, contactID string) ([]*model.ContactApplication, error) {
+	query := `
+		SELECT ac.application_id, a.name, a.status, ac.role, ac.created_at
+		FROM application_contacts ac
+		JOIN applications a ON a.id = ac.application_id
+		JOIN company_contacts c ON c.id = ac.contact_id
+		WHERE ac.contact_id = $2 AND c.user_id = $1 AND a.user_id = $1 AND a.deleted_at IS NULL
+		ORDER BY ac.created_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, contactID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	links := []*model.ContactApplication{}
+	for rows.Next() {
+		link, err := scanContactApplication(rows)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	return links, rows.Err()
+}
+
+// UpdateRole changes the contact's role in an application
+func (r *ApplicationContactRepository) UpdateRole(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error) {
+	query := `
+		UPDATE application_contacts ac
+		SET role = $4
+		FROM applications a, company_contacts c
+		WHERE ac.contact_id = $2 AND ac.application_id = $3
+			AND a.id = ac.application_id AND a.user_id = $1 AND a.deleted_at IS NULL
+			AND c.id = ac.contact_id AND c.user_id = $1
+		RETURNING ac.application_id, a.name, a.status, ac.role, ac.created_at
+	`
+
+	link, err := scanContactApplication(r.pool.QueryRow(ctx, query, userID, contactID, applicationID, role))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrApplicationContactNotFound
+		}
+		return nil, err
+	}
+	return link, nil
+}
+
+// Delete unlinks a contact from an application
+func (r *ApplicationContactRepository) Delete(ctx context.Context, userID, contactID, applicationID string) error {
+	query := `
+		DELETE FROM application_contacts ac
+		USING applications a, company_contacts c
+		WHERE ac.contact_id = $2 AND ac.application_id = $3
+			AND a.id = ac.application_id AND a.user_id = $1
+			AND c.id = ac.contact_id AND c.user_id = $1
+	`
+
+	result, err := r.pool.Exec(ctx, query, userID, contactID, applicationID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return model.ErrApplicationContactNotFound
+	}
+
+	return nil
+}
+
+func scanContactApplication(row pgx.Row) (*model.ContactApplication, error) {
+	link := &model.ContactApplication{}
+	err := row.Scan(
+		&link.ApplicationID,
+		&link.ApplicationName,
+		&link.ApplicationStatus,
+		&link.Role,
+		&link.LinkedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return link, nil
+}
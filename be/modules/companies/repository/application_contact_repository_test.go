@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var contactApplicationRowColumns = []string{"application_id", "name", "status", "role", "created_at"}
+
+func TestApplicationContactRepository_Create(t *testing.T) {
+	t.Run("links an application of the user", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`INSERT INTO application_contacts .* JOIN company_contacts c ON c.id = \$2 AND c.user_id = \$1\s+WHERE a.id = \$3 AND a.user_id = \$1 AND a.deleted_at IS NULL`).
+			WithArgs("user-123", "contact-1", "app-1", "recruiter", pgxmock.AnyArg()).
+			WillReturnRows(pgxmock.NewRows(contactApplicationRowColumns).
+				AddRow("app-1", "Backend Engineer", "active", "recruiter", now))
+
+		repo := NewApplicationContactRepositoryWithPool(mock)
+		link, err := repo.Create(context.Background(), "user-123", "contact-1", "app-1", "recruiter")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Backend Engineer", link.ApplicationName)
+		assert.Equal(t, "recruiter", link.Role)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found for another user's application", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("INSERT INTO application_contacts").
+			WithArgs("user-123", "contact-1", "app-2", "", pgxmock.AnyArg()).
+			WillReturnError(pgx.ErrNoRows)
+
+		repo := NewApplicationContactRepositoryWithPool(mock)
+		_, err = repo.Create(context.Background(), "user-123", "contact-1", "app-2", "")
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns exists for a duplicate link", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("INSERT INTO application_contacts").
+			WithArgs("user-123", "contact-1", "app-1", "", pgxmock.AnyArg()).
+			WillReturnError(&pgconn.PgError{Code: "23505"})
+
+		repo := NewApplicationContactRepositoryWithPool(mock)
+		_, err = repo.Create(context.Background(), "user-123", "contact-1", "app-1", "")
+
+		assert.ErrorIs(t, err, model.ErrApplicationContactExists)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestApplicationContactRepository_ListByContact(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	now := time.Now()
+	// Both the contact and the application must belong to the user
+	mock.ExpectQuery(`FROM application_contacts ac.*WHERE ac.contact_id = \$2 AND c.user_id = \$1 AND a.user_id = \$1 AND a.deleted_at IS NULL`).
+		WithArgs("user-123", "contact-1").
+		WillReturnRows(pgxmock.NewRows(contactApplicationRowColumns).
+			AddRow("app-2", "Platform Engineer", "active", "interviewer", now).
+			AddRow("app-1", "Backend Engineer", "rejected", "recruiter", now.Add(-time.Hour)))
+
+	repo := NewApplicationContactRepositoryWithPool(mock)
+	links, err := repo.ListByContact(context.Background(), "user-123", "contact-1")
+
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+	assert.Equal(t, "app-2", links[0].ApplicationID)
+	assert.Equal(t, "rejected", links[1].ApplicationStatus)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplicationContactRepository_UpdateRole(t *testing.T) {
+	t.Run("updates the role", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`UPDATE application_contacts ac\s+SET role = \$4`).
+			WithArgs("user-123", "contact-1", "app-1", "interviewer").
+			WillReturnRows(pgxmock.NewRows(contactApplicationRowColumns).
+				AddRow("app-1", "Backend Engineer", "active", "interviewer", time.Now()))
+
+		repo := NewApplicationContactRepositoryWithPool(mock)
+		link, err := repo.UpdateRole(context.Background(), "user-123", "contact-1", "app-1", "interviewer")
+
+		require.NoError(t, err)
+		assert.Equal(t, "interviewer", link.Role)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found for an unlinked application", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("UPDATE application_contacts").
+			WithArgs("user-123", "contact-1", "app-2", "").
+			WillReturnError(pgx.ErrNoRows)
+
+		repo := NewApplicationContactRepositoryWithPool(mock)
+		_, err = repo.UpdateRole(context.Background(), "user-123", "contact-1", "app-2", "")
+
+		assert.ErrorIs(t, err, model.ErrApplicationContactNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestApplicationContactRepository_Delete(t *testing.T) {
+	t.Run("unlinks the application", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("DELETE FROM application_contacts").
+			WithArgs("user-123", "contact-1", "app-1").
+			WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+		repo := NewApplicationContactRepositoryWithPool(mock)
+		require.NoError(t, repo.Delete(context.Background(), "user-123", "contact-1", "app-1"))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found for an unlinked application", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("DELETE FROM application_contacts").
+			WithArgs("user-123", "contact-1", "app-2").
+			WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+		repo := NewApplicationContactRepositoryWithPool(mock)
+		err = repo.Delete(context.Background(), "user-123", "contact-1", "app-2")
+
+		assert.ErrorIs(t, err, model.ErrApplicationContactNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -7,16 +7,26 @@ import (
 	"strings"
 	"time"
 
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	"github.com/andreypavlenko/jobber/modules/companies/model"
 	"github.com/andreypavlenko/jobber/modules/companies/ports"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
 // CompanyRepository implements ports.CompanyRepository
 type CompanyRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 // NewCompanyRepository creates a new company repository
@@ -24,11 +34,16 @@ func NewCompanyRepository(pool *pgxpool.Pool) *CompanyRepository {
 	return &CompanyRepository{pool: pool}
 }
 
+// NewCompanyRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewCompanyRepositoryWithPool(pool DBPool) *CompanyRepository {
+	return &CompanyRepository{pool: pool}
+}
+
 // Create creates a new company
 func (r *CompanyRepository) Create(ctx context.Context, company *model.Company) error {
 	query := `
-		INSERT INTO companies (id, user_id, name, location, notes, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO companies (id, user_id, name, location, notes, industry, size, website_url, linkedin_url, founded_year, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 	`
 
 	company.ID = uuid.New().String()
@@ -42,6 +57,11 @@ func (r *CompanyRepository) Create(ctx context.Context, company *model.Company)
 		company.Name,
 		company.Location,
 		company.Notes,
+		company.Industry,
+		company.Size,
+		company.WebsiteURL,
+		company.LinkedInURL,
+		company.FoundedYear,
 		company.CreatedAt,
 		company.UpdatedAt,
 	)
@@ -52,7 +72,8 @@ func (r *CompanyRepository) Create(ctx context.Context, company *model.Company)
 // GetByID retrieves a company by ID
 func (r *CompanyRepository) GetByID(ctx context.Context, userID, companyID string) (*model.Company, error) {
 	query := `
-		SELECT id, user_id, name, location, notes, is_favorite, created_at, updated_at
+		SELECT id, user_id, name, location, notes, logo_url, is_favorite,
+			industry, size, website_url, linkedin_url, founded_year, created_at, updated_at
 		FROM companies
 		WHERE id = $1 AND user_id = $2
 	`
@@ -64,7 +85,52 @@ func (r *CompanyRepository) GetByID(ctx context.Context, userID, companyID strin
 		&company.Name,
 		&company.Location,
 		&company.Notes,
+		&company.LogoURL,
+		&company.IsFavorite,
+		&company.Industry,
+		&company.Size,
+		&company.WebsiteURL,
+		&company.LinkedInURL,
+		&company.FoundedYear,
+		&company.CreatedAt,
+		&company.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrCompanyNotFound
+		}
+		return nil, err
+	}
+
+	return company, nil
+}
+
+// GetByName retrieves the user's oldest company whose name matches, ignoring case and surrounding spaces
+func (r *CompanyRepository) GetByName(ctx context.Context, userID, name string) (*model.Company, error) {
+	query := `
+		SELECT id, user_id, name, location, notes, logo_url, is_favorite,
+			industry, size, website_url, linkedin_url, founded_year, created_at, updated_at
+		FROM companies
+		WHERE user_id = $1 AND LOWER(TRIM(name)) = LOWER(TRIM($2))
+		ORDER BY created_at
+		LIMIT 1
+	`
+
+	company := &model.Company{}
+	err := r.pool.QueryRow(ctx, query, userID, name).Scan(
+		&company.ID,
+		&company.UserID,
+		&company.Name,
+		&company.Location,
+		&company.Notes,
+		&company.LogoURL,
 		&company.IsFavorite,
+		&company.Industry,
+		&company.Size,
+		&company.WebsiteURL,
+		&company.LinkedInURL,
+		&company.FoundedYear,
 		&company.CreatedAt,
 		&company.UpdatedAt,
 	)
@@ -97,20 +163,29 @@ func (r *CompanyRepository) GetByIDEnriched(ctx context.Context, userID, company
 			c.name,
 			c.location,
 			c.notes,
+			c.logo_url,
 			c.is_favorite,
+			c.industry,
+			c.size,
+			c.website_url,
+			c.linkedin_url,
+			c.founded_year,
 			c.created_at,
 			c.updated_at,
 			COALESCE(COUNT(DISTINCT a.id), 0) as applications_count,
 			COALESCE(COUNT(DISTINCT a.id) FILTER (WHERE a.status = 'active'), 0) as active_applications_count,
 			MAX(GREATEST(a.updated_at, COALESCE(sa.max_created, a.updated_at), COALESCE(ca.max_created, a.updated_at))) as last_activity_at,
-			COALESCE(MAX(sa.cnt), 0) as max_stages
+			COALESCE(MAX(sa.cnt), 0) as max_stages,
+			(SELECT COUNT(*) FROM jobs cj WHERE cj.company_id = c.id AND cj.user_id = c.user_id) as jobs_count,
+			(SELECT COUNT(*) FROM jobs cj WHERE cj.company_id = c.id AND cj.user_id = c.user_id AND cj.status = 'active') as active_jobs_count
 		FROM companies c
 		LEFT JOIN jobs j ON j.company_id = c.id AND j.user_id = c.user_id
-		LEFT JOIN applications a ON a.job_id = j.id AND a.user_id = j.user_id
+		LEFT JOIN applications a ON a.job_id = j.id AND a.user_id = j.user_id AND a.deleted_at IS NULL
 		LEFT JOIN stage_agg sa ON sa.application_id = a.id
 		LEFT JOIN comment_agg ca ON ca.application_id = a.id
 		WHERE c.id = $1 AND c.user_id = $2
-		GROUP BY c.id, c.name, c.location, c.notes, c.is_favorite, c.created_at, c.updated_at
+		GROUP BY c.id, c.name, c.location, c.notes, c.logo_url, c.is_favorite,
+			c.industry, c.size, c.website_url, c.linkedin_url, c.founded_year, c.created_at, c.updated_at
 	`
 
 	var dto model.CompanyDTO
@@ -120,13 +195,21 @@ func (r *CompanyRepository) GetByIDEnriched(ctx context.Context, userID, company
 		&dto.Name,
 		&dto.Location,
 		&dto.Notes,
+		&dto.LogoURL,
 		&dto.IsFavorite,
+		&dto.Industry,
+		&dto.Size,
+		&dto.WebsiteURL,
+		&dto.LinkedInURL,
+		&dto.FoundedYear,
 		&dto.CreatedAt,
 		&dto.UpdatedAt,
 		&dto.ApplicationsCount,
 		&dto.ActiveApplicationsCount,
 		&dto.LastActivityAt,
 		&maxStages,
+		&dto.JobsCount,
+		&dto.ActiveJobsCount,
 	)
 
 	if err != nil {
@@ -169,6 +252,44 @@ func (r *CompanyRepository) List(ctx context.Context, userID string, opts *ports
 		orderBy = fmt.Sprintf("%s %s", sortCol, sortDir)
 	}
 
+	// Cursor pages continue after the cursor; the name condition can use the companies
+	// index in WHERE, while the application count is only known after grouping
+	args := []any{userID, opts.Limit, opts.Offset}
+	where, args := companyFilters(opts, args)
+	var whereCursor, havingCursor string
+	var cursorTotal int
+	if opts.Cursor != nil {
+		sortBy := opts.SortBy
+		if sortBy == "" {
+			sortBy = "name"
+		}
+		col, ok := companyCursorColumns[sortBy]
+		if !ok {
+			return nil, 0, keyset.ErrUnsupportedSort
+		}
+		desc := strings.ToUpper(opts.SortDir) == "DESC"
+		cond, cursorArgs, err := col.Condition("c.id", desc, opts.Cursor, args)
+		if err != nil {
+			return nil, 0, err
+		}
+		args = cursorArgs
+		if cond != "" {
+			if sortBy == "applications_count" {
+				havingCursor = " HAVING " + cond
+			} else {
+				whereCursor = " AND " + cond
+			}
+		}
+		orderBy = col.OrderBy("c.id", desc)
+
+		// The window count only sees rows after the cursor, so cursor pages count separately
+		countWhere, countArgs := companyFilters(opts, []any{userID})
+		countQuery := `SELECT COUNT(*) FROM companies c WHERE c.user_id = $1` + countWhere
+		if err := r.pool.QueryRow(ctx, countQuery, countArgs...).Scan(&cursorTotal); err != nil {
+			return nil, 0, err
+		}
+	}
+
 	// Single query with pre-aggregated CTEs and COUNT(*) OVER()
 	query := fmt.Sprintf(`
 		WITH stage_agg AS (
@@ -186,26 +307,38 @@ func (r *CompanyRepository) List(ctx context.Context, userID string, opts *ports
 			c.name,
 			c.location,
 			c.notes,
+			c.logo_url,
 			c.is_favorite,
+			c.industry,
+			c.size,
+			c.website_url,
+			c.linkedin_url,
+			c.founded_year,
 			c.created_at,
 			c.updated_at,
 			COALESCE(COUNT(DISTINCT a.id), 0) as applications_count,
 			COALESCE(COUNT(DISTINCT a.id) FILTER (WHERE a.status = 'active'), 0) as active_applications_count,
 			MAX(GREATEST(a.updated_at, COALESCE(sa.max_created, a.updated_at), COALESCE(ca.max_created, a.updated_at))) as last_activity_at,
 			COALESCE(MAX(sa.cnt), 0) as max_stages,
+			ARRAY(
+				SELECT tr.tag_id::text FROM tag_relations tr
+				WHERE tr.entity_type = 'company' AND tr.entity_id = c.id
+				ORDER BY tr.created_at
+			) as tag_ids,
 			COUNT(*) OVER() as total_count
 		FROM companies c
 		LEFT JOIN jobs j ON j.company_id = c.id AND j.user_id = c.user_id
-		LEFT JOIN applications a ON a.job_id = j.id AND a.user_id = j.user_id
+		LEFT JOIN applications a ON a.job_id = j.id AND a.user_id = j.user_id AND a.deleted_at IS NULL
 		LEFT JOIN stage_agg sa ON sa.application_id = a.id
 		LEFT JOIN comment_agg ca ON ca.application_id = a.id
-		WHERE c.user_id = $1
-		GROUP BY c.id, c.name, c.location, c.notes, c.is_favorite, c.created_at, c.updated_at
+		WHERE c.user_id = $1%s%s
+		GROUP BY c.id, c.name, c.location, c.notes, c.logo_url, c.is_favorite,
+			c.industry, c.size, c.website_url, c.linkedin_url, c.founded_year, c.created_at, c.updated_at%s
 		ORDER BY %s
 		LIMIT $2 OFFSET $3
-	`, orderBy)
+	`, where, whereCursor, havingCursor, orderBy)
 
-	rows, err := r.pool.Query(ctx, query, userID, opts.Limit, opts.Offset)
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -221,13 +354,20 @@ func (r *CompanyRepository) List(ctx context.Context, userID string, opts *ports
 			&dto.Name,
 			&dto.Location,
 			&dto.Notes,
+			&dto.LogoURL,
 			&dto.IsFavorite,
+			&dto.Industry,
+			&dto.Size,
+			&dto.WebsiteURL,
+			&dto.LinkedInURL,
+			&dto.FoundedYear,
 			&dto.CreatedAt,
 			&dto.UpdatedAt,
 			&dto.ApplicationsCount,
 			&dto.ActiveApplicationsCount,
 			&dto.LastActivityAt,
 			&maxStages,
+			&dto.TagIDs,
 			&total,
 		); err != nil {
 			return nil, 0, err
@@ -242,10 +382,35 @@ func (r *CompanyRepository) List(ctx context.Context, userID string, opts *ports
 	if err := rows.Err(); err != nil {
 		return nil, 0, err
 	}
+	if opts.Cursor != nil {
+		total = cursorTotal
+	}
 
 	return companies, total, nil
 }
 
+// companyFilters returns the AND conditions for the list filters in opts, appending
+// their values to args
+func companyFilters(opts *ports.ListOptions, args []any) (string, []any) {
+	var where strings.Builder
+	if opts.Industry != "" {
+		args = append(args, opts.Industry)
+		fmt.Fprintf(&where, " AND LOWER(c.industry) = LOWER($%d)", len(args))
+	}
+	if opts.Size != "" {
+		args = append(args, opts.Size)
+		fmt.Fprintf(&where, " AND c.size = $%d", len(args))
+	}
+	return where.String(), args
+}
+
+// companyCursorColumns are the sorts usable with cursor pagination. Companies without
+// applications have no last activity to compare, so that sort is offset-only.
+var companyCursorColumns = map[string]keyset.Column{
+	"name":               keyset.TextColumn("c.name"),
+	"applications_count": keyset.IntColumn("COUNT(DISTINCT a.id)"),
+}
+
 // GetRelatedJobsAndApplicationsCount gets counts of related jobs and applications
 func (r *CompanyRepository) GetRelatedJobsAndApplicationsCount(ctx context.Context, userID, companyID string) (jobsCount, appsCount int, err error) {
 	query := `
@@ -280,7 +445,8 @@ func (r *CompanyRepository) deriveStatus(appsCount, activeAppsCount, maxStages i
 func (r *CompanyRepository) Update(ctx context.Context, company *model.Company) error {
 	query := `
 		UPDATE companies
-		SET name = $3, location = $4, notes = $5, updated_at = $6
+		SET name = $3, location = $4, notes = $5, industry = $6, size = $7,
+			website_url = $8, linkedin_url = $9, founded_year = $10, updated_at = $11
 		WHERE id = $1 AND user_id = $2
 	`
 
@@ -292,6 +458,11 @@ func (r *CompanyRepository) Update(ctx context.Context, company *model.Company)
 		company.Name,
 		company.Location,
 		company.Notes,
+		company.Industry,
+		company.Size,
+		company.WebsiteURL,
+		company.LinkedInURL,
+		company.FoundedYear,
 		company.UpdatedAt,
 	)
 	if err != nil {
@@ -321,6 +492,22 @@ func (r *CompanyRepository) ToggleFavorite(ctx context.Context, userID, companyI
 	return isFavorite, nil
 }
 
+// UpdateLogoURL sets or clears (nil) the logo URL of a company
+func (r *CompanyRepository) UpdateLogoURL(ctx context.Context, userID, companyID string, logoURL *string) error {
+	query := `UPDATE companies SET logo_url = $3, updated_at = $4 WHERE id = $1 AND user_id = $2`
+
+	result, err := r.pool.Exec(ctx, query, companyID, userID, logoURL, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return model.ErrCompanyNotFound
+	}
+
+	return nil
+}
+
 // Delete deletes a company
 func (r *CompanyRepository) Delete(ctx context.Context, userID, companyID string) error {
 	query := `DELETE FROM companies WHERE id = $1 AND user_id = $2`
@@ -336,3 +523,130 @@ func (r *CompanyRepository) Delete(ctx context.Context, userID, companyID string
 
 	return nil
 }
+
+// ListSimilarPairs returns the pairs of the user's companies whose names have a
+// pg_trgm similarity of at least threshold, the older company of each pair first
+func (r *CompanyRepository) ListSimilarPairs(ctx context.Context, userID string, threshold float64) ([]*model.SimilarCompanyPair, error) {
+	query := `
+		SELECT a.id, a.user_id, a.name, a.location, a.notes, a.logo_url, a.is_favorite,
+			a.industry, a.size, a.website_url, a.linkedin_url, a.founded_year, a.created_at, a.updated_at,
+			b.id, b.user_id, b.name, b.location, b.notes, b.logo_url, b.is_favorite,
+			b.industry, b.size, b.website_url, b.linkedin_url, b.founded_year, b.created_at, b.updated_at
+		FROM companies a
+		JOIN companies b ON b.user_id = a.user_id AND (a.created_at, a.id) < (b.created_at, b.id)
+		WHERE a.user_id = $1 AND similarity(a.name, b.name) >= $2
+		ORDER BY a.created_at, a.id, b.created_at, b.id
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, threshold)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pairs []*model.SimilarCompanyPair
+	for rows.Next() {
+		first, second := &model.Company{}, &model.Company{}
+		if err := rows.Scan(
+			&first.ID, &first.UserID, &first.Name, &first.Location, &first.Notes, &first.LogoURL, &first.IsFavorite,
+			&first.Industry, &first.Size, &first.WebsiteURL, &first.LinkedInURL, &first.FoundedYear, &first.CreatedAt, &first.UpdatedAt,
+			&second.ID, &second.UserID, &second.Name, &second.Location, &second.Notes, &second.LogoURL, &second.IsFavorite,
+			&second.Industry, &second.Size, &second.WebsiteURL, &second.LinkedInURL, &second.FoundedYear, &second.CreatedAt, &second.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, &model.SimilarCompanyPair{First: first, Second: second})
+	}
+	return pairs, rows.Err()
+}
+
+// Merge moves the jobs, contacts and tags of the companies fromIDs to the company
+// intoID, appends their notes to its notes, one record per line, and deletes them,
+// in a single transaction. All companies must belong to the user.
+func (r *CompanyRepository) Merge(ctx context.Context, userID, intoID string, fromIDs []string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // rollback is a no-op after commit
+
+	// Lock all companies so none is changed or deleted mid-merge
+	ids := append([]string{intoID}, fromIDs...)
+	rows, err := tx.Query(ctx,
+		`SELECT id, notes FROM companies WHERE user_id = $1 AND id = ANY($2) FOR UPDATE`,
+		userID, ids,
+	)
+	if err != nil {
+		return err
+	}
+	notesByID := make(map[string]*string, len(ids))
+	for rows.Next() {
+		var id string
+		var notes *string
+		if err := rows.Scan(&id, &notes); err != nil {
+			rows.Close()
+			return err
+		}
+		notesByID[id] = notes
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(notesByID) != len(ids) {
+		return model.ErrCompanyNotFound
+	}
+
+	if _, err := tx.Exec(ctx,
+		`UPDATE jobs SET company_id = $1 WHERE user_id = $2 AND company_id = ANY($3)`,
+		intoID, userID, fromIDs,
+	); err != nil {
+		return fmt.Errorf("failed to move jobs: %w", err)
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE company_contacts SET company_id = $1, updated_at = $4 WHERE user_id = $2 AND company_id = ANY($3)`,
+		intoID, userID, fromIDs, time.Now().UTC(),
+	); err != nil {
+		return fmt.Errorf("failed to move contacts: %w", err)
+	}
+	// Tags already on the surviving company are skipped; the delete trigger
+	// removes the merged companies' own relations. A tag on several merged
+	// companies keeps its earliest attachment, along with who added it.
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO tag_relations (tag_id, entity_type, entity_id, added_by_user_id, created_at)
+		SELECT DISTINCT ON (tag_id) tag_id, 'company', $1::uuid, added_by_user_id, created_at FROM tag_relations
+		WHERE entity_type = 'company' AND entity_id = ANY($2)
+		ORDER BY tag_id, created_at
+		ON CONFLICT (tag_id, entity_type, entity_id) DO NOTHING`,
+		intoID, fromIDs,
+	); err != nil {
+		return fmt.Errorf("failed to move tags: %w", err)
+	}
+
+	var notes []string
+	for _, id := range ids {
+		if n := notesByID[id]; n != nil && strings.TrimSpace(*n) != "" {
+			notes = append(notes, *n)
+		}
+	}
+	var mergedNotes *string
+	if len(notes) > 0 {
+		joined := strings.Join(notes, "\n")
+		mergedNotes = &joined
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE companies SET notes = $3, updated_at = $4 WHERE id = $1 AND user_id = $2`,
+		intoID, userID, mergedNotes, time.Now().UTC(),
+	); err != nil {
+		return fmt.Errorf("failed to merge notes: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM companies WHERE user_id = $1 AND id = ANY($2)`, userID, fromIDs); err != nil {
+		return fmt.Errorf("failed to delete merged companies: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
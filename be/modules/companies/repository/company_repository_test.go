@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	"github.com/andreypavlenko/jobber/modules/companies/model"
 	"github.com/andreypavlenko/jobber/modules/companies/ports"
 	"github.com/jackc/pgx/v5"
@@ -84,6 +85,72 @@ func TestCompanyRepository_GetByID(t *testing.T) {
 	})
 }
 
+func TestCompanyRepository_GetByIDEnriched_JobCounts(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	now := time.Now()
+	industry, size, foundedYear := "Fintech", "small", 2015
+	rows := pgxmock.NewRows([]string{
+		"id", "name", "location", "notes", "logo_url", "is_favorite",
+		"industry", "size", "website_url", "linkedin_url", "founded_year", "created_at", "updated_at",
+		"applications_count", "active_applications_count", "last_activity_at", "max_stages",
+		"jobs_count", "active_jobs_count",
+	}).AddRow("company-1", "Acme", nil, nil, nil, false, &industry, &size, nil, nil, &foundedYear, now, now, 2, 1, &now, 1, 4, 3)
+
+	mock.ExpectQuery(`FROM jobs cj WHERE cj.company_id = c.id AND cj.user_id = c.user_id AND cj.status = 'active'`).
+		WithArgs("company-1", "user-123").
+		WillReturnRows(rows)
+
+	repo := NewCompanyRepositoryWithPool(mock)
+	dto, err := repo.GetByIDEnriched(context.Background(), "user-123", "company-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, dto.JobsCount)
+	assert.Equal(t, 3, dto.ActiveJobsCount)
+	assert.Equal(t, 2, dto.ApplicationsCount)
+	assert.Equal(t, &industry, dto.Industry)
+	assert.Equal(t, &size, dto.Size)
+	assert.Equal(t, &foundedYear, dto.FoundedYear)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompanyRepository_UpdateLogoURL(t *testing.T) {
+	t.Run("sets logo URL", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		logoURL := "https://cdn.example.com/acme.png"
+		mock.ExpectExec("UPDATE companies SET logo_url").
+			WithArgs("company-1", "user-123", &logoURL, pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		repo := NewCompanyRepositoryWithPool(mock)
+		err = repo.UpdateLogoURL(context.Background(), "user-123", "company-1", &logoURL)
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found when no row updated", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("UPDATE companies SET logo_url").
+			WithArgs("company-1", "user-123", (*string)(nil), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		repo := NewCompanyRepositoryWithPool(mock)
+		err = repo.UpdateLogoURL(context.Background(), "user-123", "company-1", nil)
+
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestCompanyRepository_Update(t *testing.T) {
 	t.Run("updates company successfully", func(t *testing.T) {
 		mock, err := pgxmock.NewPool()
@@ -203,6 +270,117 @@ func TestCompanyRepository_List(t *testing.T) {
 	})
 }
 
+func TestCompanyRepository_List_Cursor(t *testing.T) {
+	lastID := "5b1f2f5e-8d7a-4c36-9a52-1f0f4c2e9b10"
+
+	tests := []struct {
+		name      string
+		opts      *ports.ListOptions
+		args      []interface{}
+		wantWhere string
+		wantOrder string
+	}{
+		{
+			name:      "filters names in WHERE",
+			opts:      &ports.ListOptions{Limit: 21, SortBy: "name", SortDir: "asc", Cursor: &keyset.Cursor{LastID: lastID, LastValue: "Acme"}},
+			args:      []interface{}{"user-123", 21, 0, "Acme", lastID},
+			wantWhere: "WHERE c.user_id = $1 AND (c.name, c.id) > ($4, $5)",
+			wantOrder: "ORDER BY c.name ASC, c.id ASC",
+		},
+		{
+			name:      "filters application counts in HAVING",
+			opts:      &ports.ListOptions{Limit: 21, SortBy: "applications_count", SortDir: "desc", Cursor: &keyset.Cursor{LastID: lastID, LastValue: "4"}},
+			args:      []interface{}{"user-123", 21, 0, 4, lastID},
+			wantWhere: "HAVING (COUNT(DISTINCT a.id), c.id) < ($4, $5)",
+			wantOrder: "ORDER BY COUNT(DISTINCT a.id) DESC, c.id DESC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured []string
+			mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(_, actualSQL string) error {
+				captured = append(captured, actualSQL)
+				return nil
+			})))
+			require.NoError(t, err)
+			defer mock.Close()
+
+			mock.ExpectQuery("").WithArgs("user-123").WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(9))
+			mock.ExpectQuery("").WithArgs(tt.args...).WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+			repo := NewCompanyRepositoryWithPool(mock)
+			_, total, err := repo.List(context.Background(), "user-123", tt.opts)
+
+			require.NoError(t, err)
+			assert.Equal(t, 9, total)
+			require.Len(t, captured, 2)
+			assert.Contains(t, captured[1], tt.wantWhere)
+			assert.Contains(t, captured[1], tt.wantOrder)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	t.Run("rejects the last activity sort", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		repo := NewCompanyRepositoryWithPool(mock)
+		_, _, err = repo.List(context.Background(), "user-123", &ports.ListOptions{Limit: 21, SortBy: "last_activity", Cursor: &keyset.Cursor{}})
+
+		assert.ErrorIs(t, err, keyset.ErrUnsupportedSort)
+	})
+}
+
+func TestCompanyRepository_List_Filters(t *testing.T) {
+	t.Run("filters by industry and size", func(t *testing.T) {
+		var captured []string
+		mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(_, actualSQL string) error {
+			captured = append(captured, actualSQL)
+			return nil
+		})))
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("").WithArgs("user-123", 20, 0, "fintech", "small").WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+		repo := NewCompanyRepositoryWithPool(mock)
+		_, _, err = repo.List(context.Background(), "user-123", &ports.ListOptions{Limit: 20, Industry: "fintech", Size: "small"})
+
+		require.NoError(t, err)
+		require.Len(t, captured, 1)
+		assert.Contains(t, captured[0], "WHERE c.user_id = $1 AND LOWER(c.industry) = LOWER($4) AND c.size = $5")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("applies the filters to the cursor total", func(t *testing.T) {
+		lastID := "5b1f2f5e-8d7a-4c36-9a52-1f0f4c2e9b10"
+		var captured []string
+		mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(_, actualSQL string) error {
+			captured = append(captured, actualSQL)
+			return nil
+		})))
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("").WithArgs("user-123", "enterprise").WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(3))
+		mock.ExpectQuery("").WithArgs("user-123", 21, 0, "enterprise", "Acme", lastID).WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+		repo := NewCompanyRepositoryWithPool(mock)
+		_, total, err := repo.List(context.Background(), "user-123", &ports.ListOptions{
+			Limit: 21, SortBy: "name", Size: "enterprise", Cursor: &keyset.Cursor{LastID: lastID, LastValue: "Acme"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		require.Len(t, captured, 2)
+		assert.Contains(t, captured[0], "WHERE c.user_id = $1 AND c.size = $2")
+		assert.Contains(t, captured[1], "WHERE c.user_id = $1 AND c.size = $4 AND (c.name, c.id) > ($5, $6)")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestCompanyRepository_GetRelatedJobsAndApplicationsCount(t *testing.T) {
 	t.Run("returns counts successfully", func(t *testing.T) {
 		mock, err := pgxmock.NewPool()
@@ -335,3 +513,111 @@ func (r *testCompanyRepo) GetRelatedJobsAndApplicationsCount(ctx context.Context
 	err = r.mock.QueryRow(ctx, query, companyID, userID).Scan(&jobsCount, &appsCount)
 	return
 }
+
+func TestCompanyRepository_ListSimilarPairs(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	now := time.Now()
+	columns := []string{
+		"id", "user_id", "name", "location", "notes", "logo_url", "is_favorite",
+		"industry", "size", "website_url", "linkedin_url", "founded_year", "created_at", "updated_at",
+		"id", "user_id", "name", "location", "notes", "logo_url", "is_favorite",
+		"industry", "size", "website_url", "linkedin_url", "founded_year", "created_at", "updated_at",
+	}
+	mock.ExpectQuery(`similarity\(a.name, b.name\) >= \$2`).
+		WithArgs("user-123", 0.7).
+		WillReturnRows(pgxmock.NewRows(columns).
+			AddRow("company-1", "user-123", "Acme", nil, nil, nil, false, nil, nil, nil, nil, nil, now, now,
+				"company-2", "user-123", "Acme Inc", nil, nil, nil, false, nil, nil, nil, nil, nil, now, now))
+
+	repo := NewCompanyRepositoryWithPool(mock)
+	pairs, err := repo.ListSimilarPairs(context.Background(), "user-123", 0.7)
+
+	require.NoError(t, err)
+	require.Len(t, pairs, 1)
+	assert.Equal(t, "Acme", pairs[0].First.Name)
+	assert.Equal(t, "Acme Inc", pairs[0].Second.Name)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompanyRepository_Merge(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("moves related records, merges notes and deletes the duplicates", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		fromIDs := []string{"company-2", "company-3"}
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs("user-123", []string{"company-1", "company-2", "company-3"}).
+			WillReturnRows(pgxmock.NewRows([]string{"id", "notes"}).
+				AddRow("company-3", strPtr("Recruiter: Jane")).
+				AddRow("company-1", strPtr("Remote friendly")).
+				AddRow("company-2", nil))
+		mock.ExpectExec("UPDATE jobs SET company_id").
+			WithArgs("company-1", "user-123", fromIDs).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+		mock.ExpectExec("UPDATE company_contacts SET company_id").
+			WithArgs("company-1", "user-123", fromIDs, pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectExec(`INSERT INTO tag_relations \(tag_id, entity_type, entity_id, added_by_user_id, created_at\)\s+SELECT DISTINCT ON \(tag_id\) tag_id, 'company', \$1::uuid, added_by_user_id, created_at`).
+			WithArgs("company-1", fromIDs).
+			WillReturnResult(pgxmock.NewResult("INSERT", 2))
+		mock.ExpectExec("UPDATE companies SET notes").
+			WithArgs("company-1", "user-123", strPtr("Remote friendly\nRecruiter: Jane"), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectExec("DELETE FROM companies").
+			WithArgs("user-123", fromIDs).
+			WillReturnResult(pgxmock.NewResult("DELETE", 2))
+		mock.ExpectCommit()
+
+		repo := NewCompanyRepositoryWithPool(mock)
+		err = repo.Merge(context.Background(), "user-123", "company-1", fromIDs)
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found when a company belongs to another user", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs("user-123", []string{"company-1", "company-2"}).
+			WillReturnRows(pgxmock.NewRows([]string{"id", "notes"}).AddRow("company-1", nil))
+		mock.ExpectRollback()
+
+		repo := NewCompanyRepositoryWithPool(mock)
+		err = repo.Merge(context.Background(), "user-123", "company-1", []string{"company-2"})
+
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back when moving jobs fails", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs("user-123", []string{"company-1", "company-2"}).
+			WillReturnRows(pgxmock.NewRows([]string{"id", "notes"}).AddRow("company-1", nil).AddRow("company-2", nil))
+		mock.ExpectExec("UPDATE jobs SET company_id").
+			WithArgs("company-1", "user-123", []string{"company-2"}).
+			WillReturnError(assert.AnError)
+		mock.ExpectRollback()
+
+		repo := NewCompanyRepositoryWithPool(mock)
+		err = repo.Merge(context.Background(), "user-123", "company-1", []string{"company-2"})
+
+		assert.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ContactRepository implements ports.ContactRepository
+type ContactRepository struct {
+	pool DBPool
+}
+
+// NewContactRepository creates a new company contact repository
+func NewContactRepository(pool *pgxpool.Pool) *ContactRepository {
+	return &ContactRepository{pool: pool}
+}
+
+// NewContactRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewContactRepositoryWithPool(pool DBPool) *ContactRepository {
+	return &ContactRepository{pool: pool}
+}
+
+const contactColumns = `id, company_id, user_id, name, title, email, linkedin_url, notes, created_at, updated_at`
+
+// Writes also bump the company's updated_at, so it reflects changes to the
+// embedded contacts.
+const touchCompany = `UPDATE companies SET updated_at = NOW() WHERE id IN (SELECT company_id FROM changed)`
+
+// Create creates a new contact
+func (r *ContactRepository) Create(ctx context.Context, contact *model.Contact) error {
+	query := `
+		WITH changed AS (
+			INSERT INTO company_contacts (` + contactColumns + `)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			RETURNING company_id
+		)
+		` + touchCompany
+
+	contact.ID = uuid.New().String()
+	now := time.Now().UTC()
+	contact.CreatedAt = now
+	contact.UpdatedAt = now
+
+	_, err := r.pool.Exec(ctx, query,
+		contact.ID,
+		contact.CompanyID,
+		contact.UserID,
+		contact.Name,
+		contact.Title,
+		contact.Email,
+		contact.LinkedInURL,
+		contact.Notes,
+		contact.CreatedAt,
+		contact.UpdatedAt,
+	)
+	return err
+}
+
+// GetByID retrieves a contact of one of the user's companies
+func (r *ContactRepository) GetByID(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error) {
+	query := `
+		SELECT ` + contactColumns + `
+		FROM company_contacts
+		WHERE id = $1 AND company_id = $2 AND user_id = $3
+	`
+
+	contact, err := scanContact(r.pool.QueryRow(ctx, query, contactID, companyID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrContactNotFound
+		}
+		return nil, err
+	}
+	return contact, nil
+}
+
+// ListByCompany lists a company's contacts ordered by name
+func (r *ContactRepository) ListByCompany(ctx context.Context, userID, companyID string) ([]*model.Contact, error) {
+	query := `
+		SELECT ` + contactColumns + `
+		FROM company_contacts
+		WHERE company_id = $1 AND user_id = $2
+		ORDER BY name, created_at
+	`
+
+	rows, err := r.pool.Query(ctx, query, companyID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	contacts := []*model.Contact{}
+	for rows.Next() {
+		contact, err := scanContact(rows)
+		if err != nil {
+			return nil, err
+		}
+		contacts = append(contacts, contact)
+	}
+	return contacts, rows.Err()
+}
+
+// Update updates a contact's details
+func (r *ContactRepository) Update(ctx context.Context, contact *model.Contact) error {
+	query := `
+		WITH changed AS (
+			UPDATE company_contacts
+			SET name = $4, title = $5, email = $6, linkedin_url = $7, notes = $8, updated_at = $9
+			WHERE id = $1 AND company_id = $2 AND user_id = $3
+			RETURNING company_id
+		)
+		` + touchCompany
+
+	contact.UpdatedAt = time.Now().UTC()
+
+	result, err := r.pool.Exec(ctx, query,
+		contact.ID,
+		contact.CompanyID,
+		contact.UserID,
+		contact.Name,
+		contact.Title,
+		contact.Email,
+		contact.LinkedInURL,
+		contact.Notes,
+		contact.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return model.ErrContactNotFound
+	}
+
+	return nil
+}
+
+// Delete deletes a contact
+func (r *ContactRepository) Delete(ctx context.Context, userID, companyID, contactID string) error {
+	query := `
+		WITH changed AS (
+			DELETE FROM company_contacts WHERE id = $1 AND company_id = $2 AND user_id = $3
+			RETURNING company_id
+		)
+		` + touchCompany
+
+	result, err := r.pool.Exec(ctx, query, contactID, companyID, userID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return model.ErrContactNotFound
+	}
+
+	return nil
+}
+
+func scanContact(row pgx.Row) (*model.Contact, error) {
+	contact := &model.Contact{}
+	err := row.Scan(
+		&contact.ID,
+		&contact.CompanyID,
+		&contact.UserID,
+		&contact.Name,
+		&contact.Title,
+		&contact.Email,
+		&contact.LinkedInURL,
+		&contact.Notes,
+		&contact.CreatedAt,
+		&contact.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return contact, nil
+}
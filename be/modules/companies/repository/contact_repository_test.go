@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var contactRowColumns = []string{"id", "company_id", "user_id", "name", "title", "email", "linkedin_url", "notes", "created_at", "updated_at"}
+
+func TestContactRepository_Create(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	email := "jane@acme.com"
+	contact := &model.Contact{CompanyID: "company-1", UserID: "user-123", Name: "Jane", Email: &email}
+
+	mock.ExpectExec(`INSERT INTO company_contacts .* UPDATE companies SET updated_at = NOW\(\)`).
+		WithArgs(pgxmock.AnyArg(), "company-1", "user-123", "Jane", (*string)(nil), &email, (*string)(nil), (*string)(nil), pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	repo := NewContactRepositoryWithPool(mock)
+	err = repo.Create(context.Background(), contact)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, contact.ID)
+	assert.False(t, contact.CreatedAt.IsZero())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_GetByID(t *testing.T) {
+	t.Run("returns the contact", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`FROM company_contacts\s+WHERE id = \$1 AND company_id = \$2 AND user_id = \$3`).
+			WithArgs("contact-1", "company-1", "user-123").
+			WillReturnRows(pgxmock.NewRows(contactRowColumns).
+				AddRow("contact-1", "company-1", "user-123", "Jane", nil, nil, nil, nil, now, now))
+
+		repo := NewContactRepositoryWithPool(mock)
+		contact, err := repo.GetByID(context.Background(), "user-123", "company-1", "contact-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Jane", contact.Name)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM company_contacts").
+			WithArgs("missing", "company-1", "user-123").
+			WillReturnError(pgx.ErrNoRows)
+
+		repo := NewContactRepositoryWithPool(mock)
+		_, err = repo.GetByID(context.Background(), "user-123", "company-1", "missing")
+
+		assert.ErrorIs(t, err, model.ErrContactNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestContactRepository_ListByCompany(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	now := time.Now()
+	title := "Recruiter"
+	mock.ExpectQuery(`FROM company_contacts\s+WHERE company_id = \$1 AND user_id = \$2\s+ORDER BY name`).
+		WithArgs("company-1", "user-123").
+		WillReturnRows(pgxmock.NewRows(contactRowColumns).
+			AddRow("contact-1", "company-1", "user-123", "Ann", &title, nil, nil, nil, now, now).
+			AddRow("contact-2", "company-1", "user-123", "Bob", nil, nil, nil, nil, now, now))
+
+	repo := NewContactRepositoryWithPool(mock)
+	contacts, err := repo.ListByCompany(context.Background(), "user-123", "company-1")
+
+	require.NoError(t, err)
+	require.Len(t, contacts, 2)
+	assert.Equal(t, "Recruiter", *contacts[0].Title)
+	assert.Equal(t, "Bob", contacts[1].Name)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_Update(t *testing.T) {
+	t.Run("updates the contact and touches the company", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`UPDATE company_contacts .* UPDATE companies SET updated_at = NOW\(\)`).
+			WithArgs("contact-1", "company-1", "user-123", "Jane", (*string)(nil), (*string)(nil), (*string)(nil), (*string)(nil), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		repo := NewContactRepositoryWithPool(mock)
+		err = repo.Update(context.Background(), &model.Contact{ID: "contact-1", CompanyID: "company-1", UserID: "user-123", Name: "Jane"})
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found when no contact matched", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("UPDATE company_contacts").
+			WithArgs("missing", "company-1", "user-123", "Jane", (*string)(nil), (*string)(nil), (*string)(nil), (*string)(nil), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		repo := NewContactRepositoryWithPool(mock)
+		err = repo.Update(context.Background(), &model.Contact{ID: "missing", CompanyID: "company-1", UserID: "user-123", Name: "Jane"})
+
+		assert.ErrorIs(t, err, model.ErrContactNotFound)
+	})
+}
+
+func TestContactRepository_Delete(t *testing.T) {
+	t.Run("deletes the contact", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`DELETE FROM company_contacts WHERE id = \$1 AND company_id = \$2 AND user_id = \$3`).
+			WithArgs("contact-1", "company-1", "user-123").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		repo := NewContactRepositoryWithPool(mock)
+		err = repo.Delete(context.Background(), "user-123", "company-1", "contact-1")
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found when no contact matched", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("DELETE FROM company_contacts").
+			WithArgs("missing", "company-1", "user-123").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		repo := NewContactRepositoryWithPool(mock)
+		err = repo.Delete(context.Background(), "user-123", "company-1", "missing")
+
+		assert.ErrorIs(t, err, model.ErrContactNotFound)
+	})
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/andreypavlenko/jobber/modules/companies/ports"
+)
+
+// ApplicationContactService tracks which of the user's applications a company contact was involved in
+type ApplicationContactService struct {
+	companyRepo ports.CompanyRepository
+	contactRepo ports.ContactRepository
+	linkRepo    ports.ApplicationContactRepository
+}
+
+// NewApplicationContactService creates a new application contact service
+func NewApplicationContactService(companyRepo ports.CompanyRepository, contactRepo ports.ContactRepository, linkRepo ports.ApplicationContactRepository) *ApplicationContactService {
+	return &ApplicationContactService{
+		companyRepo: companyRepo,
+		contactRepo: contactRepo,
+		linkRepo:    linkRepo,
+	}
+}
+
+// ListApplications lists the applications a contact was involved in
+func (s *ApplicationContactService) ListApplications(ctx context.Context, userID, companyID, contactID string) ([]*model.ContactApplicationDTO, error) {
+	if err := s.checkContact(ctx, userID, companyID, contactID); err != nil {
+		return nil, err
+	}
+
+	links, err := s.linkRepo.ListByContact(ctx, userID, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*model.ContactApplicationDTO, 0, len(links))
+	for _, link := range links {
+		dtos = append(dtos, link.ToDTO())
+	}
+	return dtos, nil
+}
+
+// Link records that a contact was involved in one of the user's applications
+func (s *ApplicationContactService) Link(ctx context.Context, userID, companyID, contactID string, req *model.LinkContactApplicationRequest) (*model.ContactApplicationDTO, error) {
+	if err := s.checkContact(ctx, userID, companyID, contactID); err != nil {
+		return nil, err
+	}
+
+	link, err := s.linkRepo.Create(ctx, userID, contactID, req.ApplicationID, strings.TrimSpace(req.Role))
+	if err != nil {
+		return nil, err
+	}
+	return link.ToDTO(), nil
+}
+
+// UpdateRole changes the contact's role in an application
+func (s *ApplicationContactService) UpdateRole(ctx context.Context, userID, companyID, contactID, applicationID string, req *model.UpdateContactApplicationRequest) (*model.ContactApplicationDTO, error) {
+	if err := s.checkContact(ctx, userID, companyID, contactID); err != nil {
+		return nil, err
+	}
+
+	link, err := s.linkRepo.UpdateRole(ctx, userID, contactID, applicationID, strings.TrimSpace(req.Role))
+	if err != nil {
+		return nil, err
+	}
+	return link.ToDTO(), nil
+}
+
+// Unlink removes a contact from an application
+func (s *ApplicationContactService) Unlink(ctx context.Context, userID, companyID, contactID, applicationID string) error {
+	if err := s.checkContact(ctx, userID, companyID, contactID); err != nil {
+		return err
+	}
+	return s.linkRepo.Delete(ctx, userID, contactID, applicationID)
+}
+
+// checkContact returns ErrCompanyNotFound or ErrContactNotFound unless the
+// contact belongs to one of the user's companies
+func (s *ApplicationContactService) checkContact(ctx context.Context, userID, companyID, contactID string) error {
+	if _, err := s.companyRepo.GetByID(ctx, userID, companyID); err != nil {
+		return err
+	}
+	_, err := s.contactRepo.GetByID(ctx, userID, companyID, contactID)
+	return err
+}
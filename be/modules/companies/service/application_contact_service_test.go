@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockApplicationContactRepository implements ports.ApplicationContactRepository
+type MockApplicationContactRepository struct {
+	CreateFunc        func(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error)
+	ListByContactFunc func(ctx context.Context, userID, contactID string) ([]*model.ContactApplication, error)
+	UpdateRoleFunc    func(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error)
+	DeleteFunc        func(ctx context.Context, userID, contactID, applicationID string) error
+}
+
+func (m *MockApplicationContactRepository) Create(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, userID, contactID, applicationID, role)
+	}
+	return &model.ContactApplication{ApplicationID: applicationID, Role: role}, nil
+}
+
+func (m *MockApplicationContactRepository) ListByContact(ctx context.Context, userID, contactID string) ([]*model.ContactApplication, error) {
+	if m.ListByContactFunc != nil {
+		return m.ListByContactFunc(ctx, userID, contactID)
+	}
+	return []*model.ContactApplication{}, nil
+}
+
+func (m *MockApplicationContactRepository) UpdateRole(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error) {
+	if m.UpdateRoleFunc != nil {
+		return m.UpdateRoleFunc(ctx, userID, contactID, applicationID, role)
+	}
+	return &model.ContactApplication{ApplicationID: applicationID, Role: role}, nil
+}
+
+func (m *MockApplicationContactRepository) Delete(ctx context.Context, userID, contactID, applicationID string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID, contactID, applicationID)
+	}
+	return nil
+}
+
+// ownedContactRepo finds only contact-1 of user-123 at company-1
+func ownedContactRepo() *MockContactRepository {
+	return &MockContactRepository{
+		GetByIDFunc: func(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error) {
+			if userID == "user-123" && companyID == "company-1" && contactID == "contact-1" {
+				return &model.Contact{ID: contactID, CompanyID: companyID, UserID: userID, Name: "Jane"}, nil
+			}
+			return nil, model.ErrContactNotFound
+		},
+	}
+}
+
+// unreachableLinkRepo fails the test if any link is read or written
+func unreachableLinkRepo(t *testing.T) *MockApplicationContactRepository {
+	fail := func() { t.Fatal("links of a contact the user does not own must not be touched") }
+	return &MockApplicationContactRepository{
+		CreateFunc: func(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error) {
+			fail()
+			return nil, nil
+		},
+		ListByContactFunc: func(ctx context.Context, userID, contactID string) ([]*model.ContactApplication, error) {
+			fail()
+			return nil, nil
+		},
+		UpdateRoleFunc: func(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error) {
+			fail()
+			return nil, nil
+		},
+		DeleteFunc: func(ctx context.Context, userID, contactID, applicationID string) error {
+			fail()
+			return nil
+		},
+	}
+}
+
+func TestApplicationContactService_ListApplications(t *testing.T) {
+	t.Run("lists the user's applications of the contact", func(t *testing.T) {
+		linkRepo := &MockApplicationContactRepository{
+			ListByContactFunc: func(ctx context.Context, userID, contactID string) ([]*model.ContactApplication, error) {
+				assert.Equal(t, "user-123", userID)
+				assert.Equal(t, "contact-1", contactID)
+				return []*model.ContactApplication{{ApplicationID: "app-1", ApplicationName: "Backend Engineer", Role: "recruiter"}}, nil
+			},
+		}
+		svc := NewApplicationContactService(ownedCompanyRepo(), ownedContactRepo(), linkRepo)
+
+		applications, err := svc.ListApplications(context.Background(), "user-123", "company-1", "contact-1")
+
+		require.NoError(t, err)
+		require.Len(t, applications, 1)
+		assert.Equal(t, "app-1", applications[0].ApplicationID)
+		assert.Equal(t, "recruiter", applications[0].Role)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		linkRepo := &MockApplicationContactRepository{
+			ListByContactFunc: func(ctx context.Context, userID, contactID string) ([]*model.ContactApplication, error) {
+				return nil, errors.New("database error")
+			},
+		}
+		svc := NewApplicationContactService(ownedCompanyRepo(), ownedContactRepo(), linkRepo)
+
+		_, err := svc.ListApplications(context.Background(), "user-123", "company-1", "contact-1")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestApplicationContactService_UserScope(t *testing.T) {
+	tests := []struct {
+		name      string
+		userID    string
+		companyID string
+		contactID string
+		wantErr   error
+	}{
+		{"another user", "user-456", "company-1", "contact-1", model.ErrCompanyNotFound},
+		{"another user's company", "user-123", "company-2", "contact-1", model.ErrCompanyNotFound},
+		{"a contact of another company", "user-123", "company-1", "contact-2", model.ErrContactNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := NewApplicationContactService(ownedCompanyRepo(), ownedContactRepo(), unreachableLinkRepo(t))
+
+			_, err := svc.ListApplications(ctx, tt.userID, tt.companyID, tt.contactID)
+			assert.ErrorIs(t, err, tt.wantErr)
+			_, err = svc.Link(ctx, tt.userID, tt.companyID, tt.contactID, &model.LinkContactApplicationRequest{ApplicationID: "app-1"})
+			assert.ErrorIs(t, err, tt.wantErr)
+			_, err = svc.UpdateRole(ctx, tt.userID, tt.companyID, tt.contactID, "app-1", &model.UpdateContactApplicationRequest{Role: "interviewer"})
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorIs(t, svc.Unlink(ctx, tt.userID, tt.companyID, tt.contactID, "app-1"), tt.wantErr)
+		})
+	}
+}
+
+func TestApplicationContactService_Link(t *testing.T) {
+	t.Run("links the application with a trimmed role", func(t *testing.T) {
+		linkRepo := &MockApplicationContactRepository{
+			CreateFunc: func(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error) {
+				assert.Equal(t, "user-123", userID)
+				assert.Equal(t, "contact-1", contactID)
+				assert.Equal(t, "app-1", applicationID)
+				return &model.ContactApplication{ApplicationID: applicationID, Role: role}, nil
+			},
+		}
+		svc := NewApplicationContactService(ownedCompanyRepo(), ownedContactRepo(), linkRepo)
+
+		link, err := svc.Link(context.Background(), "user-123", "company-1", "contact-1", &model.LinkContactApplicationRequest{ApplicationID: "app-1", Role: "  recruiter "})
+
+		require.NoError(t, err)
+		assert.Equal(t, "recruiter", link.Role)
+	})
+
+	t.Run("returns not found for another user's application", func(t *testing.T) {
+		linkRepo := &MockApplicationContactRepository{
+			CreateFunc: func(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error) {
+				return nil, model.ErrApplicationNotFound
+			},
+		}
+		svc := NewApplicationContactService(ownedCompanyRepo(), ownedContactRepo(), linkRepo)
+
+		_, err := svc.Link(context.Background(), "user-123", "company-1", "contact-1", &model.LinkContactApplicationRequest{ApplicationID: "app-2"})
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+}
+
+func TestApplicationContactService_UpdateRole(t *testing.T) {
+	t.Run("updates the role", func(t *testing.T) {
+		svc := NewApplicationContactService(ownedCompanyRepo(), ownedContactRepo(), &MockApplicationContactRepository{})
+
+		link, err := svc.UpdateRole(context.Background(), "user-123", "company-1", "contact-1", "app-1", &model.UpdateContactApplicationRequest{Role: " interviewer "})
+
+		require.NoError(t, err)
+		assert.Equal(t, "interviewer", link.Role)
+	})
+
+	t.Run("returns not found for an unlinked application", func(t *testing.T) {
+		linkRepo := &MockApplicationContactRepository{
+			UpdateRoleFunc: func(ctx context.Context, userID, contactID, applicationID, role string) (*model.ContactApplication, error) {
+				return nil, model.ErrApplicationContactNotFound
+			},
+		}
+		svc := NewApplicationContactService(ownedCompanyRepo(), ownedContactRepo(), linkRepo)
+
+		_, err := svc.UpdateRole(context.Background(), "user-123", "company-1", "contact-1", "app-2", &model.UpdateContactApplicationRequest{})
+
+		assert.ErrorIs(t, err, model.ErrApplicationContactNotFound)
+	})
+}
+
+func TestApplicationContactService_Unlink(t *testing.T) {
+	var deleted []string
+	linkRepo := &MockApplicationContactRepository{
+		DeleteFunc: func(ctx context.Context, userID, contactID, applicationID string) error {
+			deleted = append(deleted, userID, contactID, applicationID)
+			return nil
+		},
+	}
+	svc := NewApplicationContactService(ownedCompanyRepo(), ownedContactRepo(), linkRepo)
+
+	err := svc.Unlink(context.Background(), "user-123", "company-1", "contact-1", "app-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user-123", "contact-1", "app-1"}, deleted)
+}
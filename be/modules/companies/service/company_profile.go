@@ -0,0 +1,77 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/andreypavlenko/jobber/internal/platform/urlutil"
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+)
+
+// companyProfile holds the optional profile details of a create or update request;
+// nil fields are left unchanged
+type companyProfile struct {
+	Industry    *string
+	Size        *string
+	WebsiteURL  *string
+	LinkedInURL *string
+	FoundedYear *int
+}
+
+// applyTo validates the given details and sets them on company. Blank text and a
+// founded year of 0 clear the field.
+func (p companyProfile) applyTo(company *model.Company) error {
+	if p.Size != nil {
+		size := optionalText(p.Size)
+		if size != nil {
+			lowered := strings.ToLower(*size)
+			if !model.IsValidCompanySize(lowered) {
+				return model.ErrInvalidCompanySize
+			}
+			size = &lowered
+		}
+		company.Size = size
+	}
+	if p.FoundedYear != nil {
+		year := *p.FoundedYear
+		if year == 0 {
+			company.FoundedYear = nil
+		} else {
+			if year < model.MinFoundedYear || year > time.Now().UTC().Year()+1 {
+				return model.ErrInvalidFoundedYear
+			}
+			company.FoundedYear = &year
+		}
+	}
+	if p.WebsiteURL != nil {
+		website, err := normalizeWebsiteURL(p.WebsiteURL)
+		if err != nil {
+			return err
+		}
+		company.WebsiteURL = website
+	}
+	if p.LinkedInURL != nil {
+		linkedIn, err := normalizeLinkedInURL(p.LinkedInURL)
+		if err != nil {
+			return err
+		}
+		company.LinkedInURL = linkedIn
+	}
+	if p.Industry != nil {
+		company.Industry = optionalText(p.Industry)
+	}
+	return nil
+}
+
+// normalizeWebsiteURL canonicalizes an optional http(s) URL
+func normalizeWebsiteURL(value *string) (*string, error) {
+	raw := optionalText(value)
+	if raw == nil {
+		return nil, nil
+	}
+	normalized, err := urlutil.NormalizeURL(*raw)
+	if err != nil {
+		return nil, model.ErrInvalidWebsiteURL
+	}
+	return &normalized, nil
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompanyService_Create_Profile(t *testing.T) {
+	userID := "user-123"
+
+	newService := func(created **model.Company) *CompanyService {
+		return NewCompanyService(&MockCompanyRepository{
+			CreateFunc: func(_ context.Context, company *model.Company) error {
+				company.ID = "company-1"
+				*created = company
+				return nil
+			},
+			GetByIDEnrichedFunc: func(_ context.Context, _, companyID string) (*model.CompanyDTO, error) {
+				return &model.CompanyDTO{ID: companyID}, nil
+			},
+		}, nil, nil, nil)
+	}
+	strPtr := func(s string) *string { return &s }
+	intPtr := func(i int) *int { return &i }
+
+	t.Run("normalizes the profile details", func(t *testing.T) {
+		var created *model.Company
+		svc := newService(&created)
+
+		_, err := svc.Create(context.Background(), userID, &model.CreateCompanyRequest{
+			Name:        "Acme",
+			Industry:    strPtr("  Fintech "),
+			Size:        strPtr("Medium"),
+			WebsiteURL:  strPtr("www.Acme.com/"),
+			LinkedInURL: strPtr("https://www.linkedin.com/company/acme/"),
+			FoundedYear: intPtr(1999),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, strPtr("Fintech"), created.Industry)
+		assert.Equal(t, strPtr("medium"), created.Size)
+		assert.Equal(t, strPtr("https://acme.com"), created.WebsiteURL)
+		assert.Equal(t, strPtr("https://linkedin.com/company/acme"), created.LinkedInURL)
+		assert.Equal(t, intPtr(1999), created.FoundedYear)
+	})
+
+	t.Run("rejects invalid details", func(t *testing.T) {
+		nextYear := time.Now().UTC().Year() + 1
+		tests := []struct {
+			name    string
+			req     *model.CreateCompanyRequest
+			wantErr error
+		}{
+			{"unknown size", &model.CreateCompanyRequest{Name: "Acme", Size: strPtr("huge")}, model.ErrInvalidCompanySize},
+			{"founded before 1800", &model.CreateCompanyRequest{Name: "Acme", FoundedYear: intPtr(1799)}, model.ErrInvalidFoundedYear},
+			{"founded after next year", &model.CreateCompanyRequest{Name: "Acme", FoundedYear: intPtr(nextYear + 1)}, model.ErrInvalidFoundedYear},
+			{"website with another scheme", &model.CreateCompanyRequest{Name: "Acme", WebsiteURL: strPtr("ftp://acme.com")}, model.ErrInvalidWebsiteURL},
+			{"LinkedIn URL elsewhere", &model.CreateCompanyRequest{Name: "Acme", LinkedInURL: strPtr("https://acme.com/about")}, model.ErrInvalidLinkedInURL},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var created *model.Company
+				svc := newService(&created)
+
+				_, err := svc.Create(context.Background(), userID, tt.req)
+
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, created)
+			})
+		}
+	})
+
+	t.Run("accepts the boundary years", func(t *testing.T) {
+		for _, year := range []int{model.MinFoundedYear, time.Now().UTC().Year() + 1} {
+			var created *model.Company
+			svc := newService(&created)
+
+			_, err := svc.Create(context.Background(), userID, &model.CreateCompanyRequest{Name: "Acme", FoundedYear: intPtr(year)})
+
+			require.NoError(t, err)
+			assert.Equal(t, intPtr(year), created.FoundedYear)
+		}
+	})
+}
+
+func TestCompanyService_Update_Profile(t *testing.T) {
+	userID := "user-123"
+	strPtr := func(s string) *string { return &s }
+	intPtr := func(i int) *int { return &i }
+
+	newService := func(updated **model.Company) *CompanyService {
+		return NewCompanyService(&MockCompanyRepository{
+			GetByIDFunc: func(_ context.Context, uid, companyID string) (*model.Company, error) {
+				return &model.Company{
+					ID:          companyID,
+					UserID:      uid,
+					Name:        "Acme",
+					Industry:    strPtr("Fintech"),
+					Size:        strPtr("small"),
+					WebsiteURL:  strPtr("https://acme.com"),
+					FoundedYear: intPtr(2015),
+				}, nil
+			},
+			UpdateFunc: func(_ context.Context, company *model.Company) error {
+				*updated = company
+				return nil
+			},
+			GetByIDEnrichedFunc: func(_ context.Context, _, companyID string) (*model.CompanyDTO, error) {
+				return &model.CompanyDTO{ID: companyID}, nil
+			},
+		}, nil, nil, nil)
+	}
+
+	t.Run("clears blank details and keeps omitted ones", func(t *testing.T) {
+		var updated *model.Company
+		svc := newService(&updated)
+
+		_, err := svc.Update(context.Background(), userID, "company-1", &model.UpdateCompanyRequest{
+			Industry:    strPtr(" "),
+			WebsiteURL:  strPtr(""),
+			FoundedYear: intPtr(0),
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, updated.Industry)
+		assert.Nil(t, updated.WebsiteURL)
+		assert.Nil(t, updated.FoundedYear)
+		assert.Equal(t, strPtr("small"), updated.Size)
+	})
+
+	t.Run("rejects an unknown size", func(t *testing.T) {
+		var updated *model.Company
+		svc := newService(&updated)
+
+		_, err := svc.Update(context.Background(), userID, "company-1", &model.UpdateCompanyRequest{Size: strPtr("tiny")})
+
+		assert.ErrorIs(t, err, model.ErrInvalidCompanySize)
+		assert.Nil(t, updated)
+	})
+}
@@ -2,20 +2,80 @@ package service
 
 import (
 	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/andreypavlenko/jobber/modules/companies/model"
 	"github.com/andreypavlenko/jobber/modules/companies/ports"
 )
 
+// ProfileInvalidator drops the cached user profile when tracked entity counts change.
+type ProfileInvalidator interface {
+	InvalidateProfile(ctx context.Context, userID string) error
+}
+
+// AnalyticsInvalidator drops the cached analytics when company data they report on changes.
+type AnalyticsInvalidator interface {
+	InvalidateAnalytics(ctx context.Context, userID string) error
+}
+
+// logoCheckTimeout bounds the HEAD request used to verify a logo URL
+const logoCheckTimeout = 2 * time.Second
+
+// logoImageExtensions are accepted as images without a HEAD request
+var logoImageExtensions = map[string]bool{
+	".png":  true,
+	".jpg":  true,
+	".jpeg": true,
+	".svg":  true,
+	".webp": true,
+}
+
 // CompanyService handles company business logic
 type CompanyService struct {
-	repo ports.CompanyRepository
+	repo           ports.CompanyRepository
+	contactRepo    ports.ContactRepository
+	profileCache   ProfileInvalidator
+	analyticsCache AnalyticsInvalidator
+	httpClient     *http.Client
+}
+
+// NewCompanyService creates a new company service. contactRepo embeds contacts
+// in GetByID, profileCache is invalidated on create and delete and
+// analyticsCache on merge; each may be nil.
+func NewCompanyService(repo ports.CompanyRepository, contactRepo ports.ContactRepository, profileCache ProfileInvalidator, analyticsCache AnalyticsInvalidator) *CompanyService {
+	return &CompanyService{
+		repo:           repo,
+		contactRepo:    contactRepo,
+		profileCache:   profileCache,
+		analyticsCache: analyticsCache,
+		httpClient:     &http.Client{Timeout: logoCheckTimeout},
+	}
+}
+
+// invalidateProfile drops the user's cached profile counts; failures only log
+func (s *CompanyService) invalidateProfile(ctx context.Context, userID string) {
+	if s.profileCache == nil {
+		return
+	}
+	if err := s.profileCache.InvalidateProfile(ctx, userID); err != nil {
+		log.Printf("[WARN] profile cache invalidation failed for user=%s: %v", userID, err)
+	}
 }
 
-// NewCompanyService creates a new company service
-func NewCompanyService(repo ports.CompanyRepository) *CompanyService {
-	return &CompanyService{repo: repo}
+// invalidateAnalytics drops the user's cached analytics; failures only log
+func (s *CompanyService) invalidateAnalytics(ctx context.Context, userID string) {
+	if s.analyticsCache == nil {
+		return
+	}
+	if err := s.analyticsCache.InvalidateAnalytics(ctx, userID); err != nil {
+		log.Printf("[WARN] analytics cache invalidation failed for user=%s: %v", userID, err)
+	}
 }
 
 // Create creates a new company
@@ -31,18 +91,86 @@ func (s *CompanyService) Create(ctx context.Context, userID string, req *model.C
 		Location: req.Location,
 		Notes:    req.Notes,
 	}
+	profile := companyProfile{
+		Industry:    req.Industry,
+		Size:        req.Size,
+		WebsiteURL:  req.WebsiteURL,
+		LinkedInURL: req.LinkedInURL,
+		FoundedYear: req.FoundedYear,
+	}
+	if err := profile.applyTo(company); err != nil {
+		return nil, err
+	}
 
 	if err := s.repo.Create(ctx, company); err != nil {
 		return nil, err
 	}
+	s.invalidateProfile(ctx, userID)
 
 	// Return enriched DTO
 	return s.repo.GetByIDEnriched(ctx, userID, company.ID)
 }
 
-// GetByID retrieves a company by ID with enriched fields
+// GetByID retrieves a company by ID with enriched fields and its contacts
 func (s *CompanyService) GetByID(ctx context.Context, userID, companyID string) (*model.CompanyDTO, error) {
-	return s.repo.GetByIDEnriched(ctx, userID, companyID)
+	company, err := s.repo.GetByIDEnriched(ctx, userID, companyID)
+	if err != nil || s.contactRepo == nil {
+		return company, err
+	}
+
+	contacts, err := s.contactRepo.ListByCompany(ctx, userID, companyID)
+	if err != nil {
+		return nil, err
+	}
+	company.Contacts = make([]*model.ContactDTO, 0, len(contacts))
+	for _, contact := range contacts {
+		company.Contacts = append(company.Contacts, contact.ToDTO())
+	}
+	return company, nil
+}
+
+// ExportNotes renders the company's notes as a Markdown document headed by the
+// company name and location. It returns nil when the company has no notes.
+func (s *CompanyService) ExportNotes(ctx context.Context, userID, companyID string) (*model.NotesExport, error) {
+	company, err := s.repo.GetByID(ctx, userID, companyID)
+	if err != nil {
+		return nil, err
+	}
+	if company.Notes == nil || strings.TrimSpace(*company.Notes) == "" {
+		return nil, nil
+	}
+
+	var b strings.Builder
+	b.WriteString("# " + company.Name + "\n\n")
+	if company.Location != nil && strings.TrimSpace(*company.Location) != "" {
+		b.WriteString("- **Location:** " + strings.TrimSpace(*company.Location) + "\n\n")
+	}
+	b.WriteString(strings.TrimSpace(*company.Notes) + "\n")
+
+	return &model.NotesExport{
+		Filename: notesExportFilename(company.Name),
+		Content:  []byte(b.String()),
+	}, nil
+}
+
+// notesExportFilename builds a header-safe file name from the company name:
+// spaces become single hyphens and anything outside [A-Za-z0-9_-] is dropped
+func notesExportFilename(companyName string) string {
+	var b strings.Builder
+	for _, r := range strings.Join(strings.Fields(companyName), "-") {
+		if r == '-' || r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	name := b.String()
+	for strings.Contains(name, "--") {
+		name = strings.ReplaceAll(name, "--", "-")
+	}
+	name = strings.Trim(name, "-_")
+	if name == "" {
+		name = "company"
+	}
+	return name + "-notes.md"
 }
 
 // List retrieves companies for a user with pagination and enriched fields
@@ -71,6 +199,16 @@ func (s *CompanyService) Update(ctx context.Context, userID, companyID string, r
 	if req.Notes != nil {
 		company.Notes = req.Notes
 	}
+	profile := companyProfile{
+		Industry:    req.Industry,
+		Size:        req.Size,
+		WebsiteURL:  req.WebsiteURL,
+		LinkedInURL: req.LinkedInURL,
+		FoundedYear: req.FoundedYear,
+	}
+	if err := profile.applyTo(company); err != nil {
+		return nil, err
+	}
 
 	if err := s.repo.Update(ctx, company); err != nil {
 		return nil, err
@@ -80,6 +218,58 @@ func (s *CompanyService) Update(ctx context.Context, userID, companyID string, r
 	return s.repo.GetByIDEnriched(ctx, userID, companyID)
 }
 
+// UpdateLogoURL sets the logo of a company; an empty URL clears it
+func (s *CompanyService) UpdateLogoURL(ctx context.Context, userID, companyID, logoURL string) error {
+	// Check ownership before making any outbound request
+	if _, err := s.repo.GetByID(ctx, userID, companyID); err != nil {
+		return err
+	}
+
+	logoURL = strings.TrimSpace(logoURL)
+	if logoURL == "" {
+		return s.repo.UpdateLogoURL(ctx, userID, companyID, nil)
+	}
+
+	if err := s.validateLogoURL(ctx, logoURL); err != nil {
+		return err
+	}
+	return s.repo.UpdateLogoURL(ctx, userID, companyID, &logoURL)
+}
+
+// validateLogoURL accepts http(s) URLs with an image file extension, or
+// otherwise issues a HEAD request and requires an image content type
+func (s *CompanyService) validateLogoURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return model.ErrInvalidLogoURL
+	}
+
+	if logoImageExtensions[strings.ToLower(path.Ext(u.Path))] {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, logoCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, u.String(), nil)
+	if err != nil {
+		return model.ErrInvalidLogoURL
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return model.ErrLogoURLNotAccessible
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return model.ErrLogoURLNotAccessible
+	}
+	if !strings.HasPrefix(strings.ToLower(resp.Header.Get("Content-Type")), "image/") {
+		return model.ErrInvalidLogoURL
+	}
+	return nil
+}
+
 // ToggleFavorite toggles the favorite status of a company
 func (s *CompanyService) ToggleFavorite(ctx context.Context, userID, companyID string) (bool, error) {
 	return s.repo.ToggleFavorite(ctx, userID, companyID)
@@ -95,10 +285,89 @@ func (s *CompanyService) Delete(ctx context.Context, userID, companyID string) e
 
 	// Note: We don't prevent deletion, but the frontend will warn users
 	// about related jobs/applications using GetRelatedJobsAndApplicationsCount
-	return s.repo.Delete(ctx, userID, companyID)
+	if err := s.repo.Delete(ctx, userID, companyID); err != nil {
+		return err
+	}
+	s.invalidateProfile(ctx, userID)
+	return nil
 }
 
 // GetRelatedJobsAndApplicationsCount gets counts of related data for delete warning
 func (s *CompanyService) GetRelatedJobsAndApplicationsCount(ctx context.Context, userID, companyID string) (jobsCount, appsCount int, err error) {
 	return s.repo.GetRelatedJobsAndApplicationsCount(ctx, userID, companyID)
 }
+
+// FindDuplicates groups the user's companies with similar names. Similarity chains,
+// so "Acme", "Acme Inc" and "Acme Inc." form one group even when the first and the
+// last are not similar enough on their own. The oldest company of a group is its
+// canonical one; groups are ordered by the age of their canonical company.
+func (s *CompanyService) FindDuplicates(ctx context.Context, userID string) ([]*model.DuplicateGroupDTO, error) {
+	pairs, err := s.repo.ListSimilarPairs(ctx, userID, model.DuplicateNameSimilarity)
+	if err != nil {
+		return nil, err
+	}
+
+	parent := make(map[string]string)
+	var companies []*model.Company
+	var find func(id string) string
+	find = func(id string) string {
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+		return parent[id]
+	}
+	for _, pair := range pairs {
+		for _, company := range []*model.Company{pair.First, pair.Second} {
+			if _, ok := parent[company.ID]; !ok {
+				parent[company.ID] = company.ID
+				companies = append(companies, company)
+			}
+		}
+		parent[find(pair.Second.ID)] = find(pair.First.ID)
+	}
+
+	sort.Slice(companies, func(i, j int) bool {
+		if !companies[i].CreatedAt.Equal(companies[j].CreatedAt) {
+			return companies[i].CreatedAt.Before(companies[j].CreatedAt)
+		}
+		return companies[i].ID < companies[j].ID
+	})
+	groups := []*model.DuplicateGroupDTO{}
+	byRoot := make(map[string]*model.DuplicateGroupDTO)
+	for _, company := range companies {
+		root := find(company.ID)
+		if group, ok := byRoot[root]; ok {
+			group.Duplicates = append(group.Duplicates, company.ToDTO())
+			continue
+		}
+		group := &model.DuplicateGroupDTO{Canonical: company.ToDTO(), Duplicates: []*model.CompanyDTO{}}
+		byRoot[root] = group
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// Merge merges the companies in req into companyID: their jobs, contacts and tags move
+// to it, their notes are appended to its notes and they are deleted. Returns the
+// surviving company.
+func (s *CompanyService) Merge(ctx context.Context, userID, companyID string, req *model.MergeCompaniesRequest) (*model.CompanyDTO, error) {
+	seen := make(map[string]bool, len(req.MergeFromIDs))
+	fromIDs := make([]string, 0, len(req.MergeFromIDs))
+	for _, id := range req.MergeFromIDs {
+		if id == companyID {
+			return nil, model.ErrCompanyMergeIntoSelf
+		}
+		if !seen[id] {
+			seen[id] = true
+			fromIDs = append(fromIDs, id)
+		}
+	}
+
+	if err := s.repo.Merge(ctx, userID, companyID, fromIDs); err != nil {
+		return nil, err
+	}
+	s.invalidateProfile(ctx, userID)
+	s.invalidateAnalytics(ctx, userID)
+
+	return s.GetByID(ctx, userID, companyID)
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -22,6 +24,9 @@ type MockCompanyRepository struct {
 	DeleteFunc                            func(ctx context.Context, userID, companyID string) error
 	GetRelatedJobsAndApplicationsCountFunc func(ctx context.Context, userID, companyID string) (jobsCount, appsCount int, err error)
 	ToggleFavoriteFunc                     func(ctx context.Context, userID, companyID string) (bool, error)
+	UpdateLogoURLFunc                      func(ctx context.Context, userID, companyID string, logoURL *string) error
+	ListSimilarPairsFunc                   func(ctx context.Context, userID string, threshold float64) ([]*model.SimilarCompanyPair, error)
+	MergeFunc                              func(ctx context.Context, userID, intoID string, fromIDs []string) error
 }
 
 func (m *MockCompanyRepository) Create(ctx context.Context, company *model.Company) error {
@@ -80,6 +85,31 @@ func (m *MockCompanyRepository) ToggleFavorite(ctx context.Context, userID, comp
 	return false, nil
 }
 
+func (m *MockCompanyRepository) UpdateLogoURL(ctx context.Context, userID, companyID string, logoURL *string) error {
+	if m.UpdateLogoURLFunc != nil {
+		return m.UpdateLogoURLFunc(ctx, userID, companyID, logoURL)
+	}
+	return nil
+}
+
+func (m *MockCompanyRepository) GetByName(ctx context.Context, userID, name string) (*model.Company, error) {
+	return nil, model.ErrCompanyNotFound
+}
+
+func (m *MockCompanyRepository) ListSimilarPairs(ctx context.Context, userID string, threshold float64) ([]*model.SimilarCompanyPair, error) {
+	if m.ListSimilarPairsFunc != nil {
+		return m.ListSimilarPairsFunc(ctx, userID, threshold)
+	}
+	return nil, nil
+}
+
+func (m *MockCompanyRepository) Merge(ctx context.Context, userID, intoID string, fromIDs []string) error {
+	if m.MergeFunc != nil {
+		return m.MergeFunc(ctx, userID, intoID, fromIDs)
+	}
+	return nil
+}
+
 func TestCompanyService_Create(t *testing.T) {
 	userID := "user-123"
 
@@ -99,7 +129,7 @@ func TestCompanyService_Create(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		req := &model.CreateCompanyRequest{Name: "Test Company"}
 
 		result, err := svc.Create(context.Background(), userID, req)
@@ -111,7 +141,7 @@ func TestCompanyService_Create(t *testing.T) {
 
 	t.Run("returns error for empty name", func(t *testing.T) {
 		mockRepo := &MockCompanyRepository{}
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		req := &model.CreateCompanyRequest{Name: "   "}
 
 		result, err := svc.Create(context.Background(), userID, req)
@@ -128,7 +158,7 @@ func TestCompanyService_Create(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		req := &model.CreateCompanyRequest{Name: "Test Company"}
 
 		result, err := svc.Create(context.Background(), userID, req)
@@ -151,7 +181,7 @@ func TestCompanyService_Create(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		req := &model.CreateCompanyRequest{Name: "  Test Company  "}
 
 		_, err := svc.Create(context.Background(), userID, req)
@@ -179,7 +209,7 @@ func TestCompanyService_GetByID(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		result, err := svc.GetByID(context.Background(), userID, companyID)
 
 		require.NoError(t, err)
@@ -193,7 +223,7 @@ func TestCompanyService_GetByID(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		result, err := svc.GetByID(context.Background(), userID, companyID)
 
 		assert.Nil(t, result)
@@ -219,7 +249,7 @@ func TestCompanyService_List(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		opts := &ports.ListOptions{Limit: 20, Offset: 0}
 
 		result, total, err := svc.List(context.Background(), userID, opts)
@@ -236,7 +266,7 @@ func TestCompanyService_List(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		opts := &ports.ListOptions{Limit: 20, Offset: 0}
 
 		result, total, err := svc.List(context.Background(), userID, opts)
@@ -278,7 +308,7 @@ func TestCompanyService_Update(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		req := &model.UpdateCompanyRequest{Name: &newName}
 
 		result, err := svc.Update(context.Background(), userID, companyID, req)
@@ -300,7 +330,7 @@ func TestCompanyService_Update(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		emptyName := "   "
 		req := &model.UpdateCompanyRequest{Name: &emptyName}
 
@@ -317,7 +347,7 @@ func TestCompanyService_Update(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		newName := "New Name"
 		req := &model.UpdateCompanyRequest{Name: &newName}
 
@@ -348,7 +378,7 @@ func TestCompanyService_Delete(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		err := svc.Delete(context.Background(), userID, companyID)
 
 		require.NoError(t, err)
@@ -361,7 +391,7 @@ func TestCompanyService_Delete(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		err := svc.Delete(context.Background(), userID, companyID)
 
 		assert.Equal(t, model.ErrCompanyNotFound, err)
@@ -379,7 +409,7 @@ func TestCompanyService_GetRelatedJobsAndApplicationsCount(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		jobsCount, appsCount, err := svc.GetRelatedJobsAndApplicationsCount(context.Background(), userID, companyID)
 
 		require.NoError(t, err)
@@ -394,7 +424,7 @@ func TestCompanyService_GetRelatedJobsAndApplicationsCount(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		jobsCount, appsCount, err := svc.GetRelatedJobsAndApplicationsCount(context.Background(), userID, companyID)
 
 		require.NoError(t, err)
@@ -416,7 +446,7 @@ func TestCompanyService_ToggleFavorite(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		result, err := svc.ToggleFavorite(context.Background(), userID, companyID)
 
 		require.NoError(t, err)
@@ -430,7 +460,7 @@ func TestCompanyService_ToggleFavorite(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		result, err := svc.ToggleFavorite(context.Background(), userID, companyID)
 
 		require.NoError(t, err)
@@ -444,9 +474,444 @@ func TestCompanyService_ToggleFavorite(t *testing.T) {
 			},
 		}
 
-		svc := NewCompanyService(mockRepo)
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
 		_, err := svc.ToggleFavorite(context.Background(), userID, companyID)
 
 		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
 	})
 }
+
+// MockProfileInvalidator implements ProfileInvalidator for testing
+type MockProfileInvalidator struct {
+	CalledWith []string
+}
+
+func (m *MockProfileInvalidator) InvalidateProfile(ctx context.Context, userID string) error {
+	m.CalledWith = append(m.CalledWith, userID)
+	return nil
+}
+
+// MockAnalyticsInvalidator implements AnalyticsInvalidator for testing
+type MockAnalyticsInvalidator struct {
+	CalledWith []string
+}
+
+func (m *MockAnalyticsInvalidator) InvalidateAnalytics(ctx context.Context, userID string) error {
+	m.CalledWith = append(m.CalledWith, userID)
+	return nil
+}
+
+func TestCompanyService_ProfileInvalidation(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("invalidates profile on create", func(t *testing.T) {
+		profileCache := &MockProfileInvalidator{}
+		mockRepo := &MockCompanyRepository{
+			CreateFunc: func(ctx context.Context, company *model.Company) error { return nil },
+			GetByIDEnrichedFunc: func(ctx context.Context, uid, companyID string) (*model.CompanyDTO, error) {
+				return &model.CompanyDTO{ID: companyID}, nil
+			},
+		}
+
+		svc := NewCompanyService(mockRepo, nil, profileCache, nil)
+		_, err := svc.Create(context.Background(), userID, &model.CreateCompanyRequest{Name: "Acme"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID}, profileCache.CalledWith)
+	})
+
+	t.Run("invalidates profile on delete", func(t *testing.T) {
+		profileCache := &MockProfileInvalidator{}
+		mockRepo := &MockCompanyRepository{
+			GetByIDFunc: func(ctx context.Context, uid, companyID string) (*model.Company, error) {
+				return &model.Company{ID: companyID, UserID: uid}, nil
+			},
+			DeleteFunc: func(ctx context.Context, uid, companyID string) error { return nil },
+		}
+
+		svc := NewCompanyService(mockRepo, nil, profileCache, nil)
+		err := svc.Delete(context.Background(), userID, "company-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID}, profileCache.CalledWith)
+	})
+
+	t.Run("does not invalidate when company is missing", func(t *testing.T) {
+		profileCache := &MockProfileInvalidator{}
+		mockRepo := &MockCompanyRepository{
+			GetByIDFunc: func(ctx context.Context, uid, companyID string) (*model.Company, error) {
+				return nil, model.ErrCompanyNotFound
+			},
+		}
+
+		svc := NewCompanyService(mockRepo, nil, profileCache, nil)
+		err := svc.Delete(context.Background(), userID, "company-1")
+
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+		assert.Empty(t, profileCache.CalledWith)
+	})
+}
+
+func TestCompanyService_UpdateLogoURL(t *testing.T) {
+	userID := "user-123"
+	companyID := "company-1"
+
+	newRepo := func(saved **string) *MockCompanyRepository {
+		return &MockCompanyRepository{
+			GetByIDFunc: func(ctx context.Context, uid, cid string) (*model.Company, error) {
+				return &model.Company{ID: cid, UserID: uid}, nil
+			},
+			UpdateLogoURLFunc: func(ctx context.Context, uid, cid string, logoURL *string) error {
+				*saved = logoURL
+				return nil
+			},
+		}
+	}
+
+	newLogoServer := func(status int, contentType string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodHead, r.Method)
+			w.Header().Set("Content-Type", contentType)
+			w.WriteHeader(status)
+		}))
+	}
+
+	t.Run("accepts image extension without a HEAD request", func(t *testing.T) {
+		var saved *string
+		svc := NewCompanyService(newRepo(&saved), nil, nil, nil)
+		svc.httpClient = &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			t.Fatal("HEAD request should not be made")
+			return nil, nil
+		})}
+
+		err := svc.UpdateLogoURL(context.Background(), userID, companyID, "https://cdn.example.com/acme.PNG")
+
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+		assert.Equal(t, "https://cdn.example.com/acme.PNG", *saved)
+	})
+
+	t.Run("accepts image content type from HEAD request", func(t *testing.T) {
+		server := newLogoServer(http.StatusOK, "image/png")
+		defer server.Close()
+
+		var saved *string
+		svc := NewCompanyService(newRepo(&saved), nil, nil, nil)
+		err := svc.UpdateLogoURL(context.Background(), userID, companyID, server.URL+"/logo")
+
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+		assert.Equal(t, server.URL+"/logo", *saved)
+	})
+
+	t.Run("rejects non-image content type", func(t *testing.T) {
+		server := newLogoServer(http.StatusOK, "text/html; charset=utf-8")
+		defer server.Close()
+
+		var saved *string
+		svc := NewCompanyService(newRepo(&saved), nil, nil, nil)
+		err := svc.UpdateLogoURL(context.Background(), userID, companyID, server.URL+"/about")
+
+		assert.ErrorIs(t, err, model.ErrInvalidLogoURL)
+		assert.Nil(t, saved)
+	})
+
+	t.Run("rejects URL returning an error status", func(t *testing.T) {
+		server := newLogoServer(http.StatusNotFound, "image/png")
+		defer server.Close()
+
+		var saved *string
+		svc := NewCompanyService(newRepo(&saved), nil, nil, nil)
+		err := svc.UpdateLogoURL(context.Background(), userID, companyID, server.URL+"/missing")
+
+		assert.ErrorIs(t, err, model.ErrLogoURLNotAccessible)
+		assert.Nil(t, saved)
+	})
+
+	t.Run("rejects unreachable URL", func(t *testing.T) {
+		var saved *string
+		svc := NewCompanyService(newRepo(&saved), nil, nil, nil)
+		svc.httpClient = &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return nil, errors.New("dial tcp: connection refused")
+		})}
+
+		err := svc.UpdateLogoURL(context.Background(), userID, companyID, "https://cdn.example.com/logo")
+
+		assert.ErrorIs(t, err, model.ErrLogoURLNotAccessible)
+		assert.Nil(t, saved)
+	})
+
+	t.Run("rejects non-http scheme", func(t *testing.T) {
+		var saved *string
+		svc := NewCompanyService(newRepo(&saved), nil, nil, nil)
+		err := svc.UpdateLogoURL(context.Background(), userID, companyID, "ftp://cdn.example.com/acme.png")
+
+		assert.ErrorIs(t, err, model.ErrInvalidLogoURL)
+		assert.Nil(t, saved)
+	})
+
+	t.Run("clears logo for empty URL", func(t *testing.T) {
+		cleared := false
+		mockRepo := newRepo(new(*string))
+		mockRepo.UpdateLogoURLFunc = func(ctx context.Context, uid, cid string, logoURL *string) error {
+			cleared = logoURL == nil
+			return nil
+		}
+
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
+		err := svc.UpdateLogoURL(context.Background(), userID, companyID, "  ")
+
+		require.NoError(t, err)
+		assert.True(t, cleared)
+	})
+
+	t.Run("returns not found before checking the URL", func(t *testing.T) {
+		mockRepo := &MockCompanyRepository{
+			GetByIDFunc: func(ctx context.Context, uid, cid string) (*model.Company, error) {
+				return nil, model.ErrCompanyNotFound
+			},
+		}
+
+		svc := NewCompanyService(mockRepo, nil, nil, nil)
+		svc.httpClient = &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			t.Fatal("HEAD request should not be made")
+			return nil, nil
+		})}
+
+		err := svc.UpdateLogoURL(context.Background(), userID, companyID, "https://cdn.example.com/logo")
+
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+	})
+}
+
+// roundTripFunc adapts a function to http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestNotesExportFilename(t *testing.T) {
+	tests := []struct {
+		name     string
+		company  string
+		expected string
+	}{
+		{name: "replaces spaces with hyphens", company: "Acme Corp", expected: "Acme-Corp-notes.md"},
+		{name: "collapses repeated whitespace", company: "  Acme   Corp  ", expected: "Acme-Corp-notes.md"},
+		{name: "strips special characters", company: "AT&T / Labs (EU)", expected: "ATT-Labs-EU-notes.md"},
+		{name: "drops header-breaking characters", company: "Evil\"; filename=x", expected: "Evil-filenamex-notes.md"},
+		{name: "drops non-ASCII letters", company: "Ünïcödé!", expected: "ncd-notes.md"},
+		{name: "falls back for empty name", company: "!!!", expected: "company-notes.md"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, notesExportFilename(tt.company))
+		})
+	}
+}
+
+func TestCompanyService_ExportNotes(t *testing.T) {
+	userID := "user-123"
+	companyID := "company-1"
+	strPtr := func(s string) *string { return &s }
+
+	newService := func(company *model.Company, err error) *CompanyService {
+		mockRepo := &MockCompanyRepository{
+			GetByIDFunc: func(ctx context.Context, uid, cid string) (*model.Company, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, companyID, cid)
+				return company, err
+			},
+		}
+		return NewCompanyService(mockRepo, nil, nil, nil)
+	}
+
+	t.Run("renders notes with the location", func(t *testing.T) {
+		svc := newService(&model.Company{ID: companyID, Name: "Acme Corp", Location: strPtr(" Berlin "), Notes: strPtr("\nGreat culture.\n")}, nil)
+
+		export, err := svc.ExportNotes(context.Background(), userID, companyID)
+
+		require.NoError(t, err)
+		require.NotNil(t, export)
+		assert.Equal(t, "Acme-Corp-notes.md", export.Filename)
+		assert.Equal(t, "# Acme Corp\n\n- **Location:** Berlin\n\nGreat culture.\n", string(export.Content))
+	})
+
+	t.Run("omits a blank location", func(t *testing.T) {
+		svc := newService(&model.Company{ID: companyID, Name: "Acme Corp", Location: strPtr("  "), Notes: strPtr("Great culture.")}, nil)
+
+		export, err := svc.ExportNotes(context.Background(), userID, companyID)
+
+		require.NoError(t, err)
+		require.NotNil(t, export)
+		assert.Equal(t, "# Acme Corp\n\nGreat culture.\n", string(export.Content))
+	})
+
+	t.Run("returns nil without notes", func(t *testing.T) {
+		svc := newService(&model.Company{ID: companyID, Name: "Acme Corp"}, nil)
+
+		export, err := svc.ExportNotes(context.Background(), userID, companyID)
+
+		require.NoError(t, err)
+		assert.Nil(t, export)
+	})
+
+	t.Run("returns nil for whitespace-only notes", func(t *testing.T) {
+		svc := newService(&model.Company{ID: companyID, Name: "Acme Corp", Notes: strPtr(" \n\t ")}, nil)
+
+		export, err := svc.ExportNotes(context.Background(), userID, companyID)
+
+		require.NoError(t, err)
+		assert.Nil(t, export)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		svc := newService(nil, model.ErrCompanyNotFound)
+
+		export, err := svc.ExportNotes(context.Background(), userID, companyID)
+
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+		assert.Nil(t, export)
+	})
+}
+
+func TestCompanyService_FindDuplicates(t *testing.T) {
+	userID := "user-123"
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	company := func(id, name string, age int) *model.Company {
+		return &model.Company{ID: id, UserID: userID, Name: name, CreatedAt: base.Add(time.Duration(age) * time.Hour)}
+	}
+
+	t.Run("groups chained pairs under the oldest company", func(t *testing.T) {
+		acme := company("company-1", "Acme", 0)
+		acmeInc := company("company-2", "Acme Inc", 1)
+		acmeIncDot := company("company-3", "Acme Inc.", 2)
+		globex := company("company-4", "Globex", 3)
+		globexCorp := company("company-5", "Globex Corp", 4)
+		mockRepo := &MockCompanyRepository{
+			ListSimilarPairsFunc: func(_ context.Context, uid string, threshold float64) ([]*model.SimilarCompanyPair, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, model.DuplicateNameSimilarity, threshold)
+				return []*model.SimilarCompanyPair{
+					{First: acme, Second: acmeInc},
+					{First: acmeInc, Second: acmeIncDot},
+					{First: globex, Second: globexCorp},
+				}, nil
+			},
+		}
+
+		groups, err := NewCompanyService(mockRepo, nil, nil, nil).FindDuplicates(context.Background(), userID)
+
+		require.NoError(t, err)
+		require.Len(t, groups, 2)
+		assert.Equal(t, "company-1", groups[0].Canonical.ID)
+		require.Len(t, groups[0].Duplicates, 2)
+		assert.Equal(t, "company-2", groups[0].Duplicates[0].ID)
+		assert.Equal(t, "company-3", groups[0].Duplicates[1].ID)
+		assert.Equal(t, "company-4", groups[1].Canonical.ID)
+		require.Len(t, groups[1].Duplicates, 1)
+		assert.Equal(t, "company-5", groups[1].Duplicates[0].ID)
+	})
+
+	t.Run("returns an empty list without similar names", func(t *testing.T) {
+		groups, err := NewCompanyService(&MockCompanyRepository{}, nil, nil, nil).FindDuplicates(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.NotNil(t, groups)
+		assert.Empty(t, groups)
+	})
+}
+
+func TestCompanyService_Merge(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("merges unique companies and returns the survivor", func(t *testing.T) {
+		profileCache := &MockProfileInvalidator{}
+		var gotInto string
+		var gotFrom []string
+		mockRepo := &MockCompanyRepository{
+			MergeFunc: func(_ context.Context, uid, intoID string, fromIDs []string) error {
+				assert.Equal(t, userID, uid)
+				gotInto, gotFrom = intoID, fromIDs
+				return nil
+			},
+			GetByIDEnrichedFunc: func(_ context.Context, _, companyID string) (*model.CompanyDTO, error) {
+				return &model.CompanyDTO{ID: companyID, JobsCount: 3}, nil
+			},
+		}
+
+		svc := NewCompanyService(mockRepo, nil, profileCache, nil)
+		company, err := svc.Merge(context.Background(), userID, "company-1", &model.MergeCompaniesRequest{
+			MergeFromIDs: []string{"company-2", "company-3", "company-2"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "company-1", company.ID)
+		assert.Equal(t, 3, company.JobsCount)
+		assert.Equal(t, "company-1", gotInto)
+		assert.Equal(t, []string{"company-2", "company-3"}, gotFrom)
+		assert.Equal(t, []string{userID}, profileCache.CalledWith)
+	})
+
+	t.Run("invalidates analytics after the merge", func(t *testing.T) {
+		analyticsCache := &MockAnalyticsInvalidator{}
+		mockRepo := &MockCompanyRepository{
+			MergeFunc: func(_ context.Context, _, _ string, _ []string) error { return nil },
+			GetByIDEnrichedFunc: func(_ context.Context, _, companyID string) (*model.CompanyDTO, error) {
+				return &model.CompanyDTO{ID: companyID}, nil
+			},
+		}
+
+		_, err := NewCompanyService(mockRepo, nil, nil, analyticsCache).Merge(context.Background(), userID, "company-1", &model.MergeCompaniesRequest{
+			MergeFromIDs: []string{"company-2"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID}, analyticsCache.CalledWith)
+	})
+
+	t.Run("does not invalidate analytics when the merge fails", func(t *testing.T) {
+		analyticsCache := &MockAnalyticsInvalidator{}
+		mockRepo := &MockCompanyRepository{
+			MergeFunc: func(_ context.Context, _, _ string, _ []string) error { return model.ErrCompanyNotFound },
+		}
+
+		_, err := NewCompanyService(mockRepo, nil, nil, analyticsCache).Merge(context.Background(), userID, "company-1", &model.MergeCompaniesRequest{
+			MergeFromIDs: []string{"company-2"},
+		})
+
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+		assert.Empty(t, analyticsCache.CalledWith)
+	})
+
+	t.Run("rejects merging a company into itself", func(t *testing.T) {
+		mockRepo := &MockCompanyRepository{
+			MergeFunc: func(_ context.Context, _, _ string, _ []string) error {
+				t.Fatal("repository should not be called")
+				return nil
+			},
+		}
+
+		_, err := NewCompanyService(mockRepo, nil, nil, nil).Merge(context.Background(), userID, "company-1", &model.MergeCompaniesRequest{
+			MergeFromIDs: []string{"company-2", "company-1"},
+		})
+
+		assert.ErrorIs(t, err, model.ErrCompanyMergeIntoSelf)
+	})
+
+	t.Run("returns not found from the repository", func(t *testing.T) {
+		mockRepo := &MockCompanyRepository{
+			MergeFunc: func(_ context.Context, _, _ string, _ []string) error {
+				return model.ErrCompanyNotFound
+			},
+		}
+
+		_, err := NewCompanyService(mockRepo, nil, nil, nil).Merge(context.Background(), userID, "company-1", &model.MergeCompaniesRequest{
+			MergeFromIDs: []string{"company-2"},
+		})
+
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+	})
+}
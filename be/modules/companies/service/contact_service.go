@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/andreypavlenko/jobber/internal/platform/email"
+	"github.com/andreypavlenko/jobber/internal/platform/urlutil"
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/andreypavlenko/jobber/modules/companies/ports"
+)
+
+// ContactService handles the people a user tracks at their companies
+type ContactService struct {
+	companyRepo ports.CompanyRepository
+	contactRepo ports.ContactRepository
+}
+
+// NewContactService creates a new company contact service
+func NewContactService(companyRepo ports.CompanyRepository, contactRepo ports.ContactRepository) *ContactService {
+	return &ContactService{
+		companyRepo: companyRepo,
+		contactRepo: contactRepo,
+	}
+}
+
+// Create adds a contact to one of the user's companies
+func (s *ContactService) Create(ctx context.Context, userID, companyID string, req *model.CreateContactRequest) (*model.ContactDTO, error) {
+	if err := s.checkCompany(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, model.ErrContactNameRequired
+	}
+
+	contact := &model.Contact{
+		CompanyID: companyID,
+		UserID:    userID,
+		Name:      name,
+		Title:     optionalText(req.Title),
+		Notes:     optionalText(req.Notes),
+	}
+
+	var err error
+	if contact.Email, err = normalizeContactEmail(req.Email); err != nil {
+		return nil, err
+	}
+	if contact.LinkedInURL, err = normalizeLinkedInURL(req.LinkedInURL); err != nil {
+		return nil, err
+	}
+
+	if err := s.contactRepo.Create(ctx, contact); err != nil {
+		return nil, err
+	}
+	return contact.ToDTO(), nil
+}
+
+// List lists the contacts of one of the user's companies
+func (s *ContactService) List(ctx context.Context, userID, companyID string) ([]*model.ContactDTO, error) {
+	if err := s.checkCompany(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+
+	contacts, err := s.contactRepo.ListByCompany(ctx, userID, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*model.ContactDTO, 0, len(contacts))
+	for _, contact := range contacts {
+		dtos = append(dtos, contact.ToDTO())
+	}
+	return dtos, nil
+}
+
+// Get retrieves a contact of one of the user's companies
+func (s *ContactService) Get(ctx context.Context, userID, companyID, contactID string) (*model.ContactDTO, error) {
+	if err := s.checkCompany(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+
+	contact, err := s.contactRepo.GetByID(ctx, userID, companyID, contactID)
+	if err != nil {
+		return nil, err
+	}
+	return contact.ToDTO(), nil
+}
+
+// Update changes the given fields of a contact; empty optional fields are cleared
+func (s *ContactService) Update(ctx context.Context, userID, companyID, contactID string, req *model.UpdateContactRequest) (*model.ContactDTO, error) {
+	if err := s.checkCompany(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+
+	contact, err := s.contactRepo.GetByID(ctx, userID, companyID, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, model.ErrContactNameRequired
+		}
+		contact.Name = name
+	}
+	if req.Title != nil {
+		contact.Title = optionalText(req.Title)
+	}
+	if req.Notes != nil {
+		contact.Notes = optionalText(req.Notes)
+	}
+	if req.Email != nil {
+		if contact.Email, err = normalizeContactEmail(req.Email); err != nil {
+			return nil, err
+		}
+	}
+	if req.LinkedInURL != nil {
+		if contact.LinkedInURL, err = normalizeLinkedInURL(req.LinkedInURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.contactRepo.Update(ctx, contact); err != nil {
+		return nil, err
+	}
+	return contact.ToDTO(), nil
+}
+
+// Delete removes a contact
+func (s *ContactService) Delete(ctx context.Context, userID, companyID, contactID string) error {
+	if err := s.checkCompany(ctx, userID, companyID); err != nil {
+		return err
+	}
+	return s.contactRepo.Delete(ctx, userID, companyID, contactID)
+}
+
+// checkCompany returns ErrCompanyNotFound unless the company belongs to the user
+func (s *ContactService) checkCompany(ctx context.Context, userID, companyID string) error {
+	_, err := s.companyRepo.GetByID(ctx, userID, companyID)
+	return err
+}
+
+// optionalText trims value and returns nil when it is missing or blank
+func optionalText(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// normalizeContactEmail validates an optional email with the same rules as sign-up
+func normalizeContactEmail(value *string) (*string, error) {
+	address := optionalText(value)
+	if address == nil {
+		return nil, nil
+	}
+	if !email.IsValidAddress(*address) {
+		return nil, model.ErrInvalidContactEmail
+	}
+	return address, nil
+}
+
+// normalizeLinkedInURL canonicalizes an optional URL and requires a linkedin.com host
+func normalizeLinkedInURL(value *string) (*string, error) {
+	raw := optionalText(value)
+	if raw == nil {
+		return nil, nil
+	}
+	normalized, err := urlutil.NormalizeURL(*raw)
+	if err != nil {
+		return nil, model.ErrInvalidLinkedInURL
+	}
+	parsed, err := url.Parse(normalized)
+	if err != nil {
+		return nil, model.ErrInvalidLinkedInURL
+	}
+	host := parsed.Hostname()
+	if host != "linkedin.com" && !strings.HasSuffix(host, ".linkedin.com") {
+		return nil, model.ErrInvalidLinkedInURL
+	}
+	return &normalized, nil
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/companies/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockContactRepository implements ports.ContactRepository
+type MockContactRepository struct {
+	CreateFunc        func(ctx context.Context, contact *model.Contact) error
+	GetByIDFunc       func(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error)
+	ListByCompanyFunc func(ctx context.Context, userID, companyID string) ([]*model.Contact, error)
+	UpdateFunc        func(ctx context.Context, contact *model.Contact) error
+	DeleteFunc        func(ctx context.Context, userID, companyID, contactID string) error
+}
+
+func (m *MockContactRepository) Create(ctx context.Context, contact *model.Contact) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, contact)
+	}
+	contact.ID = "contact-1"
+	return nil
+}
+
+func (m *MockContactRepository) GetByID(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, userID, companyID, contactID)
+	}
+	return nil, model.ErrContactNotFound
+}
+
+func (m *MockContactRepository) ListByCompany(ctx context.Context, userID, companyID string) ([]*model.Contact, error) {
+	if m.ListByCompanyFunc != nil {
+		return m.ListByCompanyFunc(ctx, userID, companyID)
+	}
+	return []*model.Contact{}, nil
+}
+
+func (m *MockContactRepository) Update(ctx context.Context, contact *model.Contact) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, contact)
+	}
+	return nil
+}
+
+func (m *MockContactRepository) Delete(ctx context.Context, userID, companyID, contactID string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID, companyID, contactID)
+	}
+	return nil
+}
+
+// ownedCompanyRepo finds only company-1 of user-123
+func ownedCompanyRepo() *MockCompanyRepository {
+	return &MockCompanyRepository{
+		GetByIDFunc: func(ctx context.Context, userID, companyID string) (*model.Company, error) {
+			if userID == "user-123" && companyID == "company-1" {
+				return &model.Company{ID: companyID, UserID: userID, Name: "Acme"}, nil
+			}
+			return nil, model.ErrCompanyNotFound
+		},
+	}
+}
+
+func TestContactService_Create(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("creates a contact with normalized fields", func(t *testing.T) {
+		var created *model.Contact
+		contactRepo := &MockContactRepository{CreateFunc: func(ctx context.Context, contact *model.Contact) error {
+			created = contact
+			contact.ID = "contact-1"
+			return nil
+		}}
+		svc := NewContactService(ownedCompanyRepo(), contactRepo)
+
+		dto, err := svc.Create(context.Background(), "user-123", "company-1", &model.CreateContactRequest{
+			Name:        "  Jane Doe ",
+			Title:       strPtr("Engineering Manager"),
+			Email:       strPtr(" jane@acme.com "),
+			LinkedInURL: strPtr("www.linkedin.com/in/janedoe/"),
+			Notes:       strPtr("   "),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, created)
+		assert.Equal(t, "company-1", created.CompanyID)
+		assert.Equal(t, "user-123", created.UserID)
+		assert.Equal(t, "Jane Doe", created.Name)
+		assert.Equal(t, "jane@acme.com", *created.Email)
+		assert.Equal(t, "https://linkedin.com/in/janedoe", *created.LinkedInURL)
+		assert.Nil(t, created.Notes)
+		assert.Equal(t, "contact-1", dto.ID)
+		assert.Equal(t, "Engineering Manager", *dto.Title)
+	})
+
+	t.Run("rejects a company of another user before writing", func(t *testing.T) {
+		contactRepo := &MockContactRepository{CreateFunc: func(ctx context.Context, contact *model.Contact) error {
+			t.Fatal("contact should not be created")
+			return nil
+		}}
+		svc := NewContactService(ownedCompanyRepo(), contactRepo)
+
+		_, err := svc.Create(context.Background(), "user-456", "company-1", &model.CreateContactRequest{Name: "Jane"})
+
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+	})
+
+	tests := []struct {
+		name    string
+		req     *model.CreateContactRequest
+		wantErr error
+	}{
+		{"blank name", &model.CreateContactRequest{Name: "   "}, model.ErrContactNameRequired},
+		{"invalid email", &model.CreateContactRequest{Name: "Jane", Email: strPtr("jane@acme")}, model.ErrInvalidContactEmail},
+		{"non-LinkedIn URL", &model.CreateContactRequest{Name: "Jane", LinkedInURL: strPtr("https://example.com/in/jane")}, model.ErrInvalidLinkedInURL},
+		{"lookalike LinkedIn host", &model.CreateContactRequest{Name: "Jane", LinkedInURL: strPtr("https://notlinkedin.com/in/jane")}, model.ErrInvalidLinkedInURL},
+		{"unsupported scheme", &model.CreateContactRequest{Name: "Jane", LinkedInURL: strPtr("ftp://linkedin.com/in/jane")}, model.ErrInvalidLinkedInURL},
+	}
+	for _, tt := range tests {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			contactRepo := &MockContactRepository{CreateFunc: func(ctx context.Context, contact *model.Contact) error {
+				t.Fatal("contact should not be created")
+				return nil
+			}}
+			svc := NewContactService(ownedCompanyRepo(), contactRepo)
+
+			_, err := svc.Create(context.Background(), "user-123", "company-1", tt.req)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("accepts a country LinkedIn subdomain", func(t *testing.T) {
+		svc := NewContactService(ownedCompanyRepo(), &MockContactRepository{})
+
+		dto, err := svc.Create(context.Background(), "user-123", "company-1", &model.CreateContactRequest{
+			Name: "Jane", LinkedInURL: strPtr("https://de.linkedin.com/in/jane"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://de.linkedin.com/in/jane", *dto.LinkedInURL)
+	})
+}
+
+func TestContactService_List(t *testing.T) {
+	t.Run("lists the company's contacts", func(t *testing.T) {
+		contactRepo := &MockContactRepository{ListByCompanyFunc: func(ctx context.Context, userID, companyID string) ([]*model.Contact, error) {
+			assert.Equal(t, "user-123", userID)
+			assert.Equal(t, "company-1", companyID)
+			return []*model.Contact{{ID: "contact-1", CompanyID: companyID, Name: "Jane"}}, nil
+		}}
+		svc := NewContactService(ownedCompanyRepo(), contactRepo)
+
+		contacts, err := svc.List(context.Background(), "user-123", "company-1")
+
+		require.NoError(t, err)
+		require.Len(t, contacts, 1)
+		assert.Equal(t, "Jane", contacts[0].Name)
+	})
+
+	t.Run("returns not found for another user's company", func(t *testing.T) {
+		svc := NewContactService(ownedCompanyRepo(), &MockContactRepository{})
+
+		_, err := svc.List(context.Background(), "user-123", "company-2")
+
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+	})
+}
+
+func TestContactService_Get(t *testing.T) {
+	t.Run("returns the contact", func(t *testing.T) {
+		contactRepo := &MockContactRepository{GetByIDFunc: func(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error) {
+			assert.Equal(t, "user-123", userID)
+			assert.Equal(t, "company-1", companyID)
+			assert.Equal(t, "contact-1", contactID)
+			return &model.Contact{ID: contactID, CompanyID: companyID, UserID: userID, Name: "Jane"}, nil
+		}}
+		svc := NewContactService(ownedCompanyRepo(), contactRepo)
+
+		contact, err := svc.Get(context.Background(), "user-123", "company-1", "contact-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Jane", contact.Name)
+	})
+
+	t.Run("returns not found for an unknown contact", func(t *testing.T) {
+		svc := NewContactService(ownedCompanyRepo(), &MockContactRepository{})
+
+		_, err := svc.Get(context.Background(), "user-123", "company-1", "missing")
+
+		assert.ErrorIs(t, err, model.ErrContactNotFound)
+	})
+
+	t.Run("returns not found for another user's company", func(t *testing.T) {
+		svc := NewContactService(ownedCompanyRepo(), &MockContactRepository{})
+
+		_, err := svc.Get(context.Background(), "user-123", "company-2", "contact-1")
+
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+	})
+}
+
+func TestContactService_Update(t *testing.T) {
+	existing := func() *model.Contact {
+		email := "old@acme.com"
+		title := "Recruiter"
+		return &model.Contact{ID: "contact-1", CompanyID: "company-1", UserID: "user-123", Name: "Jane", Email: &email, Title: &title}
+	}
+
+	t.Run("updates given fields and clears empty ones", func(t *testing.T) {
+		var updated *model.Contact
+		contactRepo := &MockContactRepository{
+			GetByIDFunc: func(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error) {
+				return existing(), nil
+			},
+			UpdateFunc: func(ctx context.Context, contact *model.Contact) error {
+				updated = contact
+				return nil
+			},
+		}
+		svc := NewContactService(ownedCompanyRepo(), contactRepo)
+		name := "Jane Smith"
+		email := ""
+
+		dto, err := svc.Update(context.Background(), "user-123", "company-1", "contact-1", &model.UpdateContactRequest{Name: &name, Email: &email})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Jane Smith", updated.Name)
+		assert.Nil(t, updated.Email)
+		assert.Equal(t, "Recruiter", *updated.Title)
+		assert.Equal(t, "Jane Smith", dto.Name)
+	})
+
+	t.Run("rejects an invalid email", func(t *testing.T) {
+		contactRepo := &MockContactRepository{
+			GetByIDFunc: func(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error) {
+				return existing(), nil
+			},
+			UpdateFunc: func(ctx context.Context, contact *model.Contact) error {
+				t.Fatal("contact should not be updated")
+				return nil
+			},
+		}
+		svc := NewContactService(ownedCompanyRepo(), contactRepo)
+		email := "not-an-email"
+
+		_, err := svc.Update(context.Background(), "user-123", "company-1", "contact-1", &model.UpdateContactRequest{Email: &email})
+
+		assert.ErrorIs(t, err, model.ErrInvalidContactEmail)
+	})
+
+	t.Run("checks company ownership before loading the contact", func(t *testing.T) {
+		contactRepo := &MockContactRepository{GetByIDFunc: func(ctx context.Context, userID, companyID, contactID string) (*model.Contact, error) {
+			t.Fatal("contact should not be loaded")
+			return nil, nil
+		}}
+		svc := NewContactService(ownedCompanyRepo(), contactRepo)
+
+		_, err := svc.Update(context.Background(), "user-456", "company-1", "contact-1", &model.UpdateContactRequest{})
+
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+	})
+
+	t.Run("returns contact not found", func(t *testing.T) {
+		svc := NewContactService(ownedCompanyRepo(), &MockContactRepository{})
+
+		_, err := svc.Update(context.Background(), "user-123", "company-1", "missing", &model.UpdateContactRequest{})
+
+		assert.ErrorIs(t, err, model.ErrContactNotFound)
+	})
+}
+
+func TestContactService_Delete(t *testing.T) {
+	t.Run("deletes the contact", func(t *testing.T) {
+		var deleted string
+		contactRepo := &MockContactRepository{DeleteFunc: func(ctx context.Context, userID, companyID, contactID string) error {
+			deleted = contactID
+			return nil
+		}}
+		svc := NewContactService(ownedCompanyRepo(), contactRepo)
+
+		err := svc.Delete(context.Background(), "user-123", "company-1", "contact-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "contact-1", deleted)
+	})
+
+	t.Run("rejects another user's company", func(t *testing.T) {
+		contactRepo := &MockContactRepository{DeleteFunc: func(ctx context.Context, userID, companyID, contactID string) error {
+			t.Fatal("contact should not be deleted")
+			return nil
+		}}
+		svc := NewContactService(ownedCompanyRepo(), contactRepo)
+
+		err := svc.Delete(context.Background(), "user-456", "company-1", "contact-1")
+
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+	})
+}
+
+func TestCompanyService_GetByID_EmbedsContacts(t *testing.T) {
+	now := time.Now()
+	companyRepo := &MockCompanyRepository{
+		GetByIDEnrichedFunc: func(ctx context.Context, uid, cid string) (*model.CompanyDTO, error) {
+			return &model.CompanyDTO{ID: cid, Name: "Acme"}, nil
+		},
+	}
+
+	t.Run("embeds the contacts", func(t *testing.T) {
+		svc := NewCompanyService(companyRepo, &MockContactRepository{ListByCompanyFunc: func(ctx context.Context, userID, companyID string) ([]*model.Contact, error) {
+			return []*model.Contact{{ID: "contact-1", CompanyID: companyID, Name: "Jane", CreatedAt: now}}, nil
+		}}, nil, nil)
+
+		company, err := svc.GetByID(context.Background(), "user-123", "company-1")
+
+		require.NoError(t, err)
+		require.Len(t, company.Contacts, 1)
+		assert.Equal(t, "Jane", company.Contacts[0].Name)
+	})
+
+	t.Run("returns an empty list when there are no contacts", func(t *testing.T) {
+		svc := NewCompanyService(companyRepo, &MockContactRepository{}, nil, nil)
+
+		company, err := svc.GetByID(context.Background(), "user-123", "company-1")
+
+		require.NoError(t, err)
+		assert.NotNil(t, company.Contacts)
+		assert.Empty(t, company.Contacts)
+	})
+
+	t.Run("returns contact loading errors", func(t *testing.T) {
+		svc := NewCompanyService(companyRepo, &MockContactRepository{ListByCompanyFunc: func(ctx context.Context, userID, companyID string) ([]*model.Contact, error) {
+			return nil, errors.New("database error")
+		}}, nil, nil)
+
+		_, err := svc.GetByID(context.Background(), "user-123", "company-1")
+
+		assert.Error(t, err)
+	})
+}
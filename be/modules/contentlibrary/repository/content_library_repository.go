@@ -5,12 +5,21 @@ import (
 	"fmt"
 
 	"github.com/andreypavlenko/jobber/modules/contentlibrary/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
 // ContentLibraryRepository implements ports.ContentLibraryRepository.
 type ContentLibraryRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 // NewContentLibraryRepository creates a new ContentLibraryRepository.
@@ -18,6 +27,11 @@ func NewContentLibraryRepository(pool *pgxpool.Pool) *ContentLibraryRepository {
 	return &ContentLibraryRepository{pool: pool}
 }
 
+// NewContentLibraryRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewContentLibraryRepositoryWithPool(pool DBPool) *ContentLibraryRepository {
+	return &ContentLibraryRepository{pool: pool}
+}
+
 // Create creates a new content library entry.
 func (r *ContentLibraryRepository) Create(ctx context.Context, entry *model.ContentLibraryEntry) (*model.ContentLibraryEntry, error) {
 	query := `INSERT INTO content_library (user_id, title, content, category)
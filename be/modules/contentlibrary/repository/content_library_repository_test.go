@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/contentlibrary/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var entryColumns = []string{"id", "user_id", "title", "content", "category", "created_at", "updated_at"}
+
+func TestContentLibraryRepository_Create(t *testing.T) {
+	t.Run("returns the stored entry", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`INSERT INTO content_library \(user_id, title, content, category\).*RETURNING id, created_at, updated_at`).
+			WithArgs("user-1", "Intro", "Hello", "greeting").
+			WillReturnRows(pgxmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow("entry-1", now, now))
+
+		entry, err := NewContentLibraryRepositoryWithPool(mock).Create(context.Background(), &model.ContentLibraryEntry{
+			UserID: "user-1", Title: "Intro", Content: "Hello", Category: "greeting",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "entry-1", entry.ID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("wraps insert errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("INSERT INTO content_library").
+			WithArgs("user-1", "", "", "").
+			WillReturnError(assert.AnError)
+
+		entry, err := NewContentLibraryRepositoryWithPool(mock).Create(context.Background(), &model.ContentLibraryEntry{UserID: "user-1"})
+
+		assert.Nil(t, entry)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestContentLibraryRepository_GetByID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`FROM content_library WHERE id = \$1`).
+		WithArgs("entry-1").
+		WillReturnRows(pgxmock.NewRows(entryColumns).AddRow("entry-1", "user-1", "Intro", "Hello", "greeting", now, now))
+	mock.ExpectQuery("FROM content_library").
+		WithArgs("entry-1").
+		WillReturnError(pgx.ErrNoRows)
+
+	repo := NewContentLibraryRepositoryWithPool(mock)
+	entry, err := repo.GetByID(context.Background(), "entry-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", entry.Content)
+
+	entry, err = repo.GetByID(context.Background(), "entry-1")
+	assert.Nil(t, entry)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContentLibraryRepository_List(t *testing.T) {
+	t.Run("returns the user's entries", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`FROM content_library WHERE user_id = \$1 ORDER BY updated_at DESC`).
+			WithArgs("user-1").
+			WillReturnRows(pgxmock.NewRows(entryColumns).
+				AddRow("entry-2", "user-1", "Outro", "Bye", "closing", now, now).
+				AddRow("entry-1", "user-1", "Intro", "Hello", "greeting", now, now))
+
+		entries, err := NewContentLibraryRepositoryWithPool(mock).List(context.Background(), "user-1")
+
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "entry-2", entries[0].ID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("wraps query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM content_library").
+			WithArgs("user-1").
+			WillReturnError(assert.AnError)
+
+		entries, err := NewContentLibraryRepositoryWithPool(mock).List(context.Background(), "user-1")
+
+		assert.Nil(t, entries)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestContentLibraryRepository_Update(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	updatedAt := time.Now()
+	mock.ExpectQuery(`UPDATE content_library SET title = \$1, content = \$2, category = \$3\s+WHERE id = \$4 RETURNING updated_at`).
+		WithArgs("Intro", "Hi", "greeting", "entry-1").
+		WillReturnRows(pgxmock.NewRows([]string{"updated_at"}).AddRow(updatedAt))
+	mock.ExpectQuery("UPDATE content_library").
+		WithArgs("Intro", "Hi", "greeting", "entry-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewContentLibraryRepositoryWithPool(mock)
+	entry := &model.ContentLibraryEntry{ID: "entry-1", Title: "Intro", Content: "Hi", Category: "greeting"}
+	updated, err := repo.Update(context.Background(), entry)
+	require.NoError(t, err)
+	assert.Equal(t, updatedAt, updated.UpdatedAt)
+
+	_, err = repo.Update(context.Background(), entry)
+	assert.ErrorIs(t, err, assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContentLibraryRepository_Delete(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`DELETE FROM content_library WHERE id = \$1`).
+			WithArgs("entry-1").
+			WillReturnResult(pgxmock.NewResult("DELETE", result))
+	}
+	mock.ExpectExec("DELETE FROM content_library").
+		WithArgs("entry-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewContentLibraryRepositoryWithPool(mock)
+	assert.NoError(t, repo.Delete(context.Background(), "entry-1"))
+	assert.ErrorContains(t, repo.Delete(context.Background(), "entry-1"), "content library entry not found")
+	assert.ErrorIs(t, repo.Delete(context.Background(), "entry-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -6,12 +6,20 @@ import (
 
 	"github.com/andreypavlenko/jobber/modules/coverletters/model"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
 // CoverLetterRepository implements ports.CoverLetterRepository.
 type CoverLetterRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 // NewCoverLetterRepository creates a new CoverLetterRepository.
@@ -19,6 +27,11 @@ func NewCoverLetterRepository(pool *pgxpool.Pool) *CoverLetterRepository {
 	return &CoverLetterRepository{pool: pool}
 }
 
+// NewCoverLetterRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewCoverLetterRepositoryWithPool(pool DBPool) *CoverLetterRepository {
+	return &CoverLetterRepository{pool: pool}
+}
+
 // Create creates a new cover letter.
 func (r *CoverLetterRepository) Create(ctx context.Context, cl *model.CoverLetter) (*model.CoverLetter, error) {
 	query := `INSERT INTO cover_letters (user_id, resume_builder_id, job_id, title, template,
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/coverletters/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var coverLetterColumns = []string{"id", "user_id", "resume_builder_id", "job_id", "title", "template", "recipient_name", "recipient_title", "company_name", "company_address", "greeting", "paragraphs", "closing", "font_family", "font_size", "primary_color", "created_at", "updated_at"}
+
+func coverLetterRow(id string, now time.Time) []any {
+	jobID := "job-1"
+	return []any{id, "user-1", nil, &jobID, "Backend role", "modern", "Jane", "CTO", "Acme", "Berlin", "Dear Jane,", []string{"First", "Second"}, "Regards", "Inter", 11, "#000000", now, now}
+}
+
+// anyArgs matches n arguments of any value.
+func anyArgs(n int) []any {
+	args := make([]any, n)
+	for i := range args {
+		args[i] = pgxmock.AnyArg()
+	}
+	return args
+}
+
+func TestCoverLetterRepository_Create(t *testing.T) {
+	t.Run("returns the stored letter", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`INSERT INTO cover_letters .*RETURNING id, created_at, updated_at`).
+			WithArgs(anyArgs(15)...).
+			WillReturnRows(pgxmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow("cl-1", now, now))
+
+		cl, err := NewCoverLetterRepositoryWithPool(mock).Create(context.Background(), &model.CoverLetter{UserID: "user-1", Title: "Backend role"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "cl-1", cl.ID)
+		assert.Equal(t, now, cl.CreatedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("wraps insert errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("INSERT INTO cover_letters").
+			WithArgs(anyArgs(15)...).
+			WillReturnError(assert.AnError)
+
+		cl, err := NewCoverLetterRepositoryWithPool(mock).Create(context.Background(), &model.CoverLetter{UserID: "user-1"})
+
+		assert.Nil(t, cl)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to create cover letter")
+	})
+}
+
+func TestCoverLetterRepository_GetByID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`FROM cover_letters WHERE id = \$1`).
+		WithArgs("cl-1").
+		WillReturnRows(pgxmock.NewRows(coverLetterColumns).AddRow(coverLetterRow("cl-1", time.Now())...))
+	mock.ExpectQuery("FROM cover_letters").
+		WithArgs("cl-1").
+		WillReturnError(pgx.ErrNoRows)
+	mock.ExpectQuery("FROM cover_letters").
+		WithArgs("cl-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewCoverLetterRepositoryWithPool(mock)
+	cl, err := repo.GetByID(context.Background(), "cl-1")
+	require.NoError(t, err)
+	assert.Nil(t, cl.ResumeBuilderID)
+	require.NotNil(t, cl.JobID)
+	assert.Equal(t, "job-1", *cl.JobID)
+	assert.Equal(t, []string{"First", "Second"}, cl.Paragraphs)
+
+	cl, err = repo.GetByID(context.Background(), "cl-1")
+	assert.Nil(t, cl)
+	assert.ErrorIs(t, err, model.ErrCoverLetterNotFound)
+
+	_, err = repo.GetByID(context.Background(), "cl-1")
+	assert.ErrorIs(t, err, assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCoverLetterRepository_List(t *testing.T) {
+	t.Run("returns the user's letters", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`FROM cover_letters WHERE user_id = \$1 ORDER BY updated_at DESC`).
+			WithArgs("user-1").
+			WillReturnRows(pgxmock.NewRows(coverLetterColumns).
+				AddRow(coverLetterRow("cl-2", now)...).
+				AddRow(coverLetterRow("cl-1", now)...))
+
+		letters, err := NewCoverLetterRepositoryWithPool(mock).List(context.Background(), "user-1")
+
+		require.NoError(t, err)
+		require.Len(t, letters, 2)
+		assert.Equal(t, "cl-2", letters[0].ID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("wraps query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM cover_letters").
+			WithArgs("user-1").
+			WillReturnError(assert.AnError)
+
+		letters, err := NewCoverLetterRepositoryWithPool(mock).List(context.Background(), "user-1")
+
+		assert.Nil(t, letters)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to list cover letters")
+	})
+}
+
+func TestCoverLetterRepository_Update(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	updatedAt := time.Now()
+	mock.ExpectQuery(`UPDATE cover_letters SET.*WHERE id = \$15 RETURNING updated_at`).
+		WithArgs(anyArgs(15)...).
+		WillReturnRows(pgxmock.NewRows([]string{"updated_at"}).AddRow(updatedAt))
+	mock.ExpectQuery("UPDATE cover_letters").
+		WithArgs(anyArgs(15)...).
+		WillReturnError(pgx.ErrNoRows)
+	mock.ExpectQuery("UPDATE cover_letters").
+		WithArgs(anyArgs(15)...).
+		WillReturnError(assert.AnError)
+
+	repo := NewCoverLetterRepositoryWithPool(mock)
+	cl, err := repo.Update(context.Background(), &model.CoverLetter{ID: "cl-1"})
+	require.NoError(t, err)
+	assert.Equal(t, updatedAt, cl.UpdatedAt)
+
+	_, err = repo.Update(context.Background(), &model.CoverLetter{ID: "cl-1"})
+	assert.ErrorIs(t, err, model.ErrCoverLetterNotFound)
+
+	_, err = repo.Update(context.Background(), &model.CoverLetter{ID: "cl-1"})
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.ErrorContains(t, err, "failed to update cover letter")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCoverLetterRepository_Delete(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`DELETE FROM cover_letters WHERE id = \$1`).
+			WithArgs("cl-1").
+			WillReturnResult(pgxmock.NewResult("DELETE", result))
+	}
+	mock.ExpectExec("DELETE FROM cover_letters").
+		WithArgs("cl-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewCoverLetterRepositoryWithPool(mock)
+	assert.NoError(t, repo.Delete(context.Background(), "cl-1"))
+	assert.ErrorIs(t, repo.Delete(context.Background(), "cl-1"), model.ErrCoverLetterNotFound)
+	assert.ErrorIs(t, repo.Delete(context.Background(), "cl-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
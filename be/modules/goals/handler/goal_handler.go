@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andreypavlenko/jobber/internal/platform/auth"
+	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/modules/goals/model"
+	"github.com/andreypavlenko/jobber/modules/goals/service"
+	"github.com/gin-gonic/gin"
+)
+
+// GoalHandler handles goal HTTP requests
+type GoalHandler struct {
+	service *service.GoalService
+}
+
+// NewGoalHandler creates a new goal handler
+func NewGoalHandler(service *service.GoalService) *GoalHandler {
+	return &GoalHandler{service: service}
+}
+
+// Create godoc
+// @Summary Create a goal
+// @Description Create a job search goal, e.g. 3 offers by a target date
+// @Tags goals
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body model.CreateGoalRequest true "Goal details"
+// @Success 201 {object} model.GoalDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /goals [post]
+func (h *GoalHandler) Create(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	var req model.CreateGoalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	goal, err := h.service.Create(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusCreated, goal)
+}
+
+// Get godoc
+// @Summary Get a goal
+// @Description Get details of a specific goal by ID
+// @Tags goals
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Goal ID"
+// @Success 200 {object} model.GoalDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Goal not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /goals/{id} [get]
+func (h *GoalHandler) Get(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	goal, err := h.service.GetByID(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, goal)
+}
+
+// List godoc
+// @Summary List goals
+// @Description Get all goals for the authenticated user
+// @Tags goals
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} model.GoalDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /goals [get]
+func (h *GoalHandler) List(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	goals, err := h.service.List(c.Request.Context(), userID)
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, goals)
+}
+
+// Update godoc
+// @Summary Update a goal
+// @Description Update a goal's target value or target date
+// @Tags goals
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Goal ID"
+// @Param request body model.UpdateGoalRequest true "Fields to update"
+// @Success 200 {object} model.GoalDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Goal not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /goals/{id} [patch]
+func (h *GoalHandler) Update(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	var req model.UpdateGoalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	goal, err := h.service.Update(c.Request.Context(), userID, c.Param("id"), &req)
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, goal)
+}
+
+// Delete godoc
+// @Summary Delete a goal
+// @Description Delete a specific goal by ID
+// @Tags goals
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Goal ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Goal not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /goals/{id} [delete]
+func (h *GoalHandler) Delete(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), userID, c.Param("id")); err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Goal deleted successfully"})
+}
+
+// Progress godoc
+// @Summary Get goal progress
+// @Description Get current progress towards a goal and whether the current pace reaches the target in time
+// @Tags goals
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Goal ID"
+// @Success 200 {object} model.GoalProgressDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Goal not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /goals/{id}/progress [get]
+func (h *GoalHandler) Progress(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	progress, err := h.service.GetProgress(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, progress)
+}
+
+// ListProgress godoc
+// @Summary Get progress for all goals
+// @Description Get progress, on-track status and projected completion date for every goal of the user
+// @Tags goals
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} model.GoalProgressSummaryDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /analytics/goals/progress [get]
+func (h *GoalHandler) ListProgress(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	progress, err := h.service.ListProgress(c.Request.Context(), userID)
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, progress)
+}
+
+func (h *GoalHandler) respondWithError(c *gin.Context, err error) {
+	errorCode := model.GetErrorCode(err)
+	statusCode := http.StatusInternalServerError
+	switch errorCode {
+	case model.CodeGoalNotFound:
+		statusCode = http.StatusNotFound
+	case model.CodeInvalidTargetDate:
+		statusCode = http.StatusBadRequest
+	}
+	httpPlatform.RespondWithError(c, statusCode, string(errorCode), model.GetErrorMessage(err, auth.GetLocale(c)))
+}
+
+// RegisterRoutes registers goal routes
+func (h *GoalHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	goals := router.Group("/goals")
+	goals.Use(authMiddleware)
+	{
+		goals.POST("", h.Create)
+		goals.GET("", h.List)
+		goals.GET("/:id", h.Get)
+		goals.PATCH("/:id", h.Update)
+		goals.DELETE("/:id", h.Delete)
+		goals.GET("/:id/progress", h.Progress)
+	}
+
+	analytics := router.Group("/analytics/goals")
+	analytics.Use(authMiddleware)
+	{
+		analytics.GET("/progress", h.ListProgress)
+	}
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/goals/model"
+	"github.com/andreypavlenko/jobber/modules/goals/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// MockGoalRepository implements ports.GoalRepository
+type MockGoalRepository struct {
+	GetByIDFunc       func(ctx context.Context, userID, goalID string) (*model.Goal, error)
+	ListFunc          func(ctx context.Context, userID string) ([]*model.Goal, error)
+	CountProgressFunc func(ctx context.Context, userID string, goalType model.GoalType, since time.Time) (int, error)
+}
+
+func (m *MockGoalRepository) Create(ctx context.Context, goal *model.Goal) error {
+	goal.ID = "goal-1"
+	return nil
+}
+
+func (m *MockGoalRepository) GetByID(ctx context.Context, userID, goalID string) (*model.Goal, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, userID, goalID)
+	}
+	return nil, model.ErrGoalNotFound
+}
+
+func (m *MockGoalRepository) List(ctx context.Context, userID string) ([]*model.Goal, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockGoalRepository) Update(ctx context.Context, goal *model.Goal) error {
+	return nil
+}
+
+func (m *MockGoalRepository) Delete(ctx context.Context, userID, goalID string) error {
+	return model.ErrGoalNotFound
+}
+
+func (m *MockGoalRepository) CountProgress(ctx context.Context, userID string, goalType model.GoalType, since time.Time) (int, error) {
+	if m.CountProgressFunc != nil {
+		return m.CountProgressFunc(ctx, userID, goalType, since)
+	}
+	return 0, nil
+}
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+func mockAuthMiddleware(userID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	}
+}
+
+func TestGoalHandler_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{"creates goal", `{"goal_type":"offers","target_value":3,"target_date":"2026-06-30"}`, http.StatusCreated},
+		{"rejects unknown goal type", `{"goal_type":"interviews","target_value":3,"target_date":"2026-06-30"}`, http.StatusBadRequest},
+		{"rejects non-positive target", `{"goal_type":"offers","target_value":0,"target_date":"2026-06-30"}`, http.StatusBadRequest},
+		{"rejects malformed date", `{"goal_type":"offers","target_value":3,"target_date":"June 30"}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewGoalHandler(service.NewGoalService(&MockGoalRepository{}))
+			router := setupTestRouter()
+			router.POST("/goals", mockAuthMiddleware("user-123"), handler.Create)
+
+			req, _ := http.NewRequest(http.MethodPost, "/goals", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestGoalHandler_Progress(t *testing.T) {
+	t.Run("returns progress", func(t *testing.T) {
+		repo := &MockGoalRepository{
+			GetByIDFunc: func(ctx context.Context, userID, goalID string) (*model.Goal, error) {
+				return &model.Goal{ID: goalID, GoalType: "offers", TargetValue: 4, TargetDate: time.Now().AddDate(0, 1, 0), CreatedAt: time.Now().AddDate(0, 0, -10)}, nil
+			},
+			CountProgressFunc: func(ctx context.Context, userID string, goalType model.GoalType, since time.Time) (int, error) {
+				return 1, nil
+			},
+		}
+		handler := NewGoalHandler(service.NewGoalService(repo))
+		router := setupTestRouter()
+		router.GET("/goals/:id/progress", mockAuthMiddleware("user-123"), handler.Progress)
+
+		req, _ := http.NewRequest(http.MethodGet, "/goals/goal-1/progress", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"pct_complete":25`)
+	})
+
+	t.Run("returns 404 when goal not found", func(t *testing.T) {
+		handler := NewGoalHandler(service.NewGoalService(&MockGoalRepository{}))
+		router := setupTestRouter()
+		router.GET("/goals/:id/progress", mockAuthMiddleware("user-123"), handler.Progress)
+
+		req, _ := http.NewRequest(http.MethodGet, "/goals/missing/progress", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("returns 401 without user", func(t *testing.T) {
+		handler := NewGoalHandler(service.NewGoalService(&MockGoalRepository{}))
+		router := setupTestRouter()
+		router.GET("/goals/:id/progress", handler.Progress)
+
+		req, _ := http.NewRequest(http.MethodGet, "/goals/goal-1/progress", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestGoalHandler_ListProgress(t *testing.T) {
+	t.Run("returns progress for every goal", func(t *testing.T) {
+		repo := &MockGoalRepository{
+			ListFunc: func(ctx context.Context, userID string) ([]*model.Goal, error) {
+				return []*model.Goal{
+					{ID: "goal-1", GoalType: "applications", TargetValue: 4, TargetDate: time.Now().AddDate(0, 1, 0), CreatedAt: time.Now().AddDate(0, 0, -10)},
+					{ID: "goal-2", GoalType: "offers", TargetValue: 2, TargetDate: time.Now().AddDate(0, 1, 0), CreatedAt: time.Now().AddDate(0, 0, -10)},
+				}, nil
+			},
+			CountProgressFunc: func(ctx context.Context, userID string, goalType model.GoalType, since time.Time) (int, error) {
+				if goalType == model.GoalTypeOffers {
+					return 0, nil
+				}
+				return 1, nil
+			},
+		}
+		handler := NewGoalHandler(service.NewGoalService(repo))
+		router := setupTestRouter()
+		handler.RegisterRoutes(router.Group("/api/v1"), mockAuthMiddleware("user-123"))
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/analytics/goals/progress", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var progress []model.GoalProgressSummaryDTO
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &progress))
+		assert.Len(t, progress, 2)
+		assert.Equal(t, "goal-1", progress[0].GoalID)
+		assert.Equal(t, 25.0, progress[0].PctComplete)
+		assert.NotNil(t, progress[0].ProjectedCompletionDate)
+		assert.Nil(t, progress[1].ProjectedCompletionDate)
+		assert.Contains(t, w.Body.String(), `"projected_completion_date":null`)
+	})
+
+	t.Run("returns 401 without user", func(t *testing.T) {
+		handler := NewGoalHandler(service.NewGoalService(&MockGoalRepository{}))
+		router := setupTestRouter()
+		router.GET("/analytics/goals/progress", handler.ListProgress)
+
+		req, _ := http.NewRequest(http.MethodGet, "/analytics/goals/progress", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestGoalHandler_Delete_NotFound(t *testing.T) {
+	handler := NewGoalHandler(service.NewGoalService(&MockGoalRepository{}))
+	router := setupTestRouter()
+	router.DELETE("/goals/:id", mockAuthMiddleware("user-123"), handler.Delete)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/goals/missing", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
+
+var (
+	// ErrGoalNotFound is returned when a goal is not found
+	ErrGoalNotFound = &DomainError{Code: CodeGoalNotFound, Message: "goal not found"}
+
+	// ErrInvalidTargetDate is returned when the target date is malformed
+	ErrInvalidTargetDate = &DomainError{Code: CodeInvalidTargetDate, Message: "invalid target date"}
+)
+
+// ErrorCode represents error codes
+type ErrorCode string
+
+const (
+	CodeGoalNotFound      ErrorCode = "GOAL_NOT_FOUND"
+	CodeInvalidTargetDate ErrorCode = "INVALID_TARGET_DATE"
+	CodeInternalError     ErrorCode = "INTERNAL_ERROR"
+)
+
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
+// GetErrorCode maps errors to error codes
+func GetErrorCode(err error) ErrorCode {
+	return domainerr.CodeOf(err, CodeInternalError)
+}
+
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
+}
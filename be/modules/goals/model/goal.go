@@ -0,0 +1,64 @@
+package model
+
+import "time"
+
+// GoalType represents what a goal counts
+type GoalType string
+
+const (
+	GoalTypeApplications GoalType = "applications" // Applications submitted
+	GoalTypeOffers       GoalType = "offers"       // Applications that reached an offer
+)
+
+// Goal represents a user's job search target, e.g. 3 offers by June 30
+type Goal struct {
+	ID          string
+	UserID      string
+	GoalType    string
+	TargetValue int
+	TargetDate  time.Time
+	CreatedAt   time.Time
+}
+
+// GoalDTO represents goal data transfer object
+type GoalDTO struct {
+	ID          string    `json:"id"`
+	GoalType    string    `json:"goal_type"`
+	TargetValue int       `json:"target_value"`
+	TargetDate  string    `json:"target_date"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ToDTO converts Goal to GoalDTO
+func (g *Goal) ToDTO() *GoalDTO {
+	return &GoalDTO{
+		ID:          g.ID,
+		GoalType:    g.GoalType,
+		TargetValue: g.TargetValue,
+		TargetDate:  g.TargetDate.Format(DateLayout),
+		CreatedAt:   g.CreatedAt,
+	}
+}
+
+// GoalProgressDTO represents progress towards a goal
+type GoalProgressDTO struct {
+	Target      int     `json:"target"`
+	Current     int     `json:"current"`
+	PctComplete float64 `json:"pct_complete"`
+	OnTrack     bool    `json:"on_track"`
+}
+
+// GoalProgressSummaryDTO represents progress towards one of the user's goals
+// in the bulk progress listing
+type GoalProgressSummaryDTO struct {
+	GoalID                  string  `json:"goal_id"`
+	GoalType                string  `json:"goal_type"`
+	TargetValue             int     `json:"target_value"`
+	CurrentValue            int     `json:"current_value"`
+	PctComplete             float64 `json:"pct_complete"`
+	OnTrack                 bool    `json:"on_track"`
+	ProjectedCompletionDate *string `json:"projected_completion_date"`
+}
+
+// DateLayout is the format of goal target dates
+const DateLayout = "2006-01-02"
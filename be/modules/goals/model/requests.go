@@ -0,0 +1,14 @@
+package model
+
+// CreateGoalRequest represents a create goal request
+type CreateGoalRequest struct {
+	GoalType    string `json:"goal_type" binding:"required,oneof=applications offers"`
+	TargetValue int    `json:"target_value" binding:"required,min=1,max=10000"`
+	TargetDate  string `json:"target_date" binding:"required,datetime=2006-01-02"`
+}
+
+// UpdateGoalRequest represents an update goal request
+type UpdateGoalRequest struct {
+	TargetValue *int    `json:"target_value,omitempty" binding:"omitempty,min=1,max=10000"`
+	TargetDate  *string `json:"target_date,omitempty" binding:"omitempty,datetime=2006-01-02"`
+}
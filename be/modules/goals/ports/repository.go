@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/goals/model"
+)
+
+// GoalRepository defines the interface for goal data access
+type GoalRepository interface {
+	Create(ctx context.Context, goal *model.Goal) error
+	GetByID(ctx context.Context, userID, goalID string) (*model.Goal, error)
+	List(ctx context.Context, userID string) ([]*model.Goal, error)
+	Update(ctx context.Context, goal *model.Goal) error
+	Delete(ctx context.Context, userID, goalID string) error
+	// CountProgress counts what the goal type measures since the given time
+	CountProgress(ctx context.Context, userID string, goalType model.GoalType, since time.Time) (int, error)
+}
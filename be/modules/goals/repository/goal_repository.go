@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/goals/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// GoalRepository implements ports.GoalRepository
+type GoalRepository struct {
+	pool DBPool
+}
+
+// NewGoalRepository creates a new goal repository
+func NewGoalRepository(pool *pgxpool.Pool) *GoalRepository {
+	return &GoalRepository{pool: pool}
+}
+
+// NewGoalRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewGoalRepositoryWithPool(pool DBPool) *GoalRepository {
+	return &GoalRepository{pool: pool}
+}
+
+// Create creates a new goal
+func (r *GoalRepository) Create(ctx context.Context, goal *model.Goal) error {
+	query := `
+		INSERT INTO goals (id, user_id, goal_type, target_value, target_date, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	goal.ID = uuid.New().String()
+	goal.CreatedAt = time.Now().UTC()
+
+	_, err := r.pool.Exec(ctx, query,
+		goal.ID, goal.UserID, goal.GoalType, goal.TargetValue, goal.TargetDate, goal.CreatedAt,
+	)
+	return err
+}
+
+// GetByID retrieves a goal by ID
+func (r *GoalRepository) GetByID(ctx context.Context, userID, goalID string) (*model.Goal, error) {
+	query := `
+		SELECT id, user_id, goal_type, target_value, target_date, created_at
+		FROM goals
+		WHERE id = $1 AND user_id = $2
+	`
+
+	goal := &model.Goal{}
+	err := r.pool.QueryRow(ctx, query, goalID, userID).Scan(
+		&goal.ID, &goal.UserID, &goal.GoalType, &goal.TargetValue, &goal.TargetDate, &goal.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrGoalNotFound
+		}
+		return nil, err
+	}
+	return goal, nil
+}
+
+// List retrieves all goals for a user, newest first
+func (r *GoalRepository) List(ctx context.Context, userID string) ([]*model.Goal, error) {
+	query := `
+		SELECT id, user_id, goal_type, target_value, target_date, created_at
+		FROM goals
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var goals []*model.Goal
+	for rows.Next() {
+		goal := &model.Goal{}
+		if err := rows.Scan(&goal.ID, &goal.UserID, &goal.GoalType, &goal.TargetValue, &goal.TargetDate, &goal.CreatedAt); err != nil {
+			return nil, err
+		}
+		goals = append(goals, goal)
+	}
+	return goals, rows.Err()
+}
+
+// Update updates a goal's target
+func (r *GoalRepository) Update(ctx context.Context, goal *model.Goal) error {
+	query := `
+		UPDATE goals SET target_value = $3, target_date = $4
+		WHERE id = $1 AND user_id = $2
+	`
+
+	result, err := r.pool.Exec(ctx, query, goal.ID, goal.UserID, goal.TargetValue, goal.TargetDate)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrGoalNotFound
+	}
+	return nil
+}
+
+// Delete deletes a goal
+func (r *GoalRepository) Delete(ctx context.Context, userID, goalID string) error {
+	query := `DELETE FROM goals WHERE id = $1 AND user_id = $2`
+	result, err := r.pool.Exec(ctx, query, goalID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrGoalNotFound
+	}
+	return nil
+}
+
+// CountProgress counts applications (or offers) made since the given time
+func (r *GoalRepository) CountProgress(ctx context.Context, userID string, goalType model.GoalType, since time.Time) (int, error) {
+	var query string
+	switch goalType {
+	case model.GoalTypeApplications:
+		query = `SELECT COUNT(*) FROM applications WHERE user_id = $1 AND deleted_at IS NULL AND applied_at >= $2`
+	case model.GoalTypeOffers:
+		query = `SELECT COUNT(*) FROM applications WHERE user_id = $1 AND deleted_at IS NULL AND status = 'offer' AND updated_at >= $2`
+	default:
+		return 0, fmt.Errorf("unsupported goal type: %s", goalType)
+	}
+
+	var count int
+	err := r.pool.QueryRow(ctx, query, userID, since).Scan(&count)
+	return count, err
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/goals/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var goalColumns = []string{"id", "user_id", "goal_type", "target_value", "target_date", "created_at"}
+
+func TestGoalRepository_Create(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	targetDate := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	mock.ExpectExec("INSERT INTO goals").
+		WithArgs(pgxmock.AnyArg(), "user-1", "applications", 50, targetDate, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	goal := &model.Goal{UserID: "user-1", GoalType: "applications", TargetValue: 50, TargetDate: targetDate}
+	err = NewGoalRepositoryWithPool(mock).Create(context.Background(), goal)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, goal.ID)
+	assert.False(t, goal.CreatedAt.IsZero())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGoalRepository_GetByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(q *pgxmock.ExpectedQuery)
+		wantErr error
+	}{
+		{
+			name: "returns the user's goal",
+			setup: func(q *pgxmock.ExpectedQuery) {
+				q.WillReturnRows(pgxmock.NewRows(goalColumns).AddRow("goal-1", "user-1", "offers", 2, time.Now(), time.Now()))
+			},
+		},
+		{
+			name:    "maps missing rows to ErrGoalNotFound",
+			setup:   func(q *pgxmock.ExpectedQuery) { q.WillReturnError(pgx.ErrNoRows) },
+			wantErr: model.ErrGoalNotFound,
+		},
+		{
+			name:    "propagates query errors",
+			setup:   func(q *pgxmock.ExpectedQuery) { q.WillReturnError(assert.AnError) },
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setup(mock.ExpectQuery(`FROM goals\s+WHERE id = \$1 AND user_id = \$2`).WithArgs("goal-1", "user-1"))
+
+			goal, err := NewGoalRepositoryWithPool(mock).GetByID(context.Background(), "user-1", "goal-1")
+
+			if tt.wantErr != nil {
+				assert.Nil(t, goal)
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "offers", goal.GoalType)
+				assert.Equal(t, 2, goal.TargetValue)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGoalRepository_List(t *testing.T) {
+	t.Run("returns goals newest first", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`FROM goals\s+WHERE user_id = \$1\s+ORDER BY created_at DESC`).
+			WithArgs("user-1").
+			WillReturnRows(pgxmock.NewRows(goalColumns).
+				AddRow("goal-2", "user-1", "offers", 1, now, now).
+				AddRow("goal-1", "user-1", "applications", 30, now, now.Add(-time.Hour)))
+
+		goals, err := NewGoalRepositoryWithPool(mock).List(context.Background(), "user-1")
+
+		require.NoError(t, err)
+		require.Len(t, goals, 2)
+		assert.Equal(t, "goal-2", goals[0].ID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM goals").
+			WithArgs("user-1").
+			WillReturnError(assert.AnError)
+
+		goals, err := NewGoalRepositoryWithPool(mock).List(context.Background(), "user-1")
+
+		assert.Nil(t, goals)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestGoalRepository_Update(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	targetDate := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	goal := &model.Goal{ID: "goal-1", UserID: "user-1", TargetValue: 10, TargetDate: targetDate}
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`UPDATE goals SET target_value = \$3, target_date = \$4\s+WHERE id = \$1 AND user_id = \$2`).
+			WithArgs("goal-1", "user-1", 10, targetDate).
+			WillReturnResult(pgxmock.NewResult("UPDATE", result))
+	}
+	mock.ExpectExec("UPDATE goals").
+		WithArgs("goal-1", "user-1", 10, targetDate).
+		WillReturnError(assert.AnError)
+
+	repo := NewGoalRepositoryWithPool(mock)
+	assert.NoError(t, repo.Update(context.Background(), goal))
+	assert.ErrorIs(t, repo.Update(context.Background(), goal), model.ErrGoalNotFound)
+	assert.ErrorIs(t, repo.Update(context.Background(), goal), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGoalRepository_Delete(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`DELETE FROM goals WHERE id = \$1 AND user_id = \$2`).
+			WithArgs("goal-1", "user-1").
+			WillReturnResult(pgxmock.NewResult("DELETE", result))
+	}
+	mock.ExpectExec("DELETE FROM goals").
+		WithArgs("goal-1", "user-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewGoalRepositoryWithPool(mock)
+	assert.NoError(t, repo.Delete(context.Background(), "user-1", "goal-1"))
+	assert.ErrorIs(t, repo.Delete(context.Background(), "user-1", "goal-1"), model.ErrGoalNotFound)
+	assert.ErrorIs(t, repo.Delete(context.Background(), "user-1", "goal-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGoalRepository_CountProgress(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		goalType model.GoalType
+		query    string
+	}{
+		{
+			name:     "applications count by applied_at",
+			goalType: model.GoalTypeApplications,
+			query:    `FROM applications WHERE user_id = \$1 AND deleted_at IS NULL AND applied_at >= \$2`,
+		},
+		{
+			name:     "offers count by the offer status",
+			goalType: model.GoalTypeOffers,
+			query:    `FROM applications WHERE user_id = \$1 AND deleted_at IS NULL AND status = 'offer' AND updated_at >= \$2`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			mock.ExpectQuery(tt.query).
+				WithArgs("user-1", since).
+				WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(7))
+
+			n, err := NewGoalRepositoryWithPool(mock).CountProgress(context.Background(), "user-1", tt.goalType, since)
+
+			require.NoError(t, err)
+			assert.Equal(t, 7, n)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	t.Run("rejects unsupported goal types", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		_, err = NewGoalRepositoryWithPool(mock).CountProgress(context.Background(), "user-1", "interviews", since)
+
+		assert.ErrorContains(t, err, "unsupported goal type: interviews")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
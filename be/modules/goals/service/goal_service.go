@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/goals/model"
+	"github.com/andreypavlenko/jobber/modules/goals/ports"
+)
+
+// GoalService handles goal business logic
+type GoalService struct {
+	repo ports.GoalRepository
+	now  func() time.Time
+}
+
+// NewGoalService creates a new goal service
+func NewGoalService(repo ports.GoalRepository) *GoalService {
+	return &GoalService{repo: repo, now: time.Now}
+}
+
+// Create creates a new goal
+func (s *GoalService) Create(ctx context.Context, userID string, req *model.CreateGoalRequest) (*model.GoalDTO, error) {
+	targetDate, err := parseTargetDate(req.TargetDate)
+	if err != nil {
+		return nil, err
+	}
+
+	goal := &model.Goal{
+		UserID:      userID,
+		GoalType:    req.GoalType,
+		TargetValue: req.TargetValue,
+		TargetDate:  targetDate,
+	}
+	if err := s.repo.Create(ctx, goal); err != nil {
+		return nil, err
+	}
+	return goal.ToDTO(), nil
+}
+
+// GetByID retrieves a goal by ID
+func (s *GoalService) GetByID(ctx context.Context, userID, goalID string) (*model.GoalDTO, error) {
+	goal, err := s.repo.GetByID(ctx, userID, goalID)
+	if err != nil {
+		return nil, err
+	}
+	return goal.ToDTO(), nil
+}
+
+// List lists all goals for a user
+func (s *GoalService) List(ctx context.Context, userID string) ([]*model.GoalDTO, error) {
+	goals, err := s.repo.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*model.GoalDTO, 0, len(goals))
+	for _, goal := range goals {
+		dtos = append(dtos, goal.ToDTO())
+	}
+	return dtos, nil
+}
+
+// Update updates a goal's target value or date
+func (s *GoalService) Update(ctx context.Context, userID, goalID string, req *model.UpdateGoalRequest) (*model.GoalDTO, error) {
+	goal, err := s.repo.GetByID(ctx, userID, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TargetValue != nil {
+		goal.TargetValue = *req.TargetValue
+	}
+	if req.TargetDate != nil {
+		targetDate, err := parseTargetDate(*req.TargetDate)
+		if err != nil {
+			return nil, err
+		}
+		goal.TargetDate = targetDate
+	}
+
+	if err := s.repo.Update(ctx, goal); err != nil {
+		return nil, err
+	}
+	return goal.ToDTO(), nil
+}
+
+// Delete deletes a goal
+func (s *GoalService) Delete(ctx context.Context, userID, goalID string) error {
+	return s.repo.Delete(ctx, userID, goalID)
+}
+
+// GetProgress returns how far the user is towards a goal
+func (s *GoalService) GetProgress(ctx context.Context, userID, goalID string) (*model.GoalProgressDTO, error) {
+	goal, err := s.repo.GetByID(ctx, userID, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.CountProgress(ctx, userID, model.GoalType(goal.GoalType), goal.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return CalculateProgress(goal, current, s.now()), nil
+}
+
+// ListProgress returns progress towards every goal of the user. Goals are
+// counted concurrently; the first counting error fails the whole request.
+func (s *GoalService) ListProgress(ctx context.Context, userID string) ([]*model.GoalProgressSummaryDTO, error) {
+	goals, err := s.repo.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	summaries := make([]*model.GoalProgressSummaryDTO, len(goals))
+	errs := make([]error, len(goals))
+
+	var wg sync.WaitGroup
+	for i, goal := range goals {
+		wg.Add(1)
+		go func(i int, goal *model.Goal) {
+			defer wg.Done()
+			current, err := s.repo.CountProgress(ctx, userID, model.GoalType(goal.GoalType), goal.CreatedAt)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			summaries[i] = summarizeProgress(goal, current, now)
+		}(i, goal)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return summaries, nil
+}
+
+func summarizeProgress(goal *model.Goal, current int, now time.Time) *model.GoalProgressSummaryDTO {
+	progress := CalculateProgress(goal, current, now)
+	summary := &model.GoalProgressSummaryDTO{
+		GoalID:       goal.ID,
+		GoalType:     goal.GoalType,
+		TargetValue:  goal.TargetValue,
+		CurrentValue: current,
+		PctComplete:  progress.PctComplete,
+		OnTrack:      progress.OnTrack,
+	}
+	if projected, ok := ProjectCompletion(goal, current, now); ok {
+		date := projected.Format(model.DateLayout)
+		summary.ProjectedCompletionDate = &date
+	}
+	return summary
+}
+
+// ProjectCompletion estimates the date the goal is reached at the current pace.
+// The span from creation to the end of the target date is scaled by the velocity
+// ratio (share of target reached over share of time elapsed), so a goal that is
+// 50% complete at 50% of its time is projected to finish on its target date.
+// There is no projection without progress.
+func ProjectCompletion(goal *model.Goal, current int, now time.Time) (time.Time, bool) {
+	if current <= 0 || goal.TargetValue <= 0 {
+		return time.Time{}, false
+	}
+
+	elapsed := now.Sub(goal.CreatedAt)
+	if elapsed <= 0 {
+		return time.Time{}, false
+	}
+
+	// span / velocity simplifies to elapsed * target / current
+	projected := goal.CreatedAt.Add(time.Duration(float64(elapsed) * float64(goal.TargetValue) / float64(current)))
+
+	// The deadline is midnight after the target date, so step back into the day it ends
+	return projected.Add(-time.Nanosecond), true
+}
+
+// CalculateProgress computes progress towards a goal at the given time.
+// A goal is on track when the current pace (progress per elapsed day since the
+// goal was created), kept up until the end of the target date, reaches the target.
+func CalculateProgress(goal *model.Goal, current int, now time.Time) *model.GoalProgressDTO {
+	progress := &model.GoalProgressDTO{
+		Target:  goal.TargetValue,
+		Current: current,
+	}
+	if goal.TargetValue > 0 {
+		pct := float64(current) / float64(goal.TargetValue) * 100
+		progress.PctComplete = math.Round(math.Min(pct, 100)*100) / 100
+	}
+
+	if current >= goal.TargetValue {
+		progress.OnTrack = true
+		return progress
+	}
+
+	// Count at least one elapsed day so a goal created today has a defined pace
+	elapsedDays := math.Max(now.Sub(goal.CreatedAt).Hours()/24, 1)
+	deadline := goal.TargetDate.AddDate(0, 0, 1)
+	remainingDays := math.Max(deadline.Sub(now).Hours()/24, 0)
+
+	projected := float64(current) + float64(current)/elapsedDays*remainingDays
+	progress.OnTrack = projected >= float64(goal.TargetValue)
+	return progress
+}
+
+func parseTargetDate(value string) (time.Time, error) {
+	targetDate, err := time.Parse(model.DateLayout, value)
+	if err != nil {
+		return time.Time{}, model.ErrInvalidTargetDate
+	}
+	return targetDate, nil
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/goals/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockGoalRepository implements ports.GoalRepository
+type MockGoalRepository struct {
+	CreateFunc        func(ctx context.Context, goal *model.Goal) error
+	GetByIDFunc       func(ctx context.Context, userID, goalID string) (*model.Goal, error)
+	ListFunc          func(ctx context.Context, userID string) ([]*model.Goal, error)
+	UpdateFunc        func(ctx context.Context, goal *model.Goal) error
+	DeleteFunc        func(ctx context.Context, userID, goalID string) error
+	CountProgressFunc func(ctx context.Context, userID string, goalType model.GoalType, since time.Time) (int, error)
+}
+
+func (m *MockGoalRepository) Create(ctx context.Context, goal *model.Goal) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, goal)
+	}
+	return nil
+}
+
+func (m *MockGoalRepository) GetByID(ctx context.Context, userID, goalID string) (*model.Goal, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, userID, goalID)
+	}
+	return nil, model.ErrGoalNotFound
+}
+
+func (m *MockGoalRepository) List(ctx context.Context, userID string) ([]*model.Goal, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockGoalRepository) Update(ctx context.Context, goal *model.Goal) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, goal)
+	}
+	return nil
+}
+
+func (m *MockGoalRepository) Delete(ctx context.Context, userID, goalID string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID, goalID)
+	}
+	return nil
+}
+
+func (m *MockGoalRepository) CountProgress(ctx context.Context, userID string, goalType model.GoalType, since time.Time) (int, error) {
+	if m.CountProgressFunc != nil {
+		return m.CountProgressFunc(ctx, userID, goalType, since)
+	}
+	return 0, nil
+}
+
+func date(value string) time.Time {
+	t, _ := time.Parse(model.DateLayout, value)
+	return t
+}
+
+func TestCalculateProgress(t *testing.T) {
+	// Goal created June 1, due end of June 30 (30 days in total)
+	goal := &model.Goal{
+		GoalType:    string(model.GoalTypeOffers),
+		TargetValue: 3,
+		TargetDate:  date("2026-06-30"),
+		CreatedAt:   date("2026-06-01"),
+	}
+
+	tests := []struct {
+		name        string
+		current     int
+		now         time.Time
+		wantPct     float64
+		wantOnTrack bool
+	}{
+		{
+			name:        "on track when pace projects past target",
+			current:     2,
+			now:         date("2026-06-11"), // 10 days elapsed, 20 remaining: 2 + 0.2*20 = 6
+			wantPct:     66.67,
+			wantOnTrack: true,
+		},
+		{
+			name:        "behind when pace falls short",
+			current:     1,
+			now:         date("2026-06-21"), // 20 days elapsed, 10 remaining: 1 + 0.05*10 = 1.5
+			wantPct:     33.33,
+			wantOnTrack: false,
+		},
+		{
+			name:        "exactly on pace counts as on track",
+			current:     1,
+			now:         date("2026-06-11"), // 10 days elapsed, 20 remaining: 1 + 0.1*20 = 3
+			wantPct:     33.33,
+			wantOnTrack: true,
+		},
+		{
+			name:        "no progress is never on track",
+			current:     0,
+			now:         date("2026-06-02"),
+			wantPct:     0,
+			wantOnTrack: false,
+		},
+		{
+			name:        "target reached is on track",
+			current:     3,
+			now:         date("2026-06-15"),
+			wantPct:     100,
+			wantOnTrack: true,
+		},
+		{
+			name:        "exceeding target caps percentage",
+			current:     5,
+			now:         date("2026-06-15"),
+			wantPct:     100,
+			wantOnTrack: true,
+		},
+		{
+			name:        "past deadline without reaching target",
+			current:     2,
+			now:         date("2026-07-05"),
+			wantPct:     66.67,
+			wantOnTrack: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress := CalculateProgress(goal, tt.current, tt.now)
+
+			assert.Equal(t, 3, progress.Target)
+			assert.Equal(t, tt.current, progress.Current)
+			assert.Equal(t, tt.wantPct, progress.PctComplete)
+			assert.Equal(t, tt.wantOnTrack, progress.OnTrack)
+		})
+	}
+}
+
+func TestProjectCompletion(t *testing.T) {
+	// Goal created June 1, due end of June 30 (30 days in total)
+	goal := &model.Goal{
+		GoalType:    string(model.GoalTypeApplications),
+		TargetValue: 4,
+		TargetDate:  date("2026-06-30"),
+		CreatedAt:   date("2026-06-01"),
+	}
+
+	t.Run("half done at half time finishes on the target date", func(t *testing.T) {
+		now := date("2026-06-16") // 15 of 30 days elapsed
+
+		projected, ok := ProjectCompletion(goal, 2, now)
+		summary := summarizeProgress(goal, 2, now)
+
+		require.True(t, ok)
+		assert.Equal(t, "2026-06-30", projected.Format(model.DateLayout))
+		assert.True(t, summary.OnTrack)
+		assert.Equal(t, float64(50), summary.PctComplete)
+		require.NotNil(t, summary.ProjectedCompletionDate)
+		assert.Equal(t, "2026-06-30", *summary.ProjectedCompletionDate)
+	})
+
+	tests := []struct {
+		name    string
+		current int
+		now     time.Time
+		want    string
+	}{
+		{"ahead of pace finishes early", 2, date("2026-06-06"), "2026-06-10"},            // 5 days per 2 → 10 days for 4
+		{"behind pace finishes late", 1, date("2026-06-16"), "2026-07-30"},               // 15 days per 1 → 60 days for 4
+		{"already complete projects into the past", 4, date("2026-06-21"), "2026-06-20"}, // done within 20 days
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projected, ok := ProjectCompletion(goal, tt.current, tt.now)
+
+			require.True(t, ok)
+			assert.Equal(t, tt.want, projected.Format(model.DateLayout))
+		})
+	}
+
+	t.Run("no projection without progress", func(t *testing.T) {
+		_, ok := ProjectCompletion(goal, 0, date("2026-06-16"))
+		summary := summarizeProgress(goal, 0, date("2026-06-16"))
+
+		assert.False(t, ok)
+		assert.Nil(t, summary.ProjectedCompletionDate)
+	})
+
+	t.Run("no projection before any time has elapsed", func(t *testing.T) {
+		_, ok := ProjectCompletion(goal, 1, goal.CreatedAt)
+
+		assert.False(t, ok)
+	})
+}
+
+func TestGoalService_ListProgress(t *testing.T) {
+	goals := []*model.Goal{
+		{ID: "goal-1", GoalType: "applications", TargetValue: 4, TargetDate: date("2026-06-30"), CreatedAt: date("2026-06-01")},
+		{ID: "goal-2", GoalType: "offers", TargetValue: 2, TargetDate: date("2026-06-30"), CreatedAt: date("2026-06-01")},
+		{ID: "goal-3", GoalType: "offers", TargetValue: 1, TargetDate: date("2026-07-31"), CreatedAt: date("2026-06-10")},
+	}
+	counts := map[string]int{"goal-1": 2, "goal-2": 0, "goal-3": 1}
+
+	newRepo := func() *MockGoalRepository {
+		return &MockGoalRepository{
+			ListFunc: func(ctx context.Context, userID string) ([]*model.Goal, error) {
+				return goals, nil
+			},
+			CountProgressFunc: func(ctx context.Context, userID string, goalType model.GoalType, since time.Time) (int, error) {
+				for _, g := range goals {
+					if g.CreatedAt.Equal(since) && model.GoalType(g.GoalType) == goalType {
+						return counts[g.ID], nil
+					}
+				}
+				return 0, errors.New("unexpected goal")
+			},
+		}
+	}
+
+	t.Run("resolves every goal in order", func(t *testing.T) {
+		svc := NewGoalService(newRepo())
+		svc.now = func() time.Time { return date("2026-06-16") }
+
+		progress, err := svc.ListProgress(context.Background(), "user-1")
+
+		require.NoError(t, err)
+		require.Len(t, progress, 3)
+		assert.Equal(t, "goal-1", progress[0].GoalID)
+		assert.Equal(t, 2, progress[0].CurrentValue)
+		assert.True(t, progress[0].OnTrack)
+		assert.Equal(t, "2026-06-30", *progress[0].ProjectedCompletionDate)
+
+		assert.Equal(t, "goal-2", progress[1].GoalID)
+		assert.Equal(t, "offers", progress[1].GoalType)
+		assert.Equal(t, 0, progress[1].CurrentValue)
+		assert.False(t, progress[1].OnTrack)
+		assert.Nil(t, progress[1].ProjectedCompletionDate)
+
+		assert.Equal(t, "goal-3", progress[2].GoalID)
+		assert.Equal(t, 100.0, progress[2].PctComplete)
+	})
+
+	t.Run("returns an empty list without goals", func(t *testing.T) {
+		repo := newRepo()
+		repo.ListFunc = func(ctx context.Context, userID string) ([]*model.Goal, error) {
+			return nil, nil
+		}
+		svc := NewGoalService(repo)
+
+		progress, err := svc.ListProgress(context.Background(), "user-1")
+
+		require.NoError(t, err)
+		assert.NotNil(t, progress)
+		assert.Empty(t, progress)
+	})
+
+	t.Run("fails when a goal cannot be counted", func(t *testing.T) {
+		repo := newRepo()
+		repo.CountProgressFunc = func(ctx context.Context, userID string, goalType model.GoalType, since time.Time) (int, error) {
+			if goalType == model.GoalTypeOffers {
+				return 0, errors.New("db down")
+			}
+			return 1, nil
+		}
+		svc := NewGoalService(repo)
+
+		progress, err := svc.ListProgress(context.Background(), "user-1")
+
+		assert.Nil(t, progress)
+		assert.Error(t, err)
+	})
+}
+
+func TestGoalService_GetProgress(t *testing.T) {
+	createdAt := date("2026-06-01")
+
+	t.Run("counts progress since goal creation", func(t *testing.T) {
+		var gotType model.GoalType
+		var gotSince time.Time
+		repo := &MockGoalRepository{
+			GetByIDFunc: func(ctx context.Context, userID, goalID string) (*model.Goal, error) {
+				return &model.Goal{ID: goalID, UserID: userID, GoalType: "offers", TargetValue: 3, TargetDate: date("2026-06-30"), CreatedAt: createdAt}, nil
+			},
+			CountProgressFunc: func(ctx context.Context, userID string, goalType model.GoalType, since time.Time) (int, error) {
+				gotType = goalType
+				gotSince = since
+				return 2, nil
+			},
+		}
+		svc := NewGoalService(repo)
+		svc.now = func() time.Time { return date("2026-06-11") }
+
+		progress, err := svc.GetProgress(context.Background(), "user-1", "goal-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, model.GoalTypeOffers, gotType)
+		assert.Equal(t, createdAt, gotSince)
+		assert.Equal(t, 2, progress.Current)
+		assert.True(t, progress.OnTrack)
+	})
+
+	t.Run("returns not found for missing goal", func(t *testing.T) {
+		svc := NewGoalService(&MockGoalRepository{})
+
+		_, err := svc.GetProgress(context.Background(), "user-1", "missing")
+
+		assert.ErrorIs(t, err, model.ErrGoalNotFound)
+	})
+}
+
+func TestGoalService_Create(t *testing.T) {
+	t.Run("parses target date", func(t *testing.T) {
+		svc := NewGoalService(&MockGoalRepository{})
+
+		goal, err := svc.Create(context.Background(), "user-1", &model.CreateGoalRequest{
+			GoalType: "offers", TargetValue: 3, TargetDate: "2026-06-30",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "2026-06-30", goal.TargetDate)
+	})
+
+	t.Run("rejects malformed target date", func(t *testing.T) {
+		svc := NewGoalService(&MockGoalRepository{})
+
+		_, err := svc.Create(context.Background(), "user-1", &model.CreateGoalRequest{
+			GoalType: "offers", TargetValue: 3, TargetDate: "30/06/2026",
+		})
+
+		assert.ErrorIs(t, err, model.ErrInvalidTargetDate)
+	})
+}
@@ -50,7 +50,7 @@ func (h *ImportHandler) ParseJobPage(c *gin.Context) {
 	result, err := h.service.ParseJobPage(c.Request.Context(), userID, &req)
 	if err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusInternalServerError
 		if errors.Is(err, model.ErrAINotConfigured) {
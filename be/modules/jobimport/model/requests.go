@@ -3,6 +3,8 @@ package model
 import (
 	"errors"
 
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
 	subModel "github.com/andreypavlenko/jobber/modules/subscriptions/model"
 )
 
@@ -23,8 +25,8 @@ type ParseJobResponse struct {
 
 // Errors
 var (
-	ErrAINotConfigured = errors.New("AI parsing is not configured")
-	ErrParsingFailed   = errors.New("failed to parse job page")
+	ErrAINotConfigured = &DomainError{Code: CodeAINotConfigured, Message: "AI parsing is not configured"}
+	ErrParsingFailed   = &DomainError{Code: CodeParsingFailed, Message: "failed to parse job page"}
 )
 
 // ErrorCode represents a domain error code.
@@ -36,30 +38,18 @@ const (
 	CodeValidationError ErrorCode = "VALIDATION_ERROR"
 )
 
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
 // GetErrorCode maps domain errors to error codes.
 func GetErrorCode(err error) ErrorCode {
-	switch {
-	case errors.Is(err, subModel.ErrLimitReached):
+	if errors.Is(err, subModel.ErrLimitReached) {
 		return "PLAN_LIMIT_REACHED"
-	case errors.Is(err, ErrAINotConfigured):
-		return CodeAINotConfigured
-	case errors.Is(err, ErrParsingFailed):
-		return CodeParsingFailed
-	default:
-		return "INTERNAL_ERROR"
 	}
+	return domainerr.CodeOf(err, ErrorCode("INTERNAL_ERROR"))
 }
 
-// GetErrorMessage returns a user-friendly error message.
-func GetErrorMessage(err error) string {
-	switch {
-	case errors.Is(err, subModel.ErrLimitReached):
-		return "You have reached the job parsing limit for your current plan."
-	case errors.Is(err, ErrAINotConfigured):
-		return "AI job parsing is not available. Please contact support."
-	case errors.Is(err, ErrParsingFailed):
-		return "Failed to parse the job page. Please try again."
-	default:
-		return "An unexpected error occurred"
-	}
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
 }
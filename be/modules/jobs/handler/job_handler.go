@@ -3,15 +3,23 @@ package handler
 import (
 	"errors"
 	"net/http"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/andreypavlenko/jobber/internal/platform/auth"
 	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	"github.com/andreypavlenko/jobber/modules/jobs/model"
+	"github.com/andreypavlenko/jobber/modules/jobs/ports"
 	"github.com/andreypavlenko/jobber/modules/jobs/service"
 	subModel "github.com/andreypavlenko/jobber/modules/subscriptions/model"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
+// maxListQueryLength caps the q search term of the job list
+const maxListQueryLength = 200
+
 // JobHandler handles job HTTP requests
 type JobHandler struct {
 	service *service.JobService
@@ -56,10 +64,12 @@ func (h *JobHandler) Create(c *gin.Context) {
 		}
 
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusInternalServerError
-		if errorCode == model.CodeJobTitleRequired {
+		if errorCode == model.CodeJobTitleRequired || errorCode == model.CodeInvalidJobURL || errorCode == model.CodeInvalidJobPriority ||
+			errorCode == model.CodeInvalidSalaryRange || errorCode == model.CodeInvalidSalaryCurrency ||
+			errorCode == model.CodeInvalidEmploymentType || errorCode == model.CodeInvalidWorkArrangement {
 			statusCode = http.StatusBadRequest
 		} else if errorCode == model.CodeCompanyNotFound {
 			statusCode = http.StatusNotFound
@@ -79,7 +89,9 @@ func (h *JobHandler) Create(c *gin.Context) {
 // @Security BearerAuth
 // @Produce json
 // @Param id path string true "Job ID"
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {object} model.JobDTO
+// @Success 304 "Not Modified"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 404 {object} httpPlatform.ErrorResponse "Job not found"
 // @Failure 500 {object} httpPlatform.ErrorResponse
@@ -96,7 +108,7 @@ func (h *JobHandler) Get(c *gin.Context) {
 	job, err := h.service.GetByID(c.Request.Context(), userID, jobID)
 	if err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusInternalServerError
 		if errorCode == model.CodeJobNotFound {
@@ -107,9 +119,52 @@ func (h *JobHandler) Get(c *gin.Context) {
 		return
 	}
 
+	etag := httpPlatform.ComputeETag(job)
+	httpPlatform.SetETag(c, etag)
+	if httpPlatform.CheckIfNoneMatch(c, etag) {
+		return
+	}
 	httpPlatform.RespondWithData(c, http.StatusOK, job)
 }
 
+// History godoc
+// @Summary Get job status history
+// @Description Get the status transitions of a job, newest first
+// @Tags jobs
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Job ID"
+// @Success 200 {array} model.JobStatusHistoryDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Job not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /jobs/{id}/history [get]
+func (h *JobHandler) History(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	jobID := c.Param("id")
+
+	history, err := h.service.ListStatusHistory(c.Request.Context(), userID, jobID)
+	if err != nil {
+		errorCode := model.GetErrorCode(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
+
+		statusCode := http.StatusInternalServerError
+		if errorCode == model.CodeJobNotFound {
+			statusCode = http.StatusNotFound
+		}
+
+		httpPlatform.RespondWithError(c, statusCode, string(errorCode), errorMessage)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, history)
+}
+
 // List godoc
 // @Summary List jobs
 // @Description Get a paginated list of job postings for the authenticated user with filtering and sorting
@@ -119,9 +174,14 @@ func (h *JobHandler) Get(c *gin.Context) {
 // @Param limit query int false "Number of items per page (default: 20, max: 100)"
 // @Param offset query int false "Number of items to skip (default: 0)"
 // @Param status query string false "Filter by status: active, archived, all (default: active)"
-// @Param sort query string false "Sort format: field:order (e.g., created_at:desc, title:asc, company_name:asc)"
+// @Param q query string false "Case-insensitive text matched anywhere in the title or notes (at most 200 characters)"
+// @Param company_id query string false "Filter by company ID"
+// @Param employment_type query string false "Filter by employment type: full_time, part_time, contract, internship, freelance"
+// @Param work_arrangement query string false "Filter by work arrangement: remote, onsite, hybrid"
+// @Param sort query string false "Sort format: field:order (e.g., created_at:desc, title:asc, company_name:asc, priority:desc)"
+// @Param cursor query string false "Opaque cursor from pagination.next_cursor; pass it empty to start cursor pagination. Replaces offset; only for the created_at and title sorts"
 // @Success 200 {object} httpPlatform.PaginatedResponse{items=[]model.JobDTO}
-// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid pagination parameters"
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid pagination parameters, cursor, status, query, company ID, employment type or work arrangement"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 500 {object} httpPlatform.ErrorResponse
 // @Router /jobs [get]
@@ -149,10 +209,33 @@ func (h *JobHandler) List(c *gin.Context) {
 		return
 	}
 
+	query := strings.TrimSpace(c.Query("q"))
+	if utf8.RuneCountInString(query) > maxListQueryLength {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_QUERY", "Search query must be at most 200 characters")
+		return
+	}
+	companyID := c.Query("company_id")
+	if companyID != "" {
+		if _, err := uuid.Parse(companyID); err != nil {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, "INVALID_COMPANY_ID", "Invalid company ID")
+			return
+		}
+	}
+	employmentType := c.Query("employment_type")
+	if employmentType != "" && !model.IsValidEmploymentType(employmentType) {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeInvalidEmploymentType), model.GetErrorMessage(model.ErrInvalidEmploymentType, auth.GetLocale(c)))
+		return
+	}
+	workArrangement := c.Query("work_arrangement")
+	if workArrangement != "" && !model.IsValidWorkArrangement(workArrangement) {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeInvalidWorkArrangement), model.GetErrorMessage(model.ErrInvalidWorkArrangement, auth.GetLocale(c)))
+		return
+	}
+
 	// Parse and validate sort parameters
 	sortParam := c.Query("sort")
 	var sortBy, sortOrder string
-	allowedSortFields := map[string]bool{"created_at": true, "title": true, "company_name": true}
+	allowedSortFields := map[string]bool{"created_at": true, "title": true, "company_name": true, "priority": true}
 	allowedSortOrders := map[string]bool{"asc": true, "desc": true}
 	if sortParam != "" {
 		// Parse format: "field:order" (e.g., "created_at:desc")
@@ -166,15 +249,68 @@ func (h *JobHandler) List(c *gin.Context) {
 		}
 	}
 
-	jobs, total, err := h.service.List(c.Request.Context(), userID, pagination.Limit, pagination.Offset, status, sortBy, sortOrder)
+	opts := &ports.ListOptions{
+		Limit:           pagination.Limit,
+		Offset:          pagination.Offset,
+		Status:          status,
+		Query:           query,
+		CompanyID:       companyID,
+		EmploymentType:  employmentType,
+		WorkArrangement: workArrangement,
+		SortBy:          sortBy,
+		SortOrder:       sortOrder,
+	}
+	if pagination.Cursor != nil {
+		// Fetch one extra row to tell whether another page follows
+		opts.Cursor = pagination.Cursor
+		opts.Limit++
+	}
+
+	jobs, total, err := h.service.List(c.Request.Context(), userID, opts)
 	if err != nil {
+		if httpPlatform.RespondWithCursorError(c, err) {
+			return
+		}
 		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list jobs")
 		return
 	}
 
+	if pagination.Cursor != nil {
+		page, next := keyset.Trim(jobs, pagination.Limit, func(job *model.JobDTO) keyset.Cursor {
+			return job.Cursor(sortBy)
+		})
+		httpPlatform.RespondWithCursorPagination(c, http.StatusOK, page, pagination.Limit, total, next)
+		return
+	}
 	httpPlatform.RespondWithPagination(c, http.StatusOK, jobs, pagination.Limit, pagination.Offset, total)
 }
 
+// ListHighPriority godoc
+// @Summary List high-priority jobs
+// @Description Get the authenticated user's high-priority jobs, newest first (at most 50, not paginated)
+// @Tags jobs
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} model.JobDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /jobs/high-priority [get]
+func (h *JobHandler) ListHighPriority(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	jobs, err := h.service.ListHighPriority(c.Request.Context(), userID)
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list jobs")
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, jobs)
+}
+
 // splitSort splits a sort parameter like "created_at:desc" into [field, order]
 func splitSort(sort string) []string {
 	for i := 0; i < len(sort); i++ {
@@ -218,12 +354,14 @@ func (h *JobHandler) Update(c *gin.Context) {
 	job, err := h.service.Update(c.Request.Context(), userID, jobID, &req)
 	if err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusInternalServerError
 		if errorCode == model.CodeJobNotFound || errorCode == model.CodeCompanyNotFound {
 			statusCode = http.StatusNotFound
-		} else if errorCode == model.CodeJobTitleRequired || errorCode == model.CodeInvalidJobStatus {
+		} else if errorCode == model.CodeJobTitleRequired || errorCode == model.CodeInvalidJobStatus || errorCode == model.CodeInvalidJobURL || errorCode == model.CodeInvalidJobPriority ||
+			errorCode == model.CodeInvalidSalaryRange || errorCode == model.CodeInvalidSalaryCurrency ||
+			errorCode == model.CodeInvalidEmploymentType || errorCode == model.CodeInvalidWorkArrangement {
 			statusCode = http.StatusBadRequest
 		}
 
@@ -234,6 +372,53 @@ func (h *JobHandler) Update(c *gin.Context) {
 	httpPlatform.RespondWithData(c, http.StatusOK, job)
 }
 
+// UpdateCompany godoc
+// @Summary Reassign a job to another company
+// @Description Move a job to another of the user's companies and note the change on its applications
+// @Tags jobs
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Job ID"
+// @Param request body model.UpdateJobCompanyRequest true "New company"
+// @Success 200 {object} model.JobDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Job or company not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /jobs/{id}/company [patch]
+func (h *JobHandler) UpdateCompany(c *gin.Context) {
+	userID, exists := auth.GetUserID(c)
+	if !exists {
+		httpPlatform.RespondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		return
+	}
+
+	jobID := c.Param("id")
+
+	var req model.UpdateJobCompanyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	job, err := h.service.UpdateCompany(c.Request.Context(), userID, jobID, req.CompanyID)
+	if err != nil {
+		errorCode := model.GetErrorCode(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
+
+		statusCode := http.StatusInternalServerError
+		if errorCode == model.CodeJobNotFound || errorCode == model.CodeCompanyNotFound {
+			statusCode = http.StatusNotFound
+		}
+
+		httpPlatform.RespondWithError(c, statusCode, string(errorCode), errorMessage)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, job)
+}
+
 // Delete godoc
 // @Summary Delete a job
 // @Description Delete a specific job posting by ID
@@ -257,7 +442,7 @@ func (h *JobHandler) Delete(c *gin.Context) {
 
 	if err := h.service.Delete(c.Request.Context(), userID, jobID); err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusInternalServerError
 		if errorCode == model.CodeJobNotFound {
@@ -295,7 +480,7 @@ func (h *JobHandler) ToggleFavorite(c *gin.Context) {
 	isFavorite, err := h.service.ToggleFavorite(c.Request.Context(), userID, jobID)
 	if err != nil {
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 		statusCode := http.StatusInternalServerError
 		if errorCode == model.CodeJobNotFound {
@@ -316,8 +501,11 @@ func (h *JobHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.
 	{
 		jobs.POST("", h.Create)
 		jobs.GET("", h.List)
+		jobs.GET("/high-priority", h.ListHighPriority)
 		jobs.GET("/:id", h.Get)
+		jobs.GET("/:id/history", h.History)
 		jobs.PATCH("/:id", h.Update)
+		jobs.PATCH("/:id/company", h.UpdateCompany)
 		jobs.DELETE("/:id", h.Delete)
 		jobs.POST("/:id/favorite", h.ToggleFavorite)
 	}
@@ -4,14 +4,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	companyModel "github.com/andreypavlenko/jobber/modules/companies/model"
 	companyPorts "github.com/andreypavlenko/jobber/modules/companies/ports"
 	"github.com/andreypavlenko/jobber/modules/jobs/model"
+	"github.com/andreypavlenko/jobber/modules/jobs/ports"
 	"github.com/andreypavlenko/jobber/modules/jobs/service"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -19,12 +23,17 @@ import (
 )
 
 // MockCompanyRepository implements companyPorts.CompanyRepository for handler tests
-type MockCompanyRepository struct{}
+type MockCompanyRepository struct {
+	GetByIDFunc func(ctx context.Context, userID, companyID string) (*companyModel.Company, error)
+}
 
 func (m *MockCompanyRepository) Create(ctx context.Context, company *companyModel.Company) error {
 	return nil
 }
 func (m *MockCompanyRepository) GetByID(ctx context.Context, userID, companyID string) (*companyModel.Company, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, userID, companyID)
+	}
 	return &companyModel.Company{ID: companyID, UserID: userID}, nil
 }
 func (m *MockCompanyRepository) GetByIDEnriched(ctx context.Context, userID, companyID string) (*companyModel.CompanyDTO, error) {
@@ -45,17 +54,32 @@ func (m *MockCompanyRepository) GetRelatedJobsAndApplicationsCount(ctx context.C
 func (m *MockCompanyRepository) ToggleFavorite(ctx context.Context, userID, companyID string) (bool, error) {
 	return false, nil
 }
+func (m *MockCompanyRepository) UpdateLogoURL(ctx context.Context, userID, companyID string, logoURL *string) error {
+	return nil
+}
+func (m *MockCompanyRepository) GetByName(ctx context.Context, userID, name string) (*companyModel.Company, error) {
+	return nil, companyModel.ErrCompanyNotFound
+}
+func (m *MockCompanyRepository) ListSimilarPairs(ctx context.Context, userID string, threshold float64) ([]*companyModel.SimilarCompanyPair, error) {
+	return nil, nil
+}
+func (m *MockCompanyRepository) Merge(ctx context.Context, userID, intoID string, fromIDs []string) error {
+	return nil
+}
 
 var defaultMockCompanyRepo = &MockCompanyRepository{}
 
 // MockJobRepository implements ports.JobRepository
 type MockJobRepository struct {
-	CreateFunc         func(ctx context.Context, job *model.Job) error
-	GetByIDFunc        func(ctx context.Context, userID, jobID string) (*model.Job, error)
-	ListFunc           func(ctx context.Context, userID string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error)
-	UpdateFunc         func(ctx context.Context, job *model.Job) error
-	DeleteFunc         func(ctx context.Context, userID, jobID string) error
-	ToggleFavoriteFunc func(ctx context.Context, userID, jobID string) (bool, error)
+	CreateFunc             func(ctx context.Context, job *model.Job) error
+	GetByIDFunc            func(ctx context.Context, userID, jobID string) (*model.Job, error)
+	ListFunc               func(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.JobDTO, int, error)
+	UpdateFunc             func(ctx context.Context, job *model.Job) error
+	DeleteFunc             func(ctx context.Context, userID, jobID string) error
+	ToggleFavoriteFunc     func(ctx context.Context, userID, jobID string) (bool, error)
+	ListHighPriorityFunc   func(ctx context.Context, userID string, limit int) ([]*model.JobDTO, error)
+	FindSimilarByTitleFunc func(ctx context.Context, userID, title, excludeID string, limit int) ([]*model.SimilarJobDTO, error)
+	ListApplicationIDsFunc func(ctx context.Context, userID, jobID string) ([]string, error)
 }
 
 func (m *MockJobRepository) Create(ctx context.Context, job *model.Job) error {
@@ -72,9 +96,9 @@ func (m *MockJobRepository) GetByID(ctx context.Context, userID, jobID string) (
 	return nil, nil
 }
 
-func (m *MockJobRepository) List(ctx context.Context, userID string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error) {
+func (m *MockJobRepository) List(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
 	if m.ListFunc != nil {
-		return m.ListFunc(ctx, userID, limit, offset, status, sortBy, sortOrder)
+		return m.ListFunc(ctx, userID, opts)
 	}
 	return nil, 0, nil
 }
@@ -100,6 +124,27 @@ func (m *MockJobRepository) ToggleFavorite(ctx context.Context, userID, jobID st
 	return false, nil
 }
 
+func (m *MockJobRepository) ListHighPriority(ctx context.Context, userID string, limit int) ([]*model.JobDTO, error) {
+	if m.ListHighPriorityFunc != nil {
+		return m.ListHighPriorityFunc(ctx, userID, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockJobRepository) FindSimilarByTitle(ctx context.Context, userID, title, excludeID string, limit int) ([]*model.SimilarJobDTO, error) {
+	if m.FindSimilarByTitleFunc != nil {
+		return m.FindSimilarByTitleFunc(ctx, userID, title, excludeID, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockJobRepository) ListApplicationIDs(ctx context.Context, userID, jobID string) ([]string, error) {
+	if m.ListApplicationIDsFunc != nil {
+		return m.ListApplicationIDsFunc(ctx, userID, jobID)
+	}
+	return nil, nil
+}
+
 func setupTestRouter() *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	return gin.New()
@@ -126,7 +171,7 @@ func TestJobHandler_Create(t *testing.T) {
 			},
 		}
 
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -148,7 +193,7 @@ func TestJobHandler_Create(t *testing.T) {
 
 	t.Run("returns 401 when not authenticated", func(t *testing.T) {
 		mockRepo := &MockJobRepository{}
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -165,7 +210,7 @@ func TestJobHandler_Create(t *testing.T) {
 
 	t.Run("returns 400 for invalid request", func(t *testing.T) {
 		mockRepo := &MockJobRepository{}
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -182,7 +227,7 @@ func TestJobHandler_Create(t *testing.T) {
 
 	t.Run("returns 400 for empty title", func(t *testing.T) {
 		mockRepo := &MockJobRepository{}
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -196,6 +241,54 @@ func TestJobHandler_Create(t *testing.T) {
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
+
+	t.Run("defaults priority to medium", func(t *testing.T) {
+		var created *model.Job
+		mockRepo := &MockJobRepository{
+			CreateFunc: func(ctx context.Context, job *model.Job) error {
+				created = job
+				return nil
+			},
+		}
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.POST("/jobs", mockAuthMiddleware(userID), handler.Create)
+
+		body := `{"title":"Software Engineer"}`
+		req, _ := http.NewRequest(http.MethodPost, "/jobs", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		require.NotNil(t, created)
+		assert.Equal(t, model.PriorityMedium, created.Priority)
+	})
+
+	t.Run("returns 400 for invalid priority", func(t *testing.T) {
+		mockRepo := &MockJobRepository{
+			CreateFunc: func(ctx context.Context, job *model.Job) error {
+				t.Fatal("Create should not be called for an invalid priority")
+				return nil
+			},
+		}
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.POST("/jobs", mockAuthMiddleware(userID), handler.Create)
+
+		body := `{"title":"Software Engineer","priority":"urgent"}`
+		req, _ := http.NewRequest(http.MethodPost, "/jobs", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeInvalidJobPriority))
+	})
 }
 
 func TestJobHandler_Get(t *testing.T) {
@@ -218,7 +311,7 @@ func TestJobHandler_Get(t *testing.T) {
 			},
 		}
 
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -243,7 +336,7 @@ func TestJobHandler_Get(t *testing.T) {
 			},
 		}
 
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -257,6 +350,84 @@ func TestJobHandler_Get(t *testing.T) {
 	})
 }
 
+// MockJobStatusHistoryRepository implements ports.JobStatusHistoryRepository
+type MockJobStatusHistoryRepository struct {
+	ListByJobFunc func(ctx context.Context, userID, jobID string) ([]*model.JobStatusHistory, error)
+}
+
+func (m *MockJobStatusHistoryRepository) Create(ctx context.Context, entry *model.JobStatusHistory) error {
+	return nil
+}
+
+func (m *MockJobStatusHistoryRepository) ListByJob(ctx context.Context, userID, jobID string) ([]*model.JobStatusHistory, error) {
+	if m.ListByJobFunc != nil {
+		return m.ListByJobFunc(ctx, userID, jobID)
+	}
+	return nil, nil
+}
+
+func TestJobHandler_History(t *testing.T) {
+	userID := "user-123"
+	jobID := "job-1"
+
+	t.Run("returns history newest first", func(t *testing.T) {
+		now := time.Now().UTC()
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return &model.Job{ID: jid, UserID: uid, Status: "active"}, nil
+			},
+		}
+		historyRepo := &MockJobStatusHistoryRepository{
+			ListByJobFunc: func(ctx context.Context, uid, jid string) ([]*model.JobStatusHistory, error) {
+				return []*model.JobStatusHistory{
+					{FromStatus: "archived", ToStatus: "active", ChangedAt: now},
+					{FromStatus: "active", ToStatus: "archived", ChangedAt: now.Add(-time.Hour)},
+				}, nil
+			},
+		}
+
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, historyRepo, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/jobs/:id/history", mockAuthMiddleware(userID), handler.History)
+
+		req, _ := http.NewRequest(http.MethodGet, "/jobs/"+jobID+"/history", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response []model.JobStatusHistoryDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+		require.Len(t, response, 2)
+		assert.Equal(t, "archived", response[0].FromStatus)
+		assert.Equal(t, "active", response[0].ToStatus)
+		assert.True(t, response[0].ChangedAt.After(response[1].ChangedAt))
+	})
+
+	t.Run("returns 404 when job not found", func(t *testing.T) {
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return nil, model.ErrJobNotFound
+			},
+		}
+
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, &MockJobStatusHistoryRepository{}, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/jobs/:id/history", mockAuthMiddleware(userID), handler.History)
+
+		req, _ := http.NewRequest(http.MethodGet, "/jobs/nonexistent/history", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
 func TestJobHandler_List(t *testing.T) {
 	userID := "user-123"
 
@@ -267,12 +438,12 @@ func TestJobHandler_List(t *testing.T) {
 		}
 
 		mockRepo := &MockJobRepository{
-			ListFunc: func(ctx context.Context, uid string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error) {
+			ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
 				return expectedJobs, 2, nil
 			},
 		}
 
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -285,16 +456,51 @@ func TestJobHandler_List(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("includes application count and active stage", func(t *testing.T) {
+		stage := "Technical Interview"
+		mockRepo := &MockJobRepository{
+			ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
+				return []*model.JobDTO{
+					{ID: "job-1", Title: "Software Engineer", ApplicationsCount: 2, ActiveApplicationStage: &stage},
+					{ID: "job-2", Title: "Product Manager"},
+				}, 2, nil
+			},
+		}
+
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/jobs", mockAuthMiddleware(userID), handler.List)
+
+		req, _ := http.NewRequest(http.MethodGet, "/jobs", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp struct {
+			Items []map[string]interface{} `json:"items"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Len(t, resp.Items, 2)
+		assert.Equal(t, float64(2), resp.Items[0]["applications_count"])
+		assert.Equal(t, "Technical Interview", resp.Items[0]["active_application_stage"])
+		assert.Equal(t, float64(0), resp.Items[1]["applications_count"])
+		assert.Contains(t, resp.Items[1], "active_application_stage")
+		assert.Nil(t, resp.Items[1]["active_application_stage"])
+	})
+
 	t.Run("parses sort parameter correctly", func(t *testing.T) {
 		mockRepo := &MockJobRepository{
-			ListFunc: func(ctx context.Context, uid string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error) {
-				assert.Equal(t, "created_at", sortBy)
-				assert.Equal(t, "desc", sortOrder)
+			ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
+				assert.Equal(t, "created_at", opts.SortBy)
+				assert.Equal(t, "desc", opts.SortOrder)
 				return []*model.JobDTO{}, 0, nil
 			},
 		}
 
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -306,6 +512,221 @@ func TestJobHandler_List(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
+
+	t.Run("forwards the status, search, company, employment type and work arrangement filters", func(t *testing.T) {
+		companyID := "3f2b7c1e-8d4a-4b6e-9c1f-2a5d8e7b9c0d"
+		mockRepo := &MockJobRepository{
+			ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
+				assert.Equal(t, "archived", opts.Status)
+				assert.Equal(t, "golang 100%", opts.Query)
+				assert.Equal(t, companyID, opts.CompanyID)
+				assert.Equal(t, "contract", opts.EmploymentType)
+				assert.Equal(t, "remote", opts.WorkArrangement)
+				return []*model.JobDTO{}, 0, nil
+			},
+		}
+
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/jobs", mockAuthMiddleware(userID), handler.List)
+
+		req, _ := http.NewRequest(http.MethodGet, "/jobs?status=archived&q=+golang+100%25+&company_id="+companyID+"&employment_type=contract&work_arrangement=remote", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("rejects invalid filters", func(t *testing.T) {
+		svc := service.NewJobService(&MockJobRepository{
+			ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
+				t.Fatal("invalid filters are not forwarded")
+				return nil, 0, nil
+			},
+		}, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/jobs", mockAuthMiddleware(userID), handler.List)
+
+		for query, code := range map[string]string{
+			"status=deleted":                "INVALID_STATUS",
+			"company_id=acme":               "INVALID_COMPANY_ID",
+			"q=" + strings.Repeat("a", 201): "INVALID_QUERY",
+			"employment_type=seasonal":      "INVALID_EMPLOYMENT_TYPE",
+			"work_arrangement=office":       "INVALID_WORK_ARRANGEMENT",
+		} {
+			req, _ := http.NewRequest(http.MethodGet, "/jobs?"+query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+			assert.Contains(t, w.Body.String(), code, query)
+		}
+	})
+
+	t.Run("accepts priority sort", func(t *testing.T) {
+		mockRepo := &MockJobRepository{
+			ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
+				assert.Equal(t, "priority", opts.SortBy)
+				assert.Equal(t, "desc", opts.SortOrder)
+				return []*model.JobDTO{}, 0, nil
+			},
+		}
+
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/jobs", mockAuthMiddleware(userID), handler.List)
+
+		req, _ := http.NewRequest(http.MethodGet, "/jobs?sort=priority:desc", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("returns a cursor page", func(t *testing.T) {
+		mockRepo := &MockJobRepository{
+			ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
+				require.NotNil(t, opts.Cursor)
+				assert.Equal(t, 2, opts.Limit)
+				return []*model.JobDTO{
+					{ID: "11111111-1111-1111-1111-111111111111", Title: "Backend Engineer"},
+					{ID: "22222222-2222-2222-2222-222222222222", Title: "Go Developer"},
+				}, 7, nil
+			},
+		}
+
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/jobs", mockAuthMiddleware(userID), handler.List)
+
+		req, _ := http.NewRequest(http.MethodGet, "/jobs?cursor=&limit=1&sort=title:asc", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp struct {
+			Items      []model.JobDTO `json:"items"`
+			Pagination struct {
+				Total      int    `json:"total"`
+				NextCursor string `json:"next_cursor"`
+				HasMore    bool   `json:"has_more"`
+			} `json:"pagination"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Len(t, resp.Items, 1)
+		assert.Equal(t, 7, resp.Pagination.Total)
+		assert.True(t, resp.Pagination.HasMore)
+		next, err := keyset.Decode(resp.Pagination.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, keyset.Cursor{LastID: "11111111-1111-1111-1111-111111111111", LastValue: "Backend Engineer"}, *next)
+	})
+
+	t.Run("rejects cursor pagination for priority sort", func(t *testing.T) {
+		svc := service.NewJobService(&MockJobRepository{
+			ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
+				return nil, 0, keyset.ErrUnsupportedSort
+			},
+		}, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/jobs", mockAuthMiddleware(userID), handler.List)
+
+		req, _ := http.NewRequest(http.MethodGet, "/jobs?cursor=&sort=priority:desc", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "UNSUPPORTED_CURSOR_SORT")
+	})
+}
+
+func TestJobHandler_ListHighPriority(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("returns only high priority jobs", func(t *testing.T) {
+		stored := []*model.JobDTO{
+			{ID: "job-1", Title: "Staff Engineer", Priority: model.PriorityHigh},
+			{ID: "job-2", Title: "Product Manager", Priority: model.PriorityMedium},
+			{ID: "job-3", Title: "Tech Lead", Priority: model.PriorityHigh},
+			{ID: "job-4", Title: "Intern", Priority: model.PriorityLow},
+		}
+		mockRepo := &MockJobRepository{
+			ListHighPriorityFunc: func(ctx context.Context, uid string, limit int) ([]*model.JobDTO, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, 50, limit)
+				high := []*model.JobDTO{}
+				for _, job := range stored {
+					if job.Priority == model.PriorityHigh {
+						high = append(high, job)
+					}
+				}
+				return high, nil
+			},
+		}
+
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/jobs/high-priority", mockAuthMiddleware(userID), handler.ListHighPriority)
+
+		req, _ := http.NewRequest(http.MethodGet, "/jobs/high-priority", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var jobs []model.JobDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &jobs))
+		require.Len(t, jobs, 2)
+		for _, job := range jobs {
+			assert.Equal(t, model.PriorityHigh, job.Priority)
+		}
+		assert.Equal(t, "job-1", jobs[0].ID)
+		assert.Equal(t, "job-3", jobs[1].ID)
+	})
+
+	t.Run("returns 401 when not authenticated", func(t *testing.T) {
+		svc := service.NewJobService(&MockJobRepository{}, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/jobs/high-priority", handler.ListHighPriority)
+
+		req, _ := http.NewRequest(http.MethodGet, "/jobs/high-priority", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("returns 500 on repository error", func(t *testing.T) {
+		mockRepo := &MockJobRepository{
+			ListHighPriorityFunc: func(ctx context.Context, uid string, limit int) ([]*model.JobDTO, error) {
+				return nil, errors.New("db error")
+			},
+		}
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/jobs/high-priority", mockAuthMiddleware(userID), handler.ListHighPriority)
+
+		req, _ := http.NewRequest(http.MethodGet, "/jobs/high-priority", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
 }
 
 func TestJobHandler_Update(t *testing.T) {
@@ -331,7 +752,7 @@ func TestJobHandler_Update(t *testing.T) {
 			},
 		}
 
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -353,7 +774,7 @@ func TestJobHandler_Update(t *testing.T) {
 			},
 		}
 
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -382,7 +803,7 @@ func TestJobHandler_Update(t *testing.T) {
 			},
 		}
 
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -398,6 +819,93 @@ func TestJobHandler_Update(t *testing.T) {
 	})
 }
 
+func TestJobHandler_UpdateCompany(t *testing.T) {
+	userID := "user-123"
+	jobID := "job-1"
+	companyID := "6f1c2a9e-8b4d-4c1a-9f3e-2d5b7a8c9e01"
+
+	newRouter := func(companyRepo companyPorts.CompanyRepository, mockRepo *MockJobRepository) *gin.Engine {
+		svc := service.NewJobService(mockRepo, companyRepo, nil, nil, nil, nil, nil, nil)
+		handler := NewJobHandler(svc)
+
+		router := setupTestRouter()
+		router.PATCH("/jobs/:id/company", mockAuthMiddleware(userID), handler.UpdateCompany)
+		return router
+	}
+
+	patch := func(router *gin.Engine, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(http.MethodPatch, "/jobs/"+jobID+"/company", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("reassigns the company", func(t *testing.T) {
+		companyRepo := &MockCompanyRepository{
+			GetByIDFunc: func(ctx context.Context, uid, cid string) (*companyModel.Company, error) {
+				return &companyModel.Company{ID: cid, UserID: uid, Name: "Globex"}, nil
+			},
+		}
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return &model.Job{ID: jid, UserID: uid, Title: "Engineer", Status: "active"}, nil
+			},
+			UpdateFunc: func(ctx context.Context, job *model.Job) error {
+				return nil
+			},
+		}
+
+		w := patch(newRouter(companyRepo, mockRepo), `{"company_id":"`+companyID+`"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp model.JobDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.NotNil(t, resp.CompanyID)
+		assert.Equal(t, companyID, *resp.CompanyID)
+		require.NotNil(t, resp.CompanyName)
+		assert.Equal(t, "Globex", *resp.CompanyName)
+	})
+
+	t.Run("returns 400 for a missing or malformed company ID", func(t *testing.T) {
+		router := newRouter(defaultMockCompanyRepo, &MockJobRepository{})
+
+		assert.Equal(t, http.StatusBadRequest, patch(router, `{}`).Code)
+		assert.Equal(t, http.StatusBadRequest, patch(router, `{"company_id":"not-a-uuid"}`).Code)
+	})
+
+	t.Run("returns 404 when company belongs to another user", func(t *testing.T) {
+		companyRepo := &MockCompanyRepository{
+			GetByIDFunc: func(ctx context.Context, uid, cid string) (*companyModel.Company, error) {
+				return nil, errors.New("company not found")
+			},
+		}
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return &model.Job{ID: jid, UserID: uid, Title: "Engineer", Status: "active"}, nil
+			},
+		}
+
+		w := patch(newRouter(companyRepo, mockRepo), `{"company_id":"`+companyID+`"}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeCompanyNotFound))
+	})
+
+	t.Run("returns 404 when job not found", func(t *testing.T) {
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return nil, model.ErrJobNotFound
+			},
+		}
+
+		w := patch(newRouter(defaultMockCompanyRepo, mockRepo), `{"company_id":"`+companyID+`"}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeJobNotFound))
+	})
+}
+
 func TestJobHandler_Delete(t *testing.T) {
 	userID := "user-123"
 	jobID := "job-1"
@@ -409,7 +917,7 @@ func TestJobHandler_Delete(t *testing.T) {
 			},
 		}
 
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -429,7 +937,7 @@ func TestJobHandler_Delete(t *testing.T) {
 			},
 		}
 
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -456,7 +964,7 @@ func TestJobHandler_ToggleFavorite(t *testing.T) {
 			},
 		}
 
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -477,7 +985,7 @@ func TestJobHandler_ToggleFavorite(t *testing.T) {
 			},
 		}
 
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -492,7 +1000,7 @@ func TestJobHandler_ToggleFavorite(t *testing.T) {
 
 	t.Run("returns 401 without auth", func(t *testing.T) {
 		mockRepo := &MockJobRepository{}
-		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		handler := NewJobHandler(svc)
 
 		router := setupTestRouter()
@@ -535,7 +1043,7 @@ func TestJobHandler_RegisterRoutes(t *testing.T) {
 		GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
 			return &model.Job{ID: jid, Title: "Test", Status: "active"}, nil
 		},
-		ListFunc: func(ctx context.Context, uid string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error) {
+		ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
 			return []*model.JobDTO{}, 0, nil
 		},
 		DeleteFunc: func(ctx context.Context, uid, jid string) error {
@@ -543,7 +1051,7 @@ func TestJobHandler_RegisterRoutes(t *testing.T) {
 		},
 	}
 
-	svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+	svc := service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 	handler := NewJobHandler(svc)
 
 	router := setupTestRouter()
@@ -556,8 +1064,11 @@ func TestJobHandler_RegisterRoutes(t *testing.T) {
 	}{
 		{http.MethodPost, "/api/v1/jobs"},
 		{http.MethodGet, "/api/v1/jobs"},
+		{http.MethodGet, "/api/v1/jobs/high-priority"},
 		{http.MethodGet, "/api/v1/jobs/test-id"},
+		{http.MethodGet, "/api/v1/jobs/test-id/history"},
 		{http.MethodPatch, "/api/v1/jobs/test-id"},
+		{http.MethodPatch, "/api/v1/jobs/test-id/company"},
 		{http.MethodDelete, "/api/v1/jobs/test-id"},
 		{http.MethodPost, "/api/v1/jobs/test-id/favorite"},
 	}
@@ -579,3 +1090,50 @@ func TestJobHandler_RegisterRoutes(t *testing.T) {
 		})
 	}
 }
+
+func TestJobHandler_Get_ETag(t *testing.T) {
+	userID := "user-123"
+	jobID := "job-1"
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newRouter := func() *gin.Engine {
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return &model.Job{ID: jid, UserID: uid, Title: "Software Engineer", Status: "active", UpdatedAt: updatedAt}, nil
+			},
+		}
+		handler := NewJobHandler(service.NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil))
+		router := setupTestRouter()
+		router.GET("/jobs/:id", mockAuthMiddleware(userID), handler.Get)
+		return router
+	}
+
+	t.Run("returns 200 with ETag on cache miss", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/jobs/"+jobID, nil)
+		req.Header.Set("If-None-Match", `"outdated"`)
+		w := httptest.NewRecorder()
+		newRouter().ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEmpty(t, w.Header().Get("ETag"))
+		assert.Equal(t, "private, max-age=0", w.Header().Get("Cache-Control"))
+		assert.Contains(t, w.Body.String(), "Software Engineer")
+	})
+
+	t.Run("returns 304 on cache hit", func(t *testing.T) {
+		// The tag hashes the response body, so take it from a first response
+		first := httptest.NewRecorder()
+		newRouter().ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/jobs/"+jobID, nil))
+		etag := first.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+
+		req, _ := http.NewRequest(http.MethodGet, "/jobs/"+jobID, nil)
+		req.Header.Set("If-None-Match", etag)
+		w := httptest.NewRecorder()
+		newRouter().ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Equal(t, etag, w.Header().Get("ETag"))
+		assert.Empty(t, w.Body.String())
+	})
+}
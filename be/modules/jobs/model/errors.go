@@ -1,60 +1,68 @@
 package model
 
-import "errors"
+import (
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
 
 var (
 	// ErrJobNotFound is returned when a job is not found
-	ErrJobNotFound = errors.New("job not found")
+	ErrJobNotFound = &DomainError{Code: CodeJobNotFound, Message: "job not found"}
 
 	// ErrJobTitleRequired is returned when job title is empty
-	ErrJobTitleRequired = errors.New("job title is required")
+	ErrJobTitleRequired = &DomainError{Code: CodeJobTitleRequired, Message: "job title is required"}
 
 	// ErrInvalidJobStatus is returned when an invalid job status is provided
-	ErrInvalidJobStatus = errors.New("invalid job status")
+	ErrInvalidJobStatus = &DomainError{Code: CodeInvalidJobStatus, Message: "invalid job status"}
+
+	// ErrInvalidJobPriority is returned when an invalid job priority is provided
+	ErrInvalidJobPriority = &DomainError{Code: CodeInvalidJobPriority, Message: "invalid job priority"}
 
 	// ErrCompanyNotFound is returned when a referenced company does not exist or does not belong to the user
-	ErrCompanyNotFound = errors.New("company not found")
+	ErrCompanyNotFound = &DomainError{Code: CodeCompanyNotFound, Message: "company not found"}
+
+	// ErrInvalidJobURL is returned when a job URL cannot be normalized
+	ErrInvalidJobURL = &DomainError{Code: CodeInvalidJobURL, Message: "invalid job URL"}
+
+	// ErrInvalidEmploymentType is returned when an unknown employment type is provided
+	ErrInvalidEmploymentType = &DomainError{Code: CodeInvalidEmploymentType, Message: "invalid employment type"}
+
+	// ErrInvalidWorkArrangement is returned when an unknown work arrangement is provided
+	ErrInvalidWorkArrangement = &DomainError{Code: CodeInvalidWorkArrangement, Message: "invalid work arrangement"}
+
+	// ErrInvalidSalaryRange is returned when a salary is negative or the minimum exceeds the maximum
+	ErrInvalidSalaryRange = &DomainError{Code: CodeInvalidSalaryRange, Message: "invalid salary range"}
+
+	// ErrInvalidSalaryCurrency is returned when the salary currency is not a three-letter code
+	ErrInvalidSalaryCurrency = &DomainError{Code: CodeInvalidSalaryCurrency, Message: "invalid salary currency"}
 )
 
 // ErrorCode represents error codes
 type ErrorCode string
 
 const (
-	CodeJobNotFound      ErrorCode = "JOB_NOT_FOUND"
-	CodeJobTitleRequired ErrorCode = "JOB_TITLE_REQUIRED"
-	CodeInvalidJobStatus ErrorCode = "INVALID_JOB_STATUS"
-	CodeCompanyNotFound  ErrorCode = "COMPANY_NOT_FOUND"
-	CodeInternalError    ErrorCode = "INTERNAL_ERROR"
+	CodeJobNotFound            ErrorCode = "JOB_NOT_FOUND"
+	CodeJobTitleRequired       ErrorCode = "JOB_TITLE_REQUIRED"
+	CodeInvalidJobStatus       ErrorCode = "INVALID_JOB_STATUS"
+	CodeInvalidJobPriority     ErrorCode = "INVALID_JOB_PRIORITY"
+	CodeCompanyNotFound        ErrorCode = "COMPANY_NOT_FOUND"
+	CodeInvalidJobURL          ErrorCode = "INVALID_JOB_URL"
+	CodeInvalidEmploymentType  ErrorCode = "INVALID_EMPLOYMENT_TYPE"
+	CodeInvalidWorkArrangement ErrorCode = "INVALID_WORK_ARRANGEMENT"
+	CodeInvalidSalaryRange     ErrorCode = "INVALID_SALARY_RANGE"
+	CodeInvalidSalaryCurrency  ErrorCode = "INVALID_SALARY_CURRENCY"
+	CodeInternalError          ErrorCode = "INTERNAL_ERROR"
 )
 
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
 // GetErrorCode maps errors to error codes
 func GetErrorCode(err error) ErrorCode {
-	switch {
-	case errors.Is(err, ErrJobNotFound):
-		return CodeJobNotFound
-	case errors.Is(err, ErrJobTitleRequired):
-		return CodeJobTitleRequired
-	case errors.Is(err, ErrInvalidJobStatus):
-		return CodeInvalidJobStatus
-	case errors.Is(err, ErrCompanyNotFound):
-		return CodeCompanyNotFound
-	default:
-		return CodeInternalError
-	}
+	return domainerr.CodeOf(err, CodeInternalError)
 }
 
-// GetErrorMessage returns a user-friendly error message
-func GetErrorMessage(err error) string {
-	switch {
-	case errors.Is(err, ErrJobNotFound):
-		return "Job not found"
-	case errors.Is(err, ErrJobTitleRequired):
-		return "Job title is required"
-	case errors.Is(err, ErrInvalidJobStatus):
-		return "Invalid job status"
-	case errors.Is(err, ErrCompanyNotFound):
-		return "Company not found"
-	default:
-		return "Internal server error"
-	}
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
 }
@@ -1,6 +1,63 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
+)
+
+// Job priority values
+const (
+	PriorityLow    = "low"
+	PriorityMedium = "medium"
+	PriorityHigh   = "high"
+)
+
+// IsValidPriority reports whether p is a known job priority
+func IsValidPriority(p string) bool {
+	switch p {
+	case PriorityLow, PriorityMedium, PriorityHigh:
+		return true
+	}
+	return false
+}
+
+// Job employment types
+const (
+	EmploymentTypeFullTime   = "full_time"
+	EmploymentTypePartTime   = "part_time"
+	EmploymentTypeContract   = "contract"
+	EmploymentTypeInternship = "internship"
+	EmploymentTypeFreelance  = "freelance"
+)
+
+// IsValidEmploymentType reports whether t is a known employment type
+func IsValidEmploymentType(t string) bool {
+	switch t {
+	case EmploymentTypeFullTime, EmploymentTypePartTime, EmploymentTypeContract, EmploymentTypeInternship, EmploymentTypeFreelance:
+		return true
+	}
+	return false
+}
+
+// Job work arrangements
+const (
+	WorkArrangementRemote = "remote"
+	WorkArrangementOnsite = "onsite"
+	WorkArrangementHybrid = "hybrid"
+)
+
+// IsValidWorkArrangement reports whether a is a known work arrangement
+func IsValidWorkArrangement(a string) bool {
+	switch a {
+	case WorkArrangementRemote, WorkArrangementOnsite, WorkArrangementHybrid:
+		return true
+	}
+	return false
+}
+
+// DefaultSalaryCurrency is used when a job is created without a salary currency
+const DefaultSalaryCurrency = "USD"
 
 // Job represents a job posting
 type Job struct {
@@ -13,30 +70,61 @@ type Job struct {
 	Notes       *string
 	Description *string
 	Status      string
+	Priority    string
 	IsFavorite  bool
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// SalaryMin and SalaryMax are the advertised range in whole units of SalaryCurrency
+	SalaryMin      *int
+	SalaryMax      *int
+	SalaryCurrency string
+	// EmploymentType and WorkArrangement are nil when not specified
+	EmploymentType  *string
+	WorkArrangement *string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 // JobDTO represents job data transfer object
 type JobDTO struct {
-	ID                string    `json:"id"`
-	CompanyID         *string   `json:"company_id,omitempty"`
-	CompanyName       *string   `json:"company_name,omitempty"`
-	Title             string    `json:"title"`
-	Source            *string   `json:"source,omitempty"`
-	URL               *string   `json:"url,omitempty"`
-	Notes             *string   `json:"notes,omitempty"`
-	Description       *string   `json:"description,omitempty"`
-	Status            string    `json:"status"`
-	IsFavorite        bool      `json:"is_favorite"`
-	ApplicationsCount int       `json:"applications_count"`
-	CreatedAt         time.Time `json:"created_at"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	ID                     string    `json:"id"`
+	CompanyID              *string   `json:"company_id,omitempty"`
+	CompanyName            *string   `json:"company_name,omitempty"`
+	Title                  string    `json:"title"`
+	Source                 *string   `json:"source,omitempty"`
+	URL                    *string   `json:"url,omitempty"`
+	Notes                  *string   `json:"notes,omitempty"`
+	Description            *string   `json:"description,omitempty"`
+	Status                 string    `json:"status"`
+	Priority               string    `json:"priority"`
+	IsFavorite             bool      `json:"is_favorite"`
+	SalaryMin              *int      `json:"salary_min,omitempty"`
+	SalaryMax              *int      `json:"salary_max,omitempty"`
+	SalaryCurrency         string    `json:"salary_currency"`
+	EmploymentType         *string   `json:"employment_type,omitempty"`
+	WorkArrangement        *string   `json:"work_arrangement,omitempty"`
+	ApplicationsCount      int       `json:"applications_count"`
+	ActiveApplicationStage *string   `json:"active_application_stage"`
+	TagIDs                 []string  `json:"tag_ids,omitempty"`
+	CreatedAt              time.Time `json:"created_at"`
+	UpdatedAt              time.Time `json:"updated_at"`
+}
+
+// SimilarJobDTO is a job suggested for its title similarity to another job
+type SimilarJobDTO struct {
+	*JobDTO
+	SimilarityScore float64 `json:"similarity_score"`
+}
+
+// Cursor returns the keyset cursor positioned after this job for the given sort field
+func (d *JobDTO) Cursor(sortBy string) keyset.Cursor {
+	cursor := keyset.Cursor{LastID: d.ID, LastValue: keyset.TimeValue(d.CreatedAt)}
+	if sortBy == "title" {
+		cursor.LastValue = d.Title
+	}
+	return cursor
 }
 
 // ToDTO converts Job to JobDTO
-// Note: CompanyName and ApplicationsCount must be set separately by the repository
+// Note: CompanyName, ApplicationsCount and ActiveApplicationStage must be set separately by the repository
 func (j *Job) ToDTO() *JobDTO {
 	return &JobDTO{
 		ID:                j.ID,
@@ -48,7 +136,13 @@ func (j *Job) ToDTO() *JobDTO {
 		Notes:             j.Notes,
 		Description:       j.Description,
 		Status:            j.Status,
+		Priority:          j.Priority,
 		IsFavorite:        j.IsFavorite,
+		SalaryMin:         j.SalaryMin,
+		SalaryMax:         j.SalaryMax,
+		SalaryCurrency:    j.SalaryCurrency,
+		EmploymentType:    j.EmploymentType,
+		WorkArrangement:   j.WorkArrangement,
 		ApplicationsCount: 0, // Set by repository
 		CreatedAt:         j.CreatedAt,
 		UpdatedAt:         j.UpdatedAt,
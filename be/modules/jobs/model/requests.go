@@ -8,15 +8,36 @@ type CreateJobRequest struct {
 	URL         *string `json:"url,omitempty"`
 	Notes       *string `json:"notes,omitempty"`
 	Description *string `json:"description,omitempty"`
+	Priority    *string `json:"priority,omitempty"`
+	// SalaryMin and SalaryMax are whole units of SalaryCurrency, an ISO 4217 code (default: USD)
+	SalaryMin      *int    `json:"salary_min,omitempty"`
+	SalaryMax      *int    `json:"salary_max,omitempty"`
+	SalaryCurrency *string `json:"salary_currency,omitempty"`
+	// EmploymentType is full_time, part_time, contract, internship or freelance;
+	// WorkArrangement is remote, onsite or hybrid
+	EmploymentType  *string `json:"employment_type,omitempty"`
+	WorkArrangement *string `json:"work_arrangement,omitempty"`
 }
 
-// UpdateJobRequest represents an update job request
+// UpdateJobRequest represents an update job request.
+// An empty employment_type or work_arrangement clears the field.
 type UpdateJobRequest struct {
-	CompanyID   *string `json:"company_id,omitempty"`
-	Title       *string `json:"title,omitempty"`
-	Source      *string `json:"source,omitempty"`
-	URL         *string `json:"url,omitempty"`
-	Notes       *string `json:"notes,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Status      *string `json:"status,omitempty"`
+	CompanyID       *string `json:"company_id,omitempty"`
+	Title           *string `json:"title,omitempty"`
+	Source          *string `json:"source,omitempty"`
+	URL             *string `json:"url,omitempty"`
+	Notes           *string `json:"notes,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	Status          *string `json:"status,omitempty"`
+	Priority        *string `json:"priority,omitempty"`
+	SalaryMin       *int    `json:"salary_min,omitempty"`
+	SalaryMax       *int    `json:"salary_max,omitempty"`
+	SalaryCurrency  *string `json:"salary_currency,omitempty"`
+	EmploymentType  *string `json:"employment_type,omitempty"`
+	WorkArrangement *string `json:"work_arrangement,omitempty"`
+}
+
+// UpdateJobCompanyRequest reassigns a job to another company
+type UpdateJobCompanyRequest struct {
+	CompanyID string `json:"company_id" binding:"required,uuid"`
 }
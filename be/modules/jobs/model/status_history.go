@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// JobStatusHistory records a single job status transition
+type JobStatusHistory struct {
+	ID         string
+	JobID      string
+	UserID     string
+	FromStatus string
+	ToStatus   string
+	ChangedAt  time.Time
+}
+
+// JobStatusHistoryDTO represents a job status transition
+type JobStatusHistoryDTO struct {
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ChangedAt  time.Time `json:"changed_at"`
+}
+
+// ToDTO converts JobStatusHistory to JobStatusHistoryDTO
+func (h *JobStatusHistory) ToDTO() *JobStatusHistoryDTO {
+	return &JobStatusHistoryDTO{
+		FromStatus: h.FromStatus,
+		ToStatus:   h.ToStatus,
+		ChangedAt:  h.ChangedAt,
+	}
+}
@@ -3,15 +3,43 @@ package ports
 import (
 	"context"
 
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	"github.com/andreypavlenko/jobber/modules/jobs/model"
 )
 
+// ListOptions defines options for listing jobs
+type ListOptions struct {
+	Limit     int
+	Offset    int
+	Status    string // "active", "archived", "all"; empty means active
+	Query     string // case-insensitive substring of the title or notes
+	CompanyID string
+	// EmploymentType and WorkArrangement filter on exact values; empty means any
+	EmploymentType  string
+	WorkArrangement string
+	SortBy          string // "created_at", "title", "priority", "company_name"; empty means created_at
+	SortOrder       string // "asc", "desc"
+	// Cursor switches to keyset pagination for the created_at and title sorts
+	Cursor *keyset.Cursor
+}
+
 // JobRepository defines the interface for job data access
 type JobRepository interface {
 	Create(ctx context.Context, job *model.Job) error
 	GetByID(ctx context.Context, userID, jobID string) (*model.Job, error)
-	List(ctx context.Context, userID string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error)
+	List(ctx context.Context, userID string, opts *ListOptions) ([]*model.JobDTO, int, error)
 	Update(ctx context.Context, job *model.Job) error
 	Delete(ctx context.Context, userID, jobID string) error
 	ToggleFavorite(ctx context.Context, userID, jobID string) (bool, error)
+	ListHighPriority(ctx context.Context, userID string, limit int) ([]*model.JobDTO, error)
+	// FindSimilarByTitle returns the user's jobs whose titles are trigram-similar to title, best match first
+	FindSimilarByTitle(ctx context.Context, userID, title, excludeID string, limit int) ([]*model.SimilarJobDTO, error)
+	// ListApplicationIDs returns the IDs of the user's applications for a job
+	ListApplicationIDs(ctx context.Context, userID, jobID string) ([]string, error)
+}
+
+// JobStatusHistoryRepository defines the interface for job status history data access
+type JobStatusHistoryRepository interface {
+	Create(ctx context.Context, entry *model.JobStatusHistory) error
+	ListByJob(ctx context.Context, userID, jobID string) ([]*model.JobStatusHistory, error)
 }
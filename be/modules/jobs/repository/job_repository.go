@@ -4,17 +4,75 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	"github.com/andreypavlenko/jobber/modules/jobs/model"
+	"github.com/andreypavlenko/jobber/modules/jobs/ports"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// priorityRank maps a job priority to a sortable rank (high > medium > low)
+const priorityRank = "(CASE j.priority WHEN 'high' THEN 3 WHEN 'medium' THEN 2 ELSE 1 END)"
+
+// enrichedJobColumns lists the job columns plus company name and application
+// stats; it must be paired with enrichedJobFrom and read by scanEnrichedJob
+const enrichedJobColumns = `
+			j.id,
+			j.user_id,
+			j.company_id,
+			j.title,
+			j.source,
+			j.url,
+			j.notes,
+			j.description,
+			j.status,
+			j.priority,
+			j.is_favorite,
+			j.salary_min,
+			j.salary_max,
+			j.salary_currency,
+			j.employment_type,
+			j.work_arrangement,
+			j.created_at,
+			j.updated_at,
+			c.name as company_name,
+			app_counts.applications_count,
+			active_app.stage_name as active_application_stage`
+
+// enrichedJobFrom joins the company and computes per-job application stats
+// with lateral joins, without grouping the outer query
+const enrichedJobFrom = `FROM jobs j
+		LEFT JOIN companies c ON j.company_id = c.id
+		LEFT JOIN LATERAL (
+			SELECT COUNT(*) AS applications_count
+			FROM applications a
+			WHERE a.job_id = j.id AND a.deleted_at IS NULL
+		) app_counts ON true
+		LEFT JOIN LATERAL (
+			SELECT st.name AS stage_name
+			FROM applications a
+			LEFT JOIN application_stages s ON s.id = a.current_stage_id
+			LEFT JOIN stage_templates st ON st.id = s.stage_template_id
+			WHERE a.job_id = j.id AND a.status = 'active' AND a.deleted_at IS NULL
+			ORDER BY a.applied_at DESC, a.created_at DESC
+			LIMIT 1
+		) active_app ON true`
+
 // JobRepository implements ports.JobRepository
 type JobRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 // NewJobRepository creates a new job repository
@@ -22,15 +80,27 @@ func NewJobRepository(pool *pgxpool.Pool) *JobRepository {
 	return &JobRepository{pool: pool}
 }
 
+// NewJobRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewJobRepositoryWithPool(pool DBPool) *JobRepository {
+	return &JobRepository{pool: pool}
+}
+
 // Create creates a new job
 func (r *JobRepository) Create(ctx context.Context, job *model.Job) error {
 	query := `
-		INSERT INTO jobs (id, user_id, company_id, title, source, url, notes, description, status, board_column, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		INSERT INTO jobs (id, user_id, company_id, title, source, url, notes, description, status, priority, board_column, salary_min, salary_max, salary_currency,
+			employment_type, work_arrangement, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 	`
 
 	job.ID = uuid.New().String()
 	job.Status = "active" // Always create as active
+	if job.Priority == "" {
+		job.Priority = model.PriorityMedium
+	}
+	if job.SalaryCurrency == "" {
+		job.SalaryCurrency = model.DefaultSalaryCurrency
+	}
 	now := time.Now().UTC()
 	job.CreatedAt = now
 	job.UpdatedAt = now
@@ -45,7 +115,13 @@ func (r *JobRepository) Create(ctx context.Context, job *model.Job) error {
 		job.Notes,
 		job.Description,
 		job.Status,
+		job.Priority,
 		"wishlist", // board_column kept in DB with default value
+		job.SalaryMin,
+		job.SalaryMax,
+		job.SalaryCurrency,
+		job.EmploymentType,
+		job.WorkArrangement,
 		job.CreatedAt,
 		job.UpdatedAt,
 	)
@@ -56,7 +132,8 @@ func (r *JobRepository) Create(ctx context.Context, job *model.Job) error {
 // GetByID retrieves a job by ID
 func (r *JobRepository) GetByID(ctx context.Context, userID, jobID string) (*model.Job, error) {
 	query := `
-		SELECT id, user_id, company_id, title, source, url, notes, description, status, is_favorite, created_at, updated_at
+		SELECT id, user_id, company_id, title, source, url, notes, description, status, priority, is_favorite, salary_min, salary_max, salary_currency,
+			employment_type, work_arrangement, created_at, updated_at
 		FROM jobs
 		WHERE id = $1 AND user_id = $2
 	`
@@ -72,7 +149,13 @@ func (r *JobRepository) GetByID(ctx context.Context, userID, jobID string) (*mod
 		&job.Notes,
 		&job.Description,
 		&job.Status,
+		&job.Priority,
 		&job.IsFavorite,
+		&job.SalaryMin,
+		&job.SalaryMax,
+		&job.SalaryCurrency,
+		&job.EmploymentType,
+		&job.WorkArrangement,
 		&job.CreatedAt,
 		&job.UpdatedAt,
 	)
@@ -89,7 +172,17 @@ func (r *JobRepository) GetByID(ctx context.Context, userID, jobID string) (*mod
 
 // List retrieves jobs for a user with pagination, filtering, and sorting.
 // Uses COUNT(*) OVER() to eliminate the separate count query.
-func (r *JobRepository) List(ctx context.Context, userID string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error) {
+// likeEscaper escapes the LIKE wildcards so a search term matches literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// likePattern turns a search term into an ILIKE pattern matching it anywhere
+func likePattern(term string) string {
+	return "%" + likeEscaper.Replace(term) + "%"
+}
+
+func (r *JobRepository) List(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
+	status, sortBy, sortOrder := opts.Status, opts.SortBy, opts.SortOrder
+
 	// Default to active status if not specified
 	if status == "" {
 		status = "active"
@@ -105,6 +198,26 @@ func (r *JobRepository) List(ctx context.Context, userID string, limit, offset i
 		args = append(args, status)
 		argIndex++
 	}
+	if opts.Query != "" {
+		whereClause += fmt.Sprintf(" AND (j.title ILIKE $%d OR j.notes ILIKE $%d)", argIndex, argIndex)
+		args = append(args, likePattern(opts.Query))
+		argIndex++
+	}
+	if opts.CompanyID != "" {
+		whereClause += " AND j.company_id = $" + fmt.Sprintf("%d", argIndex)
+		args = append(args, opts.CompanyID)
+		argIndex++
+	}
+	if opts.EmploymentType != "" {
+		whereClause += " AND j.employment_type = $" + fmt.Sprintf("%d", argIndex)
+		args = append(args, opts.EmploymentType)
+		argIndex++
+	}
+	if opts.WorkArrangement != "" {
+		whereClause += " AND j.work_arrangement = $" + fmt.Sprintf("%d", argIndex)
+		args = append(args, opts.WorkArrangement)
+		argIndex++
+	}
 
 	// Determine ORDER BY clause
 	orderBy := "j.created_at DESC" // default
@@ -122,6 +235,12 @@ func (r *JobRepository) List(ctx context.Context, userID string, limit, offset i
 			} else {
 				orderBy = "LOWER(j.title) DESC"
 			}
+		case "priority":
+			if sortOrder == "asc" {
+				orderBy = priorityRank + " ASC, j.created_at DESC"
+			} else {
+				orderBy = priorityRank + " DESC, j.created_at DESC"
+			}
 		case "company_name":
 			if sortOrder == "asc" {
 				orderBy = "(CASE WHEN c.name IS NULL THEN 1 ELSE 0 END), LOWER(c.name) ASC"
@@ -133,36 +252,51 @@ func (r *JobRepository) List(ctx context.Context, userID string, limit, offset i
 		}
 	}
 
+	// The window count only sees rows after the cursor, so cursor pages count separately
+	var cursorTotal int
+	if opts.Cursor != nil {
+		if sortBy == "" {
+			sortBy = "created_at"
+		}
+		col, ok := jobCursorColumns[sortBy]
+		if !ok {
+			return nil, 0, keyset.ErrUnsupportedSort
+		}
+		if err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM jobs j WHERE "+whereClause, args...).Scan(&cursorTotal); err != nil {
+			return nil, 0, err
+		}
+
+		desc := sortOrder != "asc"
+		cond, cursorArgs, err := col.Condition("j.id", desc, opts.Cursor, args)
+		if err != nil {
+			return nil, 0, err
+		}
+		if cond != "" {
+			whereClause += " AND " + cond
+			args = cursorArgs
+			argIndex = len(args) + 1
+		}
+		orderBy = col.OrderBy("j.id", desc)
+	}
+
 	// Single query with COUNT(*) OVER() for total count
 	limitPlaceholder := fmt.Sprintf("$%d", argIndex)
 	offsetPlaceholder := fmt.Sprintf("$%d", argIndex+1)
 	query := `
-		SELECT
-			j.id,
-			j.user_id,
-			j.company_id,
-			j.title,
-			j.source,
-			j.url,
-			j.notes,
-			j.description,
-			j.status,
-			j.is_favorite,
-			j.created_at,
-			j.updated_at,
-			c.name as company_name,
-			COALESCE(COUNT(a.id), 0) as applications_count,
+		SELECT` + enrichedJobColumns + `,
+			ARRAY(
+				SELECT tr.tag_id::text FROM tag_relations tr
+				WHERE tr.entity_type = 'job' AND tr.entity_id = j.id
+				ORDER BY tr.created_at
+			) as tag_ids,
 			COUNT(*) OVER() as total_count
-		FROM jobs j
-		LEFT JOIN companies c ON j.company_id = c.id
-		LEFT JOIN applications a ON j.id = a.job_id
+		` + enrichedJobFrom + `
 		WHERE ` + whereClause + `
-		GROUP BY j.id, j.user_id, j.company_id, j.title, j.source, j.url, j.notes, j.description, j.status, j.is_favorite, j.created_at, j.updated_at, c.name
 		ORDER BY ` + orderBy + `
 		LIMIT ` + limitPlaceholder + ` OFFSET ` + offsetPlaceholder + `
 	`
 
-	queryArgs := append(args, limit, offset)
+	queryArgs := append(args, opts.Limit, opts.Offset)
 	rows, err := r.pool.Query(ctx, query, queryArgs...)
 	if err != nil {
 		return nil, 0, err
@@ -172,48 +306,146 @@ func (r *JobRepository) List(ctx context.Context, userID string, limit, offset i
 	var jobs []*model.JobDTO
 	var total int
 	for rows.Next() {
-		var companyName *string
-		var applicationsCount int
-		job := &model.Job{}
-
-		if err := rows.Scan(
-			&job.ID,
-			&job.UserID,
-			&job.CompanyID,
-			&job.Title,
-			&job.Source,
-			&job.URL,
-			&job.Notes,
-			&job.Description,
-			&job.Status,
-			&job.IsFavorite,
-			&job.CreatedAt,
-			&job.UpdatedAt,
-			&companyName,
-			&applicationsCount,
-			&total,
-		); err != nil {
+		var tagIDs []string
+		dto, err := scanEnrichedJob(rows, &tagIDs, &total)
+		if err != nil {
 			return nil, 0, err
 		}
-
-		dto := job.ToDTO()
-		dto.CompanyName = companyName
-		dto.ApplicationsCount = applicationsCount
+		dto.TagIDs = tagIDs
 		jobs = append(jobs, dto)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, 0, err
 	}
+	if opts.Cursor != nil {
+		total = cursorTotal
+	}
 
 	return jobs, total, nil
 }
 
+// jobCursorColumns are the sorts usable with cursor pagination. The priority and
+// company_name sorts order by derived keys with NULL handling and stay offset-only.
+var jobCursorColumns = map[string]keyset.Column{
+	"created_at": keyset.TimeColumn("j.created_at"),
+	"title":      keyset.LowerTextColumn("j.title"),
+}
+
+// ListHighPriority retrieves the user's high-priority jobs, newest first, capped at limit rows
+func (r *JobRepository) ListHighPriority(ctx context.Context, userID string, limit int) ([]*model.JobDTO, error) {
+	query := `
+		SELECT` + enrichedJobColumns + `
+		` + enrichedJobFrom + `
+		WHERE j.user_id = $1 AND j.priority = $2
+		ORDER BY j.created_at DESC
+		LIMIT $3
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, model.PriorityHigh, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobs := []*model.JobDTO{}
+	for rows.Next() {
+		dto, err := scanEnrichedJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, dto)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// FindSimilarByTitle retrieves the user's jobs whose titles are trigram-similar
+// to title (pg_trgm), most similar first, excluding the job excludeID
+func (r *JobRepository) FindSimilarByTitle(ctx context.Context, userID, title, excludeID string, limit int) ([]*model.SimilarJobDTO, error) {
+	query := `
+		SELECT` + enrichedJobColumns + `,
+			similarity(j.title, $2) AS similarity_score
+		` + enrichedJobFrom + `
+		WHERE j.user_id = $1 AND j.id <> $3 AND j.title % $2
+		ORDER BY similarity_score DESC, j.created_at DESC
+		LIMIT $4
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, title, excludeID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobs := []*model.SimilarJobDTO{}
+	for rows.Next() {
+		var score float64
+		dto, err := scanEnrichedJob(rows, &score)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, &model.SimilarJobDTO{JobDTO: dto, SimilarityScore: score})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// scanEnrichedJob scans a row selected with enrichedJobColumns; extra
+// receives any trailing columns such as the window total count
+func scanEnrichedJob(rows pgx.Rows, extra ...interface{}) (*model.JobDTO, error) {
+	var companyName, activeStage *string
+	var applicationsCount int
+	job := &model.Job{}
+
+	dest := []interface{}{
+		&job.ID,
+		&job.UserID,
+		&job.CompanyID,
+		&job.Title,
+		&job.Source,
+		&job.URL,
+		&job.Notes,
+		&job.Description,
+		&job.Status,
+		&job.Priority,
+		&job.IsFavorite,
+		&job.SalaryMin,
+		&job.SalaryMax,
+		&job.SalaryCurrency,
+		&job.EmploymentType,
+		&job.WorkArrangement,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+		&companyName,
+		&applicationsCount,
+		&activeStage,
+	}
+	if err := rows.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	dto := job.ToDTO()
+	dto.CompanyName = companyName
+	dto.ApplicationsCount = applicationsCount
+	dto.ActiveApplicationStage = activeStage
+	return dto, nil
+}
+
 // Update updates a job
 func (r *JobRepository) Update(ctx context.Context, job *model.Job) error {
 	query := `
 		UPDATE jobs
-		SET company_id = $3, title = $4, source = $5, url = $6, notes = $7, description = $8, status = $9, updated_at = $10
+		SET company_id = $3, title = $4, source = $5, url = $6, notes = $7, description = $8, status = $9, priority = $10, updated_at = $11,
+			salary_min = $12, salary_max = $13, salary_currency = $14, employment_type = $15, work_arrangement = $16
 		WHERE id = $1 AND user_id = $2
 	`
 
@@ -229,7 +461,13 @@ func (r *JobRepository) Update(ctx context.Context, job *model.Job) error {
 		job.Notes,
 		job.Description,
 		job.Status,
+		job.Priority,
 		job.UpdatedAt,
+		job.SalaryMin,
+		job.SalaryMax,
+		job.SalaryCurrency,
+		job.EmploymentType,
+		job.WorkArrangement,
 	)
 	if err != nil {
 		return err
@@ -242,6 +480,33 @@ func (r *JobRepository) Update(ctx context.Context, job *model.Job) error {
 	return nil
 }
 
+// ListApplicationIDs returns the IDs of the user's applications for a job,
+// leaving out applications in the trash
+func (r *JobRepository) ListApplicationIDs(ctx context.Context, userID, jobID string) ([]string, error) {
+	query := `SELECT id FROM applications WHERE job_id = $1 AND user_id = $2 AND deleted_at IS NULL ORDER BY created_at`
+
+	rows, err := r.pool.Query(ctx, query, jobID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
 // ToggleFavorite toggles the favorite status of a job
 func (r *JobRepository) ToggleFavorite(ctx context.Context, userID, jobID string) (bool, error) {
 	query := `UPDATE jobs SET is_favorite = NOT is_favorite WHERE id = $1 AND user_id = $2 RETURNING is_favorite`
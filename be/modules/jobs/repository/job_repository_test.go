@@ -5,7 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/andreypavlenko/jobber/internal/platform/keyset"
 	"github.com/andreypavlenko/jobber/modules/jobs/model"
+	"github.com/andreypavlenko/jobber/modules/jobs/ports"
 	"github.com/jackc/pgx/v5"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
@@ -192,7 +194,7 @@ func TestJobRepository_List(t *testing.T) {
 			WillReturnRows(listRows)
 
 		repo := &testJobRepo{mock: mock}
-		jobs, total, err := repo.List(context.Background(), userID, 20, 0, "active", "", "")
+		jobs, total, err := repo.List(context.Background(), userID, &ports.ListOptions{Limit: 20, Status: "active"})
 
 		require.NoError(t, err)
 		assert.Len(t, jobs, 2)
@@ -201,6 +203,328 @@ func TestJobRepository_List(t *testing.T) {
 	})
 }
 
+func TestJobRepository_List_ApplicationStats(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	userID := "user-123"
+	now := time.Now()
+	companyName := "Acme"
+
+	listRows := pgxmock.NewRows([]string{
+		"id", "user_id", "company_id", "title", "source", "url", "notes", "description", "status", "priority", "is_favorite",
+		"salary_min", "salary_max", "salary_currency", "employment_type", "work_arrangement", "created_at", "updated_at", "company_name", "applications_count", "active_application_stage", "tag_ids", "total_count",
+	}).
+		AddRow("job-1", userID, nil, "Software Engineer", nil, nil, nil, nil, "active", "high", false, intPtr(90000), intPtr(120000), "EUR", strPtr("contract"), strPtr("remote"), now, now, &companyName, 3, strPtr("Technical Interview"), []string{"tag-1", "tag-2"}, 2).
+		AddRow("job-2", userID, nil, "Product Manager", nil, nil, nil, nil, "active", "medium", true, nil, nil, "USD", nil, nil, now, now, nil, 0, (*string)(nil), []string{}, 2)
+
+	mock.ExpectQuery("LEFT JOIN LATERAL").
+		WithArgs(userID, "active", 20, 0).
+		WillReturnRows(listRows)
+
+	repo := NewJobRepositoryWithPool(mock)
+	jobs, total, err := repo.List(context.Background(), userID, &ports.ListOptions{Limit: 20})
+
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, 2, total)
+
+	assert.Equal(t, 3, jobs[0].ApplicationsCount)
+	require.NotNil(t, jobs[0].ActiveApplicationStage)
+	assert.Equal(t, "Technical Interview", *jobs[0].ActiveApplicationStage)
+	assert.Equal(t, "Acme", *jobs[0].CompanyName)
+	assert.Equal(t, []string{"tag-1", "tag-2"}, jobs[0].TagIDs)
+	assert.Equal(t, 90000, *jobs[0].SalaryMin)
+	assert.Equal(t, 120000, *jobs[0].SalaryMax)
+	assert.Equal(t, "EUR", jobs[0].SalaryCurrency)
+	assert.Equal(t, strPtr("contract"), jobs[0].EmploymentType)
+	assert.Equal(t, strPtr("remote"), jobs[0].WorkArrangement)
+
+	assert.Equal(t, 0, jobs[1].ApplicationsCount)
+	assert.Nil(t, jobs[1].ActiveApplicationStage)
+	assert.Empty(t, jobs[1].TagIDs)
+	assert.Nil(t, jobs[1].SalaryMin)
+	assert.Equal(t, "USD", jobs[1].SalaryCurrency)
+	assert.Equal(t, "high", jobs[0].Priority)
+	assert.Equal(t, "medium", jobs[1].Priority)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJobRepository_List_SortByPriority(t *testing.T) {
+	tests := []struct {
+		name      string
+		sortOrder string
+		wantOrder string
+	}{
+		{"descending", "desc", "ORDER BY (CASE j.priority WHEN 'high' THEN 3 WHEN 'medium' THEN 2 ELSE 1 END) DESC, j.created_at DESC"},
+		{"ascending", "asc", "ORDER BY (CASE j.priority WHEN 'high' THEN 3 WHEN 'medium' THEN 2 ELSE 1 END) ASC, j.created_at DESC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured string
+			mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(expectedSQL, actualSQL string) error {
+				captured = actualSQL
+				return nil
+			})))
+			require.NoError(t, err)
+			defer mock.Close()
+
+			mock.ExpectQuery("SELECT").
+				WithArgs("user-123", "active", 20, 0).
+				WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+			repo := NewJobRepositoryWithPool(mock)
+			_, _, err = repo.List(context.Background(), "user-123", &ports.ListOptions{Limit: 20, SortBy: "priority", SortOrder: tt.sortOrder})
+
+			require.NoError(t, err)
+			assert.Contains(t, captured, tt.wantOrder)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestJobRepository_List_Filters(t *testing.T) {
+	var captured string
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(expectedSQL, actualSQL string) error {
+		captured = actualSQL
+		return nil
+	})))
+	require.NoError(t, err)
+	defer mock.Close()
+
+	companyID := "3f2b7c1e-8d4a-4b6e-9c1f-2a5d8e7b9c0d"
+	mock.ExpectQuery("SELECT").
+		WithArgs("user-123", "archived", `%50\% off\_sale%`, companyID, "contract", "hybrid", 20, 0).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+	repo := NewJobRepositoryWithPool(mock)
+	_, _, err = repo.List(context.Background(), "user-123", &ports.ListOptions{
+		Limit:           20,
+		Status:          "archived",
+		Query:           "50% off_sale",
+		CompanyID:       companyID,
+		EmploymentType:  "contract",
+		WorkArrangement: "hybrid",
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, captured, "j.status = $2")
+	assert.Contains(t, captured, "(j.title ILIKE $3 OR j.notes ILIKE $3)")
+	assert.Contains(t, captured, "j.company_id = $4")
+	assert.Contains(t, captured, "j.employment_type = $5")
+	assert.Contains(t, captured, "j.work_arrangement = $6")
+	assert.Contains(t, captured, "LIMIT $7 OFFSET $8")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJobRepository_List_Cursor(t *testing.T) {
+	lastID := "5b1f2f5e-8d7a-4c36-9a52-1f0f4c2e9b10"
+
+	t.Run("continues after the cursor and counts every match", func(t *testing.T) {
+		var captured []string
+		mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(_, actualSQL string) error {
+			captured = append(captured, actualSQL)
+			return nil
+		})))
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("").WithArgs("user-123", "active").WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(12))
+		mock.ExpectQuery("").
+			WithArgs("user-123", "active", "Go Developer", lastID, 21, 0).
+			WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+		repo := NewJobRepositoryWithPool(mock)
+		_, total, err := repo.List(context.Background(), "user-123", &ports.ListOptions{
+			Limit:     21,
+			SortBy:    "title",
+			SortOrder: "asc",
+			Cursor:    &keyset.Cursor{LastID: lastID, LastValue: "Go Developer"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 12, total)
+		require.Len(t, captured, 2)
+		assert.Contains(t, captured[0], "SELECT COUNT(*) FROM jobs j WHERE j.user_id = $1 AND j.status = $2")
+		assert.Contains(t, captured[1], "AND (LOWER(j.title), j.id) > (LOWER($3), $4)")
+		assert.Contains(t, captured[1], "ORDER BY LOWER(j.title) ASC, j.id ASC")
+		assert.Contains(t, captured[1], "LIMIT $5 OFFSET $6")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects sorts without a cursor key", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		repo := NewJobRepositoryWithPool(mock)
+		_, _, err = repo.List(context.Background(), "user-123", &ports.ListOptions{Limit: 21, SortBy: "priority", Cursor: &keyset.Cursor{}})
+
+		assert.ErrorIs(t, err, keyset.ErrUnsupportedSort)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestJobRepository_ListHighPriority(t *testing.T) {
+	t.Run("selects only high priority jobs newest first", func(t *testing.T) {
+		var captured string
+		mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(expectedSQL, actualSQL string) error {
+			captured = actualSQL
+			return nil
+		})))
+		require.NoError(t, err)
+		defer mock.Close()
+
+		userID := "user-123"
+		now := time.Now()
+		rows := pgxmock.NewRows([]string{
+			"id", "user_id", "company_id", "title", "source", "url", "notes", "description", "status", "priority", "is_favorite",
+			"salary_min", "salary_max", "salary_currency", "employment_type", "work_arrangement", "created_at", "updated_at", "company_name", "applications_count", "active_application_stage",
+		}).
+			AddRow("job-1", userID, nil, "Staff Engineer", nil, nil, nil, nil, "active", "high", false, nil, nil, "USD", nil, nil, now, now, nil, 1, (*string)(nil)).
+			AddRow("job-2", userID, nil, "Tech Lead", nil, nil, nil, nil, "archived", "high", false, nil, nil, "USD", nil, nil, now.Add(-time.Hour), now, nil, 0, (*string)(nil))
+
+		mock.ExpectQuery("SELECT").
+			WithArgs(userID, "high", 50).
+			WillReturnRows(rows)
+
+		repo := NewJobRepositoryWithPool(mock)
+		jobs, err := repo.ListHighPriority(context.Background(), userID, 50)
+
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, "job-1", jobs[0].ID)
+		assert.Equal(t, "high", jobs[0].Priority)
+		assert.Equal(t, 1, jobs[0].ApplicationsCount)
+		assert.Contains(t, captured, "j.priority = $2")
+		assert.Contains(t, captured, "ORDER BY j.created_at DESC")
+		assert.Contains(t, captured, "LIMIT $3")
+		assert.NotContains(t, captured, "OFFSET")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns empty slice when none match", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("SELECT").
+			WithArgs("user-123", "high", 50).
+			WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+		repo := NewJobRepositoryWithPool(mock)
+		jobs, err := repo.ListHighPriority(context.Background(), "user-123", 50)
+
+		require.NoError(t, err)
+		assert.NotNil(t, jobs)
+		assert.Empty(t, jobs)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestJobRepository_ListApplicationIDs(t *testing.T) {
+	t.Run("returns the job's live application IDs scoped to the user", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`SELECT id FROM applications WHERE job_id = \$1 AND user_id = \$2 AND deleted_at IS NULL`).
+			WithArgs("job-1", "user-123").
+			WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("app-1").AddRow("app-2"))
+
+		repo := NewJobRepositoryWithPool(mock)
+		ids, err := repo.ListApplicationIDs(context.Background(), "user-123", "job-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app-1", "app-2"}, ids)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns empty slice when the job has no applications", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("SELECT id FROM applications").
+			WithArgs("job-1", "user-123").
+			WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+		repo := NewJobRepositoryWithPool(mock)
+		ids, err := repo.ListApplicationIDs(context.Background(), "user-123", "job-1")
+
+		require.NoError(t, err)
+		assert.NotNil(t, ids)
+		assert.Empty(t, ids)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestJobRepository_FindSimilarByTitle(t *testing.T) {
+	t.Run("ranks trigram matches and excludes the source job", func(t *testing.T) {
+		var captured string
+		mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherFunc(func(expectedSQL, actualSQL string) error {
+			captured = actualSQL
+			return nil
+		})))
+		require.NoError(t, err)
+		defer mock.Close()
+
+		userID := "user-123"
+		now := time.Now()
+		rows := pgxmock.NewRows([]string{
+			"id", "user_id", "company_id", "title", "source", "url", "notes", "description", "status", "priority", "is_favorite",
+			"salary_min", "salary_max", "salary_currency", "employment_type", "work_arrangement", "created_at", "updated_at", "company_name", "applications_count", "active_application_stage", "similarity_score",
+		}).
+			AddRow("job-2", userID, nil, "Senior Backend Engineer", nil, nil, nil, nil, "active", "medium", false, nil, nil, "USD", nil, nil, now, now, nil, 0, (*string)(nil), 0.72).
+			AddRow("job-3", userID, nil, "Backend Developer", nil, nil, nil, nil, "active", "medium", false, nil, nil, "USD", nil, nil, now, now, nil, 1, (*string)(nil), 0.41)
+
+		mock.ExpectQuery("SELECT").
+			WithArgs(userID, "Backend Engineer", "job-1", 5).
+			WillReturnRows(rows)
+
+		repo := NewJobRepositoryWithPool(mock)
+		jobs, err := repo.FindSimilarByTitle(context.Background(), userID, "Backend Engineer", "job-1", 5)
+
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, "job-2", jobs[0].ID)
+		assert.InDelta(t, 0.72, jobs[0].SimilarityScore, 0.0001)
+		assert.Equal(t, 1, jobs[1].ApplicationsCount)
+		assert.Contains(t, captured, "similarity(j.title, $2)")
+		assert.Contains(t, captured, "j.id <> $3")
+		assert.Contains(t, captured, "j.title % $2")
+		assert.Contains(t, captured, "ORDER BY similarity_score DESC")
+		assert.Contains(t, captured, "LIMIT $4")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns query error", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("similarity").
+			WithArgs("user-123", "Backend Engineer", "job-1", 5).
+			WillReturnError(assert.AnError)
+
+		repo := NewJobRepositoryWithPool(mock)
+		jobs, err := repo.FindSimilarByTitle(context.Background(), "user-123", "Backend Engineer", "job-1", 5)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, jobs)
+	})
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
 // testJobRepo is a test wrapper that uses pgxmock
 type testJobRepo struct {
 	mock pgxmock.PgxPoolIface
@@ -273,15 +597,15 @@ func (r *testJobRepo) Delete(ctx context.Context, userID, jobID string) error {
 	return nil
 }
 
-func (r *testJobRepo) List(ctx context.Context, userID string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error) {
+func (r *testJobRepo) List(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
 	countQuery := `SELECT COUNT(*) FROM jobs j WHERE j.user_id = $1 AND j.status = $2`
 	var total int
-	if err := r.mock.QueryRow(ctx, countQuery, userID, status).Scan(&total); err != nil {
+	if err := r.mock.QueryRow(ctx, countQuery, userID, opts.Status).Scan(&total); err != nil {
 		return nil, 0, err
 	}
 
 	query := `SELECT ... FROM jobs j ... LIMIT $3 OFFSET $4`
-	rows, err := r.mock.Query(ctx, query, userID, status, limit, offset)
+	rows, err := r.mock.Query(ctx, query, userID, opts.Status, opts.Limit, opts.Offset)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/jobs/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// JobStatusHistoryRepository implements ports.JobStatusHistoryRepository
+type JobStatusHistoryRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewJobStatusHistoryRepository creates a new job status history repository
+func NewJobStatusHistoryRepository(pool *pgxpool.Pool) *JobStatusHistoryRepository {
+	return &JobStatusHistoryRepository{pool: pool}
+}
+
+// Create records a job status transition
+func (r *JobStatusHistoryRepository) Create(ctx context.Context, entry *model.JobStatusHistory) error {
+	query := `
+		INSERT INTO job_status_history (id, job_id, user_id, from_status, to_status, changed_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	entry.ID = uuid.New().String()
+	if entry.ChangedAt.IsZero() {
+		entry.ChangedAt = time.Now().UTC()
+	}
+
+	_, err := r.pool.Exec(ctx, query,
+		entry.ID, entry.JobID, entry.UserID, entry.FromStatus, entry.ToStatus, entry.ChangedAt,
+	)
+	return err
+}
+
+// ListByJob returns the status transitions of a job, newest first
+func (r *JobStatusHistoryRepository) ListByJob(ctx context.Context, userID, jobID string) ([]*model.JobStatusHistory, error) {
+	query := `
+		SELECT id, job_id, user_id, from_status, to_status, changed_at
+		FROM job_status_history
+		WHERE job_id = $1 AND user_id = $2
+		ORDER BY changed_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, jobID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var history []*model.JobStatusHistory
+	for rows.Next() {
+		entry := &model.JobStatusHistory{}
+		if err := rows.Scan(&entry.ID, &entry.JobID, &entry.UserID, &entry.FromStatus, &entry.ToStatus, &entry.ChangedAt); err != nil {
+			return nil, err
+		}
+		history = append(history, entry)
+	}
+	return history, rows.Err()
+}
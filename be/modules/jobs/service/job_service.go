@@ -5,6 +5,9 @@ import (
 	"log"
 	"strings"
 
+	"github.com/andreypavlenko/jobber/internal/platform/urlutil"
+	commentModel "github.com/andreypavlenko/jobber/modules/comments/model"
+	commentPorts "github.com/andreypavlenko/jobber/modules/comments/ports"
 	companyPorts "github.com/andreypavlenko/jobber/modules/companies/ports"
 	"github.com/andreypavlenko/jobber/modules/jobs/model"
 	"github.com/andreypavlenko/jobber/modules/jobs/ports"
@@ -20,21 +23,74 @@ type CacheInvalidator interface {
 	InvalidateByJob(ctx context.Context, jobID string) error
 }
 
+// ProfileInvalidator drops the cached user profile when tracked entity counts change.
+type ProfileInvalidator interface {
+	InvalidateProfile(ctx context.Context, userID string) error
+}
+
+// AnalyticsInvalidator drops the cached analytics when job data they report on changes.
+type AnalyticsInvalidator interface {
+	InvalidateAnalytics(ctx context.Context, userID string) error
+}
+
+// highPriorityLimit caps the unpaginated high-priority shortcut list
+const highPriorityLimit = 50
+
 // JobService handles job business logic
 type JobService struct {
 	repo             ports.JobRepository
 	companyRepo      companyPorts.CompanyRepository
 	limitChecker     LimitChecker
 	cacheInvalidator CacheInvalidator
+	historyRepo      ports.JobStatusHistoryRepository
+	profileCache     ProfileInvalidator
+	commentRepo      commentPorts.CommentRepository
+	analyticsCache   AnalyticsInvalidator
 }
 
-// NewJobService creates a new job service
-func NewJobService(repo ports.JobRepository, companyRepo companyPorts.CompanyRepository, limitChecker LimitChecker, cacheInvalidator CacheInvalidator) *JobService {
+// NewJobService creates a new job service. historyRepo records status
+// transitions, commentRepo notes company changes on applications,
+// profileCache is invalidated on create and delete and analyticsCache on
+// updates and delete; each may be nil.
+func NewJobService(
+	repo ports.JobRepository,
+	companyRepo companyPorts.CompanyRepository,
+	limitChecker LimitChecker,
+	cacheInvalidator CacheInvalidator,
+	historyRepo ports.JobStatusHistoryRepository,
+	commentRepo commentPorts.CommentRepository,
+	profileCache ProfileInvalidator,
+	analyticsCache AnalyticsInvalidator,
+) *JobService {
 	return &JobService{
 		repo:             repo,
 		companyRepo:      companyRepo,
 		limitChecker:     limitChecker,
 		cacheInvalidator: cacheInvalidator,
+		historyRepo:      historyRepo,
+		profileCache:     profileCache,
+		commentRepo:      commentRepo,
+		analyticsCache:   analyticsCache,
+	}
+}
+
+// invalidateProfile drops the user's cached profile counts; failures only log
+func (s *JobService) invalidateProfile(ctx context.Context, userID string) {
+	if s.profileCache == nil {
+		return
+	}
+	if err := s.profileCache.InvalidateProfile(ctx, userID); err != nil {
+		log.Printf("[WARN] profile cache invalidation failed for user=%s: %v", userID, err)
+	}
+}
+
+// invalidateAnalytics drops the user's cached analytics; failures only log
+func (s *JobService) invalidateAnalytics(ctx context.Context, userID string) {
+	if s.analyticsCache == nil {
+		return
+	}
+	if err := s.analyticsCache.InvalidateAnalytics(ctx, userID); err != nil {
+		log.Printf("[WARN] analytics cache invalidation failed for user=%s: %v", userID, err)
 	}
 }
 
@@ -59,23 +115,118 @@ func (s *JobService) Create(ctx context.Context, userID string, req *model.Creat
 		}
 	}
 
+	jobURL, err := normalizeJobURL(req.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	priority := model.PriorityMedium
+	if req.Priority != nil {
+		if !model.IsValidPriority(*req.Priority) {
+			return nil, model.ErrInvalidJobPriority
+		}
+		priority = *req.Priority
+	}
+
+	var currency string
+	if req.SalaryCurrency != nil {
+		currency = *req.SalaryCurrency
+	}
+	currency, err = validateSalary(req.SalaryMin, req.SalaryMax, currency)
+	if err != nil {
+		return nil, err
+	}
+	employmentType, err := normalizeEmploymentType(req.EmploymentType)
+	if err != nil {
+		return nil, err
+	}
+	workArrangement, err := normalizeWorkArrangement(req.WorkArrangement)
+	if err != nil {
+		return nil, err
+	}
+
 	job := &model.Job{
-		UserID:      userID,
-		CompanyID:   req.CompanyID,
-		Title:       strings.TrimSpace(req.Title),
-		Source:      req.Source,
-		URL:         req.URL,
-		Notes:       req.Notes,
-		Description: req.Description,
+		UserID:          userID,
+		CompanyID:       req.CompanyID,
+		Title:           strings.TrimSpace(req.Title),
+		Source:          req.Source,
+		URL:             jobURL,
+		Notes:           req.Notes,
+		Description:     req.Description,
+		Priority:        priority,
+		SalaryMin:       req.SalaryMin,
+		SalaryMax:       req.SalaryMax,
+		SalaryCurrency:  currency,
+		EmploymentType:  employmentType,
+		WorkArrangement: workArrangement,
 	}
 
 	if err := s.repo.Create(ctx, job); err != nil {
 		return nil, err
 	}
+	s.invalidateProfile(ctx, userID)
 
 	return job.ToDTO(), nil
 }
 
+// normalizeJobURL canonicalizes a job URL before it is stored.
+// Nil and blank values are passed through so the URL can be cleared.
+func normalizeJobURL(raw *string) (*string, error) {
+	if raw == nil || strings.TrimSpace(*raw) == "" {
+		return raw, nil
+	}
+	normalized, err := urlutil.NormalizeURL(*raw)
+	if err != nil {
+		return nil, model.ErrInvalidJobURL
+	}
+	return &normalized, nil
+}
+
+// normalizeEmploymentType validates an optional employment type; blank means none
+func normalizeEmploymentType(value *string) (*string, error) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return nil, nil
+	}
+	employmentType := strings.TrimSpace(*value)
+	if !model.IsValidEmploymentType(employmentType) {
+		return nil, model.ErrInvalidEmploymentType
+	}
+	return &employmentType, nil
+}
+
+// normalizeWorkArrangement validates an optional work arrangement; blank means none
+func normalizeWorkArrangement(value *string) (*string, error) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return nil, nil
+	}
+	arrangement := strings.TrimSpace(*value)
+	if !model.IsValidWorkArrangement(arrangement) {
+		return nil, model.ErrInvalidWorkArrangement
+	}
+	return &arrangement, nil
+}
+
+// validateSalary checks that the salary range is non-negative and ordered, and
+// returns the currency as an upper-case three-letter code, USD when blank
+func validateSalary(min, max *int, currency string) (string, error) {
+	if (min != nil && *min < 0) || (max != nil && *max < 0) || (min != nil && max != nil && *min > *max) {
+		return "", model.ErrInvalidSalaryRange
+	}
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return model.DefaultSalaryCurrency, nil
+	}
+	if len(currency) != 3 {
+		return "", model.ErrInvalidSalaryCurrency
+	}
+	for _, r := range currency {
+		if r < 'A' || r > 'Z' {
+			return "", model.ErrInvalidSalaryCurrency
+		}
+	}
+	return currency, nil
+}
+
 // GetByID retrieves a job by ID
 func (s *JobService) GetByID(ctx context.Context, userID, jobID string) (*model.JobDTO, error) {
 	job, err := s.repo.GetByID(ctx, userID, jobID)
@@ -86,8 +237,13 @@ func (s *JobService) GetByID(ctx context.Context, userID, jobID string) (*model.
 }
 
 // List retrieves jobs for a user with pagination, filtering, and sorting
-func (s *JobService) List(ctx context.Context, userID string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error) {
-	return s.repo.List(ctx, userID, limit, offset, status, sortBy, sortOrder)
+func (s *JobService) List(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
+	return s.repo.List(ctx, userID, opts)
+}
+
+// ListHighPriority retrieves the user's most recent high-priority jobs
+func (s *JobService) ListHighPriority(ctx context.Context, userID string) ([]*model.JobDTO, error) {
+	return s.repo.ListHighPriority(ctx, userID, highPriorityLimit)
 }
 
 // Update updates a job
@@ -118,7 +274,11 @@ func (s *JobService) Update(ctx context.Context, userID, jobID string, req *mode
 		job.Source = req.Source
 	}
 	if req.URL != nil {
-		job.URL = req.URL
+		jobURL, err := normalizeJobURL(req.URL)
+		if err != nil {
+			return nil, err
+		}
+		job.URL = jobURL
 	}
 	if req.Notes != nil {
 		job.Notes = req.Notes
@@ -132,6 +292,7 @@ func (s *JobService) Update(ctx context.Context, userID, jobID string, req *mode
 		descriptionChanged = *req.Description != oldDesc
 		job.Description = req.Description
 	}
+	previousStatus := job.Status
 	if req.Status != nil {
 		// Validate status
 		if *req.Status != "active" && *req.Status != "archived" {
@@ -139,10 +300,52 @@ func (s *JobService) Update(ctx context.Context, userID, jobID string, req *mode
 		}
 		job.Status = *req.Status
 	}
+	if req.Priority != nil {
+		if !model.IsValidPriority(*req.Priority) {
+			return nil, model.ErrInvalidJobPriority
+		}
+		job.Priority = *req.Priority
+	}
+	if req.SalaryMin != nil {
+		job.SalaryMin = req.SalaryMin
+	}
+	if req.SalaryMax != nil {
+		job.SalaryMax = req.SalaryMax
+	}
+	if req.SalaryCurrency != nil {
+		job.SalaryCurrency = *req.SalaryCurrency
+	}
+	if job.SalaryCurrency, err = validateSalary(job.SalaryMin, job.SalaryMax, job.SalaryCurrency); err != nil {
+		return nil, err
+	}
+	if req.EmploymentType != nil {
+		if job.EmploymentType, err = normalizeEmploymentType(req.EmploymentType); err != nil {
+			return nil, err
+		}
+	}
+	if req.WorkArrangement != nil {
+		if job.WorkArrangement, err = normalizeWorkArrangement(req.WorkArrangement); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := s.repo.Update(ctx, job); err != nil {
 		return nil, err
 	}
+	s.invalidateAnalytics(ctx, userID)
+
+	// Record status transition
+	if job.Status != previousStatus && s.historyRepo != nil {
+		entry := &model.JobStatusHistory{
+			JobID:      jobID,
+			UserID:     userID,
+			FromStatus: previousStatus,
+			ToStatus:   job.Status,
+		}
+		if err := s.historyRepo.Create(ctx, entry); err != nil {
+			log.Printf("[WARN] failed to record status history for job=%s: %v", jobID, err)
+		}
+	}
 
 	// Invalidate match-score cache when description changes
 	if descriptionChanged && s.cacheInvalidator != nil {
@@ -154,6 +357,92 @@ func (s *JobService) Update(ctx context.Context, userID, jobID string, req *mode
 	return job.ToDTO(), nil
 }
 
+// UpdateCompany reassigns a job to another of the user's companies and notes
+// the change on every application for the job
+func (s *JobService) UpdateCompany(ctx context.Context, userID, jobID, companyID string) (*model.JobDTO, error) {
+	job, err := s.repo.GetByID(ctx, userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	company, err := s.companyRepo.GetByID(ctx, userID, companyID)
+	if err != nil {
+		return nil, model.ErrCompanyNotFound
+	}
+
+	if job.CompanyID != nil && *job.CompanyID == companyID {
+		dto := job.ToDTO()
+		dto.CompanyName = &company.Name
+		return dto, nil
+	}
+
+	content := "Company changed to " + company.Name
+	if job.CompanyID != nil && *job.CompanyID != "" {
+		if previous, err := s.companyRepo.GetByID(ctx, userID, *job.CompanyID); err == nil {
+			content = "Company changed from " + previous.Name + " to " + company.Name
+		}
+	}
+
+	job.CompanyID = &companyID
+	if err := s.repo.Update(ctx, job); err != nil {
+		return nil, err
+	}
+	s.invalidateAnalytics(ctx, userID)
+
+	s.commentOnApplications(ctx, userID, jobID, content)
+
+	dto := job.ToDTO()
+	dto.CompanyName = &company.Name
+	return dto, nil
+}
+
+// commentOnApplications adds content as a comment on each of the job's
+// applications; failures only log so the job update still succeeds
+func (s *JobService) commentOnApplications(ctx context.Context, userID, jobID, content string) {
+	if s.commentRepo == nil {
+		return
+	}
+
+	appIDs, err := s.repo.ListApplicationIDs(ctx, userID, jobID)
+	if err != nil {
+		log.Printf("[WARN] failed to list applications for job=%s: %v", jobID, err)
+		return
+	}
+
+	for _, appID := range appIDs {
+		comment := &commentModel.Comment{
+			UserID:        userID,
+			ApplicationID: appID,
+			Content:       content,
+		}
+		if err := s.commentRepo.Create(ctx, comment); err != nil {
+			log.Printf("[WARN] failed to comment company change on application=%s: %v", appID, err)
+		}
+	}
+}
+
+// ListStatusHistory returns the status transitions of a job, newest first
+func (s *JobService) ListStatusHistory(ctx context.Context, userID, jobID string) ([]*model.JobStatusHistoryDTO, error) {
+	// Verify the job exists and belongs to the user
+	if _, err := s.repo.GetByID(ctx, userID, jobID); err != nil {
+		return nil, err
+	}
+
+	dtos := []*model.JobStatusHistoryDTO{}
+	if s.historyRepo == nil {
+		return dtos, nil
+	}
+
+	history, err := s.historyRepo.ListByJob(ctx, userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range history {
+		dtos = append(dtos, entry.ToDTO())
+	}
+	return dtos, nil
+}
+
 // ToggleFavorite toggles the favorite status of a job
 func (s *JobService) ToggleFavorite(ctx context.Context, userID, jobID string) (bool, error) {
 	return s.repo.ToggleFavorite(ctx, userID, jobID)
@@ -168,5 +457,10 @@ func (s *JobService) Delete(ctx context.Context, userID, jobID string) error {
 		}
 	}
 
-	return s.repo.Delete(ctx, userID, jobID)
+	if err := s.repo.Delete(ctx, userID, jobID); err != nil {
+		return err
+	}
+	s.invalidateProfile(ctx, userID)
+	s.invalidateAnalytics(ctx, userID)
+	return nil
 }
@@ -6,9 +6,11 @@ import (
 	"testing"
 	"time"
 
+	commentModel "github.com/andreypavlenko/jobber/modules/comments/model"
 	companyModel "github.com/andreypavlenko/jobber/modules/companies/model"
 	companyPorts "github.com/andreypavlenko/jobber/modules/companies/ports"
 	"github.com/andreypavlenko/jobber/modules/jobs/model"
+	"github.com/andreypavlenko/jobber/modules/jobs/ports"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -45,6 +47,18 @@ func (m *MockCompanyRepository) GetRelatedJobsAndApplicationsCount(ctx context.C
 func (m *MockCompanyRepository) ToggleFavorite(ctx context.Context, userID, companyID string) (bool, error) {
 	return false, nil
 }
+func (m *MockCompanyRepository) UpdateLogoURL(ctx context.Context, userID, companyID string, logoURL *string) error {
+	return nil
+}
+func (m *MockCompanyRepository) GetByName(ctx context.Context, userID, name string) (*companyModel.Company, error) {
+	return nil, companyModel.ErrCompanyNotFound
+}
+func (m *MockCompanyRepository) ListSimilarPairs(ctx context.Context, userID string, threshold float64) ([]*companyModel.SimilarCompanyPair, error) {
+	return nil, nil
+}
+func (m *MockCompanyRepository) Merge(ctx context.Context, userID, intoID string, fromIDs []string) error {
+	return nil
+}
 
 var defaultMockCompanyRepo = &MockCompanyRepository{}
 
@@ -52,10 +66,13 @@ var defaultMockCompanyRepo = &MockCompanyRepository{}
 type MockJobRepository struct {
 	CreateFunc         func(ctx context.Context, job *model.Job) error
 	GetByIDFunc        func(ctx context.Context, userID, jobID string) (*model.Job, error)
-	ListFunc           func(ctx context.Context, userID string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error)
+	ListFunc           func(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.JobDTO, int, error)
 	UpdateFunc         func(ctx context.Context, job *model.Job) error
 	DeleteFunc         func(ctx context.Context, userID, jobID string) error
 	ToggleFavoriteFunc func(ctx context.Context, userID, jobID string) (bool, error)
+	ListHighPriorityFunc func(ctx context.Context, userID string, limit int) ([]*model.JobDTO, error)
+	FindSimilarByTitleFunc func(ctx context.Context, userID, title, excludeID string, limit int) ([]*model.SimilarJobDTO, error)
+	ListApplicationIDsFunc func(ctx context.Context, userID, jobID string) ([]string, error)
 }
 
 func (m *MockJobRepository) Create(ctx context.Context, job *model.Job) error {
@@ -72,9 +89,9 @@ func (m *MockJobRepository) GetByID(ctx context.Context, userID, jobID string) (
 	return nil, nil
 }
 
-func (m *MockJobRepository) List(ctx context.Context, userID string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error) {
+func (m *MockJobRepository) List(ctx context.Context, userID string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
 	if m.ListFunc != nil {
-		return m.ListFunc(ctx, userID, limit, offset, status, sortBy, sortOrder)
+		return m.ListFunc(ctx, userID, opts)
 	}
 	return nil, 0, nil
 }
@@ -100,6 +117,27 @@ func (m *MockJobRepository) ToggleFavorite(ctx context.Context, userID, jobID st
 	return false, nil
 }
 
+func (m *MockJobRepository) ListHighPriority(ctx context.Context, userID string, limit int) ([]*model.JobDTO, error) {
+	if m.ListHighPriorityFunc != nil {
+		return m.ListHighPriorityFunc(ctx, userID, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockJobRepository) FindSimilarByTitle(ctx context.Context, userID, title, excludeID string, limit int) ([]*model.SimilarJobDTO, error) {
+	if m.FindSimilarByTitleFunc != nil {
+		return m.FindSimilarByTitleFunc(ctx, userID, title, excludeID, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockJobRepository) ListApplicationIDs(ctx context.Context, userID, jobID string) ([]string, error) {
+	if m.ListApplicationIDsFunc != nil {
+		return m.ListApplicationIDsFunc(ctx, userID, jobID)
+	}
+	return nil, nil
+}
+
 func TestJobService_Create(t *testing.T) {
 	userID := "user-123"
 
@@ -114,7 +152,7 @@ func TestJobService_Create(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		req := &model.CreateJobRequest{
 			Title: "Software Engineer",
 		}
@@ -128,7 +166,7 @@ func TestJobService_Create(t *testing.T) {
 
 	t.Run("returns error for empty title", func(t *testing.T) {
 		mockRepo := &MockJobRepository{}
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		req := &model.CreateJobRequest{Title: "   "}
 
 		result, err := svc.Create(context.Background(), userID, req)
@@ -148,7 +186,7 @@ func TestJobService_Create(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		req := &model.CreateJobRequest{Title: "  Software Engineer  "}
 
 		_, err := svc.Create(context.Background(), userID, req)
@@ -172,7 +210,7 @@ func TestJobService_Create(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		req := &model.CreateJobRequest{
 			Title:     "Software Engineer",
 			CompanyID: &companyID,
@@ -199,7 +237,7 @@ func TestJobService_Create(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		req := &model.CreateJobRequest{Title: "Software Engineer"}
 
 		result, err := svc.Create(context.Background(), userID, req)
@@ -231,7 +269,7 @@ func TestJobService_GetByID(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		result, err := svc.GetByID(context.Background(), userID, jobID)
 
 		require.NoError(t, err)
@@ -246,7 +284,7 @@ func TestJobService_GetByID(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		result, err := svc.GetByID(context.Background(), userID, jobID)
 
 		assert.Nil(t, result)
@@ -264,16 +302,16 @@ func TestJobService_List(t *testing.T) {
 		}
 
 		mockRepo := &MockJobRepository{
-			ListFunc: func(ctx context.Context, uid string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error) {
+			ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
 				assert.Equal(t, userID, uid)
-				assert.Equal(t, 20, limit)
-				assert.Equal(t, 0, offset)
+				assert.Equal(t, 20, opts.Limit)
+				assert.Equal(t, 0, opts.Offset)
 				return expectedJobs, 2, nil
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
-		result, total, err := svc.List(context.Background(), userID, 20, 0, "active", "", "")
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		result, total, err := svc.List(context.Background(), userID, &ports.ListOptions{Limit: 20, Status: "active"})
 
 		require.NoError(t, err)
 		assert.Len(t, result, 2)
@@ -282,13 +320,13 @@ func TestJobService_List(t *testing.T) {
 
 	t.Run("returns empty list", func(t *testing.T) {
 		mockRepo := &MockJobRepository{
-			ListFunc: func(ctx context.Context, uid string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error) {
+			ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
 				return []*model.JobDTO{}, 0, nil
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
-		result, total, err := svc.List(context.Background(), userID, 20, 0, "active", "", "")
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		result, total, err := svc.List(context.Background(), userID, &ports.ListOptions{Limit: 20, Status: "active"})
 
 		require.NoError(t, err)
 		assert.Empty(t, result)
@@ -297,15 +335,15 @@ func TestJobService_List(t *testing.T) {
 
 	t.Run("passes sort parameters", func(t *testing.T) {
 		mockRepo := &MockJobRepository{
-			ListFunc: func(ctx context.Context, uid string, limit, offset int, status, sortBy, sortOrder string) ([]*model.JobDTO, int, error) {
-				assert.Equal(t, "title", sortBy)
-				assert.Equal(t, "asc", sortOrder)
+			ListFunc: func(ctx context.Context, uid string, opts *ports.ListOptions) ([]*model.JobDTO, int, error) {
+				assert.Equal(t, "title", opts.SortBy)
+				assert.Equal(t, "asc", opts.SortOrder)
 				return []*model.JobDTO{}, 0, nil
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
-		_, _, err := svc.List(context.Background(), userID, 20, 0, "active", "title", "asc")
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		_, _, err := svc.List(context.Background(), userID, &ports.ListOptions{Limit: 20, Status: "active", SortBy: "title", SortOrder: "asc"})
 
 		require.NoError(t, err)
 	})
@@ -334,7 +372,7 @@ func TestJobService_Update(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		newTitle := "New Title"
 		req := &model.UpdateJobRequest{Title: &newTitle}
 
@@ -358,7 +396,7 @@ func TestJobService_Update(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		emptyTitle := "   "
 		req := &model.UpdateJobRequest{Title: &emptyTitle}
 
@@ -382,7 +420,7 @@ func TestJobService_Update(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		invalidStatus := "invalid-status"
 		req := &model.UpdateJobRequest{Status: &invalidStatus}
 
@@ -392,6 +430,50 @@ func TestJobService_Update(t *testing.T) {
 		assert.Equal(t, model.ErrInvalidJobStatus, err)
 	})
 
+	t.Run("returns error for invalid priority", func(t *testing.T) {
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return &model.Job{ID: jobID, UserID: userID, Title: "Job Title", Status: "active", Priority: model.PriorityMedium}, nil
+			},
+			UpdateFunc: func(ctx context.Context, job *model.Job) error {
+				t.Fatal("Update should not be called for an invalid priority")
+				return nil
+			},
+		}
+
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		invalidPriority := "urgent"
+		req := &model.UpdateJobRequest{Priority: &invalidPriority}
+
+		result, err := svc.Update(context.Background(), userID, jobID, req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, model.ErrInvalidJobPriority, err)
+	})
+
+	t.Run("updates priority", func(t *testing.T) {
+		var updated *model.Job
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return &model.Job{ID: jobID, UserID: userID, Title: "Job Title", Status: "active", Priority: model.PriorityMedium}, nil
+			},
+			UpdateFunc: func(ctx context.Context, job *model.Job) error {
+				updated = job
+				return nil
+			},
+		}
+
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+		high := model.PriorityHigh
+		req := &model.UpdateJobRequest{Priority: &high}
+
+		result, err := svc.Update(context.Background(), userID, jobID, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.PriorityHigh, result.Priority)
+		assert.Equal(t, model.PriorityHigh, updated.Priority)
+	})
+
 	t.Run("allows valid status update", func(t *testing.T) {
 		existingJob := &model.Job{
 			ID:     jobID,
@@ -409,7 +491,7 @@ func TestJobService_Update(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		newStatus := "archived"
 		req := &model.UpdateJobRequest{Status: &newStatus}
 
@@ -426,7 +508,7 @@ func TestJobService_Update(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		newTitle := "New Title"
 		req := &model.UpdateJobRequest{Title: &newTitle}
 
@@ -451,7 +533,7 @@ func TestJobService_Delete(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		err := svc.Delete(context.Background(), userID, jobID)
 
 		require.NoError(t, err)
@@ -465,7 +547,7 @@ func TestJobService_Delete(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		err := svc.Delete(context.Background(), userID, jobID)
 
 		assert.Equal(t, model.ErrJobNotFound, err)
@@ -510,7 +592,7 @@ func TestJobService_Update_CacheInvalidation(t *testing.T) {
 			UpdateFunc: func(ctx context.Context, job *model.Job) error { return nil },
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, cache)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, cache, nil, nil, nil, nil)
 		desc := "New description"
 		req := &model.UpdateJobRequest{Description: &desc}
 
@@ -530,7 +612,7 @@ func TestJobService_Update_CacheInvalidation(t *testing.T) {
 			UpdateFunc: func(ctx context.Context, job *model.Job) error { return nil },
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, cache)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, cache, nil, nil, nil, nil)
 		newTitle := "New Title"
 		req := &model.UpdateJobRequest{Title: &newTitle}
 
@@ -553,7 +635,7 @@ func TestJobService_Update_CacheInvalidation(t *testing.T) {
 			UpdateFunc: func(ctx context.Context, job *model.Job) error { return nil },
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, cache)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, cache, nil, nil, nil, nil)
 		desc := "New description"
 		req := &model.UpdateJobRequest{Description: &desc}
 
@@ -574,7 +656,7 @@ func TestJobService_Delete_CacheInvalidation(t *testing.T) {
 			DeleteFunc: func(ctx context.Context, uid, jid string) error { return nil },
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, cache)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, cache, nil, nil, nil, nil)
 		err := svc.Delete(context.Background(), userID, jobID)
 
 		require.NoError(t, err)
@@ -592,7 +674,7 @@ func TestJobService_Delete_CacheInvalidation(t *testing.T) {
 			DeleteFunc: func(ctx context.Context, uid, jid string) error { return nil },
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, cache)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, cache, nil, nil, nil, nil)
 		err := svc.Delete(context.Background(), userID, jobID)
 
 		require.NoError(t, err)
@@ -619,7 +701,7 @@ func TestJobService_Create_LimitChecker(t *testing.T) {
 			},
 		}
 		mockRepo := &MockJobRepository{}
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, lc, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, lc, nil, nil, nil, nil, nil)
 
 		result, err := svc.Create(context.Background(), "user-123", &model.CreateJobRequest{Title: "Test"})
 
@@ -641,7 +723,7 @@ func TestJobService_Create_LimitChecker(t *testing.T) {
 				return nil
 			},
 		}
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, lc, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, lc, nil, nil, nil, nil, nil)
 
 		result, err := svc.Create(context.Background(), "user-123", &model.CreateJobRequest{Title: "Test"})
 
@@ -659,7 +741,7 @@ func TestJobService_Create_CompanyValidation(t *testing.T) {
 			},
 		}
 		mockRepo := &MockJobRepository{}
-		svc := NewJobService(mockRepo, companyRepo, nil, nil)
+		svc := NewJobService(mockRepo, companyRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := svc.Create(context.Background(), "user-123", &model.CreateJobRequest{
 			Title:     "Test",
@@ -678,7 +760,7 @@ func TestJobService_Create_CompanyValidation(t *testing.T) {
 				return nil
 			},
 		}
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := svc.Create(context.Background(), "user-123", &model.CreateJobRequest{
 			Title:     "Test",
@@ -712,7 +794,7 @@ func TestJobService_Update_CompanyValidation(t *testing.T) {
 				return existingJob, nil
 			},
 		}
-		svc := NewJobService(mockRepo, companyRepo, nil, nil)
+		svc := NewJobService(mockRepo, companyRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{
 			CompanyID: &companyID,
@@ -738,7 +820,7 @@ func TestJobService_Update_CompanyValidation(t *testing.T) {
 				return nil
 			},
 		}
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{
 			CompanyID: &emptyCompanyID,
@@ -770,7 +852,7 @@ func TestJobService_Update_CompanyValidation(t *testing.T) {
 				return nil
 			},
 		}
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{
 			Source:      &source,
@@ -802,7 +884,7 @@ func TestJobService_Update_CompanyValidation(t *testing.T) {
 				return errors.New("update failed")
 			},
 		}
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 
 		newTitle := "New Title"
 		result, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{
@@ -814,6 +896,195 @@ func TestJobService_Update_CompanyValidation(t *testing.T) {
 	})
 }
 
+// MockCommentRepository implements commentPorts.CommentRepository for testing
+type MockCommentRepository struct {
+	CreateFunc func(ctx context.Context, comment *commentModel.Comment) error
+}
+
+func (m *MockCommentRepository) Create(ctx context.Context, comment *commentModel.Comment) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, comment)
+	}
+	return nil
+}
+func (m *MockCommentRepository) ListByApplication(ctx context.Context, appID, sortDir string, userID ...string) ([]*commentModel.Comment, error) {
+	return nil, nil
+}
+func (m *MockCommentRepository) Delete(ctx context.Context, userID, commentID string) error {
+	return nil
+}
+func (m *MockCommentRepository) CountByStage(ctx context.Context, stageIDs []string) (map[string]int, error) {
+	return nil, nil
+}
+
+func (m *MockCommentRepository) SetPinned(ctx context.Context, userID, commentID string, pinned bool) (*commentModel.Comment, error) {
+	return nil, commentModel.ErrCommentNotFound
+}
+
+func TestJobService_UpdateCompany(t *testing.T) {
+	userID := "user-123"
+	jobID := "job-1"
+	oldCompanyID := "company-old"
+	newCompanyID := "company-new"
+
+	companies := map[string]string{oldCompanyID: "Acme", newCompanyID: "Globex"}
+	ownedCompanies := &MockCompanyRepository{
+		GetByIDFunc: func(_ context.Context, uid, cid string) (*companyModel.Company, error) {
+			name, ok := companies[cid]
+			if !ok || uid != userID {
+				return nil, errors.New("company not found")
+			}
+			return &companyModel.Company{ID: cid, UserID: uid, Name: name}, nil
+		},
+	}
+
+	newJobRepo := func() (*MockJobRepository, **model.Job) {
+		var updated *model.Job
+		repo := &MockJobRepository{
+			GetByIDFunc: func(_ context.Context, uid, jid string) (*model.Job, error) {
+				if uid != userID {
+					return nil, model.ErrJobNotFound
+				}
+				companyID := oldCompanyID
+				return &model.Job{ID: jid, UserID: uid, CompanyID: &companyID, Title: "Engineer", Status: "active"}, nil
+			},
+			UpdateFunc: func(_ context.Context, job *model.Job) error {
+				updated = job
+				return nil
+			},
+			ListApplicationIDsFunc: func(_ context.Context, _, _ string) ([]string, error) {
+				return []string{"app-1", "app-2"}, nil
+			},
+		}
+		return repo, &updated
+	}
+
+	t.Run("reassigns the job and comments on every application", func(t *testing.T) {
+		jobRepo, updated := newJobRepo()
+		var comments []*commentModel.Comment
+		commentRepo := &MockCommentRepository{
+			CreateFunc: func(_ context.Context, comment *commentModel.Comment) error {
+				comments = append(comments, comment)
+				return nil
+			},
+		}
+		svc := NewJobService(jobRepo, ownedCompanies, nil, nil, nil, commentRepo, nil, nil)
+
+		result, err := svc.UpdateCompany(context.Background(), userID, jobID, newCompanyID)
+
+		require.NoError(t, err)
+		require.NotNil(t, *updated)
+		assert.Equal(t, newCompanyID, *(*updated).CompanyID)
+		assert.Equal(t, newCompanyID, *result.CompanyID)
+		assert.Equal(t, "Globex", *result.CompanyName)
+		require.Len(t, comments, 2)
+		assert.Equal(t, "app-1", comments[0].ApplicationID)
+		assert.Equal(t, "app-2", comments[1].ApplicationID)
+		for _, c := range comments {
+			assert.Equal(t, userID, c.UserID)
+			assert.Nil(t, c.StageID)
+			assert.Equal(t, "Company changed from Acme to Globex", c.Content)
+		}
+	})
+
+	t.Run("omits the previous company when the job had none", func(t *testing.T) {
+		jobRepo, _ := newJobRepo()
+		jobRepo.GetByIDFunc = func(_ context.Context, uid, jid string) (*model.Job, error) {
+			return &model.Job{ID: jid, UserID: uid, Title: "Engineer", Status: "active"}, nil
+		}
+		var contents []string
+		commentRepo := &MockCommentRepository{
+			CreateFunc: func(_ context.Context, comment *commentModel.Comment) error {
+				contents = append(contents, comment.Content)
+				return nil
+			},
+		}
+		svc := NewJobService(jobRepo, ownedCompanies, nil, nil, nil, commentRepo, nil, nil)
+
+		_, err := svc.UpdateCompany(context.Background(), userID, jobID, newCompanyID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Company changed to Globex", "Company changed to Globex"}, contents)
+	})
+
+	t.Run("returns ErrCompanyNotFound for another user's company", func(t *testing.T) {
+		jobRepo, updated := newJobRepo()
+		commentRepo := &MockCommentRepository{
+			CreateFunc: func(_ context.Context, _ *commentModel.Comment) error {
+				t.Fatal("no comment expected")
+				return nil
+			},
+		}
+		svc := NewJobService(jobRepo, ownedCompanies, nil, nil, nil, commentRepo, nil, nil)
+
+		result, err := svc.UpdateCompany(context.Background(), userID, jobID, "company-foreign")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrCompanyNotFound)
+		assert.Nil(t, *updated)
+	})
+
+	t.Run("returns ErrJobNotFound for another user's job", func(t *testing.T) {
+		jobRepo, updated := newJobRepo()
+		svc := NewJobService(jobRepo, ownedCompanies, nil, nil, nil, nil, nil, nil)
+
+		result, err := svc.UpdateCompany(context.Background(), "user-other", jobID, newCompanyID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrJobNotFound)
+		assert.Nil(t, *updated)
+	})
+
+	t.Run("is a no-op when the company is unchanged", func(t *testing.T) {
+		jobRepo, updated := newJobRepo()
+		commentRepo := &MockCommentRepository{
+			CreateFunc: func(_ context.Context, _ *commentModel.Comment) error {
+				t.Fatal("no comment expected")
+				return nil
+			},
+		}
+		svc := NewJobService(jobRepo, ownedCompanies, nil, nil, nil, commentRepo, nil, nil)
+
+		result, err := svc.UpdateCompany(context.Background(), userID, jobID, oldCompanyID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Acme", *result.CompanyName)
+		assert.Nil(t, *updated)
+	})
+
+	t.Run("comment failures do not fail the update", func(t *testing.T) {
+		jobRepo, updated := newJobRepo()
+		attempts := 0
+		commentRepo := &MockCommentRepository{
+			CreateFunc: func(_ context.Context, _ *commentModel.Comment) error {
+				attempts++
+				return errors.New("db down")
+			},
+		}
+		svc := NewJobService(jobRepo, ownedCompanies, nil, nil, nil, commentRepo, nil, nil)
+
+		result, err := svc.UpdateCompany(context.Background(), userID, jobID, newCompanyID)
+
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotNil(t, *updated)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("returns error when repo update fails", func(t *testing.T) {
+		jobRepo, _ := newJobRepo()
+		jobRepo.UpdateFunc = func(_ context.Context, _ *model.Job) error {
+			return errors.New("update failed")
+		}
+		svc := NewJobService(jobRepo, ownedCompanies, nil, nil, nil, &MockCommentRepository{}, nil, nil)
+
+		result, err := svc.UpdateCompany(context.Background(), userID, jobID, newCompanyID)
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+}
+
 func TestJob_ToDTO(t *testing.T) {
 	now := time.Now()
 	companyID := "company-1"
@@ -855,7 +1126,7 @@ func TestJobService_ToggleFavorite(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		result, err := svc.ToggleFavorite(context.Background(), userID, jobID)
 
 		require.NoError(t, err)
@@ -869,7 +1140,7 @@ func TestJobService_ToggleFavorite(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		result, err := svc.ToggleFavorite(context.Background(), userID, jobID)
 
 		require.NoError(t, err)
@@ -883,9 +1154,580 @@ func TestJobService_ToggleFavorite(t *testing.T) {
 			},
 		}
 
-		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil)
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
 		_, err := svc.ToggleFavorite(context.Background(), userID, jobID)
 
 		assert.ErrorIs(t, err, model.ErrJobNotFound)
 	})
 }
+
+func TestJobService_URLNormalization(t *testing.T) {
+	userID := "user-123"
+	jobID := "job-1"
+
+	t.Run("normalizes URL on create", func(t *testing.T) {
+		var createdJob *model.Job
+		mockRepo := &MockJobRepository{
+			CreateFunc: func(_ context.Context, job *model.Job) error {
+				createdJob = job
+				return nil
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+
+		rawURL := "HTTPS://www.LinkedIn.com/jobs/123/?utm_source=newsletter"
+		_, err := svc.Create(context.Background(), userID, &model.CreateJobRequest{
+			Title: "Engineer",
+			URL:   &rawURL,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, createdJob.URL)
+		assert.Equal(t, "https://linkedin.com/jobs/123", *createdJob.URL)
+	})
+
+	t.Run("returns error for invalid URL on create", func(t *testing.T) {
+		mockRepo := &MockJobRepository{
+			CreateFunc: func(_ context.Context, _ *model.Job) error {
+				t.Fatal("Create should not be called")
+				return nil
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+
+		rawURL := "ftp://example.com/job"
+		result, err := svc.Create(context.Background(), userID, &model.CreateJobRequest{
+			Title: "Engineer",
+			URL:   &rawURL,
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrInvalidJobURL)
+	})
+
+	t.Run("normalizes URL on update", func(t *testing.T) {
+		var updatedJob *model.Job
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(_ context.Context, _, _ string) (*model.Job, error) {
+				return &model.Job{ID: jobID, UserID: userID, Title: "Engineer", Status: "active"}, nil
+			},
+			UpdateFunc: func(_ context.Context, job *model.Job) error {
+				updatedJob = job
+				return nil
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+
+		rawURL := "www.example.com/careers/42/"
+		_, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{URL: &rawURL})
+
+		require.NoError(t, err)
+		require.NotNil(t, updatedJob.URL)
+		assert.Equal(t, "https://example.com/careers/42", *updatedJob.URL)
+	})
+
+	t.Run("allows clearing URL with empty string", func(t *testing.T) {
+		var updatedJob *model.Job
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(_ context.Context, _, _ string) (*model.Job, error) {
+				return &model.Job{ID: jobID, UserID: userID, Title: "Engineer", Status: "active"}, nil
+			},
+			UpdateFunc: func(_ context.Context, job *model.Job) error {
+				updatedJob = job
+				return nil
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+
+		emptyURL := ""
+		_, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{URL: &emptyURL})
+
+		require.NoError(t, err)
+		require.NotNil(t, updatedJob.URL)
+		assert.Equal(t, "", *updatedJob.URL)
+	})
+}
+
+func TestJobService_Salary(t *testing.T) {
+	userID := "user-123"
+	jobID := "job-1"
+	intPtr := func(i int) *int { return &i }
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("stores the salary range and defaults the currency", func(t *testing.T) {
+		var createdJob *model.Job
+		mockRepo := &MockJobRepository{
+			CreateFunc: func(_ context.Context, job *model.Job) error {
+				createdJob = job
+				return nil
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+
+		result, err := svc.Create(context.Background(), userID, &model.CreateJobRequest{
+			Title:     "Engineer",
+			SalaryMin: intPtr(90000),
+			SalaryMax: intPtr(120000),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 90000, *createdJob.SalaryMin)
+		assert.Equal(t, 120000, *createdJob.SalaryMax)
+		assert.Equal(t, "USD", createdJob.SalaryCurrency)
+		assert.Equal(t, "USD", result.SalaryCurrency)
+	})
+
+	t.Run("normalizes the currency code", func(t *testing.T) {
+		var createdJob *model.Job
+		mockRepo := &MockJobRepository{
+			CreateFunc: func(_ context.Context, job *model.Job) error {
+				createdJob = job
+				return nil
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.Create(context.Background(), userID, &model.CreateJobRequest{Title: "Engineer", SalaryCurrency: strPtr(" eur ")})
+
+		require.NoError(t, err)
+		assert.Equal(t, "EUR", createdJob.SalaryCurrency)
+	})
+
+	t.Run("rejects invalid salaries on create", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			req       *model.CreateJobRequest
+			expectErr error
+		}{
+			{name: "negative minimum", req: &model.CreateJobRequest{Title: "Engineer", SalaryMin: intPtr(-1)}, expectErr: model.ErrInvalidSalaryRange},
+			{name: "minimum above maximum", req: &model.CreateJobRequest{Title: "Engineer", SalaryMin: intPtr(200), SalaryMax: intPtr(100)}, expectErr: model.ErrInvalidSalaryRange},
+			{name: "currency too long", req: &model.CreateJobRequest{Title: "Engineer", SalaryCurrency: strPtr("EURO")}, expectErr: model.ErrInvalidSalaryCurrency},
+			{name: "currency with digits", req: &model.CreateJobRequest{Title: "Engineer", SalaryCurrency: strPtr("U5D")}, expectErr: model.ErrInvalidSalaryCurrency},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockRepo := &MockJobRepository{
+					CreateFunc: func(_ context.Context, _ *model.Job) error {
+						t.Fatal("Create should not be called")
+						return nil
+					},
+				}
+				svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+
+				result, err := svc.Create(context.Background(), userID, tt.req)
+
+				assert.Nil(t, result)
+				assert.ErrorIs(t, err, tt.expectErr)
+			})
+		}
+	})
+
+	t.Run("validates the merged range on update", func(t *testing.T) {
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(_ context.Context, _, _ string) (*model.Job, error) {
+				return &model.Job{ID: jobID, UserID: userID, Title: "Engineer", Status: "active", SalaryMin: intPtr(100000), SalaryCurrency: "USD"}, nil
+			},
+			UpdateFunc: func(_ context.Context, _ *model.Job) error {
+				t.Fatal("Update should not be called")
+				return nil
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+
+		result, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{SalaryMax: intPtr(80000)})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrInvalidSalaryRange)
+	})
+
+	t.Run("updates salary fields and keeps the rest", func(t *testing.T) {
+		var updatedJob *model.Job
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(_ context.Context, _, _ string) (*model.Job, error) {
+				return &model.Job{ID: jobID, UserID: userID, Title: "Engineer", Status: "active", SalaryMin: intPtr(100000), SalaryCurrency: "USD"}, nil
+			},
+			UpdateFunc: func(_ context.Context, job *model.Job) error {
+				updatedJob = job
+				return nil
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{SalaryMax: intPtr(130000), SalaryCurrency: strPtr("gbp")})
+
+		require.NoError(t, err)
+		assert.Equal(t, 100000, *updatedJob.SalaryMin)
+		assert.Equal(t, 130000, *updatedJob.SalaryMax)
+		assert.Equal(t, "GBP", updatedJob.SalaryCurrency)
+	})
+}
+
+func TestJobService_EmploymentTypeAndWorkArrangement(t *testing.T) {
+	userID := "user-123"
+	jobID := "job-1"
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("stores both on create", func(t *testing.T) {
+		var createdJob *model.Job
+		mockRepo := &MockJobRepository{
+			CreateFunc: func(_ context.Context, job *model.Job) error {
+				createdJob = job
+				return nil
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+
+		result, err := svc.Create(context.Background(), userID, &model.CreateJobRequest{
+			Title:           "Engineer",
+			EmploymentType:  strPtr("full_time"),
+			WorkArrangement: strPtr("hybrid"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, strPtr("full_time"), createdJob.EmploymentType)
+		assert.Equal(t, strPtr("hybrid"), createdJob.WorkArrangement)
+		assert.Equal(t, strPtr("hybrid"), result.WorkArrangement)
+	})
+
+	t.Run("rejects unknown values", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			req       *model.CreateJobRequest
+			expectErr error
+		}{
+			{name: "employment type", req: &model.CreateJobRequest{Title: "Engineer", EmploymentType: strPtr("seasonal")}, expectErr: model.ErrInvalidEmploymentType},
+			{name: "work arrangement", req: &model.CreateJobRequest{Title: "Engineer", WorkArrangement: strPtr("office")}, expectErr: model.ErrInvalidWorkArrangement},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockRepo := &MockJobRepository{
+					CreateFunc: func(_ context.Context, _ *model.Job) error {
+						t.Fatal("Create should not be called")
+						return nil
+					},
+				}
+				svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+
+				result, err := svc.Create(context.Background(), userID, tt.req)
+
+				assert.Nil(t, result)
+				assert.ErrorIs(t, err, tt.expectErr)
+			})
+		}
+	})
+
+	t.Run("clears a blank value on update and keeps the omitted one", func(t *testing.T) {
+		var updatedJob *model.Job
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(_ context.Context, _, _ string) (*model.Job, error) {
+				return &model.Job{
+					ID: jobID, UserID: userID, Title: "Engineer", Status: "active", SalaryCurrency: "USD",
+					EmploymentType: strPtr("contract"), WorkArrangement: strPtr("remote"),
+				}, nil
+			},
+			UpdateFunc: func(_ context.Context, job *model.Job) error {
+				updatedJob = job
+				return nil
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{EmploymentType: strPtr("")})
+
+		require.NoError(t, err)
+		assert.Nil(t, updatedJob.EmploymentType)
+		assert.Equal(t, strPtr("remote"), updatedJob.WorkArrangement)
+	})
+}
+
+// MockJobStatusHistoryRepository implements ports.JobStatusHistoryRepository
+type MockJobStatusHistoryRepository struct {
+	Entries       []*model.JobStatusHistory
+	CreateFunc    func(ctx context.Context, entry *model.JobStatusHistory) error
+	ListByJobFunc func(ctx context.Context, userID, jobID string) ([]*model.JobStatusHistory, error)
+}
+
+func (m *MockJobStatusHistoryRepository) Create(ctx context.Context, entry *model.JobStatusHistory) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, entry)
+	}
+	m.Entries = append(m.Entries, entry)
+	return nil
+}
+
+func (m *MockJobStatusHistoryRepository) ListByJob(ctx context.Context, userID, jobID string) ([]*model.JobStatusHistory, error) {
+	if m.ListByJobFunc != nil {
+		return m.ListByJobFunc(ctx, userID, jobID)
+	}
+	return m.Entries, nil
+}
+
+func TestJobService_Update_StatusHistory(t *testing.T) {
+	userID := "user-123"
+	jobID := "job-1"
+
+	newRepo := func() *MockJobRepository {
+		return &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return &model.Job{ID: jobID, UserID: userID, Title: "Engineer", Status: "active"}, nil
+			},
+		}
+	}
+
+	t.Run("records one entry when status changes", func(t *testing.T) {
+		historyRepo := &MockJobStatusHistoryRepository{}
+		svc := NewJobService(newRepo(), defaultMockCompanyRepo, nil, nil, historyRepo, nil, nil, nil)
+
+		status := "archived"
+		_, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{Status: &status})
+
+		require.NoError(t, err)
+		require.Len(t, historyRepo.Entries, 1)
+		entry := historyRepo.Entries[0]
+		assert.Equal(t, jobID, entry.JobID)
+		assert.Equal(t, userID, entry.UserID)
+		assert.Equal(t, "active", entry.FromStatus)
+		assert.Equal(t, "archived", entry.ToStatus)
+	})
+
+	t.Run("records nothing when status is unchanged", func(t *testing.T) {
+		historyRepo := &MockJobStatusHistoryRepository{}
+		svc := NewJobService(newRepo(), defaultMockCompanyRepo, nil, nil, historyRepo, nil, nil, nil)
+
+		status := "active"
+		_, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{Status: &status})
+
+		require.NoError(t, err)
+		assert.Empty(t, historyRepo.Entries)
+	})
+
+	t.Run("records nothing when only other fields change", func(t *testing.T) {
+		historyRepo := &MockJobStatusHistoryRepository{}
+		svc := NewJobService(newRepo(), defaultMockCompanyRepo, nil, nil, historyRepo, nil, nil, nil)
+
+		title := "Senior Engineer"
+		_, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{Title: &title})
+
+		require.NoError(t, err)
+		assert.Empty(t, historyRepo.Entries)
+	})
+
+	t.Run("records nothing when the update fails", func(t *testing.T) {
+		historyRepo := &MockJobStatusHistoryRepository{}
+		repo := newRepo()
+		repo.UpdateFunc = func(ctx context.Context, job *model.Job) error {
+			return errors.New("database error")
+		}
+		svc := NewJobService(repo, defaultMockCompanyRepo, nil, nil, historyRepo, nil, nil, nil)
+
+		status := "archived"
+		_, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{Status: &status})
+
+		require.Error(t, err)
+		assert.Empty(t, historyRepo.Entries)
+	})
+
+	t.Run("history write failure does not fail the update", func(t *testing.T) {
+		historyRepo := &MockJobStatusHistoryRepository{
+			CreateFunc: func(ctx context.Context, entry *model.JobStatusHistory) error {
+				return errors.New("database error")
+			},
+		}
+		svc := NewJobService(newRepo(), defaultMockCompanyRepo, nil, nil, historyRepo, nil, nil, nil)
+
+		status := "archived"
+		result, err := svc.Update(context.Background(), userID, jobID, &model.UpdateJobRequest{Status: &status})
+
+		require.NoError(t, err)
+		assert.Equal(t, "archived", result.Status)
+	})
+}
+
+func TestJobService_ListStatusHistory(t *testing.T) {
+	userID := "user-123"
+	jobID := "job-1"
+
+	t.Run("returns history entries", func(t *testing.T) {
+		now := time.Now()
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return &model.Job{ID: jid, UserID: uid}, nil
+			},
+		}
+		historyRepo := &MockJobStatusHistoryRepository{
+			ListByJobFunc: func(ctx context.Context, uid, jid string) ([]*model.JobStatusHistory, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, jobID, jid)
+				return []*model.JobStatusHistory{
+					{FromStatus: "archived", ToStatus: "active", ChangedAt: now},
+					{FromStatus: "active", ToStatus: "archived", ChangedAt: now.Add(-time.Hour)},
+				}, nil
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, historyRepo, nil, nil, nil)
+
+		result, err := svc.ListStatusHistory(context.Background(), userID, jobID)
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, "archived", result[0].FromStatus)
+		assert.Equal(t, "active", result[0].ToStatus)
+		assert.Equal(t, now, result[0].ChangedAt)
+	})
+
+	t.Run("returns empty list when there is no history", func(t *testing.T) {
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return &model.Job{ID: jid, UserID: uid}, nil
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, &MockJobStatusHistoryRepository{}, nil, nil, nil)
+
+		result, err := svc.ListStatusHistory(context.Background(), userID, jobID)
+
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+
+	t.Run("returns not found for another user's job", func(t *testing.T) {
+		mockRepo := &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return nil, model.ErrJobNotFound
+			},
+		}
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, &MockJobStatusHistoryRepository{}, nil, nil, nil)
+
+		result, err := svc.ListStatusHistory(context.Background(), userID, jobID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrJobNotFound)
+	})
+}
+
+// MockProfileInvalidator implements ProfileInvalidator for testing
+type MockProfileInvalidator struct {
+	InvalidateProfileFunc func(ctx context.Context, userID string) error
+	CalledWith            []string
+}
+
+func (m *MockProfileInvalidator) InvalidateProfile(ctx context.Context, userID string) error {
+	m.CalledWith = append(m.CalledWith, userID)
+	if m.InvalidateProfileFunc != nil {
+		return m.InvalidateProfileFunc(ctx, userID)
+	}
+	return nil
+}
+
+func TestJobService_ProfileInvalidation(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("invalidates profile on create", func(t *testing.T) {
+		profileCache := &MockProfileInvalidator{}
+		mockRepo := &MockJobRepository{
+			CreateFunc: func(ctx context.Context, job *model.Job) error { return nil },
+		}
+
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, profileCache, nil)
+		_, err := svc.Create(context.Background(), userID, &model.CreateJobRequest{Title: "Engineer"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID}, profileCache.CalledWith)
+	})
+
+	t.Run("invalidates profile on delete", func(t *testing.T) {
+		profileCache := &MockProfileInvalidator{}
+		mockRepo := &MockJobRepository{
+			DeleteFunc: func(ctx context.Context, uid, jid string) error { return nil },
+		}
+
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, profileCache, nil)
+		err := svc.Delete(context.Background(), userID, "job-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID}, profileCache.CalledWith)
+	})
+
+	t.Run("does not invalidate when delete fails", func(t *testing.T) {
+		profileCache := &MockProfileInvalidator{}
+		mockRepo := &MockJobRepository{
+			DeleteFunc: func(ctx context.Context, uid, jid string) error { return model.ErrJobNotFound },
+		}
+
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, profileCache, nil)
+		err := svc.Delete(context.Background(), userID, "job-1")
+
+		assert.ErrorIs(t, err, model.ErrJobNotFound)
+		assert.Empty(t, profileCache.CalledWith)
+	})
+
+	t.Run("invalidation error does not break create", func(t *testing.T) {
+		profileCache := &MockProfileInvalidator{
+			InvalidateProfileFunc: func(ctx context.Context, userID string) error {
+				return errors.New("redis unavailable")
+			},
+		}
+		mockRepo := &MockJobRepository{
+			CreateFunc: func(ctx context.Context, job *model.Job) error { return nil },
+		}
+
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, profileCache, nil)
+		_, err := svc.Create(context.Background(), userID, &model.CreateJobRequest{Title: "Engineer"})
+
+		require.NoError(t, err)
+	})
+}
+
+// MockAnalyticsInvalidator implements AnalyticsInvalidator for testing
+type MockAnalyticsInvalidator struct {
+	CalledWith []string
+}
+
+func (m *MockAnalyticsInvalidator) InvalidateAnalytics(ctx context.Context, userID string) error {
+	m.CalledWith = append(m.CalledWith, userID)
+	return nil
+}
+
+func TestJobService_AnalyticsInvalidation(t *testing.T) {
+	userID := "user-123"
+	title := "Staff Engineer"
+
+	newRepo := func() *MockJobRepository {
+		return &MockJobRepository{
+			GetByIDFunc: func(ctx context.Context, uid, jid string) (*model.Job, error) {
+				return &model.Job{ID: jid, UserID: uid, Title: "Engineer", Status: "active"}, nil
+			},
+			UpdateFunc: func(ctx context.Context, job *model.Job) error { return nil },
+			DeleteFunc: func(ctx context.Context, uid, jid string) error { return nil },
+		}
+	}
+
+	t.Run("invalidates analytics on update, company change and delete", func(t *testing.T) {
+		analyticsCache := &MockAnalyticsInvalidator{}
+		svc := NewJobService(newRepo(), defaultMockCompanyRepo, nil, nil, nil, nil, nil, analyticsCache)
+
+		_, err := svc.Update(context.Background(), userID, "job-1", &model.UpdateJobRequest{Title: &title})
+		require.NoError(t, err)
+		_, err = svc.UpdateCompany(context.Background(), userID, "job-1", "company-2")
+		require.NoError(t, err)
+		require.NoError(t, svc.Delete(context.Background(), userID, "job-1"))
+
+		assert.Equal(t, []string{userID, userID, userID}, analyticsCache.CalledWith)
+	})
+
+	t.Run("does not invalidate when the write fails", func(t *testing.T) {
+		analyticsCache := &MockAnalyticsInvalidator{}
+		mockRepo := newRepo()
+		mockRepo.UpdateFunc = func(ctx context.Context, job *model.Job) error { return errors.New("database error") }
+		mockRepo.DeleteFunc = func(ctx context.Context, uid, jid string) error { return model.ErrJobNotFound }
+		svc := NewJobService(mockRepo, defaultMockCompanyRepo, nil, nil, nil, nil, nil, analyticsCache)
+
+		_, err := svc.Update(context.Background(), userID, "job-1", &model.UpdateJobRequest{Title: &title})
+		assert.Error(t, err)
+		assert.ErrorIs(t, svc.Delete(context.Background(), userID, "job-1"), model.ErrJobNotFound)
+
+		assert.Empty(t, analyticsCache.CalledWith)
+	})
+}
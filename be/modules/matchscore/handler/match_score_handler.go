@@ -60,7 +60,7 @@ func (h *MatchScoreHandler) CheckMatch(c *gin.Context) {
 
 		statusCode := http.StatusInternalServerError
 		errorCode := model.GetErrorCode(err)
-		errorMessage := model.GetErrorMessage(err)
+		errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 		switch {
 		case errors.Is(err, model.ErrJobDescriptionEmpty), errors.Is(err, model.ErrResumeFileEmpty):
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
+	jobPorts "github.com/andreypavlenko/jobber/modules/jobs/ports"
 	"github.com/andreypavlenko/jobber/modules/matchscore/model"
 	matchPorts "github.com/andreypavlenko/jobber/modules/matchscore/ports"
 	matchService "github.com/andreypavlenko/jobber/modules/matchscore/service"
@@ -24,10 +25,13 @@ import (
 type MockJobRepository struct {
 	CreateFunc         func(ctx context.Context, job *jobModel.Job) error
 	GetByIDFunc        func(ctx context.Context, userID, jobID string) (*jobModel.Job, error)
-	ListFunc           func(ctx context.Context, userID string, limit, offset int, status, sortBy, sortOrder string) ([]*jobModel.JobDTO, int, error)
+	ListFunc           func(ctx context.Context, userID string, opts *jobPorts.ListOptions) ([]*jobModel.JobDTO, int, error)
 	UpdateFunc         func(ctx context.Context, job *jobModel.Job) error
 	DeleteFunc         func(ctx context.Context, userID, jobID string) error
 	ToggleFavoriteFunc func(ctx context.Context, userID, jobID string) (bool, error)
+	ListHighPriorityFunc func(ctx context.Context, userID string, limit int) ([]*jobModel.JobDTO, error)
+	FindSimilarByTitleFunc func(ctx context.Context, userID, title, excludeID string, limit int) ([]*jobModel.SimilarJobDTO, error)
+	ListApplicationIDsFunc func(ctx context.Context, userID, jobID string) ([]string, error)
 }
 
 func (m *MockJobRepository) Create(ctx context.Context, job *jobModel.Job) error {
@@ -44,9 +48,9 @@ func (m *MockJobRepository) GetByID(ctx context.Context, userID, jobID string) (
 	return nil, nil
 }
 
-func (m *MockJobRepository) List(ctx context.Context, userID string, limit, offset int, status, sortBy, sortOrder string) ([]*jobModel.JobDTO, int, error) {
+func (m *MockJobRepository) List(ctx context.Context, userID string, opts *jobPorts.ListOptions) ([]*jobModel.JobDTO, int, error) {
 	if m.ListFunc != nil {
-		return m.ListFunc(ctx, userID, limit, offset, status, sortBy, sortOrder)
+		return m.ListFunc(ctx, userID, opts)
 	}
 	return nil, 0, nil
 }
@@ -72,13 +76,35 @@ func (m *MockJobRepository) ToggleFavorite(ctx context.Context, userID, jobID st
 	return false, nil
 }
 
+func (m *MockJobRepository) ListHighPriority(ctx context.Context, userID string, limit int) ([]*jobModel.JobDTO, error) {
+	if m.ListHighPriorityFunc != nil {
+		return m.ListHighPriorityFunc(ctx, userID, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockJobRepository) FindSimilarByTitle(ctx context.Context, userID, title, excludeID string, limit int) ([]*jobModel.SimilarJobDTO, error) {
+	if m.FindSimilarByTitleFunc != nil {
+		return m.FindSimilarByTitleFunc(ctx, userID, title, excludeID, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockJobRepository) ListApplicationIDs(ctx context.Context, userID, jobID string) ([]string, error) {
+	if m.ListApplicationIDsFunc != nil {
+		return m.ListApplicationIDsFunc(ctx, userID, jobID)
+	}
+	return nil, nil
+}
+
 // MockResumeRepository implements resumePorts.ResumeRepository
 type MockResumeRepository struct {
-	CreateFunc  func(ctx context.Context, resume *resumeModel.Resume) error
-	GetByIDFunc func(ctx context.Context, userID, resumeID string) (*resumeModel.Resume, error)
-	ListFunc    func(ctx context.Context, userID string, limit, offset int, sortBy, sortDir string) ([]*resumePorts.ResumeWithCount, int, error)
-	UpdateFunc  func(ctx context.Context, resume *resumeModel.Resume) error
-	DeleteFunc  func(ctx context.Context, userID, resumeID string) error
+	CreateFunc            func(ctx context.Context, resume *resumeModel.Resume) error
+	GetByIDFunc           func(ctx context.Context, userID, resumeID string) (*resumeModel.Resume, error)
+	ListFunc              func(ctx context.Context, userID string, limit, offset int, sortBy, sortDir string) ([]*resumePorts.ResumeWithCount, int, error)
+	UpdateFunc            func(ctx context.Context, resume *resumeModel.Resume) error
+	DeleteFunc            func(ctx context.Context, userID, resumeID string) error
+	CountApplicationsFunc func(ctx context.Context, userID, resumeID string) (int, error)
 }
 
 func (m *MockResumeRepository) Create(ctx context.Context, resume *resumeModel.Resume) error {
@@ -116,6 +142,13 @@ func (m *MockResumeRepository) Delete(ctx context.Context, userID, resumeID stri
 	return nil
 }
 
+func (m *MockResumeRepository) CountApplications(ctx context.Context, userID, resumeID string) (int, error) {
+	if m.CountApplicationsFunc != nil {
+		return m.CountApplicationsFunc(ctx, userID, resumeID)
+	}
+	return 0, nil
+}
+
 // MockLimitChecker implements matchService.LimitChecker
 type MockLimitChecker struct {
 	CheckLimitFunc   func(ctx context.Context, userID, resource string) error
@@ -1,6 +1,9 @@
 package model
 
-import "errors"
+import (
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
 
 // MatchScoreRequest is the request payload for checking resume-job match.
 type MatchScoreRequest struct {
@@ -27,10 +30,10 @@ type MatchScoreResponse struct {
 
 // Errors
 var (
-	ErrAINotConfigured     = errors.New("AI matching is not configured")
-	ErrJobDescriptionEmpty = errors.New("job description is empty")
-	ErrResumeFileEmpty     = errors.New("resume has no file")
-	ErrMatchFailed         = errors.New("failed to analyze match")
+	ErrAINotConfigured     = &DomainError{Code: CodeAINotConfigured, Message: "AI matching is not configured"}
+	ErrJobDescriptionEmpty = &DomainError{Code: CodeJobDescriptionEmpty, Message: "job description is empty"}
+	ErrResumeFileEmpty     = &DomainError{Code: CodeResumeFileEmpty, Message: "resume has no file"}
+	ErrMatchFailed         = &DomainError{Code: CodeMatchFailed, Message: "failed to analyze match"}
 )
 
 // ErrorCode represents a domain error code.
@@ -46,34 +49,15 @@ const (
 	CodeResumeNotFound      ErrorCode = "RESUME_NOT_FOUND"
 )
 
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
 // GetErrorCode maps domain errors to error codes.
 func GetErrorCode(err error) ErrorCode {
-	switch {
-	case errors.Is(err, ErrAINotConfigured):
-		return CodeAINotConfigured
-	case errors.Is(err, ErrJobDescriptionEmpty):
-		return CodeJobDescriptionEmpty
-	case errors.Is(err, ErrResumeFileEmpty):
-		return CodeResumeFileEmpty
-	case errors.Is(err, ErrMatchFailed):
-		return CodeMatchFailed
-	default:
-		return "INTERNAL_ERROR"
-	}
+	return domainerr.CodeOf(err, ErrorCode("INTERNAL_ERROR"))
 }
 
-// GetErrorMessage returns a user-friendly error message.
-func GetErrorMessage(err error) string {
-	switch {
-	case errors.Is(err, ErrAINotConfigured):
-		return "AI matching is not available"
-	case errors.Is(err, ErrJobDescriptionEmpty):
-		return "Job description is required for match analysis"
-	case errors.Is(err, ErrResumeFileEmpty):
-		return "Resume file is required for match analysis"
-	case errors.Is(err, ErrMatchFailed):
-		return "Failed to analyze match. Please try again."
-	default:
-		return "An unexpected error occurred"
-	}
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
 }
@@ -6,12 +6,20 @@ import (
 
 	"github.com/andreypavlenko/jobber/modules/matchscore/model"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
 // MatchScoreCacheRepository implements ports.MatchScoreCacheRepository using PostgreSQL.
 type MatchScoreCacheRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 // NewMatchScoreCacheRepository creates a new cache repository.
@@ -19,6 +27,11 @@ func NewMatchScoreCacheRepository(pool *pgxpool.Pool) *MatchScoreCacheRepository
 	return &MatchScoreCacheRepository{pool: pool}
 }
 
+// NewMatchScoreCacheRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewMatchScoreCacheRepositoryWithPool(pool DBPool) *MatchScoreCacheRepository {
+	return &MatchScoreCacheRepository{pool: pool}
+}
+
 // Get returns a cached match score result, or nil if not found.
 func (r *MatchScoreCacheRepository) Get(ctx context.Context, userID, jobID, resumeID string) (*model.MatchScoreResponse, error) {
 	query := `SELECT result FROM match_score_cache WHERE user_id = $1 AND job_id = $2 AND resume_id = $3`
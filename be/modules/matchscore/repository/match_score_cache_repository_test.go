@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/matchscore/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchScoreCacheRepository_Get(t *testing.T) {
+	t.Run("decodes the cached result", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		raw, err := json.Marshal(&model.MatchScoreResponse{OverallScore: 82, Strengths: []string{"Go"}})
+		require.NoError(t, err)
+		mock.ExpectQuery(`SELECT result FROM match_score_cache WHERE user_id = \$1 AND job_id = \$2 AND resume_id = \$3`).
+			WithArgs("user-1", "job-1", "resume-1").
+			WillReturnRows(pgxmock.NewRows([]string{"result"}).AddRow(raw))
+
+		result, err := NewMatchScoreCacheRepositoryWithPool(mock).Get(context.Background(), "user-1", "job-1", "resume-1")
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, 82, result.OverallScore)
+		assert.Equal(t, []string{"Go"}, result.Strengths)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns nil on a cache miss", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM match_score_cache").
+			WithArgs("user-1", "job-1", "resume-1").
+			WillReturnError(pgx.ErrNoRows)
+
+		result, err := NewMatchScoreCacheRepositoryWithPool(mock).Get(context.Background(), "user-1", "job-1", "resume-1")
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("fails on a corrupt entry", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM match_score_cache").
+			WithArgs("user-1", "job-1", "resume-1").
+			WillReturnRows(pgxmock.NewRows([]string{"result"}).AddRow([]byte("{")))
+
+		result, err := NewMatchScoreCacheRepositoryWithPool(mock).Get(context.Background(), "user-1", "job-1", "resume-1")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM match_score_cache").
+			WithArgs("user-1", "job-1", "resume-1").
+			WillReturnError(assert.AnError)
+
+		_, err = NewMatchScoreCacheRepositoryWithPool(mock).Get(context.Background(), "user-1", "job-1", "resume-1")
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestMatchScoreCacheRepository_Upsert(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	result := &model.MatchScoreResponse{OverallScore: 70, Summary: "Good fit"}
+	raw, err := json.Marshal(result)
+	require.NoError(t, err)
+	mock.ExpectExec(`INSERT INTO match_score_cache .*ON CONFLICT \(user_id, job_id, resume_id\)\s+DO UPDATE SET result = EXCLUDED.result, cached_at = NOW\(\)`).
+		WithArgs("user-1", "job-1", "resume-1", raw).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	err = NewMatchScoreCacheRepositoryWithPool(mock).Upsert(context.Background(), "user-1", "job-1", "resume-1", result)
+
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMatchScoreCacheRepository_Invalidate(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec(`DELETE FROM match_score_cache WHERE job_id = \$1`).
+		WithArgs("job-1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 2))
+	mock.ExpectExec(`DELETE FROM match_score_cache WHERE resume_id = \$1`).
+		WithArgs("resume-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewMatchScoreCacheRepositoryWithPool(mock)
+	assert.NoError(t, repo.InvalidateByJob(context.Background(), "job-1"))
+	assert.ErrorIs(t, repo.InvalidateByResume(context.Background(), "resume-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
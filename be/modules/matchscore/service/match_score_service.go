@@ -112,7 +112,7 @@ type LimitChecker interface {
 // MatchScoreService handles resume-job match scoring.
 type MatchScoreService struct {
 	aiClient     *ai.AnthropicClient
-	s3Client     *storage.S3Client
+	s3Client     storage.ObjectStorage
 	jobRepo      jobPorts.JobRepository
 	resumeRepo   resumePorts.ResumeRepository
 	limitChecker LimitChecker
@@ -122,7 +122,7 @@ type MatchScoreService struct {
 // NewMatchScoreService creates a new match score service.
 func NewMatchScoreService(
 	aiClient *ai.AnthropicClient,
-	s3Client *storage.S3Client,
+	s3Client storage.ObjectStorage,
 	jobRepo jobPorts.JobRepository,
 	resumeRepo resumePorts.ResumeRepository,
 	limitChecker LimitChecker,
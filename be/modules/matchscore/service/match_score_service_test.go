@@ -14,6 +14,7 @@ import (
 	"github.com/andreypavlenko/jobber/internal/platform/ai"
 	"github.com/andreypavlenko/jobber/internal/platform/storage"
 	jobModel "github.com/andreypavlenko/jobber/modules/jobs/model"
+	jobPorts "github.com/andreypavlenko/jobber/modules/jobs/ports"
 	"github.com/andreypavlenko/jobber/modules/matchscore/model"
 	resumeModel "github.com/andreypavlenko/jobber/modules/resumes/model"
 	resumePorts "github.com/andreypavlenko/jobber/modules/resumes/ports"
@@ -464,7 +465,7 @@ func (m *MockJobRepository) GetByID(ctx context.Context, uid, jid string) (*jobM
 	}
 	return nil, nil
 }
-func (m *MockJobRepository) List(ctx context.Context, uid string, limit, offset int, status, sortBy, sortOrder string) ([]*jobModel.JobDTO, int, error) {
+func (m *MockJobRepository) List(ctx context.Context, uid string, opts *jobPorts.ListOptions) ([]*jobModel.JobDTO, int, error) {
 	return nil, 0, nil
 }
 func (m *MockJobRepository) Update(ctx context.Context, job *jobModel.Job) error { return nil }
@@ -472,6 +473,15 @@ func (m *MockJobRepository) Delete(ctx context.Context, uid, jid string) error
 func (m *MockJobRepository) ToggleFavorite(ctx context.Context, uid, jid string) (bool, error) {
 	return false, nil
 }
+func (m *MockJobRepository) ListHighPriority(ctx context.Context, uid string, limit int) ([]*jobModel.JobDTO, error) {
+	return nil, nil
+}
+func (m *MockJobRepository) FindSimilarByTitle(ctx context.Context, uid, title, excludeID string, limit int) ([]*jobModel.SimilarJobDTO, error) {
+	return nil, nil
+}
+func (m *MockJobRepository) ListApplicationIDs(ctx context.Context, uid, jid string) ([]string, error) {
+	return nil, nil
+}
 
 type MockResumeRepository struct {
 	GetByIDFunc func(ctx context.Context, uid, rid string) (*resumeModel.Resume, error)
@@ -493,6 +503,9 @@ func (m *MockResumeRepository) Update(ctx context.Context, resume *resumeModel.R
 	return nil
 }
 func (m *MockResumeRepository) Delete(ctx context.Context, uid, rid string) error { return nil }
+func (m *MockResumeRepository) CountApplications(ctx context.Context, uid, rid string) (int, error) {
+	return 0, nil
+}
 
 // ---------------------------------------------------------------------------
 // downloadResumePDF tests
@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/andreypavlenko/jobber/internal/platform/auth"
+	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/modules/reminders/model"
+	"github.com/andreypavlenko/jobber/modules/reminders/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReminderHandler struct {
+	service *service.ReminderService
+}
+
+func NewReminderHandler(service *service.ReminderService) *ReminderHandler {
+	return &ReminderHandler{service: service}
+}
+
+// ListUpcoming godoc
+// @Summary List upcoming reminders
+// @Description Get the open reminders across all applications that are due within the given number of days, overdue ones included, earliest first
+// @Tags reminders
+// @Security BearerAuth
+// @Produce json
+// @Param days query int false "Number of days ahead to include, 1-365 (default: 7)"
+// @Success 200 {object} []model.ReminderWithApplicationDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid days"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /reminders/upcoming [get]
+func (h *ReminderHandler) ListUpcoming(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	days := model.DefaultUpcomingDays
+	if raw := c.Query("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			respondWithReminderError(c, model.ErrInvalidDays)
+			return
+		}
+		days = parsed
+	}
+
+	reminders, err := h.service.ListUpcoming(c.Request.Context(), userID, days)
+	if err != nil {
+		respondWithReminderError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, reminders)
+}
+
+// Create godoc
+// @Summary Create a reminder
+// @Description Create a reminder on one of the user's applications, optionally linked to a stage
+// @Tags reminders
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body model.CreateReminderRequest true "Reminder details"
+// @Success 201 {object} model.ReminderDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /reminders [post]
+func (h *ReminderHandler) Create(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	var req model.CreateReminderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	reminder, err := h.service.Create(c.Request.Context(), userID, &req)
+	if err != nil {
+		respondWithReminderError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusCreated, reminder)
+}
+
+// List godoc
+// @Summary List reminders
+// @Description Get the user's reminders earliest first. Overdue reminders are open ones whose time has passed; pending ones are still ahead.
+// @Tags reminders
+// @Security BearerAuth
+// @Produce json
+// @Param status query string false "Filter by status: pending, done, overdue"
+// @Param application_id query string false "Filter by application ID"
+// @Success 200 {object} []model.ReminderDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid status or application ID"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /reminders [get]
+func (h *ReminderHandler) List(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	filter := &model.ListRemindersFilter{Status: c.Query("status")}
+	if appID := c.Query("application_id"); appID != "" {
+		if _, err := uuid.Parse(appID); err != nil {
+			httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid application_id")
+			return
+		}
+		filter.ApplicationID = &appID
+	}
+
+	reminders, err := h.service.List(c.Request.Context(), userID, filter)
+	if err != nil {
+		respondWithReminderError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, reminders)
+}
+
+// Update godoc
+// @Summary Update a reminder
+// @Description Change the time or message of a reminder, or mark it done
+// @Tags reminders
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Reminder ID"
+// @Param request body model.UpdateReminderRequest true "Fields to update"
+// @Success 200 {object} model.ReminderDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Reminder not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /reminders/{id} [patch]
+func (h *ReminderHandler) Update(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	var req model.UpdateReminderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	reminder, err := h.service.Update(c.Request.Context(), userID, c.Param("id"), &req)
+	if err != nil {
+		respondWithReminderError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, reminder)
+}
+
+// Delete godoc
+// @Summary Delete a reminder
+// @Description Delete a reminder of the authenticated user
+// @Tags reminders
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Reminder ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Reminder not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /reminders/{id} [delete]
+func (h *ReminderHandler) Delete(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), userID, c.Param("id")); err != nil {
+		respondWithReminderError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Reminder deleted successfully"})
+}
+
+// Complete godoc
+// @Summary Complete a reminder
+// @Description Mark a reminder of the authenticated user as done
+// @Tags reminders
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Reminder ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Reminder not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /reminders/{id}/complete [post]
+func (h *ReminderHandler) Complete(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.MarkDone(c.Request.Context(), userID, c.Param("id")); err != nil {
+		respondWithReminderError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Reminder completed"})
+}
+
+// CompleteAllForApplication godoc
+// @Summary Complete all reminders of an application
+// @Description Mark every open reminder of an application as done
+// @Tags reminders
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Application ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Application not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /applications/{id}/reminders/complete-all [post]
+func (h *ReminderHandler) CompleteAllForApplication(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	appID := c.Param("id")
+	if _, err := uuid.Parse(appID); err != nil {
+		respondWithReminderError(c, model.ErrApplicationNotFound)
+		return
+	}
+
+	if err := h.service.MarkAllDoneByApplication(c.Request.Context(), userID, appID); err != nil {
+		respondWithReminderError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Reminders completed"})
+}
+
+// respondWithReminderError maps reminder errors to HTTP responses
+func respondWithReminderError(c *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	switch model.GetErrorCode(err) {
+	case model.CodeReminderNotFound, model.CodeApplicationNotFound:
+		statusCode = http.StatusNotFound
+	case model.CodeInvalidDays, model.CodeInvalidStatus, model.CodeMessageRequired:
+		statusCode = http.StatusBadRequest
+	}
+	httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+}
+
+func (h *ReminderHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	reminders := router.Group("/reminders")
+	reminders.Use(authMiddleware)
+	{
+		reminders.POST("", h.Create)
+		reminders.GET("", h.List)
+		reminders.GET("/upcoming", h.ListUpcoming)
+		reminders.PATCH("/:id", h.Update)
+		reminders.DELETE("/:id", h.Delete)
+		reminders.POST("/:id/complete", h.Complete)
+	}
+
+	apps := router.Group("/applications")
+	apps.Use(authMiddleware)
+	{
+		apps.POST("/:id/reminders/complete-all", h.CompleteAllForApplication)
+	}
+}
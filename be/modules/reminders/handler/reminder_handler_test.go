@@ -0,0 +1,531 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/reminders/model"
+	"github.com/andreypavlenko/jobber/modules/reminders/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockReminderRepository implements ports.ReminderRepository
+type MockReminderRepository struct {
+	CreateFunc                   func(ctx context.Context, reminder *model.Reminder) error
+	GetByIDFunc                  func(ctx context.Context, userID, reminderID string) (*model.Reminder, error)
+	ListFunc                     func(ctx context.Context, userID string, filter *model.ListRemindersFilter) ([]*model.Reminder, error)
+	ListUpcomingFunc             func(ctx context.Context, userID string, withinDays int) ([]*model.ReminderWithApplication, error)
+	UpdateFunc                   func(ctx context.Context, reminder *model.Reminder) error
+	DeleteFunc                   func(ctx context.Context, userID, reminderID string) error
+	MarkDoneFunc                 func(ctx context.Context, userID, reminderID string) error
+	MarkAllDoneByApplicationFunc func(ctx context.Context, userID, appID string) error
+	ApplicationOwnedFunc         func(ctx context.Context, userID, appID string) (bool, error)
+}
+
+func (m *MockReminderRepository) Create(ctx context.Context, reminder *model.Reminder) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, reminder)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) GetByID(ctx context.Context, userID, reminderID string) (*model.Reminder, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, userID, reminderID)
+	}
+	return nil, model.ErrReminderNotFound
+}
+
+func (m *MockReminderRepository) ListByUser(ctx context.Context, userID string) ([]*model.Reminder, error) {
+	return nil, nil
+}
+
+func (m *MockReminderRepository) List(ctx context.Context, userID string, filter *model.ListRemindersFilter) ([]*model.Reminder, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, userID, filter)
+	}
+	return nil, nil
+}
+
+func (m *MockReminderRepository) CountDue(ctx context.Context, userID string, from, to time.Time) (int, error) {
+	return 0, nil
+}
+
+func (m *MockReminderRepository) GetNextForApplication(ctx context.Context, appID string) (*model.Reminder, error) {
+	return nil, model.ErrReminderNotFound
+}
+
+func (m *MockReminderRepository) ListUpcoming(ctx context.Context, userID string, withinDays int) ([]*model.ReminderWithApplication, error) {
+	if m.ListUpcomingFunc != nil {
+		return m.ListUpcomingFunc(ctx, userID, withinDays)
+	}
+	return nil, nil
+}
+
+func (m *MockReminderRepository) Update(ctx context.Context, reminder *model.Reminder) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, reminder)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) Delete(ctx context.Context, userID, reminderID string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID, reminderID)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) MarkDone(ctx context.Context, userID, reminderID string) error {
+	if m.MarkDoneFunc != nil {
+		return m.MarkDoneFunc(ctx, userID, reminderID)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) MarkAllDoneByApplication(ctx context.Context, userID, appID string) error {
+	if m.MarkAllDoneByApplicationFunc != nil {
+		return m.MarkAllDoneByApplicationFunc(ctx, userID, appID)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) ApplicationOwned(ctx context.Context, userID, appID string) (bool, error) {
+	if m.ApplicationOwnedFunc != nil {
+		return m.ApplicationOwnedFunc(ctx, userID, appID)
+	}
+	return true, nil
+}
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+func mockAuthMiddleware(userID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	}
+}
+
+func TestReminderHandler_ListUpcoming(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("defaults to seven days", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			ListUpcomingFunc: func(ctx context.Context, uid string, withinDays int) ([]*model.ReminderWithApplication, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, model.DefaultUpcomingDays, withinDays)
+				return []*model.ReminderWithApplication{
+					{
+						Reminder:          model.Reminder{ID: "rem-1", ApplicationID: "app-1", Message: "Follow up"},
+						ApplicationName:   "Backend Engineer",
+						ApplicationStatus: "on_hold",
+					},
+				}, nil
+			},
+		}
+
+		handler := NewReminderHandler(service.NewReminderService(mockRepo))
+
+		router := setupTestRouter()
+		router.GET("/reminders/upcoming", mockAuthMiddleware(userID), handler.ListUpcoming)
+
+		req, _ := http.NewRequest(http.MethodGet, "/reminders/upcoming", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response []map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 1)
+		assert.Equal(t, "rem-1", response[0]["id"])
+		assert.Equal(t, "Follow up", response[0]["message"])
+		assert.Equal(t, "Backend Engineer", response[0]["application_name"])
+		assert.Equal(t, "on_hold", response[0]["application_status"])
+	})
+
+	for _, days := range []string{"1", "365"} {
+		t.Run("accepts days="+days, func(t *testing.T) {
+			var captured int
+			mockRepo := &MockReminderRepository{
+				ListUpcomingFunc: func(ctx context.Context, uid string, withinDays int) ([]*model.ReminderWithApplication, error) {
+					captured = withinDays
+					return nil, nil
+				},
+			}
+
+			handler := NewReminderHandler(service.NewReminderService(mockRepo))
+
+			router := setupTestRouter()
+			router.GET("/reminders/upcoming", mockAuthMiddleware(userID), handler.ListUpcoming)
+
+			req, _ := http.NewRequest(http.MethodGet, "/reminders/upcoming?days="+days, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, days, strconv.Itoa(captured))
+		})
+	}
+
+	for _, days := range []string{"0", "366", "-3", "abc"} {
+		t.Run("returns 400 for days="+days, func(t *testing.T) {
+			handler := NewReminderHandler(service.NewReminderService(&MockReminderRepository{}))
+
+			router := setupTestRouter()
+			router.GET("/reminders/upcoming", mockAuthMiddleware(userID), handler.ListUpcoming)
+
+			req, _ := http.NewRequest(http.MethodGet, "/reminders/upcoming?days="+days, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), "INVALID_DAYS")
+		})
+	}
+
+	t.Run("translates the error message to the request locale", func(t *testing.T) {
+		handler := NewReminderHandler(service.NewReminderService(&MockReminderRepository{}))
+
+		router := setupTestRouter()
+		router.GET("/reminders/upcoming", mockAuthMiddleware(userID), handler.ListUpcoming)
+
+		req, _ := http.NewRequest(http.MethodGet, "/reminders/upcoming?days=0", nil)
+		req.Header.Set("Accept-Language", "es-MX")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "Los días deben ser un número entre 1 y 365")
+	})
+
+	t.Run("returns 500 on service error", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			ListUpcomingFunc: func(ctx context.Context, uid string, withinDays int) ([]*model.ReminderWithApplication, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		handler := NewReminderHandler(service.NewReminderService(mockRepo))
+
+		router := setupTestRouter()
+		router.GET("/reminders/upcoming", mockAuthMiddleware(userID), handler.ListUpcoming)
+
+		req, _ := http.NewRequest(http.MethodGet, "/reminders/upcoming", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func sendJSON(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestReminderHandler_Create(t *testing.T) {
+	appID := "11111111-1111-1111-1111-111111111111"
+
+	setup := func(mockRepo *MockReminderRepository) *gin.Engine {
+		handler := NewReminderHandler(service.NewReminderService(mockRepo))
+		router := setupTestRouter()
+		router.POST("/reminders", mockAuthMiddleware("user-123"), handler.Create)
+		return router
+	}
+
+	t.Run("creates reminder", func(t *testing.T) {
+		w := sendJSON(setup(&MockReminderRepository{}), http.MethodPost, "/reminders",
+			`{"application_id":"`+appID+`","remind_at":"2026-03-02T09:00:00Z","message":"Follow up"}`)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response model.ReminderDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, appID, response.ApplicationID)
+		assert.Equal(t, "Follow up", response.Message)
+	})
+
+	t.Run("returns 404 for another user's application", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			ApplicationOwnedFunc: func(ctx context.Context, uid, aid string) (bool, error) {
+				return false, nil
+			},
+		}
+
+		w := sendJSON(setup(mockRepo), http.MethodPost, "/reminders",
+			`{"application_id":"`+appID+`","remind_at":"2026-03-02T09:00:00Z","message":"Follow up"}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeApplicationNotFound))
+	})
+
+	t.Run("returns 400 for invalid payload", func(t *testing.T) {
+		router := setup(&MockReminderRepository{})
+
+		assert.Equal(t, http.StatusBadRequest, sendJSON(router, http.MethodPost, "/reminders", `{"application_id":"not-a-uuid","remind_at":"2026-03-02T09:00:00Z","message":"Follow up"}`).Code)
+		assert.Equal(t, http.StatusBadRequest, sendJSON(router, http.MethodPost, "/reminders", `{"application_id":"`+appID+`","message":"Follow up"}`).Code)
+		assert.Equal(t, http.StatusBadRequest, sendJSON(router, http.MethodPost, "/reminders", `{"application_id":"`+appID+`","remind_at":"2026-03-02T09:00:00Z"}`).Code)
+	})
+}
+
+func TestReminderHandler_List(t *testing.T) {
+	appID := "11111111-1111-1111-1111-111111111111"
+
+	setup := func(mockRepo *MockReminderRepository) *gin.Engine {
+		handler := NewReminderHandler(service.NewReminderService(mockRepo))
+		router := setupTestRouter()
+		router.GET("/reminders", mockAuthMiddleware("user-123"), handler.List)
+		return router
+	}
+
+	t.Run("passes status and application filters", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			ListFunc: func(ctx context.Context, uid string, filter *model.ListRemindersFilter) ([]*model.Reminder, error) {
+				assert.Equal(t, model.ReminderStatusOverdue, filter.Status)
+				require.NotNil(t, filter.ApplicationID)
+				assert.Equal(t, appID, *filter.ApplicationID)
+				return []*model.Reminder{{ID: "rem-1", ApplicationID: appID}}, nil
+			},
+		}
+
+		w := sendJSON(setup(mockRepo), http.MethodGet, "/reminders?status=overdue&application_id="+appID, "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response []model.ReminderDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 1)
+		assert.Equal(t, "rem-1", response[0].ID)
+	})
+
+	t.Run("returns 400 for unknown status", func(t *testing.T) {
+		w := sendJSON(setup(&MockReminderRepository{}), http.MethodGet, "/reminders?status=snoozed", "")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeInvalidStatus))
+	})
+
+	t.Run("returns 400 for malformed application_id", func(t *testing.T) {
+		w := sendJSON(setup(&MockReminderRepository{}), http.MethodGet, "/reminders?application_id=abc", "")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("returns 404 for another user's application", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			ApplicationOwnedFunc: func(ctx context.Context, uid, aid string) (bool, error) {
+				return false, nil
+			},
+		}
+
+		w := sendJSON(setup(mockRepo), http.MethodGet, "/reminders?application_id="+appID, "")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestReminderHandler_Update(t *testing.T) {
+	setup := func(mockRepo *MockReminderRepository) *gin.Engine {
+		handler := NewReminderHandler(service.NewReminderService(mockRepo))
+		router := setupTestRouter()
+		router.PATCH("/reminders/:id", mockAuthMiddleware("user-123"), handler.Update)
+		return router
+	}
+
+	t.Run("marks reminder done", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			GetByIDFunc: func(ctx context.Context, uid, rid string) (*model.Reminder, error) {
+				return &model.Reminder{ID: rid, UserID: uid, Message: "Follow up"}, nil
+			},
+		}
+
+		w := sendJSON(setup(mockRepo), http.MethodPatch, "/reminders/rem-1", `{"is_done":true}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response model.ReminderDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.IsDone)
+	})
+
+	t.Run("returns 404 for unknown reminder", func(t *testing.T) {
+		w := sendJSON(setup(&MockReminderRepository{}), http.MethodPatch, "/reminders/missing", `{"is_done":true}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeReminderNotFound))
+	})
+}
+
+func TestReminderHandler_Delete(t *testing.T) {
+	setup := func(mockRepo *MockReminderRepository) *gin.Engine {
+		handler := NewReminderHandler(service.NewReminderService(mockRepo))
+		router := setupTestRouter()
+		router.DELETE("/reminders/:id", mockAuthMiddleware("user-123"), handler.Delete)
+		return router
+	}
+
+	t.Run("deletes reminder", func(t *testing.T) {
+		w := sendJSON(setup(&MockReminderRepository{}), http.MethodDelete, "/reminders/rem-1", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("returns 404 for unknown reminder", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			DeleteFunc: func(ctx context.Context, uid, rid string) error {
+				return model.ErrReminderNotFound
+			},
+		}
+
+		w := sendJSON(setup(mockRepo), http.MethodDelete, "/reminders/missing", "")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestReminderHandler_Complete(t *testing.T) {
+	setup := func(mockRepo *MockReminderRepository) *gin.Engine {
+		handler := NewReminderHandler(service.NewReminderService(mockRepo))
+		router := setupTestRouter()
+		router.POST("/reminders/:id/complete", mockAuthMiddleware("user-123"), handler.Complete)
+		return router
+	}
+
+	t.Run("completes reminder", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			MarkDoneFunc: func(ctx context.Context, uid, rid string) error {
+				assert.Equal(t, "user-123", uid)
+				assert.Equal(t, "rem-1", rid)
+				return nil
+			},
+		}
+
+		w := sendJSON(setup(mockRepo), http.MethodPost, "/reminders/rem-1/complete", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("returns 404 for unknown reminder", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			MarkDoneFunc: func(ctx context.Context, uid, rid string) error {
+				return model.ErrReminderNotFound
+			},
+		}
+
+		w := sendJSON(setup(mockRepo), http.MethodPost, "/reminders/missing/complete", "")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestReminderHandler_CompleteAllForApplication(t *testing.T) {
+	appID := "0f8fad5b-d9cb-469f-a165-70867728950e"
+
+	setup := func(mockRepo *MockReminderRepository) *gin.Engine {
+		handler := NewReminderHandler(service.NewReminderService(mockRepo))
+		router := setupTestRouter()
+		router.POST("/applications/:id/reminders/complete-all", mockAuthMiddleware("user-123"), handler.CompleteAllForApplication)
+		return router
+	}
+
+	t.Run("completes the application's reminders", func(t *testing.T) {
+		called := false
+		mockRepo := &MockReminderRepository{
+			MarkAllDoneByApplicationFunc: func(ctx context.Context, uid, aid string) error {
+				called = true
+				assert.Equal(t, appID, aid)
+				return nil
+			},
+		}
+
+		w := sendJSON(setup(mockRepo), http.MethodPost, "/applications/"+appID+"/reminders/complete-all", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, called)
+	})
+
+	t.Run("returns 404 for another user's application", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			ApplicationOwnedFunc: func(ctx context.Context, uid, aid string) (bool, error) {
+				return false, nil
+			},
+		}
+
+		w := sendJSON(setup(mockRepo), http.MethodPost, "/applications/"+appID+"/reminders/complete-all", "")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeApplicationNotFound))
+	})
+
+	t.Run("returns 404 for a malformed application ID", func(t *testing.T) {
+		w := sendJSON(setup(&MockReminderRepository{}), http.MethodPost, "/applications/not-a-uuid/reminders/complete-all", "")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("returns 500 on repository error", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			MarkAllDoneByApplicationFunc: func(ctx context.Context, uid, aid string) error {
+				return errors.New("database error")
+			},
+		}
+
+		w := sendJSON(setup(mockRepo), http.MethodPost, "/applications/"+appID+"/reminders/complete-all", "")
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestReminderHandler_RegisterRoutes(t *testing.T) {
+	mockRepo := &MockReminderRepository{
+		GetByIDFunc: func(ctx context.Context, uid, rid string) (*model.Reminder, error) {
+			return &model.Reminder{ID: rid, UserID: uid}, nil
+		},
+	}
+	handler := NewReminderHandler(service.NewReminderService(mockRepo))
+
+	router := setupTestRouter()
+	v1 := router.Group("/api/v1")
+	handler.RegisterRoutes(v1, mockAuthMiddleware("user-123"))
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/reminders/upcoming", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	routes := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{http.MethodPost, "/api/v1/reminders", `{}`},
+		{http.MethodGet, "/api/v1/reminders", ""},
+		{http.MethodGet, "/api/v1/reminders/upcoming", ""},
+		{http.MethodPatch, "/api/v1/reminders/rem-1", `{}`},
+		{http.MethodDelete, "/api/v1/reminders/rem-1", ""},
+		{http.MethodPost, "/api/v1/reminders/rem-1/complete", ""},
+		{http.MethodPost, "/api/v1/applications/0f8fad5b-d9cb-469f-a165-70867728950e/reminders/complete-all", ""},
+	}
+
+	for _, route := range routes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			w := sendJSON(router, route.method, route.path, route.body)
+
+			assert.NotEqual(t, http.StatusNotFound, w.Code, "Route %s %s should be registered", route.method, route.path)
+		})
+	}
+}
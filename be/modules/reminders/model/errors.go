@@ -0,0 +1,48 @@
+package model
+
+import (
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
+
+var (
+	// ErrReminderNotFound is returned when a reminder is not found
+	ErrReminderNotFound = &DomainError{Code: CodeReminderNotFound, Message: "reminder not found"}
+
+	// ErrInvalidDays is returned when the upcoming window is not between 1 and 365 days
+	ErrInvalidDays = &DomainError{Code: CodeInvalidDays, Message: "invalid days"}
+
+	// ErrInvalidStatus is returned when a status filter is not pending, done or overdue
+	ErrInvalidStatus = &DomainError{Code: CodeInvalidStatus, Message: "status must be pending, done or overdue"}
+
+	// ErrMessageRequired is returned when a reminder message is empty
+	ErrMessageRequired = &DomainError{Code: CodeMessageRequired, Message: "reminder message is required"}
+
+	// ErrApplicationNotFound is returned when the linked application is not found
+	ErrApplicationNotFound = &DomainError{Code: CodeApplicationNotFound, Message: "application not found"}
+)
+
+// ErrorCode represents error codes
+type ErrorCode string
+
+const (
+	CodeReminderNotFound    ErrorCode = "REMINDER_NOT_FOUND"
+	CodeInvalidDays         ErrorCode = "INVALID_DAYS"
+	CodeInvalidStatus       ErrorCode = "INVALID_STATUS"
+	CodeMessageRequired     ErrorCode = "MESSAGE_REQUIRED"
+	CodeApplicationNotFound ErrorCode = "APPLICATION_NOT_FOUND"
+	CodeInternalError       ErrorCode = "INTERNAL_ERROR"
+)
+
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
+// GetErrorCode maps errors to error codes
+func GetErrorCode(err error) ErrorCode {
+	return domainerr.CodeOf(err, CodeInternalError)
+}
+
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
+}
@@ -1,7 +1,6 @@
 package model
 
 import (
-	"errors"
 	"time"
 )
 
@@ -18,13 +17,13 @@ type Reminder struct {
 }
 
 type ReminderDTO struct {
-	ID            string     `json:"id"`
-	ApplicationID string     `json:"application_id"`
-	StageID       *string    `json:"stage_id,omitempty"`
-	RemindAt      time.Time  `json:"remind_at"`
-	Message       string     `json:"message"`
-	IsDone        bool       `json:"is_done"`
-	CreatedAt     time.Time  `json:"created_at"`
+	ID            string    `json:"id"`
+	ApplicationID string    `json:"application_id"`
+	StageID       *string   `json:"stage_id,omitempty"`
+	RemindAt      time.Time `json:"remind_at"`
+	Message       string    `json:"message"`
+	IsDone        bool      `json:"is_done"`
+	CreatedAt     time.Time `json:"created_at"`
 }
 
 func (r *Reminder) ToDTO() *ReminderDTO {
@@ -39,24 +38,57 @@ func (r *Reminder) ToDTO() *ReminderDTO {
 	}
 }
 
-type CreateReminderRequest struct {
-	ApplicationID string    `json:"application_id" binding:"required"`
-	StageID       *string   `json:"stage_id,omitempty"`
-	RemindAt      time.Time `json:"remind_at" binding:"required"`
-	Message       string    `json:"message" binding:"required,min=1"`
+// ReminderWithApplication is a reminder together with the application it belongs to
+type ReminderWithApplication struct {
+	Reminder
+	ApplicationName   string
+	ApplicationStatus string
 }
 
-type UpdateReminderRequest struct {
-	IsDone *bool `json:"is_done,omitempty"`
+// ReminderWithApplicationDTO represents a reminder in the upcoming reminders inbox
+type ReminderWithApplicationDTO struct {
+	*ReminderDTO
+	ApplicationName   string `json:"application_name"`
+	ApplicationStatus string `json:"application_status"`
 }
 
-var (
-	ErrReminderNotFound = errors.New("reminder not found")
-)
+func (r *ReminderWithApplication) ToDTO() *ReminderWithApplicationDTO {
+	return &ReminderWithApplicationDTO{
+		ReminderDTO:       r.Reminder.ToDTO(),
+		ApplicationName:   r.ApplicationName,
+		ApplicationStatus: r.ApplicationStatus,
+	}
+}
 
-type ErrorCode string
+// Bounds of the days window accepted when listing upcoming reminders
+const (
+	DefaultUpcomingDays = 7
+	MaxUpcomingDays     = 365
+)
 
+// Reminder statuses accepted by the list filter. A reminder is overdue when
+// it is not done and its remind_at has passed; pending ones are still ahead.
 const (
-	CodeReminderNotFound ErrorCode = "REMINDER_NOT_FOUND"
-	CodeInternalError    ErrorCode = "INTERNAL_ERROR"
+	ReminderStatusPending = "pending"
+	ReminderStatusDone    = "done"
+	ReminderStatusOverdue = "overdue"
 )
+
+// ListRemindersFilter narrows the reminders list; empty fields match everything
+type ListRemindersFilter struct {
+	Status        string
+	ApplicationID *string
+}
+
+type CreateReminderRequest struct {
+	ApplicationID string    `json:"application_id" binding:"required,uuid"`
+	StageID       *string   `json:"stage_id,omitempty" binding:"omitempty,uuid"`
+	RemindAt      time.Time `json:"remind_at" binding:"required"`
+	Message       string    `json:"message" binding:"required,min=1"`
+}
+
+type UpdateReminderRequest struct {
+	RemindAt *time.Time `json:"remind_at,omitempty"`
+	Message  *string    `json:"message,omitempty" binding:"omitempty,min=1"`
+	IsDone   *bool      `json:"is_done,omitempty"`
+}
@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/reminders/model"
+)
+
+// ReminderRepository defines the interface for reminder data access
+type ReminderRepository interface {
+	Create(ctx context.Context, reminder *model.Reminder) error
+	GetByID(ctx context.Context, userID, reminderID string) (*model.Reminder, error)
+	ListByUser(ctx context.Context, userID string) ([]*model.Reminder, error)
+	// List returns the user's reminders matching filter, earliest first
+	List(ctx context.Context, userID string, filter *model.ListRemindersFilter) ([]*model.Reminder, error)
+	CountDue(ctx context.Context, userID string, from, to time.Time) (int, error)
+	GetNextForApplication(ctx context.Context, appID string) (*model.Reminder, error)
+	// ListUpcoming returns the user's open reminders due within withinDays, overdue ones included
+	ListUpcoming(ctx context.Context, userID string, withinDays int) ([]*model.ReminderWithApplication, error)
+	Update(ctx context.Context, reminder *model.Reminder) error
+	Delete(ctx context.Context, userID, reminderID string) error
+	// MarkDone marks one of the user's reminders as done
+	MarkDone(ctx context.Context, userID, reminderID string) error
+	// MarkAllDoneByApplication marks every open reminder of the user's application as done
+	MarkAllDoneByApplication(ctx context.Context, userID, appID string) error
+	// ApplicationOwned reports whether the application exists and belongs to the user
+	ApplicationOwned(ctx context.Context, userID, appID string) (bool, error)
+}
@@ -2,21 +2,38 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/andreypavlenko/jobber/modules/reminders/model"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
 type ReminderRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 func NewReminderRepository(pool *pgxpool.Pool) *ReminderRepository {
 	return &ReminderRepository{pool: pool}
 }
 
+// NewReminderRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewReminderRepositoryWithPool(pool DBPool) *ReminderRepository {
+	return &ReminderRepository{pool: pool}
+}
+
 func (r *ReminderRepository) Create(ctx context.Context, reminder *model.Reminder) error {
 	query := `
 		INSERT INTO reminders (id, user_id, application_id, stage_id, remind_at, message, is_done, created_at, updated_at)
@@ -31,10 +48,15 @@ func (r *ReminderRepository) Create(ctx context.Context, reminder *model.Reminde
 	return err
 }
 
+// ListByUser returns the user's reminders, earliest first. Reminders of trashed
+// applications are left out.
 func (r *ReminderRepository) ListByUser(ctx context.Context, userID string) ([]*model.Reminder, error) {
 	query := `
-		SELECT id, user_id, application_id, stage_id, remind_at, message, is_done, created_at, updated_at
-		FROM reminders WHERE user_id = $1 ORDER BY remind_at ASC
+		SELECT r.id, r.user_id, r.application_id, r.stage_id, r.remind_at, r.message, r.is_done, r.created_at, r.updated_at
+		FROM reminders r
+		JOIN applications a ON a.id = r.application_id
+		WHERE r.user_id = $1 AND a.deleted_at IS NULL
+		ORDER BY r.remind_at ASC
 	`
 
 	rows, err := r.pool.Query(ctx, query, userID)
@@ -54,10 +76,119 @@ func (r *ReminderRepository) ListByUser(ctx context.Context, userID string) ([]*
 	return reminders, rows.Err()
 }
 
+// List returns the user's reminders matching filter, earliest first. Reminders
+// of trashed applications are left out.
+func (r *ReminderRepository) List(ctx context.Context, userID string, filter *model.ListRemindersFilter) ([]*model.Reminder, error) {
+	var where strings.Builder
+	args := []interface{}{userID}
+	switch filter.Status {
+	case model.ReminderStatusPending:
+		where.WriteString(" AND r.is_done = false AND r.remind_at >= NOW()")
+	case model.ReminderStatusDone:
+		where.WriteString(" AND r.is_done = true")
+	case model.ReminderStatusOverdue:
+		where.WriteString(" AND r.is_done = false AND r.remind_at < NOW()")
+	}
+	if filter.ApplicationID != nil {
+		args = append(args, *filter.ApplicationID)
+		fmt.Fprintf(&where, " AND r.application_id = $%d", len(args))
+	}
+
+	query := `
+		SELECT r.id, r.user_id, r.application_id, r.stage_id, r.remind_at, r.message, r.is_done, r.created_at, r.updated_at
+		FROM reminders r
+		JOIN applications a ON a.id = r.application_id
+		WHERE r.user_id = $1 AND a.deleted_at IS NULL` + where.String() + `
+		ORDER BY r.remind_at ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reminders := []*model.Reminder{}
+	for rows.Next() {
+		rem := &model.Reminder{}
+		if err := rows.Scan(&rem.ID, &rem.UserID, &rem.ApplicationID, &rem.StageID, &rem.RemindAt, &rem.Message, &rem.IsDone, &rem.CreatedAt, &rem.UpdatedAt); err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, rem)
+	}
+	return reminders, rows.Err()
+}
+
+// CountDue returns the number of open reminders due in [from, to), leaving out
+// those of trashed applications
+func (r *ReminderRepository) CountDue(ctx context.Context, userID string, from, to time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM reminders r
+		JOIN applications a ON a.id = r.application_id
+		WHERE r.user_id = $1 AND a.deleted_at IS NULL AND r.is_done = false AND r.remind_at >= $2 AND r.remind_at < $3
+	`
+	var count int
+	err := r.pool.QueryRow(ctx, query, userID, from, to).Scan(&count)
+	return count, err
+}
+
+// GetNextForApplication returns the earliest open reminder of an application that
+// is still in the future, or ErrReminderNotFound when there is none
+func (r *ReminderRepository) GetNextForApplication(ctx context.Context, appID string) (*model.Reminder, error) {
+	query := `
+		SELECT id, user_id, application_id, stage_id, remind_at, message, is_done, created_at, updated_at
+		FROM reminders
+		WHERE application_id = $1 AND is_done = false AND remind_at > NOW()
+		ORDER BY remind_at ASC
+		LIMIT 1
+	`
+	rem := &model.Reminder{}
+	err := r.pool.QueryRow(ctx, query, appID).Scan(&rem.ID, &rem.UserID, &rem.ApplicationID, &rem.StageID, &rem.RemindAt, &rem.Message, &rem.IsDone, &rem.CreatedAt, &rem.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrReminderNotFound
+		}
+		return nil, err
+	}
+	return rem, nil
+}
+
+// ListUpcoming returns the user's open reminders due within withinDays from now,
+// including overdue ones, earliest first
+func (r *ReminderRepository) ListUpcoming(ctx context.Context, userID string, withinDays int) ([]*model.ReminderWithApplication, error) {
+	query := `
+		SELECT r.id, r.user_id, r.application_id, r.stage_id, r.remind_at, r.message, r.is_done, r.created_at, r.updated_at,
+			a.name, a.status
+		FROM reminders r
+		JOIN applications a ON a.id = r.application_id
+		WHERE r.user_id = $1 AND r.is_done = false AND a.deleted_at IS NULL AND r.remind_at <= NOW() + make_interval(days => $2)
+		ORDER BY r.remind_at ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, withinDays)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reminders []*model.ReminderWithApplication
+	for rows.Next() {
+		rem := &model.ReminderWithApplication{}
+		if err := rows.Scan(&rem.ID, &rem.UserID, &rem.ApplicationID, &rem.StageID, &rem.RemindAt, &rem.Message, &rem.IsDone, &rem.CreatedAt, &rem.UpdatedAt, &rem.ApplicationName, &rem.ApplicationStatus); err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, rem)
+	}
+	return reminders, rows.Err()
+}
+
 func (r *ReminderRepository) Update(ctx context.Context, reminder *model.Reminder) error {
-	query := `UPDATE reminders SET is_done = $2, updated_at = $3 WHERE id = $1`
+	query := `
+		UPDATE reminders SET remind_at = $3, message = $4, is_done = $5, updated_at = $6
+		WHERE id = $1 AND user_id = $2
+	`
 	reminder.UpdatedAt = time.Now().UTC()
-	result, err := r.pool.Exec(ctx, query, reminder.ID, reminder.IsDone, reminder.UpdatedAt)
+	result, err := r.pool.Exec(ctx, query, reminder.ID, reminder.UserID, reminder.RemindAt, reminder.Message, reminder.IsDone, reminder.UpdatedAt)
 	if err != nil {
 		return err
 	}
@@ -67,6 +198,51 @@ func (r *ReminderRepository) Update(ctx context.Context, reminder *model.Reminde
 	return nil
 }
 
+func (r *ReminderRepository) Delete(ctx context.Context, userID, reminderID string) error {
+	query := `DELETE FROM reminders WHERE id = $1 AND user_id = $2`
+	result, err := r.pool.Exec(ctx, query, reminderID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrReminderNotFound
+	}
+	return nil
+}
+
+// MarkDone marks one of the user's reminders as done
+func (r *ReminderRepository) MarkDone(ctx context.Context, userID, reminderID string) error {
+	query := `UPDATE reminders SET is_done = true, updated_at = NOW() WHERE id = $1 AND user_id = $2`
+	result, err := r.pool.Exec(ctx, query, reminderID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrReminderNotFound
+	}
+	return nil
+}
+
+// MarkAllDoneByApplication marks every open reminder of the user's application as done
+func (r *ReminderRepository) MarkAllDoneByApplication(ctx context.Context, userID, appID string) error {
+	query := `
+		UPDATE reminders SET is_done = true, updated_at = NOW()
+		WHERE application_id = $1 AND user_id = $2 AND is_done = false
+	`
+	_, err := r.pool.Exec(ctx, query, appID, userID)
+	return err
+}
+
+// ApplicationOwned reports whether the application exists and belongs to the user
+func (r *ReminderRepository) ApplicationOwned(ctx context.Context, userID, appID string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM applications WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL)`
+	var owned bool
+	if err := r.pool.QueryRow(ctx, query, appID, userID).Scan(&owned); err != nil {
+		return false, err
+	}
+	return owned, nil
+}
+
 func (r *ReminderRepository) GetByID(ctx context.Context, userID, reminderID string) (*model.Reminder, error) {
 	query := `
 		SELECT id, user_id, application_id, stage_id, remind_at, message, is_done, created_at, updated_at
@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/reminders/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReminderRepository_GetNextForApplication(t *testing.T) {
+	columns := []string{"id", "user_id", "application_id", "stage_id", "remind_at", "message", "is_done", "created_at", "updated_at"}
+
+	t.Run("returns earliest upcoming reminder", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		remindAt := now.Add(24 * time.Hour)
+		mock.ExpectQuery(`is_done = false AND remind_at > NOW\(\)\s+ORDER BY remind_at ASC\s+LIMIT 1`).
+			WithArgs("app-1").
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("rem-1", "user-123", "app-1", nil, remindAt, "Follow up", false, now, now))
+
+		repo := NewReminderRepositoryWithPool(mock)
+		reminder, err := repo.GetNextForApplication(context.Background(), "app-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "rem-1", reminder.ID)
+		assert.Equal(t, remindAt, reminder.RemindAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found when nothing is upcoming", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM reminders").
+			WithArgs("app-1").
+			WillReturnError(pgx.ErrNoRows)
+
+		repo := NewReminderRepositoryWithPool(mock)
+		reminder, err := repo.GetNextForApplication(context.Background(), "app-1")
+
+		assert.Nil(t, reminder)
+		assert.ErrorIs(t, err, model.ErrReminderNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM reminders").
+			WithArgs("app-1").
+			WillReturnError(assert.AnError)
+
+		repo := NewReminderRepositoryWithPool(mock)
+		_, err = repo.GetNextForApplication(context.Background(), "app-1")
+
+		assert.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReminderRepository_ListUpcoming(t *testing.T) {
+	columns := []string{"id", "user_id", "application_id", "stage_id", "remind_at", "message", "is_done", "created_at", "updated_at", "name", "status"}
+
+	t.Run("returns open reminders with their application", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`JOIN applications a ON a.id = r.application_id\s+WHERE r.user_id = \$1 AND r.is_done = false AND a.deleted_at IS NULL AND r.remind_at <= NOW\(\) \+ make_interval\(days => \$2\)\s+ORDER BY r.remind_at ASC`).
+			WithArgs("user-123", 7).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("rem-1", "user-123", "app-1", nil, now.Add(-time.Hour), "Overdue", false, now, now, "Backend Engineer", "active").
+				AddRow("rem-2", "user-123", "app-2", nil, now.Add(48*time.Hour), "Prepare", false, now, now, "Data Engineer", "on_hold"))
+
+		repo := NewReminderRepositoryWithPool(mock)
+		reminders, err := repo.ListUpcoming(context.Background(), "user-123", 7)
+
+		require.NoError(t, err)
+		require.Len(t, reminders, 2)
+		assert.Equal(t, "rem-1", reminders[0].ID)
+		assert.Equal(t, "Backend Engineer", reminders[0].ApplicationName)
+		assert.Equal(t, "on_hold", reminders[1].ApplicationStatus)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM reminders r").
+			WithArgs("user-123", 7).
+			WillReturnError(assert.AnError)
+
+		repo := NewReminderRepositoryWithPool(mock)
+		reminders, err := repo.ListUpcoming(context.Background(), "user-123", 7)
+
+		assert.Nil(t, reminders)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestReminderRepository_List(t *testing.T) {
+	columns := []string{"id", "user_id", "application_id", "stage_id", "remind_at", "message", "is_done", "created_at", "updated_at"}
+	appID := "app-1"
+
+	tests := []struct {
+		name   string
+		filter *model.ListRemindersFilter
+		where  string
+		args   []interface{}
+	}{
+		{"all reminders", &model.ListRemindersFilter{}, `JOIN applications a ON a.id = r.application_id\s+WHERE r.user_id = \$1 AND a.deleted_at IS NULL\s+ORDER BY r.remind_at ASC`, []interface{}{"user-123"}},
+		{"pending", &model.ListRemindersFilter{Status: model.ReminderStatusPending}, `WHERE r.user_id = \$1 AND a.deleted_at IS NULL AND r.is_done = false AND r.remind_at >= NOW\(\)\s+ORDER BY`, []interface{}{"user-123"}},
+		{"done", &model.ListRemindersFilter{Status: model.ReminderStatusDone}, `WHERE r.user_id = \$1 AND a.deleted_at IS NULL AND r.is_done = true\s+ORDER BY`, []interface{}{"user-123"}},
+		{"overdue", &model.ListRemindersFilter{Status: model.ReminderStatusOverdue}, `WHERE r.user_id = \$1 AND a.deleted_at IS NULL AND r.is_done = false AND r.remind_at < NOW\(\)\s+ORDER BY`, []interface{}{"user-123"}},
+		{"overdue for an application", &model.ListRemindersFilter{Status: model.ReminderStatusOverdue, ApplicationID: &appID}, `r.remind_at < NOW\(\) AND r.application_id = \$2\s+ORDER BY`, []interface{}{"user-123", appID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			now := time.Now()
+			mock.ExpectQuery(tt.where).
+				WithArgs(tt.args...).
+				WillReturnRows(pgxmock.NewRows(columns).
+					AddRow("rem-1", "user-123", appID, nil, now, "Follow up", false, now, now))
+
+			repo := NewReminderRepositoryWithPool(mock)
+			reminders, err := repo.List(context.Background(), "user-123", tt.filter)
+
+			require.NoError(t, err)
+			require.Len(t, reminders, 1)
+			assert.Equal(t, "rem-1", reminders[0].ID)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReminderRepository_ListByUser(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`JOIN applications a ON a.id = r.application_id\s+WHERE r.user_id = \$1 AND a.deleted_at IS NULL\s+ORDER BY r.remind_at ASC`).
+		WithArgs("user-123").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "application_id", "stage_id", "remind_at", "message", "is_done", "created_at", "updated_at"}).
+			AddRow("rem-1", "user-123", "app-1", nil, now, "Follow up", false, now, now))
+
+	repo := NewReminderRepositoryWithPool(mock)
+	reminders, err := repo.ListByUser(context.Background(), "user-123")
+
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	assert.Equal(t, "rem-1", reminders[0].ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReminderRepository_CountDue(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	from := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	mock.ExpectQuery(`JOIN applications a ON a.id = r.application_id\s+WHERE r.user_id = \$1 AND a.deleted_at IS NULL AND r.is_done = false AND r.remind_at >= \$2 AND r.remind_at < \$3`).
+		WithArgs("user-123", from, to).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(3))
+
+	repo := NewReminderRepositoryWithPool(mock)
+	count, err := repo.CountDue(context.Background(), "user-123", from, to)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReminderRepository_Update(t *testing.T) {
+	t.Run("updates a reminder scoped to its owner", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		remindAt := time.Now().Add(time.Hour)
+		mock.ExpectExec(`UPDATE reminders SET remind_at = \$3, message = \$4, is_done = \$5`).
+			WithArgs("rem-1", "user-123", remindAt, "Follow up", true, pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		repo := NewReminderRepositoryWithPool(mock)
+		err = repo.Update(context.Background(), &model.Reminder{ID: "rem-1", UserID: "user-123", RemindAt: remindAt, Message: "Follow up", IsDone: true})
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found for another user's reminder", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("UPDATE reminders").
+			WithArgs("rem-1", "user-456", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		repo := NewReminderRepositoryWithPool(mock)
+		err = repo.Update(context.Background(), &model.Reminder{ID: "rem-1", UserID: "user-456"})
+
+		assert.ErrorIs(t, err, model.ErrReminderNotFound)
+	})
+}
+
+func TestReminderRepository_Delete(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec(`DELETE FROM reminders WHERE id = \$1 AND user_id = \$2`).
+		WithArgs("rem-1", "user-123").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	repo := NewReminderRepositoryWithPool(mock)
+	err = repo.Delete(context.Background(), "user-123", "rem-1")
+
+	assert.ErrorIs(t, err, model.ErrReminderNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReminderRepository_MarkDone(t *testing.T) {
+	t.Run("marks the reminder done", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`UPDATE reminders SET is_done = true, updated_at = NOW\(\) WHERE id = \$1 AND user_id = \$2`).
+			WithArgs("rem-1", "user-123").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		repo := NewReminderRepositoryWithPool(mock)
+		err = repo.MarkDone(context.Background(), "user-123", "rem-1")
+
+		assert.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found for another user's reminder", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`UPDATE reminders SET is_done = true`).
+			WithArgs("rem-1", "user-123").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		repo := NewReminderRepositoryWithPool(mock)
+		err = repo.MarkDone(context.Background(), "user-123", "rem-1")
+
+		assert.ErrorIs(t, err, model.ErrReminderNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReminderRepository_MarkAllDoneByApplication(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec(`UPDATE reminders SET is_done = true, updated_at = NOW\(\)\s+WHERE application_id = \$1 AND user_id = \$2 AND is_done = false`).
+		WithArgs("app-1", "user-123").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	repo := NewReminderRepositoryWithPool(mock)
+	err = repo.MarkAllDoneByApplication(context.Background(), "user-123", "app-1")
+
+	assert.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/andreypavlenko/jobber/modules/reminders/model"
+	"github.com/andreypavlenko/jobber/modules/reminders/ports"
+)
+
+type ReminderService struct {
+	repo ports.ReminderRepository
+}
+
+func NewReminderService(repo ports.ReminderRepository) *ReminderService {
+	return &ReminderService{repo: repo}
+}
+
+// ListUpcoming returns the user's open reminders due within the next days days,
+// overdue ones included. days must be between 1 and MaxUpcomingDays.
+func (s *ReminderService) ListUpcoming(ctx context.Context, userID string, days int) ([]*model.ReminderWithApplicationDTO, error) {
+	if days < 1 || days > model.MaxUpcomingDays {
+		return nil, model.ErrInvalidDays
+	}
+
+	reminders, err := s.repo.ListUpcoming(ctx, userID, days)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*model.ReminderWithApplicationDTO, len(reminders))
+	for i, reminder := range reminders {
+		dtos[i] = reminder.ToDTO()
+	}
+	return dtos, nil
+}
+
+// Create creates a reminder on one of the user's applications
+func (s *ReminderService) Create(ctx context.Context, userID string, req *model.CreateReminderRequest) (*model.ReminderDTO, error) {
+	message := strings.TrimSpace(req.Message)
+	if message == "" {
+		return nil, model.ErrMessageRequired
+	}
+	if err := s.checkApplication(ctx, userID, req.ApplicationID); err != nil {
+		return nil, err
+	}
+
+	reminder := &model.Reminder{
+		UserID:        userID,
+		ApplicationID: req.ApplicationID,
+		StageID:       req.StageID,
+		RemindAt:      req.RemindAt.UTC(),
+		Message:       message,
+	}
+	if err := s.repo.Create(ctx, reminder); err != nil {
+		return nil, err
+	}
+	return reminder.ToDTO(), nil
+}
+
+// List returns the user's reminders, optionally narrowed to a status and an
+// application, earliest first
+func (s *ReminderService) List(ctx context.Context, userID string, filter *model.ListRemindersFilter) ([]*model.ReminderDTO, error) {
+	switch filter.Status {
+	case "", model.ReminderStatusPending, model.ReminderStatusDone, model.ReminderStatusOverdue:
+	default:
+		return nil, model.ErrInvalidStatus
+	}
+	if filter.ApplicationID != nil {
+		if err := s.checkApplication(ctx, userID, *filter.ApplicationID); err != nil {
+			return nil, err
+		}
+	}
+
+	reminders, err := s.repo.List(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*model.ReminderDTO, len(reminders))
+	for i, reminder := range reminders {
+		dtos[i] = reminder.ToDTO()
+	}
+	return dtos, nil
+}
+
+// Update changes the time, message or done flag of a reminder
+func (s *ReminderService) Update(ctx context.Context, userID, reminderID string, req *model.UpdateReminderRequest) (*model.ReminderDTO, error) {
+	reminder, err := s.repo.GetByID(ctx, userID, reminderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.RemindAt != nil {
+		reminder.RemindAt = req.RemindAt.UTC()
+	}
+	if req.Message != nil {
+		message := strings.TrimSpace(*req.Message)
+		if message == "" {
+			return nil, model.ErrMessageRequired
+		}
+		reminder.Message = message
+	}
+	if req.IsDone != nil {
+		reminder.IsDone = *req.IsDone
+	}
+
+	if err := s.repo.Update(ctx, reminder); err != nil {
+		return nil, err
+	}
+	return reminder.ToDTO(), nil
+}
+
+// Delete deletes a reminder of the user
+func (s *ReminderService) Delete(ctx context.Context, userID, reminderID string) error {
+	return s.repo.Delete(ctx, userID, reminderID)
+}
+
+// MarkDone completes a reminder of the user
+func (s *ReminderService) MarkDone(ctx context.Context, userID, reminderID string) error {
+	return s.repo.MarkDone(ctx, userID, reminderID)
+}
+
+// MarkAllDoneByApplication completes every open reminder of one of the user's applications
+func (s *ReminderService) MarkAllDoneByApplication(ctx context.Context, userID, appID string) error {
+	if err := s.checkApplication(ctx, userID, appID); err != nil {
+		return err
+	}
+	return s.repo.MarkAllDoneByApplication(ctx, userID, appID)
+}
+
+// checkApplication returns ErrApplicationNotFound unless the application belongs to the user
+func (s *ReminderService) checkApplication(ctx context.Context, userID, appID string) error {
+	owned, err := s.repo.ApplicationOwned(ctx, userID, appID)
+	if err != nil {
+		return err
+	}
+	if !owned {
+		return model.ErrApplicationNotFound
+	}
+	return nil
+}
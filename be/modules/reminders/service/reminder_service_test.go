@@ -0,0 +1,402 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/reminders/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockReminderRepository implements ports.ReminderRepository
+type MockReminderRepository struct {
+	CreateFunc                   func(ctx context.Context, reminder *model.Reminder) error
+	GetByIDFunc                  func(ctx context.Context, userID, reminderID string) (*model.Reminder, error)
+	ListFunc                     func(ctx context.Context, userID string, filter *model.ListRemindersFilter) ([]*model.Reminder, error)
+	ListUpcomingFunc             func(ctx context.Context, userID string, withinDays int) ([]*model.ReminderWithApplication, error)
+	UpdateFunc                   func(ctx context.Context, reminder *model.Reminder) error
+	DeleteFunc                   func(ctx context.Context, userID, reminderID string) error
+	MarkDoneFunc                 func(ctx context.Context, userID, reminderID string) error
+	MarkAllDoneByApplicationFunc func(ctx context.Context, userID, appID string) error
+	ApplicationOwnedFunc         func(ctx context.Context, userID, appID string) (bool, error)
+}
+
+func (m *MockReminderRepository) Create(ctx context.Context, reminder *model.Reminder) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, reminder)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) GetByID(ctx context.Context, userID, reminderID string) (*model.Reminder, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, userID, reminderID)
+	}
+	return nil, model.ErrReminderNotFound
+}
+
+func (m *MockReminderRepository) ListByUser(ctx context.Context, userID string) ([]*model.Reminder, error) {
+	return nil, nil
+}
+
+func (m *MockReminderRepository) List(ctx context.Context, userID string, filter *model.ListRemindersFilter) ([]*model.Reminder, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, userID, filter)
+	}
+	return nil, nil
+}
+
+func (m *MockReminderRepository) CountDue(ctx context.Context, userID string, from, to time.Time) (int, error) {
+	return 0, nil
+}
+
+func (m *MockReminderRepository) GetNextForApplication(ctx context.Context, appID string) (*model.Reminder, error) {
+	return nil, model.ErrReminderNotFound
+}
+
+func (m *MockReminderRepository) ListUpcoming(ctx context.Context, userID string, withinDays int) ([]*model.ReminderWithApplication, error) {
+	if m.ListUpcomingFunc != nil {
+		return m.ListUpcomingFunc(ctx, userID, withinDays)
+	}
+	return nil, nil
+}
+
+func (m *MockReminderRepository) Update(ctx context.Context, reminder *model.Reminder) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, reminder)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) Delete(ctx context.Context, userID, reminderID string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID, reminderID)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) MarkDone(ctx context.Context, userID, reminderID string) error {
+	if m.MarkDoneFunc != nil {
+		return m.MarkDoneFunc(ctx, userID, reminderID)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) MarkAllDoneByApplication(ctx context.Context, userID, appID string) error {
+	if m.MarkAllDoneByApplicationFunc != nil {
+		return m.MarkAllDoneByApplicationFunc(ctx, userID, appID)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) ApplicationOwned(ctx context.Context, userID, appID string) (bool, error) {
+	if m.ApplicationOwnedFunc != nil {
+		return m.ApplicationOwnedFunc(ctx, userID, appID)
+	}
+	return true, nil
+}
+
+func TestReminderService_ListUpcoming(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("returns reminders with their application", func(t *testing.T) {
+		remindAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+		mockRepo := &MockReminderRepository{
+			ListUpcomingFunc: func(ctx context.Context, uid string, withinDays int) ([]*model.ReminderWithApplication, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, 7, withinDays)
+				return []*model.ReminderWithApplication{
+					{
+						Reminder:          model.Reminder{ID: "rem-1", ApplicationID: "app-1", RemindAt: remindAt, Message: "Follow up"},
+						ApplicationName:   "Backend Engineer",
+						ApplicationStatus: "active",
+					},
+				}, nil
+			},
+		}
+
+		svc := NewReminderService(mockRepo)
+		result, err := svc.ListUpcoming(context.Background(), userID, 7)
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "rem-1", result[0].ID)
+		assert.Equal(t, remindAt, result[0].RemindAt)
+		assert.Equal(t, "Backend Engineer", result[0].ApplicationName)
+		assert.Equal(t, "active", result[0].ApplicationStatus)
+	})
+
+	t.Run("accepts the window bounds", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{}
+
+		svc := NewReminderService(mockRepo)
+		for _, days := range []int{1, model.MaxUpcomingDays} {
+			result, err := svc.ListUpcoming(context.Background(), userID, days)
+			require.NoError(t, err, "days=%d", days)
+			assert.NotNil(t, result)
+		}
+	})
+
+	t.Run("rejects days outside the window", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			ListUpcomingFunc: func(ctx context.Context, uid string, withinDays int) ([]*model.ReminderWithApplication, error) {
+				t.Fatal("repository must not be called for an invalid window")
+				return nil, nil
+			},
+		}
+
+		svc := NewReminderService(mockRepo)
+		for _, days := range []int{-1, 0, model.MaxUpcomingDays + 1} {
+			result, err := svc.ListUpcoming(context.Background(), userID, days)
+			assert.ErrorIs(t, err, model.ErrInvalidDays, "days=%d", days)
+			assert.Nil(t, result)
+		}
+	})
+
+	t.Run("returns repository error", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			ListUpcomingFunc: func(ctx context.Context, uid string, withinDays int) ([]*model.ReminderWithApplication, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := NewReminderService(mockRepo)
+		result, err := svc.ListUpcoming(context.Background(), userID, 7)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestReminderService_Create(t *testing.T) {
+	userID := "user-123"
+	appID := "11111111-1111-1111-1111-111111111111"
+	remindAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	t.Run("creates reminder on an owned application", func(t *testing.T) {
+		var created *model.Reminder
+		mockRepo := &MockReminderRepository{
+			ApplicationOwnedFunc: func(ctx context.Context, uid, aid string) (bool, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, appID, aid)
+				return true, nil
+			},
+			CreateFunc: func(ctx context.Context, reminder *model.Reminder) error {
+				reminder.ID = "rem-1"
+				created = reminder
+				return nil
+			},
+		}
+
+		svc := NewReminderService(mockRepo)
+		result, err := svc.Create(context.Background(), userID, &model.CreateReminderRequest{
+			ApplicationID: appID,
+			RemindAt:      remindAt,
+			Message:       "  Follow up  ",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, created.UserID)
+		assert.False(t, created.IsDone)
+		assert.Equal(t, "rem-1", result.ID)
+		assert.Equal(t, "Follow up", result.Message)
+		assert.Equal(t, remindAt, result.RemindAt)
+	})
+
+	t.Run("rejects another user's application", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			ApplicationOwnedFunc: func(ctx context.Context, uid, aid string) (bool, error) {
+				return false, nil
+			},
+			CreateFunc: func(ctx context.Context, reminder *model.Reminder) error {
+				t.Fatal("Create should not be called")
+				return nil
+			},
+		}
+
+		svc := NewReminderService(mockRepo)
+		_, err := svc.Create(context.Background(), userID, &model.CreateReminderRequest{ApplicationID: appID, RemindAt: remindAt, Message: "Follow up"})
+
+		assert.Equal(t, model.ErrApplicationNotFound, err)
+	})
+
+	t.Run("rejects blank message", func(t *testing.T) {
+		svc := NewReminderService(&MockReminderRepository{})
+		_, err := svc.Create(context.Background(), userID, &model.CreateReminderRequest{ApplicationID: appID, RemindAt: remindAt, Message: "   "})
+
+		assert.Equal(t, model.ErrMessageRequired, err)
+	})
+}
+
+func TestReminderService_List(t *testing.T) {
+	userID := "user-123"
+	appID := "11111111-1111-1111-1111-111111111111"
+
+	for _, status := range []string{"", model.ReminderStatusPending, model.ReminderStatusDone, model.ReminderStatusOverdue} {
+		t.Run("passes status "+status, func(t *testing.T) {
+			mockRepo := &MockReminderRepository{
+				ListFunc: func(ctx context.Context, uid string, filter *model.ListRemindersFilter) ([]*model.Reminder, error) {
+					assert.Equal(t, status, filter.Status)
+					return []*model.Reminder{{ID: "rem-1"}}, nil
+				},
+			}
+
+			svc := NewReminderService(mockRepo)
+			result, err := svc.List(context.Background(), userID, &model.ListRemindersFilter{Status: status})
+
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+			assert.Equal(t, "rem-1", result[0].ID)
+		})
+	}
+
+	t.Run("rejects unknown status", func(t *testing.T) {
+		svc := NewReminderService(&MockReminderRepository{})
+		_, err := svc.List(context.Background(), userID, &model.ListRemindersFilter{Status: "snoozed"})
+
+		assert.Equal(t, model.ErrInvalidStatus, err)
+	})
+
+	t.Run("rejects another user's application filter", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			ApplicationOwnedFunc: func(ctx context.Context, uid, aid string) (bool, error) {
+				return false, nil
+			},
+		}
+
+		svc := NewReminderService(mockRepo)
+		_, err := svc.List(context.Background(), userID, &model.ListRemindersFilter{ApplicationID: &appID})
+
+		assert.Equal(t, model.ErrApplicationNotFound, err)
+	})
+}
+
+func TestReminderService_Update(t *testing.T) {
+	userID := "user-123"
+	remindAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	existing := func() *MockReminderRepository {
+		return &MockReminderRepository{
+			GetByIDFunc: func(ctx context.Context, uid, rid string) (*model.Reminder, error) {
+				assert.Equal(t, userID, uid)
+				return &model.Reminder{ID: rid, UserID: uid, RemindAt: remindAt, Message: "Follow up"}, nil
+			},
+		}
+	}
+
+	t.Run("updates only the given fields", func(t *testing.T) {
+		mockRepo := existing()
+		var updated *model.Reminder
+		mockRepo.UpdateFunc = func(ctx context.Context, reminder *model.Reminder) error {
+			updated = reminder
+			return nil
+		}
+		done := true
+
+		svc := NewReminderService(mockRepo)
+		result, err := svc.Update(context.Background(), userID, "rem-1", &model.UpdateReminderRequest{IsDone: &done})
+
+		require.NoError(t, err)
+		assert.True(t, updated.IsDone)
+		assert.Equal(t, "Follow up", result.Message)
+		assert.Equal(t, remindAt, result.RemindAt)
+	})
+
+	t.Run("reschedules and rewords", func(t *testing.T) {
+		later := remindAt.Add(48 * time.Hour)
+		message := "Send thank-you note"
+
+		svc := NewReminderService(existing())
+		result, err := svc.Update(context.Background(), userID, "rem-1", &model.UpdateReminderRequest{RemindAt: &later, Message: &message})
+
+		require.NoError(t, err)
+		assert.Equal(t, later, result.RemindAt)
+		assert.Equal(t, message, result.Message)
+	})
+
+	t.Run("rejects blank message", func(t *testing.T) {
+		blank := " "
+
+		svc := NewReminderService(existing())
+		_, err := svc.Update(context.Background(), userID, "rem-1", &model.UpdateReminderRequest{Message: &blank})
+
+		assert.Equal(t, model.ErrMessageRequired, err)
+	})
+
+	t.Run("returns not found for another user's reminder", func(t *testing.T) {
+		svc := NewReminderService(&MockReminderRepository{})
+		_, err := svc.Update(context.Background(), userID, "rem-1", &model.UpdateReminderRequest{})
+
+		assert.Equal(t, model.ErrReminderNotFound, err)
+	})
+}
+
+func TestReminderService_Delete(t *testing.T) {
+	mockRepo := &MockReminderRepository{
+		DeleteFunc: func(ctx context.Context, uid, rid string) error {
+			assert.Equal(t, "user-123", uid)
+			assert.Equal(t, "rem-1", rid)
+			return model.ErrReminderNotFound
+		},
+	}
+
+	svc := NewReminderService(mockRepo)
+	err := svc.Delete(context.Background(), "user-123", "rem-1")
+
+	assert.Equal(t, model.ErrReminderNotFound, err)
+}
+
+func TestReminderService_MarkDone(t *testing.T) {
+	mockRepo := &MockReminderRepository{
+		MarkDoneFunc: func(ctx context.Context, uid, rid string) error {
+			assert.Equal(t, "user-123", uid)
+			assert.Equal(t, "rem-1", rid)
+			return model.ErrReminderNotFound
+		},
+	}
+
+	svc := NewReminderService(mockRepo)
+	err := svc.MarkDone(context.Background(), "user-123", "rem-1")
+
+	assert.Equal(t, model.ErrReminderNotFound, err)
+}
+
+func TestReminderService_MarkAllDoneByApplication(t *testing.T) {
+	t.Run("completes the application's reminders", func(t *testing.T) {
+		called := false
+		mockRepo := &MockReminderRepository{
+			MarkAllDoneByApplicationFunc: func(ctx context.Context, uid, aid string) error {
+				called = true
+				assert.Equal(t, "user-123", uid)
+				assert.Equal(t, "app-1", aid)
+				return nil
+			},
+		}
+
+		svc := NewReminderService(mockRepo)
+		err := svc.MarkAllDoneByApplication(context.Background(), "user-123", "app-1")
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("returns error for another user's application", func(t *testing.T) {
+		mockRepo := &MockReminderRepository{
+			ApplicationOwnedFunc: func(ctx context.Context, uid, aid string) (bool, error) {
+				return false, nil
+			},
+			MarkAllDoneByApplicationFunc: func(ctx context.Context, uid, aid string) error {
+				t.Fatal("reminders of a foreign application must not be touched")
+				return nil
+			},
+		}
+
+		svc := NewReminderService(mockRepo)
+		err := svc.MarkAllDoneByApplication(context.Background(), "user-123", "app-1")
+
+		assert.ErrorIs(t, err, model.ErrApplicationNotFound)
+	})
+}
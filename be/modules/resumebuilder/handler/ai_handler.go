@@ -167,7 +167,7 @@ func (h *AIHandler) handleError(c *gin.Context, err error) {
 	}
 
 	errorCode := model.GetErrorCode(err)
-	errorMessage := model.GetErrorMessage(err)
+	errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 	statusCode := http.StatusInternalServerError
 	switch errorCode {
@@ -168,7 +168,7 @@ func (h *ExportHandler) handleError(c *gin.Context, err error) {
 	}
 
 	errorCode := model.GetErrorCode(err)
-	errorMessage := model.GetErrorMessage(err)
+	errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 	statusCode := http.StatusInternalServerError
 	switch errorCode {
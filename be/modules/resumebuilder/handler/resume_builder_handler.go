@@ -174,7 +174,7 @@ func (h *ResumeBuilderHandler) handleError(c *gin.Context, err error) {
 	}
 
 	errorCode := model.GetErrorCode(err)
-	errorMessage := model.GetErrorMessage(err)
+	errorMessage := model.GetErrorMessage(err, auth.GetLocale(c))
 
 	statusCode := http.StatusInternalServerError
 	switch errorCode {
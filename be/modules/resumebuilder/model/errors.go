@@ -1,22 +1,25 @@
 package model
 
-import "errors"
+import (
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
 
 var (
-	ErrResumeBuilderNotFound = errors.New("resume builder not found")
-	ErrNotOwner              = errors.New("not the owner of this resume")
-	ErrSectionEntryNotFound  = errors.New("section entry not found")
-	ErrInvalidTemplate       = errors.New("invalid template")
-	ErrInvalidSpacing        = errors.New("spacing must be between 50 and 150")
-	ErrInvalidColor          = errors.New("invalid color format")
-	ErrInvalidFont           = errors.New("invalid font family")
-	ErrInvalidSectionKey     = errors.New("invalid section key")
-	ErrInvalidMargin         = errors.New("margin must be between 0 and 200")
-	ErrInvalidLayoutMode     = errors.New("layout mode must be single, double-left, double-right, or custom")
-	ErrInvalidSidebarWidth   = errors.New("sidebar width must be between 25 and 50")
-	ErrInvalidColumnValue    = errors.New("column must be main or sidebar")
-	ErrInvalidFontSize       = errors.New("font size must be between 8 and 18")
-	ErrInvalidSkillDisplay   = errors.New("invalid skill display mode")
+	ErrResumeBuilderNotFound = &DomainError{Code: CodeResumeBuilderNotFound, Message: "resume builder not found"}
+	ErrNotOwner              = &DomainError{Code: CodeNotOwner, Message: "not the owner of this resume"}
+	ErrSectionEntryNotFound  = &DomainError{Code: CodeSectionEntryNotFound, Message: "section entry not found"}
+	ErrInvalidTemplate       = &DomainError{Code: CodeInvalidTemplate, Message: "invalid template"}
+	ErrInvalidSpacing        = &DomainError{Code: CodeInvalidSpacing, Message: "spacing must be between 50 and 150"}
+	ErrInvalidColor          = &DomainError{Code: CodeInvalidColor, Message: "invalid color format"}
+	ErrInvalidFont           = &DomainError{Code: CodeInvalidFont, Message: "invalid font family"}
+	ErrInvalidSectionKey     = &DomainError{Code: CodeInvalidSectionKey, Message: "invalid section key"}
+	ErrInvalidMargin         = &DomainError{Code: CodeInvalidMargin, Message: "margin must be between 0 and 200"}
+	ErrInvalidLayoutMode     = &DomainError{Code: CodeInvalidLayoutMode, Message: "layout mode must be single, double-left, double-right, or custom"}
+	ErrInvalidSidebarWidth   = &DomainError{Code: CodeInvalidSidebarWidth, Message: "sidebar width must be between 25 and 50"}
+	ErrInvalidColumnValue    = &DomainError{Code: CodeInvalidColumnValue, Message: "column must be main or sidebar"}
+	ErrInvalidFontSize       = &DomainError{Code: CodeInvalidFontSize, Message: "font size must be between 8 and 18"}
+	ErrInvalidSkillDisplay   = &DomainError{Code: CodeInvalidSkillDisplay, Message: "invalid skill display mode"}
 )
 
 // ErrorCode represents error codes for the resume builder module.
@@ -40,74 +43,15 @@ const (
 	CodeInternalError         ErrorCode = "INTERNAL_ERROR"
 )
 
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
 // GetErrorCode maps errors to error codes.
 func GetErrorCode(err error) ErrorCode {
-	switch {
-	case errors.Is(err, ErrResumeBuilderNotFound):
-		return CodeResumeBuilderNotFound
-	case errors.Is(err, ErrNotOwner):
-		return CodeNotOwner
-	case errors.Is(err, ErrSectionEntryNotFound):
-		return CodeSectionEntryNotFound
-	case errors.Is(err, ErrInvalidTemplate):
-		return CodeInvalidTemplate
-	case errors.Is(err, ErrInvalidSpacing):
-		return CodeInvalidSpacing
-	case errors.Is(err, ErrInvalidColor):
-		return CodeInvalidColor
-	case errors.Is(err, ErrInvalidFont):
-		return CodeInvalidFont
-	case errors.Is(err, ErrInvalidSectionKey):
-		return CodeInvalidSectionKey
-	case errors.Is(err, ErrInvalidMargin):
-		return CodeInvalidMargin
-	case errors.Is(err, ErrInvalidLayoutMode):
-		return CodeInvalidLayoutMode
-	case errors.Is(err, ErrInvalidSidebarWidth):
-		return CodeInvalidSidebarWidth
-	case errors.Is(err, ErrInvalidColumnValue):
-		return CodeInvalidColumnValue
-	case errors.Is(err, ErrInvalidFontSize):
-		return CodeInvalidFontSize
-	case errors.Is(err, ErrInvalidSkillDisplay):
-		return CodeInvalidSkillDisplay
-	default:
-		return CodeInternalError
-	}
+	return domainerr.CodeOf(err, CodeInternalError)
 }
 
-// GetErrorMessage returns a user-friendly error message.
-func GetErrorMessage(err error) string {
-	switch {
-	case errors.Is(err, ErrResumeBuilderNotFound):
-		return "Resume builder not found"
-	case errors.Is(err, ErrNotOwner):
-		return "You don't have access to this resume"
-	case errors.Is(err, ErrSectionEntryNotFound):
-		return "Section entry not found"
-	case errors.Is(err, ErrInvalidTemplate):
-		return "Invalid template selected"
-	case errors.Is(err, ErrInvalidSpacing):
-		return "Spacing must be between 50 and 150"
-	case errors.Is(err, ErrInvalidColor):
-		return "Invalid color format"
-	case errors.Is(err, ErrInvalidFont):
-		return "Invalid font family"
-	case errors.Is(err, ErrInvalidSectionKey):
-		return "Invalid section key"
-	case errors.Is(err, ErrInvalidMargin):
-		return "Margin must be between 0 and 200"
-	case errors.Is(err, ErrInvalidLayoutMode):
-		return "Layout mode must be single, double-left, double-right, or custom"
-	case errors.Is(err, ErrInvalidSidebarWidth):
-		return "Sidebar width must be between 25 and 50"
-	case errors.Is(err, ErrInvalidColumnValue):
-		return "Column must be main or sidebar"
-	case errors.Is(err, ErrInvalidFontSize):
-		return "Font size must be between 8 and 18"
-	case errors.Is(err, ErrInvalidSkillDisplay):
-		return "Invalid skill display mode"
-	default:
-		return "Internal server error"
-	}
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
 }
@@ -22,9 +22,15 @@ type dbQuerier interface {
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 }
 
+// DBPool is a dbQuerier that can also open transactions.
+type DBPool interface {
+	dbQuerier
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
 // ResumeBuilderRepository implements ports.ResumeBuilderRepository.
 type ResumeBuilderRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 	q    dbQuerier
 }
 
@@ -33,6 +39,11 @@ func NewResumeBuilderRepository(pool *pgxpool.Pool) *ResumeBuilderRepository {
 	return &ResumeBuilderRepository{pool: pool, q: pool}
 }
 
+// NewResumeBuilderRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewResumeBuilderRepositoryWithPool(pool DBPool) *ResumeBuilderRepository {
+	return &ResumeBuilderRepository{pool: pool, q: pool}
+}
+
 // RunInTransaction executes fn within a database transaction.
 // A temporary repository backed by the transaction is passed to fn.
 func (r *ResumeBuilderRepository) RunInTransaction(ctx context.Context, fn func(txRepo ports.ResumeBuilderRepository) error) error {
@@ -0,0 +1,414 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/resumebuilder/model"
+	"github.com/andreypavlenko/jobber/modules/resumebuilder/ports"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var resumeBuilderColumns = []string{"id", "user_id", "title", "template_id", "font_family", "primary_color", "text_color", "spacing", "margin_top", "margin_bottom", "margin_left", "margin_right", "layout_mode", "sidebar_width", "font_size", "skill_display", "created_at", "updated_at"}
+
+func resumeBuilderRow(now time.Time) []any {
+	return []any{"rb-1", "user-1", "My Resume", "classic", "Inter", "#000000", "#111111", 100, 40, 40, 40, 40, "single", 30, 11, "list", now, now}
+}
+
+func TestResumeBuilderRepository_Create(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec("INSERT INTO resume_builders").
+		WithArgs(anyArgs(18)...).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	rb := &model.ResumeBuilder{UserID: "user-1", Title: "My Resume"}
+	err = NewResumeBuilderRepositoryWithPool(mock).Create(context.Background(), rb)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, rb.ID)
+	assert.False(t, rb.CreatedAt.IsZero())
+	assert.Equal(t, rb.CreatedAt, rb.UpdatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResumeBuilderRepository_GetByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock pgxmock.PgxPoolIface)
+		wantErr error
+	}{
+		{
+			name: "returns the resume builder",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery("FROM resume_builders WHERE id = \\$1").
+					WithArgs("rb-1").
+					WillReturnRows(pgxmock.NewRows(resumeBuilderColumns).AddRow(resumeBuilderRow(time.Now())...))
+			},
+		},
+		{
+			name: "maps missing rows to not found",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery("FROM resume_builders").
+					WithArgs("rb-1").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: model.ErrResumeBuilderNotFound,
+		},
+		{
+			name: "propagates query errors",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery("FROM resume_builders").
+					WithArgs("rb-1").
+					WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+			tt.setup(mock)
+
+			rb, err := NewResumeBuilderRepositoryWithPool(mock).GetByID(context.Background(), "rb-1")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, rb)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "My Resume", rb.Title)
+				assert.Equal(t, 30, rb.SidebarWidth)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeBuilderRepository_List(t *testing.T) {
+	// List omits user_id
+	columns := append([]string{"id"}, resumeBuilderColumns[2:]...)
+
+	t.Run("returns the user's resumes", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		row := resumeBuilderRow(now)
+		row = append([]any{row[0]}, row[2:]...)
+		mock.ExpectQuery("FROM resume_builders WHERE user_id = \\$1 ORDER BY updated_at DESC").
+			WithArgs("user-1").
+			WillReturnRows(pgxmock.NewRows(columns).AddRow(row...))
+
+		items, err := NewResumeBuilderRepositoryWithPool(mock).List(context.Background(), "user-1")
+
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "rb-1", items[0].ID)
+		assert.Equal(t, "classic", items[0].TemplateID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM resume_builders").
+			WithArgs("user-1").
+			WillReturnError(assert.AnError)
+
+		items, err := NewResumeBuilderRepositoryWithPool(mock).List(context.Background(), "user-1")
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, items)
+	})
+}
+
+func TestResumeBuilderRepository_Update(t *testing.T) {
+	t.Run("refreshes updated_at", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		updatedAt := time.Now()
+		mock.ExpectQuery(`UPDATE resume_builders\s+SET title = \$1.*WHERE id = \$15\s+RETURNING updated_at`).
+			WithArgs(anyArgs(15)...).
+			WillReturnRows(pgxmock.NewRows([]string{"updated_at"}).AddRow(updatedAt))
+
+		rb := &model.ResumeBuilder{ID: "rb-1", Title: "Renamed"}
+		err = NewResumeBuilderRepositoryWithPool(mock).Update(context.Background(), rb)
+
+		require.NoError(t, err)
+		assert.Equal(t, updatedAt, rb.UpdatedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("maps missing rows to not found", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("UPDATE resume_builders").
+			WithArgs(anyArgs(15)...).
+			WillReturnError(pgx.ErrNoRows)
+
+		err = NewResumeBuilderRepositoryWithPool(mock).Update(context.Background(), &model.ResumeBuilder{ID: "rb-1"})
+
+		assert.ErrorIs(t, err, model.ErrResumeBuilderNotFound)
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("UPDATE resume_builders").
+			WithArgs(anyArgs(15)...).
+			WillReturnError(assert.AnError)
+
+		err = NewResumeBuilderRepositoryWithPool(mock).Update(context.Background(), &model.ResumeBuilder{ID: "rb-1"})
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestResumeBuilderRepository_Delete(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec("DELETE FROM resume_builders WHERE id = \\$1").
+		WithArgs("rb-1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec("DELETE FROM resume_builders").
+		WithArgs("rb-1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectExec("DELETE FROM resume_builders").
+		WithArgs("rb-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewResumeBuilderRepositoryWithPool(mock)
+	assert.NoError(t, repo.Delete(context.Background(), "rb-1"))
+	assert.ErrorIs(t, repo.Delete(context.Background(), "rb-1"), model.ErrResumeBuilderNotFound)
+	assert.ErrorIs(t, repo.Delete(context.Background(), "rb-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResumeBuilderRepository_VerifyOwnership(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock pgxmock.PgxPoolIface)
+		wantErr error
+	}{
+		{
+			name: "accepts the owner",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery("SELECT user_id FROM resume_builders WHERE id = \\$1").
+					WithArgs("rb-1").
+					WillReturnRows(pgxmock.NewRows([]string{"user_id"}).AddRow("user-1"))
+			},
+		},
+		{
+			name: "rejects another user",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery("SELECT user_id FROM resume_builders").
+					WithArgs("rb-1").
+					WillReturnRows(pgxmock.NewRows([]string{"user_id"}).AddRow("user-2"))
+			},
+			wantErr: model.ErrNotOwner,
+		},
+		{
+			name: "maps missing rows to not found",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery("SELECT user_id FROM resume_builders").
+					WithArgs("rb-1").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: model.ErrResumeBuilderNotFound,
+		},
+		{
+			name: "propagates query errors",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery("SELECT user_id FROM resume_builders").
+					WithArgs("rb-1").
+					WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+			tt.setup(mock)
+
+			err = NewResumeBuilderRepositoryWithPool(mock).VerifyOwnership(context.Background(), "user-1", "rb-1")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeBuilderRepository_RunInTransaction(t *testing.T) {
+	t.Run("commits when fn succeeds", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM resume_builders").
+			WithArgs("rb-1").
+			WillReturnResult(pgxmock.NewResult("DELETE", 1))
+		mock.ExpectCommit()
+
+		err = NewResumeBuilderRepositoryWithPool(mock).RunInTransaction(context.Background(), func(txRepo ports.ResumeBuilderRepository) error {
+			return txRepo.Delete(context.Background(), "rb-1")
+		})
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back when fn fails", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		err = NewResumeBuilderRepositoryWithPool(mock).RunInTransaction(context.Background(), func(ports.ResumeBuilderRepository) error {
+			return assert.AnError
+		})
+
+		assert.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates begin errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin().WillReturnError(assert.AnError)
+
+		called := false
+		err = NewResumeBuilderRepositoryWithPool(mock).RunInTransaction(context.Background(), func(ports.ResumeBuilderRepository) error {
+			called = true
+			return nil
+		})
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.False(t, called)
+	})
+}
+
+func TestResumeBuilderRepository_GetFullResume(t *testing.T) {
+	// expectSections registers every per-section query; the sections are
+	// loaded concurrently so they may arrive in any order.
+	expectSections := func(mock pgxmock.PgxPoolIface, now time.Time, failing string) {
+		result := func(table string, columns []string, row ...any) {
+			q := mock.ExpectQuery("FROM " + table + " WHERE resume_builder_id = \\$1").WithArgs("rb-1")
+			switch {
+			case table == failing:
+				q.WillReturnError(assert.AnError)
+			case row == nil:
+				q.WillReturnError(pgx.ErrNoRows)
+			default:
+				q.WillReturnRows(pgxmock.NewRows(columns).AddRow(row...))
+			}
+		}
+		result("resume_contacts", []string{"id", "resume_builder_id", "full_name", "email", "phone", "location", "website", "linkedin", "github", "created_at", "updated_at"},
+			"contact-1", "rb-1", "Jane Doe", "jane@example.com", "", "", "", "", "", now, now)
+		result("resume_summaries", nil)
+		for _, tc := range sectionCases() {
+			result(tc.table, tc.columns, tc.row(now)...)
+		}
+		result("resume_section_orders", []string{"id", "resume_builder_id", "section_key", "sort_order", "is_visible", "column_placement"},
+			"order-1", "rb-1", "experience", 0, true, "main")
+	}
+
+	t.Run("assembles every section", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+		mock.MatchExpectationsInOrder(false)
+
+		now := time.Now()
+		mock.ExpectQuery("FROM resume_builders WHERE id = \\$1").
+			WithArgs("rb-1").
+			WillReturnRows(pgxmock.NewRows(resumeBuilderColumns).AddRow(resumeBuilderRow(now)...))
+		expectSections(mock, now, "")
+
+		full, err := NewResumeBuilderRepositoryWithPool(mock).GetFullResume(context.Background(), "rb-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "rb-1", full.ID)
+		require.NotNil(t, full.Contact)
+		assert.Equal(t, "Jane Doe", full.Contact.FullName)
+		assert.Nil(t, full.Summary)
+		assert.Len(t, full.Experiences, 1)
+		assert.Len(t, full.Educations, 1)
+		assert.Len(t, full.Skills, 1)
+		assert.Len(t, full.Languages, 1)
+		assert.Len(t, full.Certifications, 1)
+		assert.Len(t, full.Projects, 1)
+		assert.Len(t, full.Volunteering, 1)
+		assert.Len(t, full.CustomSections, 1)
+		assert.Len(t, full.SectionOrder, 1)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fails when a section cannot be loaded", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+		mock.MatchExpectationsInOrder(false)
+
+		now := time.Now()
+		mock.ExpectQuery("FROM resume_builders WHERE id = \\$1").
+			WithArgs("rb-1").
+			WillReturnRows(pgxmock.NewRows(resumeBuilderColumns).AddRow(resumeBuilderRow(now)...))
+		expectSections(mock, now, "resume_skills")
+
+		full, err := NewResumeBuilderRepositoryWithPool(mock).GetFullResume(context.Background(), "rb-1")
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "load skills")
+		assert.Nil(t, full)
+	})
+
+	t.Run("returns not found without loading sections", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM resume_builders").
+			WithArgs("rb-1").
+			WillReturnError(pgx.ErrNoRows)
+
+		full, err := NewResumeBuilderRepositoryWithPool(mock).GetFullResume(context.Background(), "rb-1")
+
+		assert.ErrorIs(t, err, model.ErrResumeBuilderNotFound)
+		assert.Nil(t, full)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,583 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/resumebuilder/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sectionCase describes one list-style resume section so the CRUD
+// behaviour shared by all of them can be exercised by a single table.
+type sectionCase struct {
+	name    string
+	table   string
+	columns []string
+	// createArgs and updateArgs are the placeholder counts of the INSERT and UPDATE statements
+	createArgs int
+	updateArgs int
+	row        func(now time.Time) []any
+	create     func(r *ResumeBuilderRepository) (string, error)
+	update     func(r *ResumeBuilderRepository) error
+	delete     func(r *ResumeBuilderRepository) error
+	list       func(r *ResumeBuilderRepository) (int, error)
+	get        func(r *ResumeBuilderRepository) (string, error)
+}
+
+// anyArgs matches n arguments of any value.
+func anyArgs(n int) []any {
+	args := make([]any, n)
+	for i := range args {
+		args[i] = pgxmock.AnyArg()
+	}
+	return args
+}
+
+func sectionCases() []sectionCase {
+	ctx := context.Background()
+	return []sectionCase{
+		{
+			name:       "experience",
+			table:      "resume_experiences",
+			createArgs: 12,
+			updateArgs: 10,
+			columns:    []string{"id", "resume_builder_id", "company", "position", "location", "start_date", "end_date", "is_current", "description", "sort_order", "created_at", "updated_at"},
+			row: func(now time.Time) []any {
+				return []any{"entry-1", "rb-1", "Acme", "Engineer", "Berlin", "2020-01", "", true, "Built things", 0, now, now}
+			},
+			create: func(r *ResumeBuilderRepository) (string, error) {
+				exp := &model.Experience{ResumeBuilderID: "rb-1", Company: "Acme"}
+				err := r.CreateExperience(ctx, exp)
+				return exp.ID, err
+			},
+			update: func(r *ResumeBuilderRepository) error {
+				return r.UpdateExperience(ctx, &model.Experience{ID: "entry-1", ResumeBuilderID: "rb-1"})
+			},
+			delete: func(r *ResumeBuilderRepository) error { return r.DeleteExperience(ctx, "rb-1", "entry-1") },
+			list: func(r *ResumeBuilderRepository) (int, error) {
+				items, err := r.ListExperiences(ctx, "rb-1")
+				return len(items), err
+			},
+			get: func(r *ResumeBuilderRepository) (string, error) {
+				e, err := r.GetExperienceByID(ctx, "rb-1", "entry-1")
+				if e == nil {
+					return "", err
+				}
+				return e.ID, err
+			},
+		},
+		{
+			name:       "education",
+			table:      "resume_educations",
+			createArgs: 13,
+			updateArgs: 11,
+			columns:    []string{"id", "resume_builder_id", "institution", "degree", "field_of_study", "start_date", "end_date", "is_current", "gpa", "description", "sort_order", "created_at", "updated_at"},
+			row: func(now time.Time) []any {
+				return []any{"entry-1", "rb-1", "MIT", "BSc", "CS", "2012-09", "2016-06", false, "3.9", "", 0, now, now}
+			},
+			create: func(r *ResumeBuilderRepository) (string, error) {
+				edu := &model.Education{ResumeBuilderID: "rb-1", Institution: "MIT"}
+				err := r.CreateEducation(ctx, edu)
+				return edu.ID, err
+			},
+			update: func(r *ResumeBuilderRepository) error {
+				return r.UpdateEducation(ctx, &model.Education{ID: "entry-1", ResumeBuilderID: "rb-1"})
+			},
+			delete: func(r *ResumeBuilderRepository) error { return r.DeleteEducation(ctx, "rb-1", "entry-1") },
+			list: func(r *ResumeBuilderRepository) (int, error) {
+				items, err := r.ListEducations(ctx, "rb-1")
+				return len(items), err
+			},
+			get: func(r *ResumeBuilderRepository) (string, error) {
+				e, err := r.GetEducationByID(ctx, "rb-1", "entry-1")
+				if e == nil {
+					return "", err
+				}
+				return e.ID, err
+			},
+		},
+		{
+			name:       "skill",
+			table:      "resume_skills",
+			createArgs: 7,
+			updateArgs: 5,
+			columns:    []string{"id", "resume_builder_id", "name", "level", "sort_order", "created_at", "updated_at"},
+			row: func(now time.Time) []any {
+				return []any{"entry-1", "rb-1", "Go", "expert", 0, now, now}
+			},
+			create: func(r *ResumeBuilderRepository) (string, error) {
+				skill := &model.Skill{ResumeBuilderID: "rb-1", Name: "Go"}
+				err := r.CreateSkill(ctx, skill)
+				return skill.ID, err
+			},
+			update: func(r *ResumeBuilderRepository) error {
+				return r.UpdateSkill(ctx, &model.Skill{ID: "entry-1", ResumeBuilderID: "rb-1"})
+			},
+			delete: func(r *ResumeBuilderRepository) error { return r.DeleteSkill(ctx, "rb-1", "entry-1") },
+			list: func(r *ResumeBuilderRepository) (int, error) {
+				items, err := r.ListSkills(ctx, "rb-1")
+				return len(items), err
+			},
+			get: func(r *ResumeBuilderRepository) (string, error) {
+				s, err := r.GetSkillByID(ctx, "rb-1", "entry-1")
+				if s == nil {
+					return "", err
+				}
+				return s.ID, err
+			},
+		},
+		{
+			name:       "language",
+			table:      "resume_languages",
+			createArgs: 7,
+			updateArgs: 5,
+			columns:    []string{"id", "resume_builder_id", "name", "proficiency", "sort_order", "created_at", "updated_at"},
+			row: func(now time.Time) []any {
+				return []any{"entry-1", "rb-1", "German", "fluent", 0, now, now}
+			},
+			create: func(r *ResumeBuilderRepository) (string, error) {
+				lang := &model.Language{ResumeBuilderID: "rb-1", Name: "German"}
+				err := r.CreateLanguage(ctx, lang)
+				return lang.ID, err
+			},
+			update: func(r *ResumeBuilderRepository) error {
+				return r.UpdateLanguage(ctx, &model.Language{ID: "entry-1", ResumeBuilderID: "rb-1"})
+			},
+			delete: func(r *ResumeBuilderRepository) error { return r.DeleteLanguage(ctx, "rb-1", "entry-1") },
+			list: func(r *ResumeBuilderRepository) (int, error) {
+				items, err := r.ListLanguages(ctx, "rb-1")
+				return len(items), err
+			},
+			get: func(r *ResumeBuilderRepository) (string, error) {
+				l, err := r.GetLanguageByID(ctx, "rb-1", "entry-1")
+				if l == nil {
+					return "", err
+				}
+				return l.ID, err
+			},
+		},
+		{
+			name:       "certification",
+			table:      "resume_certifications",
+			createArgs: 10,
+			updateArgs: 8,
+			columns:    []string{"id", "resume_builder_id", "name", "issuer", "issue_date", "expiry_date", "url", "sort_order", "created_at", "updated_at"},
+			row: func(now time.Time) []any {
+				return []any{"entry-1", "rb-1", "CKA", "CNCF", "2023-01", "", "https://example.com", 0, now, now}
+			},
+			create: func(r *ResumeBuilderRepository) (string, error) {
+				cert := &model.Certification{ResumeBuilderID: "rb-1", Name: "CKA"}
+				err := r.CreateCertification(ctx, cert)
+				return cert.ID, err
+			},
+			update: func(r *ResumeBuilderRepository) error {
+				return r.UpdateCertification(ctx, &model.Certification{ID: "entry-1", ResumeBuilderID: "rb-1"})
+			},
+			delete: func(r *ResumeBuilderRepository) error { return r.DeleteCertification(ctx, "rb-1", "entry-1") },
+			list: func(r *ResumeBuilderRepository) (int, error) {
+				items, err := r.ListCertifications(ctx, "rb-1")
+				return len(items), err
+			},
+			get: func(r *ResumeBuilderRepository) (string, error) {
+				c, err := r.GetCertificationByID(ctx, "rb-1", "entry-1")
+				if c == nil {
+					return "", err
+				}
+				return c.ID, err
+			},
+		},
+		{
+			name:       "project",
+			table:      "resume_projects",
+			createArgs: 10,
+			updateArgs: 8,
+			columns:    []string{"id", "resume_builder_id", "name", "url", "start_date", "end_date", "description", "sort_order", "created_at", "updated_at"},
+			row: func(now time.Time) []any {
+				return []any{"entry-1", "rb-1", "Jobber", "https://example.com", "2024-01", "", "Job tracker", 0, now, now}
+			},
+			create: func(r *ResumeBuilderRepository) (string, error) {
+				proj := &model.Project{ResumeBuilderID: "rb-1", Name: "Jobber"}
+				err := r.CreateProject(ctx, proj)
+				return proj.ID, err
+			},
+			update: func(r *ResumeBuilderRepository) error {
+				return r.UpdateProject(ctx, &model.Project{ID: "entry-1", ResumeBuilderID: "rb-1"})
+			},
+			delete: func(r *ResumeBuilderRepository) error { return r.DeleteProject(ctx, "rb-1", "entry-1") },
+			list: func(r *ResumeBuilderRepository) (int, error) {
+				items, err := r.ListProjects(ctx, "rb-1")
+				return len(items), err
+			},
+			get: func(r *ResumeBuilderRepository) (string, error) {
+				p, err := r.GetProjectByID(ctx, "rb-1", "entry-1")
+				if p == nil {
+					return "", err
+				}
+				return p.ID, err
+			},
+		},
+		{
+			name:       "volunteering",
+			table:      "resume_volunteering",
+			createArgs: 10,
+			updateArgs: 8,
+			columns:    []string{"id", "resume_builder_id", "organization", "role", "start_date", "end_date", "description", "sort_order", "created_at", "updated_at"},
+			row: func(now time.Time) []any {
+				return []any{"entry-1", "rb-1", "Red Cross", "Driver", "2019-05", "2019-09", "", 0, now, now}
+			},
+			create: func(r *ResumeBuilderRepository) (string, error) {
+				vol := &model.Volunteering{ResumeBuilderID: "rb-1", Organization: "Red Cross"}
+				err := r.CreateVolunteering(ctx, vol)
+				return vol.ID, err
+			},
+			update: func(r *ResumeBuilderRepository) error {
+				return r.UpdateVolunteering(ctx, &model.Volunteering{ID: "entry-1", ResumeBuilderID: "rb-1"})
+			},
+			delete: func(r *ResumeBuilderRepository) error { return r.DeleteVolunteering(ctx, "rb-1", "entry-1") },
+			list: func(r *ResumeBuilderRepository) (int, error) {
+				items, err := r.ListVolunteering(ctx, "rb-1")
+				return len(items), err
+			},
+			get: func(r *ResumeBuilderRepository) (string, error) {
+				v, err := r.GetVolunteeringByID(ctx, "rb-1", "entry-1")
+				if v == nil {
+					return "", err
+				}
+				return v.ID, err
+			},
+		},
+		{
+			name:       "custom section",
+			table:      "resume_custom_sections",
+			createArgs: 7,
+			updateArgs: 5,
+			columns:    []string{"id", "resume_builder_id", "title", "content", "sort_order", "created_at", "updated_at"},
+			row: func(now time.Time) []any {
+				return []any{"entry-1", "rb-1", "Awards", "Hackathon winner", 0, now, now}
+			},
+			create: func(r *ResumeBuilderRepository) (string, error) {
+				cs := &model.CustomSection{ResumeBuilderID: "rb-1", Title: "Awards"}
+				err := r.CreateCustomSection(ctx, cs)
+				return cs.ID, err
+			},
+			update: func(r *ResumeBuilderRepository) error {
+				return r.UpdateCustomSection(ctx, &model.CustomSection{ID: "entry-1", ResumeBuilderID: "rb-1"})
+			},
+			delete: func(r *ResumeBuilderRepository) error { return r.DeleteCustomSection(ctx, "rb-1", "entry-1") },
+			list: func(r *ResumeBuilderRepository) (int, error) {
+				items, err := r.ListCustomSections(ctx, "rb-1")
+				return len(items), err
+			},
+			get: func(r *ResumeBuilderRepository) (string, error) {
+				cs, err := r.GetCustomSectionByID(ctx, "rb-1", "entry-1")
+				if cs == nil {
+					return "", err
+				}
+				return cs.ID, err
+			},
+		},
+	}
+}
+
+func TestResumeBuilderRepository_Sections(t *testing.T) {
+	for _, tc := range sectionCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Run("create assigns an id", func(t *testing.T) {
+				mock, err := pgxmock.NewPool()
+				require.NoError(t, err)
+				defer mock.Close()
+
+				mock.ExpectExec("INSERT INTO " + tc.table).
+					WithArgs(anyArgs(tc.createArgs)...).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+				id, err := tc.create(NewResumeBuilderRepositoryWithPool(mock))
+
+				require.NoError(t, err)
+				assert.NotEmpty(t, id)
+				require.NoError(t, mock.ExpectationsWereMet())
+			})
+
+			t.Run("update", func(t *testing.T) {
+				mock, err := pgxmock.NewPool()
+				require.NoError(t, err)
+				defer mock.Close()
+
+				mock.ExpectExec("UPDATE " + tc.table).
+					WithArgs(anyArgs(tc.updateArgs)...).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("UPDATE " + tc.table).
+					WithArgs(anyArgs(tc.updateArgs)...).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+				mock.ExpectExec("UPDATE " + tc.table).
+					WithArgs(anyArgs(tc.updateArgs)...).
+					WillReturnError(assert.AnError)
+
+				repo := NewResumeBuilderRepositoryWithPool(mock)
+				assert.NoError(t, tc.update(repo))
+				assert.ErrorIs(t, tc.update(repo), model.ErrSectionEntryNotFound)
+				assert.ErrorIs(t, tc.update(repo), assert.AnError)
+				require.NoError(t, mock.ExpectationsWereMet())
+			})
+
+			t.Run("delete", func(t *testing.T) {
+				mock, err := pgxmock.NewPool()
+				require.NoError(t, err)
+				defer mock.Close()
+
+				mock.ExpectExec("DELETE FROM "+tc.table).
+					WithArgs("entry-1", "rb-1").
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				mock.ExpectExec("DELETE FROM "+tc.table).
+					WithArgs("entry-1", "rb-1").
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				mock.ExpectExec("DELETE FROM "+tc.table).
+					WithArgs("entry-1", "rb-1").
+					WillReturnError(assert.AnError)
+
+				repo := NewResumeBuilderRepositoryWithPool(mock)
+				assert.NoError(t, tc.delete(repo))
+				assert.ErrorIs(t, tc.delete(repo), model.ErrSectionEntryNotFound)
+				assert.ErrorIs(t, tc.delete(repo), assert.AnError)
+				require.NoError(t, mock.ExpectationsWereMet())
+			})
+
+			t.Run("list", func(t *testing.T) {
+				mock, err := pgxmock.NewPool()
+				require.NoError(t, err)
+				defer mock.Close()
+
+				now := time.Now()
+				mock.ExpectQuery("FROM " + tc.table + " WHERE resume_builder_id = \\$1 ORDER BY sort_order").
+					WithArgs("rb-1").
+					WillReturnRows(pgxmock.NewRows(tc.columns).AddRow(tc.row(now)...).AddRow(tc.row(now)...))
+				mock.ExpectQuery("FROM " + tc.table).
+					WithArgs("rb-1").
+					WillReturnError(assert.AnError)
+
+				repo := NewResumeBuilderRepositoryWithPool(mock)
+				n, err := tc.list(repo)
+				require.NoError(t, err)
+				assert.Equal(t, 2, n)
+
+				_, err = tc.list(repo)
+				assert.ErrorIs(t, err, assert.AnError)
+				require.NoError(t, mock.ExpectationsWereMet())
+			})
+
+			t.Run("get by id", func(t *testing.T) {
+				mock, err := pgxmock.NewPool()
+				require.NoError(t, err)
+				defer mock.Close()
+
+				mock.ExpectQuery("FROM "+tc.table+" WHERE id = \\$1 AND resume_builder_id = \\$2").
+					WithArgs("entry-1", "rb-1").
+					WillReturnRows(pgxmock.NewRows(tc.columns).AddRow(tc.row(time.Now())...))
+				mock.ExpectQuery("FROM "+tc.table).
+					WithArgs("entry-1", "rb-1").
+					WillReturnError(pgx.ErrNoRows)
+				mock.ExpectQuery("FROM "+tc.table).
+					WithArgs("entry-1", "rb-1").
+					WillReturnError(assert.AnError)
+
+				repo := NewResumeBuilderRepositoryWithPool(mock)
+				id, err := tc.get(repo)
+				require.NoError(t, err)
+				assert.Equal(t, "entry-1", id)
+
+				_, err = tc.get(repo)
+				assert.ErrorIs(t, err, model.ErrSectionEntryNotFound)
+
+				_, err = tc.get(repo)
+				assert.ErrorIs(t, err, assert.AnError)
+				require.NoError(t, mock.ExpectationsWereMet())
+			})
+		})
+	}
+}
+
+func TestResumeBuilderRepository_Contact(t *testing.T) {
+	columns := []string{"id", "resume_builder_id", "full_name", "email", "phone", "location", "website", "linkedin", "github", "created_at", "updated_at"}
+
+	t.Run("upsert keeps an existing id", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`INSERT INTO resume_contacts .*ON CONFLICT \(resume_builder_id\)`).
+			WithArgs("contact-1", "rb-1", "Jane Doe", "jane@example.com", "", "", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		c := &model.Contact{ID: "contact-1", ResumeBuilderID: "rb-1", FullName: "Jane Doe", Email: "jane@example.com"}
+		err = NewResumeBuilderRepositoryWithPool(mock).UpsertContact(context.Background(), c)
+
+		require.NoError(t, err)
+		assert.Equal(t, "contact-1", c.ID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("upsert assigns an id to a new contact", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("INSERT INTO resume_contacts").
+			WithArgs(anyArgs(11)...).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		c := &model.Contact{ResumeBuilderID: "rb-1"}
+		err = NewResumeBuilderRepositoryWithPool(mock).UpsertContact(context.Background(), c)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, c.ID)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery("FROM resume_contacts WHERE resume_builder_id = \\$1").
+			WithArgs("rb-1").
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow("contact-1", "rb-1", "Jane Doe", "jane@example.com", "", "Berlin", "", "", "", now, now))
+		mock.ExpectQuery("FROM resume_contacts").
+			WithArgs("rb-1").
+			WillReturnError(pgx.ErrNoRows)
+		mock.ExpectQuery("FROM resume_contacts").
+			WithArgs("rb-1").
+			WillReturnError(assert.AnError)
+
+		repo := NewResumeBuilderRepositoryWithPool(mock)
+		c, err := repo.GetContact(context.Background(), "rb-1")
+		require.NoError(t, err)
+		assert.Equal(t, "Jane Doe", c.FullName)
+
+		_, err = repo.GetContact(context.Background(), "rb-1")
+		assert.ErrorIs(t, err, model.ErrSectionEntryNotFound)
+
+		_, err = repo.GetContact(context.Background(), "rb-1")
+		assert.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestResumeBuilderRepository_Summary(t *testing.T) {
+	t.Run("upsert assigns an id to a new summary", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`INSERT INTO resume_summaries .*ON CONFLICT \(resume_builder_id\)`).
+			WithArgs(pgxmock.AnyArg(), "rb-1", "Backend engineer", pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		s := &model.Summary{ResumeBuilderID: "rb-1", Content: "Backend engineer"}
+		err = NewResumeBuilderRepositoryWithPool(mock).UpsertSummary(context.Background(), s)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, s.ID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("get", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery("FROM resume_summaries WHERE resume_builder_id = \\$1").
+			WithArgs("rb-1").
+			WillReturnRows(pgxmock.NewRows([]string{"id", "resume_builder_id", "content", "created_at", "updated_at"}).
+				AddRow("summary-1", "rb-1", "Backend engineer", now, now))
+		mock.ExpectQuery("FROM resume_summaries").
+			WithArgs("rb-1").
+			WillReturnError(pgx.ErrNoRows)
+		mock.ExpectQuery("FROM resume_summaries").
+			WithArgs("rb-1").
+			WillReturnError(assert.AnError)
+
+		repo := NewResumeBuilderRepositoryWithPool(mock)
+		s, err := repo.GetSummary(context.Background(), "rb-1")
+		require.NoError(t, err)
+		assert.Equal(t, "Backend engineer", s.Content)
+
+		_, err = repo.GetSummary(context.Background(), "rb-1")
+		assert.ErrorIs(t, err, model.ErrSectionEntryNotFound)
+
+		_, err = repo.GetSummary(context.Background(), "rb-1")
+		assert.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestResumeBuilderRepository_SectionOrder(t *testing.T) {
+	t.Run("upsert defaults the column to main", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("INSERT INTO resume_section_orders").
+			WithArgs("rb-1", "experience", 0, true, "main").
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec("INSERT INTO resume_section_orders").
+			WithArgs("rb-1", "skills", 1, false, "sidebar").
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err = NewResumeBuilderRepositoryWithPool(mock).UpsertSectionOrder(context.Background(), "rb-1", []*model.SectionOrder{
+			{SectionKey: "experience", SortOrder: 0, IsVisible: true},
+			{SectionKey: "skills", SortOrder: 1, IsVisible: false, Column: "sidebar"},
+		})
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("upsert stops at the first failure", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("INSERT INTO resume_section_orders").
+			WithArgs(anyArgs(5)...).
+			WillReturnError(assert.AnError)
+
+		err = NewResumeBuilderRepositoryWithPool(mock).UpsertSectionOrder(context.Background(), "rb-1", []*model.SectionOrder{
+			{SectionKey: "experience"},
+			{SectionKey: "skills"},
+		})
+
+		assert.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("list", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM resume_section_orders WHERE resume_builder_id = \\$1 ORDER BY sort_order").
+			WithArgs("rb-1").
+			WillReturnRows(pgxmock.NewRows([]string{"id", "resume_builder_id", "section_key", "sort_order", "is_visible", "column_placement"}).
+				AddRow("order-1", "rb-1", "experience", 0, true, "main"))
+		mock.ExpectQuery("FROM resume_section_orders").
+			WithArgs("rb-1").
+			WillReturnError(assert.AnError)
+
+		repo := NewResumeBuilderRepositoryWithPool(mock)
+		orders, err := repo.ListSectionOrders(context.Background(), "rb-1")
+		require.NoError(t, err)
+		require.Len(t, orders, 1)
+		assert.Equal(t, "main", orders[0].Column)
+
+		_, err = repo.ListSectionOrders(context.Background(), "rb-1")
+		assert.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -2,10 +2,12 @@ package handler
 
 import (
 	"errors"
+	"io"
 	"net/http"
 
 	"github.com/andreypavlenko/jobber/internal/platform/auth"
 	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/internal/platform/storage"
 	"github.com/andreypavlenko/jobber/modules/resumes/model"
 	"github.com/andreypavlenko/jobber/modules/resumes/service"
 	subModel "github.com/andreypavlenko/jobber/modules/subscriptions/model"
@@ -22,16 +24,18 @@ func NewResumeHandler(service *service.ResumeService) *ResumeHandler {
 
 // Create godoc
 // @Summary Create a new resume
-// @Description Create a new resume version for the authenticated user
+// @Description Create a new resume version for the authenticated user, either from an external file_url or from the storage_key of a file uploaded with a URL from /resumes/upload-url
 // @Tags resumes
 // @Security BearerAuth
 // @Accept json
 // @Produce json
 // @Param request body model.CreateResumeRequest true "Resume details"
 // @Success 201 {object} model.ResumeDTO
-// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid storage key or file not uploaded"
 // @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 409 {object} httpPlatform.ErrorResponse "Storage key already used by another resume"
 // @Failure 500 {object} httpPlatform.ErrorResponse
+// @Failure 503 {object} httpPlatform.ErrorResponse "Storage temporarily unavailable"
 // @Router /resumes [post]
 func (h *ResumeHandler) Create(c *gin.Context) {
 	userID, ok := auth.MustGetUserID(c)
@@ -50,7 +54,88 @@ func (h *ResumeHandler) Create(c *gin.Context) {
 			httpPlatform.RespondWithError(c, http.StatusForbidden, "PLAN_LIMIT_REACHED", "You have reached the limit for your current plan.")
 			return
 		}
-		httpPlatform.RespondWithError(c, http.StatusInternalServerError, string(model.GetErrorCode(err)), model.GetErrorMessage(err))
+		if errors.Is(err, storage.ErrStorageUnavailable) {
+			httpPlatform.RespondWithError(c, http.StatusServiceUnavailable, "STORAGE_UNAVAILABLE", "File storage is temporarily unavailable, please try again later")
+			return
+		}
+		statusCode := http.StatusInternalServerError
+		switch model.GetErrorCode(err) {
+		case model.CodeInvalidStorageKey, model.CodeResumeFileMissing:
+			statusCode = http.StatusBadRequest
+		case model.CodeStorageKeyInUse:
+			statusCode = http.StatusConflict
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusCreated, resume)
+}
+
+// Upload godoc
+// @Summary Upload a resume file
+// @Description Upload a resume file (PDF, DOC or DOCX, max 10MB) through the API and create an S3 resume for it. The file type is detected from its contents.
+// @Tags resumes
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param title formData string true "Resume title"
+// @Param file formData file true "Resume file"
+// @Success 201 {object} model.ResumeDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse "Missing title or file, file too large or unsupported type"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 403 {object} httpPlatform.ErrorResponse "Plan limit reached"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Failure 503 {object} httpPlatform.ErrorResponse "Storage temporarily unavailable"
+// @Router /resumes/upload [post]
+func (h *ResumeHandler) Upload(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	var req model.UploadResumeRequest
+	if err := c.ShouldBind(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "NO_FILE", "Resume file is required")
+		return
+	}
+	if header.Size > model.MaxUploadSize {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeFileTooLarge), model.GetErrorMessage(model.ErrFileTooLarge, auth.GetLocale(c)))
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "READ_FAILED", "Failed to read resume file")
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, model.MaxUploadSize+1))
+	if err != nil {
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "READ_FAILED", "Failed to read resume file")
+		return
+	}
+
+	resume, err := h.service.Upload(c.Request.Context(), userID, req.Title, data)
+	if err != nil {
+		if errors.Is(err, subModel.ErrLimitReached) {
+			httpPlatform.RespondWithError(c, http.StatusForbidden, "PLAN_LIMIT_REACHED", "You have reached the limit for your current plan.")
+			return
+		}
+		if errors.Is(err, storage.ErrStorageUnavailable) {
+			httpPlatform.RespondWithError(c, http.StatusServiceUnavailable, "STORAGE_UNAVAILABLE", "File storage is temporarily unavailable, please try again later")
+			return
+		}
+		statusCode := http.StatusInternalServerError
+		switch model.GetErrorCode(err) {
+		case model.CodeResumeTitleRequired, model.CodeFileTooLarge, model.CodeUnsupportedFileType:
+			statusCode = http.StatusBadRequest
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
 	httpPlatform.RespondWithData(c, http.StatusCreated, resume)
@@ -63,7 +148,9 @@ func (h *ResumeHandler) Create(c *gin.Context) {
 // @Security BearerAuth
 // @Produce json
 // @Param id path string true "Resume ID"
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {object} model.ResumeDTO
+// @Success 304 "Not Modified"
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 404 {object} httpPlatform.ErrorResponse "Resume not found"
 // @Failure 500 {object} httpPlatform.ErrorResponse
@@ -81,12 +168,51 @@ func (h *ResumeHandler) Get(c *gin.Context) {
 		if model.GetErrorCode(err) == model.CodeResumeNotFound {
 			statusCode = http.StatusNotFound
 		}
-		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
+	// The presigned download URL expires, so a cached copy must not be revalidated
+	if resume.DownloadURL == nil {
+		etag := httpPlatform.ComputeETag(resume)
+		httpPlatform.SetETag(c, etag)
+		if httpPlatform.CheckIfNoneMatch(c, etag) {
+			return
+		}
+	}
 	httpPlatform.RespondWithData(c, http.StatusOK, resume)
 }
 
+// CountApplications godoc
+// @Summary Count applications using a resume
+// @Description Get the number of applications that use a resume and whether it can be deleted
+// @Tags resumes
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Resume ID"
+// @Success 200 {object} model.ApplicationsCountResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Resume not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /resumes/{id}/applications/count [get]
+func (h *ResumeHandler) CountApplications(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	resumeID := c.Param("id")
+
+	resp, err := h.service.CountApplications(c.Request.Context(), userID, resumeID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if model.GetErrorCode(err) == model.CodeResumeNotFound {
+			statusCode = http.StatusNotFound
+		}
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, resp)
+}
+
 // List godoc
 // @Summary List resumes
 // @Description Get a paginated list of resume versions for the authenticated user
@@ -158,7 +284,7 @@ func (h *ResumeHandler) Update(c *gin.Context) {
 		if model.GetErrorCode(err) == model.CodeResumeNotFound {
 			statusCode = http.StatusNotFound
 		}
-		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
 	httpPlatform.RespondWithData(c, http.StatusOK, resume)
@@ -195,7 +321,7 @@ func (h *ResumeHandler) Delete(c *gin.Context) {
 			statusCode = http.StatusBadRequest
 		}
 		
-		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err))
+		httpPlatform.RespondWithError(c, statusCode, string(errCode), model.GetErrorMessage(err, auth.GetLocale(c)))
 		return
 	}
 	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Resume deleted successfully"})
@@ -203,7 +329,7 @@ func (h *ResumeHandler) Delete(c *gin.Context) {
 
 // GenerateUploadURL godoc
 // @Summary Generate presigned upload URL
-// @Description Generate a presigned URL for uploading a resume file to S3
+// @Description Generate a presigned URL for uploading a resume file directly to S3. After the upload, create the resume with POST /resumes and the returned storage_key.
 // @Tags resumes
 // @Security BearerAuth
 // @Accept json
@@ -213,6 +339,7 @@ func (h *ResumeHandler) Delete(c *gin.Context) {
 // @Failure 400 {object} httpPlatform.ErrorResponse
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 500 {object} httpPlatform.ErrorResponse
+// @Failure 503 {object} httpPlatform.ErrorResponse "Storage temporarily unavailable"
 // @Router /resumes/upload-url [post]
 func (h *ResumeHandler) GenerateUploadURL(c *gin.Context) {
 	userID, ok := auth.MustGetUserID(c)
@@ -231,6 +358,10 @@ func (h *ResumeHandler) GenerateUploadURL(c *gin.Context) {
 			httpPlatform.RespondWithError(c, http.StatusForbidden, "PLAN_LIMIT_REACHED", "You have reached the limit for your current plan.")
 			return
 		}
+		if errors.Is(err, storage.ErrStorageUnavailable) {
+			httpPlatform.RespondWithError(c, http.StatusServiceUnavailable, "STORAGE_UNAVAILABLE", "File storage is temporarily unavailable, please try again later")
+			return
+		}
 		httpPlatform.RespondWithError(c, http.StatusInternalServerError, "UPLOAD_URL_GENERATION_FAILED", err.Error())
 		return
 	}
@@ -249,6 +380,7 @@ func (h *ResumeHandler) GenerateUploadURL(c *gin.Context) {
 // @Failure 401 {object} httpPlatform.ErrorResponse
 // @Failure 404 {object} httpPlatform.ErrorResponse "Resume not found"
 // @Failure 500 {object} httpPlatform.ErrorResponse
+// @Failure 503 {object} httpPlatform.ErrorResponse "Storage temporarily unavailable"
 // @Router /resumes/{id}/download [get]
 func (h *ResumeHandler) DownloadResume(c *gin.Context) {
 	userID, ok := auth.MustGetUserID(c)
@@ -259,6 +391,10 @@ func (h *ResumeHandler) DownloadResume(c *gin.Context) {
 
 	response, err := h.service.GenerateDownloadURL(c.Request.Context(), userID, resumeID)
 	if err != nil {
+		if errors.Is(err, storage.ErrStorageUnavailable) {
+			httpPlatform.RespondWithError(c, http.StatusServiceUnavailable, "STORAGE_UNAVAILABLE", "File storage is temporarily unavailable, please try again later")
+			return
+		}
 		statusCode := http.StatusInternalServerError
 		if model.GetErrorCode(err) == model.CodeResumeNotFound {
 			statusCode = http.StatusNotFound
@@ -274,10 +410,12 @@ func (h *ResumeHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware g
 	resumes.Use(authMiddleware)
 	{
 		resumes.POST("", h.Create)
+		resumes.POST("/upload", h.Upload)
 		resumes.POST("/upload-url", h.GenerateUploadURL)
 		resumes.GET("", h.List)
 		resumes.GET("/:id", h.Get)
 		resumes.GET("/:id/download", h.DownloadResume)
+		resumes.GET("/:id/applications/count", h.CountApplications)
 		resumes.PATCH("/:id", h.Update)
 		resumes.DELETE("/:id", h.Delete)
 	}
@@ -5,11 +5,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/textproto"
 	"testing"
 	"time"
 
+	"github.com/andreypavlenko/jobber/internal/platform/storage"
 	"github.com/andreypavlenko/jobber/modules/resumes/model"
 	"github.com/andreypavlenko/jobber/modules/resumes/ports"
 	"github.com/andreypavlenko/jobber/modules/resumes/service"
@@ -21,11 +24,12 @@ import (
 
 // MockResumeRepository implements ports.ResumeRepository
 type MockResumeRepository struct {
-	CreateFunc  func(ctx context.Context, resume *model.Resume) error
-	GetByIDFunc func(ctx context.Context, userID, resumeID string) (*model.Resume, error)
-	ListFunc    func(ctx context.Context, userID string, limit, offset int, sortBy, sortDir string) ([]*ports.ResumeWithCount, int, error)
-	UpdateFunc  func(ctx context.Context, resume *model.Resume) error
-	DeleteFunc  func(ctx context.Context, userID, resumeID string) error
+	CreateFunc            func(ctx context.Context, resume *model.Resume) error
+	GetByIDFunc           func(ctx context.Context, userID, resumeID string) (*model.Resume, error)
+	ListFunc              func(ctx context.Context, userID string, limit, offset int, sortBy, sortDir string) ([]*ports.ResumeWithCount, int, error)
+	UpdateFunc            func(ctx context.Context, resume *model.Resume) error
+	DeleteFunc            func(ctx context.Context, userID, resumeID string) error
+	CountApplicationsFunc func(ctx context.Context, userID, resumeID string) (int, error)
 }
 
 func (m *MockResumeRepository) Create(ctx context.Context, resume *model.Resume) error {
@@ -63,6 +67,13 @@ func (m *MockResumeRepository) Delete(ctx context.Context, userID, resumeID stri
 	return nil
 }
 
+func (m *MockResumeRepository) CountApplications(ctx context.Context, userID, resumeID string) (int, error) {
+	if m.CountApplicationsFunc != nil {
+		return m.CountApplicationsFunc(ctx, userID, resumeID)
+	}
+	return 0, nil
+}
+
 func setupTestRouter() *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	return gin.New()
@@ -205,6 +216,95 @@ func TestResumeHandler_Get(t *testing.T) {
 	})
 }
 
+func TestResumeHandler_CountApplications(t *testing.T) {
+	userID := "user-123"
+	resumeID := "resume-1"
+
+	tests := []struct {
+		name            string
+		count           int
+		expectCanDelete bool
+	}{
+		{name: "unused resume can be deleted", count: 0, expectCanDelete: true},
+		{name: "resume in use cannot be deleted", count: 3, expectCanDelete: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockResumeRepository{
+				GetByIDFunc: func(ctx context.Context, uid, rid string) (*model.Resume, error) {
+					return &model.Resume{ID: rid, UserID: uid}, nil
+				},
+				CountApplicationsFunc: func(ctx context.Context, uid, rid string) (int, error) {
+					assert.Equal(t, userID, uid)
+					assert.Equal(t, resumeID, rid)
+					return tt.count, nil
+				},
+			}
+
+			svc := service.NewResumeService(mockRepo, nil, nil, nil)
+			handler := NewResumeHandler(svc)
+
+			router := setupTestRouter()
+			router.GET("/resumes/:id/applications/count", mockAuthMiddleware(userID), handler.CountApplications)
+
+			req, _ := http.NewRequest(http.MethodGet, "/resumes/"+resumeID+"/applications/count", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, float64(tt.count), response["count"])
+			assert.Equal(t, tt.expectCanDelete, response["can_delete"])
+		})
+	}
+
+	t.Run("returns 404 when resume not found", func(t *testing.T) {
+		mockRepo := &MockResumeRepository{
+			GetByIDFunc: func(ctx context.Context, uid, rid string) (*model.Resume, error) {
+				return nil, model.ErrResumeNotFound
+			},
+		}
+
+		svc := service.NewResumeService(mockRepo, nil, nil, nil)
+		handler := NewResumeHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/resumes/:id/applications/count", mockAuthMiddleware(userID), handler.CountApplications)
+
+		req, _ := http.NewRequest(http.MethodGet, "/resumes/missing/applications/count", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("returns 500 when count fails", func(t *testing.T) {
+		mockRepo := &MockResumeRepository{
+			GetByIDFunc: func(ctx context.Context, uid, rid string) (*model.Resume, error) {
+				return &model.Resume{ID: rid, UserID: uid}, nil
+			},
+			CountApplicationsFunc: func(ctx context.Context, uid, rid string) (int, error) {
+				return 0, errors.New("db error")
+			},
+		}
+
+		svc := service.NewResumeService(mockRepo, nil, nil, nil)
+		handler := NewResumeHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/resumes/:id/applications/count", mockAuthMiddleware(userID), handler.CountApplications)
+
+		req, _ := http.NewRequest(http.MethodGet, "/resumes/"+resumeID+"/applications/count", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
 func TestResumeHandler_List(t *testing.T) {
 	userID := "user-123"
 
@@ -754,8 +854,10 @@ func TestResumeHandler_RegisterRoutes(t *testing.T) {
 		path   string
 	}{
 		{http.MethodPost, "/api/v1/resumes"},
+		{http.MethodPost, "/api/v1/resumes/upload"},
 		{http.MethodGet, "/api/v1/resumes"},
 		{http.MethodGet, "/api/v1/resumes/test-id"},
+		{http.MethodGet, "/api/v1/resumes/test-id/applications/count"},
 		{http.MethodPatch, "/api/v1/resumes/test-id"},
 		{http.MethodDelete, "/api/v1/resumes/test-id"},
 	}
@@ -777,3 +879,343 @@ func TestResumeHandler_RegisterRoutes(t *testing.T) {
 		})
 	}
 }
+
+// unavailableStorage is a storage.ObjectStorage whose breaker is open
+type unavailableStorage struct{}
+
+func (unavailableStorage) GeneratePresignedUploadURL(_ context.Context, _, _ string, _ time.Duration) (string, error) {
+	return "", storage.ErrStorageUnavailable
+}
+func (unavailableStorage) GeneratePresignedDownloadURL(_ context.Context, _ string, _ time.Duration) (string, error) {
+	return "", storage.ErrStorageUnavailable
+}
+func (unavailableStorage) PutObject(_ context.Context, _, _ string, _ []byte) error {
+	return storage.ErrStorageUnavailable
+}
+func (unavailableStorage) DeleteObject(_ context.Context, _ string) error {
+	return storage.ErrStorageUnavailable
+}
+func (unavailableStorage) GetObject(_ context.Context, _ string) ([]byte, error) {
+	return nil, storage.ErrStorageUnavailable
+}
+func (unavailableStorage) ObjectExists(_ context.Context, _ string) (bool, error) {
+	return false, storage.ErrStorageUnavailable
+}
+
+// --- Storage circuit breaker open: 503 ---
+
+func TestResumeHandler_StorageUnavailable(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("upload URL returns 503", func(t *testing.T) {
+		svc := service.NewResumeService(&MockResumeRepository{}, unavailableStorage{}, nil, nil)
+		handler := NewResumeHandler(svc)
+
+		router := setupTestRouter()
+		router.POST("/resumes/upload-url", mockAuthMiddleware(userID), handler.GenerateUploadURL)
+
+		body, _ := json.Marshal(model.GenerateUploadURLRequest{Filename: "resume.pdf", ContentType: "application/pdf"})
+		req, _ := http.NewRequest(http.MethodPost, "/resumes/upload-url", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Contains(t, w.Body.String(), "STORAGE_UNAVAILABLE")
+	})
+
+	t.Run("download URL returns 503", func(t *testing.T) {
+		storageKey := "users/user-123/resumes/resume-1.pdf"
+		mockRepo := &MockResumeRepository{
+			GetByIDFunc: func(_ context.Context, _, id string) (*model.Resume, error) {
+				return &model.Resume{ID: id, UserID: userID, StorageType: model.StorageTypeS3, StorageKey: &storageKey}, nil
+			},
+		}
+		svc := service.NewResumeService(mockRepo, unavailableStorage{}, nil, nil)
+		handler := NewResumeHandler(svc)
+
+		router := setupTestRouter()
+		router.GET("/resumes/:id/download", mockAuthMiddleware(userID), handler.DownloadResume)
+
+		req, _ := http.NewRequest(http.MethodGet, "/resumes/resume-1/download", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+}
+
+func TestResumeHandler_Get_ETag(t *testing.T) {
+	userID := "user-123"
+	resumeID := "resume-1"
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newRouter := func() *gin.Engine {
+		mockRepo := &MockResumeRepository{
+			GetByIDFunc: func(ctx context.Context, uid, rid string) (*model.Resume, error) {
+				return &model.Resume{ID: rid, UserID: uid, Title: "Backend CV", StorageType: model.StorageTypeExternal, UpdatedAt: updatedAt}, nil
+			},
+		}
+		handler := NewResumeHandler(service.NewResumeService(mockRepo, nil, nil, nil))
+		router := setupTestRouter()
+		router.GET("/resumes/:id", mockAuthMiddleware(userID), handler.Get)
+		return router
+	}
+
+	t.Run("returns 200 with ETag on cache miss", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/resumes/"+resumeID, nil)
+		req.Header.Set("If-None-Match", `"outdated"`)
+		w := httptest.NewRecorder()
+		newRouter().ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEmpty(t, w.Header().Get("ETag"))
+		assert.Contains(t, w.Body.String(), "Backend CV")
+	})
+
+	t.Run("returns 304 on cache hit", func(t *testing.T) {
+		// The tag hashes the response body, so take it from a first response
+		first := httptest.NewRecorder()
+		newRouter().ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/resumes/"+resumeID, nil))
+		etag := first.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+
+		req, _ := http.NewRequest(http.MethodGet, "/resumes/"+resumeID, nil)
+		req.Header.Set("If-None-Match", etag)
+		w := httptest.NewRecorder()
+		newRouter().ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+}
+
+// fakeStorage is a storage.ObjectStorage whose presigned URLs embed the key
+type fakeStorage struct {
+	exists bool
+}
+
+func (fakeStorage) GeneratePresignedUploadURL(_ context.Context, key, _ string, _ time.Duration) (string, error) {
+	return "https://s3.example.com/upload/" + key, nil
+}
+func (fakeStorage) GeneratePresignedDownloadURL(_ context.Context, key string, _ time.Duration) (string, error) {
+	return "https://s3.example.com/download/" + key, nil
+}
+func (fakeStorage) PutObject(_ context.Context, _, _ string, _ []byte) error { return nil }
+func (fakeStorage) DeleteObject(_ context.Context, _ string) error           { return nil }
+func (fakeStorage) GetObject(_ context.Context, _ string) ([]byte, error)    { return nil, nil }
+func (s fakeStorage) ObjectExists(_ context.Context, _ string) (bool, error) {
+	return s.exists, nil
+}
+
+func TestResumeHandler_Create_FromStorageKey(t *testing.T) {
+	userID := "user-123"
+	storageKey := "users/user-123/resumes/0b6a4f52-3c1e-4a57-9f0e-7d2b1c8e5a11.pdf"
+
+	create := func(objectStorage storage.ObjectStorage, repo *MockResumeRepository, key string) *httptest.ResponseRecorder {
+		handler := NewResumeHandler(service.NewResumeService(repo, objectStorage, nil, nil))
+		router := setupTestRouter()
+		router.POST("/resumes", mockAuthMiddleware(userID), handler.Create)
+
+		body, _ := json.Marshal(model.CreateResumeRequest{Title: "Backend CV", StorageKey: &key})
+		req, _ := http.NewRequest(http.MethodPost, "/resumes", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("creates an S3 resume with a download URL", func(t *testing.T) {
+		w := create(fakeStorage{exists: true}, &MockResumeRepository{}, storageKey)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resume model.ResumeDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resume))
+		assert.Equal(t, model.StorageTypeS3, resume.StorageType)
+		assert.Equal(t, storageKey, *resume.StorageKey)
+		require.NotNil(t, resume.DownloadURL)
+		assert.Equal(t, "https://s3.example.com/download/"+storageKey, *resume.DownloadURL)
+	})
+
+	t.Run("returns 400 for another user's storage key", func(t *testing.T) {
+		w := create(fakeStorage{exists: true}, &MockResumeRepository{}, "users/user-456/resumes/0b6a4f52-3c1e-4a57-9f0e-7d2b1c8e5a11.pdf")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "INVALID_STORAGE_KEY")
+	})
+
+	t.Run("returns 400 before the file is uploaded", func(t *testing.T) {
+		w := create(fakeStorage{exists: false}, &MockResumeRepository{}, storageKey)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "RESUME_FILE_MISSING")
+	})
+
+	t.Run("returns 409 for a storage key used by another resume", func(t *testing.T) {
+		repo := &MockResumeRepository{
+			CreateFunc: func(_ context.Context, _ *model.Resume) error {
+				return model.ErrStorageKeyInUse
+			},
+		}
+		w := create(fakeStorage{exists: true}, repo, storageKey)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "STORAGE_KEY_IN_USE")
+	})
+
+	t.Run("returns 503 when storage is unavailable", func(t *testing.T) {
+		w := create(unavailableStorage{}, &MockResumeRepository{}, storageKey)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Contains(t, w.Body.String(), "STORAGE_UNAVAILABLE")
+	})
+
+	t.Run("rejects storage_key together with file_url", func(t *testing.T) {
+		handler := NewResumeHandler(service.NewResumeService(&MockResumeRepository{}, fakeStorage{exists: true}, nil, nil))
+		router := setupTestRouter()
+		router.POST("/resumes", mockAuthMiddleware(userID), handler.Create)
+
+		body := `{"title":"Backend CV","file_url":"https://example.com/cv.pdf","storage_key":"` + storageKey + `"}`
+		req, _ := http.NewRequest(http.MethodPost, "/resumes", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "VALIDATION_ERROR")
+	})
+}
+
+func TestResumeHandler_Get_S3ResumeHasNoETag(t *testing.T) {
+	userID := "user-123"
+	storageKey := "users/user-123/resumes/resume-1.pdf"
+	mockRepo := &MockResumeRepository{
+		GetByIDFunc: func(ctx context.Context, uid, rid string) (*model.Resume, error) {
+			return &model.Resume{ID: rid, UserID: uid, Title: "Backend CV", StorageType: model.StorageTypeS3, StorageKey: &storageKey}, nil
+		},
+	}
+	handler := NewResumeHandler(service.NewResumeService(mockRepo, fakeStorage{exists: true}, nil, nil))
+	router := setupTestRouter()
+	router.GET("/resumes/:id", mockAuthMiddleware(userID), handler.Get)
+
+	req, _ := http.NewRequest(http.MethodGet, "/resumes/resume-1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("ETag"), "the presigned download URL expires")
+	assert.Contains(t, w.Body.String(), "https://s3.example.com/download/"+storageKey)
+}
+
+// multipartResume builds a multipart upload body with a title and a file of the given content type
+func multipartResume(t *testing.T, title, filename, contentType string, data []byte) (*bytes.Buffer, string) {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	if title != "" {
+		require.NoError(t, writer.WriteField("title", title))
+	}
+	if filename != "" {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", `form-data; name="file"; filename="`+filename+`"`)
+		header.Set("Content-Type", contentType)
+		part, err := writer.CreatePart(header)
+		require.NoError(t, err)
+		_, err = part.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	return body, writer.FormDataContentType()
+}
+
+func TestResumeHandler_Upload(t *testing.T) {
+	userID := "user-123"
+
+	upload := func(objectStorage storage.ObjectStorage, repo *MockResumeRepository, body *bytes.Buffer, contentType string) *httptest.ResponseRecorder {
+		handler := NewResumeHandler(service.NewResumeService(repo, objectStorage, nil, nil))
+		router := setupTestRouter()
+		router.POST("/resumes/upload", mockAuthMiddleware(userID), handler.Upload)
+
+		req, _ := http.NewRequest(http.MethodPost, "/resumes/upload", body)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("creates an S3 resume from the uploaded file", func(t *testing.T) {
+		var created *model.Resume
+		repo := &MockResumeRepository{
+			CreateFunc: func(ctx context.Context, resume *model.Resume) error {
+				resume.ID = "resume-1"
+				created = resume
+				return nil
+			},
+		}
+		body, contentType := multipartResume(t, "Backend CV", "cv.pdf", "application/pdf", []byte("%PDF-1.7"))
+
+		w := upload(fakeStorage{}, repo, body, contentType)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		require.NotNil(t, created)
+		assert.Equal(t, "Backend CV", created.Title)
+		assert.Equal(t, model.StorageTypeS3, created.StorageType)
+		require.NotNil(t, created.StorageKey)
+		assert.Regexp(t, `^users/user-123/resumes/[0-9a-f-]{36}\.pdf$`, *created.StorageKey)
+		assert.Contains(t, w.Body.String(), `"storage_type":"s3"`)
+	})
+
+	t.Run("returns 400 for an unsupported file type", func(t *testing.T) {
+		body, contentType := multipartResume(t, "Backend CV", "cv.png", "image/png", []byte("png"))
+
+		w := upload(fakeStorage{}, &MockResumeRepository{}, body, contentType)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "UNSUPPORTED_FILE_TYPE")
+	})
+
+	t.Run("ignores the declared content type", func(t *testing.T) {
+		body, contentType := multipartResume(t, "Backend CV", "cv.pdf", "application/pdf", []byte("<html><script>alert(1)</script></html>"))
+
+		w := upload(fakeStorage{}, &MockResumeRepository{}, body, contentType)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "UNSUPPORTED_FILE_TYPE")
+	})
+
+	t.Run("returns 400 for a file over 10MB", func(t *testing.T) {
+		body, contentType := multipartResume(t, "Backend CV", "cv.pdf", "application/pdf", make([]byte, model.MaxUploadSize+1))
+
+		w := upload(fakeStorage{}, &MockResumeRepository{}, body, contentType)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "FILE_TOO_LARGE")
+	})
+
+	t.Run("returns 400 without a file", func(t *testing.T) {
+		body, contentType := multipartResume(t, "Backend CV", "", "", nil)
+
+		w := upload(fakeStorage{}, &MockResumeRepository{}, body, contentType)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "NO_FILE")
+	})
+
+	t.Run("returns 400 without a title", func(t *testing.T) {
+		body, contentType := multipartResume(t, "", "cv.pdf", "application/pdf", []byte("%PDF-1.7"))
+
+		w := upload(fakeStorage{}, &MockResumeRepository{}, body, contentType)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "VALIDATION_ERROR")
+	})
+
+	t.Run("returns 503 when storage is unavailable", func(t *testing.T) {
+		body, contentType := multipartResume(t, "Backend CV", "cv.pdf", "application/pdf", []byte("%PDF-1.7"))
+
+		w := upload(unavailableStorage{}, &MockResumeRepository{}, body, contentType)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Contains(t, w.Body.String(), "STORAGE_UNAVAILABLE")
+	})
+}
@@ -1,12 +1,20 @@
 package model
 
-import "errors"
+import (
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
 
 var (
-	ErrResumeNotFound      = errors.New("resume not found")
-	ErrResumeTitleRequired = errors.New("resume title is required")
-	ErrResumeURLRequired   = errors.New("resume file URL is required")
-	ErrResumeInUse         = errors.New("cannot delete resume: it is used in one or more applications")
+	ErrResumeNotFound      = &DomainError{Code: CodeResumeNotFound, Message: "resume not found"}
+	ErrResumeTitleRequired = &DomainError{Code: CodeResumeTitleRequired, Message: "resume title is required"}
+	ErrResumeURLRequired   = &DomainError{Code: CodeResumeURLRequired, Message: "resume file URL is required"}
+	ErrResumeInUse         = &DomainError{Code: CodeResumeInUse, Message: "cannot delete resume: it is used in one or more applications"}
+	ErrInvalidStorageKey   = &DomainError{Code: CodeInvalidStorageKey, Message: "storage key was not issued to this user"}
+	ErrResumeFileMissing   = &DomainError{Code: CodeResumeFileMissing, Message: "no file has been uploaded for this storage key"}
+	ErrStorageKeyInUse     = &DomainError{Code: CodeStorageKeyInUse, Message: "storage key is already used by another resume"}
+	ErrFileTooLarge        = &DomainError{Code: CodeFileTooLarge, Message: "resume file exceeds the 10MB limit"}
+	ErrUnsupportedFileType = &DomainError{Code: CodeUnsupportedFileType, Message: "resume file must be a PDF, DOC or DOCX file"}
 )
 
 type ErrorCode string
@@ -16,35 +24,22 @@ const (
 	CodeResumeTitleRequired ErrorCode = "RESUME_TITLE_REQUIRED"
 	CodeResumeURLRequired   ErrorCode = "RESUME_URL_REQUIRED"
 	CodeResumeInUse         ErrorCode = "RESUME_IN_USE"
+	CodeInvalidStorageKey   ErrorCode = "INVALID_STORAGE_KEY"
+	CodeResumeFileMissing   ErrorCode = "RESUME_FILE_MISSING"
+	CodeStorageKeyInUse     ErrorCode = "STORAGE_KEY_IN_USE"
+	CodeFileTooLarge        ErrorCode = "FILE_TOO_LARGE"
+	CodeUnsupportedFileType ErrorCode = "UNSUPPORTED_FILE_TYPE"
 	CodeInternalError       ErrorCode = "INTERNAL_ERROR"
 )
 
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
 func GetErrorCode(err error) ErrorCode {
-	switch {
-	case errors.Is(err, ErrResumeNotFound):
-		return CodeResumeNotFound
-	case errors.Is(err, ErrResumeTitleRequired):
-		return CodeResumeTitleRequired
-	case errors.Is(err, ErrResumeURLRequired):
-		return CodeResumeURLRequired
-	case errors.Is(err, ErrResumeInUse):
-		return CodeResumeInUse
-	default:
-		return CodeInternalError
-	}
+	return domainerr.CodeOf(err, CodeInternalError)
 }
 
-func GetErrorMessage(err error) string {
-	switch {
-	case errors.Is(err, ErrResumeNotFound):
-		return "Resume not found"
-	case errors.Is(err, ErrResumeTitleRequired):
-		return "Resume title is required"
-	case errors.Is(err, ErrResumeURLRequired):
-		return "Resume file URL is required"
-	case errors.Is(err, ErrResumeInUse):
-		return "Cannot delete resume: it is used in one or more applications"
-	default:
-		return "Internal server error"
-	}
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
 }
@@ -1,9 +1,18 @@
 package model
 
+// CreateResumeRequest creates a resume from an external file_url or, after a
+// direct upload, from the storage_key returned by /resumes/upload-url
 type CreateResumeRequest struct {
-	Title    string  `json:"title" binding:"required,min=1,max=255"`
-	FileURL  *string `json:"file_url,omitempty"`
-	IsActive *bool   `json:"is_active,omitempty"`
+	Title      string  `json:"title" binding:"required,min=1,max=255"`
+	FileURL    *string `json:"file_url,omitempty"`
+	StorageKey *string `json:"storage_key,omitempty" binding:"omitempty,excluded_with=FileURL"`
+	IsActive   *bool   `json:"is_active,omitempty"`
+}
+
+// UploadResumeRequest holds the form fields of a multipart resume upload;
+// the file itself is sent in the "file" field
+type UploadResumeRequest struct {
+	Title string `form:"title" binding:"required,min=1,max=255"`
 }
 
 type UpdateResumeRequest struct {
@@ -20,9 +29,9 @@ type GenerateUploadURLRequest struct {
 
 // GenerateUploadURLResponse represents response with presigned upload URL
 type GenerateUploadURLResponse struct {
-	ResumeID  string `json:"resume_id"`
-	UploadURL string `json:"upload_url"`
-	ExpiresIn int    `json:"expires_in"`
+	UploadURL  string `json:"upload_url"`
+	StorageKey string `json:"storage_key"`
+	ExpiresIn  int    `json:"expires_in"`
 }
 
 // DownloadURLResponse represents response with presigned download URL
@@ -30,3 +39,9 @@ type DownloadURLResponse struct {
 	DownloadURL string `json:"download_url"`
 	ExpiresIn   int    `json:"expires_in"`
 }
+
+// ApplicationsCountResponse represents the number of applications using a resume
+type ApplicationsCountResponse struct {
+	Count     int  `json:"count"`
+	CanDelete bool `json:"can_delete"`
+}
@@ -10,6 +10,17 @@ const (
 	StorageTypeS3       StorageType = "s3"
 )
 
+// MaxUploadSize is the largest resume file accepted by a direct upload
+const MaxUploadSize = 10 * 1024 * 1024 // 10MB
+
+// UploadFileExtensions maps the content types accepted for resume uploads, as
+// detected from the file contents, to the extension of their storage key
+var UploadFileExtensions = map[string]string{
+	"application/pdf":    ".pdf",
+	"application/msword": ".doc",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": ".docx",
+}
+
 // Resume represents a user's resume
 type Resume struct {
 	ID          string
@@ -30,6 +41,7 @@ type ResumeDTO struct {
 	FileURL           *string     `json:"file_url"`
 	StorageType       StorageType `json:"storage_type"`
 	StorageKey        *string     `json:"storage_key,omitempty"`
+	DownloadURL       *string     `json:"download_url,omitempty"`
 	IsActive          bool        `json:"is_active"`
 	ApplicationsCount int         `json:"applications_count"`
 	CanDelete         bool        `json:"can_delete"`
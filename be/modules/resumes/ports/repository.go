@@ -18,4 +18,5 @@ type ResumeRepository interface {
 	List(ctx context.Context, userID string, limit, offset int, sortBy, sortDir string) ([]*ResumeWithCount, int, error)
 	Update(ctx context.Context, resume *model.Resume) error
 	Delete(ctx context.Context, userID, resumeID string) error
+	CountApplications(ctx context.Context, userID, resumeID string) (int, error)
 }
@@ -13,21 +13,32 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
 type ResumeRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 func NewResumeRepository(pool *pgxpool.Pool) *ResumeRepository {
 	return &ResumeRepository{pool: pool}
 }
 
+// NewResumeRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewResumeRepositoryWithPool(pool DBPool) *ResumeRepository {
+	return &ResumeRepository{pool: pool}
+}
+
 func (r *ResumeRepository) Create(ctx context.Context, resume *model.Resume) error {
 	query := `
 		INSERT INTO resumes (id, user_id, title, file_url, storage_type, storage_key, is_active, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
 
-	// Only generate ID if not already set (S3 upload flow sets it)
 	if resume.ID == "" {
 		resume.ID = uuid.New().String()
 	}
@@ -38,6 +49,10 @@ func (r *ResumeRepository) Create(ctx context.Context, resume *model.Resume) err
 	_, err := r.pool.Exec(ctx, query,
 		resume.ID, resume.UserID, resume.Title, resume.FileURL, resume.StorageType, resume.StorageKey, resume.IsActive, resume.CreatedAt, resume.UpdatedAt,
 	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return model.ErrStorageKeyInUse
+	}
 	return err
 }
 
@@ -106,7 +121,7 @@ func (r *ResumeRepository) List(ctx context.Context, userID string, limit, offse
 			r.updated_at,
 			COALESCE(COUNT(a.id), 0) as applications_count
 		FROM resumes r
-		LEFT JOIN applications a ON r.id = a.resume_id
+		LEFT JOIN applications a ON r.id = a.resume_id AND a.deleted_at IS NULL
 		WHERE r.user_id = $1
 		GROUP BY r.id, r.user_id, r.title, r.file_url, r.storage_type, r.storage_key, r.is_active, r.created_at, r.updated_at
 		ORDER BY ` + orderClause + `
@@ -178,3 +193,14 @@ func (r *ResumeRepository) Delete(ctx context.Context, userID, resumeID string)
 	}
 	return nil
 }
+
+// CountApplications returns the number of the user's applications that use the resume,
+// not counting those in the trash
+func (r *ResumeRepository) CountApplications(ctx context.Context, userID, resumeID string) (int, error) {
+	query := `SELECT COUNT(*) FROM applications WHERE resume_id = $1 AND user_id = $2 AND deleted_at IS NULL`
+	var count int
+	if err := r.pool.QueryRow(ctx, query, resumeID, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/andreypavlenko/jobber/modules/resumes/model"
 	"github.com/andreypavlenko/jobber/modules/resumes/ports"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -37,6 +38,31 @@ func TestResumeRepository_Create(t *testing.T) {
 		assert.NotEmpty(t, resume.ID)
 		require.NoError(t, mock.ExpectationsWereMet())
 	})
+
+	t.Run("returns ErrStorageKeyInUse for a storage key another resume uses", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		storageKey := "users/user-123/resumes/key.pdf"
+		resume := &model.Resume{
+			UserID:      "user-123",
+			Title:       "Uploaded Resume",
+			StorageType: model.StorageTypeS3,
+			StorageKey:  &storageKey,
+			IsActive:    true,
+		}
+
+		mock.ExpectExec("INSERT INTO resumes").
+			WithArgs(pgxmock.AnyArg(), resume.UserID, resume.Title, resume.FileURL, resume.StorageType, resume.StorageKey, resume.IsActive, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnError(&pgconn.PgError{Code: "23505"})
+
+		repo := NewResumeRepositoryWithPool(mock)
+		err = repo.Create(context.Background(), resume)
+
+		assert.ErrorIs(t, err, model.ErrStorageKeyInUse)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
 func TestResumeRepository_GetByID(t *testing.T) {
@@ -204,6 +230,51 @@ func TestResumeRepository_List(t *testing.T) {
 	})
 }
 
+func TestResumeRepository_List_CountsLiveApplications(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	userID := "user-123"
+	now := time.Now()
+	mock.ExpectQuery("SELECT COUNT").
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`LEFT JOIN applications a ON r.id = a.resume_id AND a.deleted_at IS NULL`).
+		WithArgs(userID, 20, 0).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "user_id", "title", "file_url", "storage_type", "storage_key", "is_active", "created_at", "updated_at", "applications_count",
+		}).AddRow("resume-1", userID, "Resume A", nil, "external", nil, true, now, now, 2))
+
+	repo := NewResumeRepositoryWithPool(mock)
+	resumes, total, err := repo.List(context.Background(), userID, 20, 0, "created_at", "desc")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, resumes, 1)
+	assert.Equal(t, 2, resumes[0].ApplicationsCount)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResumeRepository_CountApplications(t *testing.T) {
+	for _, count := range []int{0, 4} {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM applications WHERE resume_id = \$1 AND user_id = \$2 AND deleted_at IS NULL`).
+			WithArgs("resume-1", "user-123").
+			WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(count))
+
+		repo := NewResumeRepositoryWithPool(mock)
+		got, err := repo.CountApplications(context.Background(), "user-123", "resume-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, count, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.Close()
+	}
+}
+
 // testResumeRepo is a test wrapper that uses pgxmock
 type testResumeRepo struct {
 	mock pgxmock.PgxPoolIface
@@ -23,15 +23,18 @@ type CacheInvalidator interface {
 	InvalidateByResume(ctx context.Context, resumeID string) error
 }
 
+// downloadURLExpiry is how long presigned download URLs for resume files stay valid
+const downloadURLExpiry = 15 * time.Minute
+
 type ResumeService struct {
 	repo             ports.ResumeRepository
-	s3Client         *storage.S3Client
+	s3Client         storage.ObjectStorage
 	s3Enabled        bool
 	limitChecker     LimitChecker
 	cacheInvalidator CacheInvalidator
 }
 
-func NewResumeService(repo ports.ResumeRepository, s3Client *storage.S3Client, limitChecker LimitChecker, cacheInvalidator CacheInvalidator) *ResumeService {
+func NewResumeService(repo ports.ResumeRepository, s3Client storage.ObjectStorage, limitChecker LimitChecker, cacheInvalidator CacheInvalidator) *ResumeService {
 	return &ResumeService{
 		repo:             repo,
 		s3Client:         s3Client,
@@ -58,11 +61,19 @@ func (s *ResumeService) Create(ctx context.Context, userID string, req *model.Cr
 		isActive = *req.IsActive
 	}
 
-	var fileURL *string
+	var fileURL, storageKey *string
 	storageType := model.StorageTypeExternal
 
-	// If file_url is provided, use it as external storage
-	if req.FileURL != nil && strings.TrimSpace(*req.FileURL) != "" {
+	if req.StorageKey != nil {
+		// The file was uploaded directly to S3 with a URL from GenerateUploadURL
+		key := strings.TrimSpace(*req.StorageKey)
+		if err := s.checkUploadedFile(ctx, userID, key); err != nil {
+			return nil, err
+		}
+		storageKey = &key
+		storageType = model.StorageTypeS3
+	} else if req.FileURL != nil && strings.TrimSpace(*req.FileURL) != "" {
+		// If file_url is provided, use it as external storage
 		trimmedURL := strings.TrimSpace(*req.FileURL)
 		fileURL = &trimmedURL
 	}
@@ -72,14 +83,108 @@ func (s *ResumeService) Create(ctx context.Context, userID string, req *model.Cr
 		Title:       strings.TrimSpace(req.Title),
 		FileURL:     fileURL,
 		StorageType: storageType,
-		StorageKey:  nil,
+		StorageKey:  storageKey,
 		IsActive:    isActive,
 	}
 
 	if err := s.repo.Create(ctx, resume); err != nil {
 		return nil, err
 	}
-	return resume.ToDTO(), nil
+	return s.withDownloadURL(ctx, resume.ToDTO()), nil
+}
+
+// Upload stores a resume file sent through the API in S3 under
+// users/{user_id}/resumes/{uuid}{ext} and creates an S3 resume for it. The
+// file type and extension are detected from the contents, so a file is only
+// accepted when it really is a PDF, DOC or DOCX.
+func (s *ResumeService) Upload(ctx context.Context, userID, title string, data []byte) (*model.ResumeDTO, error) {
+	if s.limitChecker != nil {
+		if err := s.limitChecker.CheckLimit(ctx, userID, "resumes"); err != nil {
+			return nil, err
+		}
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, model.ErrResumeTitleRequired
+	}
+	contentType, _ := storage.DetectDocumentType(data)
+	ext, ok := model.UploadFileExtensions[contentType]
+	if !ok {
+		return nil, model.ErrUnsupportedFileType
+	}
+	if len(data) > model.MaxUploadSize {
+		return nil, model.ErrFileTooLarge
+	}
+	if !s.s3Enabled {
+		return nil, fmt.Errorf("S3 storage is not configured")
+	}
+
+	storageKey := resumeStoragePrefix(userID) + uuid.New().String() + ext
+	if err := s.s3Client.PutObject(ctx, storageKey, contentType, data); err != nil {
+		return nil, fmt.Errorf("failed to upload resume file: %w", err)
+	}
+
+	resume := &model.Resume{
+		UserID:      userID,
+		Title:       title,
+		StorageType: model.StorageTypeS3,
+		StorageKey:  &storageKey,
+		IsActive:    true,
+	}
+	if err := s.repo.Create(ctx, resume); err != nil {
+		if delErr := s.s3Client.DeleteObject(ctx, storageKey); delErr != nil {
+			log.Printf("[ERROR] failed to delete S3 object key=%s after resume creation failed: %v", storageKey, delErr)
+		}
+		return nil, err
+	}
+	return s.withDownloadURL(ctx, resume.ToDTO()), nil
+}
+
+// checkUploadedFile verifies that key is a resume storage key issued to the
+// user by GenerateUploadURL and that the file has been uploaded
+func (s *ResumeService) checkUploadedFile(ctx context.Context, userID, key string) error {
+	if !s.s3Enabled {
+		return fmt.Errorf("S3 storage is not configured")
+	}
+
+	id, ok := strings.CutPrefix(key, resumeStoragePrefix(userID))
+	if ok {
+		id, ok = strings.CutSuffix(id, ".pdf")
+	}
+	if !ok || uuid.Validate(id) != nil {
+		return model.ErrInvalidStorageKey
+	}
+
+	exists, err := s.s3Client.ObjectExists(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to check uploaded file: %w", err)
+	}
+	if !exists {
+		return model.ErrResumeFileMissing
+	}
+	return nil
+}
+
+// resumeStoragePrefix is the S3 key prefix under which a user's resume files are stored
+func resumeStoragePrefix(userID string) string {
+	return fmt.Sprintf("users/%s/resumes/", userID)
+}
+
+// withDownloadURL adds a presigned download URL to resumes stored in S3. A
+// presigning failure is logged rather than failing the read.
+func (s *ResumeService) withDownloadURL(ctx context.Context, dto *model.ResumeDTO) *model.ResumeDTO {
+	if !s.s3Enabled || dto.StorageType != model.StorageTypeS3 || dto.StorageKey == nil {
+		return dto
+	}
+
+	downloadURL, err := s.s3Client.GeneratePresignedDownloadURL(ctx, *dto.StorageKey, downloadURLExpiry)
+	if err != nil {
+		log.Printf("[WARN] failed to generate download URL for resume=%s: %v", dto.ID, err)
+		return dto
+	}
+	dto.DownloadURL = &downloadURL
+	return dto
 }
 
 func (s *ResumeService) GetByID(ctx context.Context, userID, resumeID string) (*model.ResumeDTO, error) {
@@ -87,7 +192,23 @@ func (s *ResumeService) GetByID(ctx context.Context, userID, resumeID string) (*
 	if err != nil {
 		return nil, err
 	}
-	return resume.ToDTO(), nil
+	return s.withDownloadURL(ctx, resume.ToDTO()), nil
+}
+
+// CountApplications returns how many applications use the resume and whether it can be deleted
+func (s *ResumeService) CountApplications(ctx context.Context, userID, resumeID string) (*model.ApplicationsCountResponse, error) {
+	if _, err := s.repo.GetByID(ctx, userID, resumeID); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountApplications(ctx, userID, resumeID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.ApplicationsCountResponse{
+		Count:     count,
+		CanDelete: count == 0,
+	}, nil
 }
 
 func (s *ResumeService) List(ctx context.Context, userID string, limit, offset int, sortBy, sortDir string) ([]*model.ResumeDTO, int, error) {
@@ -106,7 +227,7 @@ func (s *ResumeService) List(ctx context.Context, userID string, limit, offset i
 
 	dtos := make([]*model.ResumeDTO, len(resumesWithCounts))
 	for i, rwc := range resumesWithCounts {
-		dtos[i] = rwc.Resume.ToDTOWithCounts(rwc.ApplicationsCount)
+		dtos[i] = s.withDownloadURL(ctx, rwc.Resume.ToDTOWithCounts(rwc.ApplicationsCount))
 	}
 	return dtos, total, nil
 }
@@ -148,7 +269,7 @@ func (s *ResumeService) Update(ctx context.Context, userID, resumeID string, req
 		}
 	}
 
-	return resume.ToDTO(), nil
+	return s.withDownloadURL(ctx, resume.ToDTO()), nil
 }
 
 func (s *ResumeService) Delete(ctx context.Context, userID, resumeID string) error {
@@ -179,6 +300,8 @@ func (s *ResumeService) Delete(ctx context.Context, userID, resumeID string) err
 }
 
 // GenerateUploadURL generates a presigned URL for uploading a resume file
+// directly to S3. No resume exists until the client creates one with the
+// returned storage key after the upload.
 func (s *ResumeService) GenerateUploadURL(ctx context.Context, userID string, req *model.GenerateUploadURLRequest) (*model.GenerateUploadURLResponse, error) {
 	// Check subscription limit
 	if s.limitChecker != nil {
@@ -196,11 +319,8 @@ func (s *ResumeService) GenerateUploadURL(ctx context.Context, userID string, re
 		return nil, fmt.Errorf("only PDF files are allowed")
 	}
 
-	// Generate resume ID
-	resumeID := uuid.New().String()
-
-	// Generate S3 key: users/{user_id}/resumes/{resume_id}.pdf
-	storageKey := fmt.Sprintf("users/%s/resumes/%s.pdf", userID, resumeID)
+	// Generate S3 key: users/{user_id}/resumes/{uuid}.pdf
+	storageKey := resumeStoragePrefix(userID) + uuid.New().String() + ".pdf"
 
 	// Generate presigned URL (5 minutes expiry)
 	expiry := 5 * time.Minute
@@ -209,25 +329,10 @@ func (s *ResumeService) GenerateUploadURL(ctx context.Context, userID string, re
 		return nil, fmt.Errorf("failed to generate upload URL: %w", err)
 	}
 
-	// Create resume record with S3 storage type
-	resume := &model.Resume{
-		ID:          resumeID,
-		UserID:      userID,
-		Title:       "Untitled Resume", // Default title, user can update later
-		FileURL:     nil,
-		StorageType: model.StorageTypeS3,
-		StorageKey:  &storageKey,
-		IsActive:    false, // Default to inactive until file is uploaded
-	}
-
-	if err := s.repo.Create(ctx, resume); err != nil {
-		return nil, fmt.Errorf("failed to create resume record: %w", err)
-	}
-
 	return &model.GenerateUploadURLResponse{
-		ResumeID:  resumeID,
-		UploadURL: uploadURL,
-		ExpiresIn: int(expiry.Seconds()),
+		UploadURL:  uploadURL,
+		StorageKey: storageKey,
+		ExpiresIn:  int(expiry.Seconds()),
 	}, nil
 }
 
@@ -252,15 +357,13 @@ func (s *ResumeService) GenerateDownloadURL(ctx context.Context, userID, resumeI
 		return nil, fmt.Errorf("resume storage key is missing")
 	}
 
-	// Generate presigned download URL (15 minutes expiry)
-	expiry := 15 * time.Minute
-	downloadURL, err := s.s3Client.GeneratePresignedDownloadURL(ctx, *resume.StorageKey, expiry)
+	downloadURL, err := s.s3Client.GeneratePresignedDownloadURL(ctx, *resume.StorageKey, downloadURLExpiry)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate download URL: %w", err)
 	}
 
 	return &model.DownloadURLResponse{
 		DownloadURL: downloadURL,
-		ExpiresIn:   int(expiry.Seconds()),
+		ExpiresIn:   int(downloadURLExpiry.Seconds()),
 	}, nil
 }
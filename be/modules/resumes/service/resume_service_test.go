@@ -1,11 +1,15 @@
 package service
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/andreypavlenko/jobber/internal/platform/storage"
 	"github.com/andreypavlenko/jobber/modules/resumes/model"
 	"github.com/andreypavlenko/jobber/modules/resumes/ports"
 	"github.com/stretchr/testify/assert"
@@ -14,11 +18,12 @@ import (
 
 // MockResumeRepository implements ports.ResumeRepository
 type MockResumeRepository struct {
-	CreateFunc  func(ctx context.Context, resume *model.Resume) error
-	GetByIDFunc func(ctx context.Context, userID, resumeID string) (*model.Resume, error)
-	ListFunc    func(ctx context.Context, userID string, limit, offset int, sortBy, sortDir string) ([]*ports.ResumeWithCount, int, error)
-	UpdateFunc  func(ctx context.Context, resume *model.Resume) error
-	DeleteFunc  func(ctx context.Context, userID, resumeID string) error
+	CreateFunc            func(ctx context.Context, resume *model.Resume) error
+	GetByIDFunc           func(ctx context.Context, userID, resumeID string) (*model.Resume, error)
+	ListFunc              func(ctx context.Context, userID string, limit, offset int, sortBy, sortDir string) ([]*ports.ResumeWithCount, int, error)
+	UpdateFunc            func(ctx context.Context, resume *model.Resume) error
+	DeleteFunc            func(ctx context.Context, userID, resumeID string) error
+	CountApplicationsFunc func(ctx context.Context, userID, resumeID string) (int, error)
 }
 
 func (m *MockResumeRepository) Create(ctx context.Context, resume *model.Resume) error {
@@ -56,6 +61,13 @@ func (m *MockResumeRepository) Delete(ctx context.Context, userID, resumeID stri
 	return nil
 }
 
+func (m *MockResumeRepository) CountApplications(ctx context.Context, userID, resumeID string) (int, error) {
+	if m.CountApplicationsFunc != nil {
+		return m.CountApplicationsFunc(ctx, userID, resumeID)
+	}
+	return 0, nil
+}
+
 func TestResumeService_Create(t *testing.T) {
 	userID := "user-123"
 
@@ -207,6 +219,67 @@ func TestResumeService_GetByID(t *testing.T) {
 	})
 }
 
+func TestResumeService_CountApplications(t *testing.T) {
+	userID := "user-123"
+	resumeID := "resume-1"
+
+	t.Run("allows delete when no applications use the resume", func(t *testing.T) {
+		mockRepo := &MockResumeRepository{
+			GetByIDFunc: func(ctx context.Context, uid, rid string) (*model.Resume, error) {
+				return &model.Resume{ID: rid, UserID: uid}, nil
+			},
+			CountApplicationsFunc: func(ctx context.Context, uid, rid string) (int, error) {
+				return 0, nil
+			},
+		}
+
+		svc := NewResumeService(mockRepo, nil, nil, nil)
+		result, err := svc.CountApplications(context.Background(), userID, resumeID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Count)
+		assert.True(t, result.CanDelete)
+	})
+
+	t.Run("blocks delete when applications use the resume", func(t *testing.T) {
+		mockRepo := &MockResumeRepository{
+			GetByIDFunc: func(ctx context.Context, uid, rid string) (*model.Resume, error) {
+				return &model.Resume{ID: rid, UserID: uid}, nil
+			},
+			CountApplicationsFunc: func(ctx context.Context, uid, rid string) (int, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, resumeID, rid)
+				return 2, nil
+			},
+		}
+
+		svc := NewResumeService(mockRepo, nil, nil, nil)
+		result, err := svc.CountApplications(context.Background(), userID, resumeID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Count)
+		assert.False(t, result.CanDelete)
+	})
+
+	t.Run("returns not found for another user's resume", func(t *testing.T) {
+		mockRepo := &MockResumeRepository{
+			GetByIDFunc: func(ctx context.Context, uid, rid string) (*model.Resume, error) {
+				return nil, model.ErrResumeNotFound
+			},
+			CountApplicationsFunc: func(ctx context.Context, uid, rid string) (int, error) {
+				t.Fatal("count should not be called")
+				return 0, nil
+			},
+		}
+
+		svc := NewResumeService(mockRepo, nil, nil, nil)
+		result, err := svc.CountApplications(context.Background(), userID, resumeID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, model.ErrResumeNotFound)
+	})
+}
+
 func TestResumeService_List(t *testing.T) {
 	userID := "user-123"
 
@@ -1040,3 +1113,295 @@ func TestResumeService_Create_WhitespaceOnlyFileURL(t *testing.T) {
 	// whitespace-only URL should not be set
 	assert.NotNil(t, result)
 }
+
+// MockObjectStorage implements storage.ObjectStorage
+type MockObjectStorage struct {
+	ObjectExistsFunc func(ctx context.Context, key string) (bool, error)
+	PutObjectFunc    func(ctx context.Context, key, contentType string, data []byte) error
+	UploadKey        string
+	DeletedKeys      []string
+}
+
+func (m *MockObjectStorage) GeneratePresignedUploadURL(ctx context.Context, key, contentType string, expiry time.Duration) (string, error) {
+	m.UploadKey = key
+	return "https://s3.example.com/upload/" + key, nil
+}
+func (m *MockObjectStorage) GeneratePresignedDownloadURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	return "https://s3.example.com/download/" + key, nil
+}
+func (m *MockObjectStorage) PutObject(ctx context.Context, key, contentType string, data []byte) error {
+	if m.PutObjectFunc != nil {
+		return m.PutObjectFunc(ctx, key, contentType, data)
+	}
+	return nil
+}
+func (m *MockObjectStorage) DeleteObject(ctx context.Context, key string) error {
+	m.DeletedKeys = append(m.DeletedKeys, key)
+	return nil
+}
+func (m *MockObjectStorage) GetObject(ctx context.Context, key string) ([]byte, error) {
+	return nil, nil
+}
+func (m *MockObjectStorage) ObjectExists(ctx context.Context, key string) (bool, error) {
+	if m.ObjectExistsFunc != nil {
+		return m.ObjectExistsFunc(ctx, key)
+	}
+	return true, nil
+}
+
+func TestResumeService_GenerateUploadURL_ReturnsStorageKey(t *testing.T) {
+	mockRepo := &MockResumeRepository{
+		CreateFunc: func(ctx context.Context, resume *model.Resume) error {
+			t.Fatal("no resume is created until the upload is finalized")
+			return nil
+		},
+	}
+	objectStorage := &MockObjectStorage{}
+	svc := NewResumeService(mockRepo, objectStorage, nil, nil)
+
+	result, err := svc.GenerateUploadURL(context.Background(), "user-123", &model.GenerateUploadURLRequest{
+		Filename:    "resume.pdf",
+		ContentType: "application/pdf",
+	})
+
+	require.NoError(t, err)
+	assert.Regexp(t, `^users/user-123/resumes/[0-9a-f-]{36}\.pdf$`, result.StorageKey)
+	assert.Equal(t, objectStorage.UploadKey, result.StorageKey)
+	assert.Equal(t, "https://s3.example.com/upload/"+result.StorageKey, result.UploadURL)
+	assert.Equal(t, 300, result.ExpiresIn)
+}
+
+func TestResumeService_Create_FromStorageKey(t *testing.T) {
+	storageKey := "users/user-123/resumes/0b6a4f52-3c1e-4a57-9f0e-7d2b1c8e5a11.pdf"
+
+	t.Run("creates an S3 resume with a download URL", func(t *testing.T) {
+		var created *model.Resume
+		mockRepo := &MockResumeRepository{
+			CreateFunc: func(ctx context.Context, resume *model.Resume) error {
+				resume.ID = "resume-1"
+				created = resume
+				return nil
+			},
+		}
+		var checkedKey string
+		svc := NewResumeService(mockRepo, &MockObjectStorage{
+			ObjectExistsFunc: func(ctx context.Context, key string) (bool, error) {
+				checkedKey = key
+				return true, nil
+			},
+		}, nil, nil)
+		key := " " + storageKey + " "
+
+		result, err := svc.Create(context.Background(), "user-123", &model.CreateResumeRequest{Title: "Backend CV", StorageKey: &key})
+
+		require.NoError(t, err)
+		assert.Equal(t, storageKey, checkedKey)
+		assert.Equal(t, model.StorageTypeS3, created.StorageType)
+		assert.Equal(t, storageKey, *created.StorageKey)
+		assert.Nil(t, created.FileURL)
+		assert.True(t, created.IsActive)
+		require.NotNil(t, result.DownloadURL)
+		assert.Equal(t, "https://s3.example.com/download/"+storageKey, *result.DownloadURL)
+	})
+
+	t.Run("rejects keys not issued to the user", func(t *testing.T) {
+		svc := NewResumeService(&MockResumeRepository{}, &MockObjectStorage{}, nil, nil)
+
+		for _, key := range []string{
+			"users/user-456/resumes/0b6a4f52-3c1e-4a57-9f0e-7d2b1c8e5a11.pdf",
+			"users/user-123/resumes/../../user-456/resumes/0b6a4f52-3c1e-4a57-9f0e-7d2b1c8e5a11.pdf",
+			"users/user-123/resumes/0b6a4f52-3c1e-4a57-9f0e-7d2b1c8e5a11.docx",
+			"users/user-123/cover-letters/0b6a4f52-3c1e-4a57-9f0e-7d2b1c8e5a11.pdf",
+			"",
+		} {
+			_, err := svc.Create(context.Background(), "user-123", &model.CreateResumeRequest{Title: "Backend CV", StorageKey: &key})
+
+			assert.ErrorIs(t, err, model.ErrInvalidStorageKey, key)
+		}
+	})
+
+	t.Run("requires the file to be uploaded", func(t *testing.T) {
+		svc := NewResumeService(&MockResumeRepository{}, &MockObjectStorage{
+			ObjectExistsFunc: func(ctx context.Context, key string) (bool, error) {
+				return false, nil
+			},
+		}, nil, nil)
+
+		_, err := svc.Create(context.Background(), "user-123", &model.CreateResumeRequest{Title: "Backend CV", StorageKey: &storageKey})
+
+		assert.ErrorIs(t, err, model.ErrResumeFileMissing)
+	})
+
+	t.Run("returns error when S3 is not configured", func(t *testing.T) {
+		svc := NewResumeService(&MockResumeRepository{}, nil, nil, nil)
+
+		_, err := svc.Create(context.Background(), "user-123", &model.CreateResumeRequest{Title: "Backend CV", StorageKey: &storageKey})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "S3 storage is not configured")
+	})
+}
+
+func TestResumeService_List_AddsDownloadURLs(t *testing.T) {
+	storageKey := "users/user-123/resumes/resume-1.pdf"
+	externalURL := "https://example.com/cv.pdf"
+	mockRepo := &MockResumeRepository{
+		ListFunc: func(ctx context.Context, userID string, limit, offset int, sortBy, sortDir string) ([]*ports.ResumeWithCount, int, error) {
+			return []*ports.ResumeWithCount{
+				{Resume: &model.Resume{ID: "resume-1", StorageType: model.StorageTypeS3, StorageKey: &storageKey}},
+				{Resume: &model.Resume{ID: "resume-2", StorageType: model.StorageTypeExternal, FileURL: &externalURL}},
+			}, 2, nil
+		},
+	}
+	svc := NewResumeService(mockRepo, &MockObjectStorage{}, nil, nil)
+
+	result, _, err := svc.List(context.Background(), "user-123", 20, 0, "", "")
+
+	require.NoError(t, err)
+	require.NotNil(t, result[0].DownloadURL)
+	assert.Equal(t, "https://s3.example.com/download/"+storageKey, *result[0].DownloadURL)
+	assert.Nil(t, result[1].DownloadURL)
+}
+
+// docxFile returns a minimal Word document archive
+func docxFile(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	f, err := w.Create("word/document.xml")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("<w:document/>"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestResumeService_Upload(t *testing.T) {
+	userID := "user-123"
+	pdf := []byte("%PDF-1.7 resume")
+
+	t.Run("stores the file and creates an S3 resume", func(t *testing.T) {
+		var created *model.Resume
+		mockRepo := &MockResumeRepository{
+			CreateFunc: func(ctx context.Context, resume *model.Resume) error {
+				resume.ID = "resume-1"
+				created = resume
+				return nil
+			},
+		}
+		var putKey, putType string
+		var putData []byte
+		objectStorage := &MockObjectStorage{
+			PutObjectFunc: func(ctx context.Context, key, contentType string, data []byte) error {
+				putKey, putType, putData = key, contentType, data
+				return nil
+			},
+		}
+		svc := NewResumeService(mockRepo, objectStorage, nil, nil)
+		docx := docxFile(t)
+
+		result, err := svc.Upload(context.Background(), userID, " Backend CV ", docx)
+
+		require.NoError(t, err)
+		assert.Regexp(t, `^users/user-123/resumes/[0-9a-f-]{36}\.docx$`, putKey)
+		assert.Equal(t, storage.ContentTypeDOCX, putType)
+		assert.Equal(t, docx, putData)
+		assert.Equal(t, "Backend CV", created.Title)
+		assert.Equal(t, model.StorageTypeS3, created.StorageType)
+		assert.Equal(t, putKey, *created.StorageKey)
+		assert.True(t, created.IsActive)
+		require.NotNil(t, result.DownloadURL)
+		assert.Equal(t, "https://s3.example.com/download/"+putKey, *result.DownloadURL)
+	})
+
+	t.Run("takes the type from the contents", func(t *testing.T) {
+		tests := []struct {
+			data        []byte
+			contentType string
+			ext         string
+		}{
+			{pdf, storage.ContentTypePDF, ".pdf"},
+			{[]byte("\xd0\xcf\x11\xe0\xa1\xb1\x1a\xe1W\x00o\x00r\x00d\x00D\x00o\x00c\x00u\x00m\x00e\x00n\x00t\x00"), storage.ContentTypeDOC, ".doc"},
+		}
+		for _, tt := range tests {
+			var putKey, putType string
+			objectStorage := &MockObjectStorage{
+				PutObjectFunc: func(ctx context.Context, key, contentType string, data []byte) error {
+					putKey, putType = key, contentType
+					return nil
+				},
+			}
+			svc := NewResumeService(&MockResumeRepository{}, objectStorage, nil, nil)
+
+			_, err := svc.Upload(context.Background(), userID, "CV", tt.data)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.contentType, putType)
+			assert.True(t, strings.HasSuffix(putKey, tt.ext), putKey)
+		}
+	})
+
+	t.Run("rejects unsupported types and oversized files before uploading", func(t *testing.T) {
+		objectStorage := &MockObjectStorage{
+			PutObjectFunc: func(ctx context.Context, key, contentType string, data []byte) error {
+				t.Fatal("nothing should be uploaded")
+				return nil
+			},
+		}
+		svc := NewResumeService(&MockResumeRepository{}, objectStorage, nil, nil)
+
+		_, err := svc.Upload(context.Background(), userID, "CV", []byte("\x89PNG\r\n\x1a\n"))
+		assert.ErrorIs(t, err, model.ErrUnsupportedFileType)
+
+		_, err = svc.Upload(context.Background(), userID, "CV", []byte("plain text is not a resume file"))
+		assert.ErrorIs(t, err, model.ErrUnsupportedFileType)
+
+		_, err = svc.Upload(context.Background(), userID, "CV", append([]byte("%PDF-"), make([]byte, model.MaxUploadSize)...))
+		assert.ErrorIs(t, err, model.ErrFileTooLarge)
+
+		_, err = svc.Upload(context.Background(), userID, "  ", pdf)
+		assert.ErrorIs(t, err, model.ErrResumeTitleRequired)
+	})
+
+	t.Run("checks the plan limit first", func(t *testing.T) {
+		limitChecker := &MockResumeLimitChecker{
+			CheckLimitFunc: func(ctx context.Context, userID, resource string) error {
+				return errors.New("limit reached")
+			},
+		}
+		svc := NewResumeService(&MockResumeRepository{}, &MockObjectStorage{}, limitChecker, nil)
+
+		_, err := svc.Upload(context.Background(), userID, "CV", pdf)
+
+		assert.EqualError(t, err, "limit reached")
+	})
+
+	t.Run("deletes the file when the resume cannot be created", func(t *testing.T) {
+		var putKey string
+		objectStorage := &MockObjectStorage{
+			PutObjectFunc: func(ctx context.Context, key, contentType string, data []byte) error {
+				putKey = key
+				return nil
+			},
+		}
+		mockRepo := &MockResumeRepository{
+			CreateFunc: func(ctx context.Context, resume *model.Resume) error {
+				return errors.New("db error")
+			},
+		}
+		svc := NewResumeService(mockRepo, objectStorage, nil, nil)
+
+		_, err := svc.Upload(context.Background(), userID, "CV", pdf)
+
+		assert.Error(t, err)
+		assert.Equal(t, []string{putKey}, objectStorage.DeletedKeys)
+	})
+
+	t.Run("fails without S3", func(t *testing.T) {
+		svc := NewResumeService(&MockResumeRepository{}, nil, nil, nil)
+
+		_, err := svc.Upload(context.Background(), userID, "CV", pdf)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/andreypavlenko/jobber/internal/platform/auth"
+	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/modules/search/model"
+	"github.com/andreypavlenko/jobber/modules/search/service"
+	"github.com/gin-gonic/gin"
+)
+
+// SearchHandler handles search HTTP requests
+type SearchHandler struct {
+	service *service.SearchService
+}
+
+// NewSearchHandler creates a new search handler
+func NewSearchHandler(service *service.SearchService) *SearchHandler {
+	return &SearchHandler{service: service}
+}
+
+// Search godoc
+// @Summary Search applications, jobs and companies
+// @Description Full-text search over application names and comments, job titles and notes, and company names. Results from all entity types are merged and ordered by rank, best first. Matched words in the snippet are wrapped in **.
+// @Tags search
+// @Security BearerAuth
+// @Produce json
+// @Param q query string true "Search terms, e.g. golang"
+// @Param entity query string false "Comma-separated entity types to search: application, job, company (default: all)"
+// @Param limit query int false "Maximum number of results (default: 20, max: 50)"
+// @Success 200 {object} []model.SearchResult
+// @Failure 400 {object} httpPlatform.ErrorResponse "Missing or too long query, invalid entity type or limit"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /search [get]
+func (h *SearchHandler) Search(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	limit := model.DefaultLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			respondWithSearchError(c, model.ErrInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	var entityTypes []string
+	for _, entityType := range strings.Split(c.Query("entity"), ",") {
+		if entityType = strings.TrimSpace(entityType); entityType != "" {
+			entityTypes = append(entityTypes, entityType)
+		}
+	}
+
+	results, err := h.service.Search(c.Request.Context(), userID, c.Query("q"), entityTypes, limit)
+	if err != nil {
+		respondWithSearchError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, results)
+}
+
+// respondWithSearchError maps search errors to HTTP responses
+func respondWithSearchError(c *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	switch model.GetErrorCode(err) {
+	case model.CodeQueryRequired, model.CodeQueryTooLong, model.CodeInvalidEntityType, model.CodeInvalidLimit:
+		statusCode = http.StatusBadRequest
+	}
+	httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+}
+
+func (h *SearchHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	search := router.Group("/search")
+	search.Use(authMiddleware)
+	{
+		search.GET("", h.Search)
+	}
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/search/model"
+	"github.com/andreypavlenko/jobber/modules/search/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSearchRepository implements ports.SearchRepository
+type MockSearchRepository struct {
+	SearchApplicationsFunc func(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error)
+	SearchJobsFunc         func(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error)
+	SearchCompaniesFunc    func(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error)
+}
+
+func (m *MockSearchRepository) SearchApplications(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error) {
+	if m.SearchApplicationsFunc != nil {
+		return m.SearchApplicationsFunc(ctx, userID, query, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockSearchRepository) SearchJobs(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error) {
+	if m.SearchJobsFunc != nil {
+		return m.SearchJobsFunc(ctx, userID, query, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockSearchRepository) SearchCompanies(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error) {
+	if m.SearchCompaniesFunc != nil {
+		return m.SearchCompaniesFunc(ctx, userID, query, limit)
+	}
+	return nil, nil
+}
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+func mockAuthMiddleware(userID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	}
+}
+
+func get(router *gin.Engine, path string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestSearchHandler_Search(t *testing.T) {
+	userID := "user-123"
+
+	setup := func(repo *MockSearchRepository) *gin.Engine {
+		handler := NewSearchHandler(service.NewSearchService(repo))
+		router := setupTestRouter()
+		handler.RegisterRoutes(router.Group("/api/v1"), mockAuthMiddleware(userID))
+		return router
+	}
+
+	t.Run("returns merged results", func(t *testing.T) {
+		repo := &MockSearchRepository{
+			SearchApplicationsFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				t.Fatal("applications were not requested")
+				return nil, nil
+			},
+			SearchJobsFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, "golang", query)
+				assert.Equal(t, 5, limit)
+				return []*model.SearchResult{{EntityType: "job", EntityID: "job-1", Title: "Go Developer", Snippet: "**Golang** backend", Rank: 0.2}}, nil
+			},
+			SearchCompaniesFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				return []*model.SearchResult{{EntityType: "company", EntityID: "company-1", Title: "Golang Labs", Snippet: "**Golang** Labs", Rank: 0.6}}, nil
+			},
+		}
+
+		w := get(setup(repo), "/api/v1/search?q=golang&entity=job,company&limit=5")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response []model.SearchResult
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 2)
+		assert.Equal(t, "company", response[0].EntityType)
+		assert.Equal(t, "company-1", response[0].EntityID)
+		assert.Equal(t, "Golang Labs", response[0].Title)
+		assert.Equal(t, "**Golang** Labs", response[0].Snippet)
+		assert.Equal(t, "job-1", response[1].EntityID)
+	})
+
+	t.Run("returns an empty list when nothing matches", func(t *testing.T) {
+		w := get(setup(&MockSearchRepository{}), "/api/v1/search?q=golang")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `[]`, w.Body.String())
+	})
+
+	for _, tt := range []struct {
+		name  string
+		query string
+		code  model.ErrorCode
+	}{
+		{name: "missing query", query: "", code: model.CodeQueryRequired},
+		{name: "unknown entity", query: "q=golang&entity=resume", code: model.CodeInvalidEntityType},
+		{name: "non-numeric limit", query: "q=golang&limit=ten", code: model.CodeInvalidLimit},
+		{name: "limit too high", query: "q=golang&limit=51", code: model.CodeInvalidLimit},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			w := get(setup(&MockSearchRepository{}), "/api/v1/search?"+tt.query)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), string(tt.code))
+		})
+	}
+
+	t.Run("translates the error message to the request locale", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/search", nil)
+		req.Header.Set("Accept-Language", "es")
+		w := httptest.NewRecorder()
+		setup(&MockSearchRepository{}).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "La consulta de búsqueda es obligatoria")
+	})
+
+	t.Run("returns 500 on repository error", func(t *testing.T) {
+		repo := &MockSearchRepository{
+			SearchApplicationsFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		w := get(setup(repo), "/api/v1/search?q=golang")
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		handler := NewSearchHandler(service.NewSearchService(&MockSearchRepository{}))
+		router := setupTestRouter()
+		router.GET("/search", handler.Search)
+
+		w := get(router, "/search?q=golang")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
+
+var (
+	// ErrQueryRequired is returned when the search query is empty
+	ErrQueryRequired = &DomainError{Code: CodeQueryRequired, Message: "search query is required"}
+
+	// ErrQueryTooLong is returned when the search query exceeds MaxQueryLength
+	ErrQueryTooLong = &DomainError{Code: CodeQueryTooLong, Message: "search query is too long"}
+
+	// ErrInvalidEntityType is returned when an entity filter is not application, job or company
+	ErrInvalidEntityType = &DomainError{Code: CodeInvalidEntityType, Message: "invalid entity type"}
+
+	// ErrInvalidLimit is returned when the limit is not a number between 1 and MaxLimit
+	ErrInvalidLimit = &DomainError{Code: CodeInvalidLimit, Message: "invalid limit"}
+)
+
+// ErrorCode represents error codes
+type ErrorCode string
+
+const (
+	CodeQueryRequired     ErrorCode = "QUERY_REQUIRED"
+	CodeQueryTooLong      ErrorCode = "QUERY_TOO_LONG"
+	CodeInvalidEntityType ErrorCode = "INVALID_ENTITY_TYPE"
+	CodeInvalidLimit      ErrorCode = "INVALID_LIMIT"
+	CodeInternalError     ErrorCode = "INTERNAL_ERROR"
+)
+
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
+// GetErrorCode maps errors to error codes
+func GetErrorCode(err error) ErrorCode {
+	return domainerr.CodeOf(err, CodeInternalError)
+}
+
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
+}
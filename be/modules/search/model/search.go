@@ -0,0 +1,36 @@
+package model
+
+// Entity types that can be searched
+const (
+	EntityTypeApplication = "application"
+	EntityTypeJob         = "job"
+	EntityTypeCompany     = "company"
+)
+
+// EntityTypes lists every searchable entity type in the default search order
+var EntityTypes = []string{EntityTypeApplication, EntityTypeJob, EntityTypeCompany}
+
+// IsValidEntityType reports whether entityType can be searched
+func IsValidEntityType(entityType string) bool {
+	switch entityType {
+	case EntityTypeApplication, EntityTypeJob, EntityTypeCompany:
+		return true
+	}
+	return false
+}
+
+// Search limits
+const (
+	DefaultLimit   = 20
+	MaxLimit       = 50
+	MaxQueryLength = 200
+)
+
+// SearchResult is a single match from any searchable entity
+type SearchResult struct {
+	EntityType string  `json:"entity_type"`
+	EntityID   string  `json:"entity_id"`
+	Title      string  `json:"title"`
+	Snippet    string  `json:"snippet"`
+	Rank       float64 `json:"rank"`
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/andreypavlenko/jobber/modules/search/model"
+)
+
+// SearchRepository runs full-text searches over the user's entities.
+// Each method returns at most limit results ordered by rank descending.
+type SearchRepository interface {
+	// SearchApplications matches application names and the comments left on them
+	SearchApplications(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error)
+	// SearchJobs matches job titles and notes
+	SearchJobs(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error)
+	// SearchCompanies matches company names
+	SearchCompanies(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error)
+}
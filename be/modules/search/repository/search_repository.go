@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/andreypavlenko/jobber/modules/search/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// headlineOptions keeps snippets short and marks matched words with ** so they
+// stay plain text
+const headlineOptions = "MaxFragments=1, MaxWords=25, MinWords=8, StartSel=\"**\", StopSel=\"**\""
+
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// SearchRepository implements ports.SearchRepository.
+// The to_tsvector expressions match the GIN indexes from migration 000047.
+type SearchRepository struct {
+	pool DBPool
+}
+
+// NewSearchRepository creates a new search repository
+func NewSearchRepository(pool *pgxpool.Pool) *SearchRepository {
+	return &SearchRepository{pool: pool}
+}
+
+// NewSearchRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewSearchRepositoryWithPool(pool DBPool) *SearchRepository {
+	return &SearchRepository{pool: pool}
+}
+
+// SearchApplications matches application names and comment content. An
+// application matched several times is returned once with its best match.
+func (r *SearchRepository) SearchApplications(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error) {
+	sql := `
+		WITH q AS (SELECT plainto_tsquery('simple', $2) AS query),
+		matches AS (
+			SELECT a.id, a.name, a.name AS body, ts_rank(to_tsvector('simple', a.name), q.query) AS rank
+			FROM applications a, q
+			WHERE a.user_id = $1 AND a.deleted_at IS NULL AND to_tsvector('simple', a.name) @@ q.query
+			UNION ALL
+			SELECT a.id, a.name, cm.content AS body, ts_rank(to_tsvector('simple', cm.content), q.query) AS rank
+			FROM comments cm
+			JOIN applications a ON a.id = cm.application_id, q
+			WHERE cm.user_id = $1 AND a.user_id = $1 AND a.deleted_at IS NULL AND to_tsvector('simple', cm.content) @@ q.query
+		),
+		best AS (
+			SELECT DISTINCT ON (id) id, name, body, rank
+			FROM matches
+			ORDER BY id, rank DESC
+		)
+		SELECT best.id, best.name, ts_headline('simple', best.body, q.query, '` + headlineOptions + `'), best.rank::float8
+		FROM best, q
+		ORDER BY best.rank DESC, best.name
+		LIMIT $3
+	`
+
+	rows, err := r.pool.Query(ctx, sql, userID, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanResults(rows, model.EntityTypeApplication)
+}
+
+// SearchJobs matches job titles and notes
+func (r *SearchRepository) SearchJobs(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error) {
+	sql := `
+		WITH q AS (SELECT plainto_tsquery('simple', $2) AS query)
+		SELECT j.id, j.title,
+			ts_headline('simple', coalesce(j.title, '') || ' ' || coalesce(j.notes, ''), q.query, '` + headlineOptions + `'),
+			ts_rank(to_tsvector('simple', coalesce(j.title, '') || ' ' || coalesce(j.notes, '')), q.query)::float8 AS rank
+		FROM jobs j, q
+		WHERE j.user_id = $1 AND to_tsvector('simple', coalesce(j.title, '') || ' ' || coalesce(j.notes, '')) @@ q.query
+		ORDER BY rank DESC, j.title
+		LIMIT $3
+	`
+
+	rows, err := r.pool.Query(ctx, sql, userID, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanResults(rows, model.EntityTypeJob)
+}
+
+// SearchCompanies matches company names
+func (r *SearchRepository) SearchCompanies(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error) {
+	sql := `
+		WITH q AS (SELECT plainto_tsquery('simple', $2) AS query)
+		SELECT c.id, c.name,
+			ts_headline('simple', c.name, q.query, '` + headlineOptions + `'),
+			ts_rank(to_tsvector('simple', c.name), q.query)::float8 AS rank
+		FROM companies c, q
+		WHERE c.user_id = $1 AND to_tsvector('simple', c.name) @@ q.query
+		ORDER BY rank DESC, c.name
+		LIMIT $3
+	`
+
+	rows, err := r.pool.Query(ctx, sql, userID, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanResults(rows, model.EntityTypeCompany)
+}
+
+// scanResults reads id, title, snippet, rank rows into search results
+func scanResults(rows pgx.Rows, entityType string) ([]*model.SearchResult, error) {
+	defer rows.Close()
+
+	var results []*model.SearchResult
+	for rows.Next() {
+		result := &model.SearchResult{EntityType: entityType}
+		if err := rows.Scan(&result.EntityID, &result.Title, &result.Snippet, &result.Rank); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
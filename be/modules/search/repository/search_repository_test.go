@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/search/model"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchRepository_Search(t *testing.T) {
+	columns := []string{"id", "title", "snippet", "rank"}
+
+	tests := []struct {
+		name       string
+		query      string
+		entityType string
+		search     func(r *SearchRepository) ([]*model.SearchResult, error)
+	}{
+		{
+			name:       "applications include comments and skip the trash",
+			query:      `FROM comments cm\s+JOIN applications a ON a.id = cm.application_id, q\s+WHERE cm.user_id = \$1 AND a.user_id = \$1 AND a.deleted_at IS NULL`,
+			entityType: model.EntityTypeApplication,
+			search: func(r *SearchRepository) ([]*model.SearchResult, error) {
+				return r.SearchApplications(context.Background(), "user-1", "golang", 5)
+			},
+		},
+		{
+			name:       "jobs match title and notes",
+			query:      `FROM jobs j, q\s+WHERE j.user_id = \$1 AND to_tsvector\('simple', coalesce\(j.title, ''\) \|\| ' ' \|\| coalesce\(j.notes, ''\)\) @@ q.query`,
+			entityType: model.EntityTypeJob,
+			search: func(r *SearchRepository) ([]*model.SearchResult, error) {
+				return r.SearchJobs(context.Background(), "user-1", "golang", 5)
+			},
+		},
+		{
+			name:       "companies match name",
+			query:      `FROM companies c, q\s+WHERE c.user_id = \$1 AND to_tsvector\('simple', c.name\) @@ q.query`,
+			entityType: model.EntityTypeCompany,
+			search: func(r *SearchRepository) ([]*model.SearchResult, error) {
+				return r.SearchCompanies(context.Background(), "user-1", "golang", 5)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			mock.ExpectQuery(tt.query).
+				WithArgs("user-1", "golang", 5).
+				WillReturnRows(pgxmock.NewRows(columns).
+					AddRow("id-1", "Go developer", "**Go** developer", 0.8).
+					AddRow("id-2", "Platform", "uses **golang**", 0.4))
+			mock.ExpectQuery(tt.query).
+				WithArgs("user-1", "golang", 5).
+				WillReturnError(assert.AnError)
+
+			repo := NewSearchRepositoryWithPool(mock)
+			results, err := tt.search(repo)
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+			assert.Equal(t, tt.entityType, results[0].EntityType)
+			assert.Equal(t, "id-1", results[0].EntityID)
+			assert.Equal(t, "**Go** developer", results[0].Snippet)
+			assert.Equal(t, 0.8, results[0].Rank)
+
+			results, err = tt.search(repo)
+			assert.Nil(t, results)
+			assert.ErrorIs(t, err, assert.AnError)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
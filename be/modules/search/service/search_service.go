@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/andreypavlenko/jobber/modules/search/model"
+	"github.com/andreypavlenko/jobber/modules/search/ports"
+)
+
+// SearchService handles search business logic
+type SearchService struct {
+	repo ports.SearchRepository
+}
+
+// NewSearchService creates a new search service
+func NewSearchService(repo ports.SearchRepository) *SearchService {
+	return &SearchService{repo: repo}
+}
+
+// Search runs the query against the given entity types (all of them when
+// empty) and returns up to limit results merged by rank, best first.
+func (s *SearchService) Search(ctx context.Context, userID, query string, entityTypes []string, limit int) ([]*model.SearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, model.ErrQueryRequired
+	}
+	if utf8.RuneCountInString(query) > model.MaxQueryLength {
+		return nil, model.ErrQueryTooLong
+	}
+
+	if limit < 1 || limit > model.MaxLimit {
+		return nil, model.ErrInvalidLimit
+	}
+	entityTypes, err := normalizeEntityTypes(entityTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each entity type is fetched up to the full limit so the merge can keep the best overall
+	results := []*model.SearchResult{}
+	for _, entityType := range entityTypes {
+		var found []*model.SearchResult
+		switch entityType {
+		case model.EntityTypeApplication:
+			found, err = s.repo.SearchApplications(ctx, userID, query, limit)
+		case model.EntityTypeJob:
+			found, err = s.repo.SearchJobs(ctx, userID, query, limit)
+		case model.EntityTypeCompany:
+			found, err = s.repo.SearchCompanies(ctx, userID, query, limit)
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, found...)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Rank > results[j].Rank
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// normalizeEntityTypes validates and de-duplicates entity types, defaulting to all of them
+func normalizeEntityTypes(entityTypes []string) ([]string, error) {
+	if len(entityTypes) == 0 {
+		return model.EntityTypes, nil
+	}
+
+	seen := make(map[string]bool, len(entityTypes))
+	normalized := make([]string, 0, len(entityTypes))
+	for _, entityType := range entityTypes {
+		if !model.IsValidEntityType(entityType) {
+			return nil, model.ErrInvalidEntityType
+		}
+		if !seen[entityType] {
+			seen[entityType] = true
+			normalized = append(normalized, entityType)
+		}
+	}
+	return normalized, nil
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/search/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSearchRepository implements ports.SearchRepository
+type MockSearchRepository struct {
+	SearchApplicationsFunc func(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error)
+	SearchJobsFunc         func(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error)
+	SearchCompaniesFunc    func(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error)
+}
+
+func (m *MockSearchRepository) SearchApplications(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error) {
+	if m.SearchApplicationsFunc != nil {
+		return m.SearchApplicationsFunc(ctx, userID, query, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockSearchRepository) SearchJobs(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error) {
+	if m.SearchJobsFunc != nil {
+		return m.SearchJobsFunc(ctx, userID, query, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockSearchRepository) SearchCompanies(ctx context.Context, userID, query string, limit int) ([]*model.SearchResult, error) {
+	if m.SearchCompaniesFunc != nil {
+		return m.SearchCompaniesFunc(ctx, userID, query, limit)
+	}
+	return nil, nil
+}
+
+func result(entityType, id string, rank float64) *model.SearchResult {
+	return &model.SearchResult{EntityType: entityType, EntityID: id, Title: id, Rank: rank}
+}
+
+func TestSearchService_Search(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("merges all entity types by rank", func(t *testing.T) {
+		repo := &MockSearchRepository{
+			SearchApplicationsFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, "golang", query)
+				assert.Equal(t, 20, limit)
+				return []*model.SearchResult{result(model.EntityTypeApplication, "app-1", 0.3)}, nil
+			},
+			SearchJobsFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				return []*model.SearchResult{
+					result(model.EntityTypeJob, "job-1", 0.9),
+					result(model.EntityTypeJob, "job-2", 0.1),
+				}, nil
+			},
+			SearchCompaniesFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				return []*model.SearchResult{result(model.EntityTypeCompany, "company-1", 0.5)}, nil
+			},
+		}
+
+		svc := NewSearchService(repo)
+		results, err := svc.Search(context.Background(), userID, "  golang  ", nil, model.DefaultLimit)
+
+		require.NoError(t, err)
+		ids := make([]string, len(results))
+		for i, r := range results {
+			ids[i] = r.EntityID
+		}
+		assert.Equal(t, []string{"job-1", "company-1", "app-1", "job-2"}, ids)
+	})
+
+	t.Run("searches only the requested entity types", func(t *testing.T) {
+		repo := &MockSearchRepository{
+			SearchApplicationsFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				t.Fatal("applications were not requested")
+				return nil, nil
+			},
+			SearchJobsFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				return []*model.SearchResult{result(model.EntityTypeJob, "job-1", 0.2)}, nil
+			},
+			SearchCompaniesFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				return []*model.SearchResult{result(model.EntityTypeCompany, "company-1", 0.4)}, nil
+			},
+		}
+
+		svc := NewSearchService(repo)
+		results, err := svc.Search(context.Background(), userID, "golang", []string{"job", "company", "job"}, model.DefaultLimit)
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "company-1", results[0].EntityID)
+		assert.Equal(t, "job-1", results[1].EntityID)
+	})
+
+	t.Run("truncates the merged results to the limit", func(t *testing.T) {
+		repo := &MockSearchRepository{
+			SearchJobsFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				assert.Equal(t, 2, limit)
+				return []*model.SearchResult{result(model.EntityTypeJob, "job-1", 0.2), result(model.EntityTypeJob, "job-2", 0.1)}, nil
+			},
+			SearchCompaniesFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				return []*model.SearchResult{result(model.EntityTypeCompany, "company-1", 0.4)}, nil
+			},
+		}
+
+		svc := NewSearchService(repo)
+		results, err := svc.Search(context.Background(), userID, "golang", nil, 2)
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "company-1", results[0].EntityID)
+		assert.Equal(t, "job-1", results[1].EntityID)
+	})
+
+	t.Run("returns an empty list when nothing matches", func(t *testing.T) {
+		svc := NewSearchService(&MockSearchRepository{})
+		results, err := svc.Search(context.Background(), userID, "golang", nil, model.DefaultLimit)
+
+		require.NoError(t, err)
+		assert.NotNil(t, results)
+		assert.Empty(t, results)
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			query       string
+			entityTypes []string
+			limit       int
+			expectErr   error
+		}{
+			{name: "blank query", query: "   ", limit: model.DefaultLimit, expectErr: model.ErrQueryRequired},
+			{name: "query too long", query: strings.Repeat("a", model.MaxQueryLength+1), limit: model.DefaultLimit, expectErr: model.ErrQueryTooLong},
+			{name: "unknown entity type", query: "golang", entityTypes: []string{"job", "resume"}, limit: model.DefaultLimit, expectErr: model.ErrInvalidEntityType},
+			{name: "zero limit", query: "golang", limit: 0, expectErr: model.ErrInvalidLimit},
+			{name: "limit too high", query: "golang", limit: model.MaxLimit + 1, expectErr: model.ErrInvalidLimit},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				svc := NewSearchService(&MockSearchRepository{})
+				results, err := svc.Search(context.Background(), userID, tt.query, tt.entityTypes, tt.limit)
+
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, results)
+			})
+		}
+	})
+
+	t.Run("returns repository error", func(t *testing.T) {
+		repo := &MockSearchRepository{
+			SearchJobsFunc: func(ctx context.Context, uid, query string, limit int) ([]*model.SearchResult, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := NewSearchService(repo)
+		results, err := svc.Search(context.Background(), userID, "golang", nil, model.DefaultLimit)
+
+		assert.Error(t, err)
+		assert.Nil(t, results)
+	})
+}
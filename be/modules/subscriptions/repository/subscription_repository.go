@@ -6,12 +6,20 @@ import (
 
 	"github.com/andreypavlenko/jobber/modules/subscriptions/model"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
 // SubscriptionRepository implements ports.SubscriptionRepository with PostgreSQL.
 type SubscriptionRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 // NewSubscriptionRepository creates a new SubscriptionRepository.
@@ -19,6 +27,11 @@ func NewSubscriptionRepository(pool *pgxpool.Pool) *SubscriptionRepository {
 	return &SubscriptionRepository{pool: pool}
 }
 
+// NewSubscriptionRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewSubscriptionRepositoryWithPool(pool DBPool) *SubscriptionRepository {
+	return &SubscriptionRepository{pool: pool}
+}
+
 // GetByUserID retrieves a subscription by user ID.
 func (r *SubscriptionRepository) GetByUserID(ctx context.Context, userID string) (*model.Subscription, error) {
 	query := `
@@ -115,11 +128,12 @@ func (r *SubscriptionRepository) CountUserResumes(ctx context.Context, userID st
 }
 
 // CountUserApplications counts non-archived applications for a user.
-// Archived applications are excluded to match the jobs counting pattern.
+// Archived applications are excluded to match the jobs counting pattern,
+// and applications in the trash do not count until they are restored.
 func (r *SubscriptionRepository) CountUserApplications(ctx context.Context, userID string) (int, error) {
 	var count int
 	err := r.pool.QueryRow(ctx,
-		`SELECT COUNT(*) FROM applications WHERE user_id = $1 AND status != 'archived'`, userID,
+		`SELECT COUNT(*) FROM applications WHERE user_id = $1 AND status != 'archived' AND deleted_at IS NULL`, userID,
 	).Scan(&count)
 	return count, err
 }
@@ -188,7 +202,7 @@ func (r *SubscriptionRepository) GetAllCounts(ctx context.Context, userID string
 		SELECT
 			(SELECT COUNT(*) FROM jobs WHERE user_id = $1 AND status = 'active'),
 			(SELECT COUNT(*) FROM resumes WHERE user_id = $1),
-			(SELECT COUNT(*) FROM applications WHERE user_id = $1 AND status != 'archived'),
+			(SELECT COUNT(*) FROM applications WHERE user_id = $1 AND status != 'archived' AND deleted_at IS NULL),
 			(SELECT COUNT(*) FROM ai_usage WHERE user_id = $1 AND usage_type = 'match_score' AND created_at >= date_trunc('month', NOW())),
 			(SELECT COUNT(*) FROM ai_usage WHERE user_id = $1 AND usage_type = 'job_parse' AND created_at >= date_trunc('month', NOW())),
 			(SELECT COUNT(*) FROM resume_builders WHERE user_id = $1),
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/subscriptions/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var subscriptionColumns = []string{"id", "user_id", "paddle_subscription_id", "paddle_customer_id", "status", "plan", "current_period_start", "current_period_end", "cancel_at", "created_at", "updated_at"}
+
+func TestSubscriptionRepository_Get(t *testing.T) {
+	lookups := []struct {
+		name  string
+		where string
+		get   func(r *SubscriptionRepository) (*model.Subscription, error)
+	}{
+		{
+			name:  "by user id",
+			where: "WHERE user_id = \\$1",
+			get: func(r *SubscriptionRepository) (*model.Subscription, error) {
+				return r.GetByUserID(context.Background(), "key-1")
+			},
+		},
+		{
+			name:  "by paddle subscription id",
+			where: "WHERE paddle_subscription_id = \\$1",
+			get: func(r *SubscriptionRepository) (*model.Subscription, error) {
+				return r.GetByPaddleSubscriptionID(context.Background(), "key-1")
+			},
+		},
+	}
+
+	for _, lk := range lookups {
+		t.Run(lk.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			now := time.Now()
+			paddleID := "sub_123"
+			mock.ExpectQuery("FROM subscriptions\\s+" + lk.where).
+				WithArgs("key-1").
+				WillReturnRows(pgxmock.NewRows(subscriptionColumns).
+					AddRow("sub-1", "user-1", &paddleID, nil, "active", "pro", &now, &now, nil, now, now))
+			mock.ExpectQuery("FROM subscriptions").
+				WithArgs("key-1").
+				WillReturnError(pgx.ErrNoRows)
+			mock.ExpectQuery("FROM subscriptions").
+				WithArgs("key-1").
+				WillReturnError(assert.AnError)
+
+			repo := NewSubscriptionRepositoryWithPool(mock)
+			sub, err := lk.get(repo)
+			require.NoError(t, err)
+			assert.Equal(t, "pro", sub.Plan)
+			require.NotNil(t, sub.PaddleSubscriptionID)
+			assert.Equal(t, paddleID, *sub.PaddleSubscriptionID)
+			assert.Nil(t, sub.CancelAt)
+
+			sub, err = lk.get(repo)
+			assert.Nil(t, sub)
+			assert.ErrorIs(t, err, model.ErrSubscriptionNotFound)
+
+			_, err = lk.get(repo)
+			assert.ErrorIs(t, err, assert.AnError)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSubscriptionRepository_Upsert(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`INSERT INTO subscriptions .*ON CONFLICT \(user_id\) DO UPDATE SET.*RETURNING id, created_at, updated_at`).
+		WithArgs("user-1", (*string)(nil), (*string)(nil), "free", "free", (*time.Time)(nil), (*time.Time)(nil), (*time.Time)(nil)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow("sub-1", now, now))
+
+	sub := &model.Subscription{UserID: "user-1", Status: "free", Plan: "free"}
+	err = NewSubscriptionRepositoryWithPool(mock).Upsert(context.Background(), sub)
+
+	require.NoError(t, err)
+	assert.Equal(t, "sub-1", sub.ID)
+	assert.Equal(t, now, sub.UpdatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubscriptionRepository_Counts(t *testing.T) {
+	counts := []struct {
+		name  string
+		query string
+		count func(r *SubscriptionRepository) (int, error)
+	}{
+		{
+			name:  "active jobs",
+			query: `SELECT COUNT\(\*\) FROM jobs WHERE user_id = \$1 AND status = 'active'`,
+			count: func(r *SubscriptionRepository) (int, error) { return r.CountUserJobs(context.Background(), "user-1") },
+		},
+		{
+			name:  "resumes",
+			query: `SELECT COUNT\(\*\) FROM resumes WHERE user_id = \$1`,
+			count: func(r *SubscriptionRepository) (int, error) {
+				return r.CountUserResumes(context.Background(), "user-1")
+			},
+		},
+		{
+			name:  "applications outside the archive and trash",
+			query: `SELECT COUNT\(\*\) FROM applications WHERE user_id = \$1 AND status != 'archived' AND deleted_at IS NULL`,
+			count: func(r *SubscriptionRepository) (int, error) {
+				return r.CountUserApplications(context.Background(), "user-1")
+			},
+		},
+		{
+			name:  "match score requests this month",
+			query: `FROM ai_usage\s+WHERE user_id = \$1\s+AND usage_type = 'match_score'\s+AND created_at >= date_trunc\('month', NOW\(\)\)`,
+			count: func(r *SubscriptionRepository) (int, error) {
+				return r.CountUserAIRequestsThisMonth(context.Background(), "user-1")
+			},
+		},
+		{
+			name:  "job parses this month",
+			query: `FROM ai_usage\s+WHERE user_id = \$1\s+AND usage_type = 'job_parse'\s+AND created_at >= date_trunc\('month', NOW\(\)\)`,
+			count: func(r *SubscriptionRepository) (int, error) {
+				return r.CountUserJobParsesThisMonth(context.Background(), "user-1")
+			},
+		},
+		{
+			name:  "resume builders",
+			query: `SELECT COUNT\(\*\) FROM resume_builders WHERE user_id = \$1`,
+			count: func(r *SubscriptionRepository) (int, error) {
+				return r.CountUserResumeBuilders(context.Background(), "user-1")
+			},
+		},
+		{
+			name:  "cover letters",
+			query: `SELECT COUNT\(\*\) FROM cover_letters WHERE user_id = \$1`,
+			count: func(r *SubscriptionRepository) (int, error) {
+				return r.CountUserCoverLetters(context.Background(), "user-1")
+			},
+		},
+	}
+
+	for _, tc := range counts {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			mock.ExpectQuery(tc.query).
+				WithArgs("user-1").
+				WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(4))
+
+			n, err := tc.count(NewSubscriptionRepositoryWithPool(mock))
+
+			require.NoError(t, err)
+			assert.Equal(t, 4, n)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSubscriptionRepository_GetAllCounts(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`FROM applications WHERE user_id = \$1 AND status != 'archived' AND deleted_at IS NULL`).
+		WithArgs("user-1").
+		WillReturnRows(pgxmock.NewRows([]string{"jobs", "resumes", "apps", "ai", "parses", "builders", "letters"}).
+			AddRow(1, 2, 3, 4, 5, 6, 7))
+
+	jobs, resumes, apps, aiReqs, jobParses, builders, letters, err := NewSubscriptionRepositoryWithPool(mock).GetAllCounts(context.Background(), "user-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, []int{jobs, resumes, apps, aiReqs, jobParses, builders, letters})
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubscriptionRepository_RecordUsage(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec(`INSERT INTO ai_usage \(user_id, usage_type\) VALUES \(\$1, 'match_score'\)`).
+		WithArgs("user-1").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(`INSERT INTO ai_usage \(user_id, usage_type\) VALUES \(\$1, 'job_parse'\)`).
+		WithArgs("user-1").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	repo := NewSubscriptionRepositoryWithPool(mock)
+	require.NoError(t, repo.RecordAIUsage(context.Background(), "user-1"))
+	require.NoError(t, repo.RecordJobParseUsage(context.Background(), "user-1"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubscriptionRepository_WebhookEvents(t *testing.T) {
+	t.Run("exists", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM webhook_events WHERE event_id = \$1\)`).
+			WithArgs("evt-1").
+			WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+
+		exists, err := NewSubscriptionRepositoryWithPool(mock).WebhookEventExists(context.Background(), "evt-1")
+
+		require.NoError(t, err)
+		assert.True(t, exists)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("record", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`INSERT INTO webhook_events .*ON CONFLICT \(event_id\) DO NOTHING`).
+			WithArgs("evt-1", "subscription.created").
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err = NewSubscriptionRepositoryWithPool(mock).RecordWebhookEvent(context.Background(), "evt-1", "subscription.created")
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("claim reports whether this caller inserted the event", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec("INSERT INTO webhook_events").
+			WithArgs("evt-1", "subscription.created").
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec("INSERT INTO webhook_events").
+			WithArgs("evt-1", "subscription.created").
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+		mock.ExpectExec("INSERT INTO webhook_events").
+			WithArgs("evt-1", "subscription.created").
+			WillReturnError(assert.AnError)
+
+		repo := NewSubscriptionRepositoryWithPool(mock)
+		claimed, err := repo.TryClaimWebhookEvent(context.Background(), "evt-1", "subscription.created")
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = repo.TryClaimWebhookEvent(context.Background(), "evt-1", "subscription.created")
+		require.NoError(t, err)
+		assert.False(t, claimed)
+
+		claimed, err = repo.TryClaimWebhookEvent(context.Background(), "evt-1", "subscription.created")
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.False(t, claimed)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
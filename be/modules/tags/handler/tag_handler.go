@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andreypavlenko/jobber/internal/platform/auth"
+	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/modules/tags/model"
+	"github.com/andreypavlenko/jobber/modules/tags/service"
+	"github.com/gin-gonic/gin"
+)
+
+// TagHandler handles tag HTTP requests
+type TagHandler struct {
+	service *service.TagService
+}
+
+// NewTagHandler creates a new tag handler
+func NewTagHandler(service *service.TagService) *TagHandler {
+	return &TagHandler{service: service}
+}
+
+// Create godoc
+// @Summary Create a new tag
+// @Description Create a tag for the authenticated user. The color, when given, must be a 6-digit hex color such as #3B82F6.
+// @Tags tags
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body model.CreateTagRequest true "Tag details"
+// @Success 201 {object} model.TagDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid payload, name or color"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 409 {object} httpPlatform.ErrorResponse "Tag name already in use"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /tags [post]
+func (h *TagHandler) Create(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	var req model.CreateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	tag, err := h.service.Create(c.Request.Context(), userID, &req)
+	if err != nil {
+		respondWithTagError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusCreated, tag)
+}
+
+// List godoc
+// @Summary List tags
+// @Description Get all tags of the authenticated user ordered by name
+// @Tags tags
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} []model.TagDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /tags [get]
+func (h *TagHandler) List(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	tags, err := h.service.List(c.Request.Context(), userID)
+	if err != nil {
+		respondWithTagError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, tags)
+}
+
+// Update godoc
+// @Summary Update a tag
+// @Description Change the name or color of a tag. An empty color clears it.
+// @Tags tags
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Tag ID"
+// @Param request body model.UpdateTagRequest true "Fields to update"
+// @Success 200 {object} model.TagDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid payload, name or color"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Tag not found"
+// @Failure 409 {object} httpPlatform.ErrorResponse "Tag name already in use"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /tags/{id} [patch]
+func (h *TagHandler) Update(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	var req model.UpdateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	tag, err := h.service.Update(c.Request.Context(), userID, c.Param("id"), &req)
+	if err != nil {
+		respondWithTagError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, tag)
+}
+
+// Delete godoc
+// @Summary Delete a tag
+// @Description Delete a tag and detach it from every application, job and company
+// @Tags tags
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Tag ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Tag not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /tags/{id} [delete]
+func (h *TagHandler) Delete(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), userID, c.Param("id")); err != nil {
+		respondWithTagError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Tag deleted successfully"})
+}
+
+// Attach godoc
+// @Summary Attach a tag to an entity
+// @Description Attach a tag to one of the user's applications, jobs or companies
+// @Tags tags
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Tag ID"
+// @Param request body model.AttachTagRequest true "Entity to attach the tag to"
+// @Success 201 {object} model.TagRelationDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid payload or entity type"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Tag or entity not found"
+// @Failure 409 {object} httpPlatform.ErrorResponse "Tag already attached"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /tags/{id}/attach [post]
+func (h *TagHandler) Attach(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	var req model.AttachTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	rel, err := h.service.Attach(c.Request.Context(), userID, c.Param("id"), &req)
+	if err != nil {
+		respondWithTagError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusCreated, rel)
+}
+
+// Detach godoc
+// @Summary Detach a tag from an entity
+// @Description Remove a tag from one of the user's applications, jobs or companies
+// @Tags tags
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Tag ID"
+// @Param request body model.AttachTagRequest true "Entity to detach the tag from"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid payload or entity type"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Tag, entity or relation not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /tags/{id}/attach [delete]
+func (h *TagHandler) Detach(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+	var req model.AttachTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	if err := h.service.Detach(c.Request.Context(), userID, c.Param("id"), &req); err != nil {
+		respondWithTagError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Tag detached successfully"})
+}
+
+// respondWithTagError maps tag errors to HTTP responses
+func respondWithTagError(c *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	switch model.GetErrorCode(err) {
+	case model.CodeTagNotFound, model.CodeEntityNotFound, model.CodeTagRelationNotFound:
+		statusCode = http.StatusNotFound
+	case model.CodeTagNameRequired, model.CodeInvalidTagColor, model.CodeInvalidEntityType:
+		statusCode = http.StatusBadRequest
+	case model.CodeTagNameTaken, model.CodeConflict:
+		statusCode = http.StatusConflict
+	}
+	httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+}
+
+func (h *TagHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	tags := router.Group("/tags")
+	tags.Use(authMiddleware)
+	{
+		tags.POST("", h.Create)
+		tags.GET("", h.List)
+		tags.PATCH("/:id", h.Update)
+		tags.DELETE("/:id", h.Delete)
+		tags.POST("/:id/attach", h.Attach)
+		tags.DELETE("/:id/attach", h.Detach)
+	}
+}
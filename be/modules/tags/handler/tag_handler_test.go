@@ -0,0 +1,433 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/tags/model"
+	"github.com/andreypavlenko/jobber/modules/tags/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTagRepository implements ports.TagRepository
+type MockTagRepository struct {
+	CreateFunc  func(ctx context.Context, tag *model.Tag) error
+	GetByIDFunc func(ctx context.Context, userID, tagID string) (*model.Tag, error)
+	ListFunc    func(ctx context.Context, userID string) ([]*model.Tag, error)
+	UpdateFunc  func(ctx context.Context, tag *model.Tag) error
+	DeleteFunc  func(ctx context.Context, userID, tagID string) error
+
+	AddRelationFunc    func(ctx context.Context, rel *model.TagRelation) error
+	RemoveRelationFunc func(ctx context.Context, tagID, entityType, entityID string) error
+	EntityExistsFunc   func(ctx context.Context, userID, entityType, entityID string) (bool, error)
+}
+
+func (m *MockTagRepository) Create(ctx context.Context, tag *model.Tag) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, tag)
+	}
+	return nil
+}
+
+func (m *MockTagRepository) GetByID(ctx context.Context, userID, tagID string) (*model.Tag, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, userID, tagID)
+	}
+	return nil, model.ErrTagNotFound
+}
+
+func (m *MockTagRepository) List(ctx context.Context, userID string) ([]*model.Tag, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockTagRepository) Update(ctx context.Context, tag *model.Tag) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, tag)
+	}
+	return nil
+}
+
+func (m *MockTagRepository) Delete(ctx context.Context, userID, tagID string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID, tagID)
+	}
+	return nil
+}
+
+func (m *MockTagRepository) AddRelation(ctx context.Context, rel *model.TagRelation) error {
+	if m.AddRelationFunc != nil {
+		return m.AddRelationFunc(ctx, rel)
+	}
+	return nil
+}
+
+func (m *MockTagRepository) RemoveRelation(ctx context.Context, tagID, entityType, entityID string) error {
+	if m.RemoveRelationFunc != nil {
+		return m.RemoveRelationFunc(ctx, tagID, entityType, entityID)
+	}
+	return nil
+}
+
+func (m *MockTagRepository) EntityExists(ctx context.Context, userID, entityType, entityID string) (bool, error) {
+	if m.EntityExistsFunc != nil {
+		return m.EntityExistsFunc(ctx, userID, entityType, entityID)
+	}
+	return false, nil
+}
+
+func (m *MockTagRepository) ListByEntity(ctx context.Context, entityType, entityID string) ([]*model.Tag, error) {
+	return nil, nil
+}
+
+func (m *MockTagRepository) ListOwnedIDs(ctx context.Context, userID string, tagIDs []string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockTagRepository) AddRelations(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockTagRepository) RemoveRelations(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+	return 0, nil
+}
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+func mockAuthMiddleware(userID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	}
+}
+
+func sendJSON(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestTagHandler_Create(t *testing.T) {
+	userID := "user-123"
+
+	setup := func(repo *MockTagRepository) *gin.Engine {
+		handler := NewTagHandler(service.NewTagService(repo))
+		router := setupTestRouter()
+		router.POST("/tags", mockAuthMiddleware(userID), handler.Create)
+		return router
+	}
+
+	t.Run("creates tag", func(t *testing.T) {
+		repo := &MockTagRepository{
+			CreateFunc: func(ctx context.Context, tag *model.Tag) error {
+				assert.Equal(t, userID, tag.UserID)
+				tag.ID = "tag-1"
+				return nil
+			},
+		}
+
+		w := sendJSON(setup(repo), http.MethodPost, "/tags", `{"name":"Remote","color":"#3B82F6"}`)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response model.TagDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "tag-1", response.ID)
+		assert.Equal(t, "Remote", response.Name)
+		assert.Equal(t, "#3B82F6", *response.Color)
+	})
+
+	for _, color := range []string{"#ZZZ", "blue"} {
+		t.Run("returns 400 for color "+color, func(t *testing.T) {
+			w := sendJSON(setup(&MockTagRepository{}), http.MethodPost, "/tags", `{"name":"Remote","color":"`+color+`"}`)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), string(model.CodeInvalidTagColor))
+		})
+	}
+
+	t.Run("returns 400 for missing name", func(t *testing.T) {
+		w := sendJSON(setup(&MockTagRepository{}), http.MethodPost, "/tags", `{"color":"#3B82F6"}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("returns 409 for duplicate name", func(t *testing.T) {
+		repo := &MockTagRepository{
+			CreateFunc: func(ctx context.Context, tag *model.Tag) error {
+				return model.ErrTagNameTaken
+			},
+		}
+
+		w := sendJSON(setup(repo), http.MethodPost, "/tags", `{"name":"Remote"}`)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeTagNameTaken))
+	})
+
+	t.Run("translates the error message to the request locale", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/tags", bytes.NewBufferString(`{"name":"Remote","color":"blue"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept-Language", "es")
+		w := httptest.NewRecorder()
+		setup(&MockTagRepository{}).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "El color debe ser un color hexadecimal")
+	})
+}
+
+func TestTagHandler_List(t *testing.T) {
+	t.Run("lists tags", func(t *testing.T) {
+		repo := &MockTagRepository{
+			ListFunc: func(ctx context.Context, uid string) ([]*model.Tag, error) {
+				assert.Equal(t, "user-123", uid)
+				return []*model.Tag{{ID: "tag-1", Name: "Remote"}}, nil
+			},
+		}
+		handler := NewTagHandler(service.NewTagService(repo))
+		router := setupTestRouter()
+		router.GET("/tags", mockAuthMiddleware("user-123"), handler.List)
+
+		w := sendJSON(router, http.MethodGet, "/tags", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response []model.TagDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 1)
+		assert.Equal(t, "Remote", response[0].Name)
+	})
+
+	t.Run("returns 500 on repository error", func(t *testing.T) {
+		repo := &MockTagRepository{
+			ListFunc: func(ctx context.Context, uid string) ([]*model.Tag, error) {
+				return nil, errors.New("database error")
+			},
+		}
+		handler := NewTagHandler(service.NewTagService(repo))
+		router := setupTestRouter()
+		router.GET("/tags", mockAuthMiddleware("user-123"), handler.List)
+
+		w := sendJSON(router, http.MethodGet, "/tags", "")
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestTagHandler_Update(t *testing.T) {
+	userID := "user-123"
+
+	setup := func(repo *MockTagRepository) *gin.Engine {
+		handler := NewTagHandler(service.NewTagService(repo))
+		router := setupTestRouter()
+		router.PATCH("/tags/:id", mockAuthMiddleware(userID), handler.Update)
+		return router
+	}
+
+	t.Run("updates tag", func(t *testing.T) {
+		repo := &MockTagRepository{
+			GetByIDFunc: func(ctx context.Context, uid, tid string) (*model.Tag, error) {
+				return &model.Tag{ID: tid, UserID: uid, Name: "Remote"}, nil
+			},
+		}
+
+		w := sendJSON(setup(repo), http.MethodPatch, "/tags/tag-1", `{"name":"Hybrid","color":"#10B981"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response model.TagDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "Hybrid", response.Name)
+		assert.Equal(t, "#10B981", *response.Color)
+	})
+
+	t.Run("returns 404 for unknown tag", func(t *testing.T) {
+		w := sendJSON(setup(&MockTagRepository{}), http.MethodPatch, "/tags/missing", `{"name":"Hybrid"}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeTagNotFound))
+	})
+
+	t.Run("returns 400 for invalid color", func(t *testing.T) {
+		repo := &MockTagRepository{
+			GetByIDFunc: func(ctx context.Context, uid, tid string) (*model.Tag, error) {
+				return &model.Tag{ID: tid, UserID: uid, Name: "Remote"}, nil
+			},
+		}
+
+		w := sendJSON(setup(repo), http.MethodPatch, "/tags/tag-1", `{"color":"blue"}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeInvalidTagColor))
+	})
+
+	t.Run("returns 400 for invalid payload", func(t *testing.T) {
+		w := sendJSON(setup(&MockTagRepository{}), http.MethodPatch, "/tags/tag-1", `{"name":""}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTagHandler_Delete(t *testing.T) {
+	setup := func(repo *MockTagRepository) *gin.Engine {
+		handler := NewTagHandler(service.NewTagService(repo))
+		router := setupTestRouter()
+		router.DELETE("/tags/:id", mockAuthMiddleware("user-123"), handler.Delete)
+		return router
+	}
+
+	t.Run("deletes tag", func(t *testing.T) {
+		w := sendJSON(setup(&MockTagRepository{}), http.MethodDelete, "/tags/tag-1", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("returns 404 for unknown tag", func(t *testing.T) {
+		repo := &MockTagRepository{
+			DeleteFunc: func(ctx context.Context, uid, tid string) error {
+				return model.ErrTagNotFound
+			},
+		}
+
+		w := sendJSON(setup(repo), http.MethodDelete, "/tags/missing", "")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestTagHandler_Attach(t *testing.T) {
+	entityID := "11111111-1111-1111-1111-111111111111"
+
+	setup := func(repo *MockTagRepository) *gin.Engine {
+		if repo.GetByIDFunc == nil {
+			repo.GetByIDFunc = func(ctx context.Context, uid, tid string) (*model.Tag, error) {
+				return &model.Tag{ID: tid, UserID: uid}, nil
+			}
+		}
+		if repo.EntityExistsFunc == nil {
+			repo.EntityExistsFunc = func(ctx context.Context, uid, entityType, eid string) (bool, error) {
+				return true, nil
+			}
+		}
+		handler := NewTagHandler(service.NewTagService(repo))
+		router := setupTestRouter()
+		router.POST("/tags/:id/attach", mockAuthMiddleware("user-123"), handler.Attach)
+		router.DELETE("/tags/:id/attach", mockAuthMiddleware("user-123"), handler.Detach)
+		return router
+	}
+
+	t.Run("attaches tag", func(t *testing.T) {
+		w := sendJSON(setup(&MockTagRepository{}), http.MethodPost, "/tags/tag-1/attach", `{"entity_type":"application","entity_id":"`+entityID+`"}`)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response model.TagRelationDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "tag-1", response.TagID)
+		assert.Equal(t, "application", response.EntityType)
+		assert.Equal(t, entityID, response.EntityID)
+	})
+
+	t.Run("returns 400 for unknown entity type", func(t *testing.T) {
+		w := sendJSON(setup(&MockTagRepository{}), http.MethodPost, "/tags/tag-1/attach", `{"entity_type":"resume","entity_id":"`+entityID+`"}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeInvalidEntityType))
+	})
+
+	t.Run("returns 400 for invalid payload", func(t *testing.T) {
+		w := sendJSON(setup(&MockTagRepository{}), http.MethodPost, "/tags/tag-1/attach", `{"entity_type":"job","entity_id":"not-a-uuid"}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("returns 404 for another user's entity", func(t *testing.T) {
+		repo := &MockTagRepository{
+			EntityExistsFunc: func(ctx context.Context, uid, entityType, eid string) (bool, error) {
+				return false, nil
+			},
+		}
+
+		w := sendJSON(setup(repo), http.MethodPost, "/tags/tag-1/attach", `{"entity_type":"job","entity_id":"`+entityID+`"}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeEntityNotFound))
+	})
+
+	t.Run("returns 409 for duplicate relation", func(t *testing.T) {
+		repo := &MockTagRepository{
+			AddRelationFunc: func(ctx context.Context, rel *model.TagRelation) error {
+				return model.ErrTagAlreadyAttached
+			},
+		}
+
+		w := sendJSON(setup(repo), http.MethodPost, "/tags/tag-1/attach", `{"entity_type":"company","entity_id":"`+entityID+`"}`)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeConflict))
+	})
+
+	t.Run("detaches tag", func(t *testing.T) {
+		w := sendJSON(setup(&MockTagRepository{}), http.MethodDelete, "/tags/tag-1/attach", `{"entity_type":"job","entity_id":"`+entityID+`"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("returns 404 when detaching an unattached tag", func(t *testing.T) {
+		repo := &MockTagRepository{
+			RemoveRelationFunc: func(ctx context.Context, tid, entityType, eid string) error {
+				return model.ErrTagRelationNotFound
+			},
+		}
+
+		w := sendJSON(setup(repo), http.MethodDelete, "/tags/tag-1/attach", `{"entity_type":"job","entity_id":"`+entityID+`"}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeTagRelationNotFound))
+	})
+}
+
+func TestTagHandler_RegisterRoutes(t *testing.T) {
+	repo := &MockTagRepository{
+		GetByIDFunc: func(ctx context.Context, uid, tid string) (*model.Tag, error) {
+			return &model.Tag{ID: tid, UserID: uid, Name: "Remote"}, nil
+		},
+	}
+	handler := NewTagHandler(service.NewTagService(repo))
+
+	router := setupTestRouter()
+	v1 := router.Group("/api/v1")
+	handler.RegisterRoutes(v1, mockAuthMiddleware("user-123"))
+
+	routes := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{http.MethodPost, "/api/v1/tags", `{"name":"Remote"}`},
+		{http.MethodGet, "/api/v1/tags", ""},
+		{http.MethodPatch, "/api/v1/tags/tag-1", `{"name":"Hybrid"}`},
+		{http.MethodDelete, "/api/v1/tags/tag-1", ""},
+		{http.MethodPost, "/api/v1/tags/tag-1/attach", `{}`},
+		{http.MethodDelete, "/api/v1/tags/tag-1/attach", `{}`},
+	}
+
+	for _, route := range routes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			w := sendJSON(router, route.method, route.path, route.body)
+
+			assert.NotEqual(t, http.StatusNotFound, w.Code, "Route %s %s should be registered", route.method, route.path)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
+
+var (
+	// ErrTagNotFound is returned when a tag is not found
+	ErrTagNotFound = &DomainError{Code: CodeTagNotFound, Message: "tag not found"}
+
+	// ErrTagNameRequired is returned when a tag name is empty
+	ErrTagNameRequired = &DomainError{Code: CodeTagNameRequired, Message: "tag name is required"}
+
+	// ErrInvalidTagColor is returned when a tag color is not a 6-digit hex color
+	ErrInvalidTagColor = &DomainError{Code: CodeInvalidTagColor, Message: "tag color must be a 6-digit hex color such as #3B82F6"}
+
+	// ErrTagNameTaken is returned when the user already has a tag with the same name
+	ErrTagNameTaken = &DomainError{Code: CodeTagNameTaken, Message: "a tag with this name already exists"}
+
+	// ErrInvalidEntityType is returned when a tag is attached to something other than an application, job or company
+	ErrInvalidEntityType = &DomainError{Code: CodeInvalidEntityType, Message: "entity type must be application, job or company"}
+
+	// ErrEntityNotFound is returned when the entity a tag is attached to is not found
+	ErrEntityNotFound = &DomainError{Code: CodeEntityNotFound, Message: "entity not found"}
+
+	// ErrTagAlreadyAttached is returned when a tag is already attached to the entity
+	ErrTagAlreadyAttached = &DomainError{Code: CodeConflict, Message: "tag is already attached to this entity"}
+
+	// ErrTagRelationNotFound is returned when a tag is not attached to the entity
+	ErrTagRelationNotFound = &DomainError{Code: CodeTagRelationNotFound, Message: "tag is not attached to this entity"}
+)
+
+// ErrorCode represents error codes
+type ErrorCode string
+
+const (
+	CodeTagNotFound         ErrorCode = "TAG_NOT_FOUND"
+	CodeTagNameRequired     ErrorCode = "TAG_NAME_REQUIRED"
+	CodeInvalidTagColor     ErrorCode = "INVALID_TAG_COLOR"
+	CodeTagNameTaken        ErrorCode = "TAG_NAME_TAKEN"
+	CodeInvalidEntityType   ErrorCode = "INVALID_ENTITY_TYPE"
+	CodeEntityNotFound      ErrorCode = "ENTITY_NOT_FOUND"
+	CodeConflict            ErrorCode = "CONFLICT"
+	CodeTagRelationNotFound ErrorCode = "TAG_RELATION_NOT_FOUND"
+	CodeInternalError       ErrorCode = "INTERNAL_ERROR"
+)
+
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
+// GetErrorCode maps errors to error codes
+func GetErrorCode(err error) ErrorCode {
+	return domainerr.CodeOf(err, CodeInternalError)
+}
+
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
+}
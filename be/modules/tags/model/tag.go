@@ -1,7 +1,6 @@
 package model
 
 import (
-	"errors"
 	"time"
 )
 
@@ -14,10 +13,10 @@ type Tag struct {
 }
 
 type TagDTO struct {
-	ID        string     `json:"id"`
-	Name      string     `json:"name"`
-	Color     *string    `json:"color,omitempty"`
-	CreatedAt time.Time  `json:"created_at"`
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Color     *string   `json:"color,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 func (t *Tag) ToDTO() *TagDTO {
@@ -34,23 +33,62 @@ type CreateTagRequest struct {
 	Color *string `json:"color,omitempty"`
 }
 
-type TagRelation struct {
-	ID         string
-	TagID      string
-	EntityType string
-	EntityID   string
-	CreatedAt  time.Time
+// UpdateTagRequest changes the name or color of a tag; an empty color clears it
+type UpdateTagRequest struct {
+	Name  *string `json:"name,omitempty" binding:"omitempty,min=1,max=100"`
+	Color *string `json:"color,omitempty"`
 }
 
-var (
-	ErrTagNotFound    = errors.New("tag not found")
-	ErrTagNameRequired = errors.New("tag name is required")
+// Entity types a tag can be attached to
+const (
+	EntityTypeApplication = "application"
+	EntityTypeJob         = "job"
+	EntityTypeCompany     = "company"
 )
 
-type ErrorCode string
+// IsValidEntityType reports whether a tag can be attached to entityType
+func IsValidEntityType(entityType string) bool {
+	switch entityType {
+	case EntityTypeApplication, EntityTypeJob, EntityTypeCompany:
+		return true
+	}
+	return false
+}
 
-const (
-	CodeTagNotFound    ErrorCode = "TAG_NOT_FOUND"
-	CodeTagNameRequired ErrorCode = "TAG_NAME_REQUIRED"
-	CodeInternalError  ErrorCode = "INTERNAL_ERROR"
-)
+// AttachTagRequest identifies the entity a tag is attached to or detached from
+type AttachTagRequest struct {
+	EntityType string `json:"entity_type" binding:"required"`
+	EntityID   string `json:"entity_id" binding:"required,uuid"`
+}
+
+type TagRelation struct {
+	ID            string
+	TagID         string
+	EntityType    string
+	EntityID      string
+	AddedByUserID *string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type TagRelationDTO struct {
+	ID            string    `json:"id"`
+	TagID         string    `json:"tag_id"`
+	EntityType    string    `json:"entity_type"`
+	EntityID      string    `json:"entity_id"`
+	AddedByUserID *string   `json:"added_by_user_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func (r *TagRelation) ToDTO() *TagRelationDTO {
+	return &TagRelationDTO{
+		ID:            r.ID,
+		TagID:         r.TagID,
+		EntityType:    r.EntityType,
+		EntityID:      r.EntityID,
+		AddedByUserID: r.AddedByUserID,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
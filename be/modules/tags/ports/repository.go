@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/andreypavlenko/jobber/modules/tags/model"
+)
+
+type TagRepository interface {
+	Create(ctx context.Context, tag *model.Tag) error
+	GetByID(ctx context.Context, userID, tagID string) (*model.Tag, error)
+	List(ctx context.Context, userID string) ([]*model.Tag, error)
+	Update(ctx context.Context, tag *model.Tag) error
+	Delete(ctx context.Context, userID, tagID string) error
+	AddRelation(ctx context.Context, rel *model.TagRelation) error
+	RemoveRelation(ctx context.Context, tagID, entityType, entityID string) error
+	EntityExists(ctx context.Context, userID, entityType, entityID string) (bool, error)
+	ListByEntity(ctx context.Context, entityType, entityID string) ([]*model.Tag, error)
+	ListOwnedIDs(ctx context.Context, userID string, tagIDs []string) ([]string, error)
+	AddRelations(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error)
+	RemoveRelations(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error)
+}
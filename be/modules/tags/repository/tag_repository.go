@@ -2,27 +2,56 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/andreypavlenko/jobber/modules/tags/model"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
 type TagRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 func NewTagRepository(pool *pgxpool.Pool) *TagRepository {
 	return &TagRepository{pool: pool}
 }
 
+// NewTagRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewTagRepositoryWithPool(pool DBPool) *TagRepository {
+	return &TagRepository{pool: pool}
+}
+
 func (r *TagRepository) Create(ctx context.Context, tag *model.Tag) error {
 	query := `INSERT INTO tags (id, user_id, name, color, created_at) VALUES ($1, $2, $3, $4, $5)`
 	tag.ID = uuid.New().String()
 	tag.CreatedAt = time.Now().UTC()
 	_, err := r.pool.Exec(ctx, query, tag.ID, tag.UserID, tag.Name, tag.Color, tag.CreatedAt)
-	return err
+	return mapUniqueViolation(err)
+}
+
+func (r *TagRepository) GetByID(ctx context.Context, userID, tagID string) (*model.Tag, error) {
+	query := `SELECT id, user_id, name, color, created_at FROM tags WHERE id = $1 AND user_id = $2`
+	t := &model.Tag{}
+	err := r.pool.QueryRow(ctx, query, tagID, userID).Scan(&t.ID, &t.UserID, &t.Name, &t.Color, &t.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrTagNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
 }
 
 func (r *TagRepository) List(ctx context.Context, userID string) ([]*model.Tag, error) {
@@ -44,6 +73,27 @@ func (r *TagRepository) List(ctx context.Context, userID string) ([]*model.Tag,
 	return tags, rows.Err()
 }
 
+func (r *TagRepository) Update(ctx context.Context, tag *model.Tag) error {
+	query := `UPDATE tags SET name = $3, color = $4 WHERE id = $1 AND user_id = $2`
+	result, err := r.pool.Exec(ctx, query, tag.ID, tag.UserID, tag.Name, tag.Color)
+	if err != nil {
+		return mapUniqueViolation(err)
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrTagNotFound
+	}
+	return nil
+}
+
+// mapUniqueViolation turns a (user_id, name) conflict into ErrTagNameTaken
+func mapUniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return model.ErrTagNameTaken
+	}
+	return err
+}
+
 func (r *TagRepository) Delete(ctx context.Context, userID, tagID string) error {
 	query := `DELETE FROM tags WHERE id = $1 AND user_id = $2`
 	result, err := r.pool.Exec(ctx, query, tagID, userID)
@@ -56,18 +106,57 @@ func (r *TagRepository) Delete(ctx context.Context, userID, tagID string) error
 	return nil
 }
 
+// AddRelation attaches a tag to an entity. When AddedByUserID is unset the
+// tag owner is recorded as the user who added it.
 func (r *TagRepository) AddRelation(ctx context.Context, rel *model.TagRelation) error {
-	query := `INSERT INTO tag_relations (id, tag_id, entity_type, entity_id, created_at) VALUES ($1, $2, $3, $4, $5)`
+	query := `
+		INSERT INTO tag_relations (id, tag_id, entity_type, entity_id, added_by_user_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, COALESCE($5::uuid, (SELECT user_id FROM tags WHERE id = $2)), $6, $6)
+		RETURNING added_by_user_id
+	`
 	rel.ID = uuid.New().String()
 	rel.CreatedAt = time.Now().UTC()
-	_, err := r.pool.Exec(ctx, query, rel.ID, rel.TagID, rel.EntityType, rel.EntityID, rel.CreatedAt)
+	rel.UpdatedAt = rel.CreatedAt
+	err := r.pool.QueryRow(ctx, query, rel.ID, rel.TagID, rel.EntityType, rel.EntityID, rel.AddedByUserID, rel.CreatedAt).
+		Scan(&rel.AddedByUserID)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return model.ErrTagAlreadyAttached
+	}
 	return err
 }
 
-func (r *TagRepository) RemoveRelation(ctx context.Context, tagID, entityID string) error {
-	query := `DELETE FROM tag_relations WHERE tag_id = $1 AND entity_id = $2`
-	_, err := r.pool.Exec(ctx, query, tagID, entityID)
-	return err
+func (r *TagRepository) RemoveRelation(ctx context.Context, tagID, entityType, entityID string) error {
+	query := `DELETE FROM tag_relations WHERE tag_id = $1 AND entity_type = $2 AND entity_id = $3`
+	result, err := r.pool.Exec(ctx, query, tagID, entityType, entityID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrTagRelationNotFound
+	}
+	return nil
+}
+
+// entityTables maps each taggable entity type to its user-owned table
+var entityTables = map[string]string{
+	model.EntityTypeApplication: "applications",
+	model.EntityTypeJob:         "jobs",
+	model.EntityTypeCompany:     "companies",
+}
+
+// EntityExists reports whether the entity exists and belongs to the user
+func (r *TagRepository) EntityExists(ctx context.Context, userID, entityType, entityID string) (bool, error) {
+	table, ok := entityTables[entityType]
+	if !ok {
+		return false, model.ErrInvalidEntityType
+	}
+	query := fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM %s WHERE id = $1 AND user_id = $2)`, table)
+	var exists bool
+	if err := r.pool.QueryRow(ctx, query, entityID, userID).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
 }
 
 func (r *TagRepository) ListByEntity(ctx context.Context, entityType, entityID string) ([]*model.Tag, error) {
@@ -94,3 +183,51 @@ func (r *TagRepository) ListByEntity(ctx context.Context, entityType, entityID s
 	}
 	return tags, rows.Err()
 }
+
+// ListOwnedIDs returns the subset of tagIDs that belong to the user
+func (r *TagRepository) ListOwnedIDs(ctx context.Context, userID string, tagIDs []string) ([]string, error) {
+	query := `SELECT id FROM tags WHERE user_id = $1 AND id = ANY($2::uuid[])`
+	rows, err := r.pool.Query(ctx, query, userID, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// AddRelations attaches every tag to every entity in a single statement.
+// Existing relations are left untouched; only newly created rows are counted.
+func (r *TagRepository) AddRelations(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+	query := `
+		INSERT INTO tag_relations (tag_id, entity_type, entity_id, added_by_user_id, created_at, updated_at)
+		SELECT t.tag_id, $2, e.entity_id, tg.user_id, NOW(), NOW()
+		FROM unnest($1::uuid[]) AS t(tag_id)
+		JOIN tags tg ON tg.id = t.tag_id
+		CROSS JOIN unnest($3::uuid[]) AS e(entity_id)
+		ON CONFLICT (tag_id, entity_type, entity_id) DO NOTHING
+	`
+	result, err := r.pool.Exec(ctx, query, tagIDs, entityType, entityIDs)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+// RemoveRelations detaches every tag from every entity in a single statement
+func (r *TagRepository) RemoveRelations(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+	query := `DELETE FROM tag_relations WHERE entity_type = $2 AND tag_id = ANY($1::uuid[]) AND entity_id = ANY($3::uuid[])`
+	result, err := r.pool.Exec(ctx, query, tagIDs, entityType, entityIDs)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
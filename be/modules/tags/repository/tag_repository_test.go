@@ -0,0 +1,452 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/tags/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tagColumns = []string{"id", "user_id", "name", "color", "created_at"}
+
+func TestTagRepository_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		execErr error
+		wantErr error
+	}{
+		{name: "assigns id and created_at"},
+		{name: "maps a duplicate name to ErrTagNameTaken", execErr: &pgconn.PgError{Code: "23505"}, wantErr: model.ErrTagNameTaken},
+		{name: "propagates other errors", execErr: assert.AnError, wantErr: assert.AnError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			exec := mock.ExpectExec("INSERT INTO tags").
+				WithArgs(pgxmock.AnyArg(), "user-1", "remote", (*string)(nil), pgxmock.AnyArg())
+			if tt.execErr != nil {
+				exec.WillReturnError(tt.execErr)
+			} else {
+				exec.WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			}
+
+			tag := &model.Tag{UserID: "user-1", Name: "remote"}
+			err = NewTagRepositoryWithPool(mock).Create(context.Background(), tag)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.NotEmpty(t, tag.ID)
+				assert.False(t, tag.CreatedAt.IsZero())
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTagRepository_GetByID(t *testing.T) {
+	t.Run("returns the user's tag", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		color := "#ff0000"
+		mock.ExpectQuery("FROM tags WHERE id = \\$1 AND user_id = \\$2").
+			WithArgs("tag-1", "user-1").
+			WillReturnRows(pgxmock.NewRows(tagColumns).AddRow("tag-1", "user-1", "remote", &color, time.Now()))
+
+		tag, err := NewTagRepositoryWithPool(mock).GetByID(context.Background(), "user-1", "tag-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "remote", tag.Name)
+		require.NotNil(t, tag.Color)
+		assert.Equal(t, color, *tag.Color)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("maps missing rows to ErrTagNotFound", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM tags").
+			WithArgs("tag-1", "user-1").
+			WillReturnError(pgx.ErrNoRows)
+
+		tag, err := NewTagRepositoryWithPool(mock).GetByID(context.Background(), "user-1", "tag-1")
+
+		assert.Nil(t, tag)
+		assert.ErrorIs(t, err, model.ErrTagNotFound)
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM tags").
+			WithArgs("tag-1", "user-1").
+			WillReturnError(assert.AnError)
+
+		_, err = NewTagRepositoryWithPool(mock).GetByID(context.Background(), "user-1", "tag-1")
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestTagRepository_List(t *testing.T) {
+	t.Run("returns tags ordered by name", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery("FROM tags WHERE user_id = \\$1 ORDER BY name ASC").
+			WithArgs("user-1").
+			WillReturnRows(pgxmock.NewRows(tagColumns).
+				AddRow("tag-1", "user-1", "backend", nil, now).
+				AddRow("tag-2", "user-1", "remote", nil, now))
+
+		tags, err := NewTagRepositoryWithPool(mock).List(context.Background(), "user-1")
+
+		require.NoError(t, err)
+		require.Len(t, tags, 2)
+		assert.Equal(t, "backend", tags[0].Name)
+		assert.Nil(t, tags[0].Color)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM tags").
+			WithArgs("user-1").
+			WillReturnError(assert.AnError)
+
+		tags, err := NewTagRepositoryWithPool(mock).List(context.Background(), "user-1")
+
+		assert.Nil(t, tags)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestTagRepository_Update(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(exec *pgxmock.ExpectedExec)
+		wantErr error
+	}{
+		{
+			name:  "updates the tag",
+			setup: func(exec *pgxmock.ExpectedExec) { exec.WillReturnResult(pgxmock.NewResult("UPDATE", 1)) },
+		},
+		{
+			name:    "returns ErrTagNotFound when nothing matched",
+			setup:   func(exec *pgxmock.ExpectedExec) { exec.WillReturnResult(pgxmock.NewResult("UPDATE", 0)) },
+			wantErr: model.ErrTagNotFound,
+		},
+		{
+			name:    "maps a duplicate name to ErrTagNameTaken",
+			setup:   func(exec *pgxmock.ExpectedExec) { exec.WillReturnError(&pgconn.PgError{Code: "23505"}) },
+			wantErr: model.ErrTagNameTaken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setup(mock.ExpectExec("UPDATE tags SET name = \\$3, color = \\$4 WHERE id = \\$1 AND user_id = \\$2").
+				WithArgs("tag-1", "user-1", "remote", (*string)(nil)))
+
+			err = NewTagRepositoryWithPool(mock).Update(context.Background(), &model.Tag{ID: "tag-1", UserID: "user-1", Name: "remote"})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTagRepository_Delete(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec("DELETE FROM tags WHERE id = \\$1 AND user_id = \\$2").
+		WithArgs("tag-1", "user-1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec("DELETE FROM tags").
+		WithArgs("tag-1", "user-1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectExec("DELETE FROM tags").
+		WithArgs("tag-1", "user-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewTagRepositoryWithPool(mock)
+	assert.NoError(t, repo.Delete(context.Background(), "user-1", "tag-1"))
+	assert.ErrorIs(t, repo.Delete(context.Background(), "user-1", "tag-1"), model.ErrTagNotFound)
+	assert.ErrorIs(t, repo.Delete(context.Background(), "user-1", "tag-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTagRepository_AddRelation(t *testing.T) {
+	t.Run("records the tag owner when no user is given", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		owner := "user-1"
+		mock.ExpectQuery(`INSERT INTO tag_relations .*COALESCE\(\$5::uuid, \(SELECT user_id FROM tags WHERE id = \$2\)\).*RETURNING added_by_user_id`).
+			WithArgs(pgxmock.AnyArg(), "tag-1", model.EntityTypeJob, "job-1", (*string)(nil), pgxmock.AnyArg()).
+			WillReturnRows(pgxmock.NewRows([]string{"added_by_user_id"}).AddRow(&owner))
+
+		rel := &model.TagRelation{TagID: "tag-1", EntityType: model.EntityTypeJob, EntityID: "job-1"}
+		err = NewTagRepositoryWithPool(mock).AddRelation(context.Background(), rel)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, rel.ID)
+		assert.Equal(t, rel.CreatedAt, rel.UpdatedAt)
+		require.NotNil(t, rel.AddedByUserID)
+		assert.Equal(t, owner, *rel.AddedByUserID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("maps a duplicate relation to ErrTagAlreadyAttached", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("INSERT INTO tag_relations").
+			WithArgs(anyArgs(6)...).
+			WillReturnError(&pgconn.PgError{Code: "23505"})
+
+		err = NewTagRepositoryWithPool(mock).AddRelation(context.Background(), &model.TagRelation{TagID: "tag-1"})
+
+		assert.ErrorIs(t, err, model.ErrTagAlreadyAttached)
+	})
+
+	t.Run("propagates other errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("INSERT INTO tag_relations").
+			WithArgs(anyArgs(6)...).
+			WillReturnError(assert.AnError)
+
+		err = NewTagRepositoryWithPool(mock).AddRelation(context.Background(), &model.TagRelation{TagID: "tag-1"})
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestTagRepository_RemoveRelation(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec("DELETE FROM tag_relations WHERE tag_id = \\$1 AND entity_type = \\$2 AND entity_id = \\$3").
+		WithArgs("tag-1", model.EntityTypeJob, "job-1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec("DELETE FROM tag_relations").
+		WithArgs("tag-1", model.EntityTypeJob, "job-1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectExec("DELETE FROM tag_relations").
+		WithArgs("tag-1", model.EntityTypeJob, "job-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewTagRepositoryWithPool(mock)
+	assert.NoError(t, repo.RemoveRelation(context.Background(), "tag-1", model.EntityTypeJob, "job-1"))
+	assert.ErrorIs(t, repo.RemoveRelation(context.Background(), "tag-1", model.EntityTypeJob, "job-1"), model.ErrTagRelationNotFound)
+	assert.ErrorIs(t, repo.RemoveRelation(context.Background(), "tag-1", model.EntityTypeJob, "job-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTagRepository_EntityExists(t *testing.T) {
+	for entityType, table := range entityTables {
+		t.Run(entityType, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM "+table+" WHERE id = \\$1 AND user_id = \\$2\\)").
+				WithArgs("entity-1", "user-1").
+				WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+
+			exists, err := NewTagRepositoryWithPool(mock).EntityExists(context.Background(), "user-1", entityType, "entity-1")
+
+			require.NoError(t, err)
+			assert.True(t, exists)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	t.Run("rejects unknown entity types without querying", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		exists, err := NewTagRepositoryWithPool(mock).EntityExists(context.Background(), "user-1", "resume", "entity-1")
+
+		assert.False(t, exists)
+		assert.ErrorIs(t, err, model.ErrInvalidEntityType)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM applications").
+			WithArgs("entity-1", "user-1").
+			WillReturnError(assert.AnError)
+
+		_, err = NewTagRepositoryWithPool(mock).EntityExists(context.Background(), "user-1", model.EntityTypeApplication, "entity-1")
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestTagRepository_ListByEntity(t *testing.T) {
+	t.Run("returns the entity's tags", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`INNER JOIN tag_relations tr ON t.id = tr.tag_id\s+WHERE tr.entity_type = \$1 AND tr.entity_id = \$2`).
+			WithArgs(model.EntityTypeCompany, "company-1").
+			WillReturnRows(pgxmock.NewRows(tagColumns).AddRow("tag-1", "user-1", "fintech", nil, time.Now()))
+
+		tags, err := NewTagRepositoryWithPool(mock).ListByEntity(context.Background(), model.EntityTypeCompany, "company-1")
+
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		assert.Equal(t, "fintech", tags[0].Name)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM tags t").
+			WithArgs(model.EntityTypeCompany, "company-1").
+			WillReturnError(assert.AnError)
+
+		tags, err := NewTagRepositoryWithPool(mock).ListByEntity(context.Background(), model.EntityTypeCompany, "company-1")
+
+		assert.Nil(t, tags)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestTagRepository_ListOwnedIDs(t *testing.T) {
+	t.Run("returns the owned subset", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`SELECT id FROM tags WHERE user_id = \$1 AND id = ANY\(\$2::uuid\[\]\)`).
+			WithArgs("user-1", []string{"tag-1", "tag-2"}).
+			WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("tag-1"))
+
+		ids, err := NewTagRepositoryWithPool(mock).ListOwnedIDs(context.Background(), "user-1", []string{"tag-1", "tag-2"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"tag-1"}, ids)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("SELECT id FROM tags").
+			WithArgs("user-1", []string{"tag-1"}).
+			WillReturnError(assert.AnError)
+
+		_, err = NewTagRepositoryWithPool(mock).ListOwnedIDs(context.Background(), "user-1", []string{"tag-1"})
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestTagRepository_BulkRelations(t *testing.T) {
+	tagIDs := []string{"tag-1", "tag-2"}
+	entityIDs := []string{"job-1", "job-2"}
+
+	t.Run("add counts only new relations", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`INSERT INTO tag_relations .*ON CONFLICT \(tag_id, entity_type, entity_id\) DO NOTHING`).
+			WithArgs(tagIDs, model.EntityTypeJob, entityIDs).
+			WillReturnResult(pgxmock.NewResult("INSERT", 3))
+		mock.ExpectExec("INSERT INTO tag_relations").
+			WithArgs(tagIDs, model.EntityTypeJob, entityIDs).
+			WillReturnError(assert.AnError)
+
+		repo := NewTagRepositoryWithPool(mock)
+		n, err := repo.AddRelations(context.Background(), tagIDs, model.EntityTypeJob, entityIDs)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), n)
+
+		_, err = repo.AddRelations(context.Background(), tagIDs, model.EntityTypeJob, entityIDs)
+		assert.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`DELETE FROM tag_relations WHERE entity_type = \$2 AND tag_id = ANY\(\$1::uuid\[\]\) AND entity_id = ANY\(\$3::uuid\[\]\)`).
+			WithArgs(tagIDs, model.EntityTypeJob, entityIDs).
+			WillReturnResult(pgxmock.NewResult("DELETE", 2))
+		mock.ExpectExec("DELETE FROM tag_relations").
+			WithArgs(tagIDs, model.EntityTypeJob, entityIDs).
+			WillReturnError(assert.AnError)
+
+		repo := NewTagRepositoryWithPool(mock)
+		n, err := repo.RemoveRelations(context.Background(), tagIDs, model.EntityTypeJob, entityIDs)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+
+		_, err = repo.RemoveRelations(context.Background(), tagIDs, model.EntityTypeJob, entityIDs)
+		assert.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+// anyArgs matches n arguments of any value.
+func anyArgs(n int) []any {
+	args := make([]any, n)
+	for i := range args {
+		args[i] = pgxmock.AnyArg()
+	}
+	return args
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/andreypavlenko/jobber/modules/tags/model"
+	"github.com/andreypavlenko/jobber/modules/tags/ports"
+)
+
+// tagColorPattern matches a 6-digit hex color such as #3B82F6
+var tagColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// TagService handles tag business logic
+type TagService struct {
+	repo ports.TagRepository
+}
+
+// NewTagService creates a new tag service
+func NewTagService(repo ports.TagRepository) *TagService {
+	return &TagService{repo: repo}
+}
+
+// Create creates a new tag for the user
+func (s *TagService) Create(ctx context.Context, userID string, req *model.CreateTagRequest) (*model.TagDTO, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, model.ErrTagNameRequired
+	}
+	color, err := normalizeColor(req.Color)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := &model.Tag{
+		UserID: userID,
+		Name:   name,
+		Color:  color,
+	}
+	if err := s.repo.Create(ctx, tag); err != nil {
+		return nil, err
+	}
+	return tag.ToDTO(), nil
+}
+
+// List returns all tags of the user ordered by name
+func (s *TagService) List(ctx context.Context, userID string) ([]*model.TagDTO, error) {
+	tags, err := s.repo.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*model.TagDTO, len(tags))
+	for i, tag := range tags {
+		dtos[i] = tag.ToDTO()
+	}
+	return dtos, nil
+}
+
+// Update changes the name and/or color of a tag
+func (s *TagService) Update(ctx context.Context, userID, tagID string, req *model.UpdateTagRequest) (*model.TagDTO, error) {
+	tag, err := s.repo.GetByID(ctx, userID, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, model.ErrTagNameRequired
+		}
+		tag.Name = name
+	}
+	if req.Color != nil {
+		color, err := normalizeColor(req.Color)
+		if err != nil {
+			return nil, err
+		}
+		tag.Color = color
+	}
+
+	if err := s.repo.Update(ctx, tag); err != nil {
+		return nil, err
+	}
+	return tag.ToDTO(), nil
+}
+
+// Delete deletes a tag and, through the cascade, its relations
+func (s *TagService) Delete(ctx context.Context, userID, tagID string) error {
+	return s.repo.Delete(ctx, userID, tagID)
+}
+
+// Attach attaches the user's tag to one of the user's applications, jobs or companies
+func (s *TagService) Attach(ctx context.Context, userID, tagID string, req *model.AttachTagRequest) (*model.TagRelationDTO, error) {
+	if err := s.checkRelationTarget(ctx, userID, tagID, req); err != nil {
+		return nil, err
+	}
+
+	rel := &model.TagRelation{
+		TagID:         tagID,
+		EntityType:    req.EntityType,
+		EntityID:      req.EntityID,
+		AddedByUserID: &userID,
+	}
+	if err := s.repo.AddRelation(ctx, rel); err != nil {
+		return nil, err
+	}
+	return rel.ToDTO(), nil
+}
+
+// Detach removes the user's tag from an entity
+func (s *TagService) Detach(ctx context.Context, userID, tagID string, req *model.AttachTagRequest) error {
+	if err := s.checkRelationTarget(ctx, userID, tagID, req); err != nil {
+		return err
+	}
+	return s.repo.RemoveRelation(ctx, tagID, req.EntityType, req.EntityID)
+}
+
+// checkRelationTarget validates the entity type and that both the tag and
+// the entity belong to the user
+func (s *TagService) checkRelationTarget(ctx context.Context, userID, tagID string, req *model.AttachTagRequest) error {
+	if !model.IsValidEntityType(req.EntityType) {
+		return model.ErrInvalidEntityType
+	}
+	if _, err := s.repo.GetByID(ctx, userID, tagID); err != nil {
+		return err
+	}
+	exists, err := s.repo.EntityExists(ctx, userID, req.EntityType, req.EntityID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return model.ErrEntityNotFound
+	}
+	return nil
+}
+
+// normalizeColor validates a hex color and upper-cases it; nil and blank
+// colors mean no color
+func normalizeColor(color *string) (*string, error) {
+	if color == nil || strings.TrimSpace(*color) == "" {
+		return nil, nil
+	}
+	value := strings.TrimSpace(*color)
+	if !tagColorPattern.MatchString(value) {
+		return nil, model.ErrInvalidTagColor
+	}
+	value = strings.ToUpper(value)
+	return &value, nil
+}
@@ -0,0 +1,417 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/tags/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTagRepository implements ports.TagRepository
+type MockTagRepository struct {
+	CreateFunc  func(ctx context.Context, tag *model.Tag) error
+	GetByIDFunc func(ctx context.Context, userID, tagID string) (*model.Tag, error)
+	ListFunc    func(ctx context.Context, userID string) ([]*model.Tag, error)
+	UpdateFunc  func(ctx context.Context, tag *model.Tag) error
+	DeleteFunc  func(ctx context.Context, userID, tagID string) error
+
+	AddRelationFunc    func(ctx context.Context, rel *model.TagRelation) error
+	RemoveRelationFunc func(ctx context.Context, tagID, entityType, entityID string) error
+	EntityExistsFunc   func(ctx context.Context, userID, entityType, entityID string) (bool, error)
+}
+
+func (m *MockTagRepository) Create(ctx context.Context, tag *model.Tag) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, tag)
+	}
+	return nil
+}
+
+func (m *MockTagRepository) GetByID(ctx context.Context, userID, tagID string) (*model.Tag, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, userID, tagID)
+	}
+	return nil, model.ErrTagNotFound
+}
+
+func (m *MockTagRepository) List(ctx context.Context, userID string) ([]*model.Tag, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockTagRepository) Update(ctx context.Context, tag *model.Tag) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, tag)
+	}
+	return nil
+}
+
+func (m *MockTagRepository) Delete(ctx context.Context, userID, tagID string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID, tagID)
+	}
+	return nil
+}
+
+func (m *MockTagRepository) AddRelation(ctx context.Context, rel *model.TagRelation) error {
+	if m.AddRelationFunc != nil {
+		return m.AddRelationFunc(ctx, rel)
+	}
+	return nil
+}
+
+func (m *MockTagRepository) RemoveRelation(ctx context.Context, tagID, entityType, entityID string) error {
+	if m.RemoveRelationFunc != nil {
+		return m.RemoveRelationFunc(ctx, tagID, entityType, entityID)
+	}
+	return nil
+}
+
+func (m *MockTagRepository) EntityExists(ctx context.Context, userID, entityType, entityID string) (bool, error) {
+	if m.EntityExistsFunc != nil {
+		return m.EntityExistsFunc(ctx, userID, entityType, entityID)
+	}
+	return false, nil
+}
+
+func (m *MockTagRepository) ListByEntity(ctx context.Context, entityType, entityID string) ([]*model.Tag, error) {
+	return nil, nil
+}
+
+func (m *MockTagRepository) ListOwnedIDs(ctx context.Context, userID string, tagIDs []string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockTagRepository) AddRelations(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockTagRepository) RemoveRelations(ctx context.Context, tagIDs []string, entityType string, entityIDs []string) (int64, error) {
+	return 0, nil
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestTagService_Create(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("creates tag with normalized name and color", func(t *testing.T) {
+		var created *model.Tag
+		repo := &MockTagRepository{
+			CreateFunc: func(ctx context.Context, tag *model.Tag) error {
+				tag.ID = "tag-1"
+				created = tag
+				return nil
+			},
+		}
+		svc := NewTagService(repo)
+
+		result, err := svc.Create(context.Background(), userID, &model.CreateTagRequest{
+			Name:  "  Remote  ",
+			Color: strPtr("#3b82f6"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, created.UserID)
+		assert.Equal(t, "tag-1", result.ID)
+		assert.Equal(t, "Remote", result.Name)
+		require.NotNil(t, result.Color)
+		assert.Equal(t, "#3B82F6", *result.Color)
+	})
+
+	t.Run("creates tag without color", func(t *testing.T) {
+		svc := NewTagService(&MockTagRepository{})
+
+		result, err := svc.Create(context.Background(), userID, &model.CreateTagRequest{Name: "Remote"})
+
+		require.NoError(t, err)
+		assert.Nil(t, result.Color)
+	})
+
+	for _, color := range []string{"#ZZZ", "blue", "#3B82F", "3B82F6", "#3B82F6A", "#GGGGGG"} {
+		t.Run("rejects color "+color, func(t *testing.T) {
+			repo := &MockTagRepository{
+				CreateFunc: func(ctx context.Context, tag *model.Tag) error {
+					t.Fatal("Create should not be called")
+					return nil
+				},
+			}
+			svc := NewTagService(repo)
+
+			_, err := svc.Create(context.Background(), userID, &model.CreateTagRequest{Name: "Remote", Color: strPtr(color)})
+
+			assert.Equal(t, model.ErrInvalidTagColor, err)
+		})
+	}
+
+	t.Run("rejects blank name", func(t *testing.T) {
+		svc := NewTagService(&MockTagRepository{})
+
+		_, err := svc.Create(context.Background(), userID, &model.CreateTagRequest{Name: "   "})
+
+		assert.Equal(t, model.ErrTagNameRequired, err)
+	})
+
+	t.Run("passes through duplicate name", func(t *testing.T) {
+		repo := &MockTagRepository{
+			CreateFunc: func(ctx context.Context, tag *model.Tag) error {
+				return model.ErrTagNameTaken
+			},
+		}
+		svc := NewTagService(repo)
+
+		_, err := svc.Create(context.Background(), userID, &model.CreateTagRequest{Name: "Remote"})
+
+		assert.Equal(t, model.ErrTagNameTaken, err)
+	})
+}
+
+func TestTagService_List(t *testing.T) {
+	repo := &MockTagRepository{
+		ListFunc: func(ctx context.Context, uid string) ([]*model.Tag, error) {
+			assert.Equal(t, "user-123", uid)
+			return []*model.Tag{
+				{ID: "tag-1", Name: "Backend", Color: strPtr("#3B82F6")},
+				{ID: "tag-2", Name: "Remote"},
+			}, nil
+		},
+	}
+	svc := NewTagService(repo)
+
+	result, err := svc.List(context.Background(), "user-123")
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "Backend", result[0].Name)
+	assert.Equal(t, "tag-2", result[1].ID)
+}
+
+func TestTagService_Update(t *testing.T) {
+	userID := "user-123"
+	tagID := "tag-1"
+
+	existing := func() *MockTagRepository {
+		return &MockTagRepository{
+			GetByIDFunc: func(ctx context.Context, uid, tid string) (*model.Tag, error) {
+				assert.Equal(t, userID, uid)
+				return &model.Tag{ID: tid, UserID: uid, Name: "Remote", Color: strPtr("#3B82F6")}, nil
+			},
+		}
+	}
+
+	t.Run("updates name and keeps color", func(t *testing.T) {
+		repo := existing()
+		var updated *model.Tag
+		repo.UpdateFunc = func(ctx context.Context, tag *model.Tag) error {
+			updated = tag
+			return nil
+		}
+		svc := NewTagService(repo)
+
+		result, err := svc.Update(context.Background(), userID, tagID, &model.UpdateTagRequest{Name: strPtr("Hybrid")})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Hybrid", updated.Name)
+		assert.Equal(t, "Hybrid", result.Name)
+		assert.Equal(t, "#3B82F6", *result.Color)
+	})
+
+	t.Run("updates color", func(t *testing.T) {
+		svc := NewTagService(existing())
+
+		result, err := svc.Update(context.Background(), userID, tagID, &model.UpdateTagRequest{Color: strPtr("#10b981")})
+
+		require.NoError(t, err)
+		assert.Equal(t, "#10B981", *result.Color)
+	})
+
+	t.Run("empty color clears it", func(t *testing.T) {
+		svc := NewTagService(existing())
+
+		result, err := svc.Update(context.Background(), userID, tagID, &model.UpdateTagRequest{Color: strPtr("")})
+
+		require.NoError(t, err)
+		assert.Nil(t, result.Color)
+	})
+
+	t.Run("rejects invalid color", func(t *testing.T) {
+		repo := existing()
+		repo.UpdateFunc = func(ctx context.Context, tag *model.Tag) error {
+			t.Fatal("Update should not be called")
+			return nil
+		}
+		svc := NewTagService(repo)
+
+		_, err := svc.Update(context.Background(), userID, tagID, &model.UpdateTagRequest{Color: strPtr("blue")})
+
+		assert.Equal(t, model.ErrInvalidTagColor, err)
+	})
+
+	t.Run("rejects blank name", func(t *testing.T) {
+		svc := NewTagService(existing())
+
+		_, err := svc.Update(context.Background(), userID, tagID, &model.UpdateTagRequest{Name: strPtr("  ")})
+
+		assert.Equal(t, model.ErrTagNameRequired, err)
+	})
+
+	t.Run("returns not found for another user's tag", func(t *testing.T) {
+		svc := NewTagService(&MockTagRepository{})
+
+		_, err := svc.Update(context.Background(), userID, tagID, &model.UpdateTagRequest{Name: strPtr("Hybrid")})
+
+		assert.Equal(t, model.ErrTagNotFound, err)
+	})
+}
+
+func TestTagService_Delete(t *testing.T) {
+	t.Run("deletes tag scoped to user", func(t *testing.T) {
+		repo := &MockTagRepository{
+			DeleteFunc: func(ctx context.Context, uid, tid string) error {
+				assert.Equal(t, "user-123", uid)
+				assert.Equal(t, "tag-1", tid)
+				return nil
+			},
+		}
+		svc := NewTagService(repo)
+
+		assert.NoError(t, svc.Delete(context.Background(), "user-123", "tag-1"))
+	})
+
+	t.Run("returns repository error", func(t *testing.T) {
+		repo := &MockTagRepository{
+			DeleteFunc: func(ctx context.Context, uid, tid string) error {
+				return errors.New("database error")
+			},
+		}
+		svc := NewTagService(repo)
+
+		assert.Error(t, svc.Delete(context.Background(), "user-123", "tag-1"))
+	})
+}
+
+func TestTagService_Attach(t *testing.T) {
+	userID := "user-123"
+	tagID := "tag-1"
+	entityID := "11111111-1111-1111-1111-111111111111"
+
+	owned := func() *MockTagRepository {
+		return &MockTagRepository{
+			GetByIDFunc: func(ctx context.Context, uid, tid string) (*model.Tag, error) {
+				return &model.Tag{ID: tid, UserID: uid, Name: "Remote"}, nil
+			},
+			EntityExistsFunc: func(ctx context.Context, uid, entityType, eid string) (bool, error) {
+				assert.Equal(t, userID, uid)
+				return true, nil
+			},
+		}
+	}
+
+	for _, entityType := range []string{model.EntityTypeApplication, model.EntityTypeJob, model.EntityTypeCompany} {
+		t.Run("attaches tag to "+entityType, func(t *testing.T) {
+			repo := owned()
+			var added *model.TagRelation
+			repo.AddRelationFunc = func(ctx context.Context, rel *model.TagRelation) error {
+				rel.ID = "rel-1"
+				added = rel
+				return nil
+			}
+			svc := NewTagService(repo)
+
+			result, err := svc.Attach(context.Background(), userID, tagID, &model.AttachTagRequest{EntityType: entityType, EntityID: entityID})
+
+			require.NoError(t, err)
+			assert.Equal(t, tagID, added.TagID)
+			assert.Equal(t, entityType, added.EntityType)
+			assert.Equal(t, entityID, added.EntityID)
+			assert.Equal(t, userID, *added.AddedByUserID)
+			assert.Equal(t, "rel-1", result.ID)
+		})
+	}
+
+	t.Run("rejects unknown entity type", func(t *testing.T) {
+		svc := NewTagService(owned())
+
+		_, err := svc.Attach(context.Background(), userID, tagID, &model.AttachTagRequest{EntityType: "resume", EntityID: entityID})
+
+		assert.Equal(t, model.ErrInvalidEntityType, err)
+	})
+
+	t.Run("rejects another user's tag", func(t *testing.T) {
+		repo := owned()
+		repo.GetByIDFunc = nil
+		svc := NewTagService(repo)
+
+		_, err := svc.Attach(context.Background(), userID, tagID, &model.AttachTagRequest{EntityType: model.EntityTypeJob, EntityID: entityID})
+
+		assert.Equal(t, model.ErrTagNotFound, err)
+	})
+
+	t.Run("rejects another user's entity", func(t *testing.T) {
+		repo := owned()
+		repo.EntityExistsFunc = nil
+		repo.AddRelationFunc = func(ctx context.Context, rel *model.TagRelation) error {
+			t.Fatal("AddRelation should not be called")
+			return nil
+		}
+		svc := NewTagService(repo)
+
+		_, err := svc.Attach(context.Background(), userID, tagID, &model.AttachTagRequest{EntityType: model.EntityTypeJob, EntityID: entityID})
+
+		assert.Equal(t, model.ErrEntityNotFound, err)
+	})
+
+	t.Run("returns conflict for duplicate relation", func(t *testing.T) {
+		repo := owned()
+		repo.AddRelationFunc = func(ctx context.Context, rel *model.TagRelation) error {
+			return model.ErrTagAlreadyAttached
+		}
+		svc := NewTagService(repo)
+
+		_, err := svc.Attach(context.Background(), userID, tagID, &model.AttachTagRequest{EntityType: model.EntityTypeJob, EntityID: entityID})
+
+		assert.Equal(t, model.ErrTagAlreadyAttached, err)
+	})
+}
+
+func TestTagService_Detach(t *testing.T) {
+	userID := "user-123"
+	entityID := "11111111-1111-1111-1111-111111111111"
+
+	t.Run("removes relation of the given entity type", func(t *testing.T) {
+		var removed []string
+		repo := &MockTagRepository{
+			GetByIDFunc: func(ctx context.Context, uid, tid string) (*model.Tag, error) {
+				return &model.Tag{ID: tid, UserID: uid}, nil
+			},
+			EntityExistsFunc: func(ctx context.Context, uid, entityType, eid string) (bool, error) {
+				return true, nil
+			},
+			RemoveRelationFunc: func(ctx context.Context, tid, entityType, eid string) error {
+				removed = []string{tid, entityType, eid}
+				return nil
+			},
+		}
+		svc := NewTagService(repo)
+
+		err := svc.Detach(context.Background(), userID, "tag-1", &model.AttachTagRequest{EntityType: model.EntityTypeCompany, EntityID: entityID})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"tag-1", model.EntityTypeCompany, entityID}, removed)
+	})
+
+	t.Run("rejects unknown entity type", func(t *testing.T) {
+		svc := NewTagService(&MockTagRepository{})
+
+		err := svc.Detach(context.Background(), userID, "tag-1", &model.AttachTagRequest{EntityType: "resume", EntityID: entityID})
+
+		assert.Equal(t, model.ErrInvalidEntityType, err)
+	})
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/andreypavlenko/jobber/internal/platform/auth"
+	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/modules/users/model"
+	"github.com/andreypavlenko/jobber/modules/users/service"
+	"github.com/gin-gonic/gin"
+)
+
+type UserHandler struct {
+	service *service.ProfileService
+}
+
+func NewUserHandler(service *service.ProfileService) *UserHandler {
+	return &UserHandler{service: service}
+}
+
+// GetProfile godoc
+// @Summary Get current user profile
+// @Description Get the authenticated user's profile with the number of tracked jobs, companies and applications
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} model.UserDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /users/me [get]
+// @Router /me [get]
+func (h *UserHandler) GetProfile(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.service.GetProfile(c.Request.Context(), userID)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			httpPlatform.RespondWithError(c, http.StatusNotFound, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+			return
+		}
+		httpPlatform.RespondWithError(c, http.StatusInternalServerError, string(model.CodeInternalError), model.GetErrorMessage(err, auth.GetLocale(c)))
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, profile)
+}
+
+// UpdateProfile godoc
+// @Summary Update current user profile
+// @Description Update the authenticated user's name and/or locale. Omitted fields are left unchanged.
+// @Tags users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body model.UpdateProfileRequest true "Profile fields to change"
+// @Success 200 {object} model.UserDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse "Invalid name or unsupported locale"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /me [patch]
+func (h *UserHandler) UpdateProfile(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	var req model.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeValidationError), err.Error())
+		return
+	}
+
+	profile, err := h.service.UpdateProfile(c.Request.Context(), userID, &req)
+	if err != nil {
+		respondWithUserError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, profile)
+}
+
+// ChangePassword godoc
+// @Summary Change password
+// @Description Replace the authenticated user's password. The current password must be given unless the account was created through Google sign-in and has none yet; the new one must be 8 to 72 characters.
+// @Tags users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body model.ChangePasswordRequest true "Current and new password"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} httpPlatform.ErrorResponse "Incorrect current password or invalid new password"
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /me/change-password [post]
+func (h *UserHandler) ChangePassword(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	var req model.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, string(model.CodeValidationError), err.Error())
+		return
+	}
+
+	if err := h.service.ChangePassword(c.Request.Context(), userID, &req); err != nil {
+		respondWithUserError(c, err)
+		return
+	}
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Password changed successfully"})
+}
+
+// respondWithUserError maps profile errors to HTTP responses
+func respondWithUserError(c *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	switch model.GetErrorCode(err) {
+	case model.CodeUserNotFound:
+		statusCode = http.StatusNotFound
+	case model.CodeInvalidName, model.CodeInvalidLocale, model.CodeInvalidPassword, model.CodeIncorrectPassword:
+		statusCode = http.StatusBadRequest
+	}
+	httpPlatform.RespondWithError(c, statusCode, string(model.GetErrorCode(err)), model.GetErrorMessage(err, auth.GetLocale(c)))
+}
+
+// RegisterRoutes registers user routes
+func (h *UserHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	users := router.Group("/users")
+	users.Use(authMiddleware)
+	{
+		users.GET("/me", h.GetProfile)
+	}
+
+	me := router.Group("/me")
+	me.Use(authMiddleware)
+	{
+		me.GET("", h.GetProfile)
+		me.PATCH("", h.UpdateProfile)
+		me.POST("/change-password", h.ChangePassword)
+	}
+}
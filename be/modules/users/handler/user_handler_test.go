@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/andreypavlenko/jobber/modules/users/model"
+	"github.com/andreypavlenko/jobber/modules/users/service"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// stubUserRepository implements ports.UserRepository
+type stubUserRepository struct {
+	profile   *model.UserDTO
+	err       error
+	user      *model.User
+	savedHash string
+}
+
+func (r *stubUserRepository) Create(ctx context.Context, user *model.User) error { return nil }
+func (r *stubUserRepository) GetByID(ctx context.Context, userID string) (*model.User, error) {
+	if r.user == nil {
+		return nil, model.ErrUserNotFound
+	}
+	user := *r.user
+	return &user, nil
+}
+func (r *stubUserRepository) GetByIDEnriched(ctx context.Context, userID string) (*model.UserDTO, error) {
+	return r.profile, r.err
+}
+func (r *stubUserRepository) GetByEmail(ctx context.Context, email string) (*model.User, error) {
+	return nil, nil
+}
+func (r *stubUserRepository) Update(ctx context.Context, user *model.User) error {
+	r.user = user
+	r.profile = user.ToDTO()
+	return nil
+}
+func (r *stubUserRepository) Delete(ctx context.Context, userID string) error           { return nil }
+func (r *stubUserRepository) SetEmailVerified(ctx context.Context, userID string) error { return nil }
+func (r *stubUserRepository) UpdatePasswordHash(ctx context.Context, userID, hash string) error {
+	r.savedHash = hash
+	return nil
+}
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+// mockAuthMiddleware sets a user_id in the context for testing
+func mockAuthMiddleware(userID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	}
+}
+
+func newTestHandler(t *testing.T, repo *stubUserRepository) *UserHandler {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	return NewUserHandler(service.NewProfileService(repo, client))
+}
+
+func TestUserHandler_GetProfile(t *testing.T) {
+	t.Run("returns profile with counts", func(t *testing.T) {
+		handler := newTestHandler(t, &stubUserRepository{
+			profile: &model.UserDTO{ID: "user-123", Email: "test@example.com", JobCount: 4, CompanyCount: 2, ApplicationCount: 9},
+		})
+		router := setupTestRouter()
+		router.GET("/users/me", mockAuthMiddleware("user-123"), handler.GetProfile)
+
+		req, _ := http.NewRequest(http.MethodGet, "/users/me", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"job_count":4`)
+		assert.Contains(t, w.Body.String(), `"company_count":2`)
+		assert.Contains(t, w.Body.String(), `"application_count":9`)
+	})
+
+	t.Run("returns 404 when user not found", func(t *testing.T) {
+		handler := newTestHandler(t, &stubUserRepository{err: model.ErrUserNotFound})
+		router := setupTestRouter()
+		router.GET("/users/me", mockAuthMiddleware("user-123"), handler.GetProfile)
+
+		req, _ := http.NewRequest(http.MethodGet, "/users/me", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("returns 500 on repository error", func(t *testing.T) {
+		handler := newTestHandler(t, &stubUserRepository{err: errors.New("db error")})
+		router := setupTestRouter()
+		router.GET("/users/me", mockAuthMiddleware("user-123"), handler.GetProfile)
+
+		req, _ := http.NewRequest(http.MethodGet, "/users/me", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("returns 401 without user", func(t *testing.T) {
+		handler := newTestHandler(t, &stubUserRepository{})
+		router := setupTestRouter()
+		router.GET("/users/me", handler.GetProfile)
+
+		req, _ := http.NewRequest(http.MethodGet, "/users/me", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestUserHandler_MeRoutes(t *testing.T) {
+	setup := func(t *testing.T, repo *stubUserRepository) *gin.Engine {
+		router := setupTestRouter()
+		newTestHandler(t, repo).RegisterRoutes(router.Group("/api/v1"), mockAuthMiddleware("user-123"))
+		return router
+	}
+	send := func(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("GET /me returns the profile", func(t *testing.T) {
+		router := setup(t, &stubUserRepository{
+			profile: &model.UserDTO{ID: "user-123", Email: "test@example.com", Name: "Jane", Locale: "en"},
+		})
+
+		w := send(router, http.MethodGet, "/api/v1/me", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"email":"test@example.com"`)
+		assert.Contains(t, w.Body.String(), `"locale":"en"`)
+	})
+
+	t.Run("PATCH /me updates name and locale", func(t *testing.T) {
+		repo := &stubUserRepository{user: &model.User{ID: "user-123", Email: "test@example.com", Name: "Jane", Locale: "en"}}
+		router := setup(t, repo)
+
+		w := send(router, http.MethodPatch, "/api/v1/me", `{"name":"Jane Doe","locale":"es"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"name":"Jane Doe"`)
+		assert.Contains(t, w.Body.String(), `"locale":"es"`)
+		assert.Equal(t, "Jane Doe", repo.user.Name)
+	})
+
+	t.Run("PATCH /me rejects an unsupported locale", func(t *testing.T) {
+		router := setup(t, &stubUserRepository{user: &model.User{ID: "user-123", Locale: "en"}})
+
+		w := send(router, http.MethodPatch, "/api/v1/me", `{"locale":"xx-ZZ"}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), string(model.CodeInvalidLocale))
+	})
+
+	t.Run("PATCH /me rejects invalid JSON", func(t *testing.T) {
+		router := setup(t, &stubUserRepository{user: &model.User{ID: "user-123"}})
+
+		w := send(router, http.MethodPatch, "/api/v1/me", `{"name":`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("PATCH /me returns 404 for unknown user", func(t *testing.T) {
+		router := setup(t, &stubUserRepository{})
+
+		w := send(router, http.MethodPatch, "/api/v1/me", `{"name":"Jane"}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("old-password"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	t.Run("POST /me/change-password changes the password", func(t *testing.T) {
+		repo := &stubUserRepository{user: &model.User{ID: "user-123", PasswordHash: string(hash)}}
+		router := setup(t, repo)
+
+		w := send(router, http.MethodPost, "/api/v1/me/change-password", `{"current_password":"old-password","new_password":"new-password"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEmpty(t, repo.savedHash)
+	})
+
+	for _, tt := range []struct {
+		name string
+		body string
+		code string
+	}{
+		{name: "incorrect current password", body: `{"current_password":"wrong","new_password":"new-password"}`, code: string(model.CodeIncorrectPassword)},
+		{name: "new password too short", body: `{"current_password":"old-password","new_password":"short"}`, code: string(model.CodeInvalidPassword)},
+		{name: "missing current password", body: `{"new_password":"new-password"}`, code: string(model.CodeIncorrectPassword)},
+		{name: "missing fields", body: `{"current_password":"old-password"}`, code: string(model.CodeValidationError)},
+	} {
+		t.Run("POST /me/change-password rejects "+tt.name, func(t *testing.T) {
+			repo := &stubUserRepository{user: &model.User{ID: "user-123", PasswordHash: string(hash)}}
+			router := setup(t, repo)
+
+			w := send(router, http.MethodPost, "/api/v1/me/change-password", tt.body)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), tt.code)
+			assert.Empty(t, repo.savedHash)
+		})
+	}
+}
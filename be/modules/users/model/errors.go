@@ -1,102 +1,98 @@
 package model
 
-import "errors"
+import (
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
 
 var (
 	// ErrUserNotFound is returned when a user is not found
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound = &DomainError{Code: CodeUserNotFound, Message: "user not found"}
 
 	// ErrUserAlreadyExists is returned when a user with the same email already exists
-	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrUserAlreadyExists = &DomainError{Code: CodeUserAlreadyExists, Message: "user already exists"}
 
 	// ErrInvalidCredentials is returned when credentials are invalid
-	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidCredentials = &DomainError{Code: CodeInvalidCredentials, Message: "invalid credentials"}
 
 	// ErrInvalidEmail is returned when email format is invalid
-	ErrInvalidEmail = errors.New("invalid email format")
+	ErrInvalidEmail = &DomainError{Code: CodeInvalidEmail, Message: "invalid email format"}
 
 	// ErrInvalidPassword is returned when password is invalid
-	ErrInvalidPassword = errors.New("invalid password")
+	ErrInvalidPassword = &DomainError{Code: CodeInvalidPassword, Message: "invalid password"}
+
+	// ErrInvalidName is returned when the display name is too long
+	ErrInvalidName = &DomainError{Code: CodeInvalidName, Message: "invalid name"}
+
+	// ErrIncorrectPassword is returned when the current password given for a password change does not match
+	ErrIncorrectPassword = &DomainError{Code: CodeIncorrectPassword, Message: "current password is incorrect"}
+
+	// ErrInvalidLocale is returned when the locale is not a supported BCP-47 tag
+	ErrInvalidLocale = &DomainError{Code: CodeInvalidLocale, Message: "invalid locale"}
 
 	// ErrEmailNotVerified is returned when user tries to login without verified email
-	ErrEmailNotVerified = errors.New("email not verified")
+	ErrEmailNotVerified = &DomainError{Code: CodeEmailNotVerified, Message: "email not verified"}
 
 	// ErrInvalidVerificationToken is returned when verification code is invalid or expired
-	ErrInvalidVerificationToken = errors.New("invalid or expired verification code")
+	ErrInvalidVerificationToken = &DomainError{Code: CodeInvalidVerificationToken, Message: "invalid or expired verification code"}
 
 	// ErrInvalidResetToken is returned when password reset code is invalid or expired
-	ErrInvalidResetToken = errors.New("invalid or expired reset code")
+	ErrInvalidResetToken = &DomainError{Code: CodeInvalidResetToken, Message: "invalid or expired reset code"}
 
 	// ErrTooManyAttempts is returned when too many incorrect code attempts have been made
-	ErrTooManyAttempts = errors.New("too many incorrect code attempts")
+	ErrTooManyAttempts = &DomainError{Code: CodeTooManyAttempts, Message: "too many incorrect code attempts"}
+
+	// ErrInvalidOAuthState is returned when an OAuth callback has an unknown, expired or reused state
+	ErrInvalidOAuthState = &DomainError{Code: CodeInvalidOAuthState, Message: "invalid oauth state"}
+
+	// ErrOAuthEmailNotVerified is returned when the OAuth provider has not verified the account's email
+	ErrOAuthEmailNotVerified = &DomainError{Code: CodeOAuthEmailNotVerified, Message: "oauth email not verified"}
+
+	// ErrOAuthProvider is returned when the OAuth provider rejects the code or cannot be reached
+	ErrOAuthProvider = &DomainError{Code: CodeOAuthProvider, Message: "oauth provider error"}
+
+	// ErrTokenReuseDetected is returned when an already rotated refresh token is presented again
+	ErrTokenReuseDetected = &DomainError{Code: CodeTokenReuseDetected, Message: "refresh token reuse detected"}
+
+	// ErrSessionNotFound is returned when the user has no active session with the given ID
+	ErrSessionNotFound = &DomainError{Code: CodeSessionNotFound, Message: "session not found"}
 )
 
 // ErrorCode represents a machine-readable error code
 type ErrorCode string
 
 const (
-	CodeUserNotFound              ErrorCode = "USER_NOT_FOUND"
-	CodeUserAlreadyExists         ErrorCode = "USER_ALREADY_EXISTS"
-	CodeInvalidCredentials        ErrorCode = "INVALID_CREDENTIALS"
-	CodeInvalidEmail              ErrorCode = "INVALID_EMAIL"
-	CodeInvalidPassword           ErrorCode = "INVALID_PASSWORD"
-	CodeInternalError             ErrorCode = "INTERNAL_ERROR"
-	CodeUnauthorized              ErrorCode = "UNAUTHORIZED"
-	CodeValidationError           ErrorCode = "VALIDATION_ERROR"
-	CodeEmailNotVerified          ErrorCode = "EMAIL_NOT_VERIFIED"
-	CodeInvalidVerificationToken  ErrorCode = "INVALID_VERIFICATION_TOKEN"
-	CodeInvalidResetToken         ErrorCode = "INVALID_RESET_TOKEN"
-	CodeTooManyAttempts           ErrorCode = "TOO_MANY_ATTEMPTS"
+	CodeUserNotFound             ErrorCode = "USER_NOT_FOUND"
+	CodeUserAlreadyExists        ErrorCode = "USER_ALREADY_EXISTS"
+	CodeInvalidCredentials       ErrorCode = "INVALID_CREDENTIALS"
+	CodeInvalidEmail             ErrorCode = "INVALID_EMAIL"
+	CodeInvalidPassword          ErrorCode = "INVALID_PASSWORD"
+	CodeInvalidName              ErrorCode = "INVALID_NAME"
+	CodeIncorrectPassword        ErrorCode = "INCORRECT_PASSWORD"
+	CodeInvalidLocale            ErrorCode = "INVALID_LOCALE"
+	CodeInternalError            ErrorCode = "INTERNAL_ERROR"
+	CodeUnauthorized             ErrorCode = "UNAUTHORIZED"
+	CodeValidationError          ErrorCode = "VALIDATION_ERROR"
+	CodeEmailNotVerified         ErrorCode = "EMAIL_NOT_VERIFIED"
+	CodeInvalidVerificationToken ErrorCode = "INVALID_VERIFICATION_TOKEN"
+	CodeInvalidResetToken        ErrorCode = "INVALID_RESET_TOKEN"
+	CodeTooManyAttempts          ErrorCode = "TOO_MANY_ATTEMPTS"
+	CodeInvalidOAuthState        ErrorCode = "INVALID_OAUTH_STATE"
+	CodeOAuthEmailNotVerified    ErrorCode = "OAUTH_EMAIL_NOT_VERIFIED"
+	CodeOAuthProvider            ErrorCode = "OAUTH_PROVIDER_ERROR"
+	CodeTokenReuseDetected       ErrorCode = "TOKEN_REUSE_DETECTED"
+	CodeSessionNotFound          ErrorCode = "SESSION_NOT_FOUND"
 )
 
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
 // GetErrorCode maps errors to error codes
 func GetErrorCode(err error) ErrorCode {
-	switch {
-	case errors.Is(err, ErrUserNotFound):
-		return CodeUserNotFound
-	case errors.Is(err, ErrUserAlreadyExists):
-		return CodeUserAlreadyExists
-	case errors.Is(err, ErrInvalidCredentials):
-		return CodeInvalidCredentials
-	case errors.Is(err, ErrInvalidEmail):
-		return CodeInvalidEmail
-	case errors.Is(err, ErrInvalidPassword):
-		return CodeInvalidPassword
-	case errors.Is(err, ErrEmailNotVerified):
-		return CodeEmailNotVerified
-	case errors.Is(err, ErrInvalidVerificationToken):
-		return CodeInvalidVerificationToken
-	case errors.Is(err, ErrInvalidResetToken):
-		return CodeInvalidResetToken
-	case errors.Is(err, ErrTooManyAttempts):
-		return CodeTooManyAttempts
-	default:
-		return CodeInternalError
-	}
+	return domainerr.CodeOf(err, CodeInternalError)
 }
 
-// GetErrorMessage returns a user-friendly error message
-func GetErrorMessage(err error) string {
-	switch {
-	case errors.Is(err, ErrUserNotFound):
-		return "User not found"
-	case errors.Is(err, ErrUserAlreadyExists):
-		return "User with this email already exists"
-	case errors.Is(err, ErrInvalidCredentials):
-		return "Invalid email or password"
-	case errors.Is(err, ErrInvalidEmail):
-		return "Invalid email format"
-	case errors.Is(err, ErrInvalidPassword):
-		return "Password must be at least 8 characters"
-	case errors.Is(err, ErrEmailNotVerified):
-		return "Please verify your email address before logging in"
-	case errors.Is(err, ErrInvalidVerificationToken):
-		return "Invalid or expired verification code"
-	case errors.Is(err, ErrInvalidResetToken):
-		return "Invalid or expired password reset code"
-	case errors.Is(err, ErrTooManyAttempts):
-		return "Too many incorrect code attempts. Please request a new code."
-	default:
-		return "Internal server error"
-	}
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
 }
@@ -31,11 +31,14 @@ func NewUser(email, name, passwordHash, locale string) *User {
 
 // UserDTO represents user data transfer object (without sensitive data)
 type UserDTO struct {
-	ID        string    `json:"id"`
-	Email     string    `json:"email"`
-	Name      string    `json:"name"`
-	Locale    string    `json:"locale"`
-	CreatedAt time.Time `json:"created_at"`
+	ID               string    `json:"id"`
+	Email            string    `json:"email"`
+	Name             string    `json:"name"`
+	Locale           string    `json:"locale"`
+	CreatedAt        time.Time `json:"created_at"`
+	JobCount         int       `json:"job_count"`
+	CompanyCount     int       `json:"company_count"`
+	ApplicationCount int       `json:"application_count"`
 }
 
 // ToDTO converts User to UserDTO
@@ -48,3 +51,24 @@ func (u *User) ToDTO() *UserDTO {
 		CreatedAt: u.CreatedAt,
 	}
 }
+
+// Password and profile limits shared by registration, reset and profile updates
+const (
+	MinPasswordLength = 8
+	// MaxPasswordLength is the bcrypt input limit; longer passwords would be silently truncated
+	MaxPasswordLength = 72
+	MaxNameLength     = 255
+)
+
+// UpdateProfileRequest updates the user's profile; omitted fields are left unchanged
+type UpdateProfileRequest struct {
+	Name   *string `json:"name"`
+	Locale *string `json:"locale"`
+}
+
+// ChangePasswordRequest replaces the user's password after verifying the current one.
+// Users created through OAuth have no password yet and leave CurrentPassword empty.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password" binding:"required"`
+}
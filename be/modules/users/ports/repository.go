@@ -10,6 +10,8 @@ import (
 type UserRepository interface {
 	Create(ctx context.Context, user *model.User) error
 	GetByID(ctx context.Context, userID string) (*model.User, error)
+	// GetByIDEnriched returns the user's profile with job, company and application counts
+	GetByIDEnriched(ctx context.Context, userID string) (*model.UserDTO, error)
 	GetByEmail(ctx context.Context, email string) (*model.User, error)
 	Update(ctx context.Context, user *model.User) error
 	Delete(ctx context.Context, userID string) error
@@ -11,9 +11,16 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
 // UserRepository implements ports.UserRepository
 type UserRepository struct {
-	pool *pgxpool.Pool
+	pool DBPool
 }
 
 // NewUserRepository creates a new user repository
@@ -21,6 +28,11 @@ func NewUserRepository(pool *pgxpool.Pool) *UserRepository {
 	return &UserRepository{pool: pool}
 }
 
+// NewUserRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewUserRepositoryWithPool(pool DBPool) *UserRepository {
+	return &UserRepository{pool: pool}
+}
+
 // Create creates a new user
 func (r *UserRepository) Create(ctx context.Context, user *model.User) error {
 	query := `
@@ -83,6 +95,40 @@ func (r *UserRepository) GetByID(ctx context.Context, userID string) (*model.Use
 	return user, nil
 }
 
+// GetByIDEnriched retrieves a user's profile together with the number of
+// jobs, companies and applications they track, in a single query
+func (r *UserRepository) GetByIDEnriched(ctx context.Context, userID string) (*model.UserDTO, error) {
+	query := `
+		SELECT u.id, u.email, u.name, u.locale, u.created_at,
+			(SELECT COUNT(*) FROM jobs j WHERE j.user_id = u.id) AS job_count,
+			(SELECT COUNT(*) FROM companies c WHERE c.user_id = u.id) AS company_count,
+			(SELECT COUNT(*) FROM applications a WHERE a.user_id = u.id AND a.deleted_at IS NULL) AS application_count
+		FROM users u
+		WHERE u.id = $1
+	`
+
+	dto := &model.UserDTO{}
+	err := r.pool.QueryRow(ctx, query, userID).Scan(
+		&dto.ID,
+		&dto.Email,
+		&dto.Name,
+		&dto.Locale,
+		&dto.CreatedAt,
+		&dto.JobCount,
+		&dto.CompanyCount,
+		&dto.ApplicationCount,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return dto, nil
+}
+
 // GetByEmail retrieves a user by email
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*model.User, error) {
 	query := `
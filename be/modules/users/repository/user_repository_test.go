@@ -96,6 +96,53 @@ func TestUserRepository_GetByID(t *testing.T) {
 	})
 }
 
+func TestUserRepository_GetByIDEnriched(t *testing.T) {
+	t.Run("returns profile with counts", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		userID := "user-123"
+		now := time.Now()
+
+		rows := pgxmock.NewRows([]string{
+			"id", "email", "name", "locale", "created_at", "job_count", "company_count", "application_count",
+		}).AddRow(userID, "test@example.com", "Test User", "en", now, 12, 4, 7)
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM applications a WHERE a.user_id = u.id AND a.deleted_at IS NULL`).
+			WithArgs(userID).
+			WillReturnRows(rows)
+
+		repo := NewUserRepositoryWithPool(mock)
+		profile, err := repo.GetByIDEnriched(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, profile.ID)
+		assert.Equal(t, "test@example.com", profile.Email)
+		assert.Equal(t, 12, profile.JobCount)
+		assert.Equal(t, 4, profile.CompanyCount)
+		assert.Equal(t, 7, profile.ApplicationCount)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns error when user not found", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM users u").
+			WithArgs("missing").
+			WillReturnError(pgx.ErrNoRows)
+
+		repo := NewUserRepositoryWithPool(mock)
+		profile, err := repo.GetByIDEnriched(context.Background(), "missing")
+
+		assert.Nil(t, profile)
+		assert.Equal(t, model.ErrUserNotFound, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestUserRepository_GetByEmail(t *testing.T) {
 	t.Run("returns user successfully", func(t *testing.T) {
 		mock, err := pgxmock.NewPool()
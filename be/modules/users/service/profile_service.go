@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/andreypavlenko/jobber/internal/platform/auth"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+	"github.com/andreypavlenko/jobber/modules/users/model"
+	"github.com/andreypavlenko/jobber/modules/users/ports"
+	"github.com/redis/go-redis/v9"
+)
+
+// profileCacheTTL bounds how stale the cached profile counts can get
+// if an invalidation is missed.
+const profileCacheTTL = time.Minute
+
+// ProfileService serves user profiles, caching them in Redis when a client is set
+type ProfileService struct {
+	repo        ports.UserRepository
+	redisClient *redis.Client
+}
+
+// NewProfileService creates a new profile service
+func NewProfileService(repo ports.UserRepository, redisClient *redis.Client) *ProfileService {
+	return &ProfileService{repo: repo, redisClient: redisClient}
+}
+
+func profileCacheKey(userID string) string {
+	return "user_profile:" + userID
+}
+
+// GetProfile returns the user's profile with tracked entity counts.
+// Redis errors fail open: the profile is then read from the database.
+func (s *ProfileService) GetProfile(ctx context.Context, userID string) (*model.UserDTO, error) {
+	if s.redisClient == nil {
+		return s.repo.GetByIDEnriched(ctx, userID)
+	}
+
+	key := profileCacheKey(userID)
+
+	raw, err := s.redisClient.Get(ctx, key).Bytes()
+	switch {
+	case err == nil:
+		var cached model.UserDTO
+		if jsonErr := json.Unmarshal(raw, &cached); jsonErr == nil {
+			return &cached, nil
+		}
+		log.Printf("[WARN] discarding malformed profile cache entry for user=%s", userID)
+	case !errors.Is(err, redis.Nil):
+		log.Printf("[WARN] profile cache read failed for user=%s: %v", userID, err)
+	}
+
+	profile, err := s.repo.GetByIDEnriched(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if encoded, jsonErr := json.Marshal(profile); jsonErr == nil {
+		if setErr := s.redisClient.Set(ctx, key, encoded, profileCacheTTL).Err(); setErr != nil {
+			log.Printf("[WARN] profile cache write failed for user=%s: %v", userID, setErr)
+		}
+	}
+
+	return profile, nil
+}
+
+// InvalidateProfile drops the cached profile so the next read reflects
+// newly created or deleted jobs, companies and applications
+func (s *ProfileService) InvalidateProfile(ctx context.Context, userID string) error {
+	if s.redisClient == nil {
+		return nil
+	}
+	return s.redisClient.Del(ctx, profileCacheKey(userID)).Err()
+}
+
+// UpdateProfile changes the user's name and/or locale and returns the updated profile
+func (s *ProfileService) UpdateProfile(ctx context.Context, userID string, req *model.UpdateProfileRequest) (*model.UserDTO, error) {
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if utf8.RuneCountInString(name) > model.MaxNameLength {
+			return nil, model.ErrInvalidName
+		}
+		user.Name = name
+	}
+	if req.Locale != nil {
+		if !i18n.ValidateLocale(*req.Locale) {
+			return nil, model.ErrInvalidLocale
+		}
+		user.Locale = *req.Locale
+	}
+
+	if err := s.repo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if err := s.InvalidateProfile(ctx, userID); err != nil {
+		log.Printf("[WARN] profile cache invalidation failed for user=%s: %v", userID, err)
+	}
+	return s.GetProfile(ctx, userID)
+}
+
+// ChangePassword replaces the user's password once the current one is verified.
+// Users created through OAuth have no password, so this sets their first one.
+func (s *ProfileService) ChangePassword(ctx context.Context, userID string, req *model.ChangePasswordRequest) error {
+	if len(req.NewPassword) < model.MinPasswordLength || len(req.NewPassword) > model.MaxPasswordLength {
+		return model.ErrInvalidPassword
+	}
+
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.PasswordHash != "" {
+		if err := auth.VerifyPassword(req.CurrentPassword, user.PasswordHash); err != nil {
+			return model.ErrIncorrectPassword
+		}
+	}
+
+	passwordHash, err := auth.HashPassword(req.NewPassword)
+	if err != nil {
+		return err
+	}
+	return s.repo.UpdatePasswordHash(ctx, userID, passwordHash)
+}
@@ -0,0 +1,427 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/andreypavlenko/jobber/internal/platform/auth"
+	"github.com/andreypavlenko/jobber/modules/users/model"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MockUserRepository implements ports.UserRepository
+type MockUserRepository struct {
+	GetByIDFunc            func(ctx context.Context, userID string) (*model.User, error)
+	GetByIDEnrichedFunc    func(ctx context.Context, userID string) (*model.UserDTO, error)
+	UpdateFunc             func(ctx context.Context, user *model.User) error
+	UpdatePasswordHashFunc func(ctx context.Context, userID, hash string) error
+	EnrichedCalls          int
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, user *model.User) error { return nil }
+func (m *MockUserRepository) GetByID(ctx context.Context, userID string) (*model.User, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, userID)
+	}
+	return nil, nil
+}
+func (m *MockUserRepository) GetByIDEnriched(ctx context.Context, userID string) (*model.UserDTO, error) {
+	m.EnrichedCalls++
+	if m.GetByIDEnrichedFunc != nil {
+		return m.GetByIDEnrichedFunc(ctx, userID)
+	}
+	return nil, nil
+}
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*model.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) Update(ctx context.Context, user *model.User) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, user)
+	}
+	return nil
+}
+func (m *MockUserRepository) Delete(ctx context.Context, userID string) error           { return nil }
+func (m *MockUserRepository) SetEmailVerified(ctx context.Context, userID string) error { return nil }
+func (m *MockUserRepository) UpdatePasswordHash(ctx context.Context, userID, hash string) error {
+	if m.UpdatePasswordHashFunc != nil {
+		return m.UpdatePasswordHashFunc(ctx, userID, hash)
+	}
+	return nil
+}
+
+func newTestRedis(t *testing.T) (*redis.Client, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	return client, mr
+}
+
+func countingRepo(jobCount *int) *MockUserRepository {
+	return &MockUserRepository{
+		GetByIDEnrichedFunc: func(ctx context.Context, userID string) (*model.UserDTO, error) {
+			return &model.UserDTO{ID: userID, Email: "test@example.com", JobCount: *jobCount, CompanyCount: 2, ApplicationCount: 3}, nil
+		},
+	}
+}
+
+func TestProfileService_GetProfile(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("returns counts from repository", func(t *testing.T) {
+		client, _ := newTestRedis(t)
+		jobs := 5
+		svc := NewProfileService(countingRepo(&jobs), client)
+
+		profile, err := svc.GetProfile(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, profile.JobCount)
+		assert.Equal(t, 2, profile.CompanyCount)
+		assert.Equal(t, 3, profile.ApplicationCount)
+	})
+
+	t.Run("caches profile for one minute", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		jobs := 5
+		repo := countingRepo(&jobs)
+		svc := NewProfileService(repo, client)
+
+		_, err := svc.GetProfile(context.Background(), userID)
+		require.NoError(t, err)
+
+		jobs = 6
+		profile, err := svc.GetProfile(context.Background(), userID)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, repo.EnrichedCalls)
+		assert.Equal(t, 5, profile.JobCount)
+		assert.True(t, mr.Exists("user_profile:"+userID))
+		assert.Equal(t, time.Minute, mr.TTL("user_profile:"+userID))
+	})
+
+	t.Run("refetches after cache expires", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		jobs := 5
+		repo := countingRepo(&jobs)
+		svc := NewProfileService(repo, client)
+
+		_, err := svc.GetProfile(context.Background(), userID)
+		require.NoError(t, err)
+
+		jobs = 6
+		mr.FastForward(time.Minute + time.Second)
+		profile, err := svc.GetProfile(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, repo.EnrichedCalls)
+		assert.Equal(t, 6, profile.JobCount)
+	})
+
+	t.Run("falls back to repository when redis is down", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		jobs := 5
+		repo := countingRepo(&jobs)
+		svc := NewProfileService(repo, client)
+		mr.SetError("connection refused")
+
+		profile, err := svc.GetProfile(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, profile.JobCount)
+	})
+
+	t.Run("returns repository error", func(t *testing.T) {
+		client, _ := newTestRedis(t)
+		repo := &MockUserRepository{
+			GetByIDEnrichedFunc: func(ctx context.Context, userID string) (*model.UserDTO, error) {
+				return nil, model.ErrUserNotFound
+			},
+		}
+		svc := NewProfileService(repo, client)
+
+		profile, err := svc.GetProfile(context.Background(), userID)
+
+		assert.Nil(t, profile)
+		assert.ErrorIs(t, err, model.ErrUserNotFound)
+	})
+
+	t.Run("reads repository every time without redis", func(t *testing.T) {
+		jobs := 5
+		repo := countingRepo(&jobs)
+		svc := NewProfileService(repo, nil)
+
+		_, err := svc.GetProfile(context.Background(), userID)
+		require.NoError(t, err)
+		profile, err := svc.GetProfile(context.Background(), userID)
+		require.NoError(t, err)
+
+		assert.Equal(t, 5, profile.JobCount)
+		assert.Equal(t, 2, repo.EnrichedCalls)
+	})
+}
+
+func TestProfileService_InvalidateProfile(t *testing.T) {
+	userID := "user-123"
+
+	t.Run("next read reflects new counts", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		jobs := 5
+		repo := countingRepo(&jobs)
+		svc := NewProfileService(repo, client)
+
+		_, err := svc.GetProfile(context.Background(), userID)
+		require.NoError(t, err)
+
+		jobs = 6
+		require.NoError(t, svc.InvalidateProfile(context.Background(), userID))
+		assert.False(t, mr.Exists("user_profile:"+userID))
+
+		profile, err := svc.GetProfile(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, 6, profile.JobCount)
+		assert.Equal(t, 2, repo.EnrichedCalls)
+	})
+
+	t.Run("only drops the given user's entry", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		jobs := 5
+		svc := NewProfileService(countingRepo(&jobs), client)
+
+		_, err := svc.GetProfile(context.Background(), "user-a")
+		require.NoError(t, err)
+		_, err = svc.GetProfile(context.Background(), "user-b")
+		require.NoError(t, err)
+
+		require.NoError(t, svc.InvalidateProfile(context.Background(), "user-a"))
+
+		assert.False(t, mr.Exists("user_profile:user-a"))
+		assert.True(t, mr.Exists("user_profile:user-b"))
+	})
+
+	t.Run("returns redis error", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		svc := NewProfileService(&MockUserRepository{}, client)
+		mr.SetError("connection refused")
+
+		err := svc.InvalidateProfile(context.Background(), userID)
+
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, redis.Nil))
+	})
+
+	t.Run("is a no-op without redis", func(t *testing.T) {
+		svc := NewProfileService(&MockUserRepository{}, nil)
+
+		assert.NoError(t, svc.InvalidateProfile(context.Background(), userID))
+	})
+}
+
+func TestProfileService_UpdateProfile(t *testing.T) {
+	userID := "user-123"
+
+	newRepo := func(updated **model.User) *MockUserRepository {
+		return &MockUserRepository{
+			GetByIDFunc: func(ctx context.Context, uid string) (*model.User, error) {
+				return &model.User{ID: uid, Email: "test@example.com", Name: "Old Name", Locale: "en"}, nil
+			},
+			UpdateFunc: func(ctx context.Context, user *model.User) error {
+				*updated = user
+				return nil
+			},
+			GetByIDEnrichedFunc: func(ctx context.Context, uid string) (*model.UserDTO, error) {
+				dto := (*updated).ToDTO()
+				dto.JobCount = 2
+				return dto, nil
+			},
+		}
+	}
+
+	t.Run("updates name and locale", func(t *testing.T) {
+		var updated *model.User
+		svc := NewProfileService(newRepo(&updated), nil)
+
+		name, locale := "  Jane Doe  ", "es-MX"
+		profile, err := svc.UpdateProfile(context.Background(), userID, &model.UpdateProfileRequest{Name: &name, Locale: &locale})
+
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, "Jane Doe", updated.Name)
+		assert.Equal(t, "es-MX", updated.Locale)
+		assert.Equal(t, "Jane Doe", profile.Name)
+		assert.Equal(t, 2, profile.JobCount)
+	})
+
+	t.Run("leaves omitted fields unchanged", func(t *testing.T) {
+		var updated *model.User
+		svc := NewProfileService(newRepo(&updated), nil)
+
+		locale := "de"
+		_, err := svc.UpdateProfile(context.Background(), userID, &model.UpdateProfileRequest{Locale: &locale})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Old Name", updated.Name)
+		assert.Equal(t, "de", updated.Locale)
+	})
+
+	t.Run("drops the cached profile", func(t *testing.T) {
+		client, mr := newTestRedis(t)
+		require.NoError(t, mr.Set(profileCacheKey(userID), `{"id":"user-123","name":"Old Name"}`))
+		var updated *model.User
+		svc := NewProfileService(newRepo(&updated), client)
+
+		name := "Jane Doe"
+		profile, err := svc.UpdateProfile(context.Background(), userID, &model.UpdateProfileRequest{Name: &name})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Jane Doe", profile.Name)
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		longName := strings.Repeat("a", model.MaxNameLength+1)
+		badLocale := "xx-ZZ"
+		tests := []struct {
+			name      string
+			req       *model.UpdateProfileRequest
+			expectErr error
+		}{
+			{name: "name too long", req: &model.UpdateProfileRequest{Name: &longName}, expectErr: model.ErrInvalidName},
+			{name: "unsupported locale", req: &model.UpdateProfileRequest{Locale: &badLocale}, expectErr: model.ErrInvalidLocale},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var updated *model.User
+				svc := NewProfileService(newRepo(&updated), nil)
+
+				profile, err := svc.UpdateProfile(context.Background(), userID, tt.req)
+
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, profile)
+				assert.Nil(t, updated)
+			})
+		}
+	})
+
+	t.Run("returns not found for unknown user", func(t *testing.T) {
+		repo := &MockUserRepository{
+			GetByIDFunc: func(ctx context.Context, uid string) (*model.User, error) {
+				return nil, model.ErrUserNotFound
+			},
+		}
+		svc := NewProfileService(repo, nil)
+
+		name := "Jane"
+		_, err := svc.UpdateProfile(context.Background(), userID, &model.UpdateProfileRequest{Name: &name})
+
+		assert.ErrorIs(t, err, model.ErrUserNotFound)
+	})
+}
+
+func TestProfileService_ChangePassword(t *testing.T) {
+	userID := "user-123"
+	// MinCost keeps the fixture fast; VerifyPassword reads the cost from the hash
+	currentHash, err := bcrypt.GenerateFromPassword([]byte("old-password"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	newRepo := func(savedHash *string) *MockUserRepository {
+		return &MockUserRepository{
+			GetByIDFunc: func(ctx context.Context, uid string) (*model.User, error) {
+				return &model.User{ID: uid, PasswordHash: string(currentHash)}, nil
+			},
+			UpdatePasswordHashFunc: func(ctx context.Context, uid, hash string) error {
+				assert.Equal(t, userID, uid)
+				*savedHash = hash
+				return nil
+			},
+		}
+	}
+
+	t.Run("stores a hash of the new password", func(t *testing.T) {
+		var savedHash string
+		svc := NewProfileService(newRepo(&savedHash), nil)
+
+		err := svc.ChangePassword(context.Background(), userID, &model.ChangePasswordRequest{
+			CurrentPassword: "old-password",
+			NewPassword:     "new-password",
+		})
+
+		require.NoError(t, err)
+		require.NotEmpty(t, savedHash)
+		assert.NoError(t, auth.VerifyPassword("new-password", savedHash))
+	})
+
+	t.Run("rejects an incorrect current password", func(t *testing.T) {
+		var savedHash string
+		svc := NewProfileService(newRepo(&savedHash), nil)
+
+		err := svc.ChangePassword(context.Background(), userID, &model.ChangePasswordRequest{
+			CurrentPassword: "wrong-password",
+			NewPassword:     "new-password",
+		})
+
+		assert.ErrorIs(t, err, model.ErrIncorrectPassword)
+		assert.Empty(t, savedHash)
+	})
+
+	t.Run("sets a first password without the current one", func(t *testing.T) {
+		var savedHash string
+		repo := newRepo(&savedHash)
+		repo.GetByIDFunc = func(ctx context.Context, uid string) (*model.User, error) {
+			return &model.User{ID: uid, PasswordHash: ""}, nil
+		}
+		svc := NewProfileService(repo, nil)
+
+		err := svc.ChangePassword(context.Background(), userID, &model.ChangePasswordRequest{NewPassword: "new-password"})
+
+		require.NoError(t, err)
+		assert.NoError(t, auth.VerifyPassword("new-password", savedHash))
+	})
+
+	t.Run("requires the current password once one is set", func(t *testing.T) {
+		var savedHash string
+		svc := NewProfileService(newRepo(&savedHash), nil)
+
+		err := svc.ChangePassword(context.Background(), userID, &model.ChangePasswordRequest{NewPassword: "new-password"})
+
+		assert.ErrorIs(t, err, model.ErrIncorrectPassword)
+		assert.Empty(t, savedHash)
+	})
+
+	t.Run("enforces the password length", func(t *testing.T) {
+		for _, password := range []string{"short", strings.Repeat("a", model.MaxPasswordLength+1)} {
+			var savedHash string
+			svc := NewProfileService(newRepo(&savedHash), nil)
+
+			err := svc.ChangePassword(context.Background(), userID, &model.ChangePasswordRequest{
+				CurrentPassword: "old-password",
+				NewPassword:     password,
+			})
+
+			assert.ErrorIs(t, err, model.ErrInvalidPassword)
+			assert.Empty(t, savedHash)
+		}
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		repo := newRepo(new(string))
+		repo.UpdatePasswordHashFunc = func(ctx context.Context, uid, hash string) error {
+			return errors.New("database error")
+		}
+		svc := NewProfileService(repo, nil)
+
+		err := svc.ChangePassword(context.Background(), userID, &model.ChangePasswordRequest{
+			CurrentPassword: "old-password",
+			NewPassword:     "new-password",
+		})
+
+		assert.Error(t, err)
+	})
+}
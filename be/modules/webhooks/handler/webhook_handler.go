@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andreypavlenko/jobber/internal/platform/auth"
+	httpPlatform "github.com/andreypavlenko/jobber/internal/platform/http"
+	"github.com/andreypavlenko/jobber/modules/webhooks/model"
+	"github.com/andreypavlenko/jobber/modules/webhooks/service"
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookHandler handles webhook configuration HTTP requests
+type WebhookHandler struct {
+	service *service.WebhookService
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(service *service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{service: service}
+}
+
+// Create godoc
+// @Summary Create a webhook
+// @Description Register an https URL that receives signed event notifications. Deliveries carry an X-Jobber-Signature header with the HMAC-SHA256 of the body keyed by the secret; the secret is only returned here.
+// @Tags webhooks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body model.CreateWebhookRequest true "Webhook details"
+// @Success 201 {object} model.WebhookDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /webhooks [post]
+func (h *WebhookHandler) Create(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	var req model.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	webhook, err := h.service.Create(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusCreated, webhook)
+}
+
+// List godoc
+// @Summary List webhooks
+// @Description Get all webhooks of the authenticated user
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} model.WebhookDTO
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /webhooks [get]
+func (h *WebhookHandler) List(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	webhooks, err := h.service.List(c.Request.Context(), userID)
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, webhooks)
+}
+
+// Update godoc
+// @Summary Update a webhook
+// @Description Change a webhook's URL, events or secret, or pause it by setting active to false
+// @Tags webhooks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param request body model.UpdateWebhookRequest true "Fields to update"
+// @Success 200 {object} model.WebhookDTO
+// @Failure 400 {object} httpPlatform.ErrorResponse
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Webhook not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /webhooks/{id} [patch]
+func (h *WebhookHandler) Update(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	var req model.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpPlatform.RespondWithError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request payload")
+		return
+	}
+
+	webhook, err := h.service.Update(c.Request.Context(), userID, c.Param("id"), &req)
+	if err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, webhook)
+}
+
+// Delete godoc
+// @Summary Delete a webhook
+// @Description Delete a specific webhook by ID
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} httpPlatform.ErrorResponse
+// @Failure 404 {object} httpPlatform.ErrorResponse "Webhook not found"
+// @Failure 500 {object} httpPlatform.ErrorResponse
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	userID, ok := auth.MustGetUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), userID, c.Param("id")); err != nil {
+		h.respondWithError(c, err)
+		return
+	}
+
+	httpPlatform.RespondWithData(c, http.StatusOK, gin.H{"message": "Webhook deleted successfully"})
+}
+
+func (h *WebhookHandler) respondWithError(c *gin.Context, err error) {
+	errorCode := model.GetErrorCode(err)
+	statusCode := http.StatusInternalServerError
+	switch errorCode {
+	case model.CodeWebhookNotFound:
+		statusCode = http.StatusNotFound
+	case model.CodeInvalidWebhookURL:
+		statusCode = http.StatusBadRequest
+	}
+	httpPlatform.RespondWithError(c, statusCode, string(errorCode), model.GetErrorMessage(err, auth.GetLocale(c)))
+}
+
+// RegisterRoutes registers webhook configuration routes
+func (h *WebhookHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	webhooks := router.Group("/webhooks")
+	webhooks.Use(authMiddleware)
+	{
+		webhooks.POST("", h.Create)
+		webhooks.GET("", h.List)
+		webhooks.PATCH("/:id", h.Update)
+		webhooks.DELETE("/:id", h.Delete)
+	}
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andreypavlenko/jobber/modules/webhooks/model"
+	"github.com/andreypavlenko/jobber/modules/webhooks/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWebhookRepository implements ports.WebhookRepository
+type MockWebhookRepository struct {
+	GetByIDFunc func(ctx context.Context, userID, webhookID string) (*model.Webhook, error)
+	ListFunc    func(ctx context.Context, userID string) ([]*model.Webhook, error)
+}
+
+func (m *MockWebhookRepository) Create(ctx context.Context, webhook *model.Webhook) error {
+	webhook.ID = "webhook-1"
+	return nil
+}
+
+func (m *MockWebhookRepository) GetByID(ctx context.Context, userID, webhookID string) (*model.Webhook, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, userID, webhookID)
+	}
+	return nil, model.ErrWebhookNotFound
+}
+
+func (m *MockWebhookRepository) List(ctx context.Context, userID string) ([]*model.Webhook, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockWebhookRepository) Update(ctx context.Context, webhook *model.Webhook) error {
+	return nil
+}
+
+func (m *MockWebhookRepository) Delete(ctx context.Context, userID, webhookID string) error {
+	return model.ErrWebhookNotFound
+}
+
+func (m *MockWebhookRepository) ListActiveForEvent(ctx context.Context, userID, event string) ([]*model.Webhook, error) {
+	return nil, nil
+}
+
+func setupTestRouter(repo *MockWebhookRepository) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handler := NewWebhookHandler(service.NewWebhookService(repo))
+	handler.RegisterRoutes(router.Group("/api/v1"), func(c *gin.Context) {
+		c.Set("user_id", "user-123")
+		c.Next()
+	})
+	return router
+}
+
+func request(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestWebhookHandler_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantCode   string
+	}{
+		{"creates webhook", `{"url":"https://hooks.example.com/jobber","events":["application.status_changed"]}`, http.StatusCreated, ""},
+		{"rejects unknown event", `{"url":"https://hooks.example.com/jobber","events":["application.created"]}`, http.StatusBadRequest, "VALIDATION_ERROR"},
+		{"rejects missing events", `{"url":"https://hooks.example.com/jobber","events":[]}`, http.StatusBadRequest, "VALIDATION_ERROR"},
+		{"rejects malformed URL", `{"url":"not a url","events":["application.status_changed"]}`, http.StatusBadRequest, "VALIDATION_ERROR"},
+		{"rejects short secret", `{"url":"https://hooks.example.com/jobber","events":["application.status_changed"],"secret":"short"}`, http.StatusBadRequest, "VALIDATION_ERROR"},
+		{"rejects private URL", `{"url":"https://127.0.0.1/hook","events":["application.status_changed"]}`, http.StatusBadRequest, "INVALID_WEBHOOK_URL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := request(setupTestRouter(&MockWebhookRepository{}), http.MethodPost, "/api/v1/webhooks", tt.body)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantCode != "" {
+				assert.Contains(t, w.Body.String(), tt.wantCode)
+			}
+		})
+	}
+
+	t.Run("returns the secret on create", func(t *testing.T) {
+		w := request(setupTestRouter(&MockWebhookRepository{}), http.MethodPost, "/api/v1/webhooks",
+			`{"url":"https://hooks.example.com/jobber","events":["application.status_changed"],"secret":"0123456789abcdef"}`)
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		var dto model.WebhookDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &dto))
+		assert.Equal(t, "webhook-1", dto.ID)
+		assert.Equal(t, "0123456789abcdef", dto.Secret)
+		assert.True(t, dto.Active)
+	})
+}
+
+func TestWebhookHandler_List(t *testing.T) {
+	repo := &MockWebhookRepository{ListFunc: func(ctx context.Context, userID string) ([]*model.Webhook, error) {
+		assert.Equal(t, "user-123", userID)
+		return []*model.Webhook{{ID: "webhook-1", URL: "https://hooks.example.com/jobber", Secret: "hidden-secret"}}, nil
+	}}
+
+	w := request(setupTestRouter(repo), http.MethodGet, "/api/v1/webhooks", "")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "webhook-1")
+	assert.NotContains(t, w.Body.String(), "hidden-secret")
+}
+
+func TestWebhookHandler_Update(t *testing.T) {
+	t.Run("pauses a webhook", func(t *testing.T) {
+		repo := &MockWebhookRepository{GetByIDFunc: func(ctx context.Context, userID, webhookID string) (*model.Webhook, error) {
+			return &model.Webhook{ID: webhookID, UserID: userID, URL: "https://hooks.example.com/jobber", Active: true}, nil
+		}}
+
+		w := request(setupTestRouter(repo), http.MethodPatch, "/api/v1/webhooks/webhook-1", `{"active":false}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"active":false`)
+	})
+
+	t.Run("returns 404 for unknown webhook", func(t *testing.T) {
+		w := request(setupTestRouter(&MockWebhookRepository{}), http.MethodPatch, "/api/v1/webhooks/missing", `{"active":false}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "WEBHOOK_NOT_FOUND")
+	})
+}
+
+func TestWebhookHandler_Delete(t *testing.T) {
+	w := request(setupTestRouter(&MockWebhookRepository{}), http.MethodDelete, "/api/v1/webhooks/missing", "")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"github.com/andreypavlenko/jobber/internal/platform/domainerr"
+	"github.com/andreypavlenko/jobber/internal/platform/i18n"
+)
+
+var (
+	// ErrWebhookNotFound is returned when a webhook is not found
+	ErrWebhookNotFound = &DomainError{Code: CodeWebhookNotFound, Message: "webhook not found"}
+
+	// ErrInvalidWebhookURL is returned when the URL is not a public https URL
+	ErrInvalidWebhookURL = &DomainError{Code: CodeInvalidWebhookURL, Message: "invalid webhook URL"}
+)
+
+// ErrorCode represents error codes
+type ErrorCode string
+
+const (
+	CodeWebhookNotFound   ErrorCode = "WEBHOOK_NOT_FOUND"
+	CodeInvalidWebhookURL ErrorCode = "INVALID_WEBHOOK_URL"
+	CodeInternalError     ErrorCode = "INTERNAL_ERROR"
+)
+
+// DomainError is a domain error that carries its API error code
+type DomainError = domainerr.Error[ErrorCode]
+
+// GetErrorCode maps errors to error codes
+func GetErrorCode(err error) ErrorCode {
+	return domainerr.CodeOf(err, CodeInternalError)
+}
+
+// GetErrorMessage returns a user-friendly error message in the given locale
+func GetErrorMessage(err error, locale string) string {
+	return i18n.Translate(locale, string(GetErrorCode(err)))
+}
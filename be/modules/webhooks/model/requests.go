@@ -0,0 +1,18 @@
+package model
+
+// CreateWebhookRequest represents a create webhook request.
+// A secret is generated when none is given.
+type CreateWebhookRequest struct {
+	URL    string   `json:"url" binding:"required,url,max=2048"`
+	Events []string `json:"events" binding:"required,min=1,dive,oneof=application.status_changed"`
+	Secret string   `json:"secret,omitempty" binding:"omitempty,min=16,max=255"`
+	Active *bool    `json:"active,omitempty"`
+}
+
+// UpdateWebhookRequest represents an update webhook request
+type UpdateWebhookRequest struct {
+	URL    *string  `json:"url,omitempty" binding:"omitempty,url,max=2048"`
+	Events []string `json:"events,omitempty" binding:"omitempty,min=1,dive,oneof=application.status_changed"`
+	Secret *string  `json:"secret,omitempty" binding:"omitempty,min=16,max=255"`
+	Active *bool    `json:"active,omitempty"`
+}
@@ -0,0 +1,51 @@
+package model
+
+import "time"
+
+// EventApplicationStatusChanged is sent when an application moves to another status
+const EventApplicationStatusChanged = "application.status_changed"
+
+// Webhook is a user-configured URL that receives signed event notifications
+type Webhook struct {
+	ID        string
+	UserID    string
+	URL       string
+	Secret    string
+	Events    []string
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// WebhookDTO represents webhook data transfer object.
+// The secret is only returned when the webhook is created.
+type WebhookDTO struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToDTO converts Webhook to WebhookDTO without the secret
+func (w *Webhook) ToDTO() *WebhookDTO {
+	return &WebhookDTO{
+		ID:        w.ID,
+		URL:       w.URL,
+		Events:    w.Events,
+		Active:    w.Active,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+// StatusChangedPayload is the body delivered for application.status_changed
+type StatusChangedPayload struct {
+	Event         string    `json:"event"`
+	ApplicationID string    `json:"application_id"`
+	OldStatus     string    `json:"old_status"`
+	NewStatus     string    `json:"new_status"`
+	Timestamp     time.Time `json:"timestamp"`
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/andreypavlenko/jobber/modules/webhooks/model"
+)
+
+// WebhookRepository defines the interface for webhook data access
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *model.Webhook) error
+	GetByID(ctx context.Context, userID, webhookID string) (*model.Webhook, error)
+	List(ctx context.Context, userID string) ([]*model.Webhook, error)
+	Update(ctx context.Context, webhook *model.Webhook) error
+	Delete(ctx context.Context, userID, webhookID string) error
+	// ListActiveForEvent returns the user's active webhooks subscribed to the event
+	ListActiveForEvent(ctx context.Context, userID, event string) ([]*model.Webhook, error)
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/webhooks/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DBPool defines the interface for database operations used by the repository
+type DBPool interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// WebhookRepository implements ports.WebhookRepository
+type WebhookRepository struct {
+	pool DBPool
+}
+
+// NewWebhookRepository creates a new webhook repository
+func NewWebhookRepository(pool *pgxpool.Pool) *WebhookRepository {
+	return &WebhookRepository{pool: pool}
+}
+
+// NewWebhookRepositoryWithPool creates a repository with a custom pool (for testing)
+func NewWebhookRepositoryWithPool(pool DBPool) *WebhookRepository {
+	return &WebhookRepository{pool: pool}
+}
+
+const webhookColumns = `id, user_id, url, secret, events, active, created_at, updated_at`
+
+func scanWebhook(row pgx.Row) (*model.Webhook, error) {
+	webhook := &model.Webhook{}
+	err := row.Scan(
+		&webhook.ID, &webhook.UserID, &webhook.URL, &webhook.Secret,
+		&webhook.Events, &webhook.Active, &webhook.CreatedAt, &webhook.UpdatedAt,
+	)
+	return webhook, err
+}
+
+// Create creates a new webhook
+func (r *WebhookRepository) Create(ctx context.Context, webhook *model.Webhook) error {
+	query := `
+		INSERT INTO webhooks (id, user_id, url, secret, events, active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	webhook.ID = uuid.New().String()
+	webhook.CreatedAt = time.Now().UTC()
+	webhook.UpdatedAt = webhook.CreatedAt
+
+	_, err := r.pool.Exec(ctx, query,
+		webhook.ID, webhook.UserID, webhook.URL, webhook.Secret,
+		webhook.Events, webhook.Active, webhook.CreatedAt, webhook.UpdatedAt,
+	)
+	return err
+}
+
+// GetByID retrieves a webhook by ID
+func (r *WebhookRepository) GetByID(ctx context.Context, userID, webhookID string) (*model.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE id = $1 AND user_id = $2`
+
+	webhook, err := scanWebhook(r.pool.QueryRow(ctx, query, webhookID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// List retrieves all webhooks for a user, newest first
+func (r *WebhookRepository) List(ctx context.Context, userID string) ([]*model.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE user_id = $1 ORDER BY created_at DESC`
+	return r.query(ctx, query, userID)
+}
+
+// ListActiveForEvent retrieves the user's active webhooks subscribed to the event
+func (r *WebhookRepository) ListActiveForEvent(ctx context.Context, userID, event string) ([]*model.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE user_id = $1 AND active AND $2 = ANY(events)`
+	return r.query(ctx, query, userID, event)
+}
+
+func (r *WebhookRepository) query(ctx context.Context, query string, args ...any) ([]*model.Webhook, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []*model.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+// Update updates a webhook's URL, secret, events and active flag
+func (r *WebhookRepository) Update(ctx context.Context, webhook *model.Webhook) error {
+	query := `
+		UPDATE webhooks SET url = $3, secret = $4, events = $5, active = $6, updated_at = $7
+		WHERE id = $1 AND user_id = $2
+	`
+
+	webhook.UpdatedAt = time.Now().UTC()
+	result, err := r.pool.Exec(ctx, query,
+		webhook.ID, webhook.UserID, webhook.URL, webhook.Secret, webhook.Events, webhook.Active, webhook.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrWebhookNotFound
+	}
+	return nil
+}
+
+// Delete deletes a webhook
+func (r *WebhookRepository) Delete(ctx context.Context, userID, webhookID string) error {
+	query := `DELETE FROM webhooks WHERE id = $1 AND user_id = $2`
+	result, err := r.pool.Exec(ctx, query, webhookID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrWebhookNotFound
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/webhooks/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var webhookRowColumns = []string{"id", "user_id", "url", "secret", "events", "active", "created_at", "updated_at"}
+
+func TestWebhookRepository_Create(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	events := []string{"application.created"}
+	mock.ExpectExec("INSERT INTO webhooks").
+		WithArgs(pgxmock.AnyArg(), "user-1", "https://example.com/hook", "secret", events, true, pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	webhook := &model.Webhook{UserID: "user-1", URL: "https://example.com/hook", Secret: "secret", Events: events, Active: true}
+	err = NewWebhookRepositoryWithPool(mock).Create(context.Background(), webhook)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, webhook.ID)
+	assert.Equal(t, webhook.CreatedAt, webhook.UpdatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhookRepository_GetByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(q *pgxmock.ExpectedQuery)
+		wantErr error
+	}{
+		{
+			name: "returns the user's webhook",
+			setup: func(q *pgxmock.ExpectedQuery) {
+				q.WillReturnRows(pgxmock.NewRows(webhookRowColumns).
+					AddRow("hook-1", "user-1", "https://example.com/hook", "secret", []string{"application.created"}, true, time.Now(), time.Now()))
+			},
+		},
+		{
+			name:    "maps missing rows to ErrWebhookNotFound",
+			setup:   func(q *pgxmock.ExpectedQuery) { q.WillReturnError(pgx.ErrNoRows) },
+			wantErr: model.ErrWebhookNotFound,
+		},
+		{
+			name:    "propagates query errors",
+			setup:   func(q *pgxmock.ExpectedQuery) { q.WillReturnError(assert.AnError) },
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setup(mock.ExpectQuery(`FROM webhooks WHERE id = \$1 AND user_id = \$2`).WithArgs("hook-1", "user-1"))
+
+			webhook, err := NewWebhookRepositoryWithPool(mock).GetByID(context.Background(), "user-1", "hook-1")
+
+			if tt.wantErr != nil {
+				assert.Nil(t, webhook)
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, []string{"application.created"}, webhook.Events)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWebhookRepository_List(t *testing.T) {
+	t.Run("returns webhooks newest first", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`FROM webhooks WHERE user_id = \$1 ORDER BY created_at DESC`).
+			WithArgs("user-1").
+			WillReturnRows(pgxmock.NewRows(webhookRowColumns).
+				AddRow("hook-2", "user-1", "https://example.com/b", "s", []string{}, false, now, now).
+				AddRow("hook-1", "user-1", "https://example.com/a", "s", []string{}, true, now, now))
+
+		webhooks, err := NewWebhookRepositoryWithPool(mock).List(context.Background(), "user-1")
+
+		require.NoError(t, err)
+		require.Len(t, webhooks, 2)
+		assert.Equal(t, "hook-2", webhooks[0].ID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("propagates query errors", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery("FROM webhooks").
+			WithArgs("user-1").
+			WillReturnError(assert.AnError)
+
+		webhooks, err := NewWebhookRepositoryWithPool(mock).List(context.Background(), "user-1")
+
+		assert.Nil(t, webhooks)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestWebhookRepository_ListActiveForEvent(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`FROM webhooks WHERE user_id = \$1 AND active AND \$2 = ANY\(events\)`).
+		WithArgs("user-1", "application.created").
+		WillReturnRows(pgxmock.NewRows(webhookRowColumns).
+			AddRow("hook-1", "user-1", "https://example.com/a", "s", []string{"application.created"}, true, time.Now(), time.Now()))
+
+	webhooks, err := NewWebhookRepositoryWithPool(mock).ListActiveForEvent(context.Background(), "user-1", "application.created")
+
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.True(t, webhooks[0].Active)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhookRepository_Update(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	events := []string{"application.created"}
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`UPDATE webhooks SET url = \$3, secret = \$4, events = \$5, active = \$6, updated_at = \$7\s+WHERE id = \$1 AND user_id = \$2`).
+			WithArgs("hook-1", "user-1", "https://example.com/hook", "secret", events, false, pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", result))
+	}
+	mock.ExpectExec("UPDATE webhooks").
+		WithArgs("hook-1", "user-1", "https://example.com/hook", "secret", events, false, pgxmock.AnyArg()).
+		WillReturnError(assert.AnError)
+
+	webhook := &model.Webhook{ID: "hook-1", UserID: "user-1", URL: "https://example.com/hook", Secret: "secret", Events: events}
+	repo := NewWebhookRepositoryWithPool(mock)
+	require.NoError(t, repo.Update(context.Background(), webhook))
+	assert.False(t, webhook.UpdatedAt.IsZero())
+	assert.ErrorIs(t, repo.Update(context.Background(), webhook), model.ErrWebhookNotFound)
+	assert.ErrorIs(t, repo.Update(context.Background(), webhook), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhookRepository_Delete(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(`DELETE FROM webhooks WHERE id = \$1 AND user_id = \$2`).
+			WithArgs("hook-1", "user-1").
+			WillReturnResult(pgxmock.NewResult("DELETE", result))
+	}
+	mock.ExpectExec("DELETE FROM webhooks").
+		WithArgs("hook-1", "user-1").
+		WillReturnError(assert.AnError)
+
+	repo := NewWebhookRepositoryWithPool(mock)
+	assert.NoError(t, repo.Delete(context.Background(), "user-1", "hook-1"))
+	assert.ErrorIs(t, repo.Delete(context.Background(), "user-1", "hook-1"), model.ErrWebhookNotFound)
+	assert.ErrorIs(t, repo.Delete(context.Background(), "user-1", "hook-1"), assert.AnError)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/andreypavlenko/jobber/internal/platform/logger"
+	"github.com/andreypavlenko/jobber/modules/webhooks/model"
+	"github.com/andreypavlenko/jobber/modules/webhooks/ports"
+	"go.uber.org/zap"
+)
+
+const (
+	// MaxDeliveryAttempts is how often a delivery is tried before it is dropped
+	MaxDeliveryAttempts = 3
+	// DefaultRetryBackoff is the wait before the first retry; it doubles after each failure
+	DefaultRetryBackoff = 2 * time.Second
+
+	deliveryTimeout = 10 * time.Second
+
+	// SignatureHeader carries "sha256=" and the hex HMAC-SHA256 of the body keyed by the webhook secret
+	SignatureHeader = "X-Jobber-Signature"
+	// EventHeader carries the event name
+	EventHeader = "X-Jobber-Event"
+)
+
+var errPrivateAddress = errors.New("webhook host resolves to a private address")
+
+// isPrivateIP reports whether ip is loopback, private, link-local or unspecified
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsUnspecified()
+}
+
+// publicOnlyClient refuses connections to private addresses, so a hostname
+// re-pointed after validation cannot reach internal services
+func publicOnlyClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: deliveryTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivateIP(ip) {
+				return errPrivateAddress
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout:   deliveryTimeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: deliveryTimeout},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Sign returns the signature header value for body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Dispatcher delivers webhook events in the background. Each delivery is
+// tried up to MaxDeliveryAttempts times with exponential backoff.
+type Dispatcher struct {
+	repo    ports.WebhookRepository
+	client  *http.Client
+	log     *logger.Logger
+	backoff time.Duration
+	now     func() time.Time
+	wg      sync.WaitGroup
+}
+
+// NewDispatcher creates a dispatcher that only connects to public addresses
+func NewDispatcher(repo ports.WebhookRepository, log *logger.Logger) *Dispatcher {
+	if log == nil {
+		log = &logger.Logger{Logger: zap.NewNop()}
+	}
+	return &Dispatcher{
+		repo:    repo,
+		client:  publicOnlyClient(),
+		log:     log,
+		backoff: DefaultRetryBackoff,
+		now:     time.Now,
+	}
+}
+
+// NotifyStatusChanged queues application.status_changed deliveries to the
+// user's webhooks and returns without waiting for them
+func (d *Dispatcher) NotifyStatusChanged(_ context.Context, userID, appID, oldStatus, newStatus string) {
+	payload := model.StatusChangedPayload{
+		Event:         model.EventApplicationStatusChanged,
+		ApplicationID: appID,
+		OldStatus:     oldStatus,
+		NewStatus:     newStatus,
+		Timestamp:     d.now().UTC(),
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.dispatch(context.Background(), userID, payload.Event, payload)
+	}()
+}
+
+// Wait blocks until every queued delivery has finished
+func (d *Dispatcher) Wait() {
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context, userID, event string, payload any) {
+	webhooks, err := d.repo.ListActiveForEvent(ctx, userID, event)
+	if err != nil {
+		d.log.Error("failed to load webhooks", zap.String("user_id", userID), zap.String("event", event), zap.Error(err))
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		d.log.Error("failed to encode webhook payload", zap.String("event", event), zap.Error(err))
+		return
+	}
+
+	for _, webhook := range webhooks {
+		d.wg.Add(1)
+		go func(webhook *model.Webhook) {
+			defer d.wg.Done()
+			d.deliver(ctx, webhook, event, body)
+		}(webhook)
+	}
+}
+
+// deliver posts body to the webhook, retrying failed attempts
+func (d *Dispatcher) deliver(ctx context.Context, webhook *model.Webhook, event string, body []byte) {
+	wait := d.backoff
+	for attempt := 1; ; attempt++ {
+		err := d.send(ctx, webhook, event, body)
+		if err == nil {
+			return
+		}
+		if attempt == MaxDeliveryAttempts {
+			d.log.Error("webhook delivery failed",
+				zap.String("webhook_id", webhook.ID), zap.String("event", event), zap.Int("attempts", attempt), zap.Error(err))
+			return
+		}
+		d.log.Warn("webhook delivery attempt failed, retrying",
+			zap.String("webhook_id", webhook.ID), zap.Int("attempt", attempt), zap.Duration("backoff", wait), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, webhook *model.Webhook, event string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event)
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
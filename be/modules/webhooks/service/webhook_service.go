@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/andreypavlenko/jobber/modules/webhooks/model"
+	"github.com/andreypavlenko/jobber/modules/webhooks/ports"
+)
+
+// WebhookService handles webhook configuration
+type WebhookService struct {
+	repo ports.WebhookRepository
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(repo ports.WebhookRepository) *WebhookService {
+	return &WebhookService{repo: repo}
+}
+
+// Create creates a webhook and returns it with its secret, which is not shown again
+func (s *WebhookService) Create(ctx context.Context, userID string, req *model.CreateWebhookRequest) (*model.WebhookDTO, error) {
+	if err := validateWebhookURL(req.URL); err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		generated, err := generateSecret()
+		if err != nil {
+			return nil, err
+		}
+		secret = generated
+	}
+
+	webhook := &model.Webhook{
+		UserID: userID,
+		URL:    req.URL,
+		Secret: secret,
+		Events: req.Events,
+		Active: req.Active == nil || *req.Active,
+	}
+	if err := s.repo.Create(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	dto := webhook.ToDTO()
+	dto.Secret = webhook.Secret
+	return dto, nil
+}
+
+// List lists all webhooks for a user
+func (s *WebhookService) List(ctx context.Context, userID string) ([]*model.WebhookDTO, error) {
+	webhooks, err := s.repo.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*model.WebhookDTO, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		dtos = append(dtos, webhook.ToDTO())
+	}
+	return dtos, nil
+}
+
+// Update updates a webhook's URL, events, secret or active flag
+func (s *WebhookService) Update(ctx context.Context, userID, webhookID string, req *model.UpdateWebhookRequest) (*model.WebhookDTO, error) {
+	webhook, err := s.repo.GetByID(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := validateWebhookURL(*req.URL); err != nil {
+			return nil, err
+		}
+		webhook.URL = *req.URL
+	}
+	if req.Events != nil {
+		webhook.Events = req.Events
+	}
+	if req.Secret != nil {
+		webhook.Secret = *req.Secret
+	}
+	if req.Active != nil {
+		webhook.Active = *req.Active
+	}
+
+	if err := s.repo.Update(ctx, webhook); err != nil {
+		return nil, err
+	}
+	return webhook.ToDTO(), nil
+}
+
+// Delete deletes a webhook
+func (s *WebhookService) Delete(ctx context.Context, userID, webhookID string) error {
+	return s.repo.Delete(ctx, userID, webhookID)
+}
+
+// validateWebhookURL accepts https URLs whose host is not local or a private IP.
+// Hostnames are resolved again on every delivery, which blocks private addresses.
+func validateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Scheme != "https" {
+		return model.ErrInvalidWebhookURL
+	}
+
+	hostname := parsed.Hostname()
+	if hostname == "" || strings.EqualFold(hostname, "localhost") {
+		return model.ErrInvalidWebhookURL
+	}
+	if ip := net.ParseIP(hostname); ip != nil && isPrivateIP(ip) {
+		return model.ErrInvalidWebhookURL
+	}
+	return nil
+}
+
+func generateSecret() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(raw), nil
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/andreypavlenko/jobber/modules/webhooks/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWebhookRepository implements ports.WebhookRepository
+type MockWebhookRepository struct {
+	CreateFunc             func(ctx context.Context, webhook *model.Webhook) error
+	GetByIDFunc            func(ctx context.Context, userID, webhookID string) (*model.Webhook, error)
+	ListFunc               func(ctx context.Context, userID string) ([]*model.Webhook, error)
+	UpdateFunc             func(ctx context.Context, webhook *model.Webhook) error
+	DeleteFunc             func(ctx context.Context, userID, webhookID string) error
+	ListActiveForEventFunc func(ctx context.Context, userID, event string) ([]*model.Webhook, error)
+}
+
+func (m *MockWebhookRepository) Create(ctx context.Context, webhook *model.Webhook) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, webhook)
+	}
+	webhook.ID = "webhook-1"
+	return nil
+}
+
+func (m *MockWebhookRepository) GetByID(ctx context.Context, userID, webhookID string) (*model.Webhook, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, userID, webhookID)
+	}
+	return nil, model.ErrWebhookNotFound
+}
+
+func (m *MockWebhookRepository) List(ctx context.Context, userID string) ([]*model.Webhook, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockWebhookRepository) Update(ctx context.Context, webhook *model.Webhook) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, webhook)
+	}
+	return nil
+}
+
+func (m *MockWebhookRepository) Delete(ctx context.Context, userID, webhookID string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID, webhookID)
+	}
+	return nil
+}
+
+func (m *MockWebhookRepository) ListActiveForEvent(ctx context.Context, userID, event string) ([]*model.Webhook, error) {
+	if m.ListActiveForEventFunc != nil {
+		return m.ListActiveForEventFunc(ctx, userID, event)
+	}
+	return nil, nil
+}
+
+func TestWebhookService_Create(t *testing.T) {
+	events := []string{model.EventApplicationStatusChanged}
+
+	t.Run("generates a secret and returns it once", func(t *testing.T) {
+		var created *model.Webhook
+		repo := &MockWebhookRepository{CreateFunc: func(ctx context.Context, webhook *model.Webhook) error {
+			created = webhook
+			return nil
+		}}
+		svc := NewWebhookService(repo)
+
+		dto, err := svc.Create(context.Background(), "user-123", &model.CreateWebhookRequest{URL: "https://hooks.example.com/jobber", Events: events})
+
+		require.NoError(t, err)
+		require.NotNil(t, created)
+		assert.Equal(t, "user-123", created.UserID)
+		assert.True(t, created.Active)
+		assert.Len(t, created.Secret, 64)
+		assert.Equal(t, created.Secret, dto.Secret)
+		assert.Empty(t, created.ToDTO().Secret)
+	})
+
+	t.Run("keeps a given secret and active flag", func(t *testing.T) {
+		inactive := false
+		svc := NewWebhookService(&MockWebhookRepository{})
+
+		dto, err := svc.Create(context.Background(), "user-123", &model.CreateWebhookRequest{
+			URL: "https://hooks.example.com/jobber", Events: events, Secret: "a-secret-of-my-own", Active: &inactive,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "a-secret-of-my-own", dto.Secret)
+		assert.False(t, dto.Active)
+	})
+
+	for _, url := range []string{
+		"http://hooks.example.com/jobber",
+		"https://localhost/hook",
+		"https://127.0.0.1/hook",
+		"https://10.0.0.5/hook",
+		"https://169.254.169.254/latest/meta-data",
+		"https://[::1]/hook",
+	} {
+		t.Run("rejects "+url, func(t *testing.T) {
+			repo := &MockWebhookRepository{CreateFunc: func(ctx context.Context, webhook *model.Webhook) error {
+				t.Fatal("webhook should not be created")
+				return nil
+			}}
+			svc := NewWebhookService(repo)
+
+			dto, err := svc.Create(context.Background(), "user-123", &model.CreateWebhookRequest{URL: url, Events: events})
+
+			assert.Nil(t, dto)
+			assert.ErrorIs(t, err, model.ErrInvalidWebhookURL)
+		})
+	}
+}
+
+func TestWebhookService_Update(t *testing.T) {
+	existing := func() *model.Webhook {
+		return &model.Webhook{
+			ID: "webhook-1", UserID: "user-123", URL: "https://hooks.example.com/a", Secret: "old-secret-value",
+			Events: []string{model.EventApplicationStatusChanged}, Active: true,
+		}
+	}
+
+	t.Run("applies only the given fields", func(t *testing.T) {
+		var updated *model.Webhook
+		repo := &MockWebhookRepository{
+			GetByIDFunc: func(ctx context.Context, userID, webhookID string) (*model.Webhook, error) {
+				return existing(), nil
+			},
+			UpdateFunc: func(ctx context.Context, webhook *model.Webhook) error {
+				updated = webhook
+				return nil
+			},
+		}
+		svc := NewWebhookService(repo)
+		inactive := false
+
+		dto, err := svc.Update(context.Background(), "user-123", "webhook-1", &model.UpdateWebhookRequest{Active: &inactive})
+
+		require.NoError(t, err)
+		assert.False(t, updated.Active)
+		assert.Equal(t, "https://hooks.example.com/a", updated.URL)
+		assert.Equal(t, "old-secret-value", updated.Secret)
+		assert.Empty(t, dto.Secret)
+	})
+
+	t.Run("rejects a private URL", func(t *testing.T) {
+		repo := &MockWebhookRepository{
+			GetByIDFunc: func(ctx context.Context, userID, webhookID string) (*model.Webhook, error) {
+				return existing(), nil
+			},
+			UpdateFunc: func(ctx context.Context, webhook *model.Webhook) error {
+				t.Fatal("webhook should not be updated")
+				return nil
+			},
+		}
+		svc := NewWebhookService(repo)
+		url := "https://192.168.1.10/hook"
+
+		_, err := svc.Update(context.Background(), "user-123", "webhook-1", &model.UpdateWebhookRequest{URL: &url})
+
+		assert.ErrorIs(t, err, model.ErrInvalidWebhookURL)
+	})
+
+	t.Run("returns not found", func(t *testing.T) {
+		svc := NewWebhookService(&MockWebhookRepository{})
+
+		_, err := svc.Update(context.Background(), "user-123", "missing", &model.UpdateWebhookRequest{})
+
+		assert.ErrorIs(t, err, model.ErrWebhookNotFound)
+	})
+}
+
+// newTestDispatcher delivers to test servers on loopback without waiting between retries
+func newTestDispatcher(repo *MockWebhookRepository, client *http.Client) *Dispatcher {
+	d := NewDispatcher(repo, nil)
+	d.client = client
+	d.backoff = time.Millisecond
+	d.now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) }
+	return d
+}
+
+func TestDispatcher_NotifyStatusChanged(t *testing.T) {
+	t.Run("posts a signed payload to each active webhook", func(t *testing.T) {
+		var mu sync.Mutex
+		var bodies [][]byte
+		var signatures []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			defer mu.Unlock()
+			bodies = append(bodies, body)
+			signatures = append(signatures, r.Header.Get(SignatureHeader))
+			assert.Equal(t, model.EventApplicationStatusChanged, r.Header.Get(EventHeader))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		}))
+		defer server.Close()
+
+		repo := &MockWebhookRepository{ListActiveForEventFunc: func(ctx context.Context, userID, event string) ([]*model.Webhook, error) {
+			assert.Equal(t, "user-123", userID)
+			assert.Equal(t, model.EventApplicationStatusChanged, event)
+			return []*model.Webhook{{ID: "webhook-1", URL: server.URL, Secret: "top-secret-value"}}, nil
+		}}
+		d := newTestDispatcher(repo, server.Client())
+
+		d.NotifyStatusChanged(context.Background(), "user-123", "app-1", "active", "offer")
+		d.Wait()
+
+		require.Len(t, bodies, 1)
+		var payload map[string]string
+		require.NoError(t, json.Unmarshal(bodies[0], &payload))
+		assert.Equal(t, map[string]string{
+			"event":          "application.status_changed",
+			"application_id": "app-1",
+			"old_status":     "active",
+			"new_status":     "offer",
+			"timestamp":      "2026-03-01T12:00:00Z",
+		}, payload)
+		assert.Equal(t, Sign("top-secret-value", bodies[0]), signatures[0])
+	})
+
+	t.Run("retries failed deliveries up to the attempt limit", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		repo := &MockWebhookRepository{ListActiveForEventFunc: func(ctx context.Context, userID, event string) ([]*model.Webhook, error) {
+			return []*model.Webhook{{ID: "webhook-1", URL: server.URL, Secret: "s"}}, nil
+		}}
+		d := newTestDispatcher(repo, server.Client())
+
+		d.NotifyStatusChanged(context.Background(), "user-123", "app-1", "active", "rejected")
+		d.Wait()
+
+		assert.Equal(t, int32(MaxDeliveryAttempts), attempts.Load())
+	})
+
+	t.Run("stops retrying after a successful attempt", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if attempts.Add(1) == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+			}
+		}))
+		defer server.Close()
+
+		repo := &MockWebhookRepository{ListActiveForEventFunc: func(ctx context.Context, userID, event string) ([]*model.Webhook, error) {
+			return []*model.Webhook{{ID: "webhook-1", URL: server.URL, Secret: "s"}}, nil
+		}}
+		d := newTestDispatcher(repo, server.Client())
+
+		d.NotifyStatusChanged(context.Background(), "user-123", "app-1", "active", "rejected")
+		d.Wait()
+
+		assert.Equal(t, int32(2), attempts.Load())
+	})
+
+	t.Run("does nothing when loading webhooks fails", func(t *testing.T) {
+		repo := &MockWebhookRepository{ListActiveForEventFunc: func(ctx context.Context, userID, event string) ([]*model.Webhook, error) {
+			return nil, errors.New("database error")
+		}}
+		d := newTestDispatcher(repo, http.DefaultClient)
+
+		d.NotifyStatusChanged(context.Background(), "user-123", "app-1", "active", "offer")
+		d.Wait()
+	})
+}
+
+func TestPublicOnlyClient_RefusesPrivateAddresses(t *testing.T) {
+	var called atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called.Store(true)
+	}))
+	defer server.Close()
+
+	_, err := publicOnlyClient().Get(server.URL)
+
+	assert.ErrorIs(t, err, errPrivateAddress)
+	assert.False(t, called.Load())
+}
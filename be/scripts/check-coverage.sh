@@ -0,0 +1,120 @@
+#!/usr/bin/env bash
+#
+# check-coverage.sh - enforce minimum test coverage from a Go cover profile
+#
+# Usage: scripts/check-coverage.sh [coverage.out] [coverage.conf]
+#
+# The total is read from `go tool cover -func`. Per-package percentages are
+# computed from the statement counts in the profile. The config file lists
+# "<package> <min%>" pairs, one per line, where <package> is relative to the
+# module root (e.g. modules/jobs/service) and the special key "total" sets the
+# overall minimum (70% when omitted). Blank lines and # comments are ignored.
+#
+# Exits 1 when any threshold is violated, 2 on missing input.
+
+set -euo pipefail
+
+PROFILE="${1:-coverage.out}"
+CONFIG="${2:-coverage.conf}"
+TOTAL_MIN=70
+
+if [ ! -f "$PROFILE" ]; then
+    echo "Coverage profile not found: $PROFILE (run: make coverage)" >&2
+    exit 2
+fi
+if [ ! -f "$CONFIG" ]; then
+    echo "Coverage config not found: $CONFIG" >&2
+    exit 2
+fi
+
+# fail prints a threshold violation, as an annotation when running in GitHub Actions
+fail() {
+    if [ "${GITHUB_ACTIONS:-}" = "true" ]; then
+        echo "::error::$1"
+    else
+        echo "FAIL  $1"
+    fi
+}
+
+# below reports whether $1 < $2 (floating point)
+below() {
+    awk -v actual="$1" -v min="$2" 'BEGIN { exit !(actual < min) }'
+}
+
+MODULE="$(go list -m)/"
+
+TOTAL=$(go tool cover -func="$PROFILE" | awk '$1 == "total:" { sub(/%$/, "", $NF); print $NF }')
+if [ -z "$TOTAL" ]; then
+    fail "Could not parse total coverage from $PROFILE"
+    exit 1
+fi
+
+# "<package> <percent>" per package. Blocks are de-duplicated so a file that
+# appears in several test binaries' output is only counted once.
+PACKAGES=$(awk -v module="$MODULE" '
+    /^mode:/ { next }
+    {
+        # file.go:startLine.startCol,endLine.endCol numStatements hitCount
+        block = $1
+        if (!(block in stmts)) {
+            stmts[block] = $2
+            blocks[++n] = block
+        }
+        if ($3 > 0) {
+            hit[block] = 1
+        }
+    }
+    END {
+        for (i = 1; i <= n; i++) {
+            block = blocks[i]
+            pkg = substr(block, 1, index(block, ":") - 1)
+            sub(/\/[^\/]*$/, "", pkg)
+            if (index(pkg, module) == 1) {
+                pkg = substr(pkg, length(module) + 1)
+            }
+            total[pkg] += stmts[block]
+            if (block in hit) {
+                covered[pkg] += stmts[block]
+            }
+        }
+        for (pkg in total) {
+            printf "%s %.1f\n", pkg, (total[pkg] > 0 ? covered[pkg] * 100 / total[pkg] : 100)
+        }
+    }' "$PROFILE")
+
+failures=0
+
+while read -r pkg min _; do
+    case "$pkg" in
+        "" | "#"*) continue ;;
+    esac
+
+    if [ "$pkg" = "total" ]; then
+        TOTAL_MIN="$min"
+        continue
+    fi
+
+    actual=$(printf '%s\n' "$PACKAGES" | awk -v pkg="$pkg" '$1 == pkg { print $2 }')
+    if [ -z "$actual" ]; then
+        fail "$pkg: no coverage data (minimum ${min}%)"
+        failures=$((failures + 1))
+    elif below "$actual" "$min"; then
+        fail "$pkg: ${actual}% is below minimum ${min}%"
+        failures=$((failures + 1))
+    else
+        echo "ok    $pkg: ${actual}% (minimum ${min}%)"
+    fi
+done < "$CONFIG"
+
+if below "$TOTAL" "$TOTAL_MIN"; then
+    fail "total: ${TOTAL}% is below minimum ${TOTAL_MIN}%"
+    failures=$((failures + 1))
+else
+    echo "ok    total: ${TOTAL}% (minimum ${TOTAL_MIN}%)"
+fi
+
+if [ "$failures" -gt 0 ]; then
+    echo ""
+    echo "$failures coverage threshold(s) violated"
+    exit 1
+fi
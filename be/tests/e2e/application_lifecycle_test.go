@@ -0,0 +1,91 @@
+//go:build integration
+
+package e2e
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createResource POSTs a payload and returns the ID of the created resource.
+func createResource(t *testing.T, path string, payload interface{}, token string) string {
+	t.Helper()
+	resp := doRequest(t, http.MethodPost, path, payload, token)
+	assertStatus(t, resp, http.StatusCreated)
+	body := parseJSON[map[string]interface{}](t, resp)
+	id, ok := body["id"].(string)
+	require.True(t, ok, "response from %s has no id", path)
+	return id
+}
+
+func TestIntegrationApplicationLifecycle(t *testing.T) {
+	cleanupAll(t)
+
+	// Register and log in through the API
+	credentials := map[string]string{
+		"email":    "lifecycle@example.com",
+		"password": "securepass123",
+	}
+	resp := doRequest(t, http.MethodPost, "/api/v1/auth/register", credentials, "")
+	assertStatus(t, resp, http.StatusCreated)
+	resp.Body.Close()
+
+	resp = doRequest(t, http.MethodPost, "/api/v1/auth/login", credentials, "")
+	assertStatus(t, resp, http.StatusOK)
+	body := parseJSON[map[string]interface{}](t, resp)
+	tokens := body["tokens"].(map[string]interface{})
+	token := "Bearer " + tokens["access_token"].(string)
+
+	// Build the entities an application depends on
+	companyID := createResource(t, "/api/v1/companies", map[string]string{
+		"name": "Acme",
+	}, token)
+	jobID := createResource(t, "/api/v1/jobs", map[string]string{
+		"title":      "Backend Engineer",
+		"company_id": companyID,
+	}, token)
+	resumeID := createResource(t, "/api/v1/resumes", map[string]string{
+		"title": "Backend CV",
+	}, token)
+
+	appID := createResource(t, "/api/v1/applications", map[string]interface{}{
+		"job_id":     jobID,
+		"resume_id":  resumeID,
+		"applied_at": time.Now().UTC().Format(time.RFC3339),
+	}, token)
+
+	// Add a stage and complete it
+	templateID := createResource(t, "/api/v1/stage-templates", map[string]interface{}{
+		"name":  "Phone Screen",
+		"order": 1,
+	}, token)
+	stageID := createResource(t, fmt.Sprintf("/api/v1/applications/%s/stages", appID), map[string]string{
+		"stage_template_id": templateID,
+	}, token)
+
+	resp = doRequest(t, http.MethodPatch, fmt.Sprintf("/api/v1/applications/%s/stages/%s/complete", appID, stageID), map[string]string{}, token)
+	assertStatus(t, resp, http.StatusOK)
+	stage := parseJSON[map[string]interface{}](t, resp)
+	assert.Equal(t, "completed", stage["status"])
+
+	// The application reflects the progressed stage
+	resp = doRequest(t, http.MethodGet, "/api/v1/applications/"+appID, nil, token)
+	assertStatus(t, resp, http.StatusOK)
+	app := parseJSON[map[string]interface{}](t, resp)
+	assert.Equal(t, "active", app["status"])
+	assert.Equal(t, float64(1), app["stage_count"])
+	assert.Equal(t, float64(1), app["completed_stage_count"])
+
+	// Analytics count the active application
+	resp = doRequest(t, http.MethodGet, "/api/v1/analytics/overview", nil, token)
+	assertStatus(t, resp, http.StatusOK)
+	overview := parseJSON[map[string]interface{}](t, resp)
+	activeApplications, ok := overview["active_applications"].(float64)
+	require.True(t, ok, "overview has no active_applications: %v", overview)
+	assert.GreaterOrEqual(t, activeApplications, float64(1))
+}
@@ -131,6 +131,38 @@ func TestIntegrationRefreshToken(t *testing.T) {
 	assert.NotEmpty(t, newTokens["access_token"])
 }
 
+func TestIntegrationRefreshTokenReuse(t *testing.T) {
+	cleanupAll(t)
+
+	resp := doRequest(t, http.MethodPost, "/api/v1/auth/register", map[string]string{
+		"email":    "reuse@example.com",
+		"password": "securepass123",
+	}, "")
+	assertStatus(t, resp, http.StatusCreated)
+
+	body := parseJSON[map[string]interface{}](t, resp)
+	refreshToken := body["tokens"].(map[string]interface{})["refresh_token"].(string)
+
+	resp = doRequest(t, http.MethodPost, "/api/v1/auth/refresh", map[string]string{
+		"refresh_token": refreshToken,
+	}, "")
+	assertStatus(t, resp, http.StatusOK)
+	rotated := parseJSON[map[string]interface{}](t, resp)["refresh_token"].(string)
+
+	// Presenting the rotated token again ends the whole session
+	resp = doRequest(t, http.MethodPost, "/api/v1/auth/refresh", map[string]string{
+		"refresh_token": refreshToken,
+	}, "")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assertErrorCode(t, resp, "TOKEN_REUSE_DETECTED")
+
+	resp = doRequest(t, http.MethodPost, "/api/v1/auth/refresh", map[string]string{
+		"refresh_token": rotated,
+	}, "")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	resp.Body.Close()
+}
+
 func TestIntegrationRefreshInvalidToken(t *testing.T) {
 	resp := doRequest(t, http.MethodPost, "/api/v1/auth/refresh", map[string]string{
 		"refresh_token": "invalid-token-string",
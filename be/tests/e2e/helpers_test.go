@@ -52,7 +52,7 @@ func seedSubscription(t *testing.T, userID, plan string) {
 // authToken generates a JWT access token for a user.
 func authToken(t *testing.T, userID string) string {
 	t.Helper()
-	token, err := jwtManager.GenerateAccessToken(userID)
+	token, err := jwtManager.GenerateAccessToken(userID, "en")
 	require.NoError(t, err)
 	return "Bearer " + token
 }
@@ -219,33 +219,39 @@ func TestMain(m *testing.M) {
 		"", "", "", "", "", "sandbox",
 	)
 
-	authSvc := authService.NewAuthService(
-		userRepository,
-		tokenRepository,
-		jwtManager,
-		15*time.Minute,
-		7*24*time.Hour,
-		subscriptionSvc,
-	)
-	companySvc := companyService.NewCompanyService(companyRepository)
-	jobSvc := jobService.NewJobService(jobRepository, companyRepository, subscriptionSvc, matchScoreCacheRepository)
+	authSvc := authService.NewAuthService(authService.AuthServiceConfig{
+		UserRepo:            userRepository,
+		TokenRepo:           tokenRepository,
+		JWTManager:          jwtManager,
+		AccessExpiry:        15 * time.Minute,
+		RefreshExpiry:       7 * 24 * time.Hour,
+		SubscriptionCreator: subscriptionSvc,
+		Logger:              zapLogger.Logger,
+	})
+	companySvc := companyService.NewCompanyService(companyRepository, nil, nil, nil)
+	jobSvc := jobService.NewJobService(jobRepository, companyRepository, subscriptionSvc, matchScoreCacheRepository, nil, nil, nil, nil)
 	resumeSvc := resumeService.NewResumeService(resumeRepository, nil, subscriptionSvc, matchScoreCacheRepository)
-	applicationSvc := appService.NewApplicationService(
-		pool,
-		applicationRepository,
-		applicationStageRepository,
-		stageTemplateRepository,
-		jobRepository,
-		companyRepository,
-		resumeRepository,
-		commentRepository,
-		zapLogger,
-		subscriptionSvc,
-	)
-	commentSvc := commentService.NewCommentService(commentRepository)
-	analyticsSvc := analyticsService.NewAnalyticsService(analyticsRepository)
 
+	// Resume builder repository is needed by the application service
 	resumeBuilderRepository := rbRepo.NewResumeBuilderRepository(pool)
+	applicationSvc := appService.NewApplicationService(appService.ApplicationServiceConfig{
+		Pool:              pool,
+		AppRepo:           applicationRepository,
+		StageRepo:         applicationStageRepository,
+		TemplateRepo:      stageTemplateRepository,
+		JobRepo:           jobRepository,
+		CompanyRepo:       companyRepository,
+		ResumeRepo:        resumeRepository,
+		ResumeBuilderRepo: resumeBuilderRepository,
+		CommentRepo:       commentRepository,
+		Logger:            zapLogger,
+		LimitChecker:      subscriptionSvc,
+		EventRepo:         appRepo.NewApplicationEventRepository(pool),
+		TxRepos:           appRepo.NewTxRepositories,
+	})
+	commentSvc := commentService.NewCommentService(commentRepository, applicationSvc)
+	analyticsSvc := analyticsService.NewAnalyticsService(analyticsRepository)
+
 	resumeBuilderSvc := rbService.NewResumeBuilderService(resumeBuilderRepository, subscriptionSvc)
 
 	contentLibraryRepository := clRepo.NewContentLibraryRepository(pool)
@@ -262,12 +268,14 @@ func TestMain(m *testing.M) {
 	resumeHdl := resumeHandler.NewResumeHandler(resumeSvc)
 	applicationHdl := appHandler.NewApplicationHandler(applicationSvc)
 	commentHdl := commentHandler.NewCommentHandler(commentSvc)
-	analyticsHdl := analyticsHandler.NewAnalyticsHandler(analyticsSvc)
+	analyticsHdl := analyticsHandler.NewAnalyticsHandler(analyticsSvc, nil)
 	resumeBuilderHdl := rbHandler.NewResumeBuilderHandler(resumeBuilderSvc)
 	contentLibraryHdl := clHandler.NewContentLibraryHandler(contentLibrarySvc)
 	coverLetterHdl := cvHandler.NewCoverLetterHandler(coverLetterSvc)
 	subscriptionHdl := subHandler.NewSubscriptionHandler(subscriptionSvc, zapLogger.Logger)
-	webhookHdl := subHandler.NewWebhookHandler(subscriptionSvc)
+	webhookHdl := subHandler.NewWebhookHandler(subscriptionSvc, zapLogger.Logger)
+
+	idempotencyMiddleware := httpPlatform.IdempotencyMiddleware(rdb, httpPlatform.DefaultIdempotencyTTL, zapLogger.Logger)
 
 	// Register routes
 	v1 := router.Group("/api/v1")
@@ -279,13 +287,13 @@ func TestMain(m *testing.M) {
 		companyHdl.RegisterRoutes(v1, authMiddleware)
 		jobHdl.RegisterRoutes(v1, authMiddleware)
 		resumeHdl.RegisterRoutes(v1, authMiddleware)
-		applicationHdl.RegisterRoutes(v1, authMiddleware)
+		applicationHdl.RegisterRoutes(v1, authMiddleware, idempotencyMiddleware)
 		commentHdl.RegisterRoutes(v1, authMiddleware)
 		analyticsHdl.RegisterRoutes(v1, authMiddleware)
 		resumeBuilderHdl.RegisterRoutes(v1, authMiddleware)
 		contentLibraryHdl.RegisterRoutes(v1, authMiddleware)
 		coverLetterHdl.RegisterRoutes(v1, authMiddleware)
-		subscriptionHdl.RegisterRoutes(v1, authMiddleware)
+		subscriptionHdl.RegisterRoutes(v1, authMiddleware, true)
 		webhookHdl.RegisterRoutes(v1)
 	}
 
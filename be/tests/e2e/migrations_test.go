@@ -0,0 +1,112 @@
+//go:build integration
+
+package e2e
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIntegrationForeignKeyIndexes asserts that the columns commonly used for
+// filtering are covered by a B-tree index after all migrations have run.
+// Columns are compared as a set, so (entity_type, entity_id) satisfies
+// (entity_id, entity_type).
+func TestIntegrationForeignKeyIndexes(t *testing.T) {
+	required := []struct {
+		table   string
+		columns []string
+	}{
+		{"applications", []string{"user_id"}},
+		{"applications", []string{"job_id"}},
+		{"applications", []string{"resume_id"}},
+		{"application_stages", []string{"application_id"}},
+		{"comments", []string{"application_id"}},
+		{"comments", []string{"stage_id"}},
+		{"tag_relations", []string{"entity_id", "entity_type"}},
+		{"reminders", []string{"application_id"}},
+		{"jobs", []string{"user_id"}},
+		{"jobs", []string{"company_id"}},
+		{"application_contacts", []string{"contact_id"}},
+	}
+
+	// Leading key columns of every B-tree index, keyed by table
+	rows, err := pool.Query(context.Background(), `
+		SELECT t.relname, array_agg(a.attname::text ORDER BY k.ord)
+		FROM pg_index i
+		JOIN pg_class t ON t.oid = i.indrelid
+		JOIN pg_class ix ON ix.oid = i.indexrelid
+		JOIN pg_am am ON am.oid = ix.relam
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		CROSS JOIN LATERAL unnest(i.indkey) WITH ORDINALITY AS k(attnum, ord)
+		JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = k.attnum
+		WHERE n.nspname = current_schema() AND am.amname = 'btree'
+		GROUP BY t.relname, i.indexrelid`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	indexes := map[string][][]string{}
+	for rows.Next() {
+		var table string
+		var columns []string
+		require.NoError(t, rows.Scan(&table, &columns))
+		indexes[table] = append(indexes[table], columns)
+	}
+	require.NoError(t, rows.Err())
+
+	for _, want := range required {
+		found := false
+		for _, columns := range indexes[want.table] {
+			if len(columns) >= len(want.columns) && sameColumns(columns[:len(want.columns)], want.columns) {
+				found = true
+				break
+			}
+		}
+		require.True(t, found, "missing index on %s(%s)", want.table, strings.Join(want.columns, ", "))
+	}
+}
+
+// TestIntegrationTagRelationsUpdatedAtTrigger asserts that updating a tag
+// relation bumps updated_at via the BEFORE UPDATE trigger.
+func TestIntegrationTagRelationsUpdatedAtTrigger(t *testing.T) {
+	ctx := context.Background()
+	userID := seedUser(t, "tag-trigger@test.com", "password123")
+	tagID := uuid.New().String()
+	_, err := pool.Exec(ctx, `INSERT INTO tags (id, user_id, name) VALUES ($1, $2, 'remote')`, tagID, userID)
+	require.NoError(t, err)
+
+	past := time.Now().UTC().Add(-time.Hour).Truncate(time.Microsecond)
+	relID := uuid.New().String()
+	_, err = pool.Exec(ctx,
+		`INSERT INTO tag_relations (id, tag_id, entity_type, entity_id, added_by_user_id, created_at, updated_at)
+		 VALUES ($1, $2, 'company', $3, $4, $5, $5)`,
+		relID, tagID, uuid.New().String(), userID, past,
+	)
+	require.NoError(t, err)
+
+	_, err = pool.Exec(ctx, `UPDATE tag_relations SET entity_type = 'job' WHERE id = $1`, relID)
+	require.NoError(t, err)
+
+	var createdAt, updatedAt time.Time
+	var addedBy string
+	err = pool.QueryRow(ctx,
+		`SELECT created_at, updated_at, added_by_user_id FROM tag_relations WHERE id = $1`, relID,
+	).Scan(&createdAt, &updatedAt, &addedBy)
+	require.NoError(t, err)
+	require.True(t, createdAt.Equal(past), "created_at must not change")
+	require.True(t, updatedAt.After(past), "updated_at should be bumped by the trigger")
+	require.Equal(t, userID, addedBy)
+}
+
+func sameColumns(a, b []string) bool {
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return strings.Join(a, ",") == strings.Join(b, ",")
+}
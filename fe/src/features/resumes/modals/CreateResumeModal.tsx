@@ -58,14 +58,12 @@ export function CreateResumeModal({
 
   // File upload mutation
   const uploadMutation = useMutation({
-    mutationFn: async (file: File) => {
-      const resume = await resumesService.uploadResume(file, setUploadProgress);
-      // Update title if provided
-      if (title && title !== "Untitled Resume") {
-        return resumesService.update(resume.id, { title, is_active: true });
-      }
-      return resumesService.update(resume.id, { is_active: true });
-    },
+    mutationFn: (file: File) =>
+      resumesService.uploadResume(
+        file,
+        title || file.name.replace(/\.[^/.]+$/, ""),
+        setUploadProgress,
+      ),
     onSuccess: async (data) => {
       await queryClient.invalidateQueries({ queryKey: ["resumes"] });
       showSuccessNotification(t("resumes.uploadSuccess"));
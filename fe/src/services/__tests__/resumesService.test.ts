@@ -106,7 +106,7 @@ describe("resumesService", () => {
     it("calls POST on resumes/upload-url", async () => {
       const mockResponse = {
         upload_url: "https://s3.example.com/upload",
-        resume_id: "r3",
+        storage_key: "users/u1/resumes/r3.pdf",
       };
       mockApiClient.post.mockResolvedValue(mockResponse);
 
@@ -174,48 +174,56 @@ describe("resumesService", () => {
 
   describe("uploadResume", () => {
     it("orchestrates the full upload flow", async () => {
-      mockApiClient.post.mockResolvedValue({
-        upload_url: "https://s3.example.com/upload",
-        resume_id: "r5",
-      });
+      const mockResume = { id: "r5", title: "Backend CV" };
+      mockApiClient.post
+        .mockResolvedValueOnce({
+          upload_url: "https://s3.example.com/upload",
+          storage_key: "users/u1/resumes/r5.pdf",
+        })
+        .mockResolvedValueOnce(mockResume);
 
       const mockFetch = vi.fn().mockResolvedValue({ ok: true });
       globalThis.fetch = mockFetch;
 
-      const mockResume = { id: "r5", title: "resume.pdf" };
-      mockApiClient.get.mockResolvedValue(mockResume);
-
       const file = new File(["content"], "resume.pdf", {
         type: "application/pdf",
       });
       const onProgress = vi.fn();
 
-      const result = await resumesService.uploadResume(file, onProgress);
+      const result = await resumesService.uploadResume(
+        file,
+        "Backend CV",
+        onProgress,
+      );
 
       expect(mockApiClient.post).toHaveBeenCalledWith("resumes/upload-url", {
         filename: "resume.pdf",
         content_type: "application/pdf",
       });
       expect(mockFetch).toHaveBeenCalled();
-      expect(mockApiClient.get).toHaveBeenCalledWith("resumes/r5");
+      expect(mockApiClient.post).toHaveBeenCalledWith("resumes", {
+        title: "Backend CV",
+        storage_key: "users/u1/resumes/r5.pdf",
+      });
       expect(onProgress).toHaveBeenCalledWith(50);
       expect(onProgress).toHaveBeenCalledWith(100);
       expect(result).toEqual(mockResume);
     });
 
     it("works without onProgress callback", async () => {
-      mockApiClient.post.mockResolvedValue({
-        upload_url: "https://s3.example.com/upload",
-        resume_id: "r6",
-      });
+      mockApiClient.post
+        .mockResolvedValueOnce({
+          upload_url: "https://s3.example.com/upload",
+          storage_key: "users/u1/resumes/r6.pdf",
+        })
+        .mockResolvedValueOnce({ id: "r6" });
       globalThis.fetch = vi.fn().mockResolvedValue({ ok: true });
-      mockApiClient.get.mockResolvedValue({ id: "r6" });
 
       const file = new File(["content"], "test.pdf", {
         type: "application/pdf",
       });
 
-      const result = await resumesService.uploadResume(file);
+      const result = await resumesService.uploadResume(file, "test");
 
       expect(result).toEqual({ id: "r6" });
     });
@@ -81,6 +81,7 @@ export const resumesService = {
   // Complete upload flow
   async uploadResume(
     file: File,
+    title: string,
     onProgress?: (progress: number) => void,
   ): Promise<ResumeDTO> {
     // Step 1: Generate upload URL
@@ -94,7 +95,7 @@ export const resumesService = {
     await this.uploadToS3(uploadData.upload_url, file);
     if (onProgress) onProgress(100);
 
-    // Step 3: Get the created resume
-    return this.getById(uploadData.resume_id);
+    // Step 3: Create the resume for the uploaded file
+    return this.create({ title, storage_key: uploadData.storage_key });
   },
 };
@@ -285,6 +285,7 @@ export interface ResumeDTO {
   file_url: string | null;
   storage_type: StorageType;
   storage_key?: string | null;
+  download_url?: string;
   is_active: boolean;
   applications_count: number;
   can_delete: boolean;
@@ -295,6 +296,7 @@ export interface ResumeDTO {
 export interface CreateResumeRequest {
   title: string;
   file_url?: string | null;
+  storage_key?: string;
   is_active?: boolean;
 }
 
@@ -310,8 +312,8 @@ export interface GenerateUploadURLRequest {
 }
 
 export interface GenerateUploadURLResponse {
-  resume_id: string;
   upload_url: string;
+  storage_key: string;
   expires_in: number;
 }
 